@@ -26,18 +26,22 @@ const (
 	ApiContainerService_GetServices_FullMethodName                                = "/api_container_api.ApiContainerService/GetServices"
 	ApiContainerService_GetExistingAndHistoricalServiceIdentifiers_FullMethodName = "/api_container_api.ApiContainerService/GetExistingAndHistoricalServiceIdentifiers"
 	ApiContainerService_ExecCommand_FullMethodName                                = "/api_container_api.ApiContainerService/ExecCommand"
+	ApiContainerService_ExecCommandStream_FullMethodName                          = "/api_container_api.ApiContainerService/ExecCommandStream"
 	ApiContainerService_WaitForHttpGetEndpointAvailability_FullMethodName         = "/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability"
 	ApiContainerService_WaitForHttpPostEndpointAvailability_FullMethodName        = "/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability"
 	ApiContainerService_UploadFilesArtifact_FullMethodName                        = "/api_container_api.ApiContainerService/UploadFilesArtifact"
 	ApiContainerService_DownloadFilesArtifact_FullMethodName                      = "/api_container_api.ApiContainerService/DownloadFilesArtifact"
 	ApiContainerService_StoreWebFilesArtifact_FullMethodName                      = "/api_container_api.ApiContainerService/StoreWebFilesArtifact"
 	ApiContainerService_StoreFilesArtifactFromService_FullMethodName              = "/api_container_api.ApiContainerService/StoreFilesArtifactFromService"
+	ApiContainerService_CopyFilesArtifactToService_FullMethodName                 = "/api_container_api.ApiContainerService/CopyFilesArtifactToService"
 	ApiContainerService_ListFilesArtifactNamesAndUuids_FullMethodName             = "/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids"
 	ApiContainerService_InspectFilesArtifactContents_FullMethodName               = "/api_container_api.ApiContainerService/InspectFilesArtifactContents"
 	ApiContainerService_ConnectServices_FullMethodName                            = "/api_container_api.ApiContainerService/ConnectServices"
 	ApiContainerService_GetStarlarkRun_FullMethodName                             = "/api_container_api.ApiContainerService/GetStarlarkRun"
+	ApiContainerService_WatchStarlarkRunProgress_FullMethodName                   = "/api_container_api.ApiContainerService/WatchStarlarkRunProgress"
 	ApiContainerService_GetStarlarkScriptPlanYaml_FullMethodName                  = "/api_container_api.ApiContainerService/GetStarlarkScriptPlanYaml"
 	ApiContainerService_GetStarlarkPackagePlanYaml_FullMethodName                 = "/api_container_api.ApiContainerService/GetStarlarkPackagePlanYaml"
+	ApiContainerService_GetResolvedPackageDependencies_FullMethodName             = "/api_container_api.ApiContainerService/GetResolvedPackageDependencies"
 )
 
 // ApiContainerServiceClient is the client API for ApiContainerService service.
@@ -56,6 +60,9 @@ type ApiContainerServiceClient interface {
 	GetExistingAndHistoricalServiceIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalServiceIdentifiersResponse, error)
 	// Executes the given command inside a running container
 	ExecCommand(ctx context.Context, in *ExecCommandArgs, opts ...grpc.CallOption) (*ExecCommandResponse, error)
+	// Executes the given command inside a running container, streaming STDIN to it and its output back while it runs
+	// The first message sent must be the one starting the command, and the last message received carries its exit code
+	ExecCommandStream(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_ExecCommandStreamClient, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
 	WaitForHttpGetEndpointAvailability(ctx context.Context, in *WaitForHttpGetEndpointAvailabilityArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
@@ -68,16 +75,22 @@ type ApiContainerServiceClient interface {
 	StoreWebFilesArtifact(ctx context.Context, in *StoreWebFilesArtifactArgs, opts ...grpc.CallOption) (*StoreWebFilesArtifactResponse, error)
 	// Tells the API container to copy a files artifact from a service to the Kurtosis File System
 	StoreFilesArtifactFromService(ctx context.Context, in *StoreFilesArtifactFromServiceArgs, opts ...grpc.CallOption) (*StoreFilesArtifactFromServiceResponse, error)
+	// Tells the API container to copy the contents of a files artifact into a running service
+	CopyFilesArtifactToService(ctx context.Context, in *CopyFilesArtifactToServiceArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListFilesArtifactNamesAndUuids(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFilesArtifactNamesAndUuidsResponse, error)
 	InspectFilesArtifactContents(ctx context.Context, in *InspectFilesArtifactContentsRequest, opts ...grpc.CallOption) (*InspectFilesArtifactContentsResponse, error)
 	// User services port forwarding
 	ConnectServices(ctx context.Context, in *ConnectServicesArgs, opts ...grpc.CallOption) (*ConnectServicesResponse, error)
 	// Get last Starlark run
 	GetStarlarkRun(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStarlarkRunResponse, error)
+	// Streams the progress and the end of every Starlark run executed in the enclave from now on, whoever started it
+	WatchStarlarkRunProgress(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiContainerService_WatchStarlarkRunProgressClient, error)
 	// Gets yaml representing the plan the script will execute in an enclave
 	GetStarlarkScriptPlanYaml(ctx context.Context, in *StarlarkScriptPlanYamlArgs, opts ...grpc.CallOption) (*PlanYaml, error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(ctx context.Context, in *StarlarkPackagePlanYamlArgs, opts ...grpc.CallOption) (*PlanYaml, error)
+	// Returns the commit and content hash of every remote package cloned in the enclave, used to generate kurtosis.lock
+	GetResolvedPackageDependencies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetResolvedPackageDependenciesResponse, error)
 }
 
 type apiContainerServiceClient struct {
@@ -213,6 +226,37 @@ func (c *apiContainerServiceClient) ExecCommand(ctx context.Context, in *ExecCom
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) ExecCommandStream(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_ExecCommandStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[3], ApiContainerService_ExecCommandStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceExecCommandStreamClient{stream}
+	return x, nil
+}
+
+type ApiContainerService_ExecCommandStreamClient interface {
+	Send(*ExecCommandStreamRequest) error
+	Recv() (*ExecCommandStreamResponse, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceExecCommandStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceExecCommandStreamClient) Send(m *ExecCommandStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *apiContainerServiceExecCommandStreamClient) Recv() (*ExecCommandStreamResponse, error) {
+	m := new(ExecCommandStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *apiContainerServiceClient) WaitForHttpGetEndpointAvailability(ctx context.Context, in *WaitForHttpGetEndpointAvailabilityArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, ApiContainerService_WaitForHttpGetEndpointAvailability_FullMethodName, in, out, opts...)
@@ -232,7 +276,7 @@ func (c *apiContainerServiceClient) WaitForHttpPostEndpointAvailability(ctx cont
 }
 
 func (c *apiContainerServiceClient) UploadFilesArtifact(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_UploadFilesArtifactClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[3], ApiContainerService_UploadFilesArtifact_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[4], ApiContainerService_UploadFilesArtifact_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -266,7 +310,7 @@ func (x *apiContainerServiceUploadFilesArtifactClient) CloseAndRecv() (*UploadFi
 }
 
 func (c *apiContainerServiceClient) DownloadFilesArtifact(ctx context.Context, in *DownloadFilesArtifactArgs, opts ...grpc.CallOption) (ApiContainerService_DownloadFilesArtifactClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[4], ApiContainerService_DownloadFilesArtifact_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[5], ApiContainerService_DownloadFilesArtifact_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -315,6 +359,15 @@ func (c *apiContainerServiceClient) StoreFilesArtifactFromService(ctx context.Co
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) CopyFilesArtifactToService(ctx context.Context, in *CopyFilesArtifactToServiceArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_CopyFilesArtifactToService_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiContainerServiceClient) ListFilesArtifactNamesAndUuids(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFilesArtifactNamesAndUuidsResponse, error) {
 	out := new(ListFilesArtifactNamesAndUuidsResponse)
 	err := c.cc.Invoke(ctx, ApiContainerService_ListFilesArtifactNamesAndUuids_FullMethodName, in, out, opts...)
@@ -351,6 +404,38 @@ func (c *apiContainerServiceClient) GetStarlarkRun(ctx context.Context, in *empt
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) WatchStarlarkRunProgress(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiContainerService_WatchStarlarkRunProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[6], ApiContainerService_WatchStarlarkRunProgress_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceWatchStarlarkRunProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_WatchStarlarkRunProgressClient interface {
+	Recv() (*StarlarkRunResponseLine, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceWatchStarlarkRunProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceWatchStarlarkRunProgressClient) Recv() (*StarlarkRunResponseLine, error) {
+	m := new(StarlarkRunResponseLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *apiContainerServiceClient) GetStarlarkScriptPlanYaml(ctx context.Context, in *StarlarkScriptPlanYamlArgs, opts ...grpc.CallOption) (*PlanYaml, error) {
 	out := new(PlanYaml)
 	err := c.cc.Invoke(ctx, ApiContainerService_GetStarlarkScriptPlanYaml_FullMethodName, in, out, opts...)
@@ -369,6 +454,15 @@ func (c *apiContainerServiceClient) GetStarlarkPackagePlanYaml(ctx context.Conte
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) GetResolvedPackageDependencies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetResolvedPackageDependenciesResponse, error) {
+	out := new(GetResolvedPackageDependenciesResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetResolvedPackageDependencies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiContainerServiceServer is the server API for ApiContainerService service.
 // All implementations should embed UnimplementedApiContainerServiceServer
 // for forward compatibility
@@ -385,6 +479,9 @@ type ApiContainerServiceServer interface {
 	GetExistingAndHistoricalServiceIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalServiceIdentifiersResponse, error)
 	// Executes the given command inside a running container
 	ExecCommand(context.Context, *ExecCommandArgs) (*ExecCommandResponse, error)
+	// Executes the given command inside a running container, streaming STDIN to it and its output back while it runs
+	// The first message sent must be the one starting the command, and the last message received carries its exit code
+	ExecCommandStream(ApiContainerService_ExecCommandStreamServer) error
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
 	WaitForHttpGetEndpointAvailability(context.Context, *WaitForHttpGetEndpointAvailabilityArgs) (*emptypb.Empty, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
@@ -397,16 +494,22 @@ type ApiContainerServiceServer interface {
 	StoreWebFilesArtifact(context.Context, *StoreWebFilesArtifactArgs) (*StoreWebFilesArtifactResponse, error)
 	// Tells the API container to copy a files artifact from a service to the Kurtosis File System
 	StoreFilesArtifactFromService(context.Context, *StoreFilesArtifactFromServiceArgs) (*StoreFilesArtifactFromServiceResponse, error)
+	// Tells the API container to copy the contents of a files artifact into a running service
+	CopyFilesArtifactToService(context.Context, *CopyFilesArtifactToServiceArgs) (*emptypb.Empty, error)
 	ListFilesArtifactNamesAndUuids(context.Context, *emptypb.Empty) (*ListFilesArtifactNamesAndUuidsResponse, error)
 	InspectFilesArtifactContents(context.Context, *InspectFilesArtifactContentsRequest) (*InspectFilesArtifactContentsResponse, error)
 	// User services port forwarding
 	ConnectServices(context.Context, *ConnectServicesArgs) (*ConnectServicesResponse, error)
 	// Get last Starlark run
 	GetStarlarkRun(context.Context, *emptypb.Empty) (*GetStarlarkRunResponse, error)
+	// Streams the progress and the end of every Starlark run executed in the enclave from now on, whoever started it
+	WatchStarlarkRunProgress(*emptypb.Empty, ApiContainerService_WatchStarlarkRunProgressServer) error
 	// Gets yaml representing the plan the script will execute in an enclave
 	GetStarlarkScriptPlanYaml(context.Context, *StarlarkScriptPlanYamlArgs) (*PlanYaml, error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *StarlarkPackagePlanYamlArgs) (*PlanYaml, error)
+	// Returns the commit and content hash of every remote package cloned in the enclave, used to generate kurtosis.lock
+	GetResolvedPackageDependencies(context.Context, *emptypb.Empty) (*GetResolvedPackageDependenciesResponse, error)
 }
 
 // UnimplementedApiContainerServiceServer should be embedded to have forward compatible implementations.
@@ -431,6 +534,9 @@ func (UnimplementedApiContainerServiceServer) GetExistingAndHistoricalServiceIde
 func (UnimplementedApiContainerServiceServer) ExecCommand(context.Context, *ExecCommandArgs) (*ExecCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecCommand not implemented")
 }
+func (UnimplementedApiContainerServiceServer) ExecCommandStream(ApiContainerService_ExecCommandStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExecCommandStream not implemented")
+}
 func (UnimplementedApiContainerServiceServer) WaitForHttpGetEndpointAvailability(context.Context, *WaitForHttpGetEndpointAvailabilityArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WaitForHttpGetEndpointAvailability not implemented")
 }
@@ -449,6 +555,9 @@ func (UnimplementedApiContainerServiceServer) StoreWebFilesArtifact(context.Cont
 func (UnimplementedApiContainerServiceServer) StoreFilesArtifactFromService(context.Context, *StoreFilesArtifactFromServiceArgs) (*StoreFilesArtifactFromServiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StoreFilesArtifactFromService not implemented")
 }
+func (UnimplementedApiContainerServiceServer) CopyFilesArtifactToService(context.Context, *CopyFilesArtifactToServiceArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyFilesArtifactToService not implemented")
+}
 func (UnimplementedApiContainerServiceServer) ListFilesArtifactNamesAndUuids(context.Context, *emptypb.Empty) (*ListFilesArtifactNamesAndUuidsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFilesArtifactNamesAndUuids not implemented")
 }
@@ -461,12 +570,18 @@ func (UnimplementedApiContainerServiceServer) ConnectServices(context.Context, *
 func (UnimplementedApiContainerServiceServer) GetStarlarkRun(context.Context, *emptypb.Empty) (*GetStarlarkRunResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkRun not implemented")
 }
+func (UnimplementedApiContainerServiceServer) WatchStarlarkRunProgress(*emptypb.Empty, ApiContainerService_WatchStarlarkRunProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStarlarkRunProgress not implemented")
+}
 func (UnimplementedApiContainerServiceServer) GetStarlarkScriptPlanYaml(context.Context, *StarlarkScriptPlanYamlArgs) (*PlanYaml, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkScriptPlanYaml not implemented")
 }
 func (UnimplementedApiContainerServiceServer) GetStarlarkPackagePlanYaml(context.Context, *StarlarkPackagePlanYamlArgs) (*PlanYaml, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkPackagePlanYaml not implemented")
 }
+func (UnimplementedApiContainerServiceServer) GetResolvedPackageDependencies(context.Context, *emptypb.Empty) (*GetResolvedPackageDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResolvedPackageDependencies not implemented")
+}
 
 // UnsafeApiContainerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ApiContainerServiceServer will
@@ -601,6 +716,32 @@ func _ApiContainerService_ExecCommand_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_ExecCommandStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ApiContainerServiceServer).ExecCommandStream(&apiContainerServiceExecCommandStreamServer{stream})
+}
+
+type ApiContainerService_ExecCommandStreamServer interface {
+	Send(*ExecCommandStreamResponse) error
+	Recv() (*ExecCommandStreamRequest, error)
+	grpc.ServerStream
+}
+
+type apiContainerServiceExecCommandStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceExecCommandStreamServer) Send(m *ExecCommandStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *apiContainerServiceExecCommandStreamServer) Recv() (*ExecCommandStreamRequest, error) {
+	m := new(ExecCommandStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _ApiContainerService_WaitForHttpGetEndpointAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WaitForHttpGetEndpointAvailabilityArgs)
 	if err := dec(in); err != nil {
@@ -720,6 +861,24 @@ func _ApiContainerService_StoreFilesArtifactFromService_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_CopyFilesArtifactToService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFilesArtifactToServiceArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).CopyFilesArtifactToService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_CopyFilesArtifactToService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).CopyFilesArtifactToService(ctx, req.(*CopyFilesArtifactToServiceArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiContainerService_ListFilesArtifactNamesAndUuids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -792,6 +951,27 @@ func _ApiContainerService_GetStarlarkRun_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_WatchStarlarkRunProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).WatchStarlarkRunProgress(m, &apiContainerServiceWatchStarlarkRunProgressServer{stream})
+}
+
+type ApiContainerService_WatchStarlarkRunProgressServer interface {
+	Send(*StarlarkRunResponseLine) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceWatchStarlarkRunProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceWatchStarlarkRunProgressServer) Send(m *StarlarkRunResponseLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ApiContainerService_GetStarlarkScriptPlanYaml_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StarlarkScriptPlanYamlArgs)
 	if err := dec(in); err != nil {
@@ -828,6 +1008,24 @@ func _ApiContainerService_GetStarlarkPackagePlanYaml_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_GetResolvedPackageDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetResolvedPackageDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetResolvedPackageDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetResolvedPackageDependencies(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApiContainerService_ServiceDesc is the grpc.ServiceDesc for ApiContainerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -863,6 +1061,10 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StoreFilesArtifactFromService",
 			Handler:    _ApiContainerService_StoreFilesArtifactFromService_Handler,
 		},
+		{
+			MethodName: "CopyFilesArtifactToService",
+			Handler:    _ApiContainerService_CopyFilesArtifactToService_Handler,
+		},
 		{
 			MethodName: "ListFilesArtifactNamesAndUuids",
 			Handler:    _ApiContainerService_ListFilesArtifactNamesAndUuids_Handler,
@@ -887,6 +1089,10 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStarlarkPackagePlanYaml",
 			Handler:    _ApiContainerService_GetStarlarkPackagePlanYaml_Handler,
 		},
+		{
+			MethodName: "GetResolvedPackageDependencies",
+			Handler:    _ApiContainerService_GetResolvedPackageDependencies_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -904,6 +1110,12 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ApiContainerService_RunStarlarkPackage_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExecCommandStream",
+			Handler:       _ApiContainerService_ExecCommandStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "UploadFilesArtifact",
 			Handler:       _ApiContainerService_UploadFilesArtifact_Handler,
@@ -914,6 +1126,11 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ApiContainerService_DownloadFilesArtifact_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchStarlarkRunProgress",
+			Handler:       _ApiContainerService_WatchStarlarkRunProgress_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api_container_service.proto",
 }
@@ -52,6 +52,9 @@ const (
 	// ApiContainerServiceExecCommandProcedure is the fully-qualified name of the ApiContainerService's
 	// ExecCommand RPC.
 	ApiContainerServiceExecCommandProcedure = "/api_container_api.ApiContainerService/ExecCommand"
+	// ApiContainerServiceExecCommandStreamProcedure is the fully-qualified name of the
+	// ApiContainerService's ExecCommandStream RPC.
+	ApiContainerServiceExecCommandStreamProcedure = "/api_container_api.ApiContainerService/ExecCommandStream"
 	// ApiContainerServiceWaitForHttpGetEndpointAvailabilityProcedure is the fully-qualified name of the
 	// ApiContainerService's WaitForHttpGetEndpointAvailability RPC.
 	ApiContainerServiceWaitForHttpGetEndpointAvailabilityProcedure = "/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability"
@@ -70,6 +73,9 @@ const (
 	// ApiContainerServiceStoreFilesArtifactFromServiceProcedure is the fully-qualified name of the
 	// ApiContainerService's StoreFilesArtifactFromService RPC.
 	ApiContainerServiceStoreFilesArtifactFromServiceProcedure = "/api_container_api.ApiContainerService/StoreFilesArtifactFromService"
+	// ApiContainerServiceCopyFilesArtifactToServiceProcedure is the fully-qualified name of the
+	// ApiContainerService's CopyFilesArtifactToService RPC.
+	ApiContainerServiceCopyFilesArtifactToServiceProcedure = "/api_container_api.ApiContainerService/CopyFilesArtifactToService"
 	// ApiContainerServiceListFilesArtifactNamesAndUuidsProcedure is the fully-qualified name of the
 	// ApiContainerService's ListFilesArtifactNamesAndUuids RPC.
 	ApiContainerServiceListFilesArtifactNamesAndUuidsProcedure = "/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids"
@@ -82,12 +88,18 @@ const (
 	// ApiContainerServiceGetStarlarkRunProcedure is the fully-qualified name of the
 	// ApiContainerService's GetStarlarkRun RPC.
 	ApiContainerServiceGetStarlarkRunProcedure = "/api_container_api.ApiContainerService/GetStarlarkRun"
+	// ApiContainerServiceWatchStarlarkRunProgressProcedure is the fully-qualified name of the
+	// ApiContainerService's WatchStarlarkRunProgress RPC.
+	ApiContainerServiceWatchStarlarkRunProgressProcedure = "/api_container_api.ApiContainerService/WatchStarlarkRunProgress"
 	// ApiContainerServiceGetStarlarkScriptPlanYamlProcedure is the fully-qualified name of the
 	// ApiContainerService's GetStarlarkScriptPlanYaml RPC.
 	ApiContainerServiceGetStarlarkScriptPlanYamlProcedure = "/api_container_api.ApiContainerService/GetStarlarkScriptPlanYaml"
 	// ApiContainerServiceGetStarlarkPackagePlanYamlProcedure is the fully-qualified name of the
 	// ApiContainerService's GetStarlarkPackagePlanYaml RPC.
 	ApiContainerServiceGetStarlarkPackagePlanYamlProcedure = "/api_container_api.ApiContainerService/GetStarlarkPackagePlanYaml"
+	// ApiContainerServiceGetResolvedPackageDependenciesProcedure is the fully-qualified name of the
+	// ApiContainerService's GetResolvedPackageDependencies RPC.
+	ApiContainerServiceGetResolvedPackageDependenciesProcedure = "/api_container_api.ApiContainerService/GetResolvedPackageDependencies"
 )
 
 // ApiContainerServiceClient is a client for the api_container_api.ApiContainerService service.
@@ -104,6 +116,9 @@ type ApiContainerServiceClient interface {
 	GetExistingAndHistoricalServiceIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse], error)
 	// Executes the given command inside a running container
 	ExecCommand(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ExecCommandArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ExecCommandResponse], error)
+	// Executes the given command inside a running container, streaming STDIN to it and its output back while it runs
+	// The first message sent must be the one starting the command, and the last message received carries its exit code
+	ExecCommandStream(context.Context) *connect.BidiStreamForClient[kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest, kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse]
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
 	WaitForHttpGetEndpointAvailability(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
@@ -116,16 +131,22 @@ type ApiContainerServiceClient interface {
 	StoreWebFilesArtifact(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse], error)
 	// Tells the API container to copy a files artifact from a service to the Kurtosis File System
 	StoreFilesArtifactFromService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceResponse], error)
+	// Tells the API container to copy the contents of a files artifact into a running service
+	CopyFilesArtifactToService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs]) (*connect.Response[emptypb.Empty], error)
 	ListFilesArtifactNamesAndUuids(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error)
 	InspectFilesArtifactContents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse], error)
 	// User services port forwarding
 	ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error)
 	// Get last Starlark run
 	GetStarlarkRun(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse], error)
+	// Streams the progress and the end of every Starlark run executed in the enclave from now on, whoever started it
+	WatchStarlarkRunProgress(context.Context, *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine], error)
 	// Gets yaml representing the plan the script will execute in an enclave
 	GetStarlarkScriptPlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
+	// Returns the commit and content hash of every remote package cloned in the enclave, used to generate kurtosis.lock
+	GetResolvedPackageDependencies(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetResolvedPackageDependenciesResponse], error)
 }
 
 // NewApiContainerServiceClient constructs a client for the api_container_api.ApiContainerService
@@ -168,6 +189,11 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceExecCommandProcedure,
 			opts...,
 		),
+		execCommandStream: connect.NewClient[kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest, kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse](
+			httpClient,
+			baseURL+ApiContainerServiceExecCommandStreamProcedure,
+			opts...,
+		),
 		waitForHttpGetEndpointAvailability: connect.NewClient[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs, emptypb.Empty](
 			httpClient,
 			baseURL+ApiContainerServiceWaitForHttpGetEndpointAvailabilityProcedure,
@@ -198,6 +224,11 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceStoreFilesArtifactFromServiceProcedure,
 			opts...,
 		),
+		copyFilesArtifactToService: connect.NewClient[kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceCopyFilesArtifactToServiceProcedure,
+			opts...,
+		),
 		listFilesArtifactNamesAndUuids: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse](
 			httpClient,
 			baseURL+ApiContainerServiceListFilesArtifactNamesAndUuidsProcedure,
@@ -218,6 +249,11 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceGetStarlarkRunProcedure,
 			opts...,
 		),
+		watchStarlarkRunProgress: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](
+			httpClient,
+			baseURL+ApiContainerServiceWatchStarlarkRunProgressProcedure,
+			opts...,
+		),
 		getStarlarkScriptPlanYaml: connect.NewClient[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs, kurtosis_core_rpc_api_bindings.PlanYaml](
 			httpClient,
 			baseURL+ApiContainerServiceGetStarlarkScriptPlanYamlProcedure,
@@ -228,6 +264,11 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceGetStarlarkPackagePlanYamlProcedure,
 			opts...,
 		),
+		getResolvedPackageDependencies: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetResolvedPackageDependenciesResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetResolvedPackageDependenciesProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -239,18 +280,22 @@ type apiContainerServiceClient struct {
 	getServices                                *connect.Client[kurtosis_core_rpc_api_bindings.GetServicesArgs, kurtosis_core_rpc_api_bindings.GetServicesResponse]
 	getExistingAndHistoricalServiceIdentifiers *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse]
 	execCommand                                *connect.Client[kurtosis_core_rpc_api_bindings.ExecCommandArgs, kurtosis_core_rpc_api_bindings.ExecCommandResponse]
+	execCommandStream                          *connect.Client[kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest, kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse]
 	waitForHttpGetEndpointAvailability         *connect.Client[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs, emptypb.Empty]
 	waitForHttpPostEndpointAvailability        *connect.Client[kurtosis_core_rpc_api_bindings.WaitForHttpPostEndpointAvailabilityArgs, emptypb.Empty]
 	uploadFilesArtifact                        *connect.Client[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse]
 	downloadFilesArtifact                      *connect.Client[kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs, kurtosis_core_rpc_api_bindings.StreamedDataChunk]
 	storeWebFilesArtifact                      *connect.Client[kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs, kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse]
 	storeFilesArtifactFromService              *connect.Client[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceArgs, kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceResponse]
+	copyFilesArtifactToService                 *connect.Client[kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs, emptypb.Empty]
 	listFilesArtifactNamesAndUuids             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse]
 	inspectFilesArtifactContents               *connect.Client[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest, kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse]
 	connectServices                            *connect.Client[kurtosis_core_rpc_api_bindings.ConnectServicesArgs, kurtosis_core_rpc_api_bindings.ConnectServicesResponse]
 	getStarlarkRun                             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse]
+	watchStarlarkRunProgress                   *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]
 	getStarlarkScriptPlanYaml                  *connect.Client[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs, kurtosis_core_rpc_api_bindings.PlanYaml]
 	getStarlarkPackagePlanYaml                 *connect.Client[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs, kurtosis_core_rpc_api_bindings.PlanYaml]
+	getResolvedPackageDependencies             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetResolvedPackageDependenciesResponse]
 }
 
 // RunStarlarkScript calls api_container_api.ApiContainerService.RunStarlarkScript.
@@ -284,6 +329,11 @@ func (c *apiContainerServiceClient) ExecCommand(ctx context.Context, req *connec
 	return c.execCommand.CallUnary(ctx, req)
 }
 
+// ExecCommandStream calls api_container_api.ApiContainerService.ExecCommandStream.
+func (c *apiContainerServiceClient) ExecCommandStream(ctx context.Context) *connect.BidiStreamForClient[kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest, kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse] {
+	return c.execCommandStream.CallBidiStream(ctx)
+}
+
 // WaitForHttpGetEndpointAvailability calls
 // api_container_api.ApiContainerService.WaitForHttpGetEndpointAvailability.
 func (c *apiContainerServiceClient) WaitForHttpGetEndpointAvailability(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error) {
@@ -317,6 +367,12 @@ func (c *apiContainerServiceClient) StoreFilesArtifactFromService(ctx context.Co
 	return c.storeFilesArtifactFromService.CallUnary(ctx, req)
 }
 
+// CopyFilesArtifactToService calls
+// api_container_api.ApiContainerService.CopyFilesArtifactToService.
+func (c *apiContainerServiceClient) CopyFilesArtifactToService(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.copyFilesArtifactToService.CallUnary(ctx, req)
+}
+
 // ListFilesArtifactNamesAndUuids calls
 // api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids.
 func (c *apiContainerServiceClient) ListFilesArtifactNamesAndUuids(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error) {
@@ -339,6 +395,11 @@ func (c *apiContainerServiceClient) GetStarlarkRun(ctx context.Context, req *con
 	return c.getStarlarkRun.CallUnary(ctx, req)
 }
 
+// WatchStarlarkRunProgress calls api_container_api.ApiContainerService.WatchStarlarkRunProgress.
+func (c *apiContainerServiceClient) WatchStarlarkRunProgress(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine], error) {
+	return c.watchStarlarkRunProgress.CallServerStream(ctx, req)
+}
+
 // GetStarlarkScriptPlanYaml calls api_container_api.ApiContainerService.GetStarlarkScriptPlanYaml.
 func (c *apiContainerServiceClient) GetStarlarkScriptPlanYaml(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error) {
 	return c.getStarlarkScriptPlanYaml.CallUnary(ctx, req)
@@ -350,6 +411,12 @@ func (c *apiContainerServiceClient) GetStarlarkPackagePlanYaml(ctx context.Conte
 	return c.getStarlarkPackagePlanYaml.CallUnary(ctx, req)
 }
 
+// GetResolvedPackageDependencies calls
+// api_container_api.ApiContainerService.GetResolvedPackageDependencies.
+func (c *apiContainerServiceClient) GetResolvedPackageDependencies(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetResolvedPackageDependenciesResponse], error) {
+	return c.getResolvedPackageDependencies.CallUnary(ctx, req)
+}
+
 // ApiContainerServiceHandler is an implementation of the api_container_api.ApiContainerService
 // service.
 type ApiContainerServiceHandler interface {
@@ -365,6 +432,9 @@ type ApiContainerServiceHandler interface {
 	GetExistingAndHistoricalServiceIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse], error)
 	// Executes the given command inside a running container
 	ExecCommand(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ExecCommandArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ExecCommandResponse], error)
+	// Executes the given command inside a running container, streaming STDIN to it and its output back while it runs
+	// The first message sent must be the one starting the command, and the last message received carries its exit code
+	ExecCommandStream(context.Context, *connect.BidiStream[kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest, kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse]) error
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
 	WaitForHttpGetEndpointAvailability(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
@@ -377,16 +447,22 @@ type ApiContainerServiceHandler interface {
 	StoreWebFilesArtifact(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse], error)
 	// Tells the API container to copy a files artifact from a service to the Kurtosis File System
 	StoreFilesArtifactFromService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceResponse], error)
+	// Tells the API container to copy the contents of a files artifact into a running service
+	CopyFilesArtifactToService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs]) (*connect.Response[emptypb.Empty], error)
 	ListFilesArtifactNamesAndUuids(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error)
 	InspectFilesArtifactContents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse], error)
 	// User services port forwarding
 	ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error)
 	// Get last Starlark run
 	GetStarlarkRun(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse], error)
+	// Streams the progress and the end of every Starlark run executed in the enclave from now on, whoever started it
+	WatchStarlarkRunProgress(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error
 	// Gets yaml representing the plan the script will execute in an enclave
 	GetStarlarkScriptPlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
+	// Returns the commit and content hash of every remote package cloned in the enclave, used to generate kurtosis.lock
+	GetResolvedPackageDependencies(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetResolvedPackageDependenciesResponse], error)
 }
 
 // NewApiContainerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -425,6 +501,11 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.ExecCommand,
 		opts...,
 	)
+	apiContainerServiceExecCommandStreamHandler := connect.NewBidiStreamHandler(
+		ApiContainerServiceExecCommandStreamProcedure,
+		svc.ExecCommandStream,
+		opts...,
+	)
 	apiContainerServiceWaitForHttpGetEndpointAvailabilityHandler := connect.NewUnaryHandler(
 		ApiContainerServiceWaitForHttpGetEndpointAvailabilityProcedure,
 		svc.WaitForHttpGetEndpointAvailability,
@@ -455,6 +536,11 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.StoreFilesArtifactFromService,
 		opts...,
 	)
+	apiContainerServiceCopyFilesArtifactToServiceHandler := connect.NewUnaryHandler(
+		ApiContainerServiceCopyFilesArtifactToServiceProcedure,
+		svc.CopyFilesArtifactToService,
+		opts...,
+	)
 	apiContainerServiceListFilesArtifactNamesAndUuidsHandler := connect.NewUnaryHandler(
 		ApiContainerServiceListFilesArtifactNamesAndUuidsProcedure,
 		svc.ListFilesArtifactNamesAndUuids,
@@ -475,6 +561,11 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.GetStarlarkRun,
 		opts...,
 	)
+	apiContainerServiceWatchStarlarkRunProgressHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceWatchStarlarkRunProgressProcedure,
+		svc.WatchStarlarkRunProgress,
+		opts...,
+	)
 	apiContainerServiceGetStarlarkScriptPlanYamlHandler := connect.NewUnaryHandler(
 		ApiContainerServiceGetStarlarkScriptPlanYamlProcedure,
 		svc.GetStarlarkScriptPlanYaml,
@@ -485,6 +576,11 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.GetStarlarkPackagePlanYaml,
 		opts...,
 	)
+	apiContainerServiceGetResolvedPackageDependenciesHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetResolvedPackageDependenciesProcedure,
+		svc.GetResolvedPackageDependencies,
+		opts...,
+	)
 	return "/api_container_api.ApiContainerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ApiContainerServiceRunStarlarkScriptProcedure:
@@ -499,6 +595,8 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceGetExistingAndHistoricalServiceIdentifiersHandler.ServeHTTP(w, r)
 		case ApiContainerServiceExecCommandProcedure:
 			apiContainerServiceExecCommandHandler.ServeHTTP(w, r)
+		case ApiContainerServiceExecCommandStreamProcedure:
+			apiContainerServiceExecCommandStreamHandler.ServeHTTP(w, r)
 		case ApiContainerServiceWaitForHttpGetEndpointAvailabilityProcedure:
 			apiContainerServiceWaitForHttpGetEndpointAvailabilityHandler.ServeHTTP(w, r)
 		case ApiContainerServiceWaitForHttpPostEndpointAvailabilityProcedure:
@@ -511,6 +609,8 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceStoreWebFilesArtifactHandler.ServeHTTP(w, r)
 		case ApiContainerServiceStoreFilesArtifactFromServiceProcedure:
 			apiContainerServiceStoreFilesArtifactFromServiceHandler.ServeHTTP(w, r)
+		case ApiContainerServiceCopyFilesArtifactToServiceProcedure:
+			apiContainerServiceCopyFilesArtifactToServiceHandler.ServeHTTP(w, r)
 		case ApiContainerServiceListFilesArtifactNamesAndUuidsProcedure:
 			apiContainerServiceListFilesArtifactNamesAndUuidsHandler.ServeHTTP(w, r)
 		case ApiContainerServiceInspectFilesArtifactContentsProcedure:
@@ -519,10 +619,14 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceConnectServicesHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetStarlarkRunProcedure:
 			apiContainerServiceGetStarlarkRunHandler.ServeHTTP(w, r)
+		case ApiContainerServiceWatchStarlarkRunProgressProcedure:
+			apiContainerServiceWatchStarlarkRunProgressHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetStarlarkScriptPlanYamlProcedure:
 			apiContainerServiceGetStarlarkScriptPlanYamlHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetStarlarkPackagePlanYamlProcedure:
 			apiContainerServiceGetStarlarkPackagePlanYamlHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetResolvedPackageDependenciesProcedure:
+			apiContainerServiceGetResolvedPackageDependenciesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -556,6 +660,10 @@ func (UnimplementedApiContainerServiceHandler) ExecCommand(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ExecCommand is not implemented"))
 }
 
+func (UnimplementedApiContainerServiceHandler) ExecCommandStream(context.Context, *connect.BidiStream[kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest, kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ExecCommandStream is not implemented"))
+}
+
 func (UnimplementedApiContainerServiceHandler) WaitForHttpGetEndpointAvailability(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.WaitForHttpGetEndpointAvailability is not implemented"))
 }
@@ -580,6 +688,10 @@ func (UnimplementedApiContainerServiceHandler) StoreFilesArtifactFromService(con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.StoreFilesArtifactFromService is not implemented"))
 }
 
+func (UnimplementedApiContainerServiceHandler) CopyFilesArtifactToService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.CopyFilesArtifactToService is not implemented"))
+}
+
 func (UnimplementedApiContainerServiceHandler) ListFilesArtifactNamesAndUuids(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids is not implemented"))
 }
@@ -596,6 +708,10 @@ func (UnimplementedApiContainerServiceHandler) GetStarlarkRun(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkRun is not implemented"))
 }
 
+func (UnimplementedApiContainerServiceHandler) WatchStarlarkRunProgress(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.WatchStarlarkRunProgress is not implemented"))
+}
+
 func (UnimplementedApiContainerServiceHandler) GetStarlarkScriptPlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkScriptPlanYaml is not implemented"))
 }
@@ -603,3 +719,7 @@ func (UnimplementedApiContainerServiceHandler) GetStarlarkScriptPlanYaml(context
 func (UnimplementedApiContainerServiceHandler) GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkPackagePlanYaml is not implemented"))
 }
+
+func (UnimplementedApiContainerServiceHandler) GetResolvedPackageDependencies(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetResolvedPackageDependenciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetResolvedPackageDependencies is not implemented"))
+}
@@ -45,6 +45,8 @@ func NewRunStarlarkScriptArgs(
 	cloudUserId string,
 	imageDownloadMode kurtosis_core_rpc_api_bindings.ImageDownloadMode,
 	nonBlockingMode bool,
+	gitHostConfigs []*kurtosis_core_rpc_api_bindings.GitHostConfig,
+	registryCredentials []*kurtosis_core_rpc_api_bindings.RegistryCredentials,
 ) *kurtosis_core_rpc_api_bindings.RunStarlarkScriptArgs {
 	cloudInstanceIdCopy := new(string)
 	*cloudInstanceIdCopy = cloudInstanceId
@@ -65,6 +67,8 @@ func NewRunStarlarkScriptArgs(
 		CloudUserId:          cloudUserIdCopy,
 		ImageDownloadMode:    imageDownloadModeCopy,
 		NonBlockingMode:      &nonBlockingMode,
+		GitHostConfigs:       gitHostConfigs,
+		RegistryCredentials:  registryCredentials,
 	}
 }
 
@@ -81,6 +85,8 @@ func NewRunStarlarkPackageArgs(
 	imageDownloadMode kurtosis_core_rpc_api_bindings.ImageDownloadMode,
 	nonBlockingMode bool,
 	githubAuthToken string,
+	gitHostConfigs []*kurtosis_core_rpc_api_bindings.GitHostConfig,
+	registryCredentials []*kurtosis_core_rpc_api_bindings.RegistryCredentials,
 ) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
@@ -108,6 +114,8 @@ func NewRunStarlarkPackageArgs(
 		ImageDownloadMode:      imageDownloadModeCopy,
 		NonBlockingMode:        &nonBlockingMode,
 		GithubAuthToken:        githubAuthTokenCopy,
+		GitHostConfigs:         gitHostConfigs,
+		RegistryCredentials:    registryCredentials,
 	}
 }
 
@@ -124,6 +132,8 @@ func NewRunStarlarkRemotePackageArgs(
 	imageDownloadMode kurtosis_core_rpc_api_bindings.ImageDownloadMode,
 	nonBlockingMode bool,
 	githubAuthToken string,
+	gitHostConfigs []*kurtosis_core_rpc_api_bindings.GitHostConfig,
+	registryCredentials []*kurtosis_core_rpc_api_bindings.RegistryCredentials,
 ) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
@@ -151,6 +161,34 @@ func NewRunStarlarkRemotePackageArgs(
 		ImageDownloadMode:      imageDownloadModeCopy,
 		NonBlockingMode:        &nonBlockingMode,
 		GithubAuthToken:        githubAuthTokenCopy,
+		GitHostConfigs:         gitHostConfigs,
+		RegistryCredentials:    registryCredentials,
+	}
+}
+
+func NewGitHostConfig(
+	host string,
+	cloneUrlPrefix string,
+	username string,
+	password string,
+	sshPrivateKey string,
+	sshKnownHosts string,
+) *kurtosis_core_rpc_api_bindings.GitHostConfig {
+	return &kurtosis_core_rpc_api_bindings.GitHostConfig{
+		Host:           host,
+		CloneUrlPrefix: &cloneUrlPrefix,
+		Username:       &username,
+		Password:       &password,
+		SshPrivateKey:  &sshPrivateKey,
+		SshKnownHosts:  &sshKnownHosts,
+	}
+}
+
+func NewRegistryCredentials(registry string, username string, password string) *kurtosis_core_rpc_api_bindings.RegistryCredentials {
+	return &kurtosis_core_rpc_api_bindings.RegistryCredentials{
+		Registry: registry,
+		Username: username,
+		Password: password,
 	}
 }
 
@@ -362,17 +400,30 @@ func NewServiceInfo(
 	maybePublicPorts map[string]*kurtosis_core_rpc_api_bindings.Port,
 	serviceStatus kurtosis_core_rpc_api_bindings.ServiceStatus,
 	container *kurtosis_core_rpc_api_bindings.Container,
+	persistentDirectories map[string]*kurtosis_core_rpc_api_bindings.PersistentDirectory,
+	maybePublicUrls map[string]string,
 ) *kurtosis_core_rpc_api_bindings.ServiceInfo {
 	return &kurtosis_core_rpc_api_bindings.ServiceInfo{
-		ServiceUuid:       uuid,
-		Name:              name,
-		ShortenedUuid:     shortenedUuid,
-		PrivateIpAddr:     privateIpAddr,
-		PrivatePorts:      privatePorts,
-		MaybePublicIpAddr: maybePublicIpAddr,
-		MaybePublicPorts:  maybePublicPorts,
-		ServiceStatus:     serviceStatus,
-		Container:         container,
+		ServiceUuid:           uuid,
+		Name:                  name,
+		ShortenedUuid:         shortenedUuid,
+		PrivateIpAddr:         privateIpAddr,
+		PrivatePorts:          privatePorts,
+		MaybePublicIpAddr:     maybePublicIpAddr,
+		MaybePublicPorts:      maybePublicPorts,
+		ServiceStatus:         serviceStatus,
+		Container:             container,
+		PersistentDirectories: persistentDirectories,
+		MaybePublicUrls:       maybePublicUrls,
+	}
+}
+
+func NewPersistentDirectory(persistentKey string, size int64, storageClass string, accessMode string) *kurtosis_core_rpc_api_bindings.PersistentDirectory {
+	return &kurtosis_core_rpc_api_bindings.PersistentDirectory{
+		PersistentKey: persistentKey,
+		Size:          size,
+		StorageClass:  storageClass,
+		AccessMode:    accessMode,
 	}
 }
 
@@ -396,6 +447,50 @@ func NewExecCommandResponse(exitCode int32, logOutput string) *kurtosis_core_rpc
 	}
 }
 
+func NewExecCommandStreamStartRequest(serviceIdentifier string, commandArgs []string, tty bool, terminalSize *kurtosis_core_rpc_api_bindings.TerminalSize) *kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest {
+	return &kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest{
+		Request: &kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest_Start{
+			Start: &kurtosis_core_rpc_api_bindings.ExecCommandStreamStart{
+				ServiceIdentifier: serviceIdentifier,
+				CommandArgs:       commandArgs,
+				Tty:               tty,
+				TerminalSize:      terminalSize,
+			},
+		},
+	}
+}
+
+func NewExecCommandStreamStdinRequest(stdin []byte) *kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest {
+	return &kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest{
+		Request: &kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest_Stdin{
+			Stdin: stdin,
+		},
+	}
+}
+
+func NewExecCommandStreamCloseStdinRequest() *kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest {
+	return &kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest{
+		Request: &kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest_CloseStdin{
+			CloseStdin: true,
+		},
+	}
+}
+
+func NewExecCommandStreamResizeRequest(terminalSize *kurtosis_core_rpc_api_bindings.TerminalSize) *kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest {
+	return &kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest{
+		Request: &kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest_Resize{
+			Resize: terminalSize,
+		},
+	}
+}
+
+func NewTerminalSize(width uint32, height uint32) *kurtosis_core_rpc_api_bindings.TerminalSize {
+	return &kurtosis_core_rpc_api_bindings.TerminalSize{
+		Width:  width,
+		Height: height,
+	}
+}
+
 // ==============================================================================================
 //
 //	Upload Files Artifact
@@ -431,6 +526,21 @@ func DownloadFilesArtifactArgs(fileIdentifier string) *kurtosis_core_rpc_api_bin
 	}
 }
 
+// ==============================================================================================
+//
+//	Copy Files Artifact To Service
+//
+// ==============================================================================================
+
+func NewCopyFilesArtifactToServiceArgs(serviceIdentifier string, filesArtifactIdentifier string, destPath string, shouldRemoveFilesArtifactAfterCopy bool) *kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs {
+	return &kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs{
+		ServiceIdentifier:            serviceIdentifier,
+		FilesArtifactIdentifier:      filesArtifactIdentifier,
+		DestPath:                     destPath,
+		RemoveFilesArtifactAfterCopy: shouldRemoveFilesArtifactAfterCopy,
+	}
+}
+
 // ==============================================================================================
 //
 //	Connect Services arguments and response to configure user services port forwarding
@@ -446,3 +556,24 @@ func NewConnectServicesArgs(connect kurtosis_core_rpc_api_bindings.Connect) *kur
 func NewConnectServicesResponse() *kurtosis_core_rpc_api_bindings.ConnectServicesResponse {
 	return &kurtosis_core_rpc_api_bindings.ConnectServicesResponse{}
 }
+
+// ==============================================================================================
+//
+//	Get Resolved Package Dependencies
+//
+// ==============================================================================================
+
+func NewResolvedPackageDependency(repositoryLocator string, version string, commit string, contentHash string) *kurtosis_core_rpc_api_bindings.ResolvedPackageDependency {
+	return &kurtosis_core_rpc_api_bindings.ResolvedPackageDependency{
+		RepositoryLocator: repositoryLocator,
+		Version:           version,
+		Commit:            commit,
+		ContentHash:       contentHash,
+	}
+}
+
+func NewGetResolvedPackageDependenciesResponse(resolvedPackageDependencies []*kurtosis_core_rpc_api_bindings.ResolvedPackageDependency) *kurtosis_core_rpc_api_bindings.GetResolvedPackageDependenciesResponse {
+	return &kurtosis_core_rpc_api_bindings.GetResolvedPackageDependenciesResponse{
+		ResolvedPackageDependencies: resolvedPackageDependencies,
+	}
+}
@@ -29,6 +29,7 @@ import (
 	yaml_convert "github.com/ghodss/yaml"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
 	"github.com/kurtosis-tech/kurtosis/grpc-file-transfer/golang/grpc_file_streaming"
@@ -120,7 +121,9 @@ func (enclaveCtx *EnclaveContext) RunStarlarkScript(
 		runConfig.CloudInstanceId,
 		runConfig.CloudUserId,
 		runConfig.ImageDownload,
-		runConfig.NonBlockingMode)
+		runConfig.NonBlockingMode,
+		runConfig.GitHostConfigs,
+		runConfig.RegistryCredentials)
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 
 	stream, err := enclaveCtx.client.RunStarlarkScript(ctxWithCancel, executeStartosisScriptArgs)
@@ -184,7 +187,9 @@ func (enclaveCtx *EnclaveContext) RunStarlarkPackage(
 		runConfig.CloudUserId,
 		runConfig.ImageDownload,
 		runConfig.NonBlockingMode,
-		runConfig.GitHubAuthToken)
+		runConfig.GitHubAuthToken,
+		runConfig.GitHostConfigs,
+		runConfig.RegistryCredentials)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "Error preparing package '%s' for execution", packageRootPath)
 	}
@@ -309,7 +314,7 @@ func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackage(
 	}()
 
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
-	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(packageId, runConfig.RelativePathToMainFile, runConfig.MainFunctionName, serializedParams, runConfig.DryRun, runConfig.Parallelism, runConfig.ExperimentalFeatureFlags, runConfig.CloudInstanceId, runConfig.CloudUserId, runConfig.ImageDownload, runConfig.NonBlockingMode, runConfig.GitHubAuthToken)
+	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(packageId, runConfig.RelativePathToMainFile, runConfig.MainFunctionName, serializedParams, runConfig.DryRun, runConfig.Parallelism, runConfig.ExperimentalFeatureFlags, runConfig.CloudInstanceId, runConfig.CloudUserId, runConfig.ImageDownload, runConfig.NonBlockingMode, runConfig.GitHubAuthToken, runConfig.GitHostConfigs, runConfig.RegistryCredentials)
 
 	stream, err := enclaveCtx.client.RunStarlarkPackage(ctxWithCancel, executeStartosisScriptArgs)
 	if err != nil {
@@ -512,6 +517,15 @@ func (enclaveCtx *EnclaveContext) GetAllFilesArtifactNamesAndUuids(ctx context.C
 	return response.GetFileNamesAndUuids(), nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk#copyfilesartifacttoservice
+func (enclaveCtx *EnclaveContext) CopyFilesArtifactToService(ctx context.Context, serviceIdentifier string, filesArtifactIdentifier string, destPath string, shouldRemoveFilesArtifactAfterCopy bool) error {
+	args := binding_constructors.NewCopyFilesArtifactToServiceArgs(serviceIdentifier, filesArtifactIdentifier, destPath, shouldRemoveFilesArtifactAfterCopy)
+	if _, err := enclaveCtx.client.CopyFilesArtifactToService(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying files artifact '%v' to '%v' on service '%v'", filesArtifactIdentifier, destPath, serviceIdentifier)
+	}
+	return nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk#connectservices
 func (enclaveCtx *EnclaveContext) ConnectServices(ctx context.Context, connect kurtosis_core_rpc_api_bindings.Connect) error {
 	args := binding_constructors.NewConnectServicesArgs(connect)
@@ -531,6 +545,19 @@ func (enclaveCtx *EnclaveContext) GetStarlarkRun(ctx context.Context) (*kurtosis
 	return response, nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk/#getresolvedpackagedependencies---packagelock-packagelock-error-error
+func (enclaveCtx *EnclaveContext) GetResolvedPackageDependencies(ctx context.Context) (*package_lock.PackageLock, error) {
+	response, err := enclaveCtx.client.GetResolvedPackageDependencies(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the package dependencies resolved in the enclave")
+	}
+	lockedPackages := map[string]*package_lock.LockedPackage{}
+	for _, dependency := range response.GetResolvedPackageDependencies() {
+		lockedPackages[dependency.GetRepositoryLocator()] = package_lock.NewLockedPackage(dependency.GetVersion(), dependency.GetCommit(), dependency.GetContentHash())
+	}
+	return package_lock.NewPackageLock(lockedPackages), nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -619,6 +646,8 @@ func (enclaveCtx *EnclaveContext) assembleRunStartosisPackageArg(
 	imageDownloadMode kurtosis_core_rpc_api_bindings.ImageDownloadMode,
 	nonBlockingMode bool,
 	githubAuthToken string,
+	gitHostConfigs []*kurtosis_core_rpc_api_bindings.GitHostConfig,
+	registryCredentials []*kurtosis_core_rpc_api_bindings.RegistryCredentials,
 ) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs, error) {
 
 	return binding_constructors.NewRunStarlarkPackageArgs(
@@ -633,7 +662,9 @@ func (enclaveCtx *EnclaveContext) assembleRunStartosisPackageArg(
 		cloudUserId,
 		imageDownloadMode,
 		nonBlockingMode,
-		githubAuthToken), nil
+		githubAuthToken,
+		gitHostConfigs,
+		registryCredentials), nil
 }
 
 func (enclaveCtx *EnclaveContext) uploadStarlarkPackage(packageId string, packageRootPath string) error {
@@ -689,6 +720,7 @@ func (enclaveCtx *EnclaveContext) convertServiceInfoToServiceContext(serviceInfo
 		serviceCtxPrivatePorts,
 		serviceInfo.GetMaybePublicIpAddr(),
 		serviceCtxPublicPorts,
+		serviceInfo.GetMaybePublicUrls(),
 	)
 
 	return serviceContext, nil
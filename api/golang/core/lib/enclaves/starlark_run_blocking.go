@@ -21,15 +21,19 @@ type StarlarkRunResult struct {
 	ValidationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError
 
 	ExecutionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError
+
+	// The serialized value returned by the main function, with runtime values replaced. Empty if the run didn't succeed
+	SerializedOutput string
 }
 
-func NewStarlarkRunResult(runOutput StarlarkRunMultilineOutput, instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError, validationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError, executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) *StarlarkRunResult {
+func NewStarlarkRunResult(runOutput StarlarkRunMultilineOutput, instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError, validationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError, executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError, serializedOutput string) *StarlarkRunResult {
 	return &StarlarkRunResult{
 		RunOutput:           runOutput,
 		Instructions:        instructions,
 		InterpretationError: interpretationError,
 		ValidationErrors:    validationErrors,
 		ExecutionError:      executionError,
+		SerializedOutput:    serializedOutput,
 	}
 }
 
@@ -39,6 +43,7 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 	var interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError
 	validationErrors := make([]*kurtosis_core_rpc_api_bindings.StarlarkValidationError, 0)
 	var executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError
+	serializedOutput := ""
 
 	for responseLine := range starlarkRunResponseLines {
 		if responseLine.GetInstruction() != nil {
@@ -59,6 +64,7 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 			if runFinishedEvent.GetIsRunSuccessful() && runFinishedEvent.GetSerializedOutput() != "" {
 				scriptOutput.WriteString(runFinishedEvent.GetSerializedOutput())
 				scriptOutput.WriteString(starlarkRunOutputLinesSplit)
+				serializedOutput = runFinishedEvent.GetSerializedOutput()
 			}
 		}
 	}
@@ -67,5 +73,6 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 		instructions,
 		interpretationError,
 		validationErrors,
-		executionError)
+		executionError,
+		serializedOutput)
 }
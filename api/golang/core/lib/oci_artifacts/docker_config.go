@@ -0,0 +1,70 @@
+package oci_artifacts
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+	"path"
+	"strings"
+)
+
+const (
+	// registry credentials are read from the file written by 'docker login'
+	dockerConfigDirname               = ".docker"
+	dockerConfigFilename              = "config.json"
+	dockerAuthUsernamePasswordDivider = ":"
+	dockerHubLegacyRegistryKey        = "https://index.docker.io/v1/"
+	dockerHubRegistryHost             = "registry-1.docker.io"
+)
+
+// dockerConfig is the subset of the Docker config file used to authenticate against registries
+type dockerConfig struct {
+	Auths map[string]struct {
+		Auth string `json:"auth"`
+	} `json:"auths"`
+}
+
+// GetRegistryCredentialsFromDockerConfig returns the static credentials stored in the Docker config file, if any.
+// Credential helpers aren't supported
+func GetRegistryCredentialsFromDockerConfig() (map[string]*RegistryCredentials, error) {
+	credentialsByRegistry := map[string]*RegistryCredentials{}
+	homeDirpath, err := os.UserHomeDir()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the home directory")
+	}
+	dockerConfigFilepath := path.Join(homeDirpath, dockerConfigDirname, dockerConfigFilename)
+	dockerConfigBytes, err := os.ReadFile(dockerConfigFilepath)
+	if os.IsNotExist(err) {
+		return credentialsByRegistry, nil
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the Docker config file '%v'", dockerConfigFilepath)
+	}
+	parsedDockerConfig := &dockerConfig{Auths: nil}
+	if err = json.Unmarshal(dockerConfigBytes, parsedDockerConfig); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the Docker config file '%v'", dockerConfigFilepath)
+	}
+	for registry, auth := range parsedDockerConfig.Auths {
+		if auth.Auth == "" {
+			continue
+		}
+		decodedAuth, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			logrus.Warnf("Ignoring malformed credentials for registry '%v' in the Docker config file '%v'", registry, dockerConfigFilepath)
+			continue
+		}
+		username, password, found := strings.Cut(string(decodedAuth), dockerAuthUsernamePasswordDivider)
+		if !found {
+			logrus.Warnf("Ignoring malformed credentials for registry '%v' in the Docker config file '%v'", registry, dockerConfigFilepath)
+			continue
+		}
+		registryHost := strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(registry, "https://"), "http://"), "/")
+		if registry == dockerHubLegacyRegistryKey {
+			registryHost = dockerHubRegistryHost
+		}
+		credentialsByRegistry[registryHost] = NewRegistryCredentials(username, password)
+	}
+	return credentialsByRegistry, nil
+}
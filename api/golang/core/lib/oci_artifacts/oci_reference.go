@@ -0,0 +1,211 @@
+package oci_artifacts
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const (
+	// OciLocatorPrefix is the prefix identifying package locators that point to an OCI artifact
+	OciLocatorPrefix = "oci://"
+
+	DefaultTag = "latest"
+
+	tagDelimiter         = ":"
+	digestDelimiter      = "@"
+	pathSeparator        = "/"
+	sha256DigestPrefix   = "sha256:"
+	emptyTag             = ""
+	emptyDigest          = ""
+	emptyRelativePath    = ""
+	packageRootIndicator = "/"
+
+	// registry + at least one repository path component
+	minimumSubPathsForValidOciLocator = 2
+)
+
+var (
+	tagRegex        = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127}$`)
+	digestRegex     = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+	repositoryRegex = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+)
+
+// OciReference represents a parsed 'oci://' package locator
+// The expected format is 'oci://<registry>/<repository>[:<tag>][@<digest>][/<path/inside/package>]'
+// As a repository can have an arbitrary number of path components, a path inside the package can only be
+// provided if the locator contains a tag or a digest, which marks the end of the repository
+type OciReference struct {
+	registry   string
+	repository string
+	// empty if the reference is pinned to a digest without a tag
+	tag string
+	// empty if the reference isn't pinned to a digest
+	digest string
+	// the path of a file or folder inside the package, empty if the locator points to the package root
+	relativeFilePath string
+}
+
+func NewOciReference(registry string, repository string, tag string, digest string, relativeFilePath string) *OciReference {
+	return &OciReference{
+		registry:         registry,
+		repository:       repository,
+		tag:              tag,
+		digest:           digest,
+		relativeFilePath: relativeFilePath,
+	}
+}
+
+// IsOciLocator returns true if the locator should be resolved against an OCI registry
+func IsOciLocator(locator string) bool {
+	return strings.HasPrefix(locator, OciLocatorPrefix)
+}
+
+// ParseOciReference parses a locator like 'oci://registry.example.com/org/package:1.0.0/src/lib.star'
+func ParseOciReference(locator string) (*OciReference, error) {
+	if !IsOciLocator(locator) {
+		return nil, stacktrace.NewError("Expected OCI locator '%v' to start with '%v'", locator, OciLocatorPrefix)
+	}
+	locatorWithoutPrefix := strings.TrimPrefix(locator, OciLocatorPrefix)
+
+	var subPaths []string
+	for _, subPath := range strings.Split(locatorWithoutPrefix, pathSeparator) {
+		if subPath != "" {
+			subPaths = append(subPaths, subPath)
+		}
+	}
+	if len(subPaths) < minimumSubPathsForValidOciLocator {
+		return nil, stacktrace.NewError("Error parsing OCI locator '%v'. It should contain at least a registry and a repository, like '%vregistry.example.com/my-package'", locator, OciLocatorPrefix)
+	}
+
+	registry := subPaths[0]
+	var repositoryPaths []string
+	tag := emptyTag
+	digest := emptyDigest
+	relativeFilePath := emptyRelativePath
+	for idx, subPath := range subPaths[1:] {
+		repositoryPathCandidate, maybeTag, maybeDigest, hasVersion := splitOutTagAndDigest(subPath)
+		repositoryPaths = append(repositoryPaths, repositoryPathCandidate)
+		if !hasVersion {
+			continue
+		}
+		tag = maybeTag
+		digest = maybeDigest
+		// the sub paths after the one holding the version are the path inside the package
+		remainingSubPaths := subPaths[idx+2:]
+		if len(remainingSubPaths) > 0 {
+			relativeFilePath = path.Join(remainingSubPaths...)
+		}
+		break
+	}
+
+	repository := strings.Join(repositoryPaths, pathSeparator)
+	if !repositoryRegex.MatchString(repository) {
+		return nil, stacktrace.NewError("Error parsing OCI locator '%v'. Repository '%v' isn't a valid OCI repository name; it must be lowercase alphanumeric components separated by '/'", locator, repository)
+	}
+	if tag == emptyTag && digest == emptyDigest {
+		tag = DefaultTag
+	}
+	if tag != emptyTag && !tagRegex.MatchString(tag) {
+		return nil, stacktrace.NewError("Error parsing OCI locator '%v'. Tag '%v' isn't a valid OCI tag", locator, tag)
+	}
+	if digest != emptyDigest && !digestRegex.MatchString(digest) {
+		return nil, stacktrace.NewError("Error parsing OCI locator '%v'. Digest '%v' isn't valid, only '%v' digests are supported", locator, digest, sha256DigestPrefix)
+	}
+	return NewOciReference(registry, repository, tag, digest, relativeFilePath), nil
+}
+
+func (reference *OciReference) GetRegistry() string {
+	return reference.registry
+}
+
+func (reference *OciReference) GetRepository() string {
+	return reference.repository
+}
+
+func (reference *OciReference) GetTag() string {
+	return reference.tag
+}
+
+func (reference *OciReference) GetDigest() string {
+	return reference.digest
+}
+
+func (reference *OciReference) GetRelativeFilePath() string {
+	return reference.relativeFilePath
+}
+
+// IsPinnedToDigest returns true if the reference is immutable, i.e. it contains a digest
+func (reference *OciReference) IsPinnedToDigest() bool {
+	return reference.digest != emptyDigest
+}
+
+// GetManifestReference returns what should be used to fetch the manifest from the registry; the digest if it is
+// present, the tag otherwise
+func (reference *OciReference) GetManifestReference() string {
+	if reference.IsPinnedToDigest() {
+		return reference.digest
+	}
+	return reference.tag
+}
+
+// GetPackageLocator returns the locator of the package root, without any path inside the package
+func (reference *OciReference) GetPackageLocator() string {
+	locator := OciLocatorPrefix + path.Join(reference.registry, reference.repository)
+	if reference.tag != emptyTag {
+		locator = locator + tagDelimiter + reference.tag
+	}
+	if reference.digest != emptyDigest {
+		locator = locator + digestDelimiter + reference.digest
+	}
+	return locator
+}
+
+// GetPackageName returns the name the package must declare in its kurtosis.yml to be published under this reference,
+// i.e. the registry and the repository without the 'oci://' prefix, tag or digest. Packages are stored under their
+// name once pulled, so tying it to the reference prevents an artifact from replacing any other package
+func (reference *OciReference) GetPackageName() string {
+	return path.Join(reference.registry, reference.repository)
+}
+
+// GetLocator returns the full locator, including the path inside the package if any
+func (reference *OciReference) GetLocator() string {
+	if reference.relativeFilePath == emptyRelativePath {
+		return reference.GetPackageLocator()
+	}
+	return fmt.Sprintf("%v%v%v", reference.GetPackageLocator(), pathSeparator, reference.relativeFilePath)
+}
+
+// GetAbsoluteLocatorRelativeToThisReference resolves a relative locator (either relative to the package root if it
+// starts with '/', or relative to the file this reference points to) into an absolute OCI locator
+func (reference *OciReference) GetAbsoluteLocatorRelativeToThisReference(relativeLocator string) string {
+	var relativeFilePath string
+	if strings.HasPrefix(relativeLocator, packageRootIndicator) {
+		relativeFilePath = path.Join(packageRootIndicator, relativeLocator)
+	} else {
+		relativeFilePath = path.Join(packageRootIndicator, path.Dir(reference.relativeFilePath), relativeLocator)
+	}
+	relativeFilePath = strings.TrimPrefix(relativeFilePath, packageRootIndicator)
+	return NewOciReference(reference.registry, reference.repository, reference.tag, reference.digest, relativeFilePath).GetLocator()
+}
+
+// splitOutTagAndDigest splits 'name:tag@sha256:abc' into its components. The returned boolean is true if the sub path
+// contained a tag or a digest
+func splitOutTagAndDigest(subPath string) (string, string, string, bool) {
+	nameAndTag := subPath
+	digest := emptyDigest
+	if digestIdx := strings.Index(subPath, digestDelimiter); digestIdx != -1 {
+		nameAndTag = subPath[:digestIdx]
+		digest = subPath[digestIdx+len(digestDelimiter):]
+	}
+	name := nameAndTag
+	tag := emptyTag
+	if tagIdx := strings.Index(nameAndTag, tagDelimiter); tagIdx != -1 {
+		name = nameAndTag[:tagIdx]
+		tag = nameAndTag[tagIdx+len(tagDelimiter):]
+	}
+	hasVersion := nameAndTag != subPath || name != nameAndTag
+	return name, tag, digest, hasVersion
+}
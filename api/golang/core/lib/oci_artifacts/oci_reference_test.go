@@ -0,0 +1,62 @@
+package oci_artifacts
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testRegistry   = "registry.example.com:5000"
+	testRepository = "kurtosis/sample-package"
+	testDigest     = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+)
+
+func TestParseOciReference_NoTagDefaultsToLatest(t *testing.T) {
+	reference, err := ParseOciReference("oci://" + testRegistry + "/" + testRepository)
+	require.NoError(t, err)
+	require.Equal(t, NewOciReference(testRegistry, testRepository, DefaultTag, emptyDigest, emptyRelativePath), reference)
+	require.Equal(t, "oci://"+testRegistry+"/"+testRepository+":latest", reference.GetPackageLocator())
+}
+
+func TestParseOciReference_TagAndFilePath(t *testing.T) {
+	reference, err := ParseOciReference("oci://" + testRegistry + "/" + testRepository + ":1.2.0/src/lib.star")
+	require.NoError(t, err)
+	require.Equal(t, NewOciReference(testRegistry, testRepository, "1.2.0", emptyDigest, "src/lib.star"), reference)
+	require.Equal(t, "1.2.0", reference.GetManifestReference())
+	require.False(t, reference.IsPinnedToDigest())
+	require.Equal(t, testRegistry+"/"+testRepository, reference.GetPackageName())
+}
+
+func TestParseOciReference_DigestWinsOverTag(t *testing.T) {
+	reference, err := ParseOciReference("oci://" + testRegistry + "/" + testRepository + ":1.2.0@" + testDigest + "/main.star")
+	require.NoError(t, err)
+	require.Equal(t, NewOciReference(testRegistry, testRepository, "1.2.0", testDigest, "main.star"), reference)
+	require.True(t, reference.IsPinnedToDigest())
+	require.Equal(t, testDigest, reference.GetManifestReference())
+	require.Equal(t, "oci://"+testRegistry+"/"+testRepository+":1.2.0@"+testDigest+"/main.star", reference.GetLocator())
+}
+
+func TestParseOciReference_InvalidReferences(t *testing.T) {
+	invalidLocators := []string{
+		"github.com/kurtosis-tech/sample-package",
+		"oci://" + testRegistry,
+		"oci://" + testRegistry + "/Kurtosis/Uppercase:1.0.0",
+		"oci://" + testRegistry + "/" + testRepository + "@sha256:tooshort",
+		"oci://" + testRegistry + "/" + testRepository + ":-invalid-tag",
+	}
+	for _, invalidLocator := range invalidLocators {
+		_, err := ParseOciReference(invalidLocator)
+		require.Error(t, err, "Expected locator '%v' to be invalid", invalidLocator)
+	}
+}
+
+func TestGetAbsoluteLocatorRelativeToThisReference(t *testing.T) {
+	reference, err := ParseOciReference("oci://" + testRegistry + "/" + testRepository + ":1.2.0/src/lib.star")
+	require.NoError(t, err)
+
+	require.Equal(t, "oci://"+testRegistry+"/"+testRepository+":1.2.0/src/helpers.star", reference.GetAbsoluteLocatorRelativeToThisReference("./helpers.star"))
+	require.Equal(t, "oci://"+testRegistry+"/"+testRepository+":1.2.0/static/config.yml", reference.GetAbsoluteLocatorRelativeToThisReference("../static/config.yml"))
+	require.Equal(t, "oci://"+testRegistry+"/"+testRepository+":1.2.0/main.star", reference.GetAbsoluteLocatorRelativeToThisReference("/main.star"))
+	// relative locators can't escape the package
+	require.Equal(t, "oci://"+testRegistry+"/"+testRepository+":1.2.0/main.star", reference.GetAbsoluteLocatorRelativeToThisReference("../../../main.star"))
+}
@@ -0,0 +1,474 @@
+package oci_artifacts
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+const (
+	OciManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+
+	// KurtosisPackageArtifactType is the artifact type of OCI artifacts containing a Kurtosis package
+	KurtosisPackageArtifactType = "application/vnd.kurtosis.package.v1"
+	// KurtosisPackageLayerMediaType is the media type of the single layer of a package artifact. It is a TGZ archive
+	// of the package root, as produced by path-compression
+	KurtosisPackageLayerMediaType = "application/vnd.kurtosis.package.content.v1.tar+gzip"
+
+	// The OCI "empty" config, see https://github.com/opencontainers/image-spec/blob/main/manifest.md#guidance-for-an-empty-descriptor
+	emptyConfigMediaType = "application/vnd.oci.empty.v1+json"
+	emptyConfigContent   = "{}"
+
+	PackageNameAnnotationKey = "tech.kurtosis.package.name"
+
+	contentDigestHeader   = "Docker-Content-Digest"
+	authenticateHeader    = "WWW-Authenticate"
+	authorizationHeader   = "Authorization"
+	acceptHeader          = "Accept"
+	contentTypeHeader     = "Content-Type"
+	locationHeader        = "Location"
+	octetStreamMediaType  = "application/octet-stream"
+	bearerChallengePrefix = "bearer "
+	basicChallengePrefix  = "basic "
+
+	httpsScheme = "https"
+	httpScheme  = "http"
+
+	pullScope     = "pull"
+	pushPullScope = "pull,push"
+
+	maxErrorBodyBytesToDisplay = 1024
+)
+
+var (
+	// registries reachable over plain HTTP, same as the Docker daemon defaults
+	insecureRegistryHosts = map[string]bool{
+		"localhost": true,
+		"127.0.0.1": true,
+		"::1":       true,
+	}
+)
+
+// RegistryCredentials are the username and password (or token) used to authenticate against a registry
+type RegistryCredentials struct {
+	username string
+	password string
+}
+
+func NewRegistryCredentials(username string, password string) *RegistryCredentials {
+	return &RegistryCredentials{username: username, password: password}
+}
+
+func (credentials *RegistryCredentials) GetUsername() string {
+	return credentials.username
+}
+
+func (credentials *RegistryCredentials) GetPassword() string {
+	return credentials.password
+}
+
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type manifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType"`
+	ArtifactType  string            `json:"artifactType,omitempty"`
+	Config        descriptor        `json:"config"`
+	Layers        []descriptor      `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// RegistryClient is a minimal client of the OCI distribution API, able to push and pull Kurtosis packages
+type RegistryClient struct {
+	httpClient *http.Client
+	// registry host -> credentials. Registries not in this map are accessed anonymously
+	credentialsByRegistry map[string]*RegistryCredentials
+
+	// registry + scope -> bearer token obtained from the registry token service
+	bearerTokens      map[string]string
+	bearerTokensMutex *sync.Mutex
+}
+
+func NewRegistryClient(httpClient *http.Client, credentialsByRegistry map[string]*RegistryCredentials) *RegistryClient {
+	return &RegistryClient{
+		httpClient:            httpClient,
+		credentialsByRegistry: credentialsByRegistry,
+		bearerTokens:          map[string]string{},
+		bearerTokensMutex:     &sync.Mutex{},
+	}
+}
+
+// ResolveDigest returns the digest of the manifest the reference currently points to
+func (client *RegistryClient) ResolveDigest(ctx context.Context, reference *OciReference) (string, error) {
+	_, manifestDigest, err := client.getManifest(ctx, reference)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred resolving the digest of '%v'", reference.GetPackageLocator())
+	}
+	return manifestDigest, nil
+}
+
+// PullPackage writes the package archive the reference points to into the writer and returns the digest of the
+// manifest that was pulled. Digests of both the manifest and the archive are verified
+func (client *RegistryClient) PullPackage(ctx context.Context, reference *OciReference, packageArchiveWriter io.Writer) (string, error) {
+	packageManifest, manifestDigest, err := client.getManifest(ctx, reference)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the manifest of '%v'", reference.GetPackageLocator())
+	}
+	packageLayer, err := getPackageLayer(packageManifest)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Artifact '%v' isn't a valid Kurtosis package", reference.GetPackageLocator())
+	}
+
+	blobUrl := client.getRegistryUrl(reference, fmt.Sprintf("blobs/%v", packageLayer.Digest))
+	response, err := client.doRequest(ctx, reference, pullScope, http.MethodGet, blobUrl, nil, nil)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred fetching blob '%v' of '%v'", packageLayer.Digest, reference.GetPackageLocator())
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", newUnexpectedResponseError(response, "fetching blob '%v' of '%v'", packageLayer.Digest, reference.GetPackageLocator())
+	}
+
+	blobHasher := sha256.New()
+	if _, err = io.Copy(io.MultiWriter(packageArchiveWriter, blobHasher), response.Body); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading blob '%v' of '%v'", packageLayer.Digest, reference.GetPackageLocator())
+	}
+	if actualDigest := sha256DigestFromHasherSum(blobHasher.Sum(nil)); actualDigest != packageLayer.Digest {
+		return "", stacktrace.NewError("Blob of '%v' has digest '%v' but the manifest declares '%v'; the artifact is corrupted", reference.GetPackageLocator(), actualDigest, packageLayer.Digest)
+	}
+	return manifestDigest, nil
+}
+
+// PushPackage uploads the package archive as a single-layer OCI artifact and tags it with the tag of the reference.
+// It returns the digest of the pushed manifest
+func (client *RegistryClient) PushPackage(ctx context.Context, reference *OciReference, packageName string, packageArchive io.ReadSeeker) (string, error) {
+	if reference.GetTag() == emptyTag {
+		return "", stacktrace.NewError("A tag is required to push a package but reference '%v' only contains a digest", reference.GetPackageLocator())
+	}
+
+	archiveHasher := sha256.New()
+	archiveSize, err := io.Copy(archiveHasher, packageArchive)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred computing the digest of the package archive")
+	}
+	archiveDigest := sha256DigestFromHasherSum(archiveHasher.Sum(nil))
+	if err = client.pushBlob(ctx, reference, archiveDigest, archiveSize, packageArchive); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred pushing the package archive")
+	}
+
+	emptyConfig := []byte(emptyConfigContent)
+	emptyConfigDigest := sha256Digest(emptyConfig)
+	if err = client.pushBlob(ctx, reference, emptyConfigDigest, int64(len(emptyConfig)), bytes.NewReader(emptyConfig)); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred pushing the artifact config")
+	}
+
+	packageManifest := manifest{
+		SchemaVersion: 2,
+		MediaType:     OciManifestMediaType,
+		ArtifactType:  KurtosisPackageArtifactType,
+		Config: descriptor{
+			MediaType:   emptyConfigMediaType,
+			Digest:      emptyConfigDigest,
+			Size:        int64(len(emptyConfig)),
+			Annotations: nil,
+		},
+		Layers: []descriptor{
+			{
+				MediaType:   KurtosisPackageLayerMediaType,
+				Digest:      archiveDigest,
+				Size:        archiveSize,
+				Annotations: nil,
+			},
+		},
+		Annotations: map[string]string{
+			PackageNameAnnotationKey: packageName,
+		},
+	}
+	serializedManifest, err := json.Marshal(packageManifest)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the manifest of '%v'", reference.GetPackageLocator())
+	}
+
+	manifestUrl := client.getRegistryUrl(reference, fmt.Sprintf("manifests/%v", reference.GetTag()))
+	response, err := client.doRequest(ctx, reference, pushPullScope, http.MethodPut, manifestUrl, map[string]string{contentTypeHeader: OciManifestMediaType}, serializedManifest)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred pushing the manifest of '%v'", reference.GetPackageLocator())
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusOK {
+		return "", newUnexpectedResponseError(response, "pushing the manifest of '%v'", reference.GetPackageLocator())
+	}
+	return sha256Digest(serializedManifest), nil
+}
+
+func (client *RegistryClient) getManifest(ctx context.Context, reference *OciReference) (*manifest, string, error) {
+	manifestUrl := client.getRegistryUrl(reference, fmt.Sprintf("manifests/%v", reference.GetManifestReference()))
+	response, err := client.doRequest(ctx, reference, pullScope, http.MethodGet, manifestUrl, map[string]string{acceptHeader: OciManifestMediaType}, nil)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred fetching the manifest")
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, "", newUnexpectedResponseError(response, "fetching manifest '%v' of '%v'", reference.GetManifestReference(), reference.GetPackageLocator())
+	}
+	serializedManifest, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred reading the manifest")
+	}
+
+	// the digest is always computed locally, the registry can't be trusted for content-addressed references
+	manifestDigest := sha256Digest(serializedManifest)
+	if reference.IsPinnedToDigest() && manifestDigest != reference.GetDigest() {
+		return nil, "", stacktrace.NewError("The registry returned a manifest with digest '%v' while '%v' was requested", manifestDigest, reference.GetDigest())
+	}
+	if registryDigest := response.Header.Get(contentDigestHeader); registryDigest != "" && registryDigest != manifestDigest {
+		logrus.Debugf("Registry reported digest '%v' for '%v' but its content hashes to '%v'", registryDigest, reference.GetPackageLocator(), manifestDigest)
+	}
+
+	parsedManifest := &manifest{
+		SchemaVersion: 0,
+		MediaType:     "",
+		ArtifactType:  "",
+		Config: descriptor{
+			MediaType:   "",
+			Digest:      "",
+			Size:        0,
+			Annotations: nil,
+		},
+		Layers:      nil,
+		Annotations: nil,
+	}
+	if err = json.Unmarshal(serializedManifest, parsedManifest); err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred parsing the manifest of '%v'", reference.GetPackageLocator())
+	}
+	return parsedManifest, manifestDigest, nil
+}
+
+func (client *RegistryClient) pushBlob(ctx context.Context, reference *OciReference, blobDigest string, blobSize int64, blobContent io.ReadSeeker) error {
+	existingBlobUrl := client.getRegistryUrl(reference, fmt.Sprintf("blobs/%v", blobDigest))
+	existingBlobResponse, err := client.doRequest(ctx, reference, pushPullScope, http.MethodHead, existingBlobUrl, nil, nil)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking whether blob '%v' already exists", blobDigest)
+	}
+	existingBlobResponse.Body.Close()
+	if existingBlobResponse.StatusCode == http.StatusOK {
+		logrus.Debugf("Blob '%v' already exists in '%v', skipping upload", blobDigest, reference.GetRepository())
+		return nil
+	}
+
+	startUploadUrl := client.getRegistryUrl(reference, "blobs/uploads/")
+	startUploadResponse, err := client.doRequest(ctx, reference, pushPullScope, http.MethodPost, startUploadUrl, nil, nil)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the upload of blob '%v'", blobDigest)
+	}
+	startUploadResponse.Body.Close()
+	if startUploadResponse.StatusCode != http.StatusAccepted {
+		return newUnexpectedResponseError(startUploadResponse, "starting the upload of blob '%v'", blobDigest)
+	}
+	uploadLocation, err := startUploadResponse.Request.URL.Parse(startUploadResponse.Header.Get(locationHeader))
+	if err != nil {
+		return stacktrace.Propagate(err, "The registry returned an invalid upload location")
+	}
+	uploadQuery := uploadLocation.Query()
+	uploadQuery.Set("digest", blobDigest)
+	uploadLocation.RawQuery = uploadQuery.Encode()
+
+	if _, err = blobContent.Seek(0, io.SeekStart); err != nil {
+		return stacktrace.Propagate(err, "An error occurred rewinding blob '%v'", blobDigest)
+	}
+	blobBytes := make([]byte, blobSize)
+	if _, err = io.ReadFull(blobContent, blobBytes); err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading blob '%v'", blobDigest)
+	}
+	uploadResponse, err := client.doRequest(ctx, reference, pushPullScope, http.MethodPut, uploadLocation.String(), map[string]string{contentTypeHeader: octetStreamMediaType}, blobBytes)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred uploading blob '%v'", blobDigest)
+	}
+	defer uploadResponse.Body.Close()
+	if uploadResponse.StatusCode != http.StatusCreated {
+		return newUnexpectedResponseError(uploadResponse, "uploading blob '%v'", blobDigest)
+	}
+	return nil
+}
+
+// doRequest sends the request, going through the registry authentication challenge if the registry requires it
+func (client *RegistryClient) doRequest(ctx context.Context, reference *OciReference, actions string, method string, requestUrl string, headers map[string]string, body []byte) (*http.Response, error) {
+	scope := fmt.Sprintf("repository:%v:%v", reference.GetRepository(), actions)
+	tokenKey := reference.GetRegistry() + "|" + scope
+
+	client.bearerTokensMutex.Lock()
+	bearerToken, hasBearerToken := client.bearerTokens[tokenKey]
+	client.bearerTokensMutex.Unlock()
+
+	authorization := ""
+	if hasBearerToken {
+		authorization = "Bearer " + bearerToken
+	}
+	response, err := client.sendRequest(ctx, method, requestUrl, headers, body, authorization)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusUnauthorized {
+		return response, nil
+	}
+	challenge := response.Header.Get(authenticateHeader)
+	response.Body.Close()
+
+	credentials := client.credentialsByRegistry[reference.GetRegistry()]
+	switch {
+	case strings.HasPrefix(strings.ToLower(challenge), bearerChallengePrefix):
+		bearerToken, err = client.fetchBearerToken(ctx, challenge, scope, credentials)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred authenticating against registry '%v'", reference.GetRegistry())
+		}
+		client.bearerTokensMutex.Lock()
+		client.bearerTokens[tokenKey] = bearerToken
+		client.bearerTokensMutex.Unlock()
+		authorization = "Bearer " + bearerToken
+	case strings.HasPrefix(strings.ToLower(challenge), basicChallengePrefix) && credentials != nil:
+		authorization = "Basic " + basicAuthValue(credentials)
+	default:
+		return nil, stacktrace.NewError("Registry '%v' requires authentication but no usable credentials were found for it", reference.GetRegistry())
+	}
+	return client.sendRequest(ctx, method, requestUrl, headers, body, authorization)
+}
+
+func (client *RegistryClient) sendRequest(ctx context.Context, method string, requestUrl string, headers map[string]string, body []byte, authorization string) (*http.Response, error) {
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, requestUrl, bodyReader)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building the '%v' request to '%v'", method, requestUrl)
+	}
+	for headerKey, headerValue := range headers {
+		request.Header.Set(headerKey, headerValue)
+	}
+	if authorization != "" {
+		request.Header.Set(authorizationHeader, authorization)
+	}
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred sending the '%v' request to '%v'", method, requestUrl)
+	}
+	return response, nil
+}
+
+// fetchBearerToken implements https://distribution.github.io/distribution/spec/auth/token/
+func (client *RegistryClient) fetchBearerToken(ctx context.Context, challenge string, scope string, credentials *RegistryCredentials) (string, error) {
+	challengeParams := parseChallengeParams(challenge[len(bearerChallengePrefix):])
+	realm, found := challengeParams["realm"]
+	if !found {
+		return "", stacktrace.NewError("The registry authentication challenge '%v' doesn't contain a realm", challenge)
+	}
+	tokenUrl, err := url.Parse(realm)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "The registry authentication realm '%v' isn't a valid URL", realm)
+	}
+	tokenQuery := tokenUrl.Query()
+	if service, found := challengeParams["service"]; found {
+		tokenQuery.Set("service", service)
+	}
+	tokenQuery.Set("scope", scope)
+	tokenUrl.RawQuery = tokenQuery.Encode()
+
+	authorization := ""
+	if credentials != nil {
+		authorization = "Basic " + basicAuthValue(credentials)
+	}
+	response, err := client.sendRequest(ctx, http.MethodGet, tokenUrl.String(), nil, nil, authorization)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred requesting a token from '%v'", tokenUrl.String())
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", newUnexpectedResponseError(response, "requesting a token from '%v'", realm)
+	}
+	tokenResponse := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{
+		Token:       "",
+		AccessToken: "",
+	}
+	if err = json.NewDecoder(response.Body).Decode(&tokenResponse); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred decoding the token response from '%v'", realm)
+	}
+	if tokenResponse.Token != "" {
+		return tokenResponse.Token, nil
+	}
+	if tokenResponse.AccessToken != "" {
+		return tokenResponse.AccessToken, nil
+	}
+	return "", stacktrace.NewError("The token service '%v' didn't return any token", realm)
+}
+
+func (client *RegistryClient) getRegistryUrl(reference *OciReference, resourcePath string) string {
+	scheme := httpsScheme
+	registryHost := reference.GetRegistry()
+	if hostWithoutPort, _, err := net.SplitHostPort(registryHost); err == nil {
+		registryHost = hostWithoutPort
+	}
+	if insecureRegistryHosts[registryHost] {
+		scheme = httpScheme
+	}
+	return fmt.Sprintf("%v://%v/v2/%v/%v", scheme, reference.GetRegistry(), reference.GetRepository(), resourcePath)
+}
+
+func getPackageLayer(packageManifest *manifest) (*descriptor, error) {
+	for idx := range packageManifest.Layers {
+		if packageManifest.Layers[idx].MediaType == KurtosisPackageLayerMediaType {
+			return &packageManifest.Layers[idx], nil
+		}
+	}
+	return nil, stacktrace.NewError("No layer with media type '%v' was found in the artifact manifest. Packages should be pushed with 'kurtosis package push'", KurtosisPackageLayerMediaType)
+}
+
+// parseChallengeParams parses 'realm="https://auth.example.com/token",service="registry.example.com"'
+func parseChallengeParams(challengeParams string) map[string]string {
+	params := map[string]string{}
+	for _, param := range strings.Split(challengeParams, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if !found {
+			continue
+		}
+		params[strings.ToLower(key)] = strings.Trim(value, `"`)
+	}
+	return params
+}
+
+func basicAuthValue(credentials *RegistryCredentials) string {
+	return base64.StdEncoding.EncodeToString([]byte(credentials.GetUsername() + ":" + credentials.GetPassword()))
+}
+
+func sha256Digest(content []byte) string {
+	hash := sha256.Sum256(content)
+	return sha256DigestFromHasherSum(hash[:])
+}
+
+func sha256DigestFromHasherSum(sum []byte) string {
+	return sha256DigestPrefix + hex.EncodeToString(sum)
+}
+
+func newUnexpectedResponseError(response *http.Response, actionFormat string, actionArgs ...interface{}) error {
+	responseBody, _ := io.ReadAll(io.LimitReader(response.Body, maxErrorBodyBytesToDisplay))
+	return stacktrace.NewError("The registry returned unexpected status '%v' when %v. Response body was:\n%v", response.Status, fmt.Sprintf(actionFormat, actionArgs...), string(responseBody))
+}
@@ -0,0 +1,115 @@
+package oci_artifacts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	testPackageName    = "github.com/kurtosis-tech/sample-package"
+	testPackageArchive = "not really a tgz but the registry doesn't care"
+)
+
+// fakeRegistry is an in-memory implementation of the subset of the OCI distribution API the client uses
+type fakeRegistry struct {
+	mutex     *sync.Mutex
+	blobs     map[string][]byte
+	manifests map[string][]byte
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{
+		mutex:     &sync.Mutex{},
+		blobs:     map[string][]byte{},
+		manifests: map[string][]byte{},
+	}
+}
+
+func (registry *fakeRegistry) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	requestPath := strings.TrimPrefix(request.URL.Path, "/v2/"+testRepository+"/")
+	body, _ := io.ReadAll(request.Body)
+	switch {
+	case request.Method == http.MethodPost && requestPath == "blobs/uploads/":
+		writer.Header().Set(locationHeader, "/v2/"+testRepository+"/blobs/uploads/some-session-id")
+		writer.WriteHeader(http.StatusAccepted)
+	case request.Method == http.MethodPut && strings.HasPrefix(requestPath, "blobs/uploads/"):
+		registry.blobs[request.URL.Query().Get("digest")] = body
+		writer.WriteHeader(http.StatusCreated)
+	case strings.HasPrefix(requestPath, "blobs/"):
+		blob, found := registry.blobs[strings.TrimPrefix(requestPath, "blobs/")]
+		if !found {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = writer.Write(blob)
+	case request.Method == http.MethodPut && strings.HasPrefix(requestPath, "manifests/"):
+		registry.manifests[strings.TrimPrefix(requestPath, "manifests/")] = body
+		registry.manifests[sha256Digest(body)] = body
+		writer.WriteHeader(http.StatusCreated)
+	case strings.HasPrefix(requestPath, "manifests/"):
+		serializedManifest, found := registry.manifests[strings.TrimPrefix(requestPath, "manifests/")]
+		if !found {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = writer.Write(serializedManifest)
+	default:
+		writer.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func TestPushThenPullPackage(t *testing.T) {
+	registry := newFakeRegistry()
+	server := httptest.NewServer(registry)
+	defer server.Close()
+	registryHost := strings.TrimPrefix(server.URL, "http://")
+
+	client := NewRegistryClient(server.Client(), map[string]*RegistryCredentials{})
+	reference, err := ParseOciReference(fmt.Sprintf("oci://%v/%v:1.0.0", registryHost, testRepository))
+	require.NoError(t, err)
+
+	pushedDigest, err := client.PushPackage(context.Background(), reference, testPackageName, bytes.NewReader([]byte(testPackageArchive)))
+	require.NoError(t, err)
+
+	resolvedDigest, err := client.ResolveDigest(context.Background(), reference)
+	require.NoError(t, err)
+	require.Equal(t, pushedDigest, resolvedDigest)
+
+	pinnedReference, err := ParseOciReference(fmt.Sprintf("oci://%v/%v@%v", registryHost, testRepository, pushedDigest))
+	require.NoError(t, err)
+	pulledArchive := &bytes.Buffer{}
+	pulledDigest, err := client.PullPackage(context.Background(), pinnedReference, pulledArchive)
+	require.NoError(t, err)
+	require.Equal(t, pushedDigest, pulledDigest)
+	require.Equal(t, testPackageArchive, pulledArchive.String())
+}
+
+func TestPullPackage_FailsOnCorruptedBlob(t *testing.T) {
+	registry := newFakeRegistry()
+	server := httptest.NewServer(registry)
+	defer server.Close()
+	registryHost := strings.TrimPrefix(server.URL, "http://")
+
+	client := NewRegistryClient(server.Client(), map[string]*RegistryCredentials{})
+	reference, err := ParseOciReference(fmt.Sprintf("oci://%v/%v:1.0.0", registryHost, testRepository))
+	require.NoError(t, err)
+	_, err = client.PushPackage(context.Background(), reference, testPackageName, bytes.NewReader([]byte(testPackageArchive)))
+	require.NoError(t, err)
+
+	for blobDigest := range registry.blobs {
+		registry.blobs[blobDigest] = []byte("tampered")
+	}
+	_, err = client.PullPackage(context.Background(), reference, io.Discard)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the artifact is corrupted")
+}
@@ -0,0 +1,145 @@
+package package_lock
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// LockFileName is the name of the lock file, stored at the root of a package next to its kurtosis.yml
+	LockFileName = "kurtosis.lock"
+
+	ContentHashPrefix = "sha256:"
+
+	lockFileHeader      = "# This file is generated by 'kurtosis package lock'. Do not edit it by hand.\n"
+	lockFilePermissions = 0644
+
+	gitDirname = ".git"
+)
+
+// PackageLock pins every remote package dependency of a package to a commit and a content hash
+// Packages are keyed by repository locator, e.g. 'github.com/kurtosis-tech/postgres-package'
+// fields are public because it's needed for YAML decoding
+type PackageLock struct {
+	Packages map[string]*LockedPackage `yaml:"packages"`
+}
+
+// LockedPackage fields are public because it's needed for YAML decoding
+type LockedPackage struct {
+	// the tag, branch or commit requested by the import, empty if the default branch was used
+	Version string `yaml:"version,omitempty"`
+	Commit  string `yaml:"commit"`
+	Hash    string `yaml:"hash"`
+}
+
+func NewPackageLock(packages map[string]*LockedPackage) *PackageLock {
+	return &PackageLock{Packages: packages}
+}
+
+func NewLockedPackage(version string, commit string, hash string) *LockedPackage {
+	return &LockedPackage{Version: version, Commit: commit, Hash: hash}
+}
+
+// ParsePackageLock reads and validates the lock file at the given path
+func ParsePackageLock(lockFilepath string) (*PackageLock, error) {
+	lockFileContents, err := os.ReadFile(lockFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while reading the '%v' file at '%v'", LockFileName, lockFilepath)
+	}
+	packageLock := NewPackageLock(map[string]*LockedPackage{})
+	if err = yaml.Unmarshal(lockFileContents, packageLock); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while parsing the '%v' file at '%v'", LockFileName, lockFilepath)
+	}
+	if packageLock.Packages == nil {
+		packageLock.Packages = map[string]*LockedPackage{}
+	}
+	for repositoryLocator, lockedPackage := range packageLock.Packages {
+		if lockedPackage == nil || lockedPackage.Commit == "" {
+			return nil, stacktrace.NewError("Package '%v' in '%v' doesn't have a commit", repositoryLocator, lockFilepath)
+		}
+		if !strings.HasPrefix(lockedPackage.Hash, ContentHashPrefix) {
+			return nil, stacktrace.NewError("Package '%v' in '%v' has hash '%v' but only '%v' hashes are supported", repositoryLocator, lockFilepath, lockedPackage.Hash, ContentHashPrefix)
+		}
+	}
+	return packageLock, nil
+}
+
+// WriteToFile serializes the lock to the given path, overwriting any existing file
+func (packageLock *PackageLock) WriteToFile(lockFilepath string) error {
+	// the YAML encoder sorts map keys, so the file is stable between two runs locking the same dependencies
+	serializedLock, err := yaml.Marshal(packageLock)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the package lock")
+	}
+	if err = os.WriteFile(lockFilepath, append([]byte(lockFileHeader), serializedLock...), lockFilePermissions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the package lock to '%v'", lockFilepath)
+	}
+	return nil
+}
+
+// GetLockedPackage returns the locked version of the repository, if any
+func (packageLock *PackageLock) GetLockedPackage(repositoryLocator string) (*LockedPackage, bool) {
+	lockedPackage, found := packageLock.Packages[repositoryLocator]
+	return lockedPackage, found
+}
+
+// ComputeContentHash returns a hash of the content of the directory, ignoring the git metadata and file modification
+// times, so that two checkouts of the same commit always have the same hash
+func ComputeContentHash(dirpath string) (string, error) {
+	contentHash := sha256.New()
+	err := filepath.WalkDir(dirpath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() && entry.Name() == gitDirname {
+			return filepath.SkipDir
+		}
+		relativeFilePath, err := filepath.Rel(dirpath, filePath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to '%v'", filePath, dirpath)
+		}
+		relativeFilePath = filepath.ToSlash(relativeFilePath)
+		switch {
+		case entry.IsDir():
+			return nil
+		case entry.Type()&fs.ModeSymlink != 0:
+			linkTarget, err := os.Readlink(filePath)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred reading link '%v'", filePath)
+			}
+			fmt.Fprintf(contentHash, "%v -> %v\n", relativeFilePath, linkTarget)
+		default:
+			fileHash, err := hashFile(filePath)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred hashing file '%v'", filePath)
+			}
+			fmt.Fprintf(contentHash, "%v %v\n", relativeFilePath, fileHash)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred computing the content hash of '%v'", dirpath)
+	}
+	return ContentHashPrefix + hex.EncodeToString(contentHash.Sum(nil)), nil
+}
+
+func hashFile(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred opening '%v'", filePath)
+	}
+	defer file.Close()
+	fileHash := sha256.New()
+	if _, err = io.Copy(fileHash, file); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading '%v'", filePath)
+	}
+	return hex.EncodeToString(fileHash.Sum(nil)), nil
+}
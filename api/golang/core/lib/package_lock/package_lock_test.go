@@ -0,0 +1,59 @@
+package package_lock
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+)
+
+const (
+	testRepositoryLocator = "github.com/kurtosis-tech/postgres-package"
+	testCommit            = "4d2a3c6b9f1e8a7d5c0b2e4f6a8c1d3e5f7a9b0c"
+)
+
+func TestWriteThenParsePackageLock(t *testing.T) {
+	lockFilepath := path.Join(t.TempDir(), LockFileName)
+	packageLock := NewPackageLock(map[string]*LockedPackage{
+		testRepositoryLocator: NewLockedPackage("1.0.0", testCommit, ContentHashPrefix+"abc"),
+	})
+	require.NoError(t, packageLock.WriteToFile(lockFilepath))
+
+	parsedLock, err := ParsePackageLock(lockFilepath)
+	require.NoError(t, err)
+	require.Equal(t, packageLock, parsedLock)
+	lockedPackage, found := parsedLock.GetLockedPackage(testRepositoryLocator)
+	require.True(t, found)
+	require.Equal(t, testCommit, lockedPackage.Commit)
+}
+
+func TestParsePackageLock_FailsOnMissingCommit(t *testing.T) {
+	lockFilepath := path.Join(t.TempDir(), LockFileName)
+	lockContents := "packages:\n  " + testRepositoryLocator + ":\n    hash: sha256:abc\n"
+	require.NoError(t, os.WriteFile(lockFilepath, []byte(lockContents), lockFilePermissions))
+
+	_, err := ParsePackageLock(lockFilepath)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "doesn't have a commit")
+}
+
+func TestComputeContentHash_IgnoresGitMetadata(t *testing.T) {
+	firstCheckout := t.TempDir()
+	secondCheckout := t.TempDir()
+	for _, checkout := range []string{firstCheckout, secondCheckout} {
+		require.NoError(t, os.WriteFile(path.Join(checkout, "main.star"), []byte("def run(plan):\n    pass\n"), lockFilePermissions))
+		require.NoError(t, os.Mkdir(path.Join(checkout, gitDirname), 0755))
+	}
+	require.NoError(t, os.WriteFile(path.Join(secondCheckout, gitDirname, "HEAD"), []byte("ref: refs/heads/main\n"), lockFilePermissions))
+
+	firstHash, err := ComputeContentHash(firstCheckout)
+	require.NoError(t, err)
+	secondHash, err := ComputeContentHash(secondCheckout)
+	require.NoError(t, err)
+	require.Equal(t, firstHash, secondHash)
+
+	require.NoError(t, os.WriteFile(path.Join(secondCheckout, "main.star"), []byte("def run(plan):\n    return\n"), lockFilePermissions))
+	modifiedHash, err := ComputeContentHash(secondCheckout)
+	require.NoError(t, err)
+	require.NotEqual(t, firstHash, modifiedHash)
+}
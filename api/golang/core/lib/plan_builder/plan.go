@@ -0,0 +1,388 @@
+package plan_builder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	mainFunctionDeclaration = "def run(plan):"
+	statementIndent         = "    "
+	statementsSeparator     = "\n"
+
+	addServiceInstruction        = "plan.add_service"
+	removeServiceInstruction     = "plan.remove_service"
+	execInstruction              = "plan.exec"
+	requestInstruction           = "plan.request"
+	waitInstruction              = "plan.wait"
+	uploadFilesInstruction       = "plan.upload_files"
+	renderTemplatesInstruction   = "plan.render_templates"
+	storeServiceFilesInstruction = "plan.store_service_files"
+	printInstruction             = "plan.print"
+
+	serviceNameArgName         = "service_name"
+	nameArgName                = "name"
+	configArgName              = "config"
+	artifactNameArgName        = "name"
+	srcArgName                 = "src"
+	printMsgArgName            = "msg"
+	templateStructFunctionName = "struct"
+	templateArgName            = "template"
+	templateDataArgName        = "data"
+
+	serviceVariablePrefix       = "service"
+	execVariablePrefix          = "exec_result"
+	requestVariablePrefix       = "request_result"
+	waitVariablePrefix          = "wait_result"
+	filesArtifactVariablePrefix = "artifact"
+
+	// Keys of the dict returned by the generated main function, parsed back by PlanResult
+	servicesResultKey = "services"
+	valuesResultKey   = "values"
+	outputsResultKey  = "outputs"
+
+	serviceInfoNameKey             = "name"
+	serviceInfoHostnameKey         = "hostname"
+	serviceInfoIpAddressKey        = "ip_address"
+	serviceInfoPortsKey            = "ports"
+	portInfoNumberKey              = "number"
+	portInfoTransportProtocolKey   = "transport_protocol"
+	portInfoApplicationProtocolKey = "application_protocol"
+
+	autoGeneratedArtifactName = ""
+	firstVariableIdx          = 0
+
+	variableNameFormat        = "%s_%d"
+	attributeAccessFormat     = "%s.%s"
+	itemAccessFormat          = "%s[%s]"
+	portAttributeAccessFormat = "%s.ports[%s].%s"
+	returnStatementFormat     = "return %s"
+	assignmentStatementFormat = "%s = %s"
+)
+
+// Plan builds a Starlark script whose main function runs a sequence of plan instructions. Every method records the
+// first error it hits, which is then returned by Render, so calls can be chained without checking errors each time
+//
+// The generated main function returns the information of every service added, the values returned by exec, request,
+// wait and the files artifact instructions, and the custom outputs. PlanResult parses them back into typed values
+type Plan struct {
+	statements []string
+
+	// Service name -> reference, the services are returned in the result
+	services map[string]*ServiceRef
+
+	// Variables holding values returned by instructions, they're returned in the result
+	values []string
+
+	// Custom outputs, already rendered
+	outputs map[string]string
+
+	nextVariableIdx int
+
+	err error
+}
+
+func NewPlan() *Plan {
+	return &Plan{
+		statements:      []string{},
+		services:        map[string]*ServiceRef{},
+		values:          []string{},
+		outputs:         map[string]string{},
+		nextVariableIdx: firstVariableIdx,
+		err:             nil,
+	}
+}
+
+// AddService adds a plan.add_service instruction
+func (plan *Plan) AddService(name string, config *ServiceConfig) *ServiceRef {
+	variable := plan.newVariable(serviceVariablePrefix)
+	ref := newServiceRef(name, variable, config)
+	if _, found := plan.services[name]; found {
+		plan.recordErr(stacktrace.NewError("A service named '%s' was already added to the plan", name))
+		return ref
+	}
+	plan.services[name] = ref
+	kwargs := newKwargsBuilder().add(nameArgName, name).addRenderer(configArgName, config)
+	plan.addInstruction(variable, addServiceInstruction, kwargs)
+	return ref
+}
+
+// RemoveService adds a plan.remove_service instruction. The service is no longer part of the result
+func (plan *Plan) RemoveService(name string) {
+	delete(plan.services, name)
+	plan.addInstruction("", removeServiceInstruction, newKwargsBuilder().add(nameArgName, name))
+}
+
+// Exec adds a plan.exec instruction
+func (plan *Plan) Exec(serviceName string, recipe *ExecRecipe) *ExecRef {
+	variable := plan.newVariable(execVariablePrefix)
+	kwargs := newKwargsBuilder().add(serviceNameArgName, serviceName).addRenderer(recipeArgName, recipe)
+	plan.addValueInstruction(variable, execInstruction, kwargs)
+	return newExecRef(variable)
+}
+
+// Request adds a plan.request instruction running an HTTP recipe
+func (plan *Plan) Request(serviceName string, recipe Recipe) *RecipeResultRef {
+	variable := plan.newVariable(requestVariablePrefix)
+	kwargs := newKwargsBuilder().add(serviceNameArgName, serviceName).addRenderer(recipeArgName, recipe)
+	plan.addValueInstruction(variable, requestInstruction, kwargs)
+	return newRecipeResultRef(variable)
+}
+
+// Wait adds a plan.wait instruction which runs the condition's recipe until its assertion holds
+func (plan *Plan) Wait(serviceName string, condition *ReadyCondition) *RecipeResultRef {
+	variable := plan.newVariable(waitVariablePrefix)
+	kwargs := newKwargsBuilder().add(serviceNameArgName, serviceName)
+	conditionKwargs := condition.kwargs()
+	if conditionKwargs.err != nil {
+		kwargs.err = conditionKwargs.err
+	}
+	kwargs.rendered = append(kwargs.rendered, conditionKwargs.rendered...)
+	plan.addValueInstruction(variable, waitInstruction, kwargs)
+	return newRecipeResultRef(variable)
+}
+
+// UploadFiles adds a plan.upload_files instruction. An empty artifact name lets Kurtosis generate one
+func (plan *Plan) UploadFiles(src string, artifactName string) *FilesArtifactRef {
+	variable := plan.newVariable(filesArtifactVariablePrefix)
+	kwargs := newKwargsBuilder().add(srcArgName, src)
+	addArtifactNameArg(kwargs, artifactName)
+	plan.addValueInstruction(variable, uploadFilesInstruction, kwargs)
+	return newFilesArtifactRef(variable)
+}
+
+// RenderTemplates adds a plan.render_templates instruction, the templates being keyed by their path in the artifact.
+// An empty artifact name lets Kurtosis generate one
+func (plan *Plan) RenderTemplates(artifactName string, templates map[string]*TemplateData) *FilesArtifactRef {
+	variable := plan.newVariable(filesArtifactVariablePrefix)
+	renderedTemplates := map[string]string{}
+	for path, templateData := range templates {
+		renderedTemplate, err := templateData.toStarlark()
+		if err != nil {
+			plan.recordErr(stacktrace.Propagate(err, "An error occurred rendering template '%s'", path))
+			return newFilesArtifactRef(variable)
+		}
+		renderedTemplates[path] = renderedTemplate
+	}
+	kwargs := newKwargsBuilder().addRendered(configArgName, renderDict(renderedTemplates))
+	addArtifactNameArg(kwargs, artifactName)
+	plan.addValueInstruction(variable, renderTemplatesInstruction, kwargs)
+	return newFilesArtifactRef(variable)
+}
+
+// StoreServiceFiles adds a plan.store_service_files instruction. An empty artifact name lets Kurtosis generate one
+func (plan *Plan) StoreServiceFiles(serviceName string, src string, artifactName string) *FilesArtifactRef {
+	variable := plan.newVariable(filesArtifactVariablePrefix)
+	kwargs := newKwargsBuilder().add(serviceNameArgName, serviceName).add(srcArgName, src)
+	addArtifactNameArg(kwargs, artifactName)
+	plan.addValueInstruction(variable, storeServiceFilesInstruction, kwargs)
+	return newFilesArtifactRef(variable)
+}
+
+// Print adds a plan.print instruction
+func (plan *Plan) Print(value interface{}) {
+	plan.addInstruction("", printInstruction, newKwargsBuilder().add(printMsgArgName, value))
+}
+
+// Output adds a custom value to the plan result, which can be read with PlanResult.GetOutput
+func (plan *Plan) Output(key string, value interface{}) {
+	renderedValue, err := toStarlarkValue(value)
+	if err != nil {
+		plan.recordErr(stacktrace.Propagate(err, "An error occurred rendering output '%s'", key))
+		return
+	}
+	plan.outputs[key] = renderedValue
+}
+
+// Render returns the Starlark script of the plan, or the first error hit while building it
+func (plan *Plan) Render() (string, error) {
+	if plan.err != nil {
+		return "", stacktrace.Propagate(plan.err, "An error occurred building the plan")
+	}
+
+	renderedServices := map[string]string{}
+	for name, serviceRef := range plan.services {
+		renderedServices[name] = serviceRef.renderInfo()
+	}
+	renderedValues := map[string]string{}
+	for _, variable := range plan.values {
+		renderedValues[variable] = variable
+	}
+	returnValue := renderDict(map[string]string{
+		servicesResultKey: renderDict(renderedServices),
+		valuesResultKey:   renderDict(renderedValues),
+		outputsResultKey:  renderDict(plan.outputs),
+	})
+
+	lines := []string{mainFunctionDeclaration}
+	for _, statement := range plan.statements {
+		lines = append(lines, statementIndent+statement)
+	}
+	lines = append(lines, statementIndent+fmt.Sprintf(returnStatementFormat, returnValue))
+	return strings.Join(lines, statementsSeparator) + statementsSeparator, nil
+}
+
+func (plan *Plan) newVariable(prefix string) string {
+	variable := fmt.Sprintf(variableNameFormat, prefix, plan.nextVariableIdx)
+	plan.nextVariableIdx++
+	return variable
+}
+
+func (plan *Plan) addValueInstruction(variable string, instruction string, kwargs *kwargsBuilder) {
+	plan.values = append(plan.values, variable)
+	plan.addInstruction(variable, instruction, kwargs)
+}
+
+// addInstruction renders the instruction call, assigning its return value to the variable if there's one
+func (plan *Plan) addInstruction(variable string, instruction string, kwargs *kwargsBuilder) {
+	call, err := renderCall(instruction, kwargs)
+	if err != nil {
+		plan.recordErr(err)
+		return
+	}
+	if variable == "" {
+		plan.statements = append(plan.statements, call)
+		return
+	}
+	plan.statements = append(plan.statements, fmt.Sprintf(assignmentStatementFormat, variable, call))
+}
+
+func (plan *Plan) recordErr(err error) {
+	if plan.err == nil {
+		plan.err = err
+	}
+}
+
+func addArtifactNameArg(kwargs *kwargsBuilder, artifactName string) {
+	if artifactName != autoGeneratedArtifactName {
+		kwargs.add(artifactNameArgName, artifactName)
+	}
+}
+
+// TemplateData pairs a Go template with the data it's rendered with
+type TemplateData struct {
+	template string
+	data     interface{}
+}
+
+// NewTemplateData creates a template rendered with the given data, which may contain Exprs
+func NewTemplateData(template string, data interface{}) *TemplateData {
+	return &TemplateData{
+		template: template,
+		data:     data,
+	}
+}
+
+func (templateData *TemplateData) toStarlark() (string, error) {
+	kwargs := newKwargsBuilder().add(templateArgName, templateData.template).add(templateDataArgName, templateData.data)
+	return renderCall(templateStructFunctionName, kwargs)
+}
+
+// ServiceRef references a service added to the plan
+type ServiceRef struct {
+	name     string
+	variable string
+	portIds  []string
+}
+
+func newServiceRef(name string, variable string, config *ServiceConfig) *ServiceRef {
+	portIds := []string{}
+	if config != nil {
+		for portId := range config.ports {
+			portIds = append(portIds, portId)
+		}
+	}
+	return &ServiceRef{
+		name:     name,
+		variable: variable,
+		portIds:  portIds,
+	}
+}
+
+func (ref *ServiceRef) GetName() string {
+	return ref.name
+}
+
+func (ref *ServiceRef) GetHostname() Expr {
+	return newExpr(attributeAccessFormat, ref.variable, serviceInfoHostnameKey)
+}
+
+func (ref *ServiceRef) GetIpAddress() Expr {
+	return newExpr(attributeAccessFormat, ref.variable, serviceInfoIpAddressKey)
+}
+
+func (ref *ServiceRef) GetPortNumber(portId string) Expr {
+	return newExpr(portAttributeAccessFormat, ref.variable, strconv.Quote(portId), portInfoNumberKey)
+}
+
+func (ref *ServiceRef) renderInfo() string {
+	renderedPorts := map[string]string{}
+	for _, portId := range ref.portIds {
+		quotedPortId := strconv.Quote(portId)
+		renderedPorts[portId] = renderDict(map[string]string{
+			portInfoNumberKey:              fmt.Sprintf(portAttributeAccessFormat, ref.variable, quotedPortId, portInfoNumberKey),
+			portInfoTransportProtocolKey:   fmt.Sprintf(portAttributeAccessFormat, ref.variable, quotedPortId, portInfoTransportProtocolKey),
+			portInfoApplicationProtocolKey: fmt.Sprintf(portAttributeAccessFormat, ref.variable, quotedPortId, portInfoApplicationProtocolKey),
+		})
+	}
+	return renderDict(map[string]string{
+		serviceInfoNameKey:      fmt.Sprintf(attributeAccessFormat, ref.variable, serviceInfoNameKey),
+		serviceInfoHostnameKey:  fmt.Sprintf(attributeAccessFormat, ref.variable, serviceInfoHostnameKey),
+		serviceInfoIpAddressKey: fmt.Sprintf(attributeAccessFormat, ref.variable, serviceInfoIpAddressKey),
+		serviceInfoPortsKey:     renderDict(renderedPorts),
+	})
+}
+
+// ExecRef references the value returned by an exec instruction
+type ExecRef struct {
+	variable string
+}
+
+func newExecRef(variable string) *ExecRef {
+	return &ExecRef{
+		variable: variable,
+	}
+}
+
+func (ref *ExecRef) GetOutput() Expr {
+	return newExpr(itemAccessFormat, ref.variable, strconv.Quote(ExecOutputField))
+}
+
+func (ref *ExecRef) GetCode() Expr {
+	return newExpr(itemAccessFormat, ref.variable, strconv.Quote(ExecCodeField))
+}
+
+// RecipeResultRef references the value returned by a request or wait instruction
+type RecipeResultRef struct {
+	variable string
+}
+
+func newRecipeResultRef(variable string) *RecipeResultRef {
+	return &RecipeResultRef{
+		variable: variable,
+	}
+}
+
+// GetField returns a field of the recipe result, like HttpBodyField or ExtractedField("key")
+func (ref *RecipeResultRef) GetField(field string) Expr {
+	return newExpr(itemAccessFormat, ref.variable, strconv.Quote(field))
+}
+
+// FilesArtifactRef references a files artifact created by the plan
+type FilesArtifactRef struct {
+	variable string
+}
+
+func newFilesArtifactRef(variable string) *FilesArtifactRef {
+	return &FilesArtifactRef{
+		variable: variable,
+	}
+}
+
+// GetName returns the artifact name, to be passed to ServiceConfig.WithFiles
+func (ref *FilesArtifactRef) GetName() Expr {
+	return newExpr("%s", ref.variable)
+}
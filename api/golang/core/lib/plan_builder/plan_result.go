@@ -0,0 +1,215 @@
+package plan_builder
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// ServiceInfo is the information of a service added by the plan
+type ServiceInfo struct {
+	name      string
+	hostname  string
+	ipAddress string
+	ports     map[string]*services.PortSpec
+}
+
+func (info *ServiceInfo) GetName() string {
+	return info.name
+}
+
+func (info *ServiceInfo) GetHostname() string {
+	return info.hostname
+}
+
+func (info *ServiceInfo) GetIpAddress() string {
+	return info.ipAddress
+}
+
+func (info *ServiceInfo) GetPorts() map[string]*services.PortSpec {
+	return info.ports
+}
+
+// ExecResult is the value returned by an exec instruction
+type ExecResult struct {
+	output string
+	code   int
+}
+
+func (result *ExecResult) GetOutput() string {
+	return result.output
+}
+
+func (result *ExecResult) GetCode() int {
+	return result.code
+}
+
+// RecipeResult is the value returned by a request or wait instruction. Fields are the ones of the recipe, like
+// HttpStatusCodeField, HttpBodyField or ExtractedField("key")
+type RecipeResult struct {
+	fields map[string]string
+}
+
+func (result *RecipeResult) GetField(field string) (string, bool) {
+	value, found := result.fields[field]
+	return value, found
+}
+
+func (result *RecipeResult) GetIntField(field string) (int, error) {
+	value, found := result.fields[field]
+	if !found {
+		return 0, stacktrace.NewError("Field '%s' isn't part of the recipe result", field)
+	}
+	intValue, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Field '%s' of the recipe result isn't an integer; its value is '%s'", field, value)
+	}
+	return intValue, nil
+}
+
+// PlanResult holds the values returned by a plan built with Plan
+type PlanResult struct {
+	services map[string]*ServiceInfo
+	values   map[string]json.RawMessage
+	outputs  map[string]json.RawMessage
+}
+
+type serializedPlanResult struct {
+	Services map[string]*serializedServiceInfo `json:"services"`
+	Values   map[string]json.RawMessage        `json:"values"`
+	Outputs  map[string]json.RawMessage        `json:"outputs"`
+}
+
+type serializedServiceInfo struct {
+	Name      string                         `json:"name"`
+	Hostname  string                         `json:"hostname"`
+	IpAddress string                         `json:"ip_address"`
+	Ports     map[string]*serializedPortInfo `json:"ports"`
+}
+
+type serializedPortInfo struct {
+	Number              uint16 `json:"number"`
+	TransportProtocol   string `json:"transport_protocol"`
+	ApplicationProtocol string `json:"application_protocol"`
+}
+
+// NewPlanResult parses the serialized output of a plan run
+func NewPlanResult(serializedOutput string) (*PlanResult, error) {
+	var serialized serializedPlanResult
+	if err := json.Unmarshal([]byte(serializedOutput), &serialized); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the plan output '%s'", serializedOutput)
+	}
+
+	serviceInfos := map[string]*ServiceInfo{}
+	for serviceName, serializedInfo := range serialized.Services {
+		if serializedInfo == nil {
+			return nil, stacktrace.NewError("The plan output doesn't contain the information of service '%s'", serviceName)
+		}
+		ports := map[string]*services.PortSpec{}
+		for portId, serializedPort := range serializedInfo.Ports {
+			if serializedPort == nil {
+				return nil, stacktrace.NewError("The plan output doesn't contain the information of port '%s' of service '%s'", portId, serviceName)
+			}
+			transportProtocol, found := kurtosis_core_rpc_api_bindings.Port_TransportProtocol_value[serializedPort.TransportProtocol]
+			if !found {
+				return nil, stacktrace.NewError("Port '%s' of service '%s' has unknown transport protocol '%s'", portId, serviceName, serializedPort.TransportProtocol)
+			}
+			ports[portId] = services.NewPortSpec(serializedPort.Number, services.TransportProtocol(transportProtocol), serializedPort.ApplicationProtocol)
+		}
+		serviceInfos[serviceName] = &ServiceInfo{
+			name:      serializedInfo.Name,
+			hostname:  serializedInfo.Hostname,
+			ipAddress: serializedInfo.IpAddress,
+			ports:     ports,
+		}
+	}
+	return &PlanResult{
+		services: serviceInfos,
+		values:   serialized.Values,
+		outputs:  serialized.Outputs,
+	}, nil
+}
+
+func (result *PlanResult) GetService(name string) (*ServiceInfo, bool) {
+	info, found := result.services[name]
+	return info, found
+}
+
+func (result *PlanResult) GetServices() map[string]*ServiceInfo {
+	return result.services
+}
+
+func (result *PlanResult) GetExecResult(ref *ExecRef) (*ExecResult, error) {
+	fields, err := result.getDictValue(ref.variable)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the exec result")
+	}
+	code, err := strconv.Atoi(fields[ExecCodeField])
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "The exec exit code '%s' isn't an integer", fields[ExecCodeField])
+	}
+	return &ExecResult{
+		output: fields[ExecOutputField],
+		code:   code,
+	}, nil
+}
+
+func (result *PlanResult) GetRecipeResult(ref *RecipeResultRef) (*RecipeResult, error) {
+	fields, err := result.getDictValue(ref.variable)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the recipe result")
+	}
+	return &RecipeResult{
+		fields: fields,
+	}, nil
+}
+
+func (result *PlanResult) GetFilesArtifactName(ref *FilesArtifactRef) (services.FileArtifactName, error) {
+	rawValue, found := result.values[ref.variable]
+	if !found {
+		return "", stacktrace.NewError("The plan output doesn't contain value '%s'", ref.variable)
+	}
+	var artifactName string
+	if err := json.Unmarshal(rawValue, &artifactName); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing the files artifact name '%s'", string(rawValue))
+	}
+	return services.FileArtifactName(artifactName), nil
+}
+
+// GetOutput unmarshals the custom output added with Plan.Output into target
+func (result *PlanResult) GetOutput(key string, target interface{}) error {
+	rawValue, found := result.outputs[key]
+	if !found {
+		return stacktrace.NewError("The plan output doesn't contain output '%s'", key)
+	}
+	if err := json.Unmarshal(rawValue, target); err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing output '%s' with value '%s'", key, string(rawValue))
+	}
+	return nil
+}
+
+// getDictValue returns the fields of a dict returned by an instruction, non-string values being serialized back to
+// JSON. Runtime values inserted in the output are always strings, even when they hold numbers
+func (result *PlanResult) getDictValue(variable string) (map[string]string, error) {
+	rawValue, found := result.values[variable]
+	if !found {
+		return nil, stacktrace.NewError("The plan output doesn't contain value '%s'", variable)
+	}
+	var rawFields map[string]json.RawMessage
+	if err := json.Unmarshal(rawValue, &rawFields); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing value '%s' as a dict", string(rawValue))
+	}
+	fields := map[string]string{}
+	for field, rawFieldValue := range rawFields {
+		var stringValue string
+		if err := json.Unmarshal(rawFieldValue, &stringValue); err == nil {
+			fields[field] = stringValue
+			continue
+		}
+		fields[field] = string(rawFieldValue)
+	}
+	return fields, nil
+}
@@ -0,0 +1,97 @@
+package plan_builder
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/stretchr/testify/require"
+)
+
+const serializedOutput = `{
+    "outputs": {
+        "api_port": 8080,
+        "tags": ["a", "b"]
+    },
+    "services": {
+        "api": {
+            "hostname": "api",
+            "ip_address": "172.16.0.4",
+            "name": "api",
+            "ports": {
+                "http": {
+                    "application_protocol": "http",
+                    "number": 8080,
+                    "transport_protocol": "TCP"
+                },
+                "metrics": {
+                    "application_protocol": "",
+                    "number": 9090,
+                    "transport_protocol": "UDP"
+                }
+            }
+        }
+    },
+    "values": {
+        "artifact_0": "config",
+        "exec_result_2": {
+            "code": "0",
+            "output": "first line\nsecond\tline with \"quotes\" and a \\ backslash\n"
+        },
+        "request_result_3": {
+            "body": "{\"status\": \"ok\"}",
+            "code": "200",
+            "extract.status": "ok"
+        }
+    }
+}`
+
+func TestNewPlanResult(t *testing.T) {
+	result, err := NewPlanResult(serializedOutput)
+	require.NoError(t, err)
+
+	api, found := result.GetService("api")
+	require.True(t, found)
+	require.Equal(t, "api", api.GetName())
+	require.Equal(t, "api", api.GetHostname())
+	require.Equal(t, "172.16.0.4", api.GetIpAddress())
+	require.Equal(t, services.NewPortSpec(8080, services.TransportProtocol_TCP, "http"), api.GetPorts()["http"])
+	require.Equal(t, services.NewPortSpec(9090, services.TransportProtocol_UDP, ""), api.GetPorts()["metrics"])
+	_, found = result.GetService("database")
+	require.False(t, found)
+
+	execResult, err := result.GetExecResult(newExecRef("exec_result_2"))
+	require.NoError(t, err)
+	require.Equal(t, 0, execResult.GetCode())
+	require.Equal(t, "first line\nsecond\tline with \"quotes\" and a \\ backslash\n", execResult.GetOutput())
+
+	requestResult, err := result.GetRecipeResult(newRecipeResultRef("request_result_3"))
+	require.NoError(t, err)
+	statusCode, err := requestResult.GetIntField(HttpStatusCodeField)
+	require.NoError(t, err)
+	require.Equal(t, 200, statusCode)
+	status, found := requestResult.GetField(ExtractedField("status"))
+	require.True(t, found)
+	require.Equal(t, "ok", status)
+	_, err = requestResult.GetIntField(HttpBodyField)
+	require.Error(t, err)
+
+	artifactName, err := result.GetFilesArtifactName(newFilesArtifactRef("artifact_0"))
+	require.NoError(t, err)
+	require.Equal(t, services.FileArtifactName("config"), artifactName)
+
+	var apiPort int
+	require.NoError(t, result.GetOutput("api_port", &apiPort))
+	require.Equal(t, 8080, apiPort)
+	var tags []string
+	require.NoError(t, result.GetOutput("tags", &tags))
+	require.Equal(t, []string{"a", "b"}, tags)
+	require.Error(t, result.GetOutput("missing", &tags))
+
+	_, err = result.GetExecResult(newExecRef("exec_result_9"))
+	require.Error(t, err)
+}
+
+func TestNewPlanResult_UnknownTransportProtocolFails(t *testing.T) {
+	_, err := NewPlanResult(`{"services": {"api": {"ports": {"http": {"number": 80, "transport_protocol": "QUIC"}}}}}`)
+	require.Error(t, err)
+}
@@ -0,0 +1,103 @@
+package plan_builder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender_FullPlan(t *testing.T) {
+	plan := NewPlan()
+	config := plan.RenderTemplates("config", map[string]*TemplateData{
+		"config.json": NewTemplateData(`{"greeting": "{{.Greeting}}"}`, map[string]interface{}{"Greeting": "hello"}),
+	})
+	readyCondition := NewReadyCondition(NewGetHttpRequestRecipe("http", "/health"), HttpStatusCodeField, Assertion_Equal, 200).
+		WithTimeout(30 * time.Second)
+	database := plan.AddService("database", NewServiceConfig("postgres:15").
+		WithPort("postgres", NewPortSpec(5432).WithApplicationProtocol("postgresql")).
+		WithEnvVar("POSTGRES_PASSWORD", "secret"))
+	api := plan.AddService("api", NewServiceConfig("api:latest").
+		WithPort("http", NewPortSpec(8080).WithApplicationProtocol("http").WithWaitTimeout(time.Minute)).
+		WithPort("metrics", NewPortSpec(9090).WithTransportProtocol(services.TransportProtocol_UDP).WithoutWait()).
+		WithFiles("/config", config.GetName()).
+		WithCmd("serve", "--db", database.GetIpAddress()).
+		WithReadyCondition(readyCondition).
+		WithMaxMemory(512))
+	execRef := plan.Exec("api", NewExecRecipe("echo", "hi"))
+	waitRef := plan.Wait("api", NewReadyCondition(NewExecRecipe("cat", "/ready"), ExecOutputField, Assertion_NotEqual, ""))
+	plan.Print(execRef.GetOutput())
+	plan.Output("api_port", api.GetPortNumber("http"))
+	plan.Output("ready", waitRef.GetField(ExecCodeField))
+
+	script, err := plan.Render()
+	require.NoError(t, err)
+	expectedScript := `def run(plan):
+    artifact_0 = plan.render_templates(config={"config.json": struct(template="{\"greeting\": \"{{.Greeting}}\"}", data={"Greeting": "hello"})}, name="config")
+    service_1 = plan.add_service(name="database", config=ServiceConfig(image="postgres:15", ports={"postgres": PortSpec(number=5432, application_protocol="postgresql")}, env_vars={"POSTGRES_PASSWORD": "secret"}))
+    service_2 = plan.add_service(name="api", config=ServiceConfig(image="api:latest", ports={"http": PortSpec(number=8080, application_protocol="http", wait="1m0s"), "metrics": PortSpec(number=9090, transport_protocol="UDP", wait=None)}, files={"/config": artifact_0}, cmd=["serve", "--db", service_1.ip_address], max_memory=512, ready_conditions=ReadyCondition(recipe=GetHttpRequestRecipe(port_id="http", endpoint="/health"), field="code", assertion="==", target_value=200, timeout="30s")))
+    exec_result_3 = plan.exec(service_name="api", recipe=ExecRecipe(command=["echo", "hi"]))
+    wait_result_4 = plan.wait(service_name="api", recipe=ExecRecipe(command=["cat", "/ready"]), field="output", assertion="!=", target_value="")
+    plan.print(msg=exec_result_3["output"])
+    return {"outputs": {"api_port": service_2.ports["http"].number, "ready": wait_result_4["code"]}, "services": {"api": {"hostname": service_2.hostname, "ip_address": service_2.ip_address, "name": service_2.name, "ports": {"http": {"application_protocol": service_2.ports["http"].application_protocol, "number": service_2.ports["http"].number, "transport_protocol": service_2.ports["http"].transport_protocol}, "metrics": {"application_protocol": service_2.ports["metrics"].application_protocol, "number": service_2.ports["metrics"].number, "transport_protocol": service_2.ports["metrics"].transport_protocol}}}, "database": {"hostname": service_1.hostname, "ip_address": service_1.ip_address, "name": service_1.name, "ports": {"postgres": {"application_protocol": service_1.ports["postgres"].application_protocol, "number": service_1.ports["postgres"].number, "transport_protocol": service_1.ports["postgres"].transport_protocol}}}}, "values": {"artifact_0": artifact_0, "exec_result_3": exec_result_3, "wait_result_4": wait_result_4}}
+`
+	require.Equal(t, expectedScript, script)
+}
+
+func TestRender_RemovedServiceIsNotInResult(t *testing.T) {
+	plan := NewPlan()
+	plan.AddService("tmp", NewServiceConfig("busybox"))
+	plan.RemoveService("tmp")
+
+	script, err := plan.Render()
+	require.NoError(t, err)
+	expectedScript := `def run(plan):
+    service_0 = plan.add_service(name="tmp", config=ServiceConfig(image="busybox"))
+    plan.remove_service(name="tmp")
+    return {"outputs": {}, "services": {}, "values": {}}
+`
+	require.Equal(t, expectedScript, script)
+}
+
+func TestRender_DuplicatedServiceFails(t *testing.T) {
+	plan := NewPlan()
+	plan.AddService("api", NewServiceConfig("api:latest"))
+	plan.AddService("api", NewServiceConfig("api:latest"))
+
+	_, err := plan.Render()
+	require.Error(t, err)
+}
+
+func TestRender_UnsupportedValueFails(t *testing.T) {
+	plan := NewPlan()
+	plan.AddService("api", NewServiceConfig("api:latest").WithEnvVar("CHANNEL", make(chan int)))
+
+	_, err := plan.Render()
+	require.Error(t, err)
+}
+
+func TestToStarlarkValue(t *testing.T) {
+	tests := map[string]struct {
+		value    interface{}
+		expected string
+	}{
+		"nil":           {value: nil, expected: "None"},
+		"bool":          {value: true, expected: "True"},
+		"int":           {value: -3, expected: "-3"},
+		"uint":          {value: uint16(8080), expected: "8080"},
+		"whole float":   {value: 2.0, expected: "2.0"},
+		"float":         {value: 0.5, expected: "0.5"},
+		"escaped":       {value: "a \"quoted\"\nline", expected: `"a \"quoted\"\nline"`},
+		"expr":          {value: newExpr("service.ip_address"), expected: "service.ip_address"},
+		"string slice":  {value: []string{"a", "b"}, expected: `["a", "b"]`},
+		"nested map":    {value: map[string]interface{}{"b": []int{1}, "a": map[string]bool{"x": false}}, expected: `{"a": {"x": False}, "b": [1]}`},
+		"named string":  {value: Assertion_In, expected: `"IN"`},
+		"nil map value": {value: map[string]*Expr{"a": nil}, expected: `{"a": None}`},
+	}
+	for name, test := range tests {
+		rendered, err := toStarlarkValue(test.value)
+		require.NoError(t, err, name)
+		require.Equal(t, test.expected, rendered, name)
+	}
+}
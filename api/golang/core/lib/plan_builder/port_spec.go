@@ -0,0 +1,78 @@
+package plan_builder
+
+import (
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+)
+
+const (
+	portSpecTypeName = "PortSpec"
+
+	portNumberArgName          = "number"
+	transportProtocolArgName   = "transport_protocol"
+	applicationProtocolArgName = "application_protocol"
+	portWaitArgName            = "wait"
+
+	noWaitTimeout = time.Duration(0)
+)
+
+// PortSpec builds a Starlark PortSpec
+type PortSpec struct {
+	number              uint16
+	transportProtocol   services.TransportProtocol
+	applicationProtocol string
+	waitTimeout         time.Duration
+	isWaitDisabled      bool
+}
+
+func NewPortSpec(number uint16) *PortSpec {
+	return &PortSpec{
+		number:              number,
+		transportProtocol:   services.TransportProtocol_TCP,
+		applicationProtocol: "",
+		waitTimeout:         noWaitTimeout,
+		isWaitDisabled:      false,
+	}
+}
+
+func (spec *PortSpec) WithTransportProtocol(transportProtocol services.TransportProtocol) *PortSpec {
+	spec.transportProtocol = transportProtocol
+	return spec
+}
+
+func (spec *PortSpec) WithApplicationProtocol(applicationProtocol string) *PortSpec {
+	spec.applicationProtocol = applicationProtocol
+	return spec
+}
+
+// WithWaitTimeout overrides how long Kurtosis waits for the port to open when the service starts
+func (spec *PortSpec) WithWaitTimeout(timeout time.Duration) *PortSpec {
+	spec.waitTimeout = timeout
+	spec.isWaitDisabled = false
+	return spec
+}
+
+// WithoutWait disables waiting for the port to open when the service starts
+func (spec *PortSpec) WithoutWait() *PortSpec {
+	spec.waitTimeout = noWaitTimeout
+	spec.isWaitDisabled = true
+	return spec
+}
+
+func (spec *PortSpec) toStarlark() (string, error) {
+	kwargs := newKwargsBuilder().add(portNumberArgName, spec.number)
+	if spec.transportProtocol != services.TransportProtocol_TCP {
+		kwargs.add(transportProtocolArgName, kurtosis_core_rpc_api_bindings.Port_TransportProtocol(spec.transportProtocol).String())
+	}
+	if spec.applicationProtocol != "" {
+		kwargs.add(applicationProtocolArgName, spec.applicationProtocol)
+	}
+	if spec.isWaitDisabled {
+		kwargs.add(portWaitArgName, nil)
+	} else if spec.waitTimeout != noWaitTimeout {
+		kwargs.add(portWaitArgName, spec.waitTimeout.String())
+	}
+	return renderCall(portSpecTypeName, kwargs)
+}
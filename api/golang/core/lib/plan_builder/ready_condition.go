@@ -0,0 +1,83 @@
+package plan_builder
+
+import (
+	"time"
+)
+
+const (
+	readyConditionTypeName = "ReadyCondition"
+
+	recipeArgName    = "recipe"
+	fieldArgName     = "field"
+	assertionArgName = "assertion"
+	targetArgName    = "target_value"
+	intervalArgName  = "interval"
+	timeoutArgName   = "timeout"
+
+	noInterval = time.Duration(0)
+	noTimeout  = time.Duration(0)
+)
+
+type Assertion string
+
+const (
+	Assertion_Equal          Assertion = "=="
+	Assertion_NotEqual       Assertion = "!="
+	Assertion_Greater        Assertion = ">"
+	Assertion_GreaterOrEqual Assertion = ">="
+	Assertion_Less           Assertion = "<"
+	Assertion_LessOrEqual    Assertion = "<="
+	Assertion_In             Assertion = "IN"
+	Assertion_NotIn          Assertion = "NOT_IN"
+)
+
+// ReadyCondition builds a Starlark ReadyCondition. The same condition is used by Plan.Wait
+type ReadyCondition struct {
+	recipe    Recipe
+	field     string
+	assertion Assertion
+	target    interface{}
+	interval  time.Duration
+	timeout   time.Duration
+}
+
+func NewReadyCondition(recipe Recipe, field string, assertion Assertion, target interface{}) *ReadyCondition {
+	return &ReadyCondition{
+		recipe:    recipe,
+		field:     field,
+		assertion: assertion,
+		target:    target,
+		interval:  noInterval,
+		timeout:   noTimeout,
+	}
+}
+
+func (condition *ReadyCondition) WithInterval(interval time.Duration) *ReadyCondition {
+	condition.interval = interval
+	return condition
+}
+
+func (condition *ReadyCondition) WithTimeout(timeout time.Duration) *ReadyCondition {
+	condition.timeout = timeout
+	return condition
+}
+
+func (condition *ReadyCondition) toStarlark() (string, error) {
+	return renderCall(readyConditionTypeName, condition.kwargs())
+}
+
+// kwargs returns the arguments shared by ReadyCondition and plan.wait
+func (condition *ReadyCondition) kwargs() *kwargsBuilder {
+	kwargs := newKwargsBuilder().
+		addRenderer(recipeArgName, condition.recipe).
+		add(fieldArgName, condition.field).
+		add(assertionArgName, string(condition.assertion)).
+		add(targetArgName, condition.target)
+	if condition.interval != noInterval {
+		kwargs.add(intervalArgName, condition.interval.String())
+	}
+	if condition.timeout != noTimeout {
+		kwargs.add(timeoutArgName, condition.timeout.String())
+	}
+	return kwargs
+}
@@ -0,0 +1,155 @@
+package plan_builder
+
+const (
+	execRecipeTypeName            = "ExecRecipe"
+	getHttpRequestRecipeTypeName  = "GetHttpRequestRecipe"
+	postHttpRequestRecipeTypeName = "PostHttpRequestRecipe"
+
+	commandArgName     = "command"
+	extractArgName     = "extract"
+	portIdArgName      = "port_id"
+	endpointArgName    = "endpoint"
+	headersArgName     = "headers"
+	contentTypeArgName = "content_type"
+	bodyArgName        = "body"
+
+	// Fields of the values returned by recipes
+	ExecOutputField     = "output"
+	ExecCodeField       = "code"
+	HttpStatusCodeField = "code"
+	HttpBodyField       = "body"
+
+	extractedFieldPrefix = "extract."
+)
+
+// Recipe is implemented by ExecRecipe, GetHttpRequestRecipe and PostHttpRequestRecipe
+type Recipe interface {
+	starlarkRenderer
+
+	isRecipe()
+}
+
+// ExtractedField returns the name of the field a recipe stores the value extracted under the given key in
+func ExtractedField(key string) string {
+	return extractedFieldPrefix + key
+}
+
+// ExecRecipe builds a Starlark ExecRecipe
+type ExecRecipe struct {
+	command []interface{}
+	extract map[string]string
+}
+
+// NewExecRecipe creates a recipe running the given command. Arguments may be strings or Exprs
+func NewExecRecipe(command ...interface{}) *ExecRecipe {
+	return &ExecRecipe{
+		command: command,
+		extract: map[string]string{},
+	}
+}
+
+// WithExtract extracts a value from the command output using a jq query
+func (recipe *ExecRecipe) WithExtract(key string, jqQuery string) *ExecRecipe {
+	recipe.extract[key] = jqQuery
+	return recipe
+}
+
+func (recipe *ExecRecipe) toStarlark() (string, error) {
+	kwargs := newKwargsBuilder().add(commandArgName, recipe.command)
+	if len(recipe.extract) > 0 {
+		kwargs.add(extractArgName, recipe.extract)
+	}
+	return renderCall(execRecipeTypeName, kwargs)
+}
+
+func (recipe *ExecRecipe) isRecipe() {}
+
+// GetHttpRequestRecipe builds a Starlark GetHttpRequestRecipe
+type GetHttpRequestRecipe struct {
+	portId   string
+	endpoint interface{}
+	headers  map[string]interface{}
+	extract  map[string]string
+}
+
+func NewGetHttpRequestRecipe(portId string, endpoint interface{}) *GetHttpRequestRecipe {
+	return &GetHttpRequestRecipe{
+		portId:   portId,
+		endpoint: endpoint,
+		headers:  map[string]interface{}{},
+		extract:  map[string]string{},
+	}
+}
+
+func (recipe *GetHttpRequestRecipe) WithHeader(name string, value interface{}) *GetHttpRequestRecipe {
+	recipe.headers[name] = value
+	return recipe
+}
+
+// WithExtract extracts a value from the response body using a jq query
+func (recipe *GetHttpRequestRecipe) WithExtract(key string, jqQuery string) *GetHttpRequestRecipe {
+	recipe.extract[key] = jqQuery
+	return recipe
+}
+
+func (recipe *GetHttpRequestRecipe) toStarlark() (string, error) {
+	kwargs := newKwargsBuilder().add(portIdArgName, recipe.portId).add(endpointArgName, recipe.endpoint)
+	if len(recipe.headers) > 0 {
+		kwargs.add(headersArgName, recipe.headers)
+	}
+	if len(recipe.extract) > 0 {
+		kwargs.add(extractArgName, recipe.extract)
+	}
+	return renderCall(getHttpRequestRecipeTypeName, kwargs)
+}
+
+func (recipe *GetHttpRequestRecipe) isRecipe() {}
+
+// PostHttpRequestRecipe builds a Starlark PostHttpRequestRecipe
+type PostHttpRequestRecipe struct {
+	portId      string
+	endpoint    interface{}
+	contentType string
+	body        interface{}
+	headers     map[string]interface{}
+	extract     map[string]string
+}
+
+func NewPostHttpRequestRecipe(portId string, endpoint interface{}, contentType string, body interface{}) *PostHttpRequestRecipe {
+	return &PostHttpRequestRecipe{
+		portId:      portId,
+		endpoint:    endpoint,
+		contentType: contentType,
+		body:        body,
+		headers:     map[string]interface{}{},
+		extract:     map[string]string{},
+	}
+}
+
+func (recipe *PostHttpRequestRecipe) WithHeader(name string, value interface{}) *PostHttpRequestRecipe {
+	recipe.headers[name] = value
+	return recipe
+}
+
+// WithExtract extracts a value from the response body using a jq query
+func (recipe *PostHttpRequestRecipe) WithExtract(key string, jqQuery string) *PostHttpRequestRecipe {
+	recipe.extract[key] = jqQuery
+	return recipe
+}
+
+func (recipe *PostHttpRequestRecipe) toStarlark() (string, error) {
+	kwargs := newKwargsBuilder().add(portIdArgName, recipe.portId).add(endpointArgName, recipe.endpoint)
+	if recipe.contentType != "" {
+		kwargs.add(contentTypeArgName, recipe.contentType)
+	}
+	kwargs.add(bodyArgName, recipe.body)
+	if len(recipe.headers) > 0 {
+		kwargs.add(headersArgName, recipe.headers)
+	}
+	if len(recipe.extract) > 0 {
+		kwargs.add(extractArgName, recipe.extract)
+	}
+	return renderCall(postHttpRequestRecipeTypeName, kwargs)
+}
+
+func (recipe *PostHttpRequestRecipe) isRecipe() {}
@@ -0,0 +1,32 @@
+package plan_builder
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Run renders the plan and runs it in the enclave as a Starlark script, blocking until it finishes. The raw run result
+// is returned alongside the parsed one so that callers can inspect instructions and errors
+func Run(
+	ctx context.Context,
+	enclaveCtx *enclaves.EnclaveContext,
+	plan *Plan,
+	runConfig *starlark_run_config.StarlarkRunConfig,
+) (*PlanResult, *enclaves.StarlarkRunResult, error) {
+	script, err := plan.Render()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred rendering the plan")
+	}
+	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, script, runConfig)
+	if err != nil {
+		return nil, runResult, stacktrace.Propagate(err, "An error occurred running the plan script:\n%s", script)
+	}
+	planResult, err := NewPlanResult(runResult.SerializedOutput)
+	if err != nil {
+		return nil, runResult, stacktrace.Propagate(err, "An error occurred parsing the plan result")
+	}
+	return planResult, runResult, nil
+}
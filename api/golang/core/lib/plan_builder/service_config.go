@@ -0,0 +1,186 @@
+package plan_builder
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	serviceConfigTypeName = "ServiceConfig"
+
+	imageArgName                       = "image"
+	portsArgName                       = "ports"
+	filesArgName                       = "files"
+	entrypointArgName                  = "entrypoint"
+	cmdArgName                         = "cmd"
+	envVarsArgName                     = "env_vars"
+	privateIpAddressPlaceholderArgName = "private_ip_address_placeholder"
+	maxCpuArgName                      = "max_cpu"
+	maxMemoryArgName                   = "max_memory"
+	minCpuArgName                      = "min_cpu"
+	minMemoryArgName                   = "min_memory"
+	readyConditionsArgName             = "ready_conditions"
+	labelsArgName                      = "labels"
+	nodeSelectorsArgName               = "node_selectors"
+
+	unsetResource = uint64(0)
+)
+
+// ServiceConfig builds a Starlark ServiceConfig
+type ServiceConfig struct {
+	image                       string
+	ports                       map[string]*PortSpec
+	files                       map[string]interface{}
+	entrypoint                  []interface{}
+	cmd                         []interface{}
+	envVars                     map[string]interface{}
+	privateIpAddressPlaceholder string
+	maxCpuMilliCores            uint64
+	maxMemoryMegaBytes          uint64
+	minCpuMilliCores            uint64
+	minMemoryMegaBytes          uint64
+	readyCondition              *ReadyCondition
+	labels                      map[string]string
+	nodeSelectors               map[string]string
+}
+
+func NewServiceConfig(image string) *ServiceConfig {
+	return &ServiceConfig{
+		image:                       image,
+		ports:                       map[string]*PortSpec{},
+		files:                       map[string]interface{}{},
+		entrypoint:                  nil,
+		cmd:                         nil,
+		envVars:                     map[string]interface{}{},
+		privateIpAddressPlaceholder: "",
+		maxCpuMilliCores:            unsetResource,
+		maxMemoryMegaBytes:          unsetResource,
+		minCpuMilliCores:            unsetResource,
+		minMemoryMegaBytes:          unsetResource,
+		readyCondition:              nil,
+		labels:                      map[string]string{},
+		nodeSelectors:               map[string]string{},
+	}
+}
+
+func (config *ServiceConfig) WithPort(portId string, portSpec *PortSpec) *ServiceConfig {
+	config.ports[portId] = portSpec
+	return config
+}
+
+// WithFiles mounts a files artifact at the given path. The artifact name may be a string or an Expr, like the one
+// returned by FilesArtifactRef.GetName
+func (config *ServiceConfig) WithFiles(mountPath string, artifactName interface{}) *ServiceConfig {
+	config.files[mountPath] = artifactName
+	return config
+}
+
+// WithEntrypoint overrides the image entrypoint. Arguments may be strings or Exprs
+func (config *ServiceConfig) WithEntrypoint(entrypoint ...interface{}) *ServiceConfig {
+	config.entrypoint = entrypoint
+	return config
+}
+
+// WithCmd overrides the image cmd. Arguments may be strings or Exprs
+func (config *ServiceConfig) WithCmd(cmd ...interface{}) *ServiceConfig {
+	config.cmd = cmd
+	return config
+}
+
+// WithEnvVar sets an environment variable. The value may be a string or an Expr
+func (config *ServiceConfig) WithEnvVar(name string, value interface{}) *ServiceConfig {
+	config.envVars[name] = value
+	return config
+}
+
+func (config *ServiceConfig) WithPrivateIpAddressPlaceholder(placeholder string) *ServiceConfig {
+	config.privateIpAddressPlaceholder = placeholder
+	return config
+}
+
+func (config *ServiceConfig) WithMaxCpu(milliCores uint64) *ServiceConfig {
+	config.maxCpuMilliCores = milliCores
+	return config
+}
+
+func (config *ServiceConfig) WithMaxMemory(megaBytes uint64) *ServiceConfig {
+	config.maxMemoryMegaBytes = megaBytes
+	return config
+}
+
+func (config *ServiceConfig) WithMinCpu(milliCores uint64) *ServiceConfig {
+	config.minCpuMilliCores = milliCores
+	return config
+}
+
+func (config *ServiceConfig) WithMinMemory(megaBytes uint64) *ServiceConfig {
+	config.minMemoryMegaBytes = megaBytes
+	return config
+}
+
+func (config *ServiceConfig) WithReadyCondition(condition *ReadyCondition) *ServiceConfig {
+	config.readyCondition = condition
+	return config
+}
+
+func (config *ServiceConfig) WithLabel(key string, value string) *ServiceConfig {
+	config.labels[key] = value
+	return config
+}
+
+func (config *ServiceConfig) WithNodeSelector(key string, value string) *ServiceConfig {
+	config.nodeSelectors[key] = value
+	return config
+}
+
+func (config *ServiceConfig) toStarlark() (string, error) {
+	renderedPorts := map[string]string{}
+	for portId, portSpec := range config.ports {
+		renderedPort, err := portSpec.toStarlark()
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred rendering port '%s'", portId)
+		}
+		renderedPorts[portId] = renderedPort
+	}
+
+	kwargs := newKwargsBuilder().add(imageArgName, config.image)
+	if len(renderedPorts) > 0 {
+		kwargs.addRendered(portsArgName, renderDict(renderedPorts))
+	}
+	if len(config.files) > 0 {
+		kwargs.add(filesArgName, config.files)
+	}
+	if config.entrypoint != nil {
+		kwargs.add(entrypointArgName, config.entrypoint)
+	}
+	if config.cmd != nil {
+		kwargs.add(cmdArgName, config.cmd)
+	}
+	if len(config.envVars) > 0 {
+		kwargs.add(envVarsArgName, config.envVars)
+	}
+	if config.privateIpAddressPlaceholder != "" {
+		kwargs.add(privateIpAddressPlaceholderArgName, config.privateIpAddressPlaceholder)
+	}
+	if config.maxCpuMilliCores != unsetResource {
+		kwargs.add(maxCpuArgName, config.maxCpuMilliCores)
+	}
+	if config.maxMemoryMegaBytes != unsetResource {
+		kwargs.add(maxMemoryArgName, config.maxMemoryMegaBytes)
+	}
+	if config.minCpuMilliCores != unsetResource {
+		kwargs.add(minCpuArgName, config.minCpuMilliCores)
+	}
+	if config.minMemoryMegaBytes != unsetResource {
+		kwargs.add(minMemoryArgName, config.minMemoryMegaBytes)
+	}
+	if config.readyCondition != nil {
+		kwargs.addRenderer(readyConditionsArgName, config.readyCondition)
+	}
+	if len(config.labels) > 0 {
+		kwargs.add(labelsArgName, config.labels)
+	}
+	if len(config.nodeSelectors) > 0 {
+		kwargs.add(nodeSelectorsArgName, config.nodeSelectors)
+	}
+	return renderCall(serviceConfigTypeName, kwargs)
+}
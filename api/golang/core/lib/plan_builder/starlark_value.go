@@ -0,0 +1,192 @@
+package plan_builder
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	starlarkNone  = "None"
+	starlarkTrue  = "True"
+	starlarkFalse = "False"
+
+	starlarkListSeparator = ", "
+
+	floatFormat            = 'g'
+	floatShortestPrecision = -1
+	floatBitSize           = 64
+	floatDecimalChars      = ".eE"
+	floatDecimalSuffix     = ".0"
+)
+
+// Expr is a Starlark expression whose value is only known once the plan runs, like the IP address of a service added
+// earlier in the plan or the output of an exec. It can be passed anywhere the builder accepts a value
+type Expr struct {
+	starlark string
+}
+
+func newExpr(format string, args ...interface{}) Expr {
+	return Expr{
+		starlark: fmt.Sprintf(format, args...),
+	}
+}
+
+// String returns the Starlark source of the expression
+func (expr Expr) String() string {
+	return expr.starlark
+}
+
+// toStarlarkValue renders a Go value as a Starlark literal. Strings, booleans, numbers, nil, Exprs and slices or
+// string-keyed maps of those are supported. Map keys are sorted so the generated source is stable
+func toStarlarkValue(value interface{}) (string, error) {
+	switch typedValue := value.(type) {
+	case nil:
+		return starlarkNone, nil
+	case Expr:
+		return typedValue.starlark, nil
+	case *Expr:
+		if typedValue == nil {
+			return starlarkNone, nil
+		}
+		return typedValue.starlark, nil
+	case string:
+		return strconv.Quote(typedValue), nil
+	case bool:
+		if typedValue {
+			return starlarkTrue, nil
+		}
+		return starlarkFalse, nil
+	case float32:
+		return floatToStarlark(float64(typedValue))
+	case float64:
+		return floatToStarlark(typedValue)
+	}
+
+	reflectValue := reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(reflectValue.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(reflectValue.Uint(), 10), nil
+	case reflect.String:
+		return strconv.Quote(reflectValue.String()), nil
+	case reflect.Slice, reflect.Array:
+		renderedItems := []string{}
+		for idx := 0; idx < reflectValue.Len(); idx++ {
+			renderedItem, err := toStarlarkValue(reflectValue.Index(idx).Interface())
+			if err != nil {
+				return "", stacktrace.Propagate(err, "An error occurred rendering item at index '%d' of the list", idx)
+			}
+			renderedItems = append(renderedItems, renderedItem)
+		}
+		return fmt.Sprintf("[%s]", strings.Join(renderedItems, starlarkListSeparator)), nil
+	case reflect.Map:
+		if reflectValue.Type().Key().Kind() != reflect.String {
+			return "", stacktrace.NewError("Only maps with string keys can be rendered as Starlark dicts, got a map of type '%v'", reflectValue.Type())
+		}
+		renderedEntries := map[string]string{}
+		iterator := reflectValue.MapRange()
+		for iterator.Next() {
+			key := iterator.Key().String()
+			renderedItem, err := toStarlarkValue(iterator.Value().Interface())
+			if err != nil {
+				return "", stacktrace.Propagate(err, "An error occurred rendering value for key '%s' of the dict", key)
+			}
+			renderedEntries[key] = renderedItem
+		}
+		return renderDict(renderedEntries), nil
+	case reflect.Ptr:
+		if reflectValue.IsNil() {
+			return starlarkNone, nil
+		}
+		return toStarlarkValue(reflectValue.Elem().Interface())
+	default:
+		return "", stacktrace.NewError("Value '%v' of type '%T' can't be rendered as a Starlark value", value, value)
+	}
+}
+
+func floatToStarlark(value float64) (string, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return "", stacktrace.NewError("Float value '%v' can't be rendered as a Starlark literal", value)
+	}
+	rendered := strconv.FormatFloat(value, floatFormat, floatShortestPrecision, floatBitSize)
+	if !strings.ContainsAny(rendered, floatDecimalChars) {
+		rendered = rendered + floatDecimalSuffix
+	}
+	return rendered, nil
+}
+
+// renderDict renders already rendered values as a Starlark dict with sorted keys
+func renderDict(renderedEntries map[string]string) string {
+	keys := make([]string, 0, len(renderedEntries))
+	for key := range renderedEntries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	renderedItems := make([]string, 0, len(keys))
+	for _, key := range keys {
+		renderedItems = append(renderedItems, fmt.Sprintf("%s: %s", strconv.Quote(key), renderedEntries[key]))
+	}
+	return fmt.Sprintf("{%s}", strings.Join(renderedItems, starlarkListSeparator))
+}
+
+// renderCall renders a call to a Starlark function with keyword arguments, in the order they were given
+func renderCall(function string, kwargs *kwargsBuilder) (string, error) {
+	if kwargs.err != nil {
+		return "", stacktrace.Propagate(kwargs.err, "An error occurred rendering the arguments of '%s'", function)
+	}
+	return fmt.Sprintf("%s(%s)", function, strings.Join(kwargs.rendered, starlarkListSeparator)), nil
+}
+
+// kwargsBuilder accumulates rendered keyword arguments, keeping the first rendering error
+type kwargsBuilder struct {
+	rendered []string
+	err      error
+}
+
+func newKwargsBuilder() *kwargsBuilder {
+	return &kwargsBuilder{
+		rendered: []string{},
+		err:      nil,
+	}
+}
+
+func (builder *kwargsBuilder) add(name string, value interface{}) *kwargsBuilder {
+	if builder.err != nil {
+		return builder
+	}
+	renderedValue, err := toStarlarkValue(value)
+	if err != nil {
+		builder.err = stacktrace.Propagate(err, "An error occurred rendering argument '%s'", name)
+		return builder
+	}
+	return builder.addRendered(name, renderedValue)
+}
+
+func (builder *kwargsBuilder) addRendered(name string, renderedValue string) *kwargsBuilder {
+	builder.rendered = append(builder.rendered, fmt.Sprintf("%s=%s", name, renderedValue))
+	return builder
+}
+
+func (builder *kwargsBuilder) addRenderer(name string, renderer starlarkRenderer) *kwargsBuilder {
+	if builder.err != nil {
+		return builder
+	}
+	renderedValue, err := renderer.toStarlark()
+	if err != nil {
+		builder.err = stacktrace.Propagate(err, "An error occurred rendering argument '%s'", name)
+		return builder
+	}
+	return builder.addRendered(name, renderedValue)
+}
+
+// starlarkRenderer is implemented by all the typed builders of this package
+type starlarkRenderer interface {
+	toStarlark() (string, error)
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"io"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	execCommandStdinChunkSizeBytes = 32 * 1024
+)
+
+type TerminalSize struct {
+	Width  uint32
+	Height uint32
+}
+
+// ExecCommandStreams wires an interactive exec command to the caller's STDIN, STDOUT and STDERR
+type ExecCommandStreams struct {
+	// If nil, the command gets no STDIN
+	stdin  io.Reader
+	stdout io.Writer
+	// Unused when a TTY is allocated, as the TTY merges STDERR into STDOUT
+	stderr io.Writer
+
+	tty bool
+	// Only used when a TTY is allocated; if nil the backend default size is used
+	initialTerminalSize *TerminalSize
+	// Only used when a TTY is allocated; each size sent resizes the TTY
+	terminalSizes <-chan *TerminalSize
+}
+
+func NewExecCommandStreams(stdin io.Reader, stdout io.Writer, stderr io.Writer) *ExecCommandStreams {
+	return &ExecCommandStreams{
+		stdin:               stdin,
+		stdout:              stdout,
+		stderr:              stderr,
+		tty:                 false,
+		initialTerminalSize: nil,
+		terminalSizes:       nil,
+	}
+}
+
+func NewTtyExecCommandStreams(stdin io.Reader, stdout io.Writer, initialTerminalSize *TerminalSize, terminalSizes <-chan *TerminalSize) *ExecCommandStreams {
+	return &ExecCommandStreams{
+		stdin:               stdin,
+		stdout:              stdout,
+		stderr:              io.Discard,
+		tty:                 true,
+		initialTerminalSize: initialTerminalSize,
+		terminalSizes:       terminalSizes,
+	}
+}
+
+// execCommandStreamSender serializes the requests sent on the exec command stream, as gRPC streams don't support
+// concurrent sends
+type execCommandStreamSender struct {
+	mutex  *sync.Mutex
+	stream kurtosis_core_rpc_api_bindings.ApiContainerService_ExecCommandStreamClient
+}
+
+func (sender *execCommandStreamSender) send(request *kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest) error {
+	sender.mutex.Lock()
+	defer sender.mutex.Unlock()
+	return sender.stream.Send(request)
+}
+
+func (sender *execCommandStreamSender) forwardStdin(stdin io.Reader) {
+	buffer := make([]byte, execCommandStdinChunkSizeBytes)
+	for {
+		numBytesRead, readErr := stdin.Read(buffer)
+		if numBytesRead > 0 {
+			chunk := make([]byte, numBytesRead)
+			copy(chunk, buffer[:numBytesRead])
+			if err := sender.send(binding_constructors.NewExecCommandStreamStdinRequest(chunk)); err != nil {
+				return
+			}
+		}
+		if readErr != nil {
+			// the stream may already be over, in which case there's nobody left to tell STDIN is closed
+			_ = sender.send(binding_constructors.NewExecCommandStreamCloseStdinRequest())
+			return
+		}
+	}
+}
+
+func (sender *execCommandStreamSender) forwardTerminalSizes(terminalSizes <-chan *TerminalSize, done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case size, isOpen := <-terminalSizes:
+			if !isOpen {
+				return
+			}
+			if err := sender.send(binding_constructors.NewExecCommandStreamResizeRequest(binding_constructors.NewTerminalSize(size.Width, size.Height))); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func receiveExecCommandStreamOutput(stream kurtosis_core_rpc_api_bindings.ApiContainerService_ExecCommandStreamClient, stdout io.Writer, stderr io.Writer) (int32, error) {
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return 0, stacktrace.NewError("The exec command stream ended without returning the command exit code")
+		}
+		if err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred receiving the exec command output")
+		}
+		switch typedResponse := response.GetResponse().(type) {
+		case *kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse_Stdout:
+			if _, err := stdout.Write(typedResponse.Stdout); err != nil {
+				return 0, stacktrace.Propagate(err, "An error occurred writing the exec command STDOUT")
+			}
+		case *kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse_Stderr:
+			if _, err := stderr.Write(typedResponse.Stderr); err != nil {
+				return 0, stacktrace.Propagate(err, "An error occurred writing the exec command STDERR")
+			}
+		case *kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse_ExitCode:
+			return typedResponse.ExitCode, nil
+		default:
+			return 0, stacktrace.NewError("Received unknown exec command stream response of type '%T'", typedResponse)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/stacktrace"
+	"sync"
 )
 
 // Docs available at https://docs.kurtosis.com/sdk/#servicecontext
@@ -37,6 +38,9 @@ type ServiceContext struct {
 	// Network location outside the enclave
 	publicIpAddr string
 	publicPorts  map[string]*PortSpec
+
+	// URLs outside the cluster of the HTTP ports, by port ID; only reported by Kubernetes clusters exposing the services
+	publicUrls map[string]string
 }
 
 func NewServiceContext(
@@ -47,6 +51,7 @@ func NewServiceContext(
 	privatePorts map[string]*PortSpec,
 	publicIpAddr string,
 	publicPorts map[string]*PortSpec,
+	publicUrls map[string]string,
 ) *ServiceContext {
 	return &ServiceContext{
 		client:        client,
@@ -56,6 +61,7 @@ func NewServiceContext(
 		privatePorts:  privatePorts,
 		publicIpAddr:  publicIpAddr,
 		publicPorts:   publicPorts,
+		publicUrls:    publicUrls,
 	}
 }
 
@@ -89,6 +95,11 @@ func (service *ServiceContext) GetPublicPorts() map[string]*PortSpec {
 	return service.publicPorts
 }
 
+// GetPublicUrls returns the URLs where the HTTP ports of the service are reachable outside the cluster, by port ID
+func (service *ServiceContext) GetPublicUrls() map[string]string {
+	return service.publicUrls
+}
+
 // Docs available at https://docs.kurtosis.com/sdk/#execcommandliststring-command---int-exitcode-string-logs
 func (service *ServiceContext) ExecCommand(command []string) (int32, string, error) {
 	serviceName := service.serviceName
@@ -103,3 +114,45 @@ func (service *ServiceContext) ExecCommand(command []string) (int32, string, err
 	}
 	return resp.ExitCode, resp.LogOutput, nil
 }
+
+// ExecCommandStream runs the command against the service, streaming its STDIN and output as it runs, and returns its
+// exit code. Reading from a STDIN that never ends (e.g. os.Stdin) keeps going in the background after the command exits.
+// Docs available at https://docs.kurtosis.com/sdk/#execcommandstreamliststring-command-execcommandstreams-streams---int-exitcode
+func (service *ServiceContext) ExecCommandStream(ctx context.Context, command []string, streams *ExecCommandStreams) (int32, error) {
+	serviceName := service.serviceName
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := service.client.ExecCommandStream(ctx)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred opening the exec command stream to service '%v'", serviceName)
+	}
+	sender := &execCommandStreamSender{
+		mutex:  &sync.Mutex{},
+		stream: stream,
+	}
+
+	var initialTerminalSize *kurtosis_core_rpc_api_bindings.TerminalSize
+	if streams.initialTerminalSize != nil {
+		initialTerminalSize = binding_constructors.NewTerminalSize(streams.initialTerminalSize.Width, streams.initialTerminalSize.Height)
+	}
+	startRequest := binding_constructors.NewExecCommandStreamStartRequest(string(serviceName), command, streams.tty, initialTerminalSize)
+	if err = sender.send(startRequest); err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred starting command '%v' on service '%v'", command, serviceName)
+	}
+
+	if streams.stdin != nil {
+		go sender.forwardStdin(streams.stdin)
+	} else if err = sender.send(binding_constructors.NewExecCommandStreamCloseStdinRequest()); err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred closing the STDIN of command '%v' on service '%v'", command, serviceName)
+	}
+	if streams.tty && streams.terminalSizes != nil {
+		go sender.forwardTerminalSizes(streams.terminalSizes, ctx.Done())
+	}
+
+	exitCode, err := receiveExecCommandStreamOutput(stream, streams.stdout, streams.stderr)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred executing command '%v' on service '%v'", command, serviceName)
+	}
+	return exitCode, nil
+}
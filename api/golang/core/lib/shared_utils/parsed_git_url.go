@@ -5,6 +5,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"net/url"
 	"path"
+	"regexp"
 	"strings"
 )
 
@@ -22,17 +23,29 @@ const (
 	packageRootPrefixIndicatorInRelativeLocators = "/"
 	substrNotPresent                             = -1
 	extensionCharacter                           = "."
+
+	// a git host is told apart from the first directory of a relative locator, or from a GitHub author, by the dot of
+	// its domain name or by its port; 'localhost' is the only host accepted without either
+	hostDomainSeparator = "."
+	hostPortSeparator   = ":"
+	localhostHostname   = "localhost"
 )
 
+// a domain name or an IPv4 address, optionally followed by a port
+var gitHostRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*(:[0-9]{1,5})?$`)
+
 // ParsedGitURL an object representing a parsed moduleURL
 type ParsedGitURL struct {
+	// host the git host serving the repository, e.g. github.com or gitlab.com
+	host string
 	// repositoryAuthor the git of the module (GitHub user or org)
 	repositoryAuthor string
 	// repositoryName the name of the module
 	repositoryName string
 	// gitURL the url ending with `.git` where the module lives
 	gitURL string
-	// relativeRepoPath the relative path to the repo this would be repositoryAuthor/repositoryName/
+	// relativeRepoPath the relative path to the repo this would be repositoryAuthor/repositoryName/ for GitHub
+	// and host/repositoryAuthor/repositoryName/ for any other host
 	relativeRepoPath string
 	// relativeFilePath the full path of the file relative to the module store relativeRepoPath/path/to/file.star
 	// empty if there is no file
@@ -43,8 +56,9 @@ type ParsedGitURL struct {
 	tagBranchOrCommit string
 }
 
-func newParsedGitURL(moduleAuthor, moduleName, gitURL, relativeRepoPath, relativeFilePath string, tagBranchOrCommit string) *ParsedGitURL {
+func newParsedGitURL(host, moduleAuthor, moduleName, gitURL, relativeRepoPath, relativeFilePath string, tagBranchOrCommit string) *ParsedGitURL {
 	return &ParsedGitURL{
+		host:              host,
 		repositoryAuthor:  moduleAuthor,
 		repositoryName:    moduleName,
 		gitURL:            gitURL,
@@ -54,6 +68,10 @@ func newParsedGitURL(moduleAuthor, moduleName, gitURL, relativeRepoPath, relativ
 	}
 }
 
+func (parsedUrl *ParsedGitURL) GetHost() string {
+	return parsedUrl.host
+}
+
 func (parsedUrl *ParsedGitURL) GetRepositoryAuthor() string {
 	return parsedUrl.repositoryAuthor
 }
@@ -78,23 +96,42 @@ func (parsedUrl *ParsedGitURL) GetTagBranchOrCommit() string {
 	return parsedUrl.tagBranchOrCommit
 }
 
+// GetRepositoryLocator returns the locator of the repository, e.g. github.com/author/repositoryName
+func (parsedUrl *ParsedGitURL) GetRepositoryLocator() string {
+	return path.Join(parsedUrl.host, parsedUrl.repositoryAuthor, parsedUrl.repositoryName)
+}
+
 func (parsedUrl *ParsedGitURL) GetAbsoluteLocatorRelativeToThisURL(relativeUrl string) string {
 	if strings.HasPrefix(relativeUrl, packageRootPrefixIndicatorInRelativeLocators) {
-		return path.Join(GithubDomainPrefix, parsedUrl.relativeRepoPath, relativeUrl)
+		return GetLocatorFromRelativePath(path.Join(parsedUrl.relativeRepoPath, relativeUrl))
+	}
+	return GetLocatorFromRelativePath(path.Join(path.Dir(parsedUrl.relativeFilePath), relativeUrl))
+}
+
+// GetLocatorFromRelativePath is the reverse of the relative repo and file paths of a ParsedGitURL; it adds back the
+// host GitHub paths are stored without
+func GetLocatorFromRelativePath(relativePath string) string {
+	relativePath = strings.TrimPrefix(relativePath, UrlPathSeparator)
+	firstSubPath, _, _ := strings.Cut(relativePath, UrlPathSeparator)
+	if isGitHost(firstSubPath) {
+		return relativePath
 	}
-	return path.Join(GithubDomainPrefix, path.Dir(parsedUrl.relativeFilePath), relativeUrl)
+	return path.Join(GithubDomainPrefix, relativePath)
 }
 
-// ParseGitURL this takes a Git url (GitHub) for now and converts it into the struct ParsedGitURL
-// This can in the future be extended to GitLab or BitBucket or any other Git Host
+// ParseGitURL this takes a Git url and converts it into the struct ParsedGitURL
+// Any git host is supported as long as its repositories are located by <host>/<author>/<repositoryName>, which
+// excludes nested groups like GitLab subgroups
 func ParseGitURL(packageURL string) (*ParsedGitURL, error) {
-	// we expect something like github.com/author/module/path.star
+	// we expect something like github.com/author/module/path.star or gitlab.com/author/module/path.star
 	// we don't want schemas
 	parsedURL, err := url.Parse(packageURL)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error parsing the URL of module '%v'", packageURL)
 	}
-	if parsedURL.Scheme != "" {
+	// 'host:port/...' parses as a schema followed by an opaque part
+	firstSubPath, _, _ := strings.Cut(packageURL, UrlPathSeparator)
+	if parsedURL.Scheme != "" && !isGitHost(strings.ToLower(firstSubPath)) {
 		return nil, stacktrace.NewError("Error parsing the URL of module '%v'. Expected schema to be empty got '%v'", packageURL, parsedURL.Scheme)
 	}
 
@@ -104,8 +141,9 @@ func ParseGitURL(packageURL string) (*ParsedGitURL, error) {
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error parsing the URL with scheme for module '%v'", packageURLPrefixedWithHttps)
 	}
-	if strings.ToLower(parsedURL.Host) != GithubDomainPrefix {
-		return nil, stacktrace.NewError("Error parsing the URL of module. We only support modules on Github for now but got '%v'", packageURL)
+	host := strings.ToLower(parsedURL.Host)
+	if !isGitHost(host) {
+		return nil, stacktrace.NewError("Error parsing the URL of module. Expected it to start with the domain name of a git host like '%v' but got '%v'", GithubDomainPrefix, packageURL)
 	}
 
 	pathWithoutVersion, maybeTagBranchOrCommit := ParseOutTagBranchOrCommit(parsedURL.Path)
@@ -118,15 +156,22 @@ func ParseGitURL(packageURL string) (*ParsedGitURL, error) {
 
 	moduleAuthor := splitURLPath[0]
 	moduleName := splitURLPath[1]
-	gitURL := fmt.Sprintf("%v://%v/%v/%v.git", httpsSchema, GithubDomainPrefix, moduleAuthor, moduleName)
-	relativeModulePath := path.Join(moduleAuthor, moduleName)
+	gitURL := fmt.Sprintf("%v://%v/%v/%v.git", httpsSchema, host, moduleAuthor, moduleName)
+
+	// GitHub repositories are stored without their host so that the paths of the packages already in an enclave remain valid
+	relativePathPrefix := host
+	if host == GithubDomainPrefix {
+		relativePathPrefix = ""
+	}
+	relativeModulePath := path.Join(relativePathPrefix, moduleAuthor, moduleName)
 
 	relativeFilePath := ""
 	if len(splitURLPath) > MinimumSubPathsForValidGitURL {
-		relativeFilePath = path.Join(splitURLPath...)
+		relativeFilePath = path.Join(relativePathPrefix, path.Join(splitURLPath...))
 	}
 
 	parsedGitURL := newParsedGitURL(
+		host,
 		moduleAuthor,
 		moduleName,
 		gitURL,
@@ -138,6 +183,23 @@ func ParseGitURL(packageURL string) (*ParsedGitURL, error) {
 	return parsedGitURL, nil
 }
 
+// IsValidGitHost returns true if the string is a lowercase hostname, optionally followed by a port, e.g.
+// 'gitlab.com' or 'gitea:3000'
+func IsValidGitHost(host string) bool {
+	return gitHostRegex.MatchString(host)
+}
+
+// isGitHost returns true if the string is the host part of a locator
+// GitHub authors can't contain dots or colons, so a GitHub author is never mistaken for a host, except for one named
+// 'localhost' which can't be told apart from the local git host
+func isGitHost(maybeHost string) bool {
+	if !IsValidGitHost(maybeHost) {
+		return false
+	}
+	hostname, _, hasPort := strings.Cut(maybeHost, hostPortSeparator)
+	return hasPort || strings.Contains(hostname, hostDomainSeparator) || hostname == localhostHostname
+}
+
 // cleanPath removes empty "" from the string slice
 func cleanPathAndSplit(urlPath string) []string {
 	cleanPath := path.Clean(urlPath)
@@ -22,6 +22,7 @@ func TestParsedGitURL_SimpleParse(t *testing.T) {
 	require.Nil(t, err)
 
 	expectedParsedURL := newParsedGitURL(
+		GithubDomainPrefix,
 		testModuleAuthor,
 		testModuleName,
 		fmt.Sprintf("https://github.com/%v/%v.git", testModuleAuthor, testModuleName),
@@ -33,16 +34,64 @@ func TestParsedGitURL_SimpleParse(t *testing.T) {
 	require.Equal(t, expectedParsedURL, parsedURL)
 }
 
-func TestParsedGitURL_FailsOnNonGithubURL(t *testing.T) {
-	nonGithubURL := "kurtosis-git.com/" + testModuleAuthor + "/" + testModuleName + "/" + testFileName
-	_, err := ParseGitURL(nonGithubURL)
+func TestParsedGitURL_WorksWithNonGithubHost(t *testing.T) {
+	nonGithubURL := "gitea.example.com/" + testModuleAuthor + "/" + testModuleName + "/" + testFileName + "@v1.0.0"
+	parsedURL, err := ParseGitURL(nonGithubURL)
+	require.Nil(t, err)
+
+	expectedParsedURL := newParsedGitURL(
+		"gitea.example.com",
+		testModuleAuthor,
+		testModuleName,
+		fmt.Sprintf("https://gitea.example.com/%v/%v.git", testModuleAuthor, testModuleName),
+		fmt.Sprintf("gitea.example.com/%v/%v", testModuleAuthor, testModuleName),
+		fmt.Sprintf("gitea.example.com/%v/%v/%v", testModuleAuthor, testModuleName, testFileName),
+		"v1.0.0",
+	)
+	require.Equal(t, expectedParsedURL, parsedURL)
+	require.Equal(t, "gitea.example.com/"+testModuleAuthor+"/"+testModuleName, parsedURL.GetRepositoryLocator())
+	require.Equal(t, "gitea.example.com/"+testModuleAuthor+"/"+testModuleName+"/lib.star", parsedURL.GetAbsoluteLocatorRelativeToThisURL("./lib.star"))
+}
+
+func TestParsedGitURL_WorksWithHostWithPortOrLocalhost(t *testing.T) {
+	parsedURL, err := ParseGitURL("gitea:3000/" + testModuleAuthor + "/" + testModuleName + "/" + testFileName)
+	require.Nil(t, err)
+	require.Equal(t, "gitea:3000", parsedURL.GetHost())
+	require.Equal(t, fmt.Sprintf("https://gitea:3000/%v/%v.git", testModuleAuthor, testModuleName), parsedURL.GetGitURL())
+	require.Equal(t, "gitea:3000/"+testModuleAuthor+"/"+testModuleName, parsedURL.GetRepositoryLocator())
+
+	parsedURL, err = ParseGitURL("localhost/" + testModuleAuthor + "/" + testModuleName)
+	require.Nil(t, err)
+	require.Equal(t, "localhost", parsedURL.GetHost())
+	require.Equal(t, "localhost/"+testModuleAuthor+"/"+testModuleName, GetLocatorFromRelativePath(parsedURL.GetRelativeRepoPath()))
+}
+
+func TestIsValidGitHost(t *testing.T) {
+	require.True(t, IsValidGitHost("gitlab.com"))
+	require.True(t, IsValidGitHost("gitea:3000"))
+	require.True(t, IsValidGitHost("10.0.0.1:8080"))
+	require.False(t, IsValidGitHost("../gitlab.com"))
+	require.False(t, IsValidGitHost("gitlab.com/author"))
+	require.False(t, IsValidGitHost(".gitlab.com"))
+	require.False(t, IsValidGitHost("gitlab.com:"))
+	require.False(t, IsValidGitHost(""))
+}
+
+func TestParsedGitURL_FailsOnLocatorWithoutHost(t *testing.T) {
+	locatorWithoutHost := "src/" + testModuleAuthor + "/" + testModuleName + "/" + testFileName
+	_, err := ParseGitURL(locatorWithoutHost)
 	require.NotNil(t, err)
 
-	expectedErrorMsg := "We only support modules on Github for now"
+	expectedErrorMsg := "Expected it to start with the domain name of a git host"
 
 	require.Contains(t, err.Error(), expectedErrorMsg)
 }
 
+func TestGetLocatorFromRelativePath(t *testing.T) {
+	require.Equal(t, githubSampleURL, GetLocatorFromRelativePath(testModuleAuthor+"/"+testModuleName+"/"+testFileName))
+	require.Equal(t, "gitlab.com/"+testModuleAuthor+"/"+testModuleName, GetLocatorFromRelativePath("gitlab.com/"+testModuleAuthor+"/"+testModuleName))
+}
+
 func TestParsedGitURL_FailsOnNonNonEmptySchema(t *testing.T) {
 	ftpSchema := "ftp"
 	nonGithubURL := ftpSchema + "://github.com/" + testModuleAuthor + "/" + testModuleName + "/" + testFileName
@@ -97,6 +146,7 @@ func TestParsedGitURL_WorksWithVersioningInformation(t *testing.T) {
 	require.Nil(t, err)
 
 	expectedParsedURL := newParsedGitURL(
+		GithubDomainPrefix,
 		testModuleAuthor,
 		testModuleName,
 		fmt.Sprintf("https://github.com/%v/%v.git", testModuleAuthor, testModuleName),
@@ -111,6 +161,7 @@ func TestParsedGitURL_WorksWithVersioningInformation(t *testing.T) {
 	require.Nil(t, err)
 
 	expectedParsedURL = newParsedGitURL(
+		GithubDomainPrefix,
 		testModuleAuthor,
 		testModuleName,
 		fmt.Sprintf("https://github.com/%v/%v.git", testModuleAuthor, testModuleName),
@@ -18,6 +18,8 @@ const (
 )
 
 var defaultExperimentalFeatureFlags = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag(nil)
+var defaultGitHostConfigs = []*kurtosis_core_rpc_api_bindings.GitHostConfig(nil)
+var defaultRegistryCredentials = []*kurtosis_core_rpc_api_bindings.RegistryCredentials(nil)
 
 type StarlarkRunConfig struct {
 	RelativePathToMainFile   string
@@ -31,6 +33,8 @@ type StarlarkRunConfig struct {
 	ImageDownload            kurtosis_core_rpc_api_bindings.ImageDownloadMode
 	NonBlockingMode          bool
 	GitHubAuthToken          string
+	GitHostConfigs           []*kurtosis_core_rpc_api_bindings.GitHostConfig
+	RegistryCredentials      []*kurtosis_core_rpc_api_bindings.RegistryCredentials
 }
 
 type starlarkRunConfigOption func(*StarlarkRunConfig)
@@ -48,6 +52,8 @@ func NewRunStarlarkConfig(opts ...starlarkRunConfigOption) *StarlarkRunConfig {
 		ImageDownload:            defaultImageDownload,
 		NonBlockingMode:          defaultNonBlockingMode,
 		GitHubAuthToken:          defaultGitHubAuthToken,
+		GitHostConfigs:           defaultGitHostConfigs,
+		RegistryCredentials:      defaultRegistryCredentials,
 	}
 
 	for _, opt := range opts {
@@ -124,3 +130,15 @@ func WithGitHubAuthToken(githubAuthToken string) starlarkRunConfigOption {
 		config.GitHubAuthToken = githubAuthToken
 	}
 }
+
+func WithGitHostConfigs(gitHostConfigs []*kurtosis_core_rpc_api_bindings.GitHostConfig) starlarkRunConfigOption {
+	return func(config *StarlarkRunConfig) {
+		config.GitHostConfigs = gitHostConfigs
+	}
+}
+
+func WithRegistryCredentials(registryCredentials []*kurtosis_core_rpc_api_bindings.RegistryCredentials) starlarkRunConfigOption {
+	return func(config *StarlarkRunConfig) {
+		config.RegistryCredentials = registryCredentials
+	}
+}
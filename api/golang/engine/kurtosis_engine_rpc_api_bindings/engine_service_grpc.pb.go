@@ -26,8 +26,10 @@ const (
 	EngineService_GetExistingAndHistoricalEnclaveIdentifiers_FullMethodName = "/engine_api.EngineService/GetExistingAndHistoricalEnclaveIdentifiers"
 	EngineService_StopEnclave_FullMethodName                                = "/engine_api.EngineService/StopEnclave"
 	EngineService_DestroyEnclave_FullMethodName                             = "/engine_api.EngineService/DestroyEnclave"
+	EngineService_ExtendEnclave_FullMethodName                              = "/engine_api.EngineService/ExtendEnclave"
 	EngineService_Clean_FullMethodName                                      = "/engine_api.EngineService/Clean"
 	EngineService_GetServiceLogs_FullMethodName                             = "/engine_api.EngineService/GetServiceLogs"
+	EngineService_GetEnclaveApiContainerToken_FullMethodName                = "/engine_api.EngineService/GetEnclaveApiContainerToken"
 )
 
 // EngineServiceClient is the client API for EngineService service.
@@ -44,17 +46,21 @@ type EngineServiceClient interface {
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(ctx context.Context, in *CreateEnclaveArgs, opts ...grpc.CallOption) (*CreateEnclaveResponse, error)
 	// Returns information about the existing enclaves
-	GetEnclaves(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclavesResponse, error)
+	GetEnclaves(ctx context.Context, in *GetEnclavesArgs, opts ...grpc.CallOption) (*GetEnclavesResponse, error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(ctx context.Context, in *StopEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Pushes out the expiration time of an enclave that was created with a TTL
+	ExtendEnclave(ctx context.Context, in *ExtendEnclaveArgs, opts ...grpc.CallOption) (*ExtendEnclaveResponse, error)
 	// Gets rid of old enclaves
 	Clean(ctx context.Context, in *CleanArgs, opts ...grpc.CallOption) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(ctx context.Context, in *GetServiceLogsArgs, opts ...grpc.CallOption) (EngineService_GetServiceLogsClient, error)
+	// Returns the token the caller authenticates to the API container of an enclave with, when the engine requires authentication
+	GetEnclaveApiContainerToken(ctx context.Context, in *GetEnclaveApiContainerTokenArgs, opts ...grpc.CallOption) (*GetEnclaveApiContainerTokenResponse, error)
 }
 
 type engineServiceClient struct {
@@ -83,7 +89,7 @@ func (c *engineServiceClient) CreateEnclave(ctx context.Context, in *CreateEncla
 	return out, nil
 }
 
-func (c *engineServiceClient) GetEnclaves(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclavesResponse, error) {
+func (c *engineServiceClient) GetEnclaves(ctx context.Context, in *GetEnclavesArgs, opts ...grpc.CallOption) (*GetEnclavesResponse, error) {
 	out := new(GetEnclavesResponse)
 	err := c.cc.Invoke(ctx, EngineService_GetEnclaves_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -119,6 +125,15 @@ func (c *engineServiceClient) DestroyEnclave(ctx context.Context, in *DestroyEnc
 	return out, nil
 }
 
+func (c *engineServiceClient) ExtendEnclave(ctx context.Context, in *ExtendEnclaveArgs, opts ...grpc.CallOption) (*ExtendEnclaveResponse, error) {
+	out := new(ExtendEnclaveResponse)
+	err := c.cc.Invoke(ctx, EngineService_ExtendEnclave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *engineServiceClient) Clean(ctx context.Context, in *CleanArgs, opts ...grpc.CallOption) (*CleanResponse, error) {
 	out := new(CleanResponse)
 	err := c.cc.Invoke(ctx, EngineService_Clean_FullMethodName, in, out, opts...)
@@ -160,6 +175,15 @@ func (x *engineServiceGetServiceLogsClient) Recv() (*GetServiceLogsResponse, err
 	return m, nil
 }
 
+func (c *engineServiceClient) GetEnclaveApiContainerToken(ctx context.Context, in *GetEnclaveApiContainerTokenArgs, opts ...grpc.CallOption) (*GetEnclaveApiContainerTokenResponse, error) {
+	out := new(GetEnclaveApiContainerTokenResponse)
+	err := c.cc.Invoke(ctx, EngineService_GetEnclaveApiContainerToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EngineServiceServer is the server API for EngineService service.
 // All implementations should embed UnimplementedEngineServiceServer
 // for forward compatibility
@@ -174,17 +198,21 @@ type EngineServiceServer interface {
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(context.Context, *CreateEnclaveArgs) (*CreateEnclaveResponse, error)
 	// Returns information about the existing enclaves
-	GetEnclaves(context.Context, *emptypb.Empty) (*GetEnclavesResponse, error)
+	GetEnclaves(context.Context, *GetEnclavesArgs) (*GetEnclavesResponse, error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error)
+	// Pushes out the expiration time of an enclave that was created with a TTL
+	ExtendEnclave(context.Context, *ExtendEnclaveArgs) (*ExtendEnclaveResponse, error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *CleanArgs) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error
+	// Returns the token the caller authenticates to the API container of an enclave with, when the engine requires authentication
+	GetEnclaveApiContainerToken(context.Context, *GetEnclaveApiContainerTokenArgs) (*GetEnclaveApiContainerTokenResponse, error)
 }
 
 // UnimplementedEngineServiceServer should be embedded to have forward compatible implementations.
@@ -197,7 +225,7 @@ func (UnimplementedEngineServiceServer) GetEngineInfo(context.Context, *emptypb.
 func (UnimplementedEngineServiceServer) CreateEnclave(context.Context, *CreateEnclaveArgs) (*CreateEnclaveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEnclave not implemented")
 }
-func (UnimplementedEngineServiceServer) GetEnclaves(context.Context, *emptypb.Empty) (*GetEnclavesResponse, error) {
+func (UnimplementedEngineServiceServer) GetEnclaves(context.Context, *GetEnclavesArgs) (*GetEnclavesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaves not implemented")
 }
 func (UnimplementedEngineServiceServer) GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error) {
@@ -209,12 +237,18 @@ func (UnimplementedEngineServiceServer) StopEnclave(context.Context, *StopEnclav
 func (UnimplementedEngineServiceServer) DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyEnclave not implemented")
 }
+func (UnimplementedEngineServiceServer) ExtendEnclave(context.Context, *ExtendEnclaveArgs) (*ExtendEnclaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendEnclave not implemented")
+}
 func (UnimplementedEngineServiceServer) Clean(context.Context, *CleanArgs) (*CleanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Clean not implemented")
 }
 func (UnimplementedEngineServiceServer) GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetServiceLogs not implemented")
 }
+func (UnimplementedEngineServiceServer) GetEnclaveApiContainerToken(context.Context, *GetEnclaveApiContainerTokenArgs) (*GetEnclaveApiContainerTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaveApiContainerToken not implemented")
+}
 
 // UnsafeEngineServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EngineServiceServer will
@@ -264,7 +298,7 @@ func _EngineService_CreateEnclave_Handler(srv interface{}, ctx context.Context,
 }
 
 func _EngineService_GetEnclaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(GetEnclavesArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -276,7 +310,7 @@ func _EngineService_GetEnclaves_Handler(srv interface{}, ctx context.Context, de
 		FullMethod: EngineService_GetEnclaves_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EngineServiceServer).GetEnclaves(ctx, req.(*emptypb.Empty))
+		return srv.(EngineServiceServer).GetEnclaves(ctx, req.(*GetEnclavesArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -335,6 +369,24 @@ func _EngineService_DestroyEnclave_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_ExtendEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendEnclaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ExtendEnclave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ExtendEnclave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ExtendEnclave(ctx, req.(*ExtendEnclaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EngineService_Clean_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CleanArgs)
 	if err := dec(in); err != nil {
@@ -374,6 +426,24 @@ func (x *engineServiceGetServiceLogsServer) Send(m *GetServiceLogsResponse) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _EngineService_GetEnclaveApiContainerToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnclaveApiContainerTokenArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetEnclaveApiContainerToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_GetEnclaveApiContainerToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetEnclaveApiContainerToken(ctx, req.(*GetEnclaveApiContainerTokenArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EngineService_ServiceDesc is the grpc.ServiceDesc for EngineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -405,10 +475,18 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyEnclave",
 			Handler:    _EngineService_DestroyEnclave_Handler,
 		},
+		{
+			MethodName: "ExtendEnclave",
+			Handler:    _EngineService_ExtendEnclave_Handler,
+		},
 		{
 			MethodName: "Clean",
 			Handler:    _EngineService_Clean_Handler,
 		},
+		{
+			MethodName: "GetEnclaveApiContainerToken",
+			Handler:    _EngineService_GetEnclaveApiContainerToken_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -52,11 +52,17 @@ const (
 	// EngineServiceDestroyEnclaveProcedure is the fully-qualified name of the EngineService's
 	// DestroyEnclave RPC.
 	EngineServiceDestroyEnclaveProcedure = "/engine_api.EngineService/DestroyEnclave"
+	// EngineServiceExtendEnclaveProcedure is the fully-qualified name of the EngineService's
+	// ExtendEnclave RPC.
+	EngineServiceExtendEnclaveProcedure = "/engine_api.EngineService/ExtendEnclave"
 	// EngineServiceCleanProcedure is the fully-qualified name of the EngineService's Clean RPC.
 	EngineServiceCleanProcedure = "/engine_api.EngineService/Clean"
 	// EngineServiceGetServiceLogsProcedure is the fully-qualified name of the EngineService's
 	// GetServiceLogs RPC.
 	EngineServiceGetServiceLogsProcedure = "/engine_api.EngineService/GetServiceLogs"
+	// EngineServiceGetEnclaveApiContainerTokenProcedure is the fully-qualified name of the
+	// EngineService's GetEnclaveApiContainerToken RPC.
+	EngineServiceGetEnclaveApiContainerTokenProcedure = "/engine_api.EngineService/GetEnclaveApiContainerToken"
 )
 
 // EngineServiceClient is a client for the engine_api.EngineService service.
@@ -71,17 +77,21 @@ type EngineServiceClient interface {
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error)
 	// Returns information about the existing enclaves
-	GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
+	GetEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Pushes out the expiration time of an enclave that was created with a TTL
+	ExtendEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse], error)
+	// Returns the token the caller authenticates to the API container of an enclave with, when the engine requires authentication
+	GetEnclaveApiContainerToken(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenResponse], error)
 }
 
 // NewEngineServiceClient constructs a client for the engine_api.EngineService service. By default,
@@ -104,7 +114,7 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceCreateEnclaveProcedure,
 			opts...,
 		),
-		getEnclaves: connect.NewClient[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse](
+		getEnclaves: connect.NewClient[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse](
 			httpClient,
 			baseURL+EngineServiceGetEnclavesProcedure,
 			opts...,
@@ -124,6 +134,11 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceDestroyEnclaveProcedure,
 			opts...,
 		),
+		extendEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs, kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse](
+			httpClient,
+			baseURL+EngineServiceExtendEnclaveProcedure,
+			opts...,
+		),
 		clean: connect.NewClient[kurtosis_engine_rpc_api_bindings.CleanArgs, kurtosis_engine_rpc_api_bindings.CleanResponse](
 			httpClient,
 			baseURL+EngineServiceCleanProcedure,
@@ -134,6 +149,11 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceGetServiceLogsProcedure,
 			opts...,
 		),
+		getEnclaveApiContainerToken: connect.NewClient[kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenArgs, kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenResponse](
+			httpClient,
+			baseURL+EngineServiceGetEnclaveApiContainerTokenProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -141,12 +161,14 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 type engineServiceClient struct {
 	getEngineInfo                              *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse]
 	createEnclave                              *connect.Client[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs, kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse]
-	getEnclaves                                *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse]
+	getEnclaves                                *connect.Client[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse]
 	getExistingAndHistoricalEnclaveIdentifiers *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse]
 	stopEnclave                                *connect.Client[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs, emptypb.Empty]
 	destroyEnclave                             *connect.Client[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs, emptypb.Empty]
+	extendEnclave                              *connect.Client[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs, kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse]
 	clean                                      *connect.Client[kurtosis_engine_rpc_api_bindings.CleanArgs, kurtosis_engine_rpc_api_bindings.CleanResponse]
 	getServiceLogs                             *connect.Client[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]
+	getEnclaveApiContainerToken                *connect.Client[kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenArgs, kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenResponse]
 }
 
 // GetEngineInfo calls engine_api.EngineService.GetEngineInfo.
@@ -160,7 +182,7 @@ func (c *engineServiceClient) CreateEnclave(ctx context.Context, req *connect.Re
 }
 
 // GetEnclaves calls engine_api.EngineService.GetEnclaves.
-func (c *engineServiceClient) GetEnclaves(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
+func (c *engineServiceClient) GetEnclaves(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
 	return c.getEnclaves.CallUnary(ctx, req)
 }
 
@@ -180,6 +202,11 @@ func (c *engineServiceClient) DestroyEnclave(ctx context.Context, req *connect.R
 	return c.destroyEnclave.CallUnary(ctx, req)
 }
 
+// ExtendEnclave calls engine_api.EngineService.ExtendEnclave.
+func (c *engineServiceClient) ExtendEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error) {
+	return c.extendEnclave.CallUnary(ctx, req)
+}
+
 // Clean calls engine_api.EngineService.Clean.
 func (c *engineServiceClient) Clean(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error) {
 	return c.clean.CallUnary(ctx, req)
@@ -190,6 +217,11 @@ func (c *engineServiceClient) GetServiceLogs(ctx context.Context, req *connect.R
 	return c.getServiceLogs.CallServerStream(ctx, req)
 }
 
+// GetEnclaveApiContainerToken calls engine_api.EngineService.GetEnclaveApiContainerToken.
+func (c *engineServiceClient) GetEnclaveApiContainerToken(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenResponse], error) {
+	return c.getEnclaveApiContainerToken.CallUnary(ctx, req)
+}
+
 // EngineServiceHandler is an implementation of the engine_api.EngineService service.
 type EngineServiceHandler interface {
 	// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
@@ -202,17 +234,21 @@ type EngineServiceHandler interface {
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error)
 	// Returns information about the existing enclaves
-	GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
+	GetEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Pushes out the expiration time of an enclave that was created with a TTL
+	ExtendEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error
+	// Returns the token the caller authenticates to the API container of an enclave with, when the engine requires authentication
+	GetEnclaveApiContainerToken(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenResponse], error)
 }
 
 // NewEngineServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -251,6 +287,11 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.DestroyEnclave,
 		opts...,
 	)
+	engineServiceExtendEnclaveHandler := connect.NewUnaryHandler(
+		EngineServiceExtendEnclaveProcedure,
+		svc.ExtendEnclave,
+		opts...,
+	)
 	engineServiceCleanHandler := connect.NewUnaryHandler(
 		EngineServiceCleanProcedure,
 		svc.Clean,
@@ -261,6 +302,11 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.GetServiceLogs,
 		opts...,
 	)
+	engineServiceGetEnclaveApiContainerTokenHandler := connect.NewUnaryHandler(
+		EngineServiceGetEnclaveApiContainerTokenProcedure,
+		svc.GetEnclaveApiContainerToken,
+		opts...,
+	)
 	return "/engine_api.EngineService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EngineServiceGetEngineInfoProcedure:
@@ -275,10 +321,14 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 			engineServiceStopEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceDestroyEnclaveProcedure:
 			engineServiceDestroyEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceExtendEnclaveProcedure:
+			engineServiceExtendEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceCleanProcedure:
 			engineServiceCleanHandler.ServeHTTP(w, r)
 		case EngineServiceGetServiceLogsProcedure:
 			engineServiceGetServiceLogsHandler.ServeHTTP(w, r)
+		case EngineServiceGetEnclaveApiContainerTokenProcedure:
+			engineServiceGetEnclaveApiContainerTokenHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -296,7 +346,7 @@ func (UnimplementedEngineServiceHandler) CreateEnclave(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.CreateEnclave is not implemented"))
 }
 
-func (UnimplementedEngineServiceHandler) GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
+func (UnimplementedEngineServiceHandler) GetEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetEnclaves is not implemented"))
 }
 
@@ -312,6 +362,10 @@ func (UnimplementedEngineServiceHandler) DestroyEnclave(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.DestroyEnclave is not implemented"))
 }
 
+func (UnimplementedEngineServiceHandler) ExtendEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.ExtendEnclave is not implemented"))
+}
+
 func (UnimplementedEngineServiceHandler) Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.Clean is not implemented"))
 }
@@ -319,3 +373,7 @@ func (UnimplementedEngineServiceHandler) Clean(context.Context, *connect.Request
 func (UnimplementedEngineServiceHandler) GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetServiceLogs is not implemented"))
 }
+
+func (UnimplementedEngineServiceHandler) GetEnclaveApiContainerToken(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetEnclaveApiContainerToken is not implemented"))
+}
@@ -0,0 +1,113 @@
+package kurtosis_context
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+	bearerTokenPrefix        = "Bearer "
+)
+
+// bearerTokenCredentials sends a bearer token with every call to the engine or to an API container
+type bearerTokenCredentials struct {
+	token string
+}
+
+func (creds bearerTokenCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{
+		authorizationMetadataKey: bearerTokenPrefix + creds.token,
+	}, nil
+}
+
+// RequireTransportSecurity is false so the token can be sent to an engine listening on localhost without TLS, and to the
+// API containers which are always called over plaintext
+func (creds bearerTokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// GetEngineDialOptions returns the gRPC dial options authenticating to the engine with the credentials of a Kurtosis
+// context. A nil engine auth means that the engine is called over plaintext without credentials
+func GetEngineDialOptions(engineAuth *generated.EngineAuth) ([]grpc.DialOption, error) {
+	tlsConfig, err := GetEngineTlsConfig(engineAuth)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building the TLS config to connect to the engine")
+	}
+
+	var dialOptions []grpc.DialOption
+	if tlsConfig != nil {
+		dialOptions = append(dialOptions, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		// TODO SECURITY: Use HTTPS to ensure we're connecting to the real Kurtosis API servers
+		dialOptions = append(dialOptions, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	if token := engineAuth.GetToken(); token != "" {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(bearerTokenCredentials{token: token}))
+	}
+	return dialOptions, nil
+}
+
+// GetApiContainerDialOptions returns the gRPC dial options authenticating to the API container of an enclave with the
+// credentials of a Kurtosis context. The token of the context is used if it has one, otherwise the engine issues one
+// for the client certificate of the context. A nil engine auth means that the API container is called without credentials
+func GetApiContainerDialOptions(
+	ctx context.Context,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	engineAuth *generated.EngineAuth,
+	enclaveIdentifier string,
+) ([]grpc.DialOption, error) {
+	// TODO SECURITY: use HTTPS!
+	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	token := engineAuth.GetToken()
+	if token == "" && len(engineAuth.GetTlsConfig().GetClientCertificate()) > 0 {
+		response, err := engineClient.GetEnclaveApiContainerToken(ctx, &kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenArgs{
+			EnclaveIdentifier: enclaveIdentifier,
+		})
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting a token for the API container of enclave '%v' from the engine", enclaveIdentifier)
+		}
+		token = response.GetToken()
+	}
+	if token != "" {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(bearerTokenCredentials{token: token}))
+	}
+	return dialOptions, nil
+}
+
+// GetEngineTlsConfig returns nil if the engine must be called over plaintext
+func GetEngineTlsConfig(engineAuth *generated.EngineAuth) (*tls.Config, error) {
+	engineTlsConfig := engineAuth.GetTlsConfig()
+	if engineTlsConfig == nil {
+		return nil, nil
+	}
+
+	// nolint: exhaustruct
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if len(engineTlsConfig.GetCertificateAuthority()) > 0 {
+		certificateAuthorityPool := x509.NewCertPool()
+		if !certificateAuthorityPool.AppendCertsFromPEM(engineTlsConfig.GetCertificateAuthority()) {
+			return nil, stacktrace.NewError("No certificate could be parsed from the engine certificate authority PEM")
+		}
+		tlsConfig.RootCAs = certificateAuthorityPool
+	}
+	if len(engineTlsConfig.GetClientCertificate()) > 0 {
+		clientCertificate, err := tls.X509KeyPair(engineTlsConfig.GetClientCertificate(), engineTlsConfig.GetClientKey())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred loading the client certificate and key to connect to the engine")
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCertificate}
+	}
+	return tlsConfig, nil
+}
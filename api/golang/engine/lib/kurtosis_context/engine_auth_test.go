@@ -0,0 +1,40 @@
+package kurtosis_context
+
+import (
+	"context"
+	"testing"
+
+	contexts_config_api "github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEngineDialOptions_NoEngineAuth(t *testing.T) {
+	dialOptions, err := GetEngineDialOptions(nil)
+	require.NoError(t, err)
+	require.Len(t, dialOptions, 1)
+
+	tlsConfig, err := GetEngineTlsConfig(nil)
+	require.NoError(t, err)
+	require.Nil(t, tlsConfig)
+}
+
+func TestGetEngineDialOptions_Token(t *testing.T) {
+	dialOptions, err := GetEngineDialOptions(contexts_config_api.NewEngineAuth("s3cr3t", nil))
+	require.NoError(t, err)
+	require.Len(t, dialOptions, 2)
+
+	metadata, err := bearerTokenCredentials{token: "s3cr3t"}.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "Bearer s3cr3t", metadata[authorizationMetadataKey])
+}
+
+func TestGetEngineTlsConfig_InvalidCertificateAuthority(t *testing.T) {
+	engineAuth := contexts_config_api.NewEngineAuth("", &generated.TlsConfig{
+		CertificateAuthority: []byte("not a certificate"),
+		ClientCertificate:    nil,
+		ClientKey:            nil,
+	})
+	_, err := GetEngineTlsConfig(engineAuth)
+	require.Error(t, err)
+}
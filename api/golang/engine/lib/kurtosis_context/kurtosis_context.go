@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	portal_constructors "github.com/kurtosis-tech/kurtosis-portal/api/golang/constructors"
@@ -18,8 +19,8 @@ import (
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -27,6 +28,9 @@ const (
 	// NOTE: This needs to be 127.0.0.1 rather than 0.0.0.0, because Windows machines don't translate 0.0.0.0 -> 127.0.0.1
 	localHostIPAddressStr = "127.0.0.1"
 
+	// Passing this TTL when creating an enclave means the enclave never expires
+	noEnclaveTtl = time.Duration(0)
+
 	DefaultGrpcEngineServerPortNum = uint16(9710)
 
 	// Blank tells the engine server to use the default
@@ -73,8 +77,18 @@ func NewKurtosisContextFromLocalEngine() (*KurtosisContext, error) {
 	ctx := context.Background()
 	kurtosisEngineSocketStr := fmt.Sprintf("%v:%v", localHostIPAddressStr, DefaultGrpcEngineServerPortNum)
 
-	// TODO SECURITY: Use HTTPS to ensure we're connecting to the real Kurtosis API servers
-	conn, err := grpc.Dial(kurtosisEngineSocketStr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)))
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		logrus.Warnf("Unable to retrieve current Kurtosis context. This is not critical, it will assume using Kurtosis default context for now.")
+		currentContext = nil
+	}
+
+	engineDialOptions, err := GetEngineDialOptions(currentContext.GetEngineAuth())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the options to connect to the Kurtosis Engine Server with the credentials of the current context")
+	}
+	engineDialOptions = append(engineDialOptions, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)))
+	conn, err := grpc.Dial(kurtosisEngineSocketStr, engineDialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
@@ -89,16 +103,11 @@ func NewKurtosisContextFromLocalEngine() (*KurtosisContext, error) {
 	}
 
 	var portalClient portal_api.KurtosisPortalClientClient
-	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
-	if err == nil {
-		if store.IsRemote(currentContext) {
-			portalClient, err = CreatePortalDaemonClient(portalIsRequired)
-			if err != nil {
-				return nil, stacktrace.Propagate(err, "Error building client for Kurtosis Portal daemon")
-			}
+	if currentContext != nil && store.IsRemote(currentContext) {
+		portalClient, err = CreatePortalDaemonClient(portalIsRequired)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error building client for Kurtosis Portal daemon")
 		}
-	} else {
-		logrus.Warnf("Unable to retrieve current Kurtosis context. This is not critical, it will assume using Kurtosis default context for now.")
 	}
 
 	kurtosisContext := &KurtosisContext{
@@ -119,7 +128,7 @@ func (kurtosisCtx *KurtosisContext) CreateEnclave(ctx context.Context, enclaveNa
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -139,7 +148,61 @@ func (kurtosisCtx *KurtosisContext) CreateEnclaveWithDebugEnabled(
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
+	}
+
+	return enclaveContext, nil
+}
+
+// CreateEnclaveWithLabelsAndTtl creates an enclave tagged with the given labels, which the engine will stop or destroy
+// (depending on the expiry action) once the TTL elapses, unless it gets extended. A zero TTL means the enclave never expires
+func (kurtosisCtx *KurtosisContext) CreateEnclaveWithLabelsAndTtl(
+	ctx context.Context,
+	enclaveName string,
+	labels map[string]string,
+	ttl time.Duration,
+	expiryAction kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction,
+) (*enclaves.EnclaveContext, error) {
+
+	createEnclaveArgs := newCreateEnclaveArgsWithDefaultValues(enclaveName)
+	createEnclaveArgs.Labels = labels
+	if ttl != noEnclaveTtl {
+		createEnclaveArgs.Ttl = durationpb.New(ttl)
+		createEnclaveArgs.ExpiryAction = &expiryAction
+	}
+
+	response, err := kurtosisCtx.engineClient.CreateEnclave(ctx, createEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v', labels '%+v' and TTL '%v'", enclaveName, labels, ttl)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
+	}
+
+	return enclaveContext, nil
+}
+
+// CreateEnclaveWithResourceQuota creates an enclave whose services can't claim more than the given resources; runs
+// whose plan exceeds the quota fail at validation. The limits left to zero are taken from the engine default quota
+func (kurtosisCtx *KurtosisContext) CreateEnclaveWithResourceQuota(
+	ctx context.Context,
+	enclaveName string,
+	resourceQuota *kurtosis_engine_rpc_api_bindings.EnclaveResourceQuota,
+) (*enclaves.EnclaveContext, error) {
+
+	createEnclaveArgs := newCreateEnclaveArgsWithDefaultValues(enclaveName)
+	createEnclaveArgs.ResourceQuota = resourceQuota
+
+	response, err := kurtosisCtx.engineClient.CreateEnclave(ctx, createEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v' and resource quota '%+v'", enclaveName, resourceQuota)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -157,7 +220,7 @@ func (kurtosisCtx *KurtosisContext) CreateProductionEnclave(ctx context.Context,
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -174,7 +237,7 @@ func (kurtosisCtx *KurtosisContext) CreateProductionEnclaveWithDebugEnabled(ctx
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -189,7 +252,7 @@ func (kurtosisCtx *KurtosisContext) GetEnclaveContext(ctx context.Context, encla
 		return nil, stacktrace.Propagate(err, "An error occurred while getting enclave with identifier '%v'", enclaveIdentifier)
 	}
 
-	enclaveCtx, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, enclaveInfo)
+	enclaveCtx, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.engineClient, kurtosisCtx.portalClient, enclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from the returned enclave info")
 	}
@@ -199,7 +262,15 @@ func (kurtosisCtx *KurtosisContext) GetEnclaveContext(ctx context.Context, encla
 
 // Docs available at https://docs.kurtosis.com/sdk#getenclaves---enclaves-enclaves
 func (kurtosisCtx *KurtosisContext) GetEnclaves(ctx context.Context) (*Enclaves, error) {
-	response, err := kurtosisCtx.engineClient.GetEnclaves(ctx, &emptypb.Empty{})
+	return kurtosisCtx.GetEnclavesWithLabels(ctx, nil)
+}
+
+// GetEnclavesWithLabels only returns the enclaves having all the given labels
+func (kurtosisCtx *KurtosisContext) GetEnclavesWithLabels(ctx context.Context, labels map[string]string) (*Enclaves, error) {
+	getEnclavesArgs := &kurtosis_engine_rpc_api_bindings.GetEnclavesArgs{
+		Labels: labels,
+	}
+	response, err := kurtosisCtx.engineClient.GetEnclaves(ctx, getEnclavesArgs)
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
@@ -283,6 +354,21 @@ func (kurtosisCtx *KurtosisContext) DestroyEnclave(ctx context.Context, enclaveI
 	return nil
 }
 
+// ExtendEnclave pushes out the expiration time of an enclave created with a TTL, and returns the new expiration time
+func (kurtosisCtx *KurtosisContext) ExtendEnclave(ctx context.Context, enclaveIdentifier string, extension time.Duration) (time.Time, error) {
+	extendEnclaveArgs := &kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		Extension:         durationpb.New(extension),
+	}
+
+	extendEnclaveResponse, err := kurtosisCtx.engineClient.ExtendEnclave(ctx, extendEnclaveArgs)
+	if err != nil {
+		return time.Time{}, stacktrace.Propagate(err, "An error occurred extending enclave with identifier '%v' by '%v'", enclaveIdentifier, extension)
+	}
+
+	return extendEnclaveResponse.GetExpirationTime().AsTime(), nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk#cleanboolean-shouldcleanall---enclavenameanduuid-removedenclavenameanduuids
 func (kurtosisCtx *KurtosisContext) Clean(ctx context.Context, shouldCleanAll bool) ([]*kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid, error) {
 	cleanArgs := &kurtosis_engine_rpc_api_bindings.CleanArgs{
@@ -363,6 +449,21 @@ func (kurtosisCtx *KurtosisContext) GetExistingAndHistoricalEnclaveIdentifiers(c
 	return newEnclaveIdentifiers(historicalEnclaveIdentifiers.AllIdentifiers), nil
 }
 
+// GetApiContainerDialOptions returns the gRPC dial options to call the API container of an enclave with the credentials
+// of the current Kurtosis context
+func (kurtosisCtx *KurtosisContext) GetApiContainerDialOptions(ctx context.Context, enclaveIdentifier string) ([]grpc.DialOption, error) {
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		logrus.Warnf("Unable to retrieve current Kurtosis context. This is not critical, it will assume using Kurtosis default context for now.")
+		currentContext = nil
+	}
+	dialOptions, err := GetApiContainerDialOptions(ctx, kurtosisCtx.engineClient, currentContext.GetEngineAuth(), enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the options to connect to the API container of enclave '%v'", enclaveIdentifier)
+	}
+	return dialOptions, nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -410,6 +511,7 @@ func runReceiveStreamLogsFromTheServerRoutine(
 
 func newEnclaveContextFromEnclaveInfo(
 	ctx context.Context,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
 	portalClient portal_api.KurtosisPortalClientClient,
 	enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo,
 ) (*enclaves.EnclaveContext, error) {
@@ -426,6 +528,7 @@ func newEnclaveContextFromEnclaveInfo(
 		}
 	} else {
 		logrus.Warnf("Unable to retrieve current Kurtosis context. This is not critical, it will assume using Kurtosis default context for now.")
+		currentContext = nil
 	}
 
 	enclaveContainersStatus := enclaveInfo.GetContainersStatus()
@@ -458,8 +561,12 @@ func newEnclaveContextFromEnclaveInfo(
 		apiContainerHostMachineInfo.IpOnHostMachine,
 		apiContainerHostMachineInfo.GrpcPortOnHostMachine,
 	)
-	// TODO SECURITY: use HTTPS!
-	apiContainerConn, err := grpc.Dial(apiContainerHostMachineUrl, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)))
+	apiContainerDialOptions, err := GetApiContainerDialOptions(ctx, engineClient, currentContext.GetEngineAuth(), enclaveInfo.GetEnclaveUuid())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the options to connect to the API container of enclave '%v' with the credentials of the current context", enclaveInfo.GetName())
+	}
+	apiContainerDialOptions = append(apiContainerDialOptions, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)))
+	apiContainerConn, err := grpc.Dial(apiContainerHostMachineUrl, apiContainerDialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred connecting to the API container on host machine URL '%v'", apiContainerHostMachineUrl)
 	}
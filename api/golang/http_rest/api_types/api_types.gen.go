@@ -225,6 +225,24 @@ type ExecCommandResult struct {
 	LogOutput string `json:"log_output"`
 }
 
+// ExecCommandStreamInput Input sent by the client to an interactive command
+type ExecCommandStreamInput struct {
+	// CloseStdin If true, the command STDIN is closed
+	CloseStdin *bool         `json:"close_stdin,omitempty"`
+	Resize     *TerminalSize `json:"resize,omitempty"`
+
+	// Stdin Bytes to write to the command STDIN
+	Stdin *[]byte `json:"stdin,omitempty"`
+}
+
+// ExecCommandStreamOutput Output of an interactive command
+type ExecCommandStreamOutput struct {
+	// ExitCode Only set on the last message, once the command exited
+	ExitCode *int32  `json:"exit_code,omitempty"`
+	Stderr   *[]byte `json:"stderr,omitempty"`
+	Stdout   *[]byte `json:"stdout,omitempty"`
+}
+
 // FileArtifactDescription defines model for FileArtifactDescription.
 type FileArtifactDescription struct {
 	// Path Path relative to the file artifact
@@ -576,6 +594,12 @@ type StoreWebFilesArtifact struct {
 	Url string `json:"url"`
 }
 
+// TerminalSize defines model for TerminalSize.
+type TerminalSize struct {
+	Height int32 `json:"height"`
+	Width  int32 `json:"width"`
+}
+
 // Timestamp defines model for Timestamp.
 type Timestamp = time.Time
 
@@ -587,6 +611,9 @@ type TransportProtocol string
 // ArtifactIdentifier defines model for artifact_identifier.
 type ArtifactIdentifier = string
 
+// CommandArgs defines model for command_args.
+type CommandArgs = []string
+
 // ConjunctiveFilters defines model for conjunctive_filters.
 type ConjunctiveFilters = []LogLineFilter
 
@@ -644,6 +671,15 @@ type ServiceUuidSet = []string
 // StarlarkExecutionUuid defines model for starlark_execution_uuid.
 type StarlarkExecutionUuid = string
 
+// TerminalHeight defines model for terminal_height.
+type TerminalHeight = int32
+
+// TerminalWidth defines model for terminal_width.
+type TerminalWidth = int32
+
+// Tty defines model for tty.
+type Tty = bool
+
 // NotOk defines model for NotOk.
 type NotOk = ResponseInfo
 
@@ -695,6 +731,21 @@ type GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAva
 	RequestBody *RequestBody `form:"request_body,omitempty" json:"request_body,omitempty"`
 }
 
+// GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecParams defines parameters for GetEnclavesEnclaveIdentifierServicesServiceIdentifierExec.
+type GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecParams struct {
+	// CommandArgs The command to run, one argument per value
+	CommandArgs CommandArgs `form:"command_args" json:"command_args"`
+
+	// Tty If true, a TTY is allocated to the command and its STDERR is merged into STDOUT. Default is false
+	Tty *Tty `form:"tty,omitempty" json:"tty,omitempty"`
+
+	// TerminalWidth Initial width, in characters, of the TTY allocated to the command. Ignored if no TTY is allocated
+	TerminalWidth *TerminalWidth `form:"terminal_width,omitempty" json:"terminal_width,omitempty"`
+
+	// TerminalHeight Initial height, in characters, of the TTY allocated to the command. Ignored if no TTY is allocated
+	TerminalHeight *TerminalHeight `form:"terminal_height,omitempty" json:"terminal_height,omitempty"`
+}
+
 // GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsParams defines parameters for GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs.
 type GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsParams struct {
 	FollowLogs         *FollowLogs         `form:"follow_logs,omitempty" json:"follow_logs,omitempty"`
@@ -157,6 +157,9 @@ type ClientInterface interface {
 	// GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailability request
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailability(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, portNumber PortNumber, params *GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailabilityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetEnclavesEnclaveIdentifierServicesServiceIdentifierExec request
+	GetEnclavesEnclaveIdentifierServicesServiceIdentifierExec(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params *GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs request
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params *GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -479,6 +482,18 @@ func (c *Client) GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsP
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetEnclavesEnclaveIdentifierServicesServiceIdentifierExec(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params *GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnclavesEnclaveIdentifierServicesServiceIdentifierExecRequest(c.Server, enclaveIdentifier, serviceIdentifier, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params *GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsRequest(c.Server, enclaveIdentifier, serviceIdentifier, params)
 	if err != nil {
@@ -1601,6 +1616,113 @@ func NewGetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumber
 	return req, nil
 }
 
+// NewGetEnclavesEnclaveIdentifierServicesServiceIdentifierExecRequest generates requests for GetEnclavesEnclaveIdentifierServicesServiceIdentifierExec
+func NewGetEnclavesEnclaveIdentifierServicesServiceIdentifierExecRequest(server string, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params *GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "enclave_identifier", runtime.ParamLocationPath, enclaveIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "service_identifier", runtime.ParamLocationPath, serviceIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/enclaves/%s/services/%s/exec", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "command_args", runtime.ParamLocationQuery, params.CommandArgs); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Tty != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tty", runtime.ParamLocationQuery, *params.Tty); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TerminalWidth != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "terminal_width", runtime.ParamLocationQuery, *params.TerminalWidth); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TerminalHeight != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "terminal_height", runtime.ParamLocationQuery, *params.TerminalHeight); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsRequest generates requests for GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs
 func NewGetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsRequest(server string, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params *GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsParams) (*http.Request, error) {
 	var err error
@@ -2179,6 +2301,9 @@ type ClientWithResponsesInterface interface {
 	// GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailabilityWithResponse request
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailabilityWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, portNumber PortNumber, params *GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailabilityParams, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailabilityResponse, error)
 
+	// GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecWithResponse request
+	GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params *GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecParams, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecResponse, error)
+
 	// GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsWithResponse request
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params *GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsParams, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsResponse, error)
 
@@ -2646,6 +2771,29 @@ func (r GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumber
 	return 0
 }
 
+type GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExecCommandStreamOutput
+	JSONDefault  *NotOk
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -3062,6 +3210,15 @@ func (c *ClientWithResponses) GetEnclavesEnclaveIdentifierServicesServiceIdentif
 	return ParseGetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailabilityResponse(rsp)
 }
 
+// GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecWithResponse request returning *GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecResponse
+func (c *ClientWithResponses) GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params *GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecParams, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecResponse, error) {
+	rsp, err := c.GetEnclavesEnclaveIdentifierServicesServiceIdentifierExec(ctx, enclaveIdentifier, serviceIdentifier, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnclavesEnclaveIdentifierServicesServiceIdentifierExecResponse(rsp)
+}
+
 // GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsWithResponse request returning *GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsResponse
 func (c *ClientWithResponses) GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsWithResponse(ctx context.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params *GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsParams, reqEditors ...RequestEditorFn) (*GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsResponse, error) {
 	rsp, err := c.GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs(ctx, enclaveIdentifier, serviceIdentifier, params, reqEditors...)
@@ -3766,6 +3923,39 @@ func ParseGetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumb
 	return response, nil
 }
 
+// ParseGetEnclavesEnclaveIdentifierServicesServiceIdentifierExecResponse parses an HTTP response from a GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecWithResponse call
+func ParseGetEnclavesEnclaveIdentifierServicesServiceIdentifierExecResponse(rsp *http.Response) (*GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExecCommandStreamOutput
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest NotOk
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsResponse parses an HTTP response from a GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsWithResponse call
 func ParseGetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsResponse(rsp *http.Response) (*GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1546,83 +1546,90 @@ func (sh *strictHandler) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx echo.C
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9a3MbuZF/BTV3Vd5NjUlnk0ou+ibLss2KV2JJdHRXK9cYnGmSiEBgAmAoc13671d4",
-	"zYODGQ71srPZ+3BecfDoN7objc7XKOXrnDNgSkZHX6McC7wGBcL8hYUiC5yqhGTAFFkQEPrnDGQqSK4I",
-	"Z9FRNFsB8gMRw2tAXKCiIFkUR0QPyLFaRXGkP0VHwTXjSMC/CiIgi46UKCCOZLqCNdabqW2up0klCFtG",
-	"d3dxBCyleAO9QH38OHkTI7niQgGDDNm/uXAALpBaAXILheEM7HIgmF9ySBVkiQCZcyahDeXEw5HlnDCF",
-	"BKhCMInUiki0wbSA2AyQIDYkBXRLKEVzQGssbiBDWCK8wYTiOQX0A4yWI/QeKOXoigua/TjyiP2rALGt",
-	"YdYCrB+RlVJ5sga14lmY++9nsymyA1AhIUOKo3QF6Y0Hj1CitiP0Bha4oAoRid6dzrrAq29XB+y/BSyi",
-	"o+i/xpXEju1XOX6vVP6zmXJc29FATxhRBNMkA4q3yZpQSiSknGUyjAwr1nMQWkTqYzVKt5goVDBFKIIv",
-	"kBaKsKVhz4IIqSwVUkxpB149gNTRXHCxxsqMV3/6KYo9QwhTsARhcMpxeoOXWjbDOLjvqJJdpFZYlfJj",
-	"wYcODa2tfpjEm2U6AFIrr3VemL2QjNBEoXUhFXuhkFRYaDjVqkZZSbFcjdBbLhBhUmGWAvrslhmvAFO1",
-	"+txBdIdZL9RcqMRyvQN4LpQXi6Bkd5Cxtm4fHYcwXM8GqZI5z7adZqSmOFrFJCgN7vT8chbXLEopBBKY",
-	"MSF6ql7X86eOGXIbd+lqA65+MgtQgkBA6X7GX2pKV2oRwkrBOlfSiq5BwIDuhNdp4pJstBoWOcIscwZU",
-	"/4AZAiG46ATcQnM4I8y8QdbkrN+SzEHdAjBUgdID6GNYDbvUBhLKlzLBcsvSoCwtMJUQoznl6Y0RKuQP",
-	"CkdzzR29BsICqhOoYd+1kPdi1AQjIDpzzilgZiB3ur7XDfGmpWb3nFCnnClMmDeE9qf1WsuMXPGCZnWz",
-	"iAgLq3QAjkMsZKXIr3nmdGFBKHzMKcfZa6fbGlRgSv/nuqCK5Fiosebvywwrs26A7XPCsCFya0+7q+Wf",
-	"2fGMq/ObnY1wnlOSYk3K8T+lpufXgSfvhVt6whbcorjjiDHvbjh9NPy0k/Xax5r/lwoLisXNqT1WOfvA",
-	"lwGF+igBEWPUjNSsBGe8kHSLvEhZb8ovYmV0QzC6grnk6Q0oqV1AI9JSCcBrTaM4ygXPQSjHEbN2Ih1I",
-	"Sbmckdc2UCXMxsc8DLjW3oFdjSfdYVG94P3SOfFTKRF8/k9IVWtiP7bt6XF0whnT/9mixCv0Ep2cn52d",
-	"nszQeIxeg1QIFgt9epojdMHFLRYZYctr9kf0Ep2dJ7Xh0+YQlBGprYr2QYAVaw2rGx3FUTW1BqInjQHR",
-	"arsJaRoUTtdZgoVlJFGwlgHalitiIfA2MiGHElvjo99r8ibZYBdRZRnR5MJ02gCra5GK7GStfTJriALj",
-	"pcKqkPu0tSTMpR0eECP9c2O3NvZxRcUadh2y0tgvKDOXs/Pp9PQNslJx8fHsbHL2Dl2zn9BL9PHs72fn",
-	"V2c1IXCjozhyI6M48qNCsqD188Sa+rDyIv91VxndAXEwx3do2lgmRKQahBcgC6raUgtfiEpSnsGgsz6O",
-	"KF8mvFB5EVDTYymLNUj0cfb25f/oGJhn1gz2W5gKhMbyIYTeEgrHLsx/U997F61wwDDVwYIAihXZmDDB",
-	"RgK0SjNEAT5L8msgwr4kv5bhvl4iRoSh+VYZf6tOyL/8OUhIBV9UkgvYELgNkBLNiTLLwxeF3HkaI7Ko",
-	"gUwpv5XoB0nWhGITQHw8m/zvC4lerABnL37cS3gfwmj89lH7AhYggKUBSuhhEvmBqOHCNLnibUw7o1Kn",
-	"pCy5EWuzrQN/dLsy/qyFQRtxojS9zZRCFQJCjPMH3LNst0Nbl6YyGO+jrfXSujRUw5iUua3CjE1EOZgz",
-	"OF9ER7/0m+cwK+/iQ1ywT3eh06MjQaJNi7Or7071waqjxaAZnegD4Q2/ZRqxn50latvy4w9Xx/936Uz5",
-	"z5PLS2ug/Sb2cxRH/lNoq78XQnFJ5FvAmolvKV6GNzs7TyZnl7OLjyezyfnZZXJyfPK+uV/XiNC22gEJ",
-	"GJAVFpChc0NJiX74KCFDr7foZ+OaU0CnLnknf2x7kpVXneSCK55yGjw/quzDANuuBGbSJBfqa/YJyMzP",
-	"mPoJd3GkY9BEkTXw0CGhoykTpboRKCuEQUQrmAN8n3KVuY8AxCFla8hx23HbPf2KbhKtQUq8hJ6zephG",
-	"zfTYXbTMAtUesYWsD6GZ29JL5enFxflFFEeTs7fnURxdHV+cdQnlBZiM2JRTkm47tOD0H6cXTuNK/SoV",
-	"QH+MYq94wS0K5uOvqc38BYhPOYMkrz43wbhagVqZ2LrKPVZhtZmcmeQ7V6NrVqUYlDbg9Uk+N5UXlEKG",
-	"FoKvzffj6eQEUZ5iWq2vuIARmiwQUS8kwjufzdJEmsTiNVvhDaA5AEPWMkOGCqlPDGvUd/BHuSDc5vow",
-	"pXpYm0YWDxPxd6PhMDdovCPqfTFHc1hwUY8DDX9lJcZl3iPWVC+yxKc8g9lel3IxWSVY52obOmHtOoUE",
-	"cf81MrFNRMH6Zxu2BlGBLzkIsgamME0W1rA3neo+fQydCIFAa0nUqpgnuFCrRPEbYPfE1QY/mTvskrWz",
-	"PH0Qto9HbYYwYcmiYKk5AcJulblyqN1J6TnIz7G3P5lLq9n0LZHoOhIFu45CoDPOEpO4I2xZAn4fhuVY",
-	"YEqBErnuX+LPO450h0k2F4s9IbDNne0GAv4yEmVYYeP3aYJ4VSz1rUG0KGCJfTSRaGc6UTyxrCG0gyNl",
-	"9GFX1o68/n96dgdP9I/XhuMjrdHXkZ3l3FHBuYkTsAc5eHi2D5DK7FwaCIOW+XcbcYCN+F23f2u6LUEQ",
-	"TMmvkCWyVJI92dLWlJD7dmmz/ZMyUg7kr45Z6NKBaH8k5ZSCgVrzX0easZUGd+ngKhNcCDokBD+ryZLb",
-	"NZgJcbcU+wPrvkU8gB3LmOuWBhJ7F20zoQLTxeCtffv40hEm1FK/g1KhRrUeQu9ckA1WkJA8wVnWcTU1",
-	"mSL9EaTcvQcnTJIMdgpSOjfRYVRvJrkPZxPihg6avJhTknZjMDXf60j8gRdKw/2HOuDodgUCmthJJACn",
-	"KzyncM3OzmenR+jKlyHog8Rny6oJ7IVComCMsGWz/CUjmf6WwYIwrUZbc68gTV2PucXG6Q2wDGUczCKy",
-	"yM3FgwD9j/bjLZ410i94kNiWHE9Day/3wzL2Tth9vv6ZtPvyMTV7Vz92hblL91ukimva3WMYvt1dgz+8",
-	"epPez+LmhN2TtqA3CokCnxuOwgBXoN/Rbm0gbHojycv8xp60TC0Z0jz6Kx8kdB4e6iA0SqDa80M7N6nV",
-	"S4kge7r83xaRgpLvL9DNFXtb5PzPw9LQfrUJUyByAcpk/uza+5LRfu4/MCXZPeaV1+lu2qfWPZT5vZcI",
-	"zSUCCujvuKGHXEl3EjEEUTl874377vZ9qIR9HNLxq1SiSMNGR08ZjlFj9F6E6hsPGLzge1DuQQOLZbH2",
-	"tcqD7GVg2WO3SPCu3jBHOyvJDj3bWaLqe7eBJTKRNyTPIQuVNsVRziXxOxyIxtRP7eGHNy0V3TpRbMA6",
-	"kEElJUOM6iWKgFyA1KZO28gwbWo2Vi9nQuSDYrtqVmjPgThOaxzaDTVosWYDD0Zt+DsJQgmDQ0pBPa7l",
-	"om6J2MM0ELeuy8y6BImOMTU6h4cPZlNg+iE2p3tOHef2SRbAuj7o2xwOQRj6MLso2FvCiFxBdroJqqIo",
-	"tNthhyQQHqO1o2CJLNIUpFwUdK9GVtUle46S1sp7aRAAeA8FwuWCZSqpKtH7YIvuDjo5LgrmL/I+aCUL",
-	"nBq1oVPBlzpADhS7uC9J+PhOCyGAqUQqyMshw4vMGtMPu0/m2t/U8+R9bFAb7uaSYdD2CkGTWnv4f1F7",
-	"YbO3oNKXcQ7zhXvqU4e6tF5AO0ozuuRsiDxfVC94DnXtq5N/sGt+kCNf14Whc9pH0wG7Nc3g0IlX2LwX",
-	"OABEW2RT8W030mnJ4KYc8G3OlNb+fdrk6dGC8XbPh+Hg707Yi4DfOgw3F2Bq23zh1FvB1y4T1AZ22EVN",
-	"s+AsmEDjhUhtbN/xInIuOS0UIDvSvoCqpUftr3ajslyA58SVC+zPsdUA6ClgM+S5gnmDQiHTwgWgK5ij",
-	"ZpngwIuJw+lXCBpIXl58QIojfzlnXyP5gsVBVNHL9lCjXQgVTBLOTqYuQXh5Mpv67OCbaS0zODvRf+nP",
-	"URzpT5/i0HMPf44roqj+5rN36OL0crYoKDqeTqI42oCQbvvRH0evNKg8B4ZzEh1Ffxq9Gr2K7Os6w4Ox",
-	"y7brP+7i6s/xikjFxXb356/tp6x3Q8aMPe3NrkuwhYw52EKwSRYdRe9Anbol3L/VbdlxOTtuPCvuOKGq",
-	"IePA09u7TzuvWH569eqgNyyDXL6u+svdAu/WK5fL0r31L+bMfbi9Su3atcRnbJ/kmJcxxXqNNQujD0Qq",
-	"89KqqUymUghrD+YX/0Q5Mn7FUF6OTZ3US5+SzbkM8HXK5QDGftArvbUZzUfisH8dte0mWu0B1Xjn9dTd",
-	"A4XkPvc8nSXCbV/vWeTGwiBdOVyjbN7X0pdWSMOOLrdSwfrBYiVgzRW05GrnoABKpa/oqz/Kq1l9vCPx",
-	"VRXgLcz3oHAfKb4wgD+hGD/KO7vwQR4Sqe6jvPlS8aHKcg9D+iwKcJxlyAqjlX7Fn0bg3eWkHH9tPwu9",
-	"u5cKpDzfdog/rj+bf2QFcM6ybNW7PIo+xHtnBV7VPqkWdUYLd0138reuJI7uL+TT6snXQMeXu4d5lP4/",
-	"nl1UQ81rnt81rZcXfBvndMJkDqkqn9YDc+WtrWd6jy86Y+8pPLYM+RLPfx9Z4qkC9dI+ZT+4McAziImn",
-	"qOxx6h7R5rjM7v6B/ui+lwT54/KxxGSHC0C1YnkItVVegjKVapqj7qY20FZDVmAFTMu+J8ufvkHkVK/j",
-	"/EbB0oVruJWBwoSazh8lnRGe88KE4LJixy1RK1fTXyvWvIeslt5jatsp+Bvmw8NxL48n1ULfcRzju0cM",
-	"97WePmKWICoO77SqeCh7q5zc/S3Ne7fGv00eLVA5/238FK/fQbVG8IVIUxZ8Xbx69dNfkOUVSTFFNWv6",
-	"IPZ3xYb3loXvLkh7ouCocTh8N2cB0m4vWVQl5E8hH+O0alfygPOgJSm1Pie/rai+3uIlICs7TV6eL8Bv",
-	"N3Z5Hkm29QFgs0xLsgFWNjtzj02wf2BRSwJUtfVPIdO+x6kcf601Rrwb4512FM4wNmn02rSis83nKpxM",
-	"q8BW79SyH11cvt4mOlAVvFiuELazPKXjx7DAZQeIKRfKNv07bnaH/Fb6tn9WvUnlgOH13qxDVsdqNWRc",
-	"T2vUAbN9A8XhQ++5Ubtx7qAtay0yu47MoEl4sB04MZ1KF7z0bne7lj6+mg8PwV29yf2cIT/5e/CIh1QN",
-	"NTJ2z3IGvAOFKJaqelArCnZflrslxu5ZjOy+3fC3j7j1kHeEZisikVSQ20eB9hT2DTHq7TNSzBrtOE07",
-	"4ECbkoOuO3Ymy+/8zvppLEI3ex5LNMZfq7dTfbdg9bbnpv5JCbJcuh4yVXcU7bNkkFO+XZc55rZkvd76",
-	"h/QxIuoFpdfMugMIM9uO0/YCFeDqrmzzl88dXTM/2yaxTgx9R/NGH0+93DXbEFw1Eh2hiWlEQyVHOZeS",
-	"zO2liu2jazLlpq0xy2xnJf/ivULWXAGucwrKQ6hWcM1Mog+Vkog+Bzrofh49TBvcv5Pnig5qD+wGH9vN",
-	"lsFPFUwE7Mwz3wuGCpufOXBoKxniNutZSBAvJJrDCtPFQ42GRUc+sZnQBvd3G3F/G3HpuPQ8luEb6brr",
-	"r/Mfrep2y0fWdPsU3w5dEgZjVxOrfykNQSnfOqwIK1wVW7gmCF4Km6QyNYkI2IYIzszbRFdsbP53RY7G",
-	"DoqRKY9bcamOTD7iboxzEsXRBguC59S7uL4jpCN09Le//vVvtSpg8+cnzbBWwwzBM/uQAZ1QXmSdEMkS",
-	"pJdf7b8W25FpyDS6cbejo5SvQyDWpjQhfVX7Py1Sn+7+PwAA//9fRf2362cAAA==",
+	"H4sIAAAAAAAC/+0da3PbyO2v7KidptdRpPTa6bX55jh2orlE1kjyuTdnD0OJK2kbilS5Szlqxv+9wD7I",
+	"JbmkKPmVXi8fYpPcB4AFsAAWC3/tzOP1Jo5oJHjn9dfOxk/8NRU0kU9+ItjCnwuPBdCALRhN8HVA+Txh",
+	"G8HiqPO6M11RYhqSCHqTOCFpyoJOt8OwwcYXK/gdP8GTa8xuJ6H/TllCg85rkaS02+HzFV37OJnYbbAb",
+	"FwmLlp27uy5CvPajwPOTJXeDo1sQEZMkjboE8AMQl+kaZiQbmpCtH6bUwPfvlCa7HMDC8E2QMUHX3AFi",
+	"17zwk8TfSZBpNA/9LW2k4+Xl4G2X8FWcCBrRgKhnoKWi6YIIwEwP5CatY5bDKEu/bOhc0MBLKAem4LQK",
+	"5cDAEWxiBtRMqEiTiMNLxhVZu7IBp8mWzSm5ZWFIZpSs/eQzIOVz4m99FvqzkJI/0t6yR97TMIzJVZyE",
+	"wXe9mjWpAtaMyEqIjQd8vIoDN4e8n05HRDUgKaeSVWC8+WcDHguZ2PXIW7rw01AQQO7d2bQOPHs6G7Df",
+	"J3QBn3/Xz4Wsr77y/nvo81F2ObFmlNCziAnmh15AQ3/nrYGCjNN5HAU17B6l6xkwNbCI3RZRuvWZICkw",
+	"Q0joFzpPBRBILs+CJVwoKsz9MKzBqwEQG81FnKx9IduLv3zfyfgfHukSmBBx2vjzz/4SedONg/5Oct4F",
+	"OH2R8Y8Cn9YoFWv0wzheDlMDkFgZqTPMbJikRwaCrFMuoheCcAEqDeCE5jlleejzVY+cg/iyCFpE0PuT",
+	"Hqa/on4oVp9qiK4xa4QadISnVr0GeGhg2MLJ2TVktMZtomObBcfelAtvFge7WjViCQ6KGKcCwR1dTKZd",
+	"S6NkTMBB7aAKwa44rlkfGzOiJ66T1QJczWQG3ZYw6hC6j/4XS+gyKSK+gC1hI7hiXYmABF0zr5bEJdui",
+	"GKYbgnuUUqD4wo8ITZI4qQVcQXP4Qsh+rbTJsFmTzKi4pTQiOSgNgD6E1lBDwZYWxkvu+XwXzZ28tPBD",
+	"DhvPLIyBz5GpiNkoNM1xdXAMMANovgMV9DsyeSNGRTAcrDOL45D6kYRcy/pey8moFkvvaaYGigmfRUYR",
+	"CsuuASMhDQNbLYKWcYu0A47DNCTYgmsWwSawomy5Eg7yq02CqO9dgAT0DZiRc7Qiuwab6fRnXIR47gu1",
+	"21r4gDpdRnGCWCxIFMu2sCBZ85pVKUNm46UY4kBuywa8ZYFrWzCYys/PgaiC6/54ihqNjMxA/ApYJSyk",
+	"2mKg5SbTt2fjMTZd02QpmRBawtuLy2lBtCSUdcjJzagBo4pw5ZvLmzjQ+nnBQnq5CWM/eKP3GxQfYHn8",
+	"dQ1gMPBsRB+p8zLwhRzYQbQZkFkCV5YDPavSKXLGYSwuPpcm8jebkAHJgKL9f3Ek69eW1uBYDz2IFrFC",
+	"seQcRMYE1nuE1DGqM459gjppApZICHb2mTL14uhD7PKQLkErMrnRSk22SuIoTnm4I0bNKQvfDKL05pb5",
+	"5IrOOChYCksPdo1Us0Ag6q+RRmCVJDH4VkKviBzb4xokLxtO6tAqUBnM0u85DLjK3I5ZpUNas8sb1vul",
+	"tuNNxhHx7F+wDJWOzdhWu3c7p3EU4a8VSrwiL8npxXB4djol/T55A3xO6GKBFp006+C3Wz8JAPzr6M/Q",
+	"dnjhWc1HxSYkYBx3OlQtFEwWhFW3hjd5VwvE3I09NTuQjAwUKDxf5/53Wz8YIRDJTvqNR3XeeltfByaC",
+	"gCG5/HBUAKtukJzsbI1+gtI9jvawgiLl+6Q1I8xENXewEb4uzFbFvptT0cKuhlcK8zl5ZjK9GI3O3hLF",
+	"FePL4XAwfAdP38PT5fDH4cXV0GIC3Rre6Jbwm2nl4gWUz1Ol/93CS8zXsjCWwzUHhE1smhaGcRHJghC0",
+	"Kaj8KtfSL0x48zigrXbKbgeE14tTsUkdYnrCebqmnFxOz1/+HeMycaDUYLOGyUEoDL8HoYlUsxc1oKj3",
+	"aICAC4Hgo03CttmGXVmSAh1KY0Wga9EPA92Kmhb8WHB1KefAyBhIm9OCKYAjSeXSgppcBLB3FffbnaCd",
+	"rksMg1hhuqepS8TPwRY40VHGtzZyZXZwO/8jdPwT8F0kCbXpg/ZFFuV0Qsz+46DmBN4awxCHkEYjYsJL",
+	"JPvbX50kE/SL8DYJ3TJ662BBMmNy2bEZ0XZIF23LHGSw4W45+SNn4If5MhhwORz88wUnL1bUD158t5dh",
+	"TTgC8bvZQ+0xXdAERMFBCWzGiWlICu5IcVWMbq5GR21K8mw1urjdYRCP3K6kb6pgwM0PqMMUGy9S8LKd",
+	"rGYMgyeZrkRbHSWXGO+jrbJu6zQbwuhlofVUtsVgqW4M+9cF7Ga/NG9r7qW86x5iut44RbIm2ImqSO9H",
+	"787QIMHIj3P7GeBG+ja+jRCxj07NhXvgyYerk58negv8OJhM1MZmJlGf4YX55JrqxzQRMWf8nPq4iOeh",
+	"v3RPBtbTYDiZji9Pp4OL4cQ7PTl9X5yvroVrWjTcHAoEHEyw+S8kJUGOLzFW/WZHPkqXBgT8TAfi+XdV",
+	"Czz3RkCHxCKex6Fz380jiS20uEj8iMtAoT1mE4NMTY+R6QDDYDzJE2xNY9eOhpERGXHSLUiQJhIRFDAN",
+	"+D7hyuKYDohdwlbg46rBW7Ya0noS6e2ywcZpJ1FTbFtGSw6Qz9FVkDUhNNVTGq4En/1iDB0Hw/ML+HF1",
+	"Mh7WMSUMgdHtUQyMtKuRgrOfzsZa4jL5ygQAP8Kz/uCcIo2M3zpSUXwH8UOgkLfJPxfBuFqBapFxsvwc",
+	"IQ+Ryc6BPEiLRe86ysOFAhW43cnEmTdpCC4TWSTxWn4/GQ1OCQZDwnx8ESe0R2AwJmA39Uuf5dAYGEm5",
+	"uI5WPlgSM4ycKs1M8dAJdwyl1Ev4k03CYhW3h+0bm1VppPBQAZtaNDTmEo13TLxPZ/AWGNj2n+X68pyN",
+	"szBLF6meBp45vnCe3OgYj4wQY+R759ph1TiwYybHjxEkOy9Jo+beJsxURQWDJwnD418/9BZKsRedkSZ5",
+	"dO0IDgd1ycQqnXl+KlaeiD/T6EhcldMY6M3OW2vN0wRhdXtENQQuo7dIo7ncAdxmlTw+tM6XsQ8xfdRJ",
+	"bqDjeOooBnj6ugMrcd1xgR7BRDIID88Z4McsGOYhgAyGjK+bh/hrO99D5jU0hA5UHLzsCJhcCIIhQ2n3",
+	"IUGMKGbyViBax6GJjTfhoTENvOGppQFzy70imfehRkZDHv/D3jVrgi+v5Yr3UKKvO6qXNkeTOFbuoQG5",
+	"nSNlqZ2JhNCpmX/TEQfoiN9k+9cm28CyzA/BOw48ngnJnihzpYvLfJuok7tB5ik74n4nkesAEc9uCJi5",
+	"IZVQ4/qjp9lV3KAPEHWWkXZB27jgQ4uX9KzOSIg+cdzvWDcNYgCsGUYenRaQ2DtodRFyMLUPXpm3aV1q",
+	"3AQrZN4qhCxF6z70Bmtx6wvQvRsP+L/mmHkwIvgRnIZyTgvobmCdUnJZ7SToRjVG4Jtwli6ua6PZpDNw",
+	"MOoxGMnvNhJ/AqcQ4f6TDThGZBJaxI7DZurPV3gGch0NL6Znr8mVSSnCjcREy/IOmNMDShBTMoqpbAEL",
+	"8BvoTIb5hNFOnsdwmaMnM1JAZWCCShBTOQhPN/LAJqH4A+14hadFemAhF7EVOR6H1obv2510aGY35xxP",
+	"JN2Th5TssnyUmblO9iuk6lrS3aAYnu+MxmxejUHvJzFz3OZJldELSYGOzwVDoYUp0GxoVyZIVHgDGMHE",
+	"N/aEZaxgSHHrz20Q1354qIFQSGes9nfNXKRWIyWcy1Nn/1aI5OR8k3ggUxOqLGdetwtDm9EGeJK1SaiQ",
+	"kT819r5gtOn7E1AnOKJfloagu91Uzu/k+0YiFIdwCKDJDaAN5PLqg4guiLLmezMVytM3oeK2cVjNW4Av",
+	"nbuVDnZpj1Gh9V6E7IlbNAbYm1FuQMPcIWivLx3DnuhBnDkOcnHQWPFK9KxGifLv9QqWcY9/ZpsNDVyZ",
+	"VJhIzJmZ4UA0RqZrw3oY1ZLTrRbFAqwtFyijpGuhGokCJhmoNVR1qCPdtLF0LA6nrowc4tvlvVxztsRx",
+	"ZK1Q2dUI03XUcmNExV9LkBBMmkPSug2u2aB6iK6BqSVudYeZNgclNW0sOrubt14mR/dDdE59Hxvn6k7m",
+	"wNpu9DybgxOGJszGaXTOIsZXNDjbOkURXCkwO1QTj7rboHRAM57O5wDpIg33SmSelbNnK6mMvJcGDoD3",
+	"UMCdZpmFkvLUxg8qWfGgnQPGNwd5H1DIHLuG1RQcxCU6yI5kF/3Fc2/f8zRJYH7wdegma9I+Oa/Q/bDz",
+	"5BjtTezHj9FBVbiLQ7pB28sERWrtWf+xdVtubyKqSX9tZws35PW2NWkNg9akZtTxWRt+Hue38Q417fOd",
+	"v7VpfpAhb8tC2z7VremA2YpqsG3HK1/e/TkARJVkk69b2dOp8OA2a/A8e0pl/iZpMvSowHi750N78Msd",
+	"9iJgpnbDHSdU5raZxKnzJF7rSFAV2HYHNcWEM2cALU6TufLtay5kzziYYoIS1VLdZrTCo+qtmihLF4g3",
+	"TKcL7I+xWQA0JLBJ8lzRWYFCLtWCSQnQjhTTBFseTBxOvzQJHcHL8Qc8dTKHc+pmoUlYbEUVHLaBGtVE",
+	"KGeQcHo60gHCyel0ZKKDb0dWZBCawBN+xpAgfLpxXFnpZp66YAIjYFlqGxmfTaZgDGFiCwywpQnX0/f+",
+	"3HuFoALRI3/D4NVfeq/glbopK9egr6Pt+AAts8f+imFSzK78+mv1WvpdmzZ9Q3s565KqREZgBqlLBkDw",
+	"zjsqzvQQ+md+WnaS9e4WqhrU7FB5k77jGv3dTen2z/evXh1096eVyVeXf1lOjK/cDppk5q25/SrPw9VR",
+	"at2sGT59dZVJ3ihK12u8APW68wEWU96aLAqTzBTy0YL5xZQb6Ei7ou1a9mWe1EsTkt3E3LGu4PS2WNgP",
+	"ONK5img+0AqbW2W7eqJZF8/6pVtnd/dkkmPOeWpThKu23pPwjYKB63S4Qtq8yaXPtBDCTiY7MMzX92ar",
+	"hK5jQSt8VdooaBhyk9FnX7C1tL5f4vg8C/AW9qdmFI7h4rEE/BHZ+EHuJ7o3chdL1W/lxVvH9xWWIxTp",
+	"kwjASYB3+nFNFfcDxzwKw+vDSWhXveJ9d5QIgP23q2F/3y6B8cACoI1lXsl3eRB56O7t5bgh/6hSVOst",
+	"3BXNyV+7kGi6v+CPKydfHQWn7u5nUZpfnpxVXbWznt40tdMLnsc4HYAbReciK5OBZb1kemvlmt7Ds07f",
+	"WAoPzUMmxfN/h5fiuaDipSoBcHBBhSdgE0NR3mDUPaDO0ZHd/Q3N1n0UB5nt8qHYpLQKFJNGjW6WWbgA",
+	"ocxUwxXVJ7WOMh48B+uICnk3z+A52Xmcz+QsjXXxvICCFRbKAioZnYk/w4tvPppr2XJglTGd028lax7B",
+	"q5n1OFdlKMwJ8+HuuOHH03ygb9iPMVU32ttaj+8xw1rkK1wq8XHf5c1jcsdrmvd6jP+ZOJojc/557BQj",
+	"306xxtoJXKYF/4GoZWIYMrEU6b1Wvs4tPJoNvjn/7JH8osK+8M1sAwQtXsB+bqdBPzR/9Od5hZd7bAUV",
+	"TrFKw/y6HHq7Ko6DV0p1cZ7Ot6/WwnkaTlapAVQFmJZsS6OsUoy+Z+KbuxWW/5+n1T8GT5tSxfDZqm8K",
+	"fl6pEoVWjEUavZEVJVUNyRwnWfGzUgI5KyvZzS5uYwWDVRKnyxUgLnsZSncfQgNnxR/wtoeq3XlSLPL6",
+	"XPK2v5dda7ZFc7vEcpvR8WC6RbuGCscteps6qO2bHjlRtf51qymtSrd1W6ZTJdxbD5zKgsN4qdLEjUvF",
+	"hx9BzEH11IrxOI3klUijiqyAdrwF89uqbUhyj6iblzeU9S5V0iEWIGbyihjvXUey3HzIMAKF1Yk5+VQp",
+	"2zWIoNcnU0RLVdNNwJAtl9WcTN8Ohl2s2iALNF5HQD4gM5aQwtmn0597ZFoqyYWTCjLbZRekAJlVHAa8",
+	"UqWLYJ2Q3jetEgol3lq0x9KhbZoVy6ce0kNXln1Ue7OuzNu+HTtTBPc0PlE0nGXjCnJiyWxe8/P+Uts+",
+	"ZqYTxI5zYUznb8GFbZPmVwixP4nlBuRTiiVL88RSCkcqaj1EX99j4/XHkSZdwK/cvEddhyXhBd2oW7zK",
+	"djYVbOx6N3PgX7sWtqzF76grdND5ZKkz/8aTTB5nH69fnodiDTDKs8uOTcfW9t8ckQmLYE4tl7roU17O",
+	"CNVWQAHq3To7FKpy1pudqXzRxfJNYXgdKSMeNaEs5auKHgNxVKKkqtb0qaY88CdVoV2zoflzIoWCxTjc",
+	"dYRFlDPtiX9EAitHhTwmgDRnM3UKqorYy+1b/k0BwEiWQjMlKnJk5Zn9ehNSYSCE79eRjMyTjBPJJ0f5",
+	"+k+9+0mD/jl4Kp/euhHb2tgu1ut/rBCAQ8888UG+6ybCE7v7VSEz9WOxpA9w+Yyu/HBxX6Wh0OGPrCZQ",
+	"4f6mI47XERO9Sk+jGZ5J1nVBrP9rUVdTPrCkq9oZqumSRbSvk9jxTaYIMv5Gt8ItcLlvoauWGC4skkom",
+	"EYPO2LIkjuRlYn07QP5Rr9d9DUVP5rOugP1fyyjiXR+z47udrZ8wDPlpnWRKuGpCd/7xww//sNL25eMN",
+	"Llilwk0SB+rmETnFUmi1EPEMpJdf1U+FbU9WUOt91ukMPVhYF4hWlyKkr6x/yFI3d/8FB5mlRBtwAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
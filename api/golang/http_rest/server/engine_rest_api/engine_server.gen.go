@@ -750,34 +750,43 @@ func (sh *strictHandler) GetEngineInfo(ctx echo.Context) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RZW2/bNhT+KwK3R9XK2oeifssSrzXW2obtYBuKQGXEY5uNRKok5TYw/N8HUtTNohU5",
-	"TQw0T5Z0rt+58BxmhyKepJwBUxINdyjFAiegQJgnYFGMtxBSAkzRFQWh3xKQkaCpopyhIbq5GV/7ntxw",
-	"oYAB8fJnLjyGE/D4ylMb8Kwg5COqeVKsNshHmgINXVp8JOBbRgUQNFQiAx/JaAMJ1urVQ6q5pBKUrdF+",
-	"r2kTvoUQx3HbvPHKMwK8nMjDcVxYIwfeVG1AfKcSPM7ih4JGKp6mQGp017DCWaw8Kr0VjmXpyLcMxEPl",
-	"Sc0Qh8V3nMeAGdrnNsuUMwkG5wlX03v9I+JMAVP6J07TmEZYuxF8ldqXXU3k7wJWaIh+C6rwBflXGcyt",
-	"6DFb8VzZQcQY/EghUtpDIbgwGFpmLfsypVecKUwZiGu4y9afOIEcWYMCGhoMfAQsS9Dwc/6kUb71W776",
-	"DXELhVVmU8vwovnNZDKevEc+Wiyns9noGvloMp2Eo3/Hi+VoskSVzCLiProSgBWMbFLptBU8BaFoDidO",
-	"aRgVKsOYr8MYtuBIDivBi/naMyS+Z32UnuLeePLXFDnUN+VvQUjKWajw2pGefpneeY44CBILb1dMraUm",
-	"EjpaG57FJMQpjUKRsZCykOhIhX1kueObJ2VRdJ+bZnf5XAWI332FSGn7riEGjfEiSxIsHtohyiuFhHUt",
-	"IWYkzDJKDAVVkMiesExwApeM3GSUaO3WHCwEfjB+teyzfJezcYnEBy7VJxxtKMsLp2XyWqRRmHKhQs7C",
-	"DZcqTHLyWlApU7AGoVXQtIOu0bwqzB08fofe236eud25E5SsIaRpiAkRIKUzN6uQU+IkqKyjTFICRUSP",
-	"gnKU7AgmDQtcEjps8B1edoA2Lg8g2caryFSdoE4kjtZ3eTYe4z1SeYbaym1J6XLDGe9mAdfzKKSWoUel",
-	"dVVMqzM+Ua5bmCyPjr69zR429SyWPcVYq2oS9IljOh5NHm2wS5qAVDhJ6+3/aN48of2fN9Vc+B2JziFQ",
-	"1ruOZC1mi2IeWI4WS+Sj2Xx6fXO1HE8nzgHA0fdbCX8UpH7QWCweK7Z+E83o02z5X5cnSyzWoNrCtIgj",
-	"fOujJxWYb8Uh3ScLGvQubxszZUthZIO44iLBCg1RRpl687qanWpHQAJS4rU7MvmLftPtUtMeemIEVDr8",
-	"3LIuh5ZWZQH4aD6fzpGP7Oz3z+XcBNMVgqrM664TrOCVTf5D2PUJaAFUVMX629+ZUFxS6c1Hi+Uqi73L",
-	"2Rj5qIwduhj8MbjQ6ngKDKcUDdGbwcXgAvlmjTL4B8Wmko+4MShoD7tmJnMtN8upl/OY5ai2rkkvk2Be",
-	"mD3HK5dD70u16XzxkLFNmLIfk1LTqLDJb2yVn93hrUiC2ha1vz3YlF5fXDzbnnQ4pDpWpUUWRSCljkth",
-	"BjJEdhFyKygtDvLFzuxXxSRcxAEqeBRey6oO0a0eq8BIb+L6HlQN1J/CBRNC9ScczxqV3OMQKo7ng5I6",
-	"D3gfqVSPQJdy6cBuxmUTvG8ZSPUnJw/Plk/NrXTf7E16Pd6/YDI3gnOeWOT+1u52WsHY+1VrCjZUKp7v",
-	"go9l9wdL+pN4nbJC1mf/9gp5vtyu3U95tGbTY+ju2rdo++Zp0NWjWyCc3LQdl3jHmvc5WqxUgj90pebj",
-	"LfasmPyqPeA9lO3YI6AwjYF4ZsZ5SsIGWCi6wpG5iz6BOoh5hONXK6pHqpMY9ayh4CmcEsSWRprO/jqo",
-	"vFNk7Yqfzy0jIPw7izkmvYTFfN0P+ML3k4iDiDMGUZ6Mp/BVB8cJTE+OSqcEXRgJZuQZJAEjKae6wnbm",
-	"6oplyZ2J6BbTGN/RmKpncPmEmCosYizuTyIOUhzd4zXIp3EFO/srpGR/moi8sfXWa9fqk3v+orjX+CU6",
-	"f3Ffdf7eX97/PGkgf3HYn3/Yd93b9B/5Xz44i17ByWtHPwTFxcTxEikvnF40kUstZ81irbVzbikbD/yA",
-	"KNP26IZrX4blS3NjWnVdrQnEtkjfpjcf9dDiAdtSwVmiofNRJmI0RBul0mFgIzMww82GSzU0J8U+wClF",
-	"PtpiQfFdnMdAf2j8ixa9e/v2HSr/R5s/3mpMD82YCU4ycyx7VzHPyFGLZGnSq529MjTeDiLNNri3N1mD",
-	"iCcuE2ssTUsvan866rf7/wMAAP//IQrWlZYgAAA=",
+	"H4sIAAAAAAAC/9VabW/bNhD+K4S2j6qdbR+K5VuXZJux1g5sBV3RBiot0TYXmdRIKq0X+L/vSOrVohQ5",
+	"TTysQIFIOh7vnnvh3dEPXsS3KWeEKemdP3gpFnhLFBHmCb5tMYtDLNbmOSYyEjRVlDPv3As2BOUUSHEk",
+	"MuYj4ISAOtsCQ5QSge5xkhHP96he8XdGxA4eGOwBjw32vifI3xkVJPbOlciI78loQ7ZY70sV2RoB1C7V",
+	"C6USlK29vV+8wELgnbeHF4RFCb4nIY1BArqiRLQFv7mZXPpIbrhQhJEY2WcukJYL8RVSoFnOqBA9xWpT",
+	"Se7YpU/+A7H3mnbLYT1OkrZ4ExBAM0CWCAFRIY0coRkIJ75QCYKyZFfQSMXTFFSp6C7JCmeJQlSiFU5k",
+	"lw1qgjgkXnKeEMyMyJKIexr1I6tdIqdDFV0BacSZwpTBC7XBKn9l/QeMkSUxWgLuX0mUKVAFpHVi75Dj",
+	"OOzBu7eU4STcELreKIcBGFUUJ8h+90ESFG0gMCIdF36hTRB80KbhEdbSQgDU9BmhyZpxobVYIcYNLRii",
+	"JO8wxqFkdb2MEeuKrbjYYpAeOKmffvTKWIBHsgZQGqp+oTFg2Kmp+fxfKGrl+nY91a4njnBLrAMtkP5P",
+	"lUSL4PJqPtekWyLWxgmBEt7OboLBIaWF6dWoFVwmIUhIw5KYNDflanZnEzAoyYyL4jRNKAgPuo3/klrB",
+	"hxrL7wVZAcvvxlU6H9uvcjzPWU/YitvNDtIhI19TEmlYiBDcIpov1rzfpPSiiNxLsszW73hMLNwGj1JD",
+	"wrKtd/7RPmnob/2Wrn6D3UJhlZlN8rXe/GY6nUx/AwAXwez6+uoS/prOpuHVn5NFcDUNvIpndQpcCAJW",
+	"vcoztj7GBIfDR1ELJ05pWOaeMOHrMCH3xJF5cw4ISJAh8VGuo9QeM5n+OvMc2zf530PsALtQ4bXzyCrO",
+	"DustDoJtDm+fTXNJjSW0tUz6DEGQKIRzOKQsjLWlwiG83Pa1Tln48Mem2H06Vwbiy7/ArbR8lyQhGuNF",
+	"BvEmdm0T2WMoDuu7hLo2yDIay0YNMACWKax+w+IbWOssElry5eveXE9KJH7nUr3D0Qb+NoHTEnkt0ihM",
+	"oYYIQfENUIdbS14zapmjIFOkPXSNyqDC3LHG79n3dphmbnWWgsZrOFXTEMcxZCN3vVWZHKB1EVTSUSbh",
+	"iC4s2glKJ1kHJg0JXBx6ZPAdWvaANikrDNnGq/BU7aBOJDrjuyw8u9Z2RJ6hzvm2uPSp4bR3M4DrfgSo",
+	"2QUDIq0vYlqZ8Yl83cxkeXQMzW35YVP3YjmQTS5VjYM+cUzGo9tHE2wANLDPNq2n/06/eUL6P62rufDr",
+	"sM4hULl2Pc5a1BZFPRBcLQJYdj2fXd5cBJPZ1FkAOPJ+y+E7QRoGTY7FY8E2rKK5encdfOjTJICemKg2",
+	"M82iY92686Qi5ltxSA/xgga9U1vo0i5s8bxQYOLtLFNp5uil7HvdSmCmq2miuwt6X5beAMaBsF+pAkcq",
+	"KswGL93wSgLcmKneEywVFOpS4jXRowdoOutFveZkOpBHOwhwcRVD7dtoN5Y7RVzVHpByq+kjpK5Co1GL",
+	"twxVqF0yzrolzvXumYoM6woCTXvoAYZBtYdvJbvtUSjItywcFdqo2RwW5jXz+zdzEwQu163SY131GCr6",
+	"V3nSOARWVw45gIqqRH/7IxOKS+jM5pAwVlmC4ASBlaXPe2ejH0ZnejsAnEGyglc/jc7glW/mCwb/cTE+",
+	"sb4HNavDC00t65q4BDNk15iJTW2GJFEmrWuaThGVQzb0uRq/fEaekU2YdDmJy52uCpn8xnTuo9u8Fcm4",
+	"NtrZ3x50mD+enT1bf3lY3DtazEUWReBL2i6FGJ4hyhtI9walxGPbEJu+tOggCjuQCh7oPmSVv7xbXY4S",
+	"w72J629E1UD9JlygiqT6E06uG5E84PAuypqDkDoNeG8ppM5+6FIo59rYXcPbBnjg0lL9wuPds/lTs5vf",
+	"N3OTHivsX9CZG8Y5jS2svrWBc8sYQF6mpvEGTMdtD/2Yd/+ek34jXse03vWeqd16n863a0Pz2iRaPoru",
+	"Q3u0v2+eBn05ugXC0UnbcbPQlbxPkWLhyOW7Ptd8PMWeFJP/aw4AvEp3jQk0UYkZPoMAT3HYMYaDaAWF",
+	"tobmCOqxHo8nr1ZUl1RHLdS1BtRrT1iZX+cAXftiZ38kr4fiz+fmMY75F5ZwHA9ilvD1MOAL3Y8iBqdi",
+	"DEoF28odsa46OI5Y9GSr9HIYF93ft3MiLE451RH2YEZ+0H8sjUXvIYjwkiZU7Z5jG2h4a0duMyPMM4Zw",
+	"2XdS/VDcgkIFLuDxPVlKHt1BlFfW86GH0K0z9DTm8onbVhlLeNK36XL0iZk79oTq23QJmkr0udV5Txis",
+	"+lz0weai4ouAI/vwjmsRXE6mPnDj0IxQ9YlxofMX/YeY3YPgA7QwB1213lSh5c4w0iqBMhuegByHjTbS",
+	"HeLoWVK7/+gqxxXwgFWNnxsMoNf3eEPImneZx6zIr3lf9jDrmNSc6GAzoeGc/DTipHbMlUEx6KTrj9rh",
+	"mVhhkWBxdxQx2DS60zH3tFWQsOxfQLY/joW12uB98yHi0ZXaopji/i/qtWI6f/qKrZx2P6mNfnHYn79F",
+	"d02phzfqL2+cxSDj2NjRD+NinNgdIuV4/UUdudzlpF6sd+3tNsrEY3+dBfLohJu/DMuX5n6oyrr5j8YK",
+	"921q81a3GrD3PRWc6Z8L6ssVkcCXjVLp+Ti3zMi0JPp68tzUd1DYpVQPdrGgeJlYG+gPjR+keD+/fv2z",
+	"V/4ixT7eakwPxbgWPM5MOYYuEp7FnRLJUqRXD/kFidF2FOllo7t8/jwC6F0i1pY0JT2r/dNWv93/Cxu6",
+	"NB+UKQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
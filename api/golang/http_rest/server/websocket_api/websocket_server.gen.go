@@ -24,6 +24,9 @@ type ServerInterface interface {
 	// Get enclave's services logs
 	// (GET /enclaves/{enclave_identifier}/logs)
 	GetEnclavesEnclaveIdentifierLogs(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, params GetEnclavesEnclaveIdentifierLogsParams) error
+	// Run an interactive command in a service
+	// (GET /enclaves/{enclave_identifier}/services/{service_identifier}/exec)
+	GetEnclavesEnclaveIdentifierServicesServiceIdentifierExec(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecParams) error
 	// Get service logs
 	// (GET /enclaves/{enclave_identifier}/services/{service_identifier}/logs)
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsParams) error
@@ -90,6 +93,60 @@ func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierLogs(ctx echo.Conte
 	return err
 }
 
+// GetEnclavesEnclaveIdentifierServicesServiceIdentifierExec converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierServicesServiceIdentifierExec(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "enclave_identifier" -------------
+	var enclaveIdentifier EnclaveIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "enclave_identifier", runtime.ParamLocationPath, ctx.Param("enclave_identifier"), &enclaveIdentifier)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter enclave_identifier: %s", err))
+	}
+
+	// ------------- Path parameter "service_identifier" -------------
+	var serviceIdentifier ServiceIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "service_identifier", runtime.ParamLocationPath, ctx.Param("service_identifier"), &serviceIdentifier)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter service_identifier: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecParams
+	// ------------- Required query parameter "command_args" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "command_args", ctx.QueryParams(), &params.CommandArgs)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter command_args: %s", err))
+	}
+
+	// ------------- Optional query parameter "tty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tty", ctx.QueryParams(), &params.Tty)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tty: %s", err))
+	}
+
+	// ------------- Optional query parameter "terminal_width" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "terminal_width", ctx.QueryParams(), &params.TerminalWidth)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter terminal_width: %s", err))
+	}
+
+	// ------------- Optional query parameter "terminal_height" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "terminal_height", ctx.QueryParams(), &params.TerminalHeight)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter terminal_height: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEnclavesEnclaveIdentifierServicesServiceIdentifierExec(ctx, enclaveIdentifier, serviceIdentifier, params)
+	return err
+}
+
 // GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs converts echo context to params.
 func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs(ctx echo.Context) error {
 	var err error
@@ -189,6 +246,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.GET(baseURL+"/enclaves/:enclave_identifier/logs", wrapper.GetEnclavesEnclaveIdentifierLogs)
+	router.GET(baseURL+"/enclaves/:enclave_identifier/services/:service_identifier/exec", wrapper.GetEnclavesEnclaveIdentifierServicesServiceIdentifierExec)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services/:service_identifier/logs", wrapper.GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs)
 	router.GET(baseURL+"/starlark/executions/:starlark_execution_uuid/logs", wrapper.GetStarlarkExecutionsStarlarkExecutionUuidLogs)
 
@@ -197,40 +255,47 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xa3W/buhX/VwhuwDZAtbLeh4vrt6LX7Q2WJUHirhdoA11aOrLZUKTKD7de4P99IEXJ",
-	"+qBlOQtQDFheYlPnm+f8eHToJ5yKohQcuFZ4/oRLIkkBGqT7lgr+xfBU0y0kOWX1MuV4jr8akDscYU4K",
-	"wPMgaYRVuoGCOB4NhWP+s4Qcz/Gf4oPiuCJT8ZVYX1EO7xw/3kdY70ornEhJdni/jzDwlJEtJDQDrmlO",
-	"QVqZGahU0lJTYS378OHy1wipjZAaOGSo+i4ksqYikSO9AeQF4ajypiR6c3AmoCXCEr4aKiHDcy0NtH3z",
-	"ViotKV87M3PBmPiWMLE+GrA2SUDYSggGhDtp3BSWLmGUw1F5XaKARMo1rG1Y99YXbSRPCGOjNvbJTtip",
-	"QG5pOr45yw0gT4cOdPWupIJrQjlIpDdE+6WiIDyz+2lYhlaA4DukRkOGKA9vX8CO87avFmAMzRIF+lh8",
-	"BnRjapoK6OkLZLnSRDIiH5PKVSq4UxGOpuH0q+kEUwukJUkfq0SvRdgYE3TvRaNKjC2LkqSPZH2kFI6Z",
-	"ck5AXcKpUnBVpe+10DePHl80cBdeUpaMpsQqiL8o69xTS+IYaNx50Zc8F5WyHhxw+F5CahMGpBRVAXhm",
-	"K9uDjkM/KUqQmlZmMr86deMirGkBSpOiPGXzsiGsglNH8lOltC3poVEjVl8g1VZPFycHhtvPRAs5EW5v",
-	"anLrAXzXSUm0BsnDtdE2tlHUYxyx+aZlG3BTWDG/3izuk7c318s3l9fJcvH7EkfV2vXNMrher/3zzfLt",
-	"b8nd4v3i9xBL+/HBpMP2dTJnEMVUZG77cyELovEcG8r1T69xNADUCBeglC2h40kyLYeXlrYfZSfgoCOq",
-	"LAvFuCOmFeDF3d3NHY7w5fW7Gxzhj2/uri+v3wdjcl9B2pU/Fboh4UInuTDcgl0AISfXSc1tD5VktetI",
-	"c2CQZdRWL2G3Hf0Tkrmlro7LPhCpGgYXDhEGjkK9LDjc5Hj+aVx3Le2Sa5ClBO1wrJK9j6bx/oswmj2D",
-	"b1Hjsmd76KdP5cvDWBC6IobRaKAfRsKVHC+CkEUN+UNowzrkPfVjroRLmR5ZVVqatDomQizTPepQn3So",
-	"rXgCcS5OuDziBpFrU9TN/aQePCD2jRcSKuVqc8iKQdKL5wAFWs+TqrsIEalEPdKyhCzUZEa4FIrWGs50",
-	"47ZmHdmPyrCoFbejLnZsnbhBTSRDGzUaFAmlBAXcQssWwrFRIClh9N+QJVbcljAzIXeDXCGdE328be1Q",
-	"/0RlpuCdM/X4kZpTBkcDUrdmJ+X0fG2ERnWj5W2a6NsdKMP0KJQk8ghNK85h8snbFGA/B3OO87R9Hp5k",
-	"Aa/bRD/mcAjaMObZneHvKKdqA9liGyxFaXiSe5IEwjS2OgxPlElTUCo37GRFCqNLM2Gfh5JPxiBg8IkI",
-	"3EqxlqACLV7pnyThMzM1UgLXidJQNiTTG78OOzfFqnp9mQAHWmjCHJ96TuEP7e6KDJt2MvLdaJ0Iet2d",
-	"12+b3dfU5sW86cZQzYCjs/vQwzE1uY88q+ts59BUniGOnqGtW7NTGT8SyatUnGpiLmwLfdi3fls+qIht",
-	"Q/BjAHCgfywL63gMbPx24sF08/sMJx2oVYfsXrYHKk3NZ0TDK03dMR6YNdWwpKlm9tk/jNRCUYXuFvfL",
-	"3DD05vYSR3gLUlWldzH7++zCqhMlcFJSPMc/zS5mFzhywzAXh9jPhO2XfXT4Gm+o0kLu+stPwxnyfgpN",
-	"TKSmOUm1Oo86ZiIl7JVtcM5klFAIDc/h9K/tKn4aTlzPdDZ+qj++tIw4E984EySbJKyehq+rkUYXot+D",
-	"RoVhmpasuUCoh9kKWVaUCu4PEraboeWGKgQ8KwXlGqWEI6UlkMINZh39aoeA6g1IpLS1nq8/c4I+wkqJ",
-	"9BG0lcfBQSb6q4RUFAXwDLK/ISERgzVJd+i35fLWy6V8PcORH8tRwS+zyuqF99n/v2wcvqqm+u1bnyNH",
-	"zIEkDtyOHMPXFtdgYjSBp31HMoE8dAc1ga1/yzGBpXvZsn/oDbhfX1y82Hi7PZALTLfvmz4R1SZgR5QT",
-	"/1oREt5YG1ezeDcSN0VBLJC5PPeb/BfVTXCLuMSWyCfcpBx2I6cThVVLmVSFDbAc0v88vjNA+QVQbFRC",
-	"7C+wXkBSjSQqfiqF1L5L3cdkSygjK8qofgGXT2NgfX9nCf8HQM5XkPL/fyj6ncf1f/z7YfjXzvHng55v",
-	"u6cVpSeO/X2seh5X/OQ/JTTbnyeiiutkvdocSNeUQ+wbb7vSyGyG9xZswnfJJxDnvocmIkeEH+6xD/fb",
-	"7icD30ACopxqSjRkyCjK1+gPCVpS2PobJ6J2PP1j9pkvN4DcF9+8EY5W7kcIyhSQIcHZDgmeAiI8Q/C9",
-	"pBIQyTVIT1NW9+oSvUYbYaSqH0pwme40nA+OaCI2fubTwXFw1aMGKx8MzZ6FiMd+IvBfw8JZ9xX9Acvw",
-	"dxXj2PHVgNIvAh2B1BxDEX8fWge7a+KVfatDwLdUCu5uDiJsJMNzvNG6nMe+9Gbu7W8jlJ671mAf29fY",
-	"CG+JpGTF/GhPSF9e3kH8y88//4Kj5prYfXUW9c24lSKrJjfoLRMmO2qRakx69VT9r0p8llq22aN/FZ+l",
-	"ogiZ2GLpWnrR+rNb+bD/TwAAAP//53BKX7omAAA=",
+	"H4sIAAAAAAAC/+1abW/bOBL+K4TvgLsDXDvX/bDYfAsat2tcLw4S9bqLNlBpibbZ0KRKUkl9Qf77DV8k",
+	"64WS5TS3wQFXoHAkzQxnhjMPhxw+jBKxzQQnXKvR6cMowxJviSbSPsG3LeZpjOXaPqdEJZJmmgo+Oh1F",
+	"G4I8BdICyZyPEUhCQJ1vQSDKiER3mOVkNB5Rw/EtJ3IHDxzGgMea+PFIkm85lSQdnWqZk/FIJRuyxWZc",
+	"qsnWKqB3mWFUWlK+Hj2OixdYSrwbPcKLRPCvOU80vSPxirLCkvDwbdLgqH+WZAX0f5rufTV1ZGr6Xqzf",
+	"U07eWv6gRoQnDMMQNAVGuqJA1nLlhw/z8zFSGyE14SRF7llIZFRFYoU0+NoLKpyZYb3ZGxMYpc+jDUeC",
+	"mivBmLiPmVh3OqxKEhC2FIIRzK00nm8NXczAN53y6kQBiZRrsjZufTS26FzyGDPWq2OT7ICeisg7mvRP",
+	"jolzT4f2dMWsQBRpDAZIeMLav3JJAfOZsxQtYeq+kyTXMLGgcnD6AnocN32FgDynaayI7vJPi+6Z8k5p",
+	"LBmWt7EzFTxnhwh7M+cU1Ko6E/BDS5zcukAvRBgfY3TtRSMnxqRFBqR43ZEKXaoc51BI5y3lmMUbQtcb",
+	"3TZkzqmmmCH3fQxTi5INwGdigGRchEcU/Y4gGEWCzfQbM/cBMkHzNRfShMUKcWFpqdqTd8BmU7OqXSvM",
+	"VM2wlZBbrF0u/fR6NA6lVinwnqbgyE5L7eeXMNTp9eN26l3AOFDfBAMEWlOthhXI/KdaoevofHZ1ZUi3",
+	"RK5tVgMlvF18iCbonKxwzrT5bLXsMk7v+i1qoZUFQQULkHKQeiH04tYt02AktyGKs4xRUB5sm35VxsCH",
+	"isi+hezKi57zlXCDNZYoTr5nJDFuIVIK51HPbGTPINneOEdda0nwdpHrLA/kjXtvU5sbzxETSbAGF24G",
+	"r2RSQO2gqbOTfKc6TkRKArI42wE2gzRuZ4phpWFSlAJwMMUIIHZ1Ao0kG20Ho8UAWgp21kJrudNkT7sH",
+	"QyAVztIDpHvgFMuv4EvD7AsIW3zVzGb+7VAQhmcKpmu8zQ7NdVQSuqAqYvCTG7Qq6aZbZV/ztBQ3f2Mt",
+	"5MDSaVGQWyT6rmNAc5DLw7BcVbYcqMHYo/OiohuB+sOIOV/MruM3i4vobH4RR7PfIpBn310souD74t0/",
+	"z6I3v8ZXs3ez30Is1c83gbCpZVzLi0XAlyGVd8eqj/ieIBmW+5GhbXrZCtiPMXaahXxcE1NxMIDl4goY",
+	"5xdvF/Dz8ezqYn7xLuiTa1eevPcVXt0lXOh4JXJuCpdAtTM4TwpuUyDGy11NmgXRNKUGYDC7rI0/IJhH",
+	"7RQPJX1R0swskrYMJcVrGGQBQ37qH7uQNjdgmkEFbPHfyX4cD+P9F2Y0fQLfrKixPNtNM3ycLTd9TqiL",
+	"aHujLONIj7vi7iQIaVSS34QmrEbeGL7PlHAq0463oF+euJUsxDLcohr1QYOqAw8gBt37Te4xozgKUIP3",
+	"0wGxZ15IKJXd5OAlg61TXZEWClS+x64GCxGpWN3SLCNpqASDykQoWoxwpBmXBWvPfDjFxhW/dZpY03Xg",
+	"BJWeDE1Ur1MkAVxRwIpNqRb2DcAo7BLov4k90Yndyc/B2A1yhcYcaONlZYaaKyrLt3zQZgEqRMpIp0OK",
+	"0mzIpqNqayl0XBRaXqeBtsECCzuLXigBr4VpKn4Okw+epgD7MZjTzVO1ub2SBayuEr3M4hDUoc+yq5y/",
+	"pZyqDUlnd8FUlDmPV54kJmEakx1ApvIkAU1XOTuYkaLcjR1YSlqSD/ogoPABD0BZtYYoCJR4mf8Sh9fM",
+	"JJcS5MdKk6wkGV741dihOF0SORAOtNCYWT71lMRv610XGVbtoOfr3jrg9KI6L3ab9Z10echWVmOoYADt",
+	"jq1D98vU4DryqKqzGkNDedo4esRo9ZwdyvgRS+5CcaiK5uzlpjJvzbK8lRF3JcHLAGBr/L4oLPzR0vH+",
+	"wIfh6jcZDhpQDB3SO6oeqJQ5D+aSV+aEJHDAY+tMB0uaama+/SOXGmoSha5m1xEAKjq7nAPnHZHKpd7J",
+	"5O+TEzMcGM5xRuHVT5MTeDW2B9vWD1Pf3zEPQFk+TjdUaSF3zdcP7X7Q4xCaKQbXr3Ci1XHUU3Nkyl6Z",
+	"AudIRkm2Avz5BE6/bQe6dvfkSGOnD8Wfzy1jmop7zgROBwkrOltrEjg4fUc02gJu0YyVzcCiMaWQYTXN",
+	"KL+QsN0ERRAcQJhmAtYllGCOlD2ZdUelhn65Q4TCk0SmaaIhhj9zjD6SpRLJLQwH8jixkIn+Kok5RgVx",
+	"JP2b6cEwssbJDv0aRZdeLrBPRmN/LAc889RpPfM2+995afB716GrNp07lpg9yTTQ6ezC1wpX68RoAE+1",
+	"3zmAPNRPHsDW7FgOYKk3Th9vGo2B1ycnz9YWqB7IBboC12WdiAoVRpbI9kC6hJfaTl0Pw7YS8u0WGyCz",
+	"ce4n+S+qHuAGcbFJkU+jMuRG9sjpQGIVUgZlYQks+/A/ju8IUH4GFOuVMC26Kj8uqUAS+JwJqX2VCgh4",
+	"hynDS8qo3j3HMFCAdmIglGIIl/0cah6K1ryAFRWFwWu8ByjbwHObIYQVPJl7K2rymdvbLIyaeysKLFXo",
+	"S6ujNefA9aXoLynTHryXsPto9gmvo/P5xRikCUVghM8c0BJCHvZhdvQo+t1gc71bZQbVBo+1v20AxmwE",
+	"Az2aDSxkzt8nfzBsHsdVu9gzgN70QoeQ1fvBx3D4Vvl/FSq7OqB/EGza1Ah2VGt58sMQGs7ag5VLkaaG",
+	"8H+gNPHrnvK/L1qzHMf1/6rlxaqWaow/Pc/8ZnnYUuqJp/5GlHoaF6zo7i8gezxOhPPr4HF1viddQyBM",
+	"/XbZvCllli03Azbh21wHEOe6gSbutkl5yLW/YWYv7d0TCUuzveVk7rfkyhQKXyDEJSV3vk+M1Y4nX3yh",
+	"YB/8lgvkLu01QIgFYBbmSoq9eeIW7IyCbLyCDPA0mbvZJtFrWOBzqYqPoLD5Ykc4HhzRQGz8zIeDY6tB",
+	"q1pvPsBUPAkRuy7p/TAsHNVlbB6Ltm829mPHt5wo/SzQEQjNPhTxtxgKZ9dVfG/OYiCA7qgU3Pb7xqNc",
+	"Mviy0To7nfrUm9gzm41Q+tQW9FDJZ9ScTGFJTbvRHcjDB5de3sDRLz///ItpS/rLHfbRatRU41KK1J23",
+	"ojdM5GmnRqpU6dWD+3UpPkkM2+TWH6BNwKEhFSssdU1PKv/MVN48/ge6TMm77y4AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
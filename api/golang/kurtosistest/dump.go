@@ -0,0 +1,191 @@
+package kurtosistest
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	dumpDirPerms  = 0755
+	dumpFilePerms = 0644
+
+	servicesDumpFilename         = "services.json"
+	filesArtifactsDumpFilename   = "files-artifacts.json"
+	starlarkRunDumpFilename      = "starlark-run.json"
+	setupRunOutputDumpFilename   = "setup-run-output.txt"
+	serviceLogsDumpDirname       = "service-logs"
+	serviceLogsDumpFileExtension = ".log"
+
+	jsonIndentPrefix = ""
+	jsonIndent       = "  "
+
+	dumpedLogLineSeparator = "\n"
+
+	shouldFollowDumpedLogs    = false
+	shouldReturnAllDumpedLogs = true
+	allDumpedLogLines         = 0
+)
+
+var disallowedDumpPathChars = regexp.MustCompile(`[^-_.A-Za-z0-9]+`)
+
+type dumpedService struct {
+	Name             string            `json:"name"`
+	Uuid             string            `json:"uuid"`
+	PrivateIpAddress string            `json:"private_ip_address"`
+	PrivatePorts     map[string]string `json:"private_ports"`
+	PublicIpAddress  string            `json:"public_ip_address,omitempty"`
+	PublicPorts      map[string]string `json:"public_ports,omitempty"`
+	PublicUrls       map[string]string `json:"public_urls,omitempty"`
+}
+
+// Dump writes the services of the enclave, their logs, its files artifacts and its last Starlark run to the directory.
+// Everything is read through the engine and API container, so it works against remote engines too
+func (enclave *Enclave) Dump(ctx context.Context, dirpath string) error {
+	if err := os.MkdirAll(filepath.Join(dirpath, serviceLogsDumpDirname), dumpDirPerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating dump directory '%s'", dirpath)
+	}
+
+	serviceNames, err := enclave.enclaveCtx.GetServices()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services of enclave '%s'", enclave.name)
+	}
+	serviceIdentifiers := map[string]bool{}
+	for serviceName := range serviceNames {
+		serviceIdentifiers[string(serviceName)] = true
+	}
+	serviceCtxs, err := enclave.enclaveCtx.GetServiceContexts(serviceIdentifiers)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service contexts of enclave '%s'", enclave.name)
+	}
+	dumpedServices := []*dumpedService{}
+	for _, serviceCtx := range serviceCtxs {
+		dumpedServices = append(dumpedServices, newDumpedService(serviceCtx))
+	}
+	if err := writeJsonDumpFile(filepath.Join(dirpath, servicesDumpFilename), dumpedServices); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping the services")
+	}
+
+	if err := enclave.dumpServiceLogs(ctx, filepath.Join(dirpath, serviceLogsDumpDirname), serviceNames); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping the service logs")
+	}
+
+	filesArtifacts, err := enclave.enclaveCtx.GetAllFilesArtifactNamesAndUuids(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the files artifacts of enclave '%s'", enclave.name)
+	}
+	if err := writeJsonDumpFile(filepath.Join(dirpath, filesArtifactsDumpFilename), filesArtifacts); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping the files artifacts")
+	}
+
+	starlarkRun, err := enclave.enclaveCtx.GetStarlarkRun(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the last Starlark run of enclave '%s'", enclave.name)
+	}
+	serializedStarlarkRun, err := protojson.MarshalOptions{Multiline: true, Indent: jsonIndent}.Marshal(starlarkRun) // nolint: exhaustruct
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the last Starlark run")
+	}
+	if err := os.WriteFile(filepath.Join(dirpath, starlarkRunDumpFilename), serializedStarlarkRun, dumpFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping the last Starlark run")
+	}
+
+	if enclave.runResult != nil {
+		if err := os.WriteFile(filepath.Join(dirpath, setupRunOutputDumpFilename), []byte(enclave.runResult.RunOutput), dumpFilePerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred dumping the output of the setup run")
+		}
+	}
+	return nil
+}
+
+// dumpIfFailed dumps the enclave to a directory named after the test in the artifacts directory, if the test failed.
+// Dump errors are only logged, as the test has already failed
+func (enclave *Enclave) dumpIfFailed(t testing.TB) {
+	if !t.Failed() {
+		return
+	}
+	dumpDirpath := filepath.Join(enclave.opts.artifactsDirpath, sanitizeDumpPath(t.Name()), enclave.name)
+	if err := enclave.Dump(context.Background(), dumpDirpath); err != nil {
+		t.Logf("An error occurred dumping enclave '%s' to '%s':\n%v", enclave.name, dumpDirpath, err)
+		return
+	}
+	t.Logf("Test failed; dumped enclave '%s' to '%s'", enclave.name, dumpDirpath)
+}
+
+func (enclave *Enclave) dumpServiceLogs(ctx context.Context, dirpath string, serviceNames map[services.ServiceName]services.ServiceUUID) error {
+	if len(serviceNames) == 0 {
+		return nil
+	}
+	serviceUuids := map[services.ServiceUUID]bool{}
+	serviceNamesByUuid := map[services.ServiceUUID]services.ServiceName{}
+	for serviceName, serviceUuid := range serviceNames {
+		serviceUuids[serviceUuid] = true
+		serviceNamesByUuid[serviceUuid] = serviceName
+	}
+	logsChan, cancelFunc, err := enclave.kurtosisCtx.GetServiceLogs(ctx, enclave.name, serviceUuids, shouldFollowDumpedLogs, shouldReturnAllDumpedLogs, allDumpedLogLines, nil)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the logs of the services of enclave '%s'", enclave.name)
+	}
+	defer cancelFunc()
+
+	logLinesByUuid := map[services.ServiceUUID][]string{}
+	for streamContent := range logsChan {
+		for serviceUuid, serviceLogs := range streamContent.GetServiceLogsByServiceUuids() {
+			for _, serviceLog := range serviceLogs {
+				logLinesByUuid[serviceUuid] = append(logLinesByUuid[serviceUuid], serviceLog.GetContent())
+			}
+		}
+	}
+	for serviceUuid, serviceName := range serviceNamesByUuid {
+		logFilepath := filepath.Join(dirpath, sanitizeDumpPath(string(serviceName))+serviceLogsDumpFileExtension)
+		content := strings.Join(logLinesByUuid[serviceUuid], dumpedLogLineSeparator)
+		if err := os.WriteFile(logFilepath, []byte(content), dumpFilePerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the logs of service '%s' to '%s'", serviceName, logFilepath)
+		}
+	}
+	return nil
+}
+
+func newDumpedService(serviceCtx *services.ServiceContext) *dumpedService {
+	return &dumpedService{
+		Name:             string(serviceCtx.GetServiceName()),
+		Uuid:             string(serviceCtx.GetServiceUUID()),
+		PrivateIpAddress: serviceCtx.GetPrivateIPAddress(),
+		PrivatePorts:     portSpecsToStrings(serviceCtx.GetPrivatePorts()),
+		PublicIpAddress:  serviceCtx.GetMaybePublicIPAddress(),
+		PublicPorts:      portSpecsToStrings(serviceCtx.GetPublicPorts()),
+		PublicUrls:       serviceCtx.GetPublicUrls(),
+	}
+}
+
+func portSpecsToStrings(portSpecs map[string]*services.PortSpec) map[string]string {
+	result := map[string]string{}
+	for portId, portSpec := range portSpecs {
+		result[portId] = portSpec.String()
+	}
+	return result
+}
+
+func writeJsonDumpFile(filepath string, value interface{}) error {
+	serialized, err := json.MarshalIndent(value, jsonIndentPrefix, jsonIndent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing '%+v'", value)
+	}
+	if err := os.WriteFile(filepath, serialized, dumpFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing file '%s'", filepath)
+	}
+	return nil
+}
+
+// sanitizeDumpPath turns a test or service name into a single safe path element
+func sanitizeDumpPath(name string) string {
+	return disallowedDumpPathChars.ReplaceAllString(name, "_")
+}
@@ -0,0 +1,322 @@
+/*
+Package kurtosistest creates ephemeral enclaves for Go tests.
+
+	func TestMyService(t *testing.T) {
+		enclave := kurtosistest.NewEnclave(t, kurtosistest.WithStarlarkRemotePackage("github.com/my-org/my-package"))
+		url := enclave.GetServicePublicUrl(t, "api", "http")
+		...
+	}
+
+The enclave is destroyed once the test finishes and dumped to the artifacts directory when the test fails. Tests of a
+package can also share an enclave with NewSharedEnclave.
+*/
+package kurtosistest
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/plan_builder"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveNameSeparator     = "-"
+	maxEnclaveNamePrefixLen  = 40
+	defaultEnclaveNamePrefix = "kurtosistest"
+	enclaveNameNumberBase    = 36
+
+	// Labels set on every enclave created by this package
+	kurtosistestLabelKey     = "kurtosistest"
+	kurtosistestLabelValue   = "true"
+	kurtosistestTestLabelKey = "kurtosistest.test"
+	testNameLabelValueMaxLen = 63
+
+	enclaveDestroyRetries    = 3
+	enclaveDestroyRetryDelay = time.Second
+
+	defaultUrlScheme         = "http"
+	urlFormat                = "%s://%s:%d"
+	publicAddressFormat      = "%s:%d"
+	emptyApplicationProtocol = ""
+	noPublicIpAddress        = ""
+
+	setupDescriptionScript    = "script"
+	setupDescriptionPackage   = "package '%s'"
+	setupDescriptionPlan      = "plan"
+	multipleSetupsDescription = "script, package, remote package or plan"
+)
+
+var (
+	disallowedEnclaveNameChars = regexp.MustCompile("[^-A-Za-z0-9]+")
+
+	// Makes enclave names unique even when tests with the same name start in the same second
+	enclaveNameCounter uint64
+)
+
+// Enclave is an enclave created for tests
+type Enclave struct {
+	kurtosisCtx *kurtosis_context.KurtosisContext
+	enclaveCtx  *enclaves.EnclaveContext
+	name        string
+
+	// Nil if the enclave wasn't set up with a script, package or plan
+	runResult *enclaves.StarlarkRunResult
+
+	// Nil if the enclave wasn't set up with a plan
+	planResult *plan_builder.PlanResult
+
+	opts *options
+}
+
+// NewEnclave creates an enclave, runs the setup script, package or plan if one was given, and registers a cleanup
+// which dumps the enclave to the artifacts directory if the test failed, then destroys it. The test fails right away
+// if the enclave can't be created or set up
+func NewEnclave(t testing.TB, opts ...Option) *Enclave {
+	t.Helper()
+	resolvedOpts := newOptions(opts...)
+	enclave, err := createEnclave(context.Background(), t.Name(), resolvedOpts)
+	if enclave != nil {
+		t.Cleanup(func() {
+			enclave.cleanUp(t, enclave.shouldDestroyAfter(t))
+		})
+	}
+	if err != nil {
+		t.Fatalf("An error occurred creating the test enclave:\n%v", err)
+	}
+	t.Logf("Created enclave '%s' for test '%s'", enclave.name, t.Name())
+	if resolvedOpts.shouldCaptureServiceLog {
+		enclave.CaptureServiceLogs(t)
+	}
+	return enclave
+}
+
+func (enclave *Enclave) GetName() string {
+	return enclave.name
+}
+
+func (enclave *Enclave) GetEnclaveContext() *enclaves.EnclaveContext {
+	return enclave.enclaveCtx
+}
+
+func (enclave *Enclave) GetKurtosisContext() *kurtosis_context.KurtosisContext {
+	return enclave.kurtosisCtx
+}
+
+// GetRunResult returns the result of the setup run, or nil if there was none
+func (enclave *Enclave) GetRunResult() *enclaves.StarlarkRunResult {
+	return enclave.runResult
+}
+
+// GetPlanResult returns the result of the setup plan, or nil if the enclave wasn't set up with WithPlan
+func (enclave *Enclave) GetPlanResult() *plan_builder.PlanResult {
+	return enclave.planResult
+}
+
+// RunScript runs a Starlark script in the enclave, failing the test if the run fails
+func (enclave *Enclave) RunScript(t testing.TB, serializedScript string) *enclaves.StarlarkRunResult {
+	t.Helper()
+	runResult, err := enclave.enclaveCtx.RunStarlarkScriptBlocking(context.Background(), serializedScript, starlark_run_config.NewRunStarlarkConfig())
+	if err != nil {
+		t.Fatalf("An error occurred running a Starlark script in enclave '%s':\n%v", enclave.name, err)
+	}
+	return runResult
+}
+
+// RunPlan runs a plan in the enclave, failing the test if the run fails
+func (enclave *Enclave) RunPlan(t testing.TB, plan *plan_builder.Plan) *plan_builder.PlanResult {
+	t.Helper()
+	planResult, _, err := plan_builder.Run(context.Background(), enclave.enclaveCtx, plan, starlark_run_config.NewRunStarlarkConfig())
+	if err != nil {
+		t.Fatalf("An error occurred running a plan in enclave '%s':\n%v", enclave.name, err)
+	}
+	return planResult
+}
+
+// GetService returns the context of a service of the enclave, failing the test if it doesn't exist
+func (enclave *Enclave) GetService(t testing.TB, serviceName string) *services.ServiceContext {
+	t.Helper()
+	serviceCtx, err := enclave.enclaveCtx.GetServiceContext(serviceName)
+	if err != nil {
+		t.Fatalf("An error occurred getting service '%s' of enclave '%s':\n%v", serviceName, enclave.name, err)
+	}
+	return serviceCtx
+}
+
+// GetServicePublicAddress returns the host:port where a port of a service is reachable from the test, failing the
+// test if the port isn't published
+func (enclave *Enclave) GetServicePublicAddress(t testing.TB, serviceName string, portId string) string {
+	t.Helper()
+	serviceCtx := enclave.GetService(t, serviceName)
+	publicIpAddress, publicPort := getPublicPort(t, serviceCtx, portId)
+	return fmt.Sprintf(publicAddressFormat, publicIpAddress, publicPort.GetNumber())
+}
+
+// GetServicePublicUrl returns the URL where a port of a service is reachable from the test. The URL published by the
+// backend is preferred; otherwise it's built from the public address, using the application protocol of the port as
+// scheme (http if it has none)
+func (enclave *Enclave) GetServicePublicUrl(t testing.TB, serviceName string, portId string) string {
+	t.Helper()
+	serviceCtx := enclave.GetService(t, serviceName)
+	if publicUrl, found := serviceCtx.GetPublicUrls()[portId]; found && publicUrl != "" {
+		return publicUrl
+	}
+	publicIpAddress, publicPort := getPublicPort(t, serviceCtx, portId)
+	scheme := publicPort.GetMaybeApplicationProtocol()
+	if scheme == emptyApplicationProtocol {
+		scheme = defaultUrlScheme
+	}
+	return fmt.Sprintf(urlFormat, scheme, publicIpAddress, publicPort.GetNumber())
+}
+
+// ====================================================================================================
+//
+//	Private Helper Methods
+//
+// ====================================================================================================
+func createEnclave(ctx context.Context, testName string, opts *options) (*Enclave, error) {
+	kurtosisCtx := opts.kurtosisCtx
+	if kurtosisCtx == nil {
+		var err error
+		kurtosisCtx, err = kurtosis_context.NewKurtosisContextFromLocalEngine()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred connecting to the local engine. Is the engine running? Try running 'kurtosis engine start'")
+		}
+	}
+
+	namePrefix := opts.enclaveNamePrefix
+	if namePrefix == "" {
+		namePrefix = testName
+	}
+	enclaveName := newEnclaveName(namePrefix)
+	labels := map[string]string{
+		kurtosistestLabelKey:     kurtosistestLabelValue,
+		kurtosistestTestLabelKey: truncate(testName, testNameLabelValueMaxLen),
+	}
+	enclaveCtx, err := kurtosisCtx.CreateEnclaveWithLabelsAndTtl(ctx, enclaveName, labels, opts.ttl, kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating enclave '%s'", enclaveName)
+	}
+	enclave := &Enclave{
+		kurtosisCtx: kurtosisCtx,
+		enclaveCtx:  enclaveCtx,
+		name:        enclaveName,
+		runResult:   nil,
+		planResult:  nil,
+		opts:        opts,
+	}
+
+	// The enclave is returned alongside setup errors so the caller can still dump and destroy it
+	if err := enclave.runSetup(ctx); err != nil {
+		return enclave, stacktrace.Propagate(err, "An error occurred setting up enclave '%s'", enclaveName)
+	}
+	return enclave, nil
+}
+
+func (enclave *Enclave) runSetup(ctx context.Context) error {
+	opts := enclave.opts
+	numSetups := 0
+	for _, isSet := range []bool{opts.setupScript != "", opts.setupPackageRootPath != "", opts.setupRemotePackageId != "", opts.setupPlan != nil} {
+		if isSet {
+			numSetups++
+		}
+	}
+	if numSetups > 1 {
+		return stacktrace.NewError("Only one of %s can be used to set up an enclave", multipleSetupsDescription)
+	}
+
+	var err error
+	var setupDescription string
+	switch {
+	case opts.setupScript != "":
+		setupDescription = setupDescriptionScript
+		enclave.runResult, err = enclave.enclaveCtx.RunStarlarkScriptBlocking(ctx, opts.setupScript, opts.runConfig)
+	case opts.setupPackageRootPath != "":
+		setupDescription = fmt.Sprintf(setupDescriptionPackage, opts.setupPackageRootPath)
+		enclave.runResult, err = enclave.enclaveCtx.RunStarlarkPackageBlocking(ctx, opts.setupPackageRootPath, opts.runConfig)
+	case opts.setupRemotePackageId != "":
+		setupDescription = fmt.Sprintf(setupDescriptionPackage, opts.setupRemotePackageId)
+		enclave.runResult, err = enclave.enclaveCtx.RunStarlarkRemotePackageBlocking(ctx, opts.setupRemotePackageId, opts.runConfig)
+	case opts.setupPlan != nil:
+		setupDescription = setupDescriptionPlan
+		enclave.planResult, enclave.runResult, err = plan_builder.Run(ctx, enclave.enclaveCtx, opts.setupPlan, opts.runConfig)
+	default:
+		return nil
+	}
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the setup %s", setupDescription)
+	}
+	return nil
+}
+
+func (enclave *Enclave) shouldDestroyAfter(t testing.TB) bool {
+	if enclave.opts.shouldKeepEnclave {
+		return false
+	}
+	return !(t.Failed() && enclave.opts.shouldKeepOnFailure)
+}
+
+// cleanUp dumps the enclave if the test failed, then destroys it if asked to
+func (enclave *Enclave) cleanUp(t testing.TB, shouldDestroy bool) {
+	enclave.dumpIfFailed(t)
+	if !shouldDestroy {
+		t.Logf("Keeping enclave '%s'; destroy it with 'kurtosis enclave rm -f %s'", enclave.name, enclave.name)
+		return
+	}
+	if err := enclave.destroy(context.Background()); err != nil {
+		t.Errorf("An error occurred destroying enclave '%s'; ACTION REQUIRED: destroy it manually with 'kurtosis enclave rm -f %s':\n%v", enclave.name, enclave.name, err)
+	}
+}
+
+func (enclave *Enclave) destroy(ctx context.Context) error {
+	var err error
+	for attempt := 0; attempt < enclaveDestroyRetries; attempt++ {
+		if err = enclave.kurtosisCtx.DestroyEnclave(ctx, enclave.name); err == nil {
+			return nil
+		}
+		time.Sleep(enclaveDestroyRetryDelay)
+	}
+	return stacktrace.Propagate(err, "An error occurred destroying enclave '%s', even after %d attempts", enclave.name, enclaveDestroyRetries)
+}
+
+func getPublicPort(t testing.TB, serviceCtx *services.ServiceContext, portId string) (string, *services.PortSpec) {
+	t.Helper()
+	publicPort, found := serviceCtx.GetPublicPorts()[portId]
+	if !found || serviceCtx.GetMaybePublicIPAddress() == noPublicIpAddress {
+		t.Fatalf("Port '%s' of service '%s' isn't reachable from outside the enclave", portId, serviceCtx.GetServiceName())
+	}
+	return serviceCtx.GetMaybePublicIPAddress(), publicPort
+}
+
+// newEnclaveName derives a valid and unique enclave name from the prefix
+func newEnclaveName(prefix string) string {
+	sanitizedPrefix := strings.Trim(disallowedEnclaveNameChars.ReplaceAllString(prefix, enclaveNameSeparator), enclaveNameSeparator)
+	sanitizedPrefix = strings.TrimRight(truncate(sanitizedPrefix, maxEnclaveNamePrefixLen), enclaveNameSeparator)
+	if sanitizedPrefix == "" {
+		sanitizedPrefix = defaultEnclaveNamePrefix
+	}
+	counter := atomic.AddUint64(&enclaveNameCounter, 1)
+	return strings.Join([]string{
+		sanitizedPrefix,
+		strconv.FormatInt(time.Now().Unix(), enclaveNameNumberBase),
+		strconv.FormatUint(counter, enclaveNameNumberBase),
+	}, enclaveNameSeparator)
+}
+
+func truncate(str string, maxLen int) string {
+	if len(str) <= maxLen {
+		return str
+	}
+	return str[:maxLen]
+}
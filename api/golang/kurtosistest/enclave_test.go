@@ -0,0 +1,42 @@
+package kurtosistest
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/enclave"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEnclaveName(t *testing.T) {
+	allowedEnclaveName := regexp.MustCompile(enclave.AllowedEnclaveNameCharsRegexStr)
+	tests := map[string]string{
+		"TestSimple":                     "TestSimple-",
+		"TestParent/sub_test#01":         "TestParent-sub-test-01-",
+		"/leading and trailing/":         "leading-and-trailing-",
+		"":                               "kurtosistest-",
+		"___":                            "kurtosistest-",
+		strings.Repeat("TestLong", 20):   strings.Repeat("TestLong", 5) + "-",
+		strings.Repeat("a", 39) + "/bcd": strings.Repeat("a", 39) + "-",
+	}
+	for prefix, expectedStart := range tests {
+		enclaveName := newEnclaveName(prefix)
+		require.True(t, allowedEnclaveName.MatchString(enclaveName), "Enclave name '%s' generated from '%s' isn't valid", enclaveName, prefix)
+		require.True(t, strings.HasPrefix(enclaveName, expectedStart), "Enclave name '%s' generated from '%s' doesn't start with '%s'", enclaveName, prefix, expectedStart)
+	}
+	require.NotEqual(t, newEnclaveName("TestSame"), newEnclaveName("TestSame"))
+}
+
+func TestSanitizeDumpPath(t *testing.T) {
+	require.Equal(t, "TestParent_sub_test_01", sanitizeDumpPath("TestParent/sub test#01"))
+	require.Equal(t, "my-service.v2", sanitizeDumpPath("my-service.v2"))
+}
+
+func TestRunSetup_MultipleSetupsFail(t *testing.T) {
+	testEnclave := &Enclave{ // nolint: exhaustruct
+		opts: newOptions(WithStarlarkScript("def run(plan): pass"), WithStarlarkRemotePackage("github.com/org/package")),
+	}
+	require.Error(t, testEnclave.runSetup(context.Background()))
+}
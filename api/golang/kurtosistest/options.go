@@ -0,0 +1,157 @@
+package kurtosistest
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/plan_builder"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+)
+
+const (
+	// Directory enclaves are dumped to when a test fails, if not set with WithArtifactsDirpath
+	ArtifactsDirpathEnvVar = "KURTOSISTEST_ARTIFACTS_DIR"
+
+	// When set to true, enclaves are kept once tests finish, as if WithKeepEnclave was passed
+	KeepEnclavesEnvVar = "KURTOSISTEST_KEEP_ENCLAVES"
+
+	defaultArtifactsDirname = "kurtosistest"
+
+	// Enclaves leaked by a killed test run get destroyed by the engine once this elapses
+	defaultEnclaveTtl = time.Hour
+)
+
+type Option func(*options)
+
+type options struct {
+	kurtosisCtx *kurtosis_context.KurtosisContext
+
+	enclaveNamePrefix string
+
+	setupScript             string
+	setupPackageRootPath    string
+	setupRemotePackageId    string
+	setupPlan               *plan_builder.Plan
+	runConfig               *starlark_run_config.StarlarkRunConfig
+	artifactsDirpath        string
+	ttl                     time.Duration
+	shouldKeepEnclave       bool
+	shouldKeepOnFailure     bool
+	shouldCaptureServiceLog bool
+}
+
+func newOptions(opts ...Option) *options {
+	artifactsDirpath, found := os.LookupEnv(ArtifactsDirpathEnvVar)
+	if !found || artifactsDirpath == "" {
+		artifactsDirpath = filepath.Join(os.TempDir(), defaultArtifactsDirname)
+	}
+	// An unparseable value means the enclaves aren't kept
+	shouldKeepEnclave, _ := strconv.ParseBool(os.Getenv(KeepEnclavesEnvVar))
+
+	result := &options{
+		kurtosisCtx:             nil,
+		enclaveNamePrefix:       "",
+		setupScript:             "",
+		setupPackageRootPath:    "",
+		setupRemotePackageId:    "",
+		setupPlan:               nil,
+		runConfig:               starlark_run_config.NewRunStarlarkConfig(),
+		artifactsDirpath:        artifactsDirpath,
+		ttl:                     defaultEnclaveTtl,
+		shouldKeepEnclave:       shouldKeepEnclave,
+		shouldKeepOnFailure:     false,
+		shouldCaptureServiceLog: false,
+	}
+	for _, opt := range opts {
+		opt(result)
+	}
+	return result
+}
+
+// WithKurtosisContext uses the given context instead of connecting to the local engine
+func WithKurtosisContext(kurtosisCtx *kurtosis_context.KurtosisContext) Option {
+	return func(opts *options) {
+		opts.kurtosisCtx = kurtosisCtx
+	}
+}
+
+// WithEnclaveNamePrefix overrides the prefix of the enclave name, which defaults to the test name
+func WithEnclaveNamePrefix(prefix string) Option {
+	return func(opts *options) {
+		opts.enclaveNamePrefix = prefix
+	}
+}
+
+// WithStarlarkScript runs the script once the enclave is created
+func WithStarlarkScript(serializedScript string) Option {
+	return func(opts *options) {
+		opts.setupScript = serializedScript
+	}
+}
+
+// WithStarlarkPackage runs the local package once the enclave is created
+func WithStarlarkPackage(packageRootPath string) Option {
+	return func(opts *options) {
+		opts.setupPackageRootPath = packageRootPath
+	}
+}
+
+// WithStarlarkRemotePackage runs the remote package once the enclave is created
+func WithStarlarkRemotePackage(packageId string) Option {
+	return func(opts *options) {
+		opts.setupRemotePackageId = packageId
+	}
+}
+
+// WithPlan runs the plan once the enclave is created; its result is available with Enclave.GetPlanResult
+func WithPlan(plan *plan_builder.Plan) Option {
+	return func(opts *options) {
+		opts.setupPlan = plan
+	}
+}
+
+// WithRunConfig overrides the configuration the setup script, package or plan is run with
+func WithRunConfig(runConfig *starlark_run_config.StarlarkRunConfig) Option {
+	return func(opts *options) {
+		opts.runConfig = runConfig
+	}
+}
+
+// WithArtifactsDirpath overrides the directory enclaves are dumped to when a test fails
+func WithArtifactsDirpath(dirpath string) Option {
+	return func(opts *options) {
+		opts.artifactsDirpath = dirpath
+	}
+}
+
+// WithTtl overrides how long the engine keeps the enclave before destroying it, in case the test run gets killed
+// before the enclave is cleaned up. Zero means the enclave never expires
+func WithTtl(ttl time.Duration) Option {
+	return func(opts *options) {
+		opts.ttl = ttl
+	}
+}
+
+// WithKeepEnclave keeps the enclave once the test finishes
+func WithKeepEnclave() Option {
+	return func(opts *options) {
+		opts.shouldKeepEnclave = true
+	}
+}
+
+// WithKeepEnclaveOnFailure keeps the enclave when the test fails, so it can be inspected
+func WithKeepEnclaveOnFailure() Option {
+	return func(opts *options) {
+		opts.shouldKeepOnFailure = true
+	}
+}
+
+// WithServiceLogCapture streams the logs of every service of the enclave to the test log once the setup is done
+func WithServiceLogCapture() Option {
+	return func(opts *options) {
+		opts.shouldCaptureServiceLog = true
+	}
+}
@@ -0,0 +1,40 @@
+package kurtosistest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOptions_Defaults(t *testing.T) {
+	t.Setenv(ArtifactsDirpathEnvVar, "")
+	t.Setenv(KeepEnclavesEnvVar, "")
+
+	opts := newOptions()
+	require.Equal(t, filepath.Join(os.TempDir(), defaultArtifactsDirname), opts.artifactsDirpath)
+	require.Equal(t, defaultEnclaveTtl, opts.ttl)
+	require.False(t, opts.shouldKeepEnclave)
+	require.NotNil(t, opts.runConfig)
+}
+
+func TestNewOptions_EnvVars(t *testing.T) {
+	t.Setenv(ArtifactsDirpathEnvVar, "/tmp/artifacts")
+	t.Setenv(KeepEnclavesEnvVar, "true")
+
+	opts := newOptions()
+	require.Equal(t, "/tmp/artifacts", opts.artifactsDirpath)
+	require.True(t, opts.shouldKeepEnclave)
+}
+
+func TestNewOptions_OptionsOverrideEnvVars(t *testing.T) {
+	t.Setenv(ArtifactsDirpathEnvVar, "/tmp/artifacts")
+
+	opts := newOptions(WithArtifactsDirpath("/tmp/other"), WithTtl(time.Minute), WithKeepEnclaveOnFailure(), WithServiceLogCapture())
+	require.Equal(t, "/tmp/other", opts.artifactsDirpath)
+	require.Equal(t, time.Minute, opts.ttl)
+	require.True(t, opts.shouldKeepOnFailure)
+	require.True(t, opts.shouldCaptureServiceLog)
+}
@@ -0,0 +1,75 @@
+package kurtosistest
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+)
+
+const (
+	shouldFollowCapturedLogs    = true
+	shouldReturnAllCapturedLogs = true
+	allCapturedLogLines         = 0
+)
+
+// CaptureServiceLogs streams the logs of the given services, or of every service of the enclave if none is given, to
+// the test log until the test finishes. Each line is prefixed with the name of the service it comes from
+func (enclave *Enclave) CaptureServiceLogs(t testing.TB, serviceNames ...string) {
+	t.Helper()
+	serviceUuids := map[services.ServiceUUID]bool{}
+	serviceNamesByUuid := map[services.ServiceUUID]services.ServiceName{}
+	if len(serviceNames) == 0 {
+		allServices, err := enclave.enclaveCtx.GetServices()
+		if err != nil {
+			t.Fatalf("An error occurred getting the services of enclave '%s' to capture their logs:\n%v", enclave.name, err)
+		}
+		for serviceName, serviceUuid := range allServices {
+			serviceUuids[serviceUuid] = true
+			serviceNamesByUuid[serviceUuid] = serviceName
+		}
+	}
+	for _, serviceName := range serviceNames {
+		serviceCtx := enclave.GetService(t, serviceName)
+		serviceUuids[serviceCtx.GetServiceUUID()] = true
+		serviceNamesByUuid[serviceCtx.GetServiceUUID()] = serviceCtx.GetServiceName()
+	}
+	if len(serviceUuids) == 0 {
+		return
+	}
+
+	ctx, cancelCtxFunc := context.WithCancel(context.Background())
+	logsChan, cancelLogsFunc, err := enclave.kurtosisCtx.GetServiceLogs(ctx, enclave.name, serviceUuids, shouldFollowCapturedLogs, shouldReturnAllCapturedLogs, allCapturedLogLines, nil)
+	if err != nil {
+		cancelCtxFunc()
+		t.Fatalf("An error occurred streaming the logs of services '%v' of enclave '%s':\n%v", serviceNames, enclave.name, err)
+	}
+
+	// The test log can't be written to once the test is over, so the cleanup waits for the routine to return
+	waitGroup := &sync.WaitGroup{}
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case streamContent, isChanOpen := <-logsChan:
+				if !isChanOpen {
+					return
+				}
+				for serviceUuid, serviceLogs := range streamContent.GetServiceLogsByServiceUuids() {
+					for _, serviceLog := range serviceLogs {
+						t.Logf("[%s] %s", serviceNamesByUuid[serviceUuid], serviceLog.GetContent())
+					}
+				}
+			}
+		}
+	}()
+	t.Cleanup(func() {
+		cancelLogsFunc()
+		cancelCtxFunc()
+		waitGroup.Wait()
+	})
+}
@@ -0,0 +1,109 @@
+package kurtosistest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+)
+
+// sharedEnclaveRegistry holds the enclaves shared by the tests of a package, by key
+type sharedEnclaveRegistry struct {
+	mutex     sync.Mutex
+	isEnabled bool
+	entries   map[string]*sharedEnclaveEntry
+}
+
+type sharedEnclaveEntry struct {
+	once    sync.Once
+	enclave *Enclave
+	err     error
+}
+
+var sharedEnclaves = &sharedEnclaveRegistry{
+	mutex:     sync.Mutex{},
+	isEnabled: false,
+	entries:   map[string]*sharedEnclaveEntry{},
+}
+
+// RunWithSharedEnclaves runs the tests of the package, then destroys the enclaves they shared. It must be called from
+// TestMain for NewSharedEnclave to be used:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(kurtosistest.RunWithSharedEnclaves(m))
+//	}
+func RunWithSharedEnclaves(m *testing.M) int {
+	sharedEnclaves.mutex.Lock()
+	sharedEnclaves.isEnabled = true
+	sharedEnclaves.mutex.Unlock()
+
+	exitCode := m.Run()
+
+	sharedEnclaves.mutex.Lock()
+	defer sharedEnclaves.mutex.Unlock()
+	for key, entry := range sharedEnclaves.entries {
+		if entry.enclave == nil || !entry.enclave.shouldDestroyAfterSharedUse(exitCode) {
+			continue
+		}
+		if err := entry.enclave.destroy(context.Background()); err != nil {
+			fmt.Fprintf(os.Stderr, "An error occurred destroying enclave '%s' shared under key '%s'; ACTION REQUIRED: destroy it manually with 'kurtosis enclave rm -f %s':\n%v\n", entry.enclave.name, key, entry.enclave.name, err)
+		}
+	}
+	sharedEnclaves.entries = map[string]*sharedEnclaveEntry{}
+	return exitCode
+}
+
+// NewSharedEnclave returns the enclave shared under the key by the tests of the package, creating and setting it up
+// with the options of the first caller. The enclave is dumped when a test using it fails, and destroyed once all the
+// tests have run. Shared enclaves require TestMain to call RunWithSharedEnclaves
+func NewSharedEnclave(t testing.TB, key string, opts ...Option) *Enclave {
+	t.Helper()
+	entry := sharedEnclaves.getOrCreateEntry(t, key)
+	resolvedOpts := newOptions(opts...)
+	entry.once.Do(func() {
+		entry.enclave, entry.err = createEnclave(context.Background(), key, resolvedOpts)
+		if entry.err == nil {
+			t.Logf("Created enclave '%s' shared under key '%s'", entry.enclave.name, key)
+		}
+	})
+	if entry.enclave != nil {
+		t.Cleanup(func() {
+			entry.enclave.dumpIfFailed(t)
+		})
+	}
+	if entry.err != nil {
+		t.Fatalf("An error occurred creating the enclave shared under key '%s':\n%v", key, entry.err)
+	}
+	// Log capture follows the calling test, not the first caller
+	if resolvedOpts.shouldCaptureServiceLog {
+		entry.enclave.CaptureServiceLogs(t)
+	}
+	return entry.enclave
+}
+
+func (registry *sharedEnclaveRegistry) getOrCreateEntry(t testing.TB, key string) *sharedEnclaveEntry {
+	t.Helper()
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	if !registry.isEnabled {
+		t.Fatalf("Shared enclaves require the TestMain of the package to call kurtosistest.RunWithSharedEnclaves")
+	}
+	entry, found := registry.entries[key]
+	if !found {
+		entry = &sharedEnclaveEntry{
+			once:    sync.Once{},
+			enclave: nil,
+			err:     nil,
+		}
+		registry.entries[key] = entry
+	}
+	return entry
+}
+
+func (enclave *Enclave) shouldDestroyAfterSharedUse(exitCode int) bool {
+	if enclave.opts.shouldKeepEnclave {
+		return false
+	}
+	return !(exitCode != 0 && enclave.opts.shouldKeepOnFailure)
+}
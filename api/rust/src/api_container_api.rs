@@ -79,6 +79,9 @@ pub struct Container {
         ::prost::alloc::string::String,
         ::prost::alloc::string::String,
     >,
+    /// How many times the container was restarted, or its pod rescheduled by Kubernetes, since the service started
+    #[prost(uint32, tag = "6")]
+    pub restart_count: u32,
 }
 /// Nested message and enum types in `Container`.
 pub mod container {
@@ -159,6 +162,35 @@ pub struct ServiceInfo {
     /// Docker container or Kubernetes pod container
     #[prost(message, optional, tag = "9")]
     pub container: ::core::option::Option<Container>,
+    /// The persistent directories mounted on the service, in mount_path -> persistent_directory
+    #[prost(map = "string, message", tag = "10")]
+    pub persistent_directories: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        PersistentDirectory,
+    >,
+    /// The URLs where the HTTP ports of the service are reachable *outside* the cluster, in user_defined_port_id -> url
+    /// NOTE: Will be empty if the service isn't running or the backend doesn't expose the services, which is only done by
+    ///   Kubernetes clusters configured with a service exposure
+    #[prost(map = "string, string", tag = "11")]
+    pub maybe_public_urls: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PersistentDirectory {
+    #[prost(string, tag = "1")]
+    pub persistent_key: ::prost::alloc::string::String,
+    /// The size requested for the directory, in bytes
+    #[prost(int64, tag = "2")]
+    pub size: i64,
+    /// The storage class the directory's volume was provisioned with; empty means the backend's default
+    #[prost(string, tag = "3")]
+    pub storage_class: ::prost::alloc::string::String,
+    /// The access mode of the directory's volume, e.g. ReadWriteOnce; empty means the backend's default
+    #[prost(string, tag = "4")]
+    pub access_mode: ::prost::alloc::string::String,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -190,6 +222,12 @@ pub struct RunStarlarkScriptArgs {
     /// Defaults to false
     #[prost(bool, optional, tag = "10")]
     pub non_blocking_mode: ::core::option::Option<bool>,
+    /// configuration of the git hosts the packages imported by the script are cloned from
+    #[prost(message, repeated, tag = "11")]
+    pub git_host_configs: ::prost::alloc::vec::Vec<GitHostConfig>,
+    /// credentials of the registries the OCI packages imported by the script are pulled from
+    #[prost(message, repeated, tag = "12")]
+    pub registry_credentials: ::prost::alloc::vec::Vec<RegistryCredentials>,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -239,6 +277,12 @@ pub struct RunStarlarkPackageArgs {
     /// token that will be used to authenticate requests for this package
     #[prost(string, optional, tag = "16")]
     pub github_auth_token: ::core::option::Option<::prost::alloc::string::String>,
+    /// configuration of the git hosts the package and its dependencies are cloned from
+    #[prost(message, repeated, tag = "17")]
+    pub git_host_configs: ::prost::alloc::vec::Vec<GitHostConfig>,
+    /// credentials of the registries the package and its dependencies are pulled from, when published as OCI artifacts
+    #[prost(message, repeated, tag = "18")]
+    pub registry_credentials: ::prost::alloc::vec::Vec<RegistryCredentials>,
     /// Deprecated: If the package is local, it should have been uploaded with UploadStarlarkPackage prior to calling
     /// RunStarlarkPackage. If the package is remote and must be cloned within the APIC, use the standalone boolean flag
     /// clone_package below
@@ -263,6 +307,39 @@ pub mod run_starlark_package_args {
         Remote(bool),
     }
 }
+/// How to clone the repositories of a git host, e.g. 'gitlab.com', and the credentials to do it
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GitHostConfig {
+    #[prost(string, tag = "1")]
+    pub host: ::prost::alloc::string::String,
+    /// The URL the '<author>/<repository>' paths of the host are appended to when cloning, e.g. 'ssh://git@gitlab.com' to
+    /// clone over SSH or 'file:///path/to/repositories' to clone from local bare repositories. Defaults to '<https://<host>'>
+    #[prost(string, optional, tag = "2")]
+    pub clone_url_prefix: ::core::option::Option<::prost::alloc::string::String>,
+    /// HTTPS basic auth credentials; the password is usually an access token
+    #[prost(string, optional, tag = "3")]
+    pub username: ::core::option::Option<::prost::alloc::string::String>,
+    #[prost(string, optional, tag = "4")]
+    pub password: ::core::option::Option<::prost::alloc::string::String>,
+    /// PEM encoded private key used to clone over SSH
+    #[prost(string, optional, tag = "5")]
+    pub ssh_private_key: ::core::option::Option<::prost::alloc::string::String>,
+    /// known_hosts entries used to verify the host when cloning over SSH
+    #[prost(string, optional, tag = "6")]
+    pub ssh_known_hosts: ::core::option::Option<::prost::alloc::string::String>,
+}
+/// Credentials of an OCI registry, e.g. 'ghcr.io', as stored by 'docker login'. They're only kept for the run
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RegistryCredentials {
+    #[prost(string, tag = "1")]
+    pub registry: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub username: ::prost::alloc::string::String,
+    #[prost(string, tag = "3")]
+    pub password: ::prost::alloc::string::String,
+}
 /// ==============================================================================================
 ///                                Starlark Execution Response
 /// ==============================================================================================
@@ -473,6 +550,79 @@ pub struct ExecCommandResponse {
     pub log_output: ::prost::alloc::string::String,
 }
 /// ==============================================================================================
+///                                      Exec Command Stream
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ExecCommandStreamRequest {
+    #[prost(oneof = "exec_command_stream_request::Request", tags = "1, 2, 3, 4")]
+    pub request: ::core::option::Option<exec_command_stream_request::Request>,
+}
+/// Nested message and enum types in `ExecCommandStreamRequest`.
+pub mod exec_command_stream_request {
+    #[allow(clippy::derive_partial_eq_without_eq)]
+    #[derive(Clone, PartialEq, ::prost::Oneof)]
+    pub enum Request {
+        /// Starts the command; must be the first message sent, and only sent once
+        #[prost(message, tag = "1")]
+        Start(super::ExecCommandStreamStart),
+        /// Bytes to write to the command's STDIN
+        #[prost(bytes, tag = "2")]
+        Stdin(::prost::alloc::vec::Vec<u8>),
+        /// Closes the command's STDIN, e.g. so commands reading STDIN until the end can finish
+        #[prost(bool, tag = "3")]
+        CloseStdin(bool),
+        /// Resizes the command's TTY; ignored if the command was started without a TTY
+        #[prost(message, tag = "4")]
+        Resize(super::TerminalSize),
+    }
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ExecCommandStreamStart {
+    /// The service identifier of the container that the command should be executed in
+    #[prost(string, tag = "1")]
+    pub service_identifier: ::prost::alloc::string::String,
+    #[prost(string, repeated, tag = "2")]
+    pub command_args: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// Whether to allocate a TTY for the command, in which case STDERR is merged into STDOUT
+    #[prost(bool, tag = "3")]
+    pub tty: bool,
+    /// The initial size of the TTY
+    #[prost(message, optional, tag = "4")]
+    pub terminal_size: ::core::option::Option<TerminalSize>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TerminalSize {
+    /// Number of columns
+    #[prost(uint32, tag = "1")]
+    pub width: u32,
+    /// Number of rows
+    #[prost(uint32, tag = "2")]
+    pub height: u32,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ExecCommandStreamResponse {
+    #[prost(oneof = "exec_command_stream_response::Response", tags = "1, 2, 3")]
+    pub response: ::core::option::Option<exec_command_stream_response::Response>,
+}
+/// Nested message and enum types in `ExecCommandStreamResponse`.
+pub mod exec_command_stream_response {
+    #[allow(clippy::derive_partial_eq_without_eq)]
+    #[derive(Clone, PartialEq, ::prost::Oneof)]
+    pub enum Response {
+        #[prost(bytes, tag = "1")]
+        Stdout(::prost::alloc::vec::Vec<u8>),
+        #[prost(bytes, tag = "2")]
+        Stderr(::prost::alloc::vec::Vec<u8>),
+        /// Sent once the command exits, as the last message of the stream
+        #[prost(int32, tag = "3")]
+        ExitCode(i32),
+    }
+}
+/// ==============================================================================================
 ///                              Wait For HTTP Get Endpoint Availability
 /// ==============================================================================================
 #[allow(clippy::derive_partial_eq_without_eq)]
@@ -620,6 +770,22 @@ pub struct StoreFilesArtifactFromServiceResponse {
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CopyFilesArtifactToServiceArgs {
+    /// Identifier of the service that the files will be copied into
+    #[prost(string, tag = "1")]
+    pub service_identifier: ::prost::alloc::string::String,
+    /// Name or UUID of the files artifact whose contents will be copied
+    #[prost(string, tag = "2")]
+    pub files_artifact_identifier: ::prost::alloc::string::String,
+    /// The absolute path on the service where the files artifact contents will be extracted
+    #[prost(string, tag = "3")]
+    pub dest_path: ::prost::alloc::string::String,
+    /// Whether the files artifact is removed from the enclave once copied, e.g. because it was only uploaded for the copy
+    #[prost(bool, tag = "4")]
+    pub remove_files_artifact_after_copy: bool,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct FilesArtifactNameAndUuid {
     /// A string representing the name of the file
     #[prost(string, tag = "1")]
@@ -728,6 +894,30 @@ pub struct StarlarkPackagePlanYamlArgs {
     #[prost(string, optional, tag = "4")]
     pub main_function_name: ::core::option::Option<::prost::alloc::string::String>,
 }
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ResolvedPackageDependency {
+    /// The locator of the repository containing the package, e.g. github.com/kurtosis-tech/postgres-package
+    #[prost(string, tag = "1")]
+    pub repository_locator: ::prost::alloc::string::String,
+    /// The tag, branch or commit requested when the repository was cloned, empty for the default branch
+    #[prost(string, tag = "2")]
+    pub version: ::prost::alloc::string::String,
+    /// The commit that was checked out
+    #[prost(string, tag = "3")]
+    pub commit: ::prost::alloc::string::String,
+    /// The hash of the content of the repository, ignoring git metadata
+    #[prost(string, tag = "4")]
+    pub content_hash: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetResolvedPackageDependenciesResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub resolved_package_dependencies: ::prost::alloc::vec::Vec<
+        ResolvedPackageDependency,
+    >,
+}
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum ServiceStatus {
@@ -1132,6 +1322,40 @@ pub mod api_container_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /// Executes the given command inside a running container, streaming STDIN to it and its output back while it runs
+        /// The first message sent must be the one starting the command, and the last message received carries its exit code
+        pub async fn exec_command_stream(
+            &mut self,
+            request: impl tonic::IntoStreamingRequest<
+                Message = super::ExecCommandStreamRequest,
+            >,
+        ) -> std::result::Result<
+            tonic::Response<tonic::codec::Streaming<super::ExecCommandStreamResponse>>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/ExecCommandStream",
+            );
+            let mut req = request.into_streaming_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "ExecCommandStream",
+                    ),
+                );
+            self.inner.streaming(req, path, codec).await
+        }
         /// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
         pub async fn wait_for_http_get_endpoint_availability(
             &mut self,
@@ -1316,6 +1540,34 @@ pub mod api_container_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /// Tells the API container to copy the contents of a files artifact into a running service
+        pub async fn copy_files_artifact_to_service(
+            &mut self,
+            request: impl tonic::IntoRequest<super::CopyFilesArtifactToServiceArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/CopyFilesArtifactToService",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "CopyFilesArtifactToService",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
         pub async fn list_files_artifact_names_and_uuids(
             &mut self,
             request: impl tonic::IntoRequest<()>,
@@ -1438,6 +1690,37 @@ pub mod api_container_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /// Streams the progress and the end of every Starlark run executed in the enclave from now on, whoever started it
+        pub async fn watch_starlark_run_progress(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
+        ) -> std::result::Result<
+            tonic::Response<tonic::codec::Streaming<super::StarlarkRunResponseLine>>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/WatchStarlarkRunProgress",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "WatchStarlarkRunProgress",
+                    ),
+                );
+            self.inner.server_streaming(req, path, codec).await
+        }
         /// Gets yaml representing the plan the script will execute in an enclave
         pub async fn get_starlark_script_plan_yaml(
             &mut self,
@@ -1494,6 +1777,37 @@ pub mod api_container_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /// Returns the commit and content hash of every remote package cloned in the enclave, used to generate kurtosis.lock
+        pub async fn get_resolved_package_dependencies(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetResolvedPackageDependenciesResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/GetResolvedPackageDependencies",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "GetResolvedPackageDependencies",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
     }
 }
 /// Generated server implementations.
@@ -1560,6 +1874,24 @@ pub mod api_container_service_server {
             tonic::Response<super::ExecCommandResponse>,
             tonic::Status,
         >;
+        /// Server streaming response type for the ExecCommandStream method.
+        type ExecCommandStreamStream: futures_core::Stream<
+                Item = std::result::Result<
+                    super::ExecCommandStreamResponse,
+                    tonic::Status,
+                >,
+            >
+            + Send
+            + 'static;
+        /// Executes the given command inside a running container, streaming STDIN to it and its output back while it runs
+        /// The first message sent must be the one starting the command, and the last message received carries its exit code
+        async fn exec_command_stream(
+            &self,
+            request: tonic::Request<tonic::Streaming<super::ExecCommandStreamRequest>>,
+        ) -> std::result::Result<
+            tonic::Response<Self::ExecCommandStreamStream>,
+            tonic::Status,
+        >;
         /// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
         async fn wait_for_http_get_endpoint_availability(
             &self,
@@ -1608,6 +1940,11 @@ pub mod api_container_service_server {
             tonic::Response<super::StoreFilesArtifactFromServiceResponse>,
             tonic::Status,
         >;
+        /// Tells the API container to copy the contents of a files artifact into a running service
+        async fn copy_files_artifact_to_service(
+            &self,
+            request: tonic::Request<super::CopyFilesArtifactToServiceArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
         async fn list_files_artifact_names_and_uuids(
             &self,
             request: tonic::Request<()>,
@@ -1638,6 +1975,20 @@ pub mod api_container_service_server {
             tonic::Response<super::GetStarlarkRunResponse>,
             tonic::Status,
         >;
+        /// Server streaming response type for the WatchStarlarkRunProgress method.
+        type WatchStarlarkRunProgressStream: futures_core::Stream<
+                Item = std::result::Result<super::StarlarkRunResponseLine, tonic::Status>,
+            >
+            + Send
+            + 'static;
+        /// Streams the progress and the end of every Starlark run executed in the enclave from now on, whoever started it
+        async fn watch_starlark_run_progress(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<Self::WatchStarlarkRunProgressStream>,
+            tonic::Status,
+        >;
         /// Gets yaml representing the plan the script will execute in an enclave
         async fn get_starlark_script_plan_yaml(
             &self,
@@ -1648,6 +1999,14 @@ pub mod api_container_service_server {
             &self,
             request: tonic::Request<super::StarlarkPackagePlanYamlArgs>,
         ) -> std::result::Result<tonic::Response<super::PlanYaml>, tonic::Status>;
+        /// Returns the commit and content hash of every remote package cloned in the enclave, used to generate kurtosis.lock
+        async fn get_resolved_package_dependencies(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetResolvedPackageDependenciesResponse>,
+            tonic::Status,
+        >;
     }
     #[derive(Debug)]
     pub struct ApiContainerServiceServer<T: ApiContainerService> {
@@ -2012,6 +2371,55 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/api_container_api.ApiContainerService/ExecCommandStream" => {
+                    #[allow(non_camel_case_types)]
+                    struct ExecCommandStreamSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::StreamingService<super::ExecCommandStreamRequest>
+                    for ExecCommandStreamSvc<T> {
+                        type Response = super::ExecCommandStreamResponse;
+                        type ResponseStream = T::ExecCommandStreamStream;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::ResponseStream>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                tonic::Streaming<super::ExecCommandStreamRequest>,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).exec_command_stream(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = ExecCommandStreamSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability" => {
                     #[allow(non_camel_case_types)]
                     struct WaitForHttpGetEndpointAvailabilitySvc<T: ApiContainerService>(
@@ -2313,6 +2721,56 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/api_container_api.ApiContainerService/CopyFilesArtifactToService" => {
+                    #[allow(non_camel_case_types)]
+                    struct CopyFilesArtifactToServiceSvc<T: ApiContainerService>(
+                        pub Arc<T>,
+                    );
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<super::CopyFilesArtifactToServiceArgs>
+                    for CopyFilesArtifactToServiceSvc<T> {
+                        type Response = ();
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::CopyFilesArtifactToServiceArgs,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).copy_files_artifact_to_service(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = CopyFilesArtifactToServiceSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids" => {
                     #[allow(non_camel_case_types)]
                     struct ListFilesArtifactNamesAndUuidsSvc<T: ApiContainerService>(
@@ -2494,6 +2952,52 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/api_container_api.ApiContainerService/WatchStarlarkRunProgress" => {
+                    #[allow(non_camel_case_types)]
+                    struct WatchStarlarkRunProgressSvc<T: ApiContainerService>(
+                        pub Arc<T>,
+                    );
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::ServerStreamingService<()>
+                    for WatchStarlarkRunProgressSvc<T> {
+                        type Response = super::StarlarkRunResponseLine;
+                        type ResponseStream = T::WatchStarlarkRunProgressStream;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::ResponseStream>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).watch_starlark_run_progress(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = WatchStarlarkRunProgressSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.server_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/api_container_api.ApiContainerService/GetStarlarkScriptPlanYaml" => {
                     #[allow(non_camel_case_types)]
                     struct GetStarlarkScriptPlanYamlSvc<T: ApiContainerService>(
@@ -2590,6 +3094,49 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/api_container_api.ApiContainerService/GetResolvedPackageDependencies" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetResolvedPackageDependenciesSvc<T: ApiContainerService>(
+                        pub Arc<T>,
+                    );
+                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
+                    for GetResolvedPackageDependenciesSvc<T> {
+                        type Response = super::GetResolvedPackageDependenciesResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_resolved_package_dependencies(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetResolvedPackageDependenciesSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         Ok(
@@ -32,6 +32,38 @@ pub struct CreateEnclaveArgs {
     /// This is not an EnclaveMode because we will need to debug both current Modes (Test and Prod)
     #[prost(bool, optional, tag = "5")]
     pub should_apic_run_in_debug_mode: ::core::option::Option<bool>,
+    /// Arbitrary key-value pairs attached to the enclave, which can be used to filter enclaves
+    #[prost(map = "string, string", tag = "6")]
+    pub labels: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// If set, the enclave will expire after this duration and the engine will apply the expiry action to it
+    #[prost(message, optional, tag = "7")]
+    pub ttl: ::core::option::Option<::prost_types::Duration>,
+    /// What to do with the enclave once it expires; only meaningful if a TTL is set. Defaults to destroying it
+    #[prost(enumeration = "EnclaveExpiryAction", optional, tag = "8")]
+    pub expiry_action: ::core::option::Option<i32>,
+    /// If set, caps the resources the enclave services can claim. Limits left to zero are taken from the engine default quota
+    #[prost(message, optional, tag = "9")]
+    pub resource_quota: ::core::option::Option<EnclaveResourceQuota>,
+}
+/// A zero limit means that the resource isn't capped
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EnclaveResourceQuota {
+    /// The sum of the min_cpu of all the services, in millicores
+    #[prost(uint64, tag = "1")]
+    pub max_cpu_millicores: u64,
+    /// The sum of the min_memory of all the services, in megabytes
+    #[prost(uint64, tag = "2")]
+    pub max_memory_megabytes: u64,
+    /// The number of services in the enclave
+    #[prost(uint32, tag = "3")]
+    pub max_services: u32,
+    /// The sum of the sizes of all the persistent directories, in megabytes
+    #[prost(uint64, tag = "4")]
+    pub max_persistent_storage_megabytes: u64,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -99,6 +131,35 @@ pub struct EnclaveInfo {
     pub creation_time: ::core::option::Option<::prost_types::Timestamp>,
     #[prost(enumeration = "EnclaveMode", tag = "9")]
     pub mode: i32,
+    /// The labels attached to the enclave when it was created
+    #[prost(map = "string, string", tag = "10")]
+    pub labels: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// When the enclave expires; not present if the enclave never expires
+    #[prost(message, optional, tag = "11")]
+    pub expiration_time: ::core::option::Option<::prost_types::Timestamp>,
+    /// What happens to the enclave once it expires; only meaningful if the expiration time is present
+    #[prost(enumeration = "EnclaveExpiryAction", tag = "12")]
+    pub expiry_action: i32,
+    /// The resource quota of the enclave; not present if the enclave has no quota
+    #[prost(message, optional, tag = "13")]
+    pub resource_quota: ::core::option::Option<EnclaveResourceQuota>,
+    /// Identifier of the principal that created the enclave, prefixed by how it authenticated (e.g. 'token:alice' or
+    /// 'certificate:alice'); empty if the engine wasn't requiring authentication when it was created
+    #[prost(string, tag = "14")]
+    pub owner: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEnclavesArgs {
+    /// If set, only the enclaves having all these labels will be returned
+    #[prost(map = "string, string", tag = "1")]
+    pub labels: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -151,6 +212,44 @@ pub struct DestroyEnclaveArgs {
     pub enclave_identifier: ::prost::alloc::string::String,
 }
 /// ==============================================================================================
+///                                   Get Enclave API Container Token
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEnclaveApiContainerTokenArgs {
+    /// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave whose API container will be called
+    #[prost(string, tag = "1")]
+    pub enclave_identifier: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEnclaveApiContainerTokenResponse {
+    /// Bearer token for the API container of the enclave; the caller's own token, or a credential issued by the engine
+    /// when the caller authenticated with a client certificate
+    #[prost(string, tag = "1")]
+    pub token: ::prost::alloc::string::String,
+}
+/// ==============================================================================================
+///                                        Extend Enclave
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ExtendEnclaveArgs {
+    /// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to extend
+    #[prost(string, tag = "1")]
+    pub enclave_identifier: ::prost::alloc::string::String,
+    /// How much time to add to the enclave's TTL, counting from now if the enclave has already expired
+    #[prost(message, optional, tag = "2")]
+    pub extension: ::core::option::Option<::prost_types::Duration>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ExtendEnclaveResponse {
+    /// The new expiration time of the enclave
+    #[prost(message, optional, tag = "1")]
+    pub expiration_time: ::core::option::Option<::prost_types::Timestamp>,
+}
+/// ==============================================================================================
 ///                                        Create Enclave
 /// ==============================================================================================
 #[allow(clippy::derive_partial_eq_without_eq)]
@@ -236,6 +335,33 @@ pub struct LogLineFilter {
     #[prost(string, tag = "2")]
     pub text_pattern: ::prost::alloc::string::String,
 }
+/// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum EnclaveExpiryAction {
+    Destroy = 0,
+    Stop = 1,
+}
+impl EnclaveExpiryAction {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            EnclaveExpiryAction::Destroy => "EnclaveExpiryAction_DESTROY",
+            EnclaveExpiryAction::Stop => "EnclaveExpiryAction_STOP",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "EnclaveExpiryAction_DESTROY" => Some(Self::Destroy),
+            "EnclaveExpiryAction_STOP" => Some(Self::Stop),
+            _ => None,
+        }
+    }
+}
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum EnclaveMode {
@@ -524,7 +650,7 @@ pub mod engine_service_client {
         /// Returns information about the existing enclaves
         pub async fn get_enclaves(
             &mut self,
-            request: impl tonic::IntoRequest<()>,
+            request: impl tonic::IntoRequest<super::GetEnclavesArgs>,
         ) -> std::result::Result<
             tonic::Response<super::GetEnclavesResponse>,
             tonic::Status,
@@ -624,6 +750,32 @@ pub mod engine_service_client {
                 .insert(GrpcMethod::new("engine_api.EngineService", "DestroyEnclave"));
             self.inner.unary(req, path, codec).await
         }
+        /// Pushes out the expiration time of an enclave that was created with a TTL
+        pub async fn extend_enclave(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ExtendEnclaveArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::ExtendEnclaveResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/ExtendEnclave",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "ExtendEnclave"));
+            self.inner.unary(req, path, codec).await
+        }
         /// Gets rid of old enclaves
         pub async fn clean(
             &mut self,
@@ -673,6 +825,37 @@ pub mod engine_service_client {
                 .insert(GrpcMethod::new("engine_api.EngineService", "GetServiceLogs"));
             self.inner.server_streaming(req, path, codec).await
         }
+        /// Returns the token the caller authenticates to the API container of an enclave with, when the engine requires authentication
+        pub async fn get_enclave_api_container_token(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetEnclaveApiContainerTokenArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclaveApiContainerTokenResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/GetEnclaveApiContainerToken",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "engine_api.EngineService",
+                        "GetEnclaveApiContainerToken",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
     }
 }
 /// Generated server implementations.
@@ -704,7 +887,7 @@ pub mod engine_service_server {
         /// Returns information about the existing enclaves
         async fn get_enclaves(
             &self,
-            request: tonic::Request<()>,
+            request: tonic::Request<super::GetEnclavesArgs>,
         ) -> std::result::Result<
             tonic::Response<super::GetEnclavesResponse>,
             tonic::Status,
@@ -727,6 +910,14 @@ pub mod engine_service_server {
             &self,
             request: tonic::Request<super::DestroyEnclaveArgs>,
         ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Pushes out the expiration time of an enclave that was created with a TTL
+        async fn extend_enclave(
+            &self,
+            request: tonic::Request<super::ExtendEnclaveArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::ExtendEnclaveResponse>,
+            tonic::Status,
+        >;
         /// Gets rid of old enclaves
         async fn clean(
             &self,
@@ -746,6 +937,14 @@ pub mod engine_service_server {
             tonic::Response<Self::GetServiceLogsStream>,
             tonic::Status,
         >;
+        /// Returns the token the caller authenticates to the API container of an enclave with, when the engine requires authentication
+        async fn get_enclave_api_container_token(
+            &self,
+            request: tonic::Request<super::GetEnclaveApiContainerTokenArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclaveApiContainerTokenResponse>,
+            tonic::Status,
+        >;
     }
     #[derive(Debug)]
     pub struct EngineServiceServer<T: EngineService> {
@@ -916,14 +1115,19 @@ pub mod engine_service_server {
                 "/engine_api.EngineService/GetEnclaves" => {
                     #[allow(non_camel_case_types)]
                     struct GetEnclavesSvc<T: EngineService>(pub Arc<T>);
-                    impl<T: EngineService> tonic::server::UnaryService<()>
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::GetEnclavesArgs>
                     for GetEnclavesSvc<T> {
                         type Response = super::GetEnclavesResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetEnclavesArgs>,
+                        ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
                                 (*inner).get_enclaves(request).await
@@ -1095,6 +1299,52 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/engine_api.EngineService/ExtendEnclave" => {
+                    #[allow(non_camel_case_types)]
+                    struct ExtendEnclaveSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::ExtendEnclaveArgs>
+                    for ExtendEnclaveSvc<T> {
+                        type Response = super::ExtendEnclaveResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ExtendEnclaveArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).extend_enclave(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = ExtendEnclaveSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/engine_api.EngineService/Clean" => {
                     #[allow(non_camel_case_types)]
                     struct CleanSvc<T: EngineService>(pub Arc<T>);
@@ -1184,6 +1434,54 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/engine_api.EngineService/GetEnclaveApiContainerToken" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetEnclaveApiContainerTokenSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::GetEnclaveApiContainerTokenArgs>
+                    for GetEnclaveApiContainerTokenSvc<T> {
+                        type Response = super::GetEnclaveApiContainerTokenResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::GetEnclaveApiContainerTokenArgs,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_enclave_api_container_token(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetEnclaveApiContainerTokenSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         Ok(
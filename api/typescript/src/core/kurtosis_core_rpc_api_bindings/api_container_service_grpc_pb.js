@@ -27,6 +27,17 @@ function deserialize_api_container_api_ConnectServicesResponse(buffer_arg) {
   return api_container_service_pb.ConnectServicesResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_CopyFilesArtifactToServiceArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.CopyFilesArtifactToServiceArgs)) {
+    throw new Error('Expected argument of type api_container_api.CopyFilesArtifactToServiceArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_CopyFilesArtifactToServiceArgs(buffer_arg) {
+  return api_container_service_pb.CopyFilesArtifactToServiceArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_DownloadFilesArtifactArgs(arg) {
   if (!(arg instanceof api_container_service_pb.DownloadFilesArtifactArgs)) {
     throw new Error('Expected argument of type api_container_api.DownloadFilesArtifactArgs');
@@ -60,6 +71,28 @@ function deserialize_api_container_api_ExecCommandResponse(buffer_arg) {
   return api_container_service_pb.ExecCommandResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_ExecCommandStreamRequest(arg) {
+  if (!(arg instanceof api_container_service_pb.ExecCommandStreamRequest)) {
+    throw new Error('Expected argument of type api_container_api.ExecCommandStreamRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ExecCommandStreamRequest(buffer_arg) {
+  return api_container_service_pb.ExecCommandStreamRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_ExecCommandStreamResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.ExecCommandStreamResponse)) {
+    throw new Error('Expected argument of type api_container_api.ExecCommandStreamResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ExecCommandStreamResponse(buffer_arg) {
+  return api_container_service_pb.ExecCommandStreamResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_GetExistingAndHistoricalServiceIdentifiersResponse(arg) {
   if (!(arg instanceof api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse)) {
     throw new Error('Expected argument of type api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse');
@@ -71,6 +104,17 @@ function deserialize_api_container_api_GetExistingAndHistoricalServiceIdentifier
   return api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_GetResolvedPackageDependenciesResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetResolvedPackageDependenciesResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetResolvedPackageDependenciesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetResolvedPackageDependenciesResponse(buffer_arg) {
+  return api_container_service_pb.GetResolvedPackageDependenciesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_GetServicesArgs(arg) {
   if (!(arg instanceof api_container_service_pb.GetServicesArgs)) {
     throw new Error('Expected argument of type api_container_api.GetServicesArgs');
@@ -376,6 +420,19 @@ execCommand: {
     responseSerialize: serialize_api_container_api_ExecCommandResponse,
     responseDeserialize: deserialize_api_container_api_ExecCommandResponse,
   },
+  // Executes the given command inside a running container, streaming STDIN to it and its output back while it runs
+// The first message sent must be the one starting the command, and the last message received carries its exit code
+execCommandStream: {
+    path: '/api_container_api.ApiContainerService/ExecCommandStream',
+    requestStream: true,
+    responseStream: true,
+    requestType: api_container_service_pb.ExecCommandStreamRequest,
+    responseType: api_container_service_pb.ExecCommandStreamResponse,
+    requestSerialize: serialize_api_container_api_ExecCommandStreamRequest,
+    requestDeserialize: deserialize_api_container_api_ExecCommandStreamRequest,
+    responseSerialize: serialize_api_container_api_ExecCommandStreamResponse,
+    responseDeserialize: deserialize_api_container_api_ExecCommandStreamResponse,
+  },
   // Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
 waitForHttpGetEndpointAvailability: {
     path: '/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability',
@@ -448,6 +505,18 @@ storeFilesArtifactFromService: {
     responseSerialize: serialize_api_container_api_StoreFilesArtifactFromServiceResponse,
     responseDeserialize: deserialize_api_container_api_StoreFilesArtifactFromServiceResponse,
   },
+  // Tells the API container to copy the contents of a files artifact into a running service
+copyFilesArtifactToService: {
+    path: '/api_container_api.ApiContainerService/CopyFilesArtifactToService',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.CopyFilesArtifactToServiceArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_CopyFilesArtifactToServiceArgs,
+    requestDeserialize: deserialize_api_container_api_CopyFilesArtifactToServiceArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
   listFilesArtifactNamesAndUuids: {
     path: '/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids',
     requestStream: false,
@@ -494,6 +563,18 @@ getStarlarkRun: {
     responseSerialize: serialize_api_container_api_GetStarlarkRunResponse,
     responseDeserialize: deserialize_api_container_api_GetStarlarkRunResponse,
   },
+  // Streams the progress and the end of every Starlark run executed in the enclave from now on, whoever started it
+watchStarlarkRunProgress: {
+    path: '/api_container_api.ApiContainerService/WatchStarlarkRunProgress',
+    requestStream: false,
+    responseStream: true,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.StarlarkRunResponseLine,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_StarlarkRunResponseLine,
+    responseDeserialize: deserialize_api_container_api_StarlarkRunResponseLine,
+  },
   // Gets yaml representing the plan the script will execute in an enclave
 getStarlarkScriptPlanYaml: {
     path: '/api_container_api.ApiContainerService/GetStarlarkScriptPlanYaml',
@@ -518,6 +599,18 @@ getStarlarkPackagePlanYaml: {
     responseSerialize: serialize_api_container_api_PlanYaml,
     responseDeserialize: deserialize_api_container_api_PlanYaml,
   },
+  // Returns the commit and content hash of every remote package cloned in the enclave, used to generate kurtosis.lock
+getResolvedPackageDependencies: {
+    path: '/api_container_api.ApiContainerService/GetResolvedPackageDependencies',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetResolvedPackageDependenciesResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetResolvedPackageDependenciesResponse,
+    responseDeserialize: deserialize_api_container_api_GetResolvedPackageDependenciesResponse,
+  },
 };
 
 exports.ApiContainerServiceClient = grpc.makeGenericClientConstructor(ApiContainerServiceService);
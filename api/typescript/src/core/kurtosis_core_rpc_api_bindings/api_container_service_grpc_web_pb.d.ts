@@ -73,6 +73,13 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.StoreFilesArtifactFromServiceResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.StoreFilesArtifactFromServiceResponse>;
 
+  copyFilesArtifactToService(
+    request: api_container_service_pb.CopyFilesArtifactToServiceArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
   listFilesArtifactNamesAndUuids(
     request: google_protobuf_empty_pb.Empty,
     metadata: grpcWeb.Metadata | undefined,
@@ -101,6 +108,11 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.GetStarlarkRunResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.GetStarlarkRunResponse>;
 
+  watchStarlarkRunProgress(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
+
   getStarlarkScriptPlanYaml(
     request: api_container_service_pb.StarlarkScriptPlanYamlArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -115,6 +127,13 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.PlanYaml) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.PlanYaml>;
 
+  getResolvedPackageDependencies(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetResolvedPackageDependenciesResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetResolvedPackageDependenciesResponse>;
+
 }
 
 export class ApiContainerServicePromiseClient {
@@ -172,6 +191,11 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.StoreFilesArtifactFromServiceResponse>;
 
+  copyFilesArtifactToService(
+    request: api_container_service_pb.CopyFilesArtifactToServiceArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
   listFilesArtifactNamesAndUuids(
     request: google_protobuf_empty_pb.Empty,
     metadata?: grpcWeb.Metadata
@@ -192,6 +216,11 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.GetStarlarkRunResponse>;
 
+  watchStarlarkRunProgress(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
+
   getStarlarkScriptPlanYaml(
     request: api_container_service_pb.StarlarkScriptPlanYamlArgs,
     metadata?: grpcWeb.Metadata
@@ -202,5 +231,10 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.PlanYaml>;
 
+  getResolvedPackageDependencies(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetResolvedPackageDependenciesResponse>;
+
 }
 
@@ -671,6 +671,67 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.storeFilesArt
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.CopyFilesArtifactToServiceArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_CopyFilesArtifactToService = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/CopyFilesArtifactToService',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.CopyFilesArtifactToServiceArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.CopyFilesArtifactToServiceArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.CopyFilesArtifactToServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.copyFilesArtifactToService =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/CopyFilesArtifactToService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_CopyFilesArtifactToService,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.CopyFilesArtifactToServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.copyFilesArtifactToService =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/CopyFilesArtifactToService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_CopyFilesArtifactToService);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -915,6 +976,62 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.getStarlarkRu
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.StarlarkRunResponseLine>}
+ */
+const methodDescriptor_ApiContainerService_WatchStarlarkRunProgress = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/WatchStarlarkRunProgress',
+  grpc.web.MethodType.SERVER_STREAMING,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.StarlarkRunResponseLine,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.StarlarkRunResponseLine.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StarlarkRunResponseLine>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.watchStarlarkRunProgress =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/WatchStarlarkRunProgress',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WatchStarlarkRunProgress);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StarlarkRunResponseLine>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.watchStarlarkRunProgress =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/WatchStarlarkRunProgress',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WatchStarlarkRunProgress);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -1037,5 +1154,66 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.getStarlarkPa
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetResolvedPackageDependenciesResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetResolvedPackageDependencies = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetResolvedPackageDependencies',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetResolvedPackageDependenciesResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetResolvedPackageDependenciesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetResolvedPackageDependenciesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetResolvedPackageDependenciesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getResolvedPackageDependencies =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetResolvedPackageDependencies',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetResolvedPackageDependencies,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetResolvedPackageDependenciesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getResolvedPackageDependencies =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetResolvedPackageDependencies',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetResolvedPackageDependencies);
+};
+
+
 module.exports = proto.api_container_api;
 
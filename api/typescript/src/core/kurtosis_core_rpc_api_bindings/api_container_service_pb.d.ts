@@ -81,6 +81,9 @@ export class Container extends jspb.Message {
   getEnvVarsMap(): jspb.Map<string, string>;
   clearEnvVarsMap(): Container;
 
+  getRestartCount(): number;
+  setRestartCount(value: number): Container;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Container.AsObject;
   static toObject(includeInstance: boolean, msg: Container): Container.AsObject;
@@ -96,6 +99,7 @@ export namespace Container {
     entrypointArgsList: Array<string>,
     cmdArgsList: Array<string>,
     envVarsMap: Array<[string, string]>,
+    restartCount: number,
   }
 
   export enum Status { 
@@ -135,6 +139,12 @@ export class ServiceInfo extends jspb.Message {
   hasContainer(): boolean;
   clearContainer(): ServiceInfo;
 
+  getPersistentDirectoriesMap(): jspb.Map<string, PersistentDirectory>;
+  clearPersistentDirectoriesMap(): ServiceInfo;
+
+  getMaybePublicUrlsMap(): jspb.Map<string, string>;
+  clearMaybePublicUrlsMap(): ServiceInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ServiceInfo.AsObject;
   static toObject(includeInstance: boolean, msg: ServiceInfo): ServiceInfo.AsObject;
@@ -154,6 +164,38 @@ export namespace ServiceInfo {
     shortenedUuid: string,
     serviceStatus: ServiceStatus,
     container?: Container.AsObject,
+    persistentDirectoriesMap: Array<[string, PersistentDirectory.AsObject]>,
+    maybePublicUrlsMap: Array<[string, string]>,
+  }
+}
+
+export class PersistentDirectory extends jspb.Message {
+  getPersistentKey(): string;
+  setPersistentKey(value: string): PersistentDirectory;
+
+  getSize(): number;
+  setSize(value: number): PersistentDirectory;
+
+  getStorageClass(): string;
+  setStorageClass(value: string): PersistentDirectory;
+
+  getAccessMode(): string;
+  setAccessMode(value: string): PersistentDirectory;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PersistentDirectory.AsObject;
+  static toObject(includeInstance: boolean, msg: PersistentDirectory): PersistentDirectory.AsObject;
+  static serializeBinaryToWriter(message: PersistentDirectory, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PersistentDirectory;
+  static deserializeBinaryFromReader(message: PersistentDirectory, reader: jspb.BinaryReader): PersistentDirectory;
+}
+
+export namespace PersistentDirectory {
+  export type AsObject = {
+    persistentKey: string,
+    size: number,
+    storageClass: string,
+    accessMode: string,
   }
 }
 
@@ -206,6 +248,16 @@ export class RunStarlarkScriptArgs extends jspb.Message {
   hasNonBlockingMode(): boolean;
   clearNonBlockingMode(): RunStarlarkScriptArgs;
 
+  getGitHostConfigsList(): Array<GitHostConfig>;
+  setGitHostConfigsList(value: Array<GitHostConfig>): RunStarlarkScriptArgs;
+  clearGitHostConfigsList(): RunStarlarkScriptArgs;
+  addGitHostConfigs(value?: GitHostConfig, index?: number): GitHostConfig;
+
+  getRegistryCredentialsList(): Array<RegistryCredentials>;
+  setRegistryCredentialsList(value: Array<RegistryCredentials>): RunStarlarkScriptArgs;
+  clearRegistryCredentialsList(): RunStarlarkScriptArgs;
+  addRegistryCredentials(value?: RegistryCredentials, index?: number): RegistryCredentials;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): RunStarlarkScriptArgs.AsObject;
   static toObject(includeInstance: boolean, msg: RunStarlarkScriptArgs): RunStarlarkScriptArgs.AsObject;
@@ -226,6 +278,8 @@ export namespace RunStarlarkScriptArgs {
     cloudUserId?: string,
     imageDownloadMode?: ImageDownloadMode,
     nonBlockingMode?: boolean,
+    gitHostConfigsList: Array<GitHostConfig.AsObject>,
+    registryCredentialsList: Array<RegistryCredentials.AsObject>,
   }
 
   export enum SerializedParamsCase { 
@@ -341,6 +395,16 @@ export class RunStarlarkPackageArgs extends jspb.Message {
   hasGithubAuthToken(): boolean;
   clearGithubAuthToken(): RunStarlarkPackageArgs;
 
+  getGitHostConfigsList(): Array<GitHostConfig>;
+  setGitHostConfigsList(value: Array<GitHostConfig>): RunStarlarkPackageArgs;
+  clearGitHostConfigsList(): RunStarlarkPackageArgs;
+  addGitHostConfigs(value?: GitHostConfig, index?: number): GitHostConfig;
+
+  getRegistryCredentialsList(): Array<RegistryCredentials>;
+  setRegistryCredentialsList(value: Array<RegistryCredentials>): RunStarlarkPackageArgs;
+  clearRegistryCredentialsList(): RunStarlarkPackageArgs;
+  addRegistryCredentials(value?: RegistryCredentials, index?: number): RegistryCredentials;
+
   getStarlarkPackageContentCase(): RunStarlarkPackageArgs.StarlarkPackageContentCase;
 
   serializeBinary(): Uint8Array;
@@ -368,6 +432,8 @@ export namespace RunStarlarkPackageArgs {
     imageDownloadMode?: ImageDownloadMode,
     nonBlockingMode?: boolean,
     githubAuthToken?: string,
+    gitHostConfigsList: Array<GitHostConfig.AsObject>,
+    registryCredentialsList: Array<RegistryCredentials.AsObject>,
   }
 
   export enum StarlarkPackageContentCase { 
@@ -432,6 +498,105 @@ export namespace RunStarlarkPackageArgs {
   }
 }
 
+export class GitHostConfig extends jspb.Message {
+  getHost(): string;
+  setHost(value: string): GitHostConfig;
+
+  getCloneUrlPrefix(): string;
+  setCloneUrlPrefix(value: string): GitHostConfig;
+  hasCloneUrlPrefix(): boolean;
+  clearCloneUrlPrefix(): GitHostConfig;
+
+  getUsername(): string;
+  setUsername(value: string): GitHostConfig;
+  hasUsername(): boolean;
+  clearUsername(): GitHostConfig;
+
+  getPassword(): string;
+  setPassword(value: string): GitHostConfig;
+  hasPassword(): boolean;
+  clearPassword(): GitHostConfig;
+
+  getSshPrivateKey(): string;
+  setSshPrivateKey(value: string): GitHostConfig;
+  hasSshPrivateKey(): boolean;
+  clearSshPrivateKey(): GitHostConfig;
+
+  getSshKnownHosts(): string;
+  setSshKnownHosts(value: string): GitHostConfig;
+  hasSshKnownHosts(): boolean;
+  clearSshKnownHosts(): GitHostConfig;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GitHostConfig.AsObject;
+  static toObject(includeInstance: boolean, msg: GitHostConfig): GitHostConfig.AsObject;
+  static serializeBinaryToWriter(message: GitHostConfig, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GitHostConfig;
+  static deserializeBinaryFromReader(message: GitHostConfig, reader: jspb.BinaryReader): GitHostConfig;
+}
+
+export namespace GitHostConfig {
+  export type AsObject = {
+    host: string,
+    cloneUrlPrefix?: string,
+    username?: string,
+    password?: string,
+    sshPrivateKey?: string,
+    sshKnownHosts?: string,
+  }
+
+  export enum CloneUrlPrefixCase { 
+    _CLONE_URL_PREFIX_NOT_SET = 0,
+    CLONE_URL_PREFIX = 2,
+  }
+
+  export enum UsernameCase { 
+    _USERNAME_NOT_SET = 0,
+    USERNAME = 3,
+  }
+
+  export enum PasswordCase { 
+    _PASSWORD_NOT_SET = 0,
+    PASSWORD = 4,
+  }
+
+  export enum SshPrivateKeyCase { 
+    _SSH_PRIVATE_KEY_NOT_SET = 0,
+    SSH_PRIVATE_KEY = 5,
+  }
+
+  export enum SshKnownHostsCase { 
+    _SSH_KNOWN_HOSTS_NOT_SET = 0,
+    SSH_KNOWN_HOSTS = 6,
+  }
+}
+
+export class RegistryCredentials extends jspb.Message {
+  getRegistry(): string;
+  setRegistry(value: string): RegistryCredentials;
+
+  getUsername(): string;
+  setUsername(value: string): RegistryCredentials;
+
+  getPassword(): string;
+  setPassword(value: string): RegistryCredentials;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RegistryCredentials.AsObject;
+  static toObject(includeInstance: boolean, msg: RegistryCredentials): RegistryCredentials.AsObject;
+  static serializeBinaryToWriter(message: RegistryCredentials, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RegistryCredentials;
+  static deserializeBinaryFromReader(message: RegistryCredentials, reader: jspb.BinaryReader): RegistryCredentials;
+}
+
+export namespace RegistryCredentials {
+  export type AsObject = {
+    registry: string,
+    username: string,
+    password: string,
+  }
+}
+
 export class StarlarkRunResponseLine extends jspb.Message {
   getInstruction(): StarlarkInstruction | undefined;
   setInstruction(value?: StarlarkInstruction): StarlarkRunResponseLine;
@@ -936,6 +1101,152 @@ export namespace ExecCommandResponse {
   }
 }
 
+export class ExecCommandStreamRequest extends jspb.Message {
+  getStart(): ExecCommandStreamStart | undefined;
+  setStart(value?: ExecCommandStreamStart): ExecCommandStreamRequest;
+  hasStart(): boolean;
+  clearStart(): ExecCommandStreamRequest;
+
+  getStdin(): Uint8Array | string;
+  getStdin_asU8(): Uint8Array;
+  getStdin_asB64(): string;
+  setStdin(value: Uint8Array | string): ExecCommandStreamRequest;
+
+  getCloseStdin(): boolean;
+  setCloseStdin(value: boolean): ExecCommandStreamRequest;
+
+  getResize(): TerminalSize | undefined;
+  setResize(value?: TerminalSize): ExecCommandStreamRequest;
+  hasResize(): boolean;
+  clearResize(): ExecCommandStreamRequest;
+
+  getRequestCase(): ExecCommandStreamRequest.RequestCase;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExecCommandStreamRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ExecCommandStreamRequest): ExecCommandStreamRequest.AsObject;
+  static serializeBinaryToWriter(message: ExecCommandStreamRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExecCommandStreamRequest;
+  static deserializeBinaryFromReader(message: ExecCommandStreamRequest, reader: jspb.BinaryReader): ExecCommandStreamRequest;
+}
+
+export namespace ExecCommandStreamRequest {
+  export type AsObject = {
+    start?: ExecCommandStreamStart.AsObject,
+    stdin: Uint8Array | string,
+    closeStdin: boolean,
+    resize?: TerminalSize.AsObject,
+  }
+
+  export enum RequestCase { 
+    REQUEST_NOT_SET = 0,
+    START = 1,
+    STDIN = 2,
+    CLOSE_STDIN = 3,
+    RESIZE = 4,
+  }
+}
+
+export class ExecCommandStreamStart extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): ExecCommandStreamStart;
+
+  getCommandArgsList(): Array<string>;
+  setCommandArgsList(value: Array<string>): ExecCommandStreamStart;
+  clearCommandArgsList(): ExecCommandStreamStart;
+  addCommandArgs(value: string, index?: number): ExecCommandStreamStart;
+
+  getTty(): boolean;
+  setTty(value: boolean): ExecCommandStreamStart;
+
+  getTerminalSize(): TerminalSize | undefined;
+  setTerminalSize(value?: TerminalSize): ExecCommandStreamStart;
+  hasTerminalSize(): boolean;
+  clearTerminalSize(): ExecCommandStreamStart;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExecCommandStreamStart.AsObject;
+  static toObject(includeInstance: boolean, msg: ExecCommandStreamStart): ExecCommandStreamStart.AsObject;
+  static serializeBinaryToWriter(message: ExecCommandStreamStart, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExecCommandStreamStart;
+  static deserializeBinaryFromReader(message: ExecCommandStreamStart, reader: jspb.BinaryReader): ExecCommandStreamStart;
+}
+
+export namespace ExecCommandStreamStart {
+  export type AsObject = {
+    serviceIdentifier: string,
+    commandArgsList: Array<string>,
+    tty: boolean,
+    terminalSize?: TerminalSize.AsObject,
+  }
+
+  export enum TerminalSizeCase { 
+    _TERMINAL_SIZE_NOT_SET = 0,
+    TERMINAL_SIZE = 4,
+  }
+}
+
+export class TerminalSize extends jspb.Message {
+  getWidth(): number;
+  setWidth(value: number): TerminalSize;
+
+  getHeight(): number;
+  setHeight(value: number): TerminalSize;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TerminalSize.AsObject;
+  static toObject(includeInstance: boolean, msg: TerminalSize): TerminalSize.AsObject;
+  static serializeBinaryToWriter(message: TerminalSize, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TerminalSize;
+  static deserializeBinaryFromReader(message: TerminalSize, reader: jspb.BinaryReader): TerminalSize;
+}
+
+export namespace TerminalSize {
+  export type AsObject = {
+    width: number,
+    height: number,
+  }
+}
+
+export class ExecCommandStreamResponse extends jspb.Message {
+  getStdout(): Uint8Array | string;
+  getStdout_asU8(): Uint8Array;
+  getStdout_asB64(): string;
+  setStdout(value: Uint8Array | string): ExecCommandStreamResponse;
+
+  getStderr(): Uint8Array | string;
+  getStderr_asU8(): Uint8Array;
+  getStderr_asB64(): string;
+  setStderr(value: Uint8Array | string): ExecCommandStreamResponse;
+
+  getExitCode(): number;
+  setExitCode(value: number): ExecCommandStreamResponse;
+
+  getResponseCase(): ExecCommandStreamResponse.ResponseCase;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExecCommandStreamResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ExecCommandStreamResponse): ExecCommandStreamResponse.AsObject;
+  static serializeBinaryToWriter(message: ExecCommandStreamResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExecCommandStreamResponse;
+  static deserializeBinaryFromReader(message: ExecCommandStreamResponse, reader: jspb.BinaryReader): ExecCommandStreamResponse;
+}
+
+export namespace ExecCommandStreamResponse {
+  export type AsObject = {
+    stdout: Uint8Array | string,
+    stderr: Uint8Array | string,
+    exitCode: number,
+  }
+
+  export enum ResponseCase { 
+    RESPONSE_NOT_SET = 0,
+    STDOUT = 1,
+    STDERR = 2,
+    EXIT_CODE = 3,
+  }
+}
+
 export class WaitForHttpGetEndpointAvailabilityArgs extends jspb.Message {
   getServiceIdentifier(): string;
   setServiceIdentifier(value: string): WaitForHttpGetEndpointAvailabilityArgs;
@@ -1273,6 +1584,36 @@ export namespace StoreFilesArtifactFromServiceResponse {
   }
 }
 
+export class CopyFilesArtifactToServiceArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): CopyFilesArtifactToServiceArgs;
+
+  getFilesArtifactIdentifier(): string;
+  setFilesArtifactIdentifier(value: string): CopyFilesArtifactToServiceArgs;
+
+  getDestPath(): string;
+  setDestPath(value: string): CopyFilesArtifactToServiceArgs;
+
+  getRemoveFilesArtifactAfterCopy(): boolean;
+  setRemoveFilesArtifactAfterCopy(value: boolean): CopyFilesArtifactToServiceArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CopyFilesArtifactToServiceArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: CopyFilesArtifactToServiceArgs): CopyFilesArtifactToServiceArgs.AsObject;
+  static serializeBinaryToWriter(message: CopyFilesArtifactToServiceArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CopyFilesArtifactToServiceArgs;
+  static deserializeBinaryFromReader(message: CopyFilesArtifactToServiceArgs, reader: jspb.BinaryReader): CopyFilesArtifactToServiceArgs;
+}
+
+export namespace CopyFilesArtifactToServiceArgs {
+  export type AsObject = {
+    serviceIdentifier: string,
+    filesArtifactIdentifier: string,
+    destPath: string,
+    removeFilesArtifactAfterCopy: boolean,
+  }
+}
+
 export class FilesArtifactNameAndUuid extends jspb.Message {
   getFilename(): string;
   setFilename(value: string): FilesArtifactNameAndUuid;
@@ -1588,6 +1929,56 @@ export namespace StarlarkPackagePlanYamlArgs {
   }
 }
 
+export class ResolvedPackageDependency extends jspb.Message {
+  getRepositoryLocator(): string;
+  setRepositoryLocator(value: string): ResolvedPackageDependency;
+
+  getVersion(): string;
+  setVersion(value: string): ResolvedPackageDependency;
+
+  getCommit(): string;
+  setCommit(value: string): ResolvedPackageDependency;
+
+  getContentHash(): string;
+  setContentHash(value: string): ResolvedPackageDependency;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResolvedPackageDependency.AsObject;
+  static toObject(includeInstance: boolean, msg: ResolvedPackageDependency): ResolvedPackageDependency.AsObject;
+  static serializeBinaryToWriter(message: ResolvedPackageDependency, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResolvedPackageDependency;
+  static deserializeBinaryFromReader(message: ResolvedPackageDependency, reader: jspb.BinaryReader): ResolvedPackageDependency;
+}
+
+export namespace ResolvedPackageDependency {
+  export type AsObject = {
+    repositoryLocator: string,
+    version: string,
+    commit: string,
+    contentHash: string,
+  }
+}
+
+export class GetResolvedPackageDependenciesResponse extends jspb.Message {
+  getResolvedPackageDependenciesList(): Array<ResolvedPackageDependency>;
+  setResolvedPackageDependenciesList(value: Array<ResolvedPackageDependency>): GetResolvedPackageDependenciesResponse;
+  clearResolvedPackageDependenciesList(): GetResolvedPackageDependenciesResponse;
+  addResolvedPackageDependencies(value?: ResolvedPackageDependency, index?: number): ResolvedPackageDependency;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetResolvedPackageDependenciesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetResolvedPackageDependenciesResponse): GetResolvedPackageDependenciesResponse.AsObject;
+  static serializeBinaryToWriter(message: GetResolvedPackageDependenciesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetResolvedPackageDependenciesResponse;
+  static deserializeBinaryFromReader(message: GetResolvedPackageDependenciesResponse, reader: jspb.BinaryReader): GetResolvedPackageDependenciesResponse;
+}
+
+export namespace GetResolvedPackageDependenciesResponse {
+  export type AsObject = {
+    resolvedPackageDependenciesList: Array<ResolvedPackageDependency.AsObject>,
+  }
+}
+
 export enum ServiceStatus { 
   STOPPED = 0,
   RUNNING = 1,
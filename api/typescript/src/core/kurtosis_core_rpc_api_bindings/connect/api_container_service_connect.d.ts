@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkPackagePlanYamlArgs, StarlarkRunResponseLine, StarlarkScriptPlanYamlArgs, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
+import { ConnectServicesArgs, ConnectServicesResponse, CopyFilesArtifactToServiceArgs, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, ExecCommandStreamRequest, ExecCommandStreamResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetResolvedPackageDependenciesResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkPackagePlanYamlArgs, StarlarkRunResponseLine, StarlarkScriptPlanYamlArgs, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -78,6 +78,18 @@ export declare const ApiContainerService: {
       readonly O: typeof ExecCommandResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Executes the given command inside a running container, streaming STDIN to it and its output back while it runs
+     * The first message sent must be the one starting the command, and the last message received carries its exit code
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ExecCommandStream
+     */
+    readonly execCommandStream: {
+      readonly name: "ExecCommandStream",
+      readonly I: typeof ExecCommandStreamRequest,
+      readonly O: typeof ExecCommandStreamResponse,
+      readonly kind: MethodKind.BiDiStreaming,
+    },
     /**
      * Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
      *
@@ -144,6 +156,17 @@ export declare const ApiContainerService: {
       readonly O: typeof StoreFilesArtifactFromServiceResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Tells the API container to copy the contents of a files artifact into a running service
+     *
+     * @generated from rpc api_container_api.ApiContainerService.CopyFilesArtifactToService
+     */
+    readonly copyFilesArtifactToService: {
+      readonly name: "CopyFilesArtifactToService",
+      readonly I: typeof CopyFilesArtifactToServiceArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids
      */
@@ -184,6 +207,17 @@ export declare const ApiContainerService: {
       readonly O: typeof GetStarlarkRunResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Streams the progress and the end of every Starlark run executed in the enclave from now on, whoever started it
+     *
+     * @generated from rpc api_container_api.ApiContainerService.WatchStarlarkRunProgress
+     */
+    readonly watchStarlarkRunProgress: {
+      readonly name: "WatchStarlarkRunProgress",
+      readonly I: typeof Empty,
+      readonly O: typeof StarlarkRunResponseLine,
+      readonly kind: MethodKind.ServerStreaming,
+    },
     /**
      * Gets yaml representing the plan the script will execute in an enclave
      *
@@ -206,6 +240,17 @@ export declare const ApiContainerService: {
       readonly O: typeof PlanYaml,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Returns the commit and content hash of every remote package cloned in the enclave, used to generate kurtosis.lock
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetResolvedPackageDependencies
+     */
+    readonly getResolvedPackageDependencies: {
+      readonly name: "GetResolvedPackageDependencies",
+      readonly I: typeof Empty,
+      readonly O: typeof GetResolvedPackageDependenciesResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -200,6 +200,13 @@ export declare class Container extends Message<Container> {
    */
   envVars: { [key: string]: string };
 
+  /**
+   * How many times the container was restarted, or its pod rescheduled by Kubernetes, since the service started
+   *
+   * @generated from field: uint32 restart_count = 6;
+   */
+  restartCount: number;
+
   constructor(data?: PartialMessage<Container>);
 
   static readonly runtime: typeof proto3;
@@ -306,6 +313,22 @@ export declare class ServiceInfo extends Message<ServiceInfo> {
    */
   container?: Container;
 
+  /**
+   * The persistent directories mounted on the service, in mount_path -> persistent_directory
+   *
+   * @generated from field: map<string, api_container_api.PersistentDirectory> persistent_directories = 10;
+   */
+  persistentDirectories: { [key: string]: PersistentDirectory };
+
+  /**
+   * The URLs where the HTTP ports of the service are reachable *outside* the cluster, in user_defined_port_id -> url
+   * NOTE: Will be empty if the service isn't running or the backend doesn't expose the services, which is only done by
+   *  Kubernetes clusters configured with a service exposure
+   *
+   * @generated from field: map<string, string> maybe_public_urls = 11;
+   */
+  maybePublicUrls: { [key: string]: string };
+
   constructor(data?: PartialMessage<ServiceInfo>);
 
   static readonly runtime: typeof proto3;
@@ -321,6 +344,51 @@ export declare class ServiceInfo extends Message<ServiceInfo> {
   static equals(a: ServiceInfo | PlainMessage<ServiceInfo> | undefined, b: ServiceInfo | PlainMessage<ServiceInfo> | undefined): boolean;
 }
 
+/**
+ * @generated from message api_container_api.PersistentDirectory
+ */
+export declare class PersistentDirectory extends Message<PersistentDirectory> {
+  /**
+   * @generated from field: string persistent_key = 1;
+   */
+  persistentKey: string;
+
+  /**
+   * The size requested for the directory, in bytes
+   *
+   * @generated from field: int64 size = 2;
+   */
+  size: bigint;
+
+  /**
+   * The storage class the directory's volume was provisioned with; empty means the backend's default
+   *
+   * @generated from field: string storage_class = 3;
+   */
+  storageClass: string;
+
+  /**
+   * The access mode of the directory's volume, e.g. ReadWriteOnce; empty means the backend's default
+   *
+   * @generated from field: string access_mode = 4;
+   */
+  accessMode: string;
+
+  constructor(data?: PartialMessage<PersistentDirectory>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.PersistentDirectory";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PersistentDirectory;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PersistentDirectory;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PersistentDirectory;
+
+  static equals(a: PersistentDirectory | PlainMessage<PersistentDirectory> | undefined, b: PersistentDirectory | PlainMessage<PersistentDirectory> | undefined): boolean;
+}
+
 /**
  * @generated from message api_container_api.RunStarlarkScriptArgs
  */
@@ -389,6 +457,20 @@ export declare class RunStarlarkScriptArgs extends Message<RunStarlarkScriptArgs
    */
   nonBlockingMode?: boolean;
 
+  /**
+   * configuration of the git hosts the packages imported by the script are cloned from
+   *
+   * @generated from field: repeated api_container_api.GitHostConfig git_host_configs = 11;
+   */
+  gitHostConfigs: GitHostConfig[];
+
+  /**
+   * credentials of the registries the OCI packages imported by the script are pulled from
+   *
+   * @generated from field: repeated api_container_api.RegistryCredentials registry_credentials = 12;
+   */
+  registryCredentials: RegistryCredentials[];
+
   constructor(data?: PartialMessage<RunStarlarkScriptArgs>);
 
   static readonly runtime: typeof proto3;
@@ -526,6 +608,20 @@ export declare class RunStarlarkPackageArgs extends Message<RunStarlarkPackageAr
    */
   githubAuthToken?: string;
 
+  /**
+   * configuration of the git hosts the package and its dependencies are cloned from
+   *
+   * @generated from field: repeated api_container_api.GitHostConfig git_host_configs = 17;
+   */
+  gitHostConfigs: GitHostConfig[];
+
+  /**
+   * credentials of the registries the package and its dependencies are pulled from, when published as OCI artifacts
+   *
+   * @generated from field: repeated api_container_api.RegistryCredentials registry_credentials = 18;
+   */
+  registryCredentials: RegistryCredentials[];
+
   constructor(data?: PartialMessage<RunStarlarkPackageArgs>);
 
   static readonly runtime: typeof proto3;
@@ -541,6 +637,102 @@ export declare class RunStarlarkPackageArgs extends Message<RunStarlarkPackageAr
   static equals(a: RunStarlarkPackageArgs | PlainMessage<RunStarlarkPackageArgs> | undefined, b: RunStarlarkPackageArgs | PlainMessage<RunStarlarkPackageArgs> | undefined): boolean;
 }
 
+/**
+ * How to clone the repositories of a git host, e.g. 'gitlab.com', and the credentials to do it
+ *
+ * @generated from message api_container_api.GitHostConfig
+ */
+export declare class GitHostConfig extends Message<GitHostConfig> {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host: string;
+
+  /**
+   * The URL the '<author>/<repository>' paths of the host are appended to when cloning, e.g. 'ssh://git@gitlab.com' to
+   * clone over SSH or 'file:///path/to/repositories' to clone from local bare repositories. Defaults to 'https://<host>'
+   *
+   * @generated from field: optional string clone_url_prefix = 2;
+   */
+  cloneUrlPrefix?: string;
+
+  /**
+   * HTTPS basic auth credentials; the password is usually an access token
+   *
+   * @generated from field: optional string username = 3;
+   */
+  username?: string;
+
+  /**
+   * @generated from field: optional string password = 4;
+   */
+  password?: string;
+
+  /**
+   * PEM encoded private key used to clone over SSH
+   *
+   * @generated from field: optional string ssh_private_key = 5;
+   */
+  sshPrivateKey?: string;
+
+  /**
+   * known_hosts entries used to verify the host when cloning over SSH
+   *
+   * @generated from field: optional string ssh_known_hosts = 6;
+   */
+  sshKnownHosts?: string;
+
+  constructor(data?: PartialMessage<GitHostConfig>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GitHostConfig";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitHostConfig;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GitHostConfig;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GitHostConfig;
+
+  static equals(a: GitHostConfig | PlainMessage<GitHostConfig> | undefined, b: GitHostConfig | PlainMessage<GitHostConfig> | undefined): boolean;
+}
+
+/**
+ * Credentials of an OCI registry, e.g. 'ghcr.io', as stored by 'docker login'. They're only kept for the run
+ *
+ * @generated from message api_container_api.RegistryCredentials
+ */
+export declare class RegistryCredentials extends Message<RegistryCredentials> {
+  /**
+   * @generated from field: string registry = 1;
+   */
+  registry: string;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string password = 3;
+   */
+  password: string;
+
+  constructor(data?: PartialMessage<RegistryCredentials>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.RegistryCredentials";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegistryCredentials;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegistryCredentials;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegistryCredentials;
+
+  static equals(a: RegistryCredentials | PlainMessage<RegistryCredentials> | undefined, b: RegistryCredentials | PlainMessage<RegistryCredentials> | undefined): boolean;
+}
+
 /**
  * ==============================================================================================
  *                               Starlark Execution Response
@@ -1166,6 +1358,188 @@ export declare class ExecCommandResponse extends Message<ExecCommandResponse> {
   static equals(a: ExecCommandResponse | PlainMessage<ExecCommandResponse> | undefined, b: ExecCommandResponse | PlainMessage<ExecCommandResponse> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                                     Exec Command Stream
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.ExecCommandStreamRequest
+ */
+export declare class ExecCommandStreamRequest extends Message<ExecCommandStreamRequest> {
+  /**
+   * @generated from oneof api_container_api.ExecCommandStreamRequest.request
+   */
+  request: {
+    /**
+     * Starts the command; must be the first message sent, and only sent once
+     *
+     * @generated from field: api_container_api.ExecCommandStreamStart start = 1;
+     */
+    value: ExecCommandStreamStart;
+    case: "start";
+  } | {
+    /**
+     * Bytes to write to the command's STDIN
+     *
+     * @generated from field: bytes stdin = 2;
+     */
+    value: Uint8Array;
+    case: "stdin";
+  } | {
+    /**
+     * Closes the command's STDIN, e.g. so commands reading STDIN until the end can finish
+     *
+     * @generated from field: bool close_stdin = 3;
+     */
+    value: boolean;
+    case: "closeStdin";
+  } | {
+    /**
+     * Resizes the command's TTY; ignored if the command was started without a TTY
+     *
+     * @generated from field: api_container_api.TerminalSize resize = 4;
+     */
+    value: TerminalSize;
+    case: "resize";
+  } | { case: undefined; value?: undefined };
+
+  constructor(data?: PartialMessage<ExecCommandStreamRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ExecCommandStreamRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExecCommandStreamRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExecCommandStreamRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExecCommandStreamRequest;
+
+  static equals(a: ExecCommandStreamRequest | PlainMessage<ExecCommandStreamRequest> | undefined, b: ExecCommandStreamRequest | PlainMessage<ExecCommandStreamRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ExecCommandStreamStart
+ */
+export declare class ExecCommandStreamStart extends Message<ExecCommandStreamStart> {
+  /**
+   * The service identifier of the container that the command should be executed in
+   *
+   * @generated from field: string service_identifier = 1;
+   */
+  serviceIdentifier: string;
+
+  /**
+   * @generated from field: repeated string command_args = 2;
+   */
+  commandArgs: string[];
+
+  /**
+   * Whether to allocate a TTY for the command, in which case STDERR is merged into STDOUT
+   *
+   * @generated from field: bool tty = 3;
+   */
+  tty: boolean;
+
+  /**
+   * The initial size of the TTY
+   *
+   * @generated from field: optional api_container_api.TerminalSize terminal_size = 4;
+   */
+  terminalSize?: TerminalSize;
+
+  constructor(data?: PartialMessage<ExecCommandStreamStart>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ExecCommandStreamStart";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExecCommandStreamStart;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExecCommandStreamStart;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExecCommandStreamStart;
+
+  static equals(a: ExecCommandStreamStart | PlainMessage<ExecCommandStreamStart> | undefined, b: ExecCommandStreamStart | PlainMessage<ExecCommandStreamStart> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.TerminalSize
+ */
+export declare class TerminalSize extends Message<TerminalSize> {
+  /**
+   * Number of columns
+   *
+   * @generated from field: uint32 width = 1;
+   */
+  width: number;
+
+  /**
+   * Number of rows
+   *
+   * @generated from field: uint32 height = 2;
+   */
+  height: number;
+
+  constructor(data?: PartialMessage<TerminalSize>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.TerminalSize";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TerminalSize;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TerminalSize;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TerminalSize;
+
+  static equals(a: TerminalSize | PlainMessage<TerminalSize> | undefined, b: TerminalSize | PlainMessage<TerminalSize> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ExecCommandStreamResponse
+ */
+export declare class ExecCommandStreamResponse extends Message<ExecCommandStreamResponse> {
+  /**
+   * @generated from oneof api_container_api.ExecCommandStreamResponse.response
+   */
+  response: {
+    /**
+     * @generated from field: bytes stdout = 1;
+     */
+    value: Uint8Array;
+    case: "stdout";
+  } | {
+    /**
+     * @generated from field: bytes stderr = 2;
+     */
+    value: Uint8Array;
+    case: "stderr";
+  } | {
+    /**
+     * Sent once the command exits, as the last message of the stream
+     *
+     * @generated from field: int32 exit_code = 3;
+     */
+    value: number;
+    case: "exitCode";
+  } | { case: undefined; value?: undefined };
+
+  constructor(data?: PartialMessage<ExecCommandStreamResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ExecCommandStreamResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExecCommandStreamResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExecCommandStreamResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExecCommandStreamResponse;
+
+  static equals(a: ExecCommandStreamResponse | PlainMessage<ExecCommandStreamResponse> | undefined, b: ExecCommandStreamResponse | PlainMessage<ExecCommandStreamResponse> | undefined): boolean;
+}
+
 /**
  * ==============================================================================================
  *                             Wait For HTTP Get Endpoint Availability
@@ -1583,6 +1957,53 @@ export declare class StoreFilesArtifactFromServiceResponse extends Message<Store
   static equals(a: StoreFilesArtifactFromServiceResponse | PlainMessage<StoreFilesArtifactFromServiceResponse> | undefined, b: StoreFilesArtifactFromServiceResponse | PlainMessage<StoreFilesArtifactFromServiceResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message api_container_api.CopyFilesArtifactToServiceArgs
+ */
+export declare class CopyFilesArtifactToServiceArgs extends Message<CopyFilesArtifactToServiceArgs> {
+  /**
+   * Identifier of the service that the files will be copied into
+   *
+   * @generated from field: string service_identifier = 1;
+   */
+  serviceIdentifier: string;
+
+  /**
+   * Name or UUID of the files artifact whose contents will be copied
+   *
+   * @generated from field: string files_artifact_identifier = 2;
+   */
+  filesArtifactIdentifier: string;
+
+  /**
+   * The absolute path on the service where the files artifact contents will be extracted
+   *
+   * @generated from field: string dest_path = 3;
+   */
+  destPath: string;
+
+  /**
+   * Whether the files artifact is removed from the enclave once copied, e.g. because it was only uploaded for the copy
+   *
+   * @generated from field: bool remove_files_artifact_after_copy = 4;
+   */
+  removeFilesArtifactAfterCopy: boolean;
+
+  constructor(data?: PartialMessage<CopyFilesArtifactToServiceArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.CopyFilesArtifactToServiceArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CopyFilesArtifactToServiceArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CopyFilesArtifactToServiceArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CopyFilesArtifactToServiceArgs;
+
+  static equals(a: CopyFilesArtifactToServiceArgs | PlainMessage<CopyFilesArtifactToServiceArgs> | undefined, b: CopyFilesArtifactToServiceArgs | PlainMessage<CopyFilesArtifactToServiceArgs> | undefined): boolean;
+}
+
 /**
  * @generated from message api_container_api.FilesArtifactNameAndUuid
  */
@@ -1943,3 +2364,74 @@ export declare class StarlarkPackagePlanYamlArgs extends Message<StarlarkPackage
   static equals(a: StarlarkPackagePlanYamlArgs | PlainMessage<StarlarkPackagePlanYamlArgs> | undefined, b: StarlarkPackagePlanYamlArgs | PlainMessage<StarlarkPackagePlanYamlArgs> | undefined): boolean;
 }
 
+/**
+ * @generated from message api_container_api.ResolvedPackageDependency
+ */
+export declare class ResolvedPackageDependency extends Message<ResolvedPackageDependency> {
+  /**
+   * The locator of the repository containing the package, e.g. github.com/kurtosis-tech/postgres-package
+   *
+   * @generated from field: string repository_locator = 1;
+   */
+  repositoryLocator: string;
+
+  /**
+   * The tag, branch or commit requested when the repository was cloned, empty for the default branch
+   *
+   * @generated from field: string version = 2;
+   */
+  version: string;
+
+  /**
+   * The commit that was checked out
+   *
+   * @generated from field: string commit = 3;
+   */
+  commit: string;
+
+  /**
+   * The hash of the content of the repository, ignoring git metadata
+   *
+   * @generated from field: string content_hash = 4;
+   */
+  contentHash: string;
+
+  constructor(data?: PartialMessage<ResolvedPackageDependency>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ResolvedPackageDependency";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolvedPackageDependency;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResolvedPackageDependency;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResolvedPackageDependency;
+
+  static equals(a: ResolvedPackageDependency | PlainMessage<ResolvedPackageDependency> | undefined, b: ResolvedPackageDependency | PlainMessage<ResolvedPackageDependency> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetResolvedPackageDependenciesResponse
+ */
+export declare class GetResolvedPackageDependenciesResponse extends Message<GetResolvedPackageDependenciesResponse> {
+  /**
+   * @generated from field: repeated api_container_api.ResolvedPackageDependency resolved_package_dependencies = 1;
+   */
+  resolvedPackageDependencies: ResolvedPackageDependency[];
+
+  constructor(data?: PartialMessage<GetResolvedPackageDependenciesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetResolvedPackageDependenciesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetResolvedPackageDependenciesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetResolvedPackageDependenciesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetResolvedPackageDependenciesResponse;
+
+  static equals(a: GetResolvedPackageDependenciesResponse | PlainMessage<GetResolvedPackageDependenciesResponse> | undefined, b: GetResolvedPackageDependenciesResponse | PlainMessage<GetResolvedPackageDependenciesResponse> | undefined): boolean;
+}
+
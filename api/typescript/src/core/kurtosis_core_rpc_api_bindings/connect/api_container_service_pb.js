@@ -104,6 +104,7 @@ export const Container = proto3.makeMessageType(
     { no: 3, name: "entrypoint_args", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 4, name: "cmd_args", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 5, name: "env_vars", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 6, name: "restart_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ],
 );
 
@@ -134,6 +135,21 @@ export const ServiceInfo = proto3.makeMessageType(
     { no: 7, name: "shortened_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 8, name: "service_status", kind: "enum", T: proto3.getEnumType(ServiceStatus) },
     { no: 9, name: "container", kind: "message", T: Container },
+    { no: 10, name: "persistent_directories", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: PersistentDirectory} },
+    { no: 11, name: "maybe_public_urls", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.PersistentDirectory
+ */
+export const PersistentDirectory = proto3.makeMessageType(
+  "api_container_api.PersistentDirectory",
+  () => [
+    { no: 1, name: "persistent_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "storage_class", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "access_mode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -153,6 +169,8 @@ export const RunStarlarkScriptArgs = proto3.makeMessageType(
     { no: 8, name: "cloud_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 9, name: "image_download_mode", kind: "enum", T: proto3.getEnumType(ImageDownloadMode), opt: true },
     { no: 10, name: "non_blocking_mode", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 11, name: "git_host_configs", kind: "message", T: GitHostConfig, repeated: true },
+    { no: 12, name: "registry_credentials", kind: "message", T: RegistryCredentials, repeated: true },
   ],
 );
 
@@ -177,6 +195,39 @@ export const RunStarlarkPackageArgs = proto3.makeMessageType(
     { no: 14, name: "image_download_mode", kind: "enum", T: proto3.getEnumType(ImageDownloadMode), opt: true },
     { no: 15, name: "non_blocking_mode", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
     { no: 16, name: "github_auth_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 17, name: "git_host_configs", kind: "message", T: GitHostConfig, repeated: true },
+    { no: 18, name: "registry_credentials", kind: "message", T: RegistryCredentials, repeated: true },
+  ],
+);
+
+/**
+ * How to clone the repositories of a git host, e.g. 'gitlab.com', and the credentials to do it
+ *
+ * @generated from message api_container_api.GitHostConfig
+ */
+export const GitHostConfig = proto3.makeMessageType(
+  "api_container_api.GitHostConfig",
+  () => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "clone_url_prefix", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "ssh_private_key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "ssh_known_hosts", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ],
+);
+
+/**
+ * Credentials of an OCI registry, e.g. 'ghcr.io', as stored by 'docker login'. They're only kept for the run
+ *
+ * @generated from message api_container_api.RegistryCredentials
+ */
+export const RegistryCredentials = proto3.makeMessageType(
+  "api_container_api.RegistryCredentials",
+  () => [
+    { no: 1, name: "registry", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -408,6 +459,59 @@ export const ExecCommandResponse = proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                     Exec Command Stream
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.ExecCommandStreamRequest
+ */
+export const ExecCommandStreamRequest = proto3.makeMessageType(
+  "api_container_api.ExecCommandStreamRequest",
+  () => [
+    { no: 1, name: "start", kind: "message", T: ExecCommandStreamStart, oneof: "request" },
+    { no: 2, name: "stdin", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "request" },
+    { no: 3, name: "close_stdin", kind: "scalar", T: 8 /* ScalarType.BOOL */, oneof: "request" },
+    { no: 4, name: "resize", kind: "message", T: TerminalSize, oneof: "request" },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ExecCommandStreamStart
+ */
+export const ExecCommandStreamStart = proto3.makeMessageType(
+  "api_container_api.ExecCommandStreamStart",
+  () => [
+    { no: 1, name: "service_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "command_args", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "tty", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "terminal_size", kind: "message", T: TerminalSize, opt: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.TerminalSize
+ */
+export const TerminalSize = proto3.makeMessageType(
+  "api_container_api.TerminalSize",
+  () => [
+    { no: 1, name: "width", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "height", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ExecCommandStreamResponse
+ */
+export const ExecCommandStreamResponse = proto3.makeMessageType(
+  "api_container_api.ExecCommandStreamResponse",
+  () => [
+    { no: 1, name: "stdout", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "response" },
+    { no: 2, name: "stderr", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "response" },
+    { no: 3, name: "exit_code", kind: "scalar", T: 5 /* ScalarType.INT32 */, oneof: "response" },
+  ],
+);
+
 /**
  * ==============================================================================================
  *                             Wait For HTTP Get Endpoint Availability
@@ -551,6 +655,19 @@ export const StoreFilesArtifactFromServiceResponse = proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message api_container_api.CopyFilesArtifactToServiceArgs
+ */
+export const CopyFilesArtifactToServiceArgs = proto3.makeMessageType(
+  "api_container_api.CopyFilesArtifactToServiceArgs",
+  () => [
+    { no: 1, name: "service_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "files_artifact_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "dest_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "remove_files_artifact_after_copy", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
 /**
  * @generated from message api_container_api.FilesArtifactNameAndUuid
  */
@@ -675,3 +792,26 @@ export const StarlarkPackagePlanYamlArgs = proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message api_container_api.ResolvedPackageDependency
+ */
+export const ResolvedPackageDependency = proto3.makeMessageType(
+  "api_container_api.ResolvedPackageDependency",
+  () => [
+    { no: 1, name: "repository_locator", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "commit", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "content_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetResolvedPackageDependenciesResponse
+ */
+export const GetResolvedPackageDependenciesResponse = proto3.makeMessageType(
+  "api_container_api.GetResolvedPackageDependenciesResponse",
+  () => [
+    { no: 1, name: "resolved_package_dependencies", kind: "message", T: ResolvedPackageDependency, repeated: true },
+  ],
+);
+
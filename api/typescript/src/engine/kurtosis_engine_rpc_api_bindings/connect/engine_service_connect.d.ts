@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, StopEnclaveArgs } from "./engine_service_pb.js";
+import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, ExtendEnclaveArgs, ExtendEnclaveResponse, GetEnclaveApiContainerTokenArgs, GetEnclaveApiContainerTokenResponse, GetEnclavesArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, StopEnclaveArgs } from "./engine_service_pb.js";
 
 /**
  * @generated from service engine_api.EngineService
@@ -44,7 +44,7 @@ export declare const EngineService: {
      */
     readonly getEnclaves: {
       readonly name: "GetEnclaves",
-      readonly I: typeof Empty,
+      readonly I: typeof GetEnclavesArgs,
       readonly O: typeof GetEnclavesResponse,
       readonly kind: MethodKind.Unary,
     },
@@ -81,6 +81,17 @@ export declare const EngineService: {
       readonly O: typeof Empty,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Pushes out the expiration time of an enclave that was created with a TTL
+     *
+     * @generated from rpc engine_api.EngineService.ExtendEnclave
+     */
+    readonly extendEnclave: {
+      readonly name: "ExtendEnclave",
+      readonly I: typeof ExtendEnclaveArgs,
+      readonly O: typeof ExtendEnclaveResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * Gets rid of old enclaves
      *
@@ -103,6 +114,17 @@ export declare const EngineService: {
       readonly O: typeof GetServiceLogsResponse,
       readonly kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Returns the token the caller authenticates to the API container of an enclave with, when the engine requires authentication
+     *
+     * @generated from rpc engine_api.EngineService.GetEnclaveApiContainerToken
+     */
+    readonly getEnclaveApiContainerToken: {
+      readonly name: "GetEnclaveApiContainerToken",
+      readonly I: typeof GetEnclaveApiContainerTokenArgs,
+      readonly O: typeof GetEnclaveApiContainerTokenResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, StopEnclaveArgs } from "./engine_service_pb.js";
+import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, ExtendEnclaveArgs, ExtendEnclaveResponse, GetEnclaveApiContainerTokenArgs, GetEnclaveApiContainerTokenResponse, GetEnclavesArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, StopEnclaveArgs } from "./engine_service_pb.js";
 
 /**
  * @generated from service engine_api.EngineService
@@ -44,7 +44,7 @@ export const EngineService = {
      */
     getEnclaves: {
       name: "GetEnclaves",
-      I: Empty,
+      I: GetEnclavesArgs,
       O: GetEnclavesResponse,
       kind: MethodKind.Unary,
     },
@@ -81,6 +81,17 @@ export const EngineService = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * Pushes out the expiration time of an enclave that was created with a TTL
+     *
+     * @generated from rpc engine_api.EngineService.ExtendEnclave
+     */
+    extendEnclave: {
+      name: "ExtendEnclave",
+      I: ExtendEnclaveArgs,
+      O: ExtendEnclaveResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Gets rid of old enclaves
      *
@@ -103,6 +114,17 @@ export const EngineService = {
       O: GetServiceLogsResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Returns the token the caller authenticates to the API container of an enclave with, when the engine requires authentication
+     *
+     * @generated from rpc engine_api.EngineService.GetEnclaveApiContainerToken
+     */
+    getEnclaveApiContainerToken: {
+      name: "GetEnclaveApiContainerToken",
+      I: GetEnclaveApiContainerTokenArgs,
+      O: GetEnclaveApiContainerTokenResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -3,9 +3,26 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
+import type { BinaryReadOptions, Duration, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.EnclaveExpiryAction
+ */
+export declare enum EnclaveExpiryAction {
+  /**
+   * @generated from enum value: EnclaveExpiryAction_DESTROY = 0;
+   */
+  EnclaveExpiryAction_DESTROY = 0,
+
+  /**
+   * @generated from enum value: EnclaveExpiryAction_STOP = 1;
+   */
+  EnclaveExpiryAction_STOP = 1,
+}
+
 /**
  * @generated from enum engine_api.EnclaveMode
  */
@@ -185,6 +202,34 @@ export declare class CreateEnclaveArgs extends Message<CreateEnclaveArgs> {
    */
   shouldApicRunInDebugMode?: boolean;
 
+  /**
+   * Arbitrary key-value pairs attached to the enclave, which can be used to filter enclaves
+   *
+   * @generated from field: map<string, string> labels = 6;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * If set, the enclave will expire after this duration and the engine will apply the expiry action to it
+   *
+   * @generated from field: optional google.protobuf.Duration ttl = 7;
+   */
+  ttl?: Duration;
+
+  /**
+   * What to do with the enclave once it expires; only meaningful if a TTL is set. Defaults to destroying it
+   *
+   * @generated from field: optional engine_api.EnclaveExpiryAction expiry_action = 8;
+   */
+  expiryAction?: EnclaveExpiryAction;
+
+  /**
+   * If set, caps the resources the enclave services can claim. Limits left to zero are taken from the engine default quota
+   *
+   * @generated from field: optional engine_api.EnclaveResourceQuota resource_quota = 9;
+   */
+  resourceQuota?: EnclaveResourceQuota;
+
   constructor(data?: PartialMessage<CreateEnclaveArgs>);
 
   static readonly runtime: typeof proto3;
@@ -200,6 +245,55 @@ export declare class CreateEnclaveArgs extends Message<CreateEnclaveArgs> {
   static equals(a: CreateEnclaveArgs | PlainMessage<CreateEnclaveArgs> | undefined, b: CreateEnclaveArgs | PlainMessage<CreateEnclaveArgs> | undefined): boolean;
 }
 
+/**
+ * A zero limit means that the resource isn't capped
+ *
+ * @generated from message engine_api.EnclaveResourceQuota
+ */
+export declare class EnclaveResourceQuota extends Message<EnclaveResourceQuota> {
+  /**
+   * The sum of the min_cpu of all the services, in millicores
+   *
+   * @generated from field: uint64 max_cpu_millicores = 1;
+   */
+  maxCpuMillicores: bigint;
+
+  /**
+   * The sum of the min_memory of all the services, in megabytes
+   *
+   * @generated from field: uint64 max_memory_megabytes = 2;
+   */
+  maxMemoryMegabytes: bigint;
+
+  /**
+   * The number of services in the enclave
+   *
+   * @generated from field: uint32 max_services = 3;
+   */
+  maxServices: number;
+
+  /**
+   * The sum of the sizes of all the persistent directories, in megabytes
+   *
+   * @generated from field: uint64 max_persistent_storage_megabytes = 4;
+   */
+  maxPersistentStorageMegabytes: bigint;
+
+  constructor(data?: PartialMessage<EnclaveResourceQuota>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.EnclaveResourceQuota";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclaveResourceQuota;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclaveResourceQuota;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclaveResourceQuota;
+
+  static equals(a: EnclaveResourceQuota | PlainMessage<EnclaveResourceQuota> | undefined, b: EnclaveResourceQuota | PlainMessage<EnclaveResourceQuota> | undefined): boolean;
+}
+
 /**
  * @generated from message engine_api.CreateEnclaveResponse
  */
@@ -375,6 +469,42 @@ export declare class EnclaveInfo extends Message<EnclaveInfo> {
    */
   mode: EnclaveMode;
 
+  /**
+   * The labels attached to the enclave when it was created
+   *
+   * @generated from field: map<string, string> labels = 10;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * When the enclave expires; not present if the enclave never expires
+   *
+   * @generated from field: google.protobuf.Timestamp expiration_time = 11;
+   */
+  expirationTime?: Timestamp;
+
+  /**
+   * What happens to the enclave once it expires; only meaningful if the expiration time is present
+   *
+   * @generated from field: engine_api.EnclaveExpiryAction expiry_action = 12;
+   */
+  expiryAction: EnclaveExpiryAction;
+
+  /**
+   * The resource quota of the enclave; not present if the enclave has no quota
+   *
+   * @generated from field: engine_api.EnclaveResourceQuota resource_quota = 13;
+   */
+  resourceQuota?: EnclaveResourceQuota;
+
+  /**
+   * Identifier of the principal that created the enclave, prefixed by how it authenticated (e.g. 'token:alice' or
+   * 'certificate:alice'); empty if the engine wasn't requiring authentication when it was created
+   *
+   * @generated from field: string owner = 14;
+   */
+  owner: string;
+
   constructor(data?: PartialMessage<EnclaveInfo>);
 
   static readonly runtime: typeof proto3;
@@ -390,6 +520,32 @@ export declare class EnclaveInfo extends Message<EnclaveInfo> {
   static equals(a: EnclaveInfo | PlainMessage<EnclaveInfo> | undefined, b: EnclaveInfo | PlainMessage<EnclaveInfo> | undefined): boolean;
 }
 
+/**
+ * @generated from message engine_api.GetEnclavesArgs
+ */
+export declare class GetEnclavesArgs extends Message<GetEnclavesArgs> {
+  /**
+   * If set, only the enclaves having all these labels will be returned
+   *
+   * @generated from field: map<string, string> labels = 1;
+   */
+  labels: { [key: string]: string };
+
+  constructor(data?: PartialMessage<GetEnclavesArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetEnclavesArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclavesArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclavesArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclavesArgs;
+
+  static equals(a: GetEnclavesArgs | PlainMessage<GetEnclavesArgs> | undefined, b: GetEnclavesArgs | PlainMessage<GetEnclavesArgs> | undefined): boolean;
+}
+
 /**
  * @generated from message engine_api.GetEnclavesResponse
  */
@@ -542,6 +698,126 @@ export declare class DestroyEnclaveArgs extends Message<DestroyEnclaveArgs> {
   static equals(a: DestroyEnclaveArgs | PlainMessage<DestroyEnclaveArgs> | undefined, b: DestroyEnclaveArgs | PlainMessage<DestroyEnclaveArgs> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                                  Get Enclave API Container Token
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetEnclaveApiContainerTokenArgs
+ */
+export declare class GetEnclaveApiContainerTokenArgs extends Message<GetEnclaveApiContainerTokenArgs> {
+  /**
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave whose API container will be called
+   *
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  constructor(data?: PartialMessage<GetEnclaveApiContainerTokenArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetEnclaveApiContainerTokenArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclaveApiContainerTokenArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclaveApiContainerTokenArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclaveApiContainerTokenArgs;
+
+  static equals(a: GetEnclaveApiContainerTokenArgs | PlainMessage<GetEnclaveApiContainerTokenArgs> | undefined, b: GetEnclaveApiContainerTokenArgs | PlainMessage<GetEnclaveApiContainerTokenArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.GetEnclaveApiContainerTokenResponse
+ */
+export declare class GetEnclaveApiContainerTokenResponse extends Message<GetEnclaveApiContainerTokenResponse> {
+  /**
+   * Bearer token for the API container of the enclave; the caller's own token, or a credential issued by the engine
+   * when the caller authenticated with a client certificate
+   *
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  constructor(data?: PartialMessage<GetEnclaveApiContainerTokenResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetEnclaveApiContainerTokenResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclaveApiContainerTokenResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclaveApiContainerTokenResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclaveApiContainerTokenResponse;
+
+  static equals(a: GetEnclaveApiContainerTokenResponse | PlainMessage<GetEnclaveApiContainerTokenResponse> | undefined, b: GetEnclaveApiContainerTokenResponse | PlainMessage<GetEnclaveApiContainerTokenResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                       Extend Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.ExtendEnclaveArgs
+ */
+export declare class ExtendEnclaveArgs extends Message<ExtendEnclaveArgs> {
+  /**
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to extend
+   *
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  /**
+   * How much time to add to the enclave's TTL, counting from now if the enclave has already expired
+   *
+   * @generated from field: google.protobuf.Duration extension = 2;
+   */
+  extension?: Duration;
+
+  constructor(data?: PartialMessage<ExtendEnclaveArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ExtendEnclaveArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExtendEnclaveArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExtendEnclaveArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExtendEnclaveArgs;
+
+  static equals(a: ExtendEnclaveArgs | PlainMessage<ExtendEnclaveArgs> | undefined, b: ExtendEnclaveArgs | PlainMessage<ExtendEnclaveArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.ExtendEnclaveResponse
+ */
+export declare class ExtendEnclaveResponse extends Message<ExtendEnclaveResponse> {
+  /**
+   * The new expiration time of the enclave
+   *
+   * @generated from field: google.protobuf.Timestamp expiration_time = 1;
+   */
+  expirationTime?: Timestamp;
+
+  constructor(data?: PartialMessage<ExtendEnclaveResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ExtendEnclaveResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExtendEnclaveResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExtendEnclaveResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExtendEnclaveResponse;
+
+  static equals(a: ExtendEnclaveResponse | PlainMessage<ExtendEnclaveResponse> | undefined, b: ExtendEnclaveResponse | PlainMessage<ExtendEnclaveResponse> | undefined): boolean;
+}
+
 /**
  * ==============================================================================================
  *                                       Create Enclave
@@ -3,7 +3,20 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { proto3, Timestamp } from "@bufbuild/protobuf";
+import { Duration, proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.EnclaveExpiryAction
+ */
+export const EnclaveExpiryAction = proto3.makeEnum(
+  "engine_api.EnclaveExpiryAction",
+  [
+    {no: 0, name: "EnclaveExpiryAction_DESTROY"},
+    {no: 1, name: "EnclaveExpiryAction_STOP"},
+  ],
+);
 
 /**
  * @generated from enum engine_api.EnclaveMode
@@ -93,6 +106,25 @@ export const CreateEnclaveArgs = proto3.makeMessageType(
     { no: 3, name: "api_container_log_level", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "mode", kind: "enum", T: proto3.getEnumType(EnclaveMode), opt: true },
     { no: 5, name: "should_apic_run_in_debug_mode", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 6, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 7, name: "ttl", kind: "message", T: Duration, opt: true },
+    { no: 8, name: "expiry_action", kind: "enum", T: proto3.getEnumType(EnclaveExpiryAction), opt: true },
+    { no: 9, name: "resource_quota", kind: "message", T: EnclaveResourceQuota, opt: true },
+  ],
+);
+
+/**
+ * A zero limit means that the resource isn't capped
+ *
+ * @generated from message engine_api.EnclaveResourceQuota
+ */
+export const EnclaveResourceQuota = proto3.makeMessageType(
+  "engine_api.EnclaveResourceQuota",
+  () => [
+    { no: 1, name: "max_cpu_millicores", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "max_memory_megabytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "max_services", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "max_persistent_storage_megabytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
   ],
 );
 
@@ -149,6 +181,21 @@ export const EnclaveInfo = proto3.makeMessageType(
     { no: 7, name: "api_container_host_machine_info", kind: "message", T: EnclaveAPIContainerHostMachineInfo },
     { no: 8, name: "creation_time", kind: "message", T: Timestamp },
     { no: 9, name: "mode", kind: "enum", T: proto3.getEnumType(EnclaveMode) },
+    { no: 10, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 11, name: "expiration_time", kind: "message", T: Timestamp },
+    { no: 12, name: "expiry_action", kind: "enum", T: proto3.getEnumType(EnclaveExpiryAction) },
+    { no: 13, name: "resource_quota", kind: "message", T: EnclaveResourceQuota },
+    { no: 14, name: "owner", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.GetEnclavesArgs
+ */
+export const GetEnclavesArgs = proto3.makeMessageType(
+  "engine_api.GetEnclavesArgs",
+  () => [
+    { no: 1, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ],
 );
 
@@ -214,6 +261,55 @@ export const DestroyEnclaveArgs = proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                  Get Enclave API Container Token
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetEnclaveApiContainerTokenArgs
+ */
+export const GetEnclaveApiContainerTokenArgs = proto3.makeMessageType(
+  "engine_api.GetEnclaveApiContainerTokenArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.GetEnclaveApiContainerTokenResponse
+ */
+export const GetEnclaveApiContainerTokenResponse = proto3.makeMessageType(
+  "engine_api.GetEnclaveApiContainerTokenResponse",
+  () => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                       Extend Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.ExtendEnclaveArgs
+ */
+export const ExtendEnclaveArgs = proto3.makeMessageType(
+  "engine_api.ExtendEnclaveArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "extension", kind: "message", T: Duration },
+  ],
+);
+
+/**
+ * @generated from message engine_api.ExtendEnclaveResponse
+ */
+export const ExtendEnclaveResponse = proto3.makeMessageType(
+  "engine_api.ExtendEnclaveResponse",
+  () => [
+    { no: 1, name: "expiration_time", kind: "message", T: Timestamp },
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                       Create Enclave
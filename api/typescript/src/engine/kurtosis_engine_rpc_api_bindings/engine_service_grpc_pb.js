@@ -3,6 +3,7 @@
 'use strict';
 var grpc = require('@grpc/grpc-js');
 var engine_service_pb = require('./engine_service_pb.js');
+var google_protobuf_duration_pb = require('google-protobuf/google/protobuf/duration_pb.js');
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 
@@ -61,6 +62,61 @@ function deserialize_engine_api_DestroyEnclaveArgs(buffer_arg) {
   return engine_service_pb.DestroyEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_ExtendEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.ExtendEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.ExtendEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ExtendEnclaveArgs(buffer_arg) {
+  return engine_service_pb.ExtendEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_ExtendEnclaveResponse(arg) {
+  if (!(arg instanceof engine_service_pb.ExtendEnclaveResponse)) {
+    throw new Error('Expected argument of type engine_api.ExtendEnclaveResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ExtendEnclaveResponse(buffer_arg) {
+  return engine_service_pb.ExtendEnclaveResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetEnclaveApiContainerTokenArgs(arg) {
+  if (!(arg instanceof engine_service_pb.GetEnclaveApiContainerTokenArgs)) {
+    throw new Error('Expected argument of type engine_api.GetEnclaveApiContainerTokenArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetEnclaveApiContainerTokenArgs(buffer_arg) {
+  return engine_service_pb.GetEnclaveApiContainerTokenArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetEnclaveApiContainerTokenResponse(arg) {
+  if (!(arg instanceof engine_service_pb.GetEnclaveApiContainerTokenResponse)) {
+    throw new Error('Expected argument of type engine_api.GetEnclaveApiContainerTokenResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetEnclaveApiContainerTokenResponse(buffer_arg) {
+  return engine_service_pb.GetEnclaveApiContainerTokenResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetEnclavesArgs(arg) {
+  if (!(arg instanceof engine_service_pb.GetEnclavesArgs)) {
+    throw new Error('Expected argument of type engine_api.GetEnclavesArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetEnclavesArgs(buffer_arg) {
+  return engine_service_pb.GetEnclavesArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_GetEnclavesResponse(arg) {
   if (!(arg instanceof engine_service_pb.GetEnclavesResponse)) {
     throw new Error('Expected argument of type engine_api.GetEnclavesResponse');
@@ -172,10 +228,10 @@ getEnclaves: {
     path: '/engine_api.EngineService/GetEnclaves',
     requestStream: false,
     responseStream: false,
-    requestType: google_protobuf_empty_pb.Empty,
+    requestType: engine_service_pb.GetEnclavesArgs,
     responseType: engine_service_pb.GetEnclavesResponse,
-    requestSerialize: serialize_google_protobuf_Empty,
-    requestDeserialize: deserialize_google_protobuf_Empty,
+    requestSerialize: serialize_engine_api_GetEnclavesArgs,
+    requestDeserialize: deserialize_engine_api_GetEnclavesArgs,
     responseSerialize: serialize_engine_api_GetEnclavesResponse,
     responseDeserialize: deserialize_engine_api_GetEnclavesResponse,
   },
@@ -215,6 +271,18 @@ destroyEnclave: {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // Pushes out the expiration time of an enclave that was created with a TTL
+extendEnclave: {
+    path: '/engine_api.EngineService/ExtendEnclave',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.ExtendEnclaveArgs,
+    responseType: engine_service_pb.ExtendEnclaveResponse,
+    requestSerialize: serialize_engine_api_ExtendEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_ExtendEnclaveArgs,
+    responseSerialize: serialize_engine_api_ExtendEnclaveResponse,
+    responseDeserialize: deserialize_engine_api_ExtendEnclaveResponse,
+  },
   // Gets rid of old enclaves
 clean: {
     path: '/engine_api.EngineService/Clean',
@@ -239,6 +307,18 @@ getServiceLogs: {
     responseSerialize: serialize_engine_api_GetServiceLogsResponse,
     responseDeserialize: deserialize_engine_api_GetServiceLogsResponse,
   },
+  // Returns the token the caller authenticates to the API container of an enclave with, when the engine requires authentication
+getEnclaveApiContainerToken: {
+    path: '/engine_api.EngineService/GetEnclaveApiContainerToken',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.GetEnclaveApiContainerTokenArgs,
+    responseType: engine_service_pb.GetEnclaveApiContainerTokenResponse,
+    requestSerialize: serialize_engine_api_GetEnclaveApiContainerTokenArgs,
+    requestDeserialize: deserialize_engine_api_GetEnclaveApiContainerTokenArgs,
+    responseSerialize: serialize_engine_api_GetEnclaveApiContainerTokenResponse,
+    responseDeserialize: deserialize_engine_api_GetEnclaveApiContainerTokenResponse,
+  },
 };
 
 exports.EngineServiceClient = grpc.makeGenericClientConstructor(EngineServiceService);
@@ -24,7 +24,7 @@ export class EngineServiceClient {
   ): grpcWeb.ClientReadableStream<engine_service_pb.CreateEnclaveResponse>;
 
   getEnclaves(
-    request: google_protobuf_empty_pb.Empty,
+    request: engine_service_pb.GetEnclavesArgs,
     metadata: grpcWeb.Metadata | undefined,
     callback: (err: grpcWeb.RpcError,
                response: engine_service_pb.GetEnclavesResponse) => void
@@ -51,6 +51,13 @@ export class EngineServiceClient {
                response: google_protobuf_empty_pb.Empty) => void
   ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
 
+  extendEnclave(
+    request: engine_service_pb.ExtendEnclaveArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.ExtendEnclaveResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.ExtendEnclaveResponse>;
+
   clean(
     request: engine_service_pb.CleanArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -63,6 +70,13 @@ export class EngineServiceClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  getEnclaveApiContainerToken(
+    request: engine_service_pb.GetEnclaveApiContainerTokenArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.GetEnclaveApiContainerTokenResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.GetEnclaveApiContainerTokenResponse>;
+
 }
 
 export class EngineServicePromiseClient {
@@ -81,7 +95,7 @@ export class EngineServicePromiseClient {
   ): Promise<engine_service_pb.CreateEnclaveResponse>;
 
   getEnclaves(
-    request: google_protobuf_empty_pb.Empty,
+    request: engine_service_pb.GetEnclavesArgs,
     metadata?: grpcWeb.Metadata
   ): Promise<engine_service_pb.GetEnclavesResponse>;
 
@@ -100,6 +114,11 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<google_protobuf_empty_pb.Empty>;
 
+  extendEnclave(
+    request: engine_service_pb.ExtendEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.ExtendEnclaveResponse>;
+
   clean(
     request: engine_service_pb.CleanArgs,
     metadata?: grpcWeb.Metadata
@@ -110,5 +129,10 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  getEnclaveApiContainerToken(
+    request: engine_service_pb.GetEnclaveApiContainerTokenArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.GetEnclaveApiContainerTokenResponse>;
+
 }
 
@@ -20,6 +20,8 @@ const grpc = {};
 grpc.web = require('grpc-web');
 
 
+var google_protobuf_duration_pb = require('google-protobuf/google/protobuf/duration_pb.js')
+
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js')
 
 var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js')
@@ -203,16 +205,16 @@ proto.engine_api.EngineServicePromiseClient.prototype.createEnclave =
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.google.protobuf.Empty,
+ *   !proto.engine_api.GetEnclavesArgs,
  *   !proto.engine_api.GetEnclavesResponse>}
  */
 const methodDescriptor_EngineService_GetEnclaves = new grpc.web.MethodDescriptor(
   '/engine_api.EngineService/GetEnclaves',
   grpc.web.MethodType.UNARY,
-  google_protobuf_empty_pb.Empty,
+  proto.engine_api.GetEnclavesArgs,
   proto.engine_api.GetEnclavesResponse,
   /**
-   * @param {!proto.google.protobuf.Empty} request
+   * @param {!proto.engine_api.GetEnclavesArgs} request
    * @return {!Uint8Array}
    */
   function(request) {
@@ -223,7 +225,7 @@ const methodDescriptor_EngineService_GetEnclaves = new grpc.web.MethodDescriptor
 
 
 /**
- * @param {!proto.google.protobuf.Empty} request The
+ * @param {!proto.engine_api.GetEnclavesArgs} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
@@ -244,7 +246,7 @@ proto.engine_api.EngineServiceClient.prototype.getEnclaves =
 
 
 /**
- * @param {!proto.google.protobuf.Empty} request The
+ * @param {!proto.engine_api.GetEnclavesArgs} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
@@ -444,6 +446,67 @@ proto.engine_api.EngineServicePromiseClient.prototype.destroyEnclave =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.ExtendEnclaveArgs,
+ *   !proto.engine_api.ExtendEnclaveResponse>}
+ */
+const methodDescriptor_EngineService_ExtendEnclave = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/ExtendEnclave',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.ExtendEnclaveArgs,
+  proto.engine_api.ExtendEnclaveResponse,
+  /**
+   * @param {!proto.engine_api.ExtendEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.ExtendEnclaveResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.ExtendEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.ExtendEnclaveResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.ExtendEnclaveResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.extendEnclave =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/ExtendEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ExtendEnclave,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.ExtendEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.ExtendEnclaveResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.extendEnclave =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/ExtendEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ExtendEnclave);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -561,5 +624,66 @@ proto.engine_api.EngineServicePromiseClient.prototype.getServiceLogs =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.GetEnclaveApiContainerTokenArgs,
+ *   !proto.engine_api.GetEnclaveApiContainerTokenResponse>}
+ */
+const methodDescriptor_EngineService_GetEnclaveApiContainerToken = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/GetEnclaveApiContainerToken',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.GetEnclaveApiContainerTokenArgs,
+  proto.engine_api.GetEnclaveApiContainerTokenResponse,
+  /**
+   * @param {!proto.engine_api.GetEnclaveApiContainerTokenArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.GetEnclaveApiContainerTokenResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.GetEnclaveApiContainerTokenArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.GetEnclaveApiContainerTokenResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.GetEnclaveApiContainerTokenResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.getEnclaveApiContainerToken =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/GetEnclaveApiContainerToken',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetEnclaveApiContainerToken,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.GetEnclaveApiContainerTokenArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.GetEnclaveApiContainerTokenResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.getEnclaveApiContainerToken =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/GetEnclaveApiContainerToken',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetEnclaveApiContainerToken);
+};
+
+
 module.exports = proto.engine_api;
 
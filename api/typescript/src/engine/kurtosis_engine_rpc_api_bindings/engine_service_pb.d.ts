@@ -1,5 +1,6 @@
 import * as jspb from 'google-protobuf'
 
+import * as google_protobuf_duration_pb from 'google-protobuf/google/protobuf/duration_pb';
 import * as google_protobuf_empty_pb from 'google-protobuf/google/protobuf/empty_pb';
 import * as google_protobuf_timestamp_pb from 'google-protobuf/google/protobuf/timestamp_pb';
 
@@ -48,6 +49,24 @@ export class CreateEnclaveArgs extends jspb.Message {
   hasShouldApicRunInDebugMode(): boolean;
   clearShouldApicRunInDebugMode(): CreateEnclaveArgs;
 
+  getLabelsMap(): jspb.Map<string, string>;
+  clearLabelsMap(): CreateEnclaveArgs;
+
+  getTtl(): google_protobuf_duration_pb.Duration | undefined;
+  setTtl(value?: google_protobuf_duration_pb.Duration): CreateEnclaveArgs;
+  hasTtl(): boolean;
+  clearTtl(): CreateEnclaveArgs;
+
+  getExpiryAction(): EnclaveExpiryAction;
+  setExpiryAction(value: EnclaveExpiryAction): CreateEnclaveArgs;
+  hasExpiryAction(): boolean;
+  clearExpiryAction(): CreateEnclaveArgs;
+
+  getResourceQuota(): EnclaveResourceQuota | undefined;
+  setResourceQuota(value?: EnclaveResourceQuota): CreateEnclaveArgs;
+  hasResourceQuota(): boolean;
+  clearResourceQuota(): CreateEnclaveArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): CreateEnclaveArgs.AsObject;
   static toObject(includeInstance: boolean, msg: CreateEnclaveArgs): CreateEnclaveArgs.AsObject;
@@ -63,6 +82,10 @@ export namespace CreateEnclaveArgs {
     apiContainerLogLevel?: string,
     mode?: EnclaveMode,
     shouldApicRunInDebugMode?: boolean,
+    labelsMap: Array<[string, string]>,
+    ttl?: google_protobuf_duration_pb.Duration.AsObject,
+    expiryAction?: EnclaveExpiryAction,
+    resourceQuota?: EnclaveResourceQuota.AsObject,
   }
 
   export enum EnclaveNameCase { 
@@ -89,6 +112,51 @@ export namespace CreateEnclaveArgs {
     _SHOULD_APIC_RUN_IN_DEBUG_MODE_NOT_SET = 0,
     SHOULD_APIC_RUN_IN_DEBUG_MODE = 5,
   }
+
+  export enum TtlCase { 
+    _TTL_NOT_SET = 0,
+    TTL = 7,
+  }
+
+  export enum ExpiryActionCase { 
+    _EXPIRY_ACTION_NOT_SET = 0,
+    EXPIRY_ACTION = 8,
+  }
+
+  export enum ResourceQuotaCase { 
+    _RESOURCE_QUOTA_NOT_SET = 0,
+    RESOURCE_QUOTA = 9,
+  }
+}
+
+export class EnclaveResourceQuota extends jspb.Message {
+  getMaxCpuMillicores(): number;
+  setMaxCpuMillicores(value: number): EnclaveResourceQuota;
+
+  getMaxMemoryMegabytes(): number;
+  setMaxMemoryMegabytes(value: number): EnclaveResourceQuota;
+
+  getMaxServices(): number;
+  setMaxServices(value: number): EnclaveResourceQuota;
+
+  getMaxPersistentStorageMegabytes(): number;
+  setMaxPersistentStorageMegabytes(value: number): EnclaveResourceQuota;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveResourceQuota.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveResourceQuota): EnclaveResourceQuota.AsObject;
+  static serializeBinaryToWriter(message: EnclaveResourceQuota, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveResourceQuota;
+  static deserializeBinaryFromReader(message: EnclaveResourceQuota, reader: jspb.BinaryReader): EnclaveResourceQuota;
+}
+
+export namespace EnclaveResourceQuota {
+  export type AsObject = {
+    maxCpuMillicores: number,
+    maxMemoryMegabytes: number,
+    maxServices: number,
+    maxPersistentStorageMegabytes: number,
+  }
 }
 
 export class CreateEnclaveResponse extends jspb.Message {
@@ -197,6 +265,25 @@ export class EnclaveInfo extends jspb.Message {
   getMode(): EnclaveMode;
   setMode(value: EnclaveMode): EnclaveInfo;
 
+  getLabelsMap(): jspb.Map<string, string>;
+  clearLabelsMap(): EnclaveInfo;
+
+  getExpirationTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setExpirationTime(value?: google_protobuf_timestamp_pb.Timestamp): EnclaveInfo;
+  hasExpirationTime(): boolean;
+  clearExpirationTime(): EnclaveInfo;
+
+  getExpiryAction(): EnclaveExpiryAction;
+  setExpiryAction(value: EnclaveExpiryAction): EnclaveInfo;
+
+  getResourceQuota(): EnclaveResourceQuota | undefined;
+  setResourceQuota(value?: EnclaveResourceQuota): EnclaveInfo;
+  hasResourceQuota(): boolean;
+  clearResourceQuota(): EnclaveInfo;
+
+  getOwner(): string;
+  setOwner(value: string): EnclaveInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): EnclaveInfo.AsObject;
   static toObject(includeInstance: boolean, msg: EnclaveInfo): EnclaveInfo.AsObject;
@@ -216,6 +303,29 @@ export namespace EnclaveInfo {
     apiContainerHostMachineInfo?: EnclaveAPIContainerHostMachineInfo.AsObject,
     creationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     mode: EnclaveMode,
+    labelsMap: Array<[string, string]>,
+    expirationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    expiryAction: EnclaveExpiryAction,
+    resourceQuota?: EnclaveResourceQuota.AsObject,
+    owner: string,
+  }
+}
+
+export class GetEnclavesArgs extends jspb.Message {
+  getLabelsMap(): jspb.Map<string, string>;
+  clearLabelsMap(): GetEnclavesArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclavesArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclavesArgs): GetEnclavesArgs.AsObject;
+  static serializeBinaryToWriter(message: GetEnclavesArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclavesArgs;
+  static deserializeBinaryFromReader(message: GetEnclavesArgs, reader: jspb.BinaryReader): GetEnclavesArgs;
+}
+
+export namespace GetEnclavesArgs {
+  export type AsObject = {
+    labelsMap: Array<[string, string]>,
   }
 }
 
@@ -319,6 +429,86 @@ export namespace DestroyEnclaveArgs {
   }
 }
 
+export class GetEnclaveApiContainerTokenArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): GetEnclaveApiContainerTokenArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclaveApiContainerTokenArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclaveApiContainerTokenArgs): GetEnclaveApiContainerTokenArgs.AsObject;
+  static serializeBinaryToWriter(message: GetEnclaveApiContainerTokenArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclaveApiContainerTokenArgs;
+  static deserializeBinaryFromReader(message: GetEnclaveApiContainerTokenArgs, reader: jspb.BinaryReader): GetEnclaveApiContainerTokenArgs;
+}
+
+export namespace GetEnclaveApiContainerTokenArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+  }
+}
+
+export class GetEnclaveApiContainerTokenResponse extends jspb.Message {
+  getToken(): string;
+  setToken(value: string): GetEnclaveApiContainerTokenResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclaveApiContainerTokenResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclaveApiContainerTokenResponse): GetEnclaveApiContainerTokenResponse.AsObject;
+  static serializeBinaryToWriter(message: GetEnclaveApiContainerTokenResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclaveApiContainerTokenResponse;
+  static deserializeBinaryFromReader(message: GetEnclaveApiContainerTokenResponse, reader: jspb.BinaryReader): GetEnclaveApiContainerTokenResponse;
+}
+
+export namespace GetEnclaveApiContainerTokenResponse {
+  export type AsObject = {
+    token: string,
+  }
+}
+
+export class ExtendEnclaveArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): ExtendEnclaveArgs;
+
+  getExtension(): google_protobuf_duration_pb.Duration | undefined;
+  setExtension(value?: google_protobuf_duration_pb.Duration): ExtendEnclaveArgs;
+  hasExtension(): boolean;
+  clearExtension(): ExtendEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExtendEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: ExtendEnclaveArgs): ExtendEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: ExtendEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExtendEnclaveArgs;
+  static deserializeBinaryFromReader(message: ExtendEnclaveArgs, reader: jspb.BinaryReader): ExtendEnclaveArgs;
+}
+
+export namespace ExtendEnclaveArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+    extension?: google_protobuf_duration_pb.Duration.AsObject,
+  }
+}
+
+export class ExtendEnclaveResponse extends jspb.Message {
+  getExpirationTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setExpirationTime(value?: google_protobuf_timestamp_pb.Timestamp): ExtendEnclaveResponse;
+  hasExpirationTime(): boolean;
+  clearExpirationTime(): ExtendEnclaveResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExtendEnclaveResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ExtendEnclaveResponse): ExtendEnclaveResponse.AsObject;
+  static serializeBinaryToWriter(message: ExtendEnclaveResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExtendEnclaveResponse;
+  static deserializeBinaryFromReader(message: ExtendEnclaveResponse, reader: jspb.BinaryReader): ExtendEnclaveResponse;
+}
+
+export namespace ExtendEnclaveResponse {
+  export type AsObject = {
+    expirationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
 export class CleanArgs extends jspb.Message {
   getShouldCleanAll(): boolean;
   setShouldCleanAll(value: boolean): CleanArgs;
@@ -517,6 +707,10 @@ export namespace LogLineFilter {
   }
 }
 
+export enum EnclaveExpiryAction { 
+  ENCLAVEEXPIRYACTION_DESTROY = 0,
+  ENCLAVEEXPIRYACTION_STOP = 1,
+}
 export enum EnclaveMode { 
   TEST = 0,
   PRODUCTION = 1,
@@ -21,6 +21,8 @@ var global = (function() {
   return Function('return this')();
 }.call(null));
 
+var google_protobuf_duration_pb = require('google-protobuf/google/protobuf/duration_pb.js');
+goog.object.extend(proto, google_protobuf_duration_pb);
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 goog.object.extend(proto, google_protobuf_empty_pb);
 var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
@@ -34,10 +36,17 @@ goog.exportSymbol('proto.engine_api.EnclaveAPIContainerHostMachineInfo', null, g
 goog.exportSymbol('proto.engine_api.EnclaveAPIContainerInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveAPIContainerStatus', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveContainersStatus', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveExpiryAction', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveIdentifiers', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveMode', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveNameAndUuid', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveResourceQuota', null, global);
+goog.exportSymbol('proto.engine_api.ExtendEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.ExtendEnclaveResponse', null, global);
+goog.exportSymbol('proto.engine_api.GetEnclaveApiContainerTokenArgs', null, global);
+goog.exportSymbol('proto.engine_api.GetEnclaveApiContainerTokenResponse', null, global);
+goog.exportSymbol('proto.engine_api.GetEnclavesArgs', null, global);
 goog.exportSymbol('proto.engine_api.GetEnclavesResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetEngineInfoResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse', null, global);
@@ -89,6 +98,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.CreateEnclaveArgs.displayName = 'proto.engine_api.CreateEnclaveArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.EnclaveResourceQuota = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.EnclaveResourceQuota, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EnclaveResourceQuota.displayName = 'proto.engine_api.EnclaveResourceQuota';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -173,6 +203,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.EnclaveInfo.displayName = 'proto.engine_api.EnclaveInfo';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetEnclavesArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetEnclavesArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetEnclavesArgs.displayName = 'proto.engine_api.GetEnclavesArgs';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -278,6 +329,90 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.DestroyEnclaveArgs.displayName = 'proto.engine_api.DestroyEnclaveArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetEnclaveApiContainerTokenArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetEnclaveApiContainerTokenArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetEnclaveApiContainerTokenArgs.displayName = 'proto.engine_api.GetEnclaveApiContainerTokenArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetEnclaveApiContainerTokenResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetEnclaveApiContainerTokenResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetEnclaveApiContainerTokenResponse.displayName = 'proto.engine_api.GetEnclaveApiContainerTokenResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ExtendEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.ExtendEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ExtendEnclaveArgs.displayName = 'proto.engine_api.ExtendEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ExtendEnclaveResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.ExtendEnclaveResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ExtendEnclaveResponse.displayName = 'proto.engine_api.ExtendEnclaveResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -591,7 +726,11 @@ proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
     apiContainerVersionTag: jspb.Message.getFieldWithDefault(msg, 2, ""),
     apiContainerLogLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
     mode: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    shouldApicRunInDebugMode: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+    shouldApicRunInDebugMode: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
+    ttl: (f = msg.getTtl()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
+    expiryAction: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    resourceQuota: (f = msg.getResourceQuota()) && proto.engine_api.EnclaveResourceQuota.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -648,6 +787,26 @@ proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, r
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setShouldApicRunInDebugMode(value);
       break;
+    case 6:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 7:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setTtl(value);
+      break;
+    case 8:
+      var value = /** @type {!proto.engine_api.EnclaveExpiryAction} */ (reader.readEnum());
+      msg.setExpiryAction(value);
+      break;
+    case 9:
+      var value = new proto.engine_api.EnclaveResourceQuota;
+      reader.readMessage(value,proto.engine_api.EnclaveResourceQuota.deserializeBinaryFromReader);
+      msg.setResourceQuota(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -712,6 +871,33 @@ proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter = function(message, w
       f
     );
   }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getTtl();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {!proto.engine_api.EnclaveExpiryAction} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = message.getResourceQuota();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.engine_api.EnclaveResourceQuota.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -895,6 +1081,138 @@ proto.engine_api.CreateEnclaveArgs.prototype.hasShouldApicRunInDebugMode = funct
 };
 
 
+/**
+ * map<string, string> labels = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;};
+
+
+/**
+ * optional google.protobuf.Duration ttl = 7;
+ * @return {?proto.google.protobuf.Duration}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getTtl = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 7));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+*/
+proto.engine_api.CreateEnclaveArgs.prototype.setTtl = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearTtl = function() {
+  return this.setTtl(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasTtl = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional EnclaveExpiryAction expiry_action = 8;
+ * @return {!proto.engine_api.EnclaveExpiryAction}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getExpiryAction = function() {
+  return /** @type {!proto.engine_api.EnclaveExpiryAction} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveExpiryAction} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setExpiryAction = function(value) {
+  return jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearExpiryAction = function() {
+  return jspb.Message.setField(this, 8, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasExpiryAction = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional EnclaveResourceQuota resource_quota = 9;
+ * @return {?proto.engine_api.EnclaveResourceQuota}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getResourceQuota = function() {
+  return /** @type{?proto.engine_api.EnclaveResourceQuota} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveResourceQuota, 9));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveResourceQuota|undefined} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+*/
+proto.engine_api.CreateEnclaveArgs.prototype.setResourceQuota = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearResourceQuota = function() {
+  return this.setResourceQuota(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasResourceQuota = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
 
 
 
@@ -911,8 +1229,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
+proto.engine_api.EnclaveResourceQuota.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveResourceQuota.toObject(opt_includeInstance, this);
 };
 
 
@@ -921,13 +1239,16 @@ proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CreateEnclaveResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.EnclaveResourceQuota} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.EnclaveResourceQuota.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
+    maxCpuMillicores: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    maxMemoryMegabytes: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    maxServices: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    maxPersistentStorageMegabytes: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -941,23 +1262,23 @@ proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CreateEnclaveResponse}
+ * @return {!proto.engine_api.EnclaveResourceQuota}
  */
-proto.engine_api.CreateEnclaveResponse.deserializeBinary = function(bytes) {
+proto.engine_api.EnclaveResourceQuota.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CreateEnclaveResponse;
-  return proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.EnclaveResourceQuota;
+  return proto.engine_api.EnclaveResourceQuota.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CreateEnclaveResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.EnclaveResourceQuota} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CreateEnclaveResponse}
+ * @return {!proto.engine_api.EnclaveResourceQuota}
  */
-proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.EnclaveResourceQuota.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -965,9 +1286,20 @@ proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveInfo;
-      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
-      msg.setEnclaveInfo(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMaxCpuMillicores(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMaxMemoryMegabytes(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxServices(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMaxPersistentStorageMegabytes(value);
       break;
     default:
       reader.skipField();
@@ -982,9 +1314,9 @@ proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
+proto.engine_api.EnclaveResourceQuota.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.EnclaveResourceQuota.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -992,7 +1324,213 @@ proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CreateEnclaveResponse} message
+ * @param {!proto.engine_api.EnclaveResourceQuota} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveResourceQuota.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMaxCpuMillicores();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getMaxMemoryMegabytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getMaxServices();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getMaxPersistentStorageMegabytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 max_cpu_millicores = 1;
+ * @return {number}
+ */
+proto.engine_api.EnclaveResourceQuota.prototype.getMaxCpuMillicores = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveResourceQuota} returns this
+ */
+proto.engine_api.EnclaveResourceQuota.prototype.setMaxCpuMillicores = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 max_memory_megabytes = 2;
+ * @return {number}
+ */
+proto.engine_api.EnclaveResourceQuota.prototype.getMaxMemoryMegabytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveResourceQuota} returns this
+ */
+proto.engine_api.EnclaveResourceQuota.prototype.setMaxMemoryMegabytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 max_services = 3;
+ * @return {number}
+ */
+proto.engine_api.EnclaveResourceQuota.prototype.getMaxServices = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveResourceQuota} returns this
+ */
+proto.engine_api.EnclaveResourceQuota.prototype.setMaxServices = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 max_persistent_storage_megabytes = 4;
+ * @return {number}
+ */
+proto.engine_api.EnclaveResourceQuota.prototype.getMaxPersistentStorageMegabytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveResourceQuota} returns this
+ */
+proto.engine_api.EnclaveResourceQuota.prototype.setMaxPersistentStorageMegabytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CreateEnclaveResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CreateEnclaveResponse}
+ */
+proto.engine_api.CreateEnclaveResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CreateEnclaveResponse;
+  return proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CreateEnclaveResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CreateEnclaveResponse}
+ */
+proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
+      msg.setEnclaveInfo(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CreateEnclaveResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
@@ -1466,7 +2004,12 @@ proto.engine_api.EnclaveInfo.toObject = function(includeInstance, msg) {
     apiContainerInfo: (f = msg.getApiContainerInfo()) && proto.engine_api.EnclaveAPIContainerInfo.toObject(includeInstance, f),
     apiContainerHostMachineInfo: (f = msg.getApiContainerHostMachineInfo()) && proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(includeInstance, f),
     creationTime: (f = msg.getCreationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    mode: jspb.Message.getFieldWithDefault(msg, 9, 0)
+    mode: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
+    expirationTime: (f = msg.getExpirationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    expiryAction: jspb.Message.getFieldWithDefault(msg, 12, 0),
+    resourceQuota: (f = msg.getResourceQuota()) && proto.engine_api.EnclaveResourceQuota.toObject(includeInstance, f),
+    owner: jspb.Message.getFieldWithDefault(msg, 14, "")
   };
 
   if (includeInstance) {
@@ -1542,6 +2085,30 @@ proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader)
       var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
       msg.setMode(value);
       break;
+    case 10:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 11:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpirationTime(value);
+      break;
+    case 12:
+      var value = /** @type {!proto.engine_api.EnclaveExpiryAction} */ (reader.readEnum());
+      msg.setExpiryAction(value);
+      break;
+    case 13:
+      var value = new proto.engine_api.EnclaveResourceQuota;
+      reader.readMessage(value,proto.engine_api.EnclaveResourceQuota.deserializeBinaryFromReader);
+      msg.setResourceQuota(value);
+      break;
+    case 14:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1637,6 +2204,40 @@ proto.engine_api.EnclaveInfo.serializeBinaryToWriter = function(message, writer)
       f
     );
   }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(10, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getExpirationTime();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getExpiryAction();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      12,
+      f
+    );
+  }
+  f = message.getResourceQuota();
+  if (f != null) {
+    writer.writeMessage(
+      13,
+      f,
+      proto.engine_api.EnclaveResourceQuota.serializeBinaryToWriter
+    );
+  }
+  f = message.getOwner();
+  if (f.length > 0) {
+    writer.writeString(
+      14,
+      f
+    );
+  }
 };
 
 
@@ -1859,54 +2460,319 @@ proto.engine_api.EnclaveInfo.prototype.setMode = function(value) {
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * map<string, string> labels = 10;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.engine_api.GetEnclavesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetEnclavesResponse.toObject(opt_includeInstance, this);
+proto.engine_api.EnclaveInfo.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 10, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetEnclavesResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
  */
-proto.engine_api.GetEnclavesResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    enclaveInfoMap: (f = msg.getEnclaveInfoMap()) ? f.toObject(includeInstance, proto.engine_api.EnclaveInfo.toObject) : []
-  };
+proto.engine_api.EnclaveInfo.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * optional google.protobuf.Timestamp expiration_time = 11;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.EnclaveInfo.prototype.getExpirationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 11));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetEnclavesResponse}
- */
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setExpirationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 11, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearExpirationTime = function() {
+  return this.setExpirationTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasExpirationTime = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+/**
+ * optional EnclaveExpiryAction expiry_action = 12;
+ * @return {!proto.engine_api.EnclaveExpiryAction}
+ */
+proto.engine_api.EnclaveInfo.prototype.getExpiryAction = function() {
+  return /** @type {!proto.engine_api.EnclaveExpiryAction} */ (jspb.Message.getFieldWithDefault(this, 12, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveExpiryAction} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setExpiryAction = function(value) {
+  return jspb.Message.setProto3EnumField(this, 12, value);
+};
+
+
+/**
+ * optional EnclaveResourceQuota resource_quota = 13;
+ * @return {?proto.engine_api.EnclaveResourceQuota}
+ */
+proto.engine_api.EnclaveInfo.prototype.getResourceQuota = function() {
+  return /** @type{?proto.engine_api.EnclaveResourceQuota} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveResourceQuota, 13));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveResourceQuota|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setResourceQuota = function(value) {
+  return jspb.Message.setWrapperField(this, 13, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearResourceQuota = function() {
+  return this.setResourceQuota(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasResourceQuota = function() {
+  return jspb.Message.getField(this, 13) != null;
+};
+
+
+/**
+ * optional string owner = 14;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 14, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setOwner = function(value) {
+  return jspb.Message.setProto3StringField(this, 14, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetEnclavesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclavesArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetEnclavesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetEnclavesArgs}
+ */
+proto.engine_api.GetEnclavesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetEnclavesArgs;
+  return proto.engine_api.GetEnclavesArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetEnclavesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetEnclavesArgs}
+ */
+proto.engine_api.GetEnclavesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetEnclavesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetEnclavesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetEnclavesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * map<string, string> labels = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.GetEnclavesArgs.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetEnclavesArgs} returns this
+ */
+proto.engine_api.GetEnclavesArgs.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetEnclavesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclavesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetEnclavesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveInfoMap: (f = msg.getEnclaveInfoMap()) ? f.toObject(includeInstance, proto.engine_api.EnclaveInfo.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetEnclavesResponse}
+ */
 proto.engine_api.GetEnclavesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
   var msg = new proto.engine_api.GetEnclavesResponse;
@@ -2040,23 +2906,631 @@ proto.engine_api.EnclaveIdentifiers.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveIdentifiers}
+ * @return {!proto.engine_api.EnclaveIdentifiers}
+ */
+proto.engine_api.EnclaveIdentifiers.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveIdentifiers;
+  return proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveIdentifiers} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveIdentifiers}
+ */
+proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveIdentifiers} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_uuid = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.getEnclaveUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.setEnclaveUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string shortened_uuid = 3;
+ * @return {string}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
+    proto.engine_api.EnclaveIdentifiers.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse;
+  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveIdentifiers;
+      reader.readMessage(value,proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader);
+      msg.addAllidentifiers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAllidentifiersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated EnclaveIdentifiers allIdentifiers = 1;
+ * @return {!Array<!proto.engine_api.EnclaveIdentifiers>}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.getAllidentifiersList = function() {
+  return /** @type{!Array<!proto.engine_api.EnclaveIdentifiers>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveIdentifiers, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.EnclaveIdentifiers>} value
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
+*/
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveIdentifiers=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveIdentifiers}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveIdentifiers, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.clearAllidentifiersList = function() {
+  return this.setAllidentifiersList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.StopEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.StopEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.StopEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.StopEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.StopEnclaveArgs}
+ */
+proto.engine_api.StopEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.StopEnclaveArgs;
+  return proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.StopEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.StopEnclaveArgs}
+ */
+proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.StopEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.StopEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.StopEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.StopEnclaveArgs} returns this
+ */
+proto.engine_api.StopEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.DestroyEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.DestroyEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.DestroyEnclaveArgs}
+ */
+proto.engine_api.DestroyEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.DestroyEnclaveArgs;
+  return proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.DestroyEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.DestroyEnclaveArgs}
+ */
+proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.DestroyEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.DestroyEnclaveArgs} returns this
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetEnclaveApiContainerTokenArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclaveApiContainerTokenArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetEnclaveApiContainerTokenArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclaveApiContainerTokenArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetEnclaveApiContainerTokenArgs}
  */
-proto.engine_api.EnclaveIdentifiers.deserializeBinary = function(bytes) {
+proto.engine_api.GetEnclaveApiContainerTokenArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveIdentifiers;
-  return proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetEnclaveApiContainerTokenArgs;
+  return proto.engine_api.GetEnclaveApiContainerTokenArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveIdentifiers} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetEnclaveApiContainerTokenArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveIdentifiers}
+ * @return {!proto.engine_api.GetEnclaveApiContainerTokenArgs}
  */
-proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetEnclaveApiContainerTokenArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2065,15 +3539,7 @@ proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveUuid(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
+      msg.setEnclaveIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -2088,9 +3554,9 @@ proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.serializeBinary = function() {
+proto.engine_api.GetEnclaveApiContainerTokenArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetEnclaveApiContainerTokenArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2098,97 +3564,40 @@ proto.engine_api.EnclaveIdentifiers.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveIdentifiers} message
+ * @param {!proto.engine_api.GetEnclaveApiContainerTokenArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetEnclaveApiContainerTokenArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveUuid();
+  f = message.getEnclaveIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getShortenedUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string enclave_uuid = 1;
+ * optional string enclave_identifier = 1;
  * @return {string}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.getEnclaveUuid = function() {
+proto.engine_api.GetEnclaveApiContainerTokenArgs.prototype.getEnclaveIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ * @return {!proto.engine_api.GetEnclaveApiContainerTokenArgs} returns this
  */
-proto.engine_api.EnclaveIdentifiers.prototype.setEnclaveUuid = function(value) {
+proto.engine_api.GetEnclaveApiContainerTokenArgs.prototype.setEnclaveIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string name = 2;
- * @return {string}
- */
-proto.engine_api.EnclaveIdentifiers.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveIdentifiers} returns this
- */
-proto.engine_api.EnclaveIdentifiers.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional string shortened_uuid = 3;
- * @return {string}
- */
-proto.engine_api.EnclaveIdentifiers.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveIdentifiers} returns this
- */
-proto.engine_api.EnclaveIdentifiers.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.repeatedFields_ = [1];
 
 
 
@@ -2205,8 +3614,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject(opt_includeInstance, this);
+proto.engine_api.GetEnclaveApiContainerTokenResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclaveApiContainerTokenResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2215,14 +3624,13 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.to
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetEnclaveApiContainerTokenResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.GetEnclaveApiContainerTokenResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
-    proto.engine_api.EnclaveIdentifiers.toObject, includeInstance)
+    token: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2236,23 +3644,23 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject = f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ * @return {!proto.engine_api.GetEnclaveApiContainerTokenResponse}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinary = function(bytes) {
+proto.engine_api.GetEnclaveApiContainerTokenResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse;
-  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetEnclaveApiContainerTokenResponse;
+  return proto.engine_api.GetEnclaveApiContainerTokenResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetEnclaveApiContainerTokenResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ * @return {!proto.engine_api.GetEnclaveApiContainerTokenResponse}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetEnclaveApiContainerTokenResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2260,9 +3668,8 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeB
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveIdentifiers;
-      reader.readMessage(value,proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader);
-      msg.addAllidentifiers(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToken(value);
       break;
     default:
       reader.skipField();
@@ -2277,9 +3684,9 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeB
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.serializeBinary = function() {
+proto.engine_api.GetEnclaveApiContainerTokenResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetEnclaveApiContainerTokenResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2287,58 +3694,37 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.se
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} message
+ * @param {!proto.engine_api.GetEnclaveApiContainerTokenResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetEnclaveApiContainerTokenResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAllidentifiersList();
+  f = message.getToken();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated EnclaveIdentifiers allIdentifiers = 1;
- * @return {!Array<!proto.engine_api.EnclaveIdentifiers>}
- */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.getAllidentifiersList = function() {
-  return /** @type{!Array<!proto.engine_api.EnclaveIdentifiers>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveIdentifiers, 1));
-};
-
-
-/**
- * @param {!Array<!proto.engine_api.EnclaveIdentifiers>} value
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
-*/
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.engine_api.EnclaveIdentifiers=} opt_value
- * @param {number=} opt_index
- * @return {!proto.engine_api.EnclaveIdentifiers}
+ * optional string token = 1;
+ * @return {string}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveIdentifiers, opt_index);
+proto.engine_api.GetEnclaveApiContainerTokenResponse.prototype.getToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.GetEnclaveApiContainerTokenResponse} returns this
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.clearAllidentifiersList = function() {
-  return this.setAllidentifiersList([]);
+proto.engine_api.GetEnclaveApiContainerTokenResponse.prototype.setToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -2358,8 +3744,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.StopEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.StopEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.ExtendEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ExtendEnclaveArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2368,13 +3754,14 @@ proto.engine_api.StopEnclaveArgs.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.StopEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.ExtendEnclaveArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.StopEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.ExtendEnclaveArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    extension: (f = msg.getExtension$()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2388,23 +3775,23 @@ proto.engine_api.StopEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.StopEnclaveArgs}
+ * @return {!proto.engine_api.ExtendEnclaveArgs}
  */
-proto.engine_api.StopEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.ExtendEnclaveArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.StopEnclaveArgs;
-  return proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.ExtendEnclaveArgs;
+  return proto.engine_api.ExtendEnclaveArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.StopEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.ExtendEnclaveArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.StopEnclaveArgs}
+ * @return {!proto.engine_api.ExtendEnclaveArgs}
  */
-proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.ExtendEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2415,6 +3802,11 @@ proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, rea
       var value = /** @type {string} */ (reader.readString());
       msg.setEnclaveIdentifier(value);
       break;
+    case 2:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setExtension$(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2428,9 +3820,9 @@ proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.StopEnclaveArgs.prototype.serializeBinary = function() {
+proto.engine_api.ExtendEnclaveArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.ExtendEnclaveArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2438,11 +3830,11 @@ proto.engine_api.StopEnclaveArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.StopEnclaveArgs} message
+ * @param {!proto.engine_api.ExtendEnclaveArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.ExtendEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getEnclaveIdentifier();
   if (f.length > 0) {
@@ -2451,6 +3843,14 @@ proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter = function(message, wri
       f
     );
   }
+  f = message.getExtension$();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -2458,20 +3858,57 @@ proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter = function(message, wri
  * optional string enclave_identifier = 1;
  * @return {string}
  */
-proto.engine_api.StopEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+proto.engine_api.ExtendEnclaveArgs.prototype.getEnclaveIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.StopEnclaveArgs} returns this
+ * @return {!proto.engine_api.ExtendEnclaveArgs} returns this
  */
-proto.engine_api.StopEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+proto.engine_api.ExtendEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional google.protobuf.Duration extension = 2;
+ * @return {?proto.google.protobuf.Duration}
+ */
+proto.engine_api.ExtendEnclaveArgs.prototype.getExtension$ = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.engine_api.ExtendEnclaveArgs} returns this
+*/
+proto.engine_api.ExtendEnclaveArgs.prototype.setExtension$ = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.ExtendEnclaveArgs} returns this
+ */
+proto.engine_api.ExtendEnclaveArgs.prototype.clearExtension$ = function() {
+  return this.setExtension$(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.ExtendEnclaveArgs.prototype.hasExtension$ = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
 
 
 
@@ -2488,8 +3925,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.DestroyEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.ExtendEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ExtendEnclaveResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2498,13 +3935,13 @@ proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.DestroyEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.ExtendEnclaveResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.ExtendEnclaveResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    expirationTime: (f = msg.getExpirationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2518,23 +3955,23 @@ proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.DestroyEnclaveArgs}
+ * @return {!proto.engine_api.ExtendEnclaveResponse}
  */
-proto.engine_api.DestroyEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.ExtendEnclaveResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.DestroyEnclaveArgs;
-  return proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.ExtendEnclaveResponse;
+  return proto.engine_api.ExtendEnclaveResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.DestroyEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.ExtendEnclaveResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.DestroyEnclaveArgs}
+ * @return {!proto.engine_api.ExtendEnclaveResponse}
  */
-proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.ExtendEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2542,8 +3979,9 @@ proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveIdentifier(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpirationTime(value);
       break;
     default:
       reader.skipField();
@@ -2558,9 +3996,9 @@ proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
+proto.engine_api.ExtendEnclaveResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.ExtendEnclaveResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2568,37 +4006,57 @@ proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.DestroyEnclaveArgs} message
+ * @param {!proto.engine_api.ExtendEnclaveResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.ExtendEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getExpirationTime();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string enclave_identifier = 1;
- * @return {string}
+ * optional google.protobuf.Timestamp expiration_time = 1;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.getEnclaveIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.ExtendEnclaveResponse.prototype.getExpirationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.DestroyEnclaveArgs} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.ExtendEnclaveResponse} returns this
+*/
+proto.engine_api.ExtendEnclaveResponse.prototype.setExpirationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.ExtendEnclaveResponse} returns this
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.engine_api.ExtendEnclaveResponse.prototype.clearExpirationTime = function() {
+  return this.setExpirationTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.ExtendEnclaveResponse.prototype.hasExpirationTime = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -3970,6 +5428,14 @@ proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.engine_api.EnclaveExpiryAction = {
+  ENCLAVEEXPIRYACTION_DESTROY: 0,
+  ENCLAVEEXPIRYACTION_STOP: 1
+};
+
 /**
  * @enum {number}
  */
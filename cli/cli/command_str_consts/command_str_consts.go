@@ -25,6 +25,7 @@ const (
 	ContextLsCmdStr         = "ls"
 	ContextRmCmdStr         = "rm"
 	ContextSetCmdStr        = "set"
+	ContextSetAuthCmdStr    = "set-engine-auth"
 	DiscordCmdStr           = "discord"
 	DocsCmdStr              = "docs"
 	EnclaveCmdStr           = "enclave"
@@ -35,6 +36,8 @@ const (
 	EnclaveRmCmdStr         = "rm"
 	EnclaveDumpCmdStr       = "dump"
 	EnclaveConnectCmdStr    = "connect"
+	EnclaveForwardCmdStr    = "forward"
+	EnclaveExtendCmdStr     = "extend"
 	EngineCmdStr            = "engine"
 	EngineLogsCmdStr        = "logs"
 	EngineStartCmdStr       = "start"
@@ -57,6 +60,7 @@ const (
 	PortalStopCmdStr        = "stop"
 	ServiceCmdStr           = "service"
 	ServiceAddCmdStr        = "add"
+	ServiceCpCmdStr         = "cp"
 	ServiceExecCmdStr       = "exec"
 	ServiceLogsCmdStr       = "logs"
 	ServiceRmCmdStr         = "rm"
@@ -73,6 +77,8 @@ const (
 	GatewayCmdStr           = "gateway"
 	PackageCmdStr           = "package"
 	InitCmdStr              = "init"
+	PackagePushCmdStr       = "push"
+	PackageLockCmdStr       = "lock"
 	PortCmdStr              = "port"
 	PortPrintCmdStr         = "print"
 	WebCmdStr               = "web"
@@ -10,6 +10,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_labels"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_resource_quota"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/logrus_log_levels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
@@ -18,7 +20,9 @@ import (
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"strings"
+	"time"
 )
 
 const (
@@ -26,6 +30,16 @@ const (
 	apiContainerLogLevelFlagKey  = "api-container-log-level"
 	enclaveNameFlagKey           = "name"
 	enclaveProductionModeFlagKey = "production"
+	enclaveLabelsFlagKey         = "labels"
+	enclaveTtlFlagKey            = "ttl"
+	enclaveResourceQuotaFlagKey  = "resource-quota"
+	enclaveOnExpiryFlagKey       = "on-expiry"
+
+	// Signifies that the enclave should never expire
+	noEnclaveTtlKeyword = ""
+
+	onExpiryDestroyKeyword = "destroy"
+	onExpiryStopKeyword    = "stop"
 
 	// Signifies that an enclave name should be auto-generated
 	autogenerateEnclaveNameKeyword = ""
@@ -77,6 +91,53 @@ var EnclaveAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCo
 			Type:      flags.FlagType_Bool,
 			Default:   "false",
 		},
+		{
+			Key: enclaveLabelsFlagKey,
+			Usage: fmt.Sprintf(
+				"Labels to attach to the enclave, in the form \"KEY1%vVALUE1%vKEY2%vVALUE2\"; they can be used to filter enclaves with 'enclave ls'",
+				enclave_labels.LabelKeyValueDelimiter,
+				enclave_labels.LabelDeclarationsDelimiter,
+				enclave_labels.LabelKeyValueDelimiter,
+			),
+			Type:    flags.FlagType_String,
+			Default: "",
+		},
+		{
+			Key:     enclaveTtlFlagKey,
+			Usage:   "How long the enclave should live before the engine acts on it (e.g. '30m', '2h'); emptystring means the enclave never expires",
+			Type:    flags.FlagType_String,
+			Default: noEnclaveTtlKeyword,
+		},
+		{
+			Key: enclaveOnExpiryFlagKey,
+			Usage: fmt.Sprintf(
+				"What the engine should do with the enclave once its TTL elapses (%v|%v); only used if '--%v' is set",
+				onExpiryDestroyKeyword,
+				onExpiryStopKeyword,
+				enclaveTtlFlagKey,
+			),
+			Type:    flags.FlagType_String,
+			Default: onExpiryDestroyKeyword,
+		},
+		{
+			Key: enclaveResourceQuotaFlagKey,
+			Usage: fmt.Sprintf(
+				"Caps the resources the enclave services can claim, in the form \"%v%v2000%v%v%v4096%v%v%v10%v%v%v10240\" where cpu is the sum of the services min_cpu in millicores, memory the sum of their min_memory in megabytes and storage the sum of the persistent directory sizes in megabytes. Plans that exceed the quota fail at validation. The resources left out take their limit from the engine default quota",
+				enclave_resource_quota.CpuQuotaKey,
+				enclave_resource_quota.QuotaKeyValueDelimiter,
+				enclave_resource_quota.QuotaDeclarationsDelimiter,
+				enclave_resource_quota.MemoryQuotaKey,
+				enclave_resource_quota.QuotaKeyValueDelimiter,
+				enclave_resource_quota.QuotaDeclarationsDelimiter,
+				enclave_resource_quota.ServicesQuotaKey,
+				enclave_resource_quota.QuotaKeyValueDelimiter,
+				enclave_resource_quota.QuotaDeclarationsDelimiter,
+				enclave_resource_quota.StorageQuotaKey,
+				enclave_resource_quota.QuotaKeyValueDelimiter,
+			),
+			Type:    flags.FlagType_String,
+			Default: "",
+		},
 	},
 }
 
@@ -115,13 +176,23 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred while getting the enclave name using flag with key '%v'; this is a bug in Kurtosis ", enclaveNameFlagKey)
 	}
 
+	enclaveLabels, enclaveTtl, expiryAction, err := getEnclaveLabelsAndExpiration(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the enclave labels and expiration flags")
+	}
+
+	enclaveResourceQuota, err := getEnclaveResourceQuota(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the enclave resource quota flag")
+	}
+
 	dontRestartAPIContainers := false
 	engineManager, err := engine_manager.NewEngineManager(ctx)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating an engine manager.")
 	}
 
-	engineClient, closeClientFunc, err := engineManager.StartEngineIdempotentlyWithDefaultVersion(ctx, defaults.DefaultEngineLogLevel, defaults.DefaultEngineEnclavePoolSize, defaults.DefaultGitHubAuthTokenOverride, dontRestartAPIContainers, defaults.DefaultDomain, defaults.DefaultLogRetentionPeriod)
+	engineClient, closeClientFunc, err := engineManager.StartEngineIdempotentlyWithDefaultVersion(ctx, defaults.DefaultEngineLogLevel, defaults.DefaultEngineEnclavePoolSize, defaults.DefaultGitHubAuthTokenOverride, dontRestartAPIContainers, defaults.DefaultDomain, defaults.DefaultLogRetentionPeriod, defaults.DefaultEnclaveResourceQuota, defaults.DefaultEngineAuthConfigFilepath)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating a new Kurtosis engine client")
 	}
@@ -149,6 +220,10 @@ func run(
 		ApiContainerLogLevel:     &kurtosisLogLevelStr,
 		Mode:                     &mode,
 		ShouldApicRunInDebugMode: &shouldApicRunInDebugMode,
+		Labels:                   enclaveLabels,
+		Ttl:                      enclaveTtl,
+		ExpiryAction:             expiryAction,
+		ResourceQuota:            enclaveResourceQuota,
 	}
 	createdEnclaveResponse, err := engineClient.CreateEnclave(ctx, createEnclaveArgs)
 	if err != nil {
@@ -162,3 +237,66 @@ func run(
 
 	return nil
 }
+
+// Returns nil if no quota was requested, in which case the engine applies its default quota
+func getEnclaveResourceQuota(flags *flags.ParsedFlags) (*kurtosis_engine_rpc_api_bindings.EnclaveResourceQuota, error) {
+	enclaveResourceQuotaStr, err := flags.GetString(enclaveResourceQuotaFlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the enclave resource quota using flag with key '%v'; this is a bug in Kurtosis", enclaveResourceQuotaFlagKey)
+	}
+	enclaveResourceQuota, err := enclave_resource_quota.ParseEnclaveResourceQuotaStr(enclaveResourceQuotaStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing enclave resource quota string '%v'", enclaveResourceQuotaStr)
+	}
+	if enclaveResourceQuota == nil {
+		return nil, nil
+	}
+	return &kurtosis_engine_rpc_api_bindings.EnclaveResourceQuota{
+		MaxCpuMillicores:              uint64(enclaveResourceQuota.GetMaxCpuMilliCores()),
+		MaxMemoryMegabytes:            uint64(enclaveResourceQuota.GetMaxMemoryMegaBytes()),
+		MaxServices:                   enclaveResourceQuota.GetMaxServices(),
+		MaxPersistentStorageMegabytes: enclaveResourceQuota.GetMaxPersistentStorageMegaBytes(),
+	}, nil
+}
+
+func getEnclaveLabelsAndExpiration(flags *flags.ParsedFlags) (map[string]string, *durationpb.Duration, *kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction, error) {
+	enclaveLabelsStr, err := flags.GetString(enclaveLabelsFlagKey)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred while getting the enclave labels using flag with key '%v'; this is a bug in Kurtosis", enclaveLabelsFlagKey)
+	}
+	enclaveLabels, err := enclave_labels.ParseEnclaveLabelsStr(enclaveLabelsStr)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred parsing enclave labels string '%v'", enclaveLabelsStr)
+	}
+
+	enclaveTtlStr, err := flags.GetString(enclaveTtlFlagKey)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred while getting the enclave TTL using flag with key '%v'; this is a bug in Kurtosis", enclaveTtlFlagKey)
+	}
+	if enclaveTtlStr == noEnclaveTtlKeyword {
+		return enclaveLabels, nil, nil, nil
+	}
+	enclaveTtl, err := time.ParseDuration(enclaveTtlStr)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred parsing enclave TTL '%v'; it should be a duration like '30m' or '2h'", enclaveTtlStr)
+	}
+	if enclaveTtl <= 0 {
+		return nil, nil, nil, stacktrace.NewError("The enclave TTL must be positive but was '%v'", enclaveTtlStr)
+	}
+
+	onExpiryStr, err := flags.GetString(enclaveOnExpiryFlagKey)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred while getting the enclave expiry action using flag with key '%v'; this is a bug in Kurtosis", enclaveOnExpiryFlagKey)
+	}
+	var expiryAction kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction
+	switch strings.ToLower(onExpiryStr) {
+	case onExpiryDestroyKeyword:
+		expiryAction = kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY
+	case onExpiryStopKeyword:
+		expiryAction = kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_STOP
+	default:
+		return nil, nil, nil, stacktrace.NewError("Unrecognized enclave expiry action '%v'; valid values are '%v' and '%v'", onExpiryStr, onExpiryDestroyKeyword, onExpiryStopKeyword)
+	}
+
+	return enclaveLabels, durationpb.New(enclaveTtl), &expiryAction, nil
+}
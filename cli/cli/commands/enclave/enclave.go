@@ -10,6 +10,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/add"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/connect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/dump"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/extend"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/forward"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/ls"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/rm"
@@ -33,4 +35,6 @@ func init() {
 	EnclaveCmd.AddCommand(rm.EnclaveRmCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(dump.EnclaveDumpCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(connect.EnclaveConnectCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(forward.EnclaveForwardCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(extend.EnclaveExtendCmd.MustGetCobraCommand())
 }
@@ -0,0 +1,94 @@
+package extend
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"time"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	ttlFlagKey = "ttl"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveExtendCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveExtendCmdStr,
+	ShortDescription: "Extends an enclave's TTL",
+	LongDescription: "Pushes back the expiration time of an enclave that was created with a TTL by the given duration. " +
+		"The extension is counted from the current expiration time, or from now if the enclave has already expired but hasn't been reaped yet",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       ttlFlagKey,
+			Usage:     "How much time to add to the enclave's TTL (e.g. '30m', '2h')",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   "",
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	extensionStr, err := flags.GetString(ttlFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", ttlFlagKey)
+	}
+	extension, err := time.ParseDuration(extensionStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing TTL extension '%v'; it should be a duration like '30m' or '2h'", extensionStr)
+	}
+	if extension <= 0 {
+		return stacktrace.NewError("The TTL extension must be positive but was '%v'", extensionStr)
+	}
+
+	extendArgs := &kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		Extension:         durationpb.New(extension),
+	}
+	response, err := engineClient.ExtendEnclave(ctx, extendArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred extending the TTL of enclave '%v'", enclaveIdentifier)
+	}
+
+	out.PrintOutLn(fmt.Sprintf("Enclave '%v' now expires at %v", enclaveIdentifier, response.GetExpirationTime().AsTime().Local().Format(time.RFC1123)))
+	return nil
+}
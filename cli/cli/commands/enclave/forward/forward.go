@@ -0,0 +1,198 @@
+package forward
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_port_forwarder"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	basePortFlagKey        = "base-port"
+	portsPerServiceFlagKey = "ports-per-service"
+	mappingFileFlagKey     = "mapping-file"
+	pollIntervalFlagKey    = "poll-interval"
+
+	defaultBasePort            = 40000
+	defaultPortsPerService     = 10
+	defaultMappingFilepath     = ""
+	defaultPollIntervalSeconds = 2
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	interruptChanBufferSize = 1
+)
+
+var allServices = map[string]bool{}
+
+var EnclaveForwardCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveForwardCmdStr,
+	ShortDescription: "Forwards stable local ports to all the services of an enclave",
+	LongDescription: fmt.Sprintf(
+		"Keeps a local port bound to every port of every service in the enclave until interrupted, following the "+
+			"services as they get added, removed or restarted. The local ports are assigned deterministically: the N-th "+
+			"service gets the '%v' ports starting at '%v' + N * '%v', and a service keeps its ports across re-runs. "+
+			"The mapping between the service ports and the local ports is written as JSON to the file given by '%v' "+
+			"so that other tools can read it.",
+		portsPerServiceFlagKey,
+		basePortFlagKey,
+		portsPerServiceFlagKey,
+		mappingFileFlagKey,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     basePortFlagKey,
+			Usage:   "The first local port to forward",
+			Type:    flags.FlagType_Uint32,
+			Default: strconv.Itoa(defaultBasePort),
+		},
+		{
+			Key:     portsPerServiceFlagKey,
+			Usage:   "The number of consecutive local ports reserved for each service",
+			Type:    flags.FlagType_Uint32,
+			Default: strconv.Itoa(defaultPortsPerService),
+		},
+		{
+			Key:     mappingFileFlagKey,
+			Usage:   "The file to write the port mapping to; defaults to a file named after the enclave in the Kurtosis state directory",
+			Type:    flags.FlagType_String,
+			Default: defaultMappingFilepath,
+		},
+		{
+			Key:     pollIntervalFlagKey,
+			Usage:   "How often, in seconds, the services of the enclave are checked for changes",
+			Type:    flags.FlagType_Uint32,
+			Default: strconv.Itoa(defaultPollIntervalSeconds),
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier arg using key '%v'", enclaveIdentifierArgKey)
+	}
+	basePort, err := getUint16Flag(flags, basePortFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the base port using flag key '%v'", basePortFlagKey)
+	}
+	portsPerService, err := getUint16Flag(flags, portsPerServiceFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the number of ports per service using flag key '%v'", portsPerServiceFlagKey)
+	}
+	mappingFilepath, err := flags.GetString(mappingFileFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the mapping file using flag key '%v'", mappingFileFlagKey)
+	}
+	pollIntervalSeconds, err := flags.GetUint32(pollIntervalFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the poll interval using flag key '%v'", pollIntervalFlagKey)
+	}
+	if pollIntervalSeconds == 0 {
+		return stacktrace.NewError("The poll interval must be at least 1 second")
+	}
+
+	portScheme, err := enclave_port_forwarder.NewPortScheme(basePort, portsPerService)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the port scheme")
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting an enclave context for enclave '%v'", enclaveIdentifier)
+	}
+	enclaveName := enclaveCtx.GetEnclaveName()
+
+	if mappingFilepath == defaultMappingFilepath {
+		mappingFilepath, err = host_machine_directories.GetEnclavePortForwardsFilepath(enclaveName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the default port forwards file of enclave '%v'", enclaveName)
+		}
+	}
+
+	forwarder, err := enclave_port_forwarder.NewEnclavePortForwarder(enclaveName, portScheme, mappingFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the port forwarder of enclave '%v'", enclaveName)
+	}
+	defer forwarder.Close()
+
+	interruptChan := make(chan os.Signal, interruptChanBufferSize)
+	signal.Notify(interruptChan, os.Interrupt)
+	defer signal.Stop(interruptChan)
+
+	ticker := time.NewTicker(time.Duration(pollIntervalSeconds) * time.Second)
+	defer ticker.Stop()
+
+	out.PrintOutLn(fmt.Sprintf("Forwarding the ports of the services in enclave '%v'; the mapping is written to '%v'. Press Ctrl+C to stop.", enclaveName, mappingFilepath))
+	for {
+		serviceContexts, err := enclaveCtx.GetServiceContexts(allServices)
+		if err != nil {
+			// the enclave might be temporarily unreachable, e.g. when the engine restarts, so we keep the ports bound and retry
+			logrus.Warnf("An error occurred getting the services of enclave '%v'; retrying:\n%v", enclaveName, err)
+		} else if err = forwarder.Sync(serviceContexts); err != nil {
+			return stacktrace.Propagate(err, "An error occurred forwarding the ports of the services in enclave '%v'", enclaveName)
+		}
+
+		select {
+		case <-interruptChan:
+			logrus.Info("Interrupted; releasing the forwarded ports")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func getUint16Flag(flags *flags.ParsedFlags, flagKey string) (uint16, error) {
+	value, err := flags.GetUint32(flagKey)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting flag '%v'", flagKey)
+	}
+	if value > uint32(^uint16(0)) {
+		return 0, stacktrace.NewError("Flag '%v' must be at most '%v' but got '%v'", flagKey, ^uint16(0), value)
+	}
+	return uint16(value), nil
+}
@@ -50,6 +50,7 @@ const (
 
 	userServicesArtifactsHeader = "User Services"
 	filesArtifactsHeader        = "Files Artifacts"
+	persistentDirectoriesHeader = "Persistent Directories"
 
 	productionEnclaveFlagStr = "production"
 )
@@ -57,6 +58,7 @@ const (
 var enclaveObjectPrintingFuncs = map[string]func(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, showFullUuid bool, isAPIContainerRunning bool) error{
 	userServicesArtifactsHeader: printUserServices,
 	filesArtifactsHeader:        printFilesArtifacts,
+	persistentDirectoriesHeader: printPersistentDirectories,
 }
 
 var EnclaveInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
@@ -165,8 +167,8 @@ func PrintEnclaveInspect(ctx context.Context, kurtosisCtx *kurtosis_context.Kurt
 
 	headersWithPrintErrs := []string{}
 	for _, header := range sortedEnclaveObjHeaders {
-		if header == filesArtifactsHeader && !isApiContainerRunning {
-			// can't fetch files artifact information if APIC isn't running
+		if (header == filesArtifactsHeader || header == persistentDirectoriesHeader) && !isApiContainerRunning {
+			// can't fetch files artifact or persistent directory information if APIC isn't running
 			continue
 		}
 
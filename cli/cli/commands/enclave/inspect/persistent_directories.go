@@ -0,0 +1,81 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
+	"github.com/kurtosis-tech/stacktrace"
+	"sort"
+)
+
+const (
+	persistentDirectoryServiceNameColHeader  = "Service"
+	persistentDirectoryMountPathColHeader    = "Mount Path"
+	persistentDirectoryKeyColHeader          = "Persistent Key"
+	persistentDirectorySizeColHeader         = "Size"
+	persistentDirectoryStorageClassColHeader = "Storage Class"
+	persistentDirectoryAccessModeColHeader   = "Access Mode"
+
+	// an empty storage class or access mode means the backend picked it
+	backendDefaultStr = "<default>"
+
+	bytesInMegabyte = 1024 * 1024
+)
+
+func printPersistentDirectories(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, _ bool, _ bool) error {
+	allServicesMap := map[string]bool{}
+	userServices, err := user_services.GetUserServiceInfoMapFromAPIContainer(ctx, kurtosisCtx, enclaveInfo, allServicesMap)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(
+		persistentDirectoryServiceNameColHeader,
+		persistentDirectoryMountPathColHeader,
+		persistentDirectoryKeyColHeader,
+		persistentDirectorySizeColHeader,
+		persistentDirectoryStorageClassColHeader,
+		persistentDirectoryAccessModeColHeader,
+	)
+	sortedUserServices := user_services.GetSortedUserServiceSliceFromUserServiceMap(userServices)
+	for _, userService := range sortedUserServices {
+		persistentDirectories := userService.GetPersistentDirectories()
+		sortedMountPaths := []string{}
+		for mountPath := range persistentDirectories {
+			sortedMountPaths = append(sortedMountPaths, mountPath)
+		}
+		sort.Strings(sortedMountPaths)
+
+		for _, mountPath := range sortedMountPaths {
+			persistentDirectory := persistentDirectories[mountPath]
+			if err := tablePrinter.AddRow(
+				userService.GetName(),
+				mountPath,
+				persistentDirectory.GetPersistentKey(),
+				fmt.Sprintf("%d MB", persistentDirectory.GetSize()/bytesInMegabyte),
+				getValueOrBackendDefault(persistentDirectory.GetStorageClass()),
+				getValueOrBackendDefault(persistentDirectory.GetAccessMode()),
+			); err != nil {
+				return stacktrace.Propagate(
+					err,
+					"An error occurred adding row for persistent directory '%v' of user service '%v' to the table printer",
+					persistentDirectory.GetPersistentKey(),
+					userService.GetName(),
+				)
+			}
+		}
+	}
+	tablePrinter.Print()
+
+	return nil
+}
+
+func getValueOrBackendDefault(value string) string {
+	if value == "" {
+		return backendDefaultStr
+	}
+	return value
+}
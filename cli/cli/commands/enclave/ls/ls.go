@@ -7,12 +7,14 @@ package ls
 
 import (
 	"context"
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_labels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_status_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
@@ -28,6 +30,7 @@ const (
 	enclaveStatusColumnHeader       = "Status"
 	enclaveNameColumnHeader         = "Name"
 	enclaveCreationTimeColumnHeader = "Creation Time"
+	enclaveExpirationColumnHeader   = "Expires"
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
@@ -35,6 +38,12 @@ const (
 	fullUuidsFlagKey       = "full-uuids"
 	fullUuidFlagKeyDefault = "false"
 
+	labelsFlagKey = "labels"
+
+	emptyTimeForEnclavesWithoutExpiration = ""
+	// The protobuf enum values are prefixed with the enum name, which we don't want to print
+	expiryActionEnumValuePrefix = "EnclaveExpiryAction_"
+
 	emptyTimeForOldEnclaves = ""
 )
 
@@ -51,6 +60,17 @@ var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 			Type:    flags.FlagType_Bool,
 			Default: fullUuidFlagKeyDefault,
 		},
+		{
+			Key: labelsFlagKey,
+			Usage: fmt.Sprintf(
+				"Only list enclaves carrying all of the given labels, in the form \"KEY1%vVALUE1%vKEY2%vVALUE2\"",
+				enclave_labels.LabelKeyValueDelimiter,
+				enclave_labels.LabelDeclarationsDelimiter,
+				enclave_labels.LabelKeyValueDelimiter,
+			),
+			Type:    flags.FlagType_String,
+			Default: "",
+		},
 	},
 	Args:    nil,
 	RunFunc: run,
@@ -69,7 +89,16 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
 	}
 
-	enclaves, err := kurtosisCtx.GetEnclaves(ctx)
+	labelsStr, err := flags.GetString(labelsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", labelsFlagKey)
+	}
+	labels, err := enclave_labels.ParseEnclaveLabelsStr(labelsStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing enclave labels string '%v'", labelsStr)
+	}
+
+	enclaves, err := kurtosisCtx.GetEnclavesWithLabels(ctx, labels)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting enclaves")
 	}
@@ -79,7 +108,7 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
 	}
 
-	tablePrinter := output_printers.NewTablePrinter(enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader)
+	tablePrinter := output_printers.NewTablePrinter(enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader, enclaveExpirationColumnHeader)
 	orderedEnclaveInfoMaps, enclaveWithoutCreationTimeInfoMap := getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(enclaves.GetEnclavesByUuid())
 
 	//TODO remove this iteration after 2023-01-01 when we are sure that there is not any old enclave created without the creation time label
@@ -96,7 +125,7 @@ func run(
 			return stacktrace.Propagate(err, "An error occurred when stringify enclave containers status '%v'", enclaveInfo.GetContainersStatus())
 		}
 
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveInfo.Name, enclaveStatus, emptyTimeForOldEnclaves); err != nil {
+		if err := tablePrinter.AddRow(uuidToPrint, enclaveInfo.Name, enclaveStatus, emptyTimeForOldEnclaves, getEnclaveExpirationStr(enclaveInfo)); err != nil {
 			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
 		}
 	}
@@ -120,7 +149,7 @@ func run(
 
 		enclaveName := enclaveInfo.GetName()
 
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveName, enclaveStatus, enclaveCreationTime); err != nil {
+		if err := tablePrinter.AddRow(uuidToPrint, enclaveName, enclaveStatus, enclaveCreationTime, getEnclaveExpirationStr(enclaveInfo)); err != nil {
 			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
 		}
 	}
@@ -172,3 +201,14 @@ func getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(
 
 	return orderedEnclaveInfoMaps, enclaveWithoutCreationTimeInfoMap
 }
+
+func getEnclaveExpirationStr(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) string {
+	if enclaveInfo.GetExpirationTime() == nil {
+		return emptyTimeForEnclavesWithoutExpiration
+	}
+	return fmt.Sprintf(
+		" %v (%v)",
+		enclaveInfo.GetExpirationTime().AsTime().Local().Format(time.RFC1123),
+		strings.ToLower(strings.TrimPrefix(enclaveInfo.GetExpiryAction().String(), expiryActionEnumValuePrefix)),
+	)
+}
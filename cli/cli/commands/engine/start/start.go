@@ -13,6 +13,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/common"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_resource_quota"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_auth_config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/logrus_log_levels"
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
@@ -26,6 +28,9 @@ const (
 	enclavePoolSizeFlagKey         = "enclave-pool-size"
 	githubAuthTokenOverrideFlagKey = "github-auth-token"
 	logRetentionPeriodFlagKey      = "log-retention-period"
+	enclaveResourceQuotaFlagKey    = "default-enclave-resource-quota"
+	engineAuthConfigFlagKey        = "auth-config"
+	noEngineAuthFlagKey            = "no-auth"
 
 	defaultEngineVersion          = ""
 	kurtosisTechEngineImagePrefix = "kurtosistech/engine"
@@ -36,6 +41,8 @@ const (
 
 	domainFlagKey = "domain"
 	defaultDomain = ""
+
+	defaultNoEngineAuth = "false"
 )
 
 var StartCmd = &lowlevel.LowlevelKurtosisCommand{
@@ -102,6 +109,40 @@ var StartCmd = &lowlevel.LowlevelKurtosisCommand{
 			Type:      flags.FlagType_String,
 			Default:   defaults.DefaultLogRetentionPeriod,
 		},
+		{
+			Key: enclaveResourceQuotaFlagKey,
+			Usage: fmt.Sprintf(
+				"The resource quota of the enclaves that don't set one, and the limits used for the resources an enclave quota leaves unset, in the form \"%v%v2000%v%v%v4096%v%v%v10%v%v%v10240\" where cpu is in millicores and memory and storage are in megabytes. Plans that exceed the quota of their enclave fail at validation. Blank means that the enclaves aren't capped",
+				enclave_resource_quota.CpuQuotaKey,
+				enclave_resource_quota.QuotaKeyValueDelimiter,
+				enclave_resource_quota.QuotaDeclarationsDelimiter,
+				enclave_resource_quota.MemoryQuotaKey,
+				enclave_resource_quota.QuotaKeyValueDelimiter,
+				enclave_resource_quota.QuotaDeclarationsDelimiter,
+				enclave_resource_quota.ServicesQuotaKey,
+				enclave_resource_quota.QuotaKeyValueDelimiter,
+				enclave_resource_quota.QuotaDeclarationsDelimiter,
+				enclave_resource_quota.StorageQuotaKey,
+				enclave_resource_quota.QuotaKeyValueDelimiter,
+			),
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaults.DefaultEnclaveResourceQuota,
+		},
+		{
+			Key:       engineAuthConfigFlagKey,
+			Usage:     fmt.Sprintf("Path to a YAML file declaring the bearer tokens and client certificates accepted by the engine, the role of each of them and optionally the certificate the engine serves TLS with. Blank reuses the auth config the engine of the cluster was last started with, if any; pass '--%v' to start an engine accepting any caller", noEngineAuthFlagKey),
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaults.DefaultEngineAuthConfigFilepath,
+		},
+		{
+			Key:       noEngineAuthFlagKey,
+			Usage:     fmt.Sprintf("Start an engine accepting any caller, even if the engine of the cluster was last started with an auth config. Can't be combined with '--%v'", engineAuthConfigFlagKey),
+			Shorthand: "",
+			Type:      flags.FlagType_Bool,
+			Default:   defaultNoEngineAuth,
+		},
 	},
 	PreValidationAndRunFunc:  nil,
 	RunFunc:                  run,
@@ -172,16 +213,47 @@ func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error
 		return stacktrace.Propagate(err, "An error occurred parsing provided log retention period '%v' into a duration. Ensure the provided value has the proper format of hours using 'h'.", logRetentionPeriodStr)
 	}
 
+	enclaveResourceQuotaStr, err := flags.GetString(enclaveResourceQuotaFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the default enclave resource quota using flag with key '%v'; this is a bug in Kurtosis", enclaveResourceQuotaFlagKey)
+	}
+	if _, err := enclave_resource_quota.ParseEnclaveResourceQuotaStr(enclaveResourceQuotaStr); err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the default enclave resource quota '%v'", enclaveResourceQuotaStr)
+	}
+
+	engineAuthConfigFilepath, err := flags.GetString(engineAuthConfigFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the engine auth config filepath using flag with key '%v'; this is a bug in Kurtosis", engineAuthConfigFlagKey)
+	}
+	engineAuthConfig, err := engine_auth_config.ParseEngineAuthConfigFile(engineAuthConfigFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the engine auth config file '%v'", engineAuthConfigFilepath)
+	}
+	// The enclave manager UI doesn't support engine authentication yet so it isn't served by engines requiring it
+	if engineAuthConfig != nil && domain != defaultDomain {
+		return stacktrace.NewError("The '%v' flag sets the domain of the enclave manager UI, which isn't served when the engine requires authentication with the '%v' flag; remove one of the two flags", domainFlagKey, engineAuthConfigFlagKey)
+	}
+	noEngineAuth, err := flags.GetBool(noEngineAuthFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the no engine auth flag with key '%v'; this is a bug in Kurtosis", noEngineAuthFlagKey)
+	}
+	if noEngineAuth {
+		if engineAuthConfigFilepath != defaults.DefaultEngineAuthConfigFilepath {
+			return stacktrace.NewError("The '%v' and '%v' flags can't be combined", engineAuthConfigFlagKey, noEngineAuthFlagKey)
+		}
+		engineAuthConfigFilepath = engine_auth_config.NoEngineAuthConfigFilepath
+	}
+
 	if engineVersion == defaultEngineVersion && isDebugMode {
 		engineDebugVersion := fmt.Sprintf("%s-%s", kurtosis_version.KurtosisVersion, defaults.DefaultKurtosisContainerDebugImageNameSuffix)
 		logrus.Infof("Starting Kurtosis engine in debug mode from image '%v%v%v'...", kurtosisTechEngineImagePrefix, imageVersionDelimiter, engineDebugVersion)
-		_, engineClientCloseFunc, startEngineErr = engineManager.StartEngineIdempotentlyWithCustomVersion(ctx, engineDebugVersion, logLevel, enclavePoolSize, true, githubAuthTokenOverride, shouldRestartAPIContainers, domain, logRetentionPeriodStr)
+		_, engineClientCloseFunc, startEngineErr = engineManager.StartEngineIdempotentlyWithCustomVersion(ctx, engineDebugVersion, logLevel, enclavePoolSize, true, githubAuthTokenOverride, shouldRestartAPIContainers, domain, logRetentionPeriodStr, enclaveResourceQuotaStr, engineAuthConfigFilepath)
 	} else if engineVersion == defaultEngineVersion {
 		logrus.Infof("Starting Kurtosis engine from image '%v%v%v'...", kurtosisTechEngineImagePrefix, imageVersionDelimiter, kurtosis_version.KurtosisVersion)
-		_, engineClientCloseFunc, startEngineErr = engineManager.StartEngineIdempotentlyWithDefaultVersion(ctx, logLevel, enclavePoolSize, githubAuthTokenOverride, shouldRestartAPIContainers, domain, logRetentionPeriodStr)
+		_, engineClientCloseFunc, startEngineErr = engineManager.StartEngineIdempotentlyWithDefaultVersion(ctx, logLevel, enclavePoolSize, githubAuthTokenOverride, shouldRestartAPIContainers, domain, logRetentionPeriodStr, enclaveResourceQuotaStr, engineAuthConfigFilepath)
 	} else {
 		logrus.Infof("Starting Kurtosis engine from image '%v%v%v'...", kurtosisTechEngineImagePrefix, imageVersionDelimiter, engineVersion)
-		_, engineClientCloseFunc, startEngineErr = engineManager.StartEngineIdempotentlyWithCustomVersion(ctx, engineVersion, logLevel, enclavePoolSize, defaults.DefaultEnableDebugMode, githubAuthTokenOverride, shouldRestartAPIContainers, domain, logRetentionPeriodStr)
+		_, engineClientCloseFunc, startEngineErr = engineManager.StartEngineIdempotentlyWithCustomVersion(ctx, engineVersion, logLevel, enclavePoolSize, defaults.DefaultEnableDebugMode, githubAuthTokenOverride, shouldRestartAPIContainers, domain, logRetentionPeriodStr, enclaveResourceQuotaStr, engineAuthConfigFilepath)
 	}
 	if startEngineErr != nil {
 		return stacktrace.Propagate(startEngineErr, "An error occurred starting the Kurtosis engine")
@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/ls"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/rm"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/set"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/set_engine_auth"
 	"github.com/spf13/cobra"
 )
 
@@ -22,4 +23,5 @@ func init() {
 	ContextCmd.AddCommand(ls.ContextLsCmd.MustGetCobraCommand())
 	ContextCmd.AddCommand(rm.ContextRmCmd.MustGetCobraCommand())
 	ContextCmd.AddCommand(set.ContextSetCmd.MustGetCobraCommand())
+	ContextCmd.AddCommand(set_engine_auth.ContextSetEngineAuthCmd.MustGetCobraCommand())
 }
@@ -0,0 +1,179 @@
+package set_engine_auth
+
+import (
+	"context"
+	"os"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/context_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	contexts_config_api "github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	contextIdentifierArgKey      = "context"
+	contextIdentifierArgIsGreedy = false
+
+	tokenFlagKey                = "token"
+	certificateAuthorityFlagKey = "ca-cert"
+	clientCertificateFlagKey    = "client-cert"
+	clientKeyFlagKey            = "client-key"
+	clearFlagKey                = "clear"
+
+	defaultToken                = ""
+	defaultCertificateAuthority = ""
+	defaultClientCertificate    = ""
+	defaultClientKey            = ""
+	defaultClear                = "false"
+)
+
+var ContextSetEngineAuthCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.ContextSetAuthCmdStr,
+	ShortDescription: "Sets the credentials used to call the engine of a Kurtosis context",
+	LongDescription: "Sets the bearer token and TLS certificates the CLI uses to call the engine of a Kurtosis context, " +
+		"which are required when the engine was started with an auth config. The certificates are read from the " +
+		"files passed and stored in the context, replacing the credentials previously set",
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       tokenFlagKey,
+			Usage:     "The bearer token sent to the engine",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaultToken,
+		},
+		{
+			Key:       certificateAuthorityFlagKey,
+			Usage:     "Path to the PEM certificate of the authority that signed the engine server certificate. Setting it makes the CLI call the engine over TLS",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaultCertificateAuthority,
+		},
+		{
+			Key:       clientCertificateFlagKey,
+			Usage:     "Path to the PEM client certificate presented to the engine",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaultClientCertificate,
+		},
+		{
+			Key:       clientKeyFlagKey,
+			Usage:     "Path to the PEM key of the client certificate",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaultClientKey,
+		},
+		{
+			Key:       clearFlagKey,
+			Usage:     "Removes the credentials of the context instead of setting them",
+			Shorthand: "",
+			Type:      flags.FlagType_Bool,
+			Default:   defaultClear,
+		},
+	},
+	Args: []*args.ArgConfig{
+		context_id_arg.NewContextIdentifierArg(store.GetContextsConfigStore(), contextIdentifierArgKey, contextIdentifierArgIsGreedy),
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+	contextIdentifier, err := args.GetNonGreedyArg(contextIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for context identifier arg '%v' but none was found; this is a bug in the Kurtosis CLI!", contextIdentifierArgKey)
+	}
+
+	shouldClear, err := flags.GetBool(clearFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", clearFlagKey)
+	}
+
+	var engineAuth *generated.EngineAuth
+	if !shouldClear {
+		engineAuth, err = getEngineAuthFromFlags(flags)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred building the engine credentials from the flags")
+		}
+	}
+
+	contextsConfigStore := store.GetContextsConfigStore()
+	contextUuids, err := context_id_arg.GetContextUuidForContextIdentifier(contextsConfigStore, []string{contextIdentifier})
+	if err != nil {
+		return stacktrace.Propagate(err, "Error searching for context matching context identifier: '%s'", contextIdentifier)
+	}
+	contextUuid, found := contextUuids[contextIdentifier]
+	if !found {
+		return stacktrace.NewError("No context matching identifier '%s' could be found", contextIdentifier)
+	}
+
+	if err = contextsConfigStore.SetEngineAuth(contextUuid, engineAuth); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting the engine credentials of context '%s'", contextIdentifier)
+	}
+	if engineAuth == nil {
+		logrus.Infof("Engine credentials of context '%s' removed", contextIdentifier)
+	} else {
+		logrus.Infof("Engine credentials of context '%s' set", contextIdentifier)
+	}
+	return nil
+}
+
+func getEngineAuthFromFlags(flags *flags.ParsedFlags) (*generated.EngineAuth, error) {
+	token, err := flags.GetString(tokenFlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", tokenFlagKey)
+	}
+	certificateAuthority, err := readFileFlag(flags, certificateAuthorityFlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the certificate authority")
+	}
+	clientCertificate, err := readFileFlag(flags, clientCertificateFlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the client certificate")
+	}
+	clientKey, err := readFileFlag(flags, clientKeyFlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the client key")
+	}
+	if (clientCertificate == nil) != (clientKey == nil) {
+		return nil, stacktrace.NewError("The '%v' and '%v' flags must be set together", clientCertificateFlagKey, clientKeyFlagKey)
+	}
+	if clientCertificate != nil && certificateAuthority == nil {
+		return nil, stacktrace.NewError("A client certificate can only be presented over TLS, which requires the '%v' flag", certificateAuthorityFlagKey)
+	}
+	if token == "" && certificateAuthority == nil {
+		return nil, stacktrace.NewError("Either a token or TLS certificates need to be set; use the '%v' flag to remove the credentials of the context", clearFlagKey)
+	}
+
+	var tlsConfig *generated.TlsConfig
+	if certificateAuthority != nil {
+		tlsConfig = &generated.TlsConfig{
+			CertificateAuthority: certificateAuthority,
+			ClientCertificate:    clientCertificate,
+			ClientKey:            clientKey,
+		}
+	}
+	return contexts_config_api.NewEngineAuth(token, tlsConfig), nil
+}
+
+// readFileFlag returns nil if the flag isn't set
+func readFileFlag(flags *flags.ParsedFlags, flagKey string) ([]byte, error) {
+	filepath, err := flags.GetString(flagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", flagKey)
+	}
+	if filepath == "" {
+		return nil, nil
+	}
+	content, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading file '%v' passed to the '%v' flag", filepath, flagKey)
+	}
+	return content, nil
+}
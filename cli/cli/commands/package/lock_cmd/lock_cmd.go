@@ -0,0 +1,242 @@
+package lock_cmd
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/registry_credentials_getter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+const (
+	packageDirpathArgKey        = "package-dir"
+	packageDirpathArgIsOptional = true
+	packageDirpathArgDefault    = "."
+
+	packageArgsFlagKey     = "args"
+	packageArgsFlagDefault = "{}"
+
+	upgradeFlagKey     = "upgrade"
+	upgradeFlagDefault = "false"
+
+	registryCredentialsFlagKey     = "registry-credentials"
+	registryCredentialsFlagDefault = ""
+
+	kurtosisYmlFilename = "kurtosis.yml"
+
+	// the package is only interpreted to resolve its dependencies, no instruction is executed
+	isDryRun = true
+
+	autogenerateEnclaveName = ""
+
+	tmpPackageCopyDirPattern = "kurtosis-package-lock-"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+// LockCmd we only fill in the required struct fields, hence the others remain nil
+// nolint: exhaustruct
+var LockCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.PackageLockCmdStr,
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	ShortDescription:          "Pins the remote dependencies of a package in a " + package_lock.LockFileName + " file",
+	LongDescription: "Interprets the Kurtosis package in the given directory (the current directory by default) in a " +
+		"temporary enclave and writes the commit and content hash of every remote package it transitively imports to the '" +
+		package_lock.LockFileName + "' file next to its '" + kurtosisYmlFilename + "'. Runs of the package then clone these " +
+		"exact commits and fail if their content doesn't match the hashes. Dependencies already pinned by an existing '" +
+		package_lock.LockFileName + "' are kept as is, new ones are added and unused ones are removed; use '--" + upgradeFlagKey +
+		"' to re-resolve all of them to their latest version. The package is interpreted with the given arguments, so pass " +
+		"the same '--" + packageArgsFlagKey + "' as the arguments of 'kurtosis " + command_str_consts.StarlarkRunCmdStr + "' if the imports depend on them.",
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     upgradeFlagKey,
+			Usage:   "If true, dependencies pinned by the existing " + package_lock.LockFileName + " are re-resolved to their latest version",
+			Type:    flags.FlagType_Bool,
+			Default: upgradeFlagDefault,
+		},
+		{
+			Key:     packageArgsFlagKey,
+			Usage:   "The JSON or YAML arguments the package is interpreted with",
+			Type:    flags.FlagType_String,
+			Default: packageArgsFlagDefault,
+		},
+		{
+			Key:     registryCredentialsFlagKey,
+			Usage:   "Comma separated registries whose credentials, read from the Docker config, are sent to the enclave so that it can pull the private OCI packages hosted on them. No credentials are sent by default",
+			Type:    flags.FlagType_String,
+			Default: registryCredentialsFlagDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		file_system_path_arg.NewDirpathArg(
+			packageDirpathArgKey,
+			packageDirpathArgIsOptional,
+			packageDirpathArgDefault,
+			file_system_path_arg.DefaultValidationFunc,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	packageDirpath, err := args.GetNonGreedyArg(packageDirpathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "an error occurred getting the value of argument with key '%v'", packageDirpathArgKey)
+	}
+	packageArgs, err := flags.GetString(packageArgsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", packageArgsFlagKey)
+	}
+	shouldUpgrade, err := flags.GetBool(upgradeFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", upgradeFlagKey)
+	}
+	registriesStr, err := flags.GetString(registryCredentialsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", registryCredentialsFlagKey)
+	}
+
+	kurtosisYml, err := enclaves.ParseKurtosisYaml(path.Join(packageDirpath, kurtosisYmlFilename))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the '%v' of the package in '%v'. Only Kurtosis packages can be locked", kurtosisYmlFilename, packageDirpath)
+	}
+
+	// the APIC honors the lock file uploaded with the package, so upgrading means resolving a copy of the package without it
+	packageDirpathToResolve := packageDirpath
+	if shouldUpgrade {
+		packageCopyDirpath, err := copyPackageWithoutLockFile(packageDirpath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred copying the package in '%v' without its '%v'", packageDirpath, package_lock.LockFileName)
+		}
+		defer os.RemoveAll(packageCopyDirpath)
+		packageDirpathToResolve = packageCopyDirpath
+	}
+
+	gitHostConfigs, err := kurtosis_config_getter.GetGitHostConfigs()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the git hosts from the Kurtosis config")
+	}
+
+	registryCredentials, err := registry_credentials_getter.GetRegistryCredentials(registry_credentials_getter.ParseRegistries(registriesStr))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the registry credentials")
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	logrus.Infof("Creating a temporary enclave to resolve the dependencies of package '%v'...", kurtosisYml.PackageName)
+	enclaveCtx, err := kurtosisCtx.CreateEnclave(ctx, autogenerateEnclaveName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary enclave")
+	}
+	defer func() {
+		if err := kurtosisCtx.DestroyEnclave(ctx, enclaveCtx.GetEnclaveName()); err != nil {
+			logrus.Warnf("An error occurred destroying the temporary enclave '%v'; you'll have to remove it manually with 'kurtosis %v %v %v'. Error was:\n%v", enclaveCtx.GetEnclaveName(), command_str_consts.EnclaveCmdStr, command_str_consts.EnclaveRmCmdStr, enclaveCtx.GetEnclaveName(), err)
+		}
+	}()
+
+	runConfig := starlark_run_config.NewRunStarlarkConfig(
+		starlark_run_config.WithDryRun(isDryRun),
+		starlark_run_config.WithSerializedParams(packageArgs),
+		starlark_run_config.WithGitHostConfigs(gitHostConfigs),
+		starlark_run_config.WithRegistryCredentials(registryCredentials),
+	)
+	if _, err = enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpathToResolve, runConfig); err != nil {
+		return stacktrace.Propagate(err, "An error occurred interpreting package '%v' to resolve its dependencies", kurtosisYml.PackageName)
+	}
+
+	packageLock, err := enclaveCtx.GetResolvedPackageDependencies(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the dependencies resolved for package '%v'", kurtosisYml.PackageName)
+	}
+	lockFilepath := path.Join(packageDirpath, package_lock.LockFileName)
+	if err = packageLock.WriteToFile(lockFilepath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the lock file '%v'", lockFilepath)
+	}
+	out.PrintOutLn(fmt.Sprintf("Locked %d dependencies of package '%v' in '%v'", len(packageLock.Packages), kurtosisYml.PackageName, lockFilepath))
+	return nil
+}
+
+// copyPackageWithoutLockFile copies the package to a temporary directory, leaving out the lock file at its root
+func copyPackageWithoutLockFile(packageDirpath string) (string, error) {
+	packageCopyDirpath, err := os.MkdirTemp("", tmpPackageCopyDirPattern)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred creating a temporary directory to copy the package to")
+	}
+	err = filepath.WalkDir(packageDirpath, func(filepathInPackage string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relativeFilepath, err := filepath.Rel(packageDirpath, filepathInPackage)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to '%v'", filepathInPackage, packageDirpath)
+		}
+		if relativeFilepath == package_lock.LockFileName {
+			return nil
+		}
+		copyFilepath := filepath.Join(packageCopyDirpath, relativeFilepath)
+		info, err := entry.Info()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the info of '%v'", filepathInPackage)
+		}
+		if entry.IsDir() {
+			return os.MkdirAll(copyFilepath, info.Mode().Perm())
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		return copyFile(filepathInPackage, copyFilepath, info.Mode().Perm())
+	})
+	if err != nil {
+		os.RemoveAll(packageCopyDirpath)
+		return "", stacktrace.Propagate(err, "An error occurred copying package '%v' to '%v'", packageDirpath, packageCopyDirpath)
+	}
+	return packageCopyDirpath, nil
+}
+
+func copyFile(sourceFilepath string, destinationFilepath string, perm fs.FileMode) error {
+	source, err := os.Open(sourceFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening '%v'", sourceFilepath)
+	}
+	defer source.Close()
+	destination, err := os.OpenFile(destinationFilepath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating '%v'", destinationFilepath)
+	}
+	defer destination.Close()
+	if _, err = io.Copy(destination, source); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying '%v' to '%v'", sourceFilepath, destinationFilepath)
+	}
+	return nil
+}
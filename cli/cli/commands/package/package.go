@@ -3,6 +3,8 @@ package _package
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/package/init_cmd"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/package/lock_cmd"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/package/push_cmd"
 	"github.com/spf13/cobra"
 )
 
@@ -16,4 +18,6 @@ var PackageCmd = &cobra.Command{
 
 func init() {
 	PackageCmd.AddCommand(init_cmd.InitCmd.MustGetCobraCommand())
+	PackageCmd.AddCommand(push_cmd.PushCmd.MustGetCobraCommand())
+	PackageCmd.AddCommand(lock_cmd.LockCmd.MustGetCobraCommand())
 }
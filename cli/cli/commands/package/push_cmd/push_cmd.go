@@ -0,0 +1,128 @@
+package push_cmd
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/oci_artifacts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/path-compression"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"os"
+	"path"
+)
+
+const (
+	packageDirpathArgKey        = "package-dir"
+	packageDirpathArgIsOptional = false
+	packageDirpathArgDefault    = ""
+
+	ociReferenceArgKey        = "oci-reference"
+	ociReferenceArgIsOptional = false
+	ociReferenceArgIsGreedy   = false
+
+	kurtosisYmlFilename = "kurtosis.yml"
+
+	enforceMaxFileSizeLimit = true
+)
+
+// PushCmd we only fill in the required struct fields, hence the others remain nil
+// nolint: exhaustruct
+var PushCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.PackagePushCmdStr,
+	ShortDescription: "Publishes a Kurtosis package to an OCI registry",
+	LongDescription: "Archives the Kurtosis package in the given directory and pushes it as an OCI artifact to the given " +
+		"reference (e.g. '" + oci_artifacts.OciLocatorPrefix + "ghcr.io/my-org/my-package:1.0.0'). The pushed package can then be " +
+		"run or imported using the same reference, optionally pinned to the printed digest. The package name declared in its '" +
+		kurtosisYmlFilename + "' must be the reference without its tag (e.g. 'ghcr.io/my-org/my-package'). Credentials for the registry are " +
+		"read from the Docker config file, so run 'docker login <registry>' beforehand if the registry requires authentication.",
+	Args: []*args.ArgConfig{
+		file_system_path_arg.NewDirpathArg(
+			packageDirpathArgKey,
+			packageDirpathArgIsOptional,
+			packageDirpathArgDefault,
+			file_system_path_arg.DefaultValidationFunc,
+		),
+		{
+			Key:            ociReferenceArgKey,
+			IsOptional:     ociReferenceArgIsOptional,
+			IsGreedy:       ociReferenceArgIsGreedy,
+			ValidationFunc: validateOciReferenceArg,
+		},
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(ctx context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	packageDirpath, err := args.GetNonGreedyArg(packageDirpathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "an error occurred getting the value of argument with key '%v'", packageDirpathArgKey)
+	}
+	ociReferenceStr, err := args.GetNonGreedyArg(ociReferenceArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "an error occurred getting the value of argument with key '%v'", ociReferenceArgKey)
+	}
+	ociReference, err := oci_artifacts.ParseOciReference(ociReferenceStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing OCI reference '%v'", ociReferenceStr)
+	}
+
+	kurtosisYml, err := enclaves.ParseKurtosisYaml(path.Join(packageDirpath, kurtosisYmlFilename))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the '%v' of the package in '%v'. Only Kurtosis packages can be pushed", kurtosisYmlFilename, packageDirpath)
+	}
+	if kurtosisYml.PackageName != ociReference.GetPackageName() {
+		return stacktrace.NewError("The package in '%v' is named '%v' but it can only be pushed to '%v' if its '%v' declares the name '%v'", packageDirpath, kurtosisYml.PackageName, ociReference.GetPackageLocator(), kurtosisYmlFilename, ociReference.GetPackageName())
+	}
+
+	archivePath, _, _, err := path_compression.CompressPathToFile(packageDirpath, enforceMaxFileSizeLimit)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred compressing the package in '%v'", packageDirpath)
+	}
+	defer os.RemoveAll(path.Dir(archivePath))
+	archive, err := os.Open(archivePath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening the package archive '%v'", archivePath)
+	}
+	defer archive.Close()
+
+	credentialsByRegistry, err := oci_artifacts.GetRegistryCredentialsFromDockerConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading registry credentials from the Docker config")
+	}
+	registryClient := oci_artifacts.NewRegistryClient(http.DefaultClient, credentialsByRegistry)
+	logrus.Infof("Pushing package '%v' to '%v'", kurtosisYml.PackageName, ociReference.GetPackageLocator())
+	manifestDigest, err := registryClient.PushPackage(ctx, ociReference, kurtosisYml.PackageName, archive)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred pushing package '%v' to '%v'", kurtosisYml.PackageName, ociReference.GetPackageLocator())
+	}
+
+	pinnedReference := oci_artifacts.NewOciReference(ociReference.GetRegistry(), ociReference.GetRepository(), ociReference.GetTag(), manifestDigest, "")
+	out.PrintOutLn(fmt.Sprintf("Pushed package '%v' with digest '%v'", kurtosisYml.PackageName, manifestDigest))
+	out.PrintOutLn(fmt.Sprintf("Run it with: kurtosis %v %v", command_str_consts.StarlarkRunCmdStr, pinnedReference.GetPackageLocator()))
+	return nil
+}
+
+func validateOciReferenceArg(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	ociReferenceStr, err := args.GetNonGreedyArg(ociReferenceArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "an error occurred getting the value of argument with key '%v'", ociReferenceArgKey)
+	}
+	ociReference, err := oci_artifacts.ParseOciReference(ociReferenceStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating OCI reference '%v'", ociReferenceStr)
+	}
+	if ociReference.IsPinnedToDigest() || ociReference.GetRelativeFilePath() != "" {
+		return stacktrace.NewError("A package can only be pushed to a tag, like '%vregistry.example.com/my-package:1.0.0', got '%v'", oci_artifacts.OciLocatorPrefix, ociReferenceStr)
+	}
+	return nil
+}
@@ -145,6 +145,11 @@ func run(
 
 	publicPort, found := publicPorts[portIdentifier]
 	if !found {
+		// Kubernetes clusters don't publish the ports on the host, but can expose the HTTP ones under a URL
+		if publicUrl, found := serviceCtx.GetPublicUrls()[portIdentifier]; found {
+			out.PrintOutLn(publicUrl)
+			return nil
+		}
 		return stacktrace.NewError(
 			fmt.Sprintf("Port Identifier: '%v' is not found for service: '%v' in enclave '%v'", portIdentifier, serviceIdentifier, enclaveIdentifier),
 		)
@@ -21,7 +21,9 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/oci_artifacts"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	command_args_run "github.com/kurtosis-tech/kurtosis/cli/cli/command_args/run"
@@ -31,8 +33,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/portal_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/registry_credentials_getter"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -106,6 +110,9 @@ const (
 	nonBlockingModeFlagKey = "non-blocking-tasks"
 	defaultBlockingMode    = "false"
 
+	registryCredentialsFlagKey = "registry-credentials"
+	defaultRegistryCredentials = ""
+
 	httpProtocolRegexStr = "^(http|https)://"
 )
 
@@ -117,7 +124,8 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 	LongDescription: "Run a Starlark script or runnable package (" + user_support_constants.StarlarkPackagesReferenceURL + ") in " +
 		"an enclave. For a script we expect a path to a " + starlarkExtension + " file. For a runnable package we expect " +
 		"either a path to a local runnable package directory, or a path to the " + kurtosisYMLFilePath + " file in the package, or the locator URL (" + user_support_constants.StarlarkLocatorsReferenceURL +
-		") to a remote runnable package on Github. If the '" + enclaveIdentifierFlagKey + "' flag argument " +
+		") to a remote runnable package on Github, or an '" + oci_artifacts.OciLocatorPrefix + "' locator to a runnable package published to an OCI registry " +
+		"(see 'kurtosis " + command_str_consts.PackageCmdStr + " " + command_str_consts.PackagePushCmdStr + "'). If the '" + enclaveIdentifierFlagKey + "' flag argument " +
 		"is provided, Kurtosis will run the script inside the specified enclave or create it if it doesn't exist. If no '" +
 		enclaveIdentifierFlagKey + "' flag param is provided, Kurtosis will create a new enclave with a random name.",
 	Flags: []*flags.FlagConfig{
@@ -209,10 +217,18 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 		},
 		{
 			Key:     nonBlockingModeFlagKey,
-			Usage:   "If set, Kurtosis will not block on removing services from tasks from run_sh and run_python instructions. These services will remain and must be manually cleaned up.",
+			Usage:   "If set, Kurtosis will not block on removing the containers of tasks from run_sh and run_python instructions once they exit. They are removed in the background instead.",
 			Type:    flags.FlagType_Bool,
 			Default: defaultBlockingMode,
 		},
+		{
+			Key: registryCredentialsFlagKey,
+			Usage: fmt.Sprintf("Comma separated registries whose credentials, read from the Docker config, are sent to the enclave "+
+				"so that it can pull the private OCI packages hosted on them, i.e. '--%s ghcr.io,registry.example.com'. "+
+				"No credentials are sent by default.", registryCredentialsFlagKey),
+			Type:    flags.FlagType_String,
+			Default: defaultRegistryCredentials,
+		},
 	},
 	Args: []*args.ArgConfig{
 		// TODO add a `Usage` description here when ArgConfig supports it
@@ -278,6 +294,20 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the image-download using flag key '%s'", imageDownloadFlagKey)
 	}
 
+	gitHostConfigs, err := kurtosis_config_getter.GetGitHostConfigs()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the git hosts from the Kurtosis config")
+	}
+
+	registriesStr, err := flags.GetString(registryCredentialsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the registries using flag key '%s'", registryCredentialsFlagKey)
+	}
+	registryCredentials, err := registry_credentials_getter.GetRegistryCredentials(registry_credentials_getter.ParseRegistries(registriesStr))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the registry credentials")
+	}
+
 	showFullUuids, err := flags.GetBool(fullUuidsFlagKey)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
@@ -342,6 +372,8 @@ func run(
 		starlark_run_config.WithSerializedParams(packageArgs),
 		starlark_run_config.WithImageDownloadMode(*imageDownload),
 		starlark_run_config.WithNonBlockingMode(nonBlockingMode),
+		starlark_run_config.WithGitHostConfigs(gitHostConfigs),
+		starlark_run_config.WithRegistryCredentials(registryCredentials),
 	)
 
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
@@ -375,8 +407,7 @@ func run(
 		connect = kurtosis_core_rpc_api_bindings.Connect_NO_CONNECT
 	}
 
-	isRemotePackage := strings.HasPrefix(starlarkScriptOrPackagePath, githubDomainPrefix)
-	if isRemotePackage {
+	if isRemotePackageLocator(starlarkScriptOrPackagePath) {
 		responseLineChan, cancelFunc, errRunningKurtosis = executeRemotePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, starlarkRunConfig)
 	} else {
 		fileOrDir, err := os.Stat(starlarkScriptOrPackagePath)
@@ -668,14 +699,42 @@ func isKurtosisYMLFileInPackageDir(fileInfo os.FileInfo, kurtosisYMLFilePath str
 }
 
 func scriptPathValidation(scriptPath string) (error, bool) {
-	// if it's a Github path we don't validate further, the APIC will do it for us
-	if strings.HasPrefix(scriptPath, githubDomainPrefix) {
+	// if it's a git repository path or an OCI artifact we don't validate further, the APIC will do it for us
+	if isRemotePackageLocator(scriptPath) {
 		return nil, file_system_path_arg.DoNotContinueWithDefaultValidation
 	}
+	// a path that doesn't exist but looks like a locator is most likely a package of a git host missing from the config
+	if _, err := os.Stat(scriptPath); err != nil {
+		if parsedGitUrl, parseErr := shared_utils.ParseGitURL(scriptPath); parseErr == nil {
+			return stacktrace.NewError("'%v' doesn't exist on disk. If it's a package hosted on '%v', add that git host to the 'git-hosts' section of the Kurtosis config", scriptPath, parsedGitUrl.GetHost()), file_system_path_arg.DoNotContinueWithDefaultValidation
+		}
+	}
 
 	return nil, file_system_path_arg.ContinueWithDefaultValidation
 }
 
+// isRemotePackageLocator returns true if the path points to a package hosted on GitHub, on a git host configured in the
+// Kurtosis config or in an OCI registry; a path existing on disk is always treated as local. Other git hosts aren't
+// recognized as a mistyped local path like 'my.package/main.star' would look like a locator
+func isRemotePackageLocator(starlarkScriptOrPackagePath string) bool {
+	if strings.HasPrefix(starlarkScriptOrPackagePath, githubDomainPrefix) || oci_artifacts.IsOciLocator(starlarkScriptOrPackagePath) {
+		return true
+	}
+	if _, err := os.Stat(starlarkScriptOrPackagePath); err == nil {
+		return false
+	}
+	parsedGitUrl, err := shared_utils.ParseGitURL(starlarkScriptOrPackagePath)
+	if err != nil {
+		return false
+	}
+	isConfiguredGitHost, err := kurtosis_config_getter.IsConfiguredGitHost(parsedGitUrl.GetHost())
+	if err != nil {
+		logrus.Warnf("An error occurred checking whether '%v' is a git host configured in the Kurtosis config, treating '%v' as a local path:\n%v", parsedGitUrl.GetHost(), starlarkScriptOrPackagePath, err)
+		return false
+	}
+	return isConfiguredGitHost
+}
+
 func validateSerializedArgs(serializedArgs string) error {
 	var result interface{}
 	var jsonError error
@@ -0,0 +1,139 @@
+package cp
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifierArgKey        = "service"
+	isServiceIdentifierArgOptional = false
+	isServiceIdentifierArgGreedy   = false
+
+	srcArgKey  = "src"
+	destArgKey = "dest"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	// an empty name makes the API container generate one for the uploaded files artifact
+	autoGeneratedArtifactName = ""
+)
+
+var ServiceCpCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ServiceCpCmdStr,
+	ShortDescription: "Copy files into a running service",
+	LongDescription: "Copies a local file or folder, or the contents of an existing files artifact, into the given " +
+		"absolute directory inside a running service. Local paths take precedence over files artifacts with the same " +
+		"name and are uploaded to the enclave as a temporary files artifact, removed once copied. Existing files at the " +
+		"destination are overwritten.",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifierArgKey,
+			enclaveIdentifierArgKey,
+			isServiceIdentifierArgOptional,
+			isServiceIdentifierArgGreedy,
+		),
+		{
+			Key: srcArgKey,
+		},
+		{
+			Key: destArgKey,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceIdentifier, err := args.GetNonGreedyArg(serviceIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifier value using key '%v'", serviceIdentifierArgKey)
+	}
+
+	src, err := args.GetNonGreedyArg(srcArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the source value using key '%v'", srcArgKey)
+	}
+
+	dest, err := args.GetNonGreedyArg(destArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the destination value using key '%v'", destArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	filesArtifactIdentifier, isUploadedFromLocalPath, err := getFilesArtifactIdentifierForSource(enclaveCtx, src)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred resolving source '%v' to a files artifact in enclave '%v'", src, enclaveIdentifier)
+	}
+
+	// the files artifact uploaded from a local path is only needed for the copy, so it doesn't outlive it
+	if err = enclaveCtx.CopyFilesArtifactToService(ctx, serviceIdentifier, filesArtifactIdentifier, dest, isUploadedFromLocalPath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying '%v' to '%v' on service '%v' in enclave '%v'", src, dest, serviceIdentifier, enclaveIdentifier)
+	}
+	logrus.Infof("Copied '%v' to '%v' on service '%v'", src, dest, serviceIdentifier)
+	return nil
+}
+
+// getFilesArtifactIdentifierForSource uploads the source as a new files artifact if it's a local path, otherwise
+// treats it as the identifier of a files artifact already present in the enclave
+// It returns true along with the identifier if the source was uploaded
+func getFilesArtifactIdentifierForSource(enclaveCtx *enclaves.EnclaveContext, src string) (string, bool, error) {
+	if _, err := os.Stat(src); err != nil {
+		if os.IsNotExist(err) {
+			return src, false, nil
+		}
+		return "", false, stacktrace.Propagate(err, "An error occurred checking whether '%v' is a local path", src)
+	}
+	filesArtifactUuid, filesArtifactName, err := enclaveCtx.UploadFiles(src, autoGeneratedArtifactName)
+	if err != nil {
+		return "", false, stacktrace.Propagate(err, "An error occurred uploading local path '%v'", src)
+	}
+	logrus.Debugf("Uploaded local path '%v' as files artifact '%v'", src, filesArtifactName)
+	return string(filesArtifactUuid), true, nil
+}
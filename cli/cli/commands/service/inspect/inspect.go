@@ -41,6 +41,7 @@ const (
 	serviceNameTitleName           = "Name"
 	serviceUUIDTitleName           = "UUID"
 	serviceStatusTitleName         = "Status"
+	serviceRestartsTitleName       = "Restarts"
 	serviceImageTitleName          = "Image"
 	servicePortsTitleName          = "Ports"
 	serviceEntrypointArgsTitleName = "ENTRYPOINT"
@@ -132,7 +133,7 @@ func PrintServiceInspect(ctx context.Context, kurtosisBackend backend_interface.
 		serviceMap := map[string]bool{
 			serviceIdentifier: true,
 		}
-		userServices, err = user_services.GetUserServiceInfoMapFromAPIContainer(ctx, enclaveInfo, serviceMap)
+		userServices, err = user_services.GetUserServiceInfoMapFromAPIContainer(ctx, kurtosisCtx, enclaveInfo, serviceMap)
 		if err != nil {
 			return stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
 		}
@@ -154,6 +155,7 @@ func PrintServiceInspect(ctx context.Context, kurtosisBackend backend_interface.
 	serviceStatus := userService.GetServiceStatus()
 	serviceStatusStr := service_status_stringifier.ServiceStatusStringifier(serviceStatus)
 	out.PrintOutLn(fmt.Sprintf("%s: %s", serviceStatusTitleName, serviceStatusStr))
+	out.PrintOutLn(fmt.Sprintf("%s: %d", serviceRestartsTitleName, userService.GetContainer().GetRestartCount()))
 
 	out.PrintOutLn(fmt.Sprintf("%s: %s", serviceImageTitleName, userService.GetContainer().GetImageName()))
 
@@ -34,6 +34,10 @@ func run(_ context.Context, _ *flags.ParsedFlags, _ *args.ParsedArgs) error {
 	if err != nil {
 		return stacktrace.Propagate(err, "tried fetching the current Kurtosis context but failed, we can't switch clusters without this information. This is a bug in Kurtosis")
 	}
+	// The enclave manager UI isn't served by engines requiring authentication
+	if currentKurtosisContext.GetEngineAuth() != nil {
+		return stacktrace.NewError("The Kurtosis Web UI doesn't support engine authentication yet, and the current Kurtosis context '%v' authenticates to its engine; use the CLI instead", currentKurtosisContext.GetName())
+	}
 	if store.IsRemote(currentKurtosisContext) {
 		if err := multi_os_command_executor.OpenFile(user_support_constants.KurtosisCloudLink); err != nil {
 			return stacktrace.Propagate(err, "An error occurred while opening the Kurtosis Cloud Web UI")
@@ -28,6 +28,13 @@ const (
 	DefaultDomain = ""
 
 	DefaultLogRetentionPeriod = "168h"
+
+	// An empty default enclave resource quota means that the enclaves aren't capped unless they ask for it
+	DefaultEnclaveResourceQuota = ""
+
+	// An empty engine auth config filepath means that the engine is started with the auth config the engine of the
+	// cluster was last started with, if any, so that starting it implicitly never turns its authentication off
+	DefaultEngineAuthConfigFilepath = ""
 )
 
 var DefaultApiContainerLogLevel = logrus.DebugLevel
@@ -0,0 +1,52 @@
+package enclave_labels
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	LabelKeyValueDelimiter     = "="
+	LabelDeclarationsDelimiter = ","
+
+	expectedNumberKeyValueComponentsInLabelDeclaration = 2
+)
+
+// ParseEnclaveLabelsStr parses a string of the form "KEY1=VALUE1,KEY2=VALUE2" into a map of enclave labels
+func ParseEnclaveLabelsStr(labelsStr string) (map[string]string, error) {
+	result := map[string]string{}
+	if labelsStr == "" {
+		return result, nil
+	}
+
+	allLabelDeclarationStrs := strings.Split(labelsStr, LabelDeclarationsDelimiter)
+	for _, labelDeclarationStr := range allLabelDeclarationStrs {
+		if len(strings.TrimSpace(labelDeclarationStr)) == 0 {
+			continue
+		}
+
+		labelKeyValueComponents := strings.SplitN(labelDeclarationStr, LabelKeyValueDelimiter, expectedNumberKeyValueComponentsInLabelDeclaration)
+		if len(labelKeyValueComponents) < expectedNumberKeyValueComponentsInLabelDeclaration {
+			return nil, stacktrace.NewError("Label declaration string '%v' must be of the form KEY1%vVALUE1", labelDeclarationStr, LabelKeyValueDelimiter)
+		}
+		key := strings.TrimSpace(labelKeyValueComponents[0])
+		value := strings.TrimSpace(labelKeyValueComponents[1])
+		if key == "" {
+			return nil, stacktrace.NewError("Label declaration string '%v' has an empty key", labelDeclarationStr)
+		}
+
+		preexistingValue, found := result[key]
+		if found {
+			return nil, stacktrace.NewError(
+				"Cannot declare label '%v' assigned to value '%v' because the key has previously been assigned to value '%v'",
+				key,
+				value,
+				preexistingValue,
+			)
+		}
+
+		result[key] = value
+	}
+	return result, nil
+}
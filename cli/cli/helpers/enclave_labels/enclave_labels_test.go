@@ -0,0 +1,28 @@
+package enclave_labels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnclaveLabelsStr(t *testing.T) {
+	labels, err := ParseEnclaveLabelsStr("team=infra, env=ci,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"team": "infra", "env": "ci"}, labels)
+
+	labels, err = ParseEnclaveLabelsStr("")
+	require.NoError(t, err)
+	require.Empty(t, labels)
+}
+
+func TestParseEnclaveLabelsStr_InvalidDeclarations(t *testing.T) {
+	_, err := ParseEnclaveLabelsStr("team")
+	require.Error(t, err)
+
+	_, err = ParseEnclaveLabelsStr("=infra")
+	require.Error(t, err)
+
+	_, err = ParseEnclaveLabelsStr("team=infra,team=core")
+	require.Error(t, err)
+}
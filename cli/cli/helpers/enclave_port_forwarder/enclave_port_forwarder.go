@@ -0,0 +1,240 @@
+package enclave_port_forwarder
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"net"
+	"sort"
+	"strconv"
+)
+
+const (
+	tcpTransportProtocolName = "TCP"
+	udpTransportProtocolName = "UDP"
+)
+
+// EnclavePortForwarder keeps a local port bound to every port of every service in an enclave
+// Local ports are assigned by the port scheme and remembered in the mapping file, so a service keeps its local ports
+// when it gets restarted, removed and re-added, or when the forwarder itself is restarted
+type EnclavePortForwarder struct {
+	scheme          *PortScheme
+	mappingFilepath string
+	mapping         *PortForwardsMapping
+
+	// keyed by service name, then port ID
+	proxies map[string]map[string]portProxy
+}
+
+func NewEnclavePortForwarder(enclaveName string, scheme *PortScheme, mappingFilepath string) (*EnclavePortForwarder, error) {
+	mapping, err := readPortForwardsMapping(mappingFilepath, enclaveName, scheme)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the existing port forwards of enclave '%v'", enclaveName)
+	}
+	// nothing is forwarded until the first sync
+	for _, servicePortForwards := range mapping.Services {
+		servicePortForwards.IsInEnclave = false
+		for _, portForward := range servicePortForwards.Ports {
+			portForward.RemoteAddress = noRemoteAddress
+		}
+	}
+	return &EnclavePortForwarder{
+		scheme:          scheme,
+		mappingFilepath: mappingFilepath,
+		mapping:         mapping,
+		proxies:         map[string]map[string]portProxy{},
+	}, nil
+}
+
+func (forwarder *EnclavePortForwarder) GetMapping() *PortForwardsMapping {
+	return forwarder.mapping
+}
+
+// Sync makes the forwarded ports match the services currently in the enclave and writes the mapping file
+// Ports that can't be forwarded are logged and retried on the next sync rather than failing the whole sync
+func (forwarder *EnclavePortForwarder) Sync(serviceContexts map[services.ServiceName]*services.ServiceContext) error {
+	for serviceName, servicePortForwards := range forwarder.mapping.Services {
+		if _, found := serviceContexts[services.ServiceName(serviceName)]; found {
+			continue
+		}
+		if servicePortForwards.IsInEnclave {
+			logrus.Infof("Service '%v' was removed from the enclave; releasing its local ports", serviceName)
+		}
+		forwarder.closeServiceProxies(serviceName)
+		servicePortForwards.IsInEnclave = false
+		for _, portForward := range servicePortForwards.Ports {
+			portForward.RemoteAddress = noRemoteAddress
+		}
+	}
+
+	serviceNames := []string{}
+	for serviceName := range serviceContexts {
+		serviceNames = append(serviceNames, string(serviceName))
+	}
+	// sorted so that services found at the same time always get the same slots
+	sort.Strings(serviceNames)
+	for _, serviceName := range serviceNames {
+		forwarder.syncService(serviceContexts[services.ServiceName(serviceName)])
+	}
+
+	if err := forwarder.mapping.writeToFile(forwarder.mappingFilepath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the port forwards mapping to '%v'", forwarder.mappingFilepath)
+	}
+	return nil
+}
+
+// Close releases all the local ports; the mapping file is kept so the same ports are used next time
+func (forwarder *EnclavePortForwarder) Close() {
+	for serviceName := range forwarder.proxies {
+		forwarder.closeServiceProxies(serviceName)
+	}
+}
+
+func (forwarder *EnclavePortForwarder) syncService(serviceCtx *services.ServiceContext) {
+	serviceName := string(serviceCtx.GetServiceName())
+	servicePortForwards, found := forwarder.mapping.Services[serviceName]
+	if !found {
+		servicePortForwards = &ServicePortForwards{
+			Slot:        forwarder.getFreeSlot(),
+			IsInEnclave: true,
+			Ports:       map[string]*PortForward{},
+		}
+		forwarder.mapping.Services[serviceName] = servicePortForwards
+		logrus.Infof("Service '%v' was added to the enclave; forwarding its ports", serviceName)
+	}
+	servicePortForwards.IsInEnclave = true
+	if _, found := forwarder.proxies[serviceName]; !found {
+		forwarder.proxies[serviceName] = map[string]portProxy{}
+	}
+
+	portIds := []string{}
+	for portId := range serviceCtx.GetPrivatePorts() {
+		portIds = append(portIds, portId)
+	}
+	sort.Strings(portIds)
+	for _, portId := range portIds {
+		privatePortSpec := serviceCtx.GetPrivatePorts()[portId]
+		remoteAddress := noRemoteAddress
+		if publicPortSpec, found := serviceCtx.GetPublicPorts()[portId]; found && serviceCtx.GetMaybePublicIPAddress() != "" {
+			remoteAddress = net.JoinHostPort(serviceCtx.GetMaybePublicIPAddress(), strconv.Itoa(int(publicPortSpec.GetNumber())))
+		}
+		if err := forwarder.syncPort(serviceName, servicePortForwards, portId, privatePortSpec, remoteAddress); err != nil {
+			logrus.Warnf("Port '%v' of service '%v' couldn't be forwarded; it will be retried:\n%v", portId, serviceName, err)
+		}
+	}
+
+	for portId := range forwarder.proxies[serviceName] {
+		if _, found := serviceCtx.GetPrivatePorts()[portId]; !found {
+			forwarder.proxies[serviceName][portId].close()
+			delete(forwarder.proxies[serviceName], portId)
+			servicePortForwards.Ports[portId].RemoteAddress = noRemoteAddress
+		}
+	}
+}
+
+func (forwarder *EnclavePortForwarder) syncPort(
+	serviceName string,
+	servicePortForwards *ServicePortForwards,
+	portId string,
+	privatePortSpec *services.PortSpec,
+	remoteAddress string,
+) error {
+	transportProtocolName, err := getTransportProtocolName(privatePortSpec.GetTransportProtocol())
+	if err != nil {
+		return stacktrace.Propagate(err, "Port '%v' can't be forwarded", portId)
+	}
+	portForward, found := servicePortForwards.Ports[portId]
+	if found && portForward.TransportProtocol != transportProtocolName {
+		// the port keeps its local port number but has to be bound again with the new protocol
+		if proxy, found := forwarder.proxies[serviceName][portId]; found {
+			proxy.close()
+			delete(forwarder.proxies[serviceName], portId)
+		}
+		portForward.TransportProtocol = transportProtocolName
+	}
+	if !found {
+		localPort, err := forwarder.getFreeLocalPort(servicePortForwards)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred assigning a local port to port '%v'", portId)
+		}
+		portForward = &PortForward{
+			LocalPort:           localPort,
+			TransportProtocol:   transportProtocolName,
+			ApplicationProtocol: privatePortSpec.GetMaybeApplicationProtocol(),
+			RemoteAddress:       noRemoteAddress,
+		}
+		servicePortForwards.Ports[portId] = portForward
+	}
+
+	proxy, found := forwarder.proxies[serviceName][portId]
+	if !found {
+		proxy, err = newPortProxy(portForward.TransportProtocol, portForward.LocalPort, remoteAddress)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred binding local port '%v'", portForward.LocalPort)
+		}
+		forwarder.proxies[serviceName][portId] = proxy
+	}
+	if portForward.RemoteAddress != remoteAddress {
+		logrus.Infof("Forwarding local port '%v' to port '%v' of service '%v' at '%v'", portForward.LocalPort, portId, serviceName, remoteAddress)
+	}
+	proxy.setRemoteAddress(remoteAddress)
+	portForward.RemoteAddress = remoteAddress
+	return nil
+}
+
+func (forwarder *EnclavePortForwarder) closeServiceProxies(serviceName string) {
+	for _, proxy := range forwarder.proxies[serviceName] {
+		proxy.close()
+	}
+	delete(forwarder.proxies, serviceName)
+}
+
+func (forwarder *EnclavePortForwarder) getFreeSlot() uint16 {
+	usedSlots := map[uint16]bool{}
+	for _, servicePortForwards := range forwarder.mapping.Services {
+		usedSlots[servicePortForwards.Slot] = true
+	}
+	slot := uint16(0)
+	for usedSlots[slot] {
+		slot++
+	}
+	return slot
+}
+
+func (forwarder *EnclavePortForwarder) getFreeLocalPort(servicePortForwards *ServicePortForwards) (uint16, error) {
+	usedLocalPorts := map[uint16]bool{}
+	for _, portForward := range servicePortForwards.Ports {
+		usedLocalPorts[portForward.LocalPort] = true
+	}
+	for portIndex := uint16(0); portIndex < forwarder.scheme.GetPortsPerService(); portIndex++ {
+		localPort, err := forwarder.scheme.GetLocalPort(servicePortForwards.Slot, portIndex)
+		if err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred getting the local port at index '%v' of slot '%v'", portIndex, servicePortForwards.Slot)
+		}
+		if !usedLocalPorts[localPort] {
+			return localPort, nil
+		}
+	}
+	return 0, stacktrace.NewError("All the '%v' local ports of slot '%v' are used; increase the number of ports per service", forwarder.scheme.GetPortsPerService(), servicePortForwards.Slot)
+}
+
+func newPortProxy(transportProtocolName string, localPort uint16, remoteAddress string) (portProxy, error) {
+	switch transportProtocolName {
+	case tcpTransportProtocolName:
+		return newTcpPortProxy(localPort, remoteAddress)
+	case udpTransportProtocolName:
+		return newUdpPortProxy(localPort, remoteAddress)
+	}
+	return nil, stacktrace.NewError("Unsupported transport protocol '%v'", transportProtocolName)
+}
+
+func getTransportProtocolName(transportProtocol services.TransportProtocol) (string, error) {
+	switch transportProtocol {
+	case services.TransportProtocol_TCP:
+		return tcpTransportProtocolName, nil
+	case services.TransportProtocol_UDP:
+		return udpTransportProtocolName, nil
+	}
+	return "", stacktrace.NewError("Only TCP and UDP ports can be forwarded but got transport protocol '%v'", fmt.Sprint(transportProtocol))
+}
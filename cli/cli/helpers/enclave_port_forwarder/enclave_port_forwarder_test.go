@@ -0,0 +1,139 @@
+package enclave_port_forwarder
+
+import (
+	"bufio"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"path"
+	"strconv"
+	"testing"
+)
+
+const (
+	testEnclaveName  = "test-enclave"
+	testServiceName  = "test-service"
+	testPortId       = "http"
+	testPortsPerSlot = 2
+
+	privatePortNum = 8080
+)
+
+var noServiceClient kurtosis_core_rpc_api_bindings.ApiContainerServiceClient = nil
+
+func TestEnclavePortForwarder_KeepsLocalPortWhenServiceMoves(t *testing.T) {
+	scheme := getTestPortScheme(t)
+	mappingFilepath := path.Join(t.TempDir(), "mapping.json")
+	forwarder, err := NewEnclavePortForwarder(testEnclaveName, scheme, mappingFilepath)
+	require.NoError(t, err)
+	defer forwarder.Close()
+
+	firstServer := startEchoServer(t, "first:")
+	require.NoError(t, forwarder.Sync(getTestServiceContexts(firstServer)))
+	localPort := forwarder.GetMapping().Services[testServiceName].Ports[testPortId].LocalPort
+	require.Equal(t, scheme.GetBasePort(), localPort)
+	require.Equal(t, "first:hello", sendLine(t, localPort, "hello"))
+
+	// e.g. the service got restarted and got a new public port
+	secondServer := startEchoServer(t, "second:")
+	require.NoError(t, forwarder.Sync(getTestServiceContexts(secondServer)))
+	require.Equal(t, localPort, forwarder.GetMapping().Services[testServiceName].Ports[testPortId].LocalPort)
+	require.Equal(t, "second:hello", sendLine(t, localPort, "hello"))
+
+	// the service got removed
+	require.NoError(t, forwarder.Sync(map[services.ServiceName]*services.ServiceContext{}))
+	require.False(t, forwarder.GetMapping().Services[testServiceName].IsInEnclave)
+	_, err = net.Dial(tcpNetwork, getLocalAddress(localPort))
+	require.Error(t, err)
+}
+
+func TestEnclavePortForwarder_ReusesLocalPortsFromMappingFile(t *testing.T) {
+	scheme := getTestPortScheme(t)
+	mappingFilepath := path.Join(t.TempDir(), "mapping.json")
+	server := startEchoServer(t, "")
+
+	otherServiceContexts := map[services.ServiceName]*services.ServiceContext{
+		"another-service": getTestServiceContext("another-service", server),
+	}
+	forwarder, err := NewEnclavePortForwarder(testEnclaveName, scheme, mappingFilepath)
+	require.NoError(t, err)
+	require.NoError(t, forwarder.Sync(otherServiceContexts))
+	require.NoError(t, forwarder.Sync(getTestServiceContexts(server)))
+	localPort := forwarder.GetMapping().Services[testServiceName].Ports[testPortId].LocalPort
+	forwarder.Close()
+
+	// a new forwarder seeing the services in another order gives them the same ports
+	forwarder, err = NewEnclavePortForwarder(testEnclaveName, scheme, mappingFilepath)
+	require.NoError(t, err)
+	defer forwarder.Close()
+	require.NoError(t, forwarder.Sync(getTestServiceContexts(server)))
+	require.Equal(t, localPort, forwarder.GetMapping().Services[testServiceName].Ports[testPortId].LocalPort)
+	require.Equal(t, "hello", sendLine(t, localPort, "hello"))
+}
+
+func getTestPortScheme(t *testing.T) *PortScheme {
+	// ask the OS for a free port to use as base port, the next ones are very likely free too
+	listener, err := net.Listen(tcpNetwork, getLocalAddress(0))
+	require.NoError(t, err)
+	basePort := uint16(listener.Addr().(*net.TCPAddr).Port)
+	require.NoError(t, listener.Close())
+	scheme, err := NewPortScheme(basePort, testPortsPerSlot)
+	require.NoError(t, err)
+	return scheme
+}
+
+func getTestServiceContexts(server net.Listener) map[services.ServiceName]*services.ServiceContext {
+	return map[services.ServiceName]*services.ServiceContext{
+		testServiceName: getTestServiceContext(testServiceName, server),
+	}
+}
+
+func getTestServiceContext(serviceName services.ServiceName, server net.Listener) *services.ServiceContext {
+	publicPortNum := uint16(server.Addr().(*net.TCPAddr).Port)
+	return services.NewServiceContext(
+		noServiceClient,
+		serviceName,
+		services.ServiceUUID(serviceName),
+		"10.0.0.1",
+		map[string]*services.PortSpec{testPortId: services.NewPortSpec(privatePortNum, services.TransportProtocol_TCP, "")},
+		localhostIpAddress,
+		map[string]*services.PortSpec{testPortId: services.NewPortSpec(publicPortNum, services.TransportProtocol_TCP, "")},
+		nil,
+	)
+}
+
+// startEchoServer replies to every line with the line prefixed by the given prefix
+func startEchoServer(t *testing.T, prefix string) net.Listener {
+	server, err := net.Listen(tcpNetwork, getLocalAddress(0))
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+	go func() {
+		for {
+			conn, err := server.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				scanner := bufio.NewScanner(conn)
+				for scanner.Scan() {
+					_, _ = io.WriteString(conn, prefix+scanner.Text()+"\n")
+				}
+			}()
+		}
+	}()
+	return server
+}
+
+func sendLine(t *testing.T, localPort uint16, line string) string {
+	conn, err := net.Dial(tcpNetwork, net.JoinHostPort(localhostIpAddress, strconv.Itoa(int(localPort))))
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = io.WriteString(conn, line+"\n")
+	require.NoError(t, err)
+	reply, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+	return reply[:len(reply)-1]
+}
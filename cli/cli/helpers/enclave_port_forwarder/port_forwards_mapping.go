@@ -0,0 +1,91 @@
+package enclave_port_forwarder
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+	"path"
+)
+
+const (
+	mappingFilePerms    = 0644
+	mappingDirPerms     = 0755
+	mappingFileIndent   = "  "
+	noMappingFilePrefix = ""
+)
+
+// PortForwardsMapping is written to the mapping file so other tools can find the local port of every service port
+// Fields are public because it's needed for JSON encoding
+type PortForwardsMapping struct {
+	EnclaveName     string                          `json:"enclave_name"`
+	BasePort        uint16                          `json:"base_port"`
+	PortsPerService uint16                          `json:"ports_per_service"`
+	Services        map[string]*ServicePortForwards `json:"services"`
+}
+
+type ServicePortForwards struct {
+	// the slot of the service in the port scheme; kept for removed services so they get the same ports back
+	Slot uint16 `json:"slot"`
+	// false if the service isn't in the enclave anymore; its local ports aren't bound then
+	IsInEnclave bool                    `json:"is_in_enclave"`
+	Ports       map[string]*PortForward `json:"ports"`
+}
+
+type PortForward struct {
+	LocalPort           uint16 `json:"local_port"`
+	TransportProtocol   string `json:"transport_protocol"`
+	ApplicationProtocol string `json:"application_protocol,omitempty"`
+	// the address the local port is currently forwarded to; empty if the service isn't reachable, e.g. it's stopped
+	RemoteAddress string `json:"remote_address"`
+}
+
+func newPortForwardsMapping(enclaveName string, scheme *PortScheme) *PortForwardsMapping {
+	return &PortForwardsMapping{
+		EnclaveName:     enclaveName,
+		BasePort:        scheme.GetBasePort(),
+		PortsPerService: scheme.GetPortsPerService(),
+		Services:        map[string]*ServicePortForwards{},
+	}
+}
+
+// readPortForwardsMapping returns an empty mapping if the file doesn't exist, belongs to another enclave or was
+// written with another port scheme
+func readPortForwardsMapping(mappingFilepath string, enclaveName string, scheme *PortScheme) (*PortForwardsMapping, error) {
+	mappingFileContents, err := os.ReadFile(mappingFilepath)
+	if os.IsNotExist(err) {
+		return newPortForwardsMapping(enclaveName, scheme), nil
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading port forwards mapping file '%v'", mappingFilepath)
+	}
+	mapping := newPortForwardsMapping(enclaveName, scheme)
+	if err = json.Unmarshal(mappingFileContents, mapping); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing port forwards mapping file '%v'", mappingFilepath)
+	}
+	if mapping.EnclaveName != enclaveName || mapping.BasePort != scheme.GetBasePort() || mapping.PortsPerService != scheme.GetPortsPerService() {
+		return newPortForwardsMapping(enclaveName, scheme), nil
+	}
+	if mapping.Services == nil {
+		mapping.Services = map[string]*ServicePortForwards{}
+	}
+	return mapping, nil
+}
+
+func (mapping *PortForwardsMapping) writeToFile(mappingFilepath string) error {
+	serializedMapping, err := json.MarshalIndent(mapping, noMappingFilePrefix, mappingFileIndent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the port forwards mapping")
+	}
+	if err = os.MkdirAll(path.Dir(mappingFilepath), mappingDirPerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the directory of port forwards mapping file '%v'", mappingFilepath)
+	}
+	// written to a temporary file first so readers never see a half-written mapping
+	tmpMappingFilepath := mappingFilepath + ".tmp"
+	if err = os.WriteFile(tmpMappingFilepath, serializedMapping, mappingFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing port forwards mapping file '%v'", tmpMappingFilepath)
+	}
+	if err = os.Rename(tmpMappingFilepath, mappingFilepath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred moving port forwards mapping file '%v' to '%v'", tmpMappingFilepath, mappingFilepath)
+	}
+	return nil
+}
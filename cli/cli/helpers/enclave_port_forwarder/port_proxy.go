@@ -0,0 +1,237 @@
+package enclave_port_forwarder
+
+import (
+	"errors"
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	localhostIpAddress = "127.0.0.1"
+	tcpNetwork         = "tcp"
+	udpNetwork         = "udp"
+
+	noRemoteAddress = ""
+
+	dialTimeout = 5 * time.Second
+
+	udpMaxDatagramSize = 65535
+	// UDP has no connections, so a client is forgotten once it hasn't sent or received anything for this long
+	udpClientIdleTimeout = 2 * time.Minute
+)
+
+// portProxy keeps a local port bound and forwards what it receives to the remote address it's currently pointed at
+// The remote address can change while the local port stays the same, e.g. when a service gets restarted
+type portProxy interface {
+	setRemoteAddress(remoteAddress string)
+	close()
+}
+
+type remoteAddressHolder struct {
+	mutex         sync.RWMutex
+	remoteAddress string
+}
+
+func (holder *remoteAddressHolder) setRemoteAddress(remoteAddress string) {
+	holder.mutex.Lock()
+	defer holder.mutex.Unlock()
+	holder.remoteAddress = remoteAddress
+}
+
+func (holder *remoteAddressHolder) getRemoteAddress() string {
+	holder.mutex.RLock()
+	defer holder.mutex.RUnlock()
+	return holder.remoteAddress
+}
+
+type tcpPortProxy struct {
+	*remoteAddressHolder
+	listener net.Listener
+}
+
+func newTcpPortProxy(localPort uint16, remoteAddress string) (*tcpPortProxy, error) {
+	listener, err := net.Listen(tcpNetwork, getLocalAddress(localPort))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listening on local TCP port '%v'", localPort)
+	}
+	proxy := &tcpPortProxy{
+		remoteAddressHolder: &remoteAddressHolder{
+			mutex:         sync.RWMutex{},
+			remoteAddress: remoteAddress,
+		},
+		listener: listener,
+	}
+	go proxy.acceptConnections()
+	return proxy, nil
+}
+
+func (proxy *tcpPortProxy) close() {
+	if err := proxy.listener.Close(); err != nil {
+		logrus.Debugf("An error occurred closing TCP listener on '%v':\n%v", proxy.listener.Addr(), err)
+	}
+}
+
+func (proxy *tcpPortProxy) acceptConnections() {
+	for {
+		localConn, err := proxy.listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return
+		}
+		if err != nil {
+			logrus.Debugf("An error occurred accepting a connection on '%v':\n%v", proxy.listener.Addr(), err)
+			continue
+		}
+		go proxy.forwardConnection(localConn)
+	}
+}
+
+func (proxy *tcpPortProxy) forwardConnection(localConn net.Conn) {
+	defer localConn.Close()
+	remoteAddress := proxy.getRemoteAddress()
+	if remoteAddress == noRemoteAddress {
+		logrus.Debugf("Dropping connection on '%v' as the service isn't reachable", proxy.listener.Addr())
+		return
+	}
+	remoteConn, err := net.DialTimeout(tcpNetwork, remoteAddress, dialTimeout)
+	if err != nil {
+		logrus.Debugf("An error occurred connecting to '%v' to forward a connection on '%v':\n%v", remoteAddress, proxy.listener.Addr(), err)
+		return
+	}
+	defer remoteConn.Close()
+
+	copyDone := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(remoteConn, localConn)
+		copyDone <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(localConn, remoteConn)
+		copyDone <- struct{}{}
+	}()
+	// as soon as one side is done both connections are closed, which ends the other copy
+	<-copyDone
+}
+
+type udpPortProxy struct {
+	*remoteAddressHolder
+	localConn *net.UDPConn
+
+	clientsMutex sync.Mutex
+	clients      map[string]*net.UDPConn
+}
+
+func newUdpPortProxy(localPort uint16, remoteAddress string) (*udpPortProxy, error) {
+	localAddress, err := net.ResolveUDPAddr(udpNetwork, getLocalAddress(localPort))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving local UDP address for port '%v'", localPort)
+	}
+	localConn, err := net.ListenUDP(udpNetwork, localAddress)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listening on local UDP port '%v'", localPort)
+	}
+	proxy := &udpPortProxy{
+		remoteAddressHolder: &remoteAddressHolder{
+			mutex:         sync.RWMutex{},
+			remoteAddress: remoteAddress,
+		},
+		localConn:    localConn,
+		clientsMutex: sync.Mutex{},
+		clients:      map[string]*net.UDPConn{},
+	}
+	go proxy.forwardDatagrams()
+	return proxy, nil
+}
+
+func (proxy *udpPortProxy) close() {
+	if err := proxy.localConn.Close(); err != nil {
+		logrus.Debugf("An error occurred closing UDP listener on '%v':\n%v", proxy.localConn.LocalAddr(), err)
+	}
+	proxy.clientsMutex.Lock()
+	defer proxy.clientsMutex.Unlock()
+	for _, remoteConn := range proxy.clients {
+		remoteConn.Close()
+	}
+}
+
+func (proxy *udpPortProxy) forwardDatagrams() {
+	buffer := make([]byte, udpMaxDatagramSize)
+	for {
+		numBytes, clientAddress, err := proxy.localConn.ReadFromUDP(buffer)
+		if errors.Is(err, net.ErrClosed) {
+			return
+		}
+		if err != nil {
+			logrus.Debugf("An error occurred reading from '%v':\n%v", proxy.localConn.LocalAddr(), err)
+			continue
+		}
+		remoteConn, err := proxy.getOrCreateRemoteConn(clientAddress)
+		if err != nil {
+			logrus.Debugf("Dropping datagram on '%v':\n%v", proxy.localConn.LocalAddr(), err)
+			continue
+		}
+		if _, err = remoteConn.Write(buffer[:numBytes]); err != nil {
+			logrus.Debugf("An error occurred forwarding a datagram from '%v' to '%v':\n%v", clientAddress, remoteConn.RemoteAddr(), err)
+		}
+	}
+}
+
+func (proxy *udpPortProxy) getOrCreateRemoteConn(clientAddress *net.UDPAddr) (*net.UDPConn, error) {
+	proxy.clientsMutex.Lock()
+	defer proxy.clientsMutex.Unlock()
+	remoteAddress := proxy.getRemoteAddress()
+	if remoteConn, found := proxy.clients[clientAddress.String()]; found {
+		// the service might have moved since this client was seen
+		if remoteConn.RemoteAddr().String() == remoteAddress {
+			return remoteConn, nil
+		}
+		remoteConn.Close()
+		delete(proxy.clients, clientAddress.String())
+	}
+	if remoteAddress == noRemoteAddress {
+		return nil, stacktrace.NewError("The service isn't reachable")
+	}
+	resolvedRemoteAddress, err := net.ResolveUDPAddr(udpNetwork, remoteAddress)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving remote UDP address '%v'", remoteAddress)
+	}
+	remoteConn, err := net.DialUDP(udpNetwork, nil, resolvedRemoteAddress)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to remote UDP address '%v'", remoteAddress)
+	}
+	proxy.clients[clientAddress.String()] = remoteConn
+	go proxy.forwardReplies(clientAddress, remoteConn)
+	return remoteConn, nil
+}
+
+func (proxy *udpPortProxy) forwardReplies(clientAddress *net.UDPAddr, remoteConn *net.UDPConn) {
+	defer func() {
+		proxy.clientsMutex.Lock()
+		defer proxy.clientsMutex.Unlock()
+		if proxy.clients[clientAddress.String()] == remoteConn {
+			delete(proxy.clients, clientAddress.String())
+		}
+		remoteConn.Close()
+	}()
+	buffer := make([]byte, udpMaxDatagramSize)
+	for {
+		if err := remoteConn.SetReadDeadline(time.Now().Add(udpClientIdleTimeout)); err != nil {
+			return
+		}
+		numBytes, err := remoteConn.Read(buffer)
+		if err != nil {
+			return
+		}
+		if _, err = proxy.localConn.WriteToUDP(buffer[:numBytes], clientAddress); err != nil {
+			logrus.Debugf("An error occurred forwarding a reply from '%v' to '%v':\n%v", remoteConn.RemoteAddr(), clientAddress, err)
+		}
+	}
+}
+
+func getLocalAddress(localPort uint16) string {
+	return fmt.Sprintf("%v:%v", localhostIpAddress, localPort)
+}
@@ -0,0 +1,45 @@
+package enclave_port_forwarder
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// PortScheme deterministically assigns local ports to service ports: every service gets a slot of consecutive
+// ports starting at basePort + slot * portsPerService, and each of its ports gets an index in that slot
+type PortScheme struct {
+	basePort        uint16
+	portsPerService uint16
+}
+
+func NewPortScheme(basePort uint16, portsPerService uint16) (*PortScheme, error) {
+	if basePort == 0 {
+		return nil, stacktrace.NewError("The base port must be greater than 0")
+	}
+	if portsPerService == 0 {
+		return nil, stacktrace.NewError("The number of ports per service must be greater than 0")
+	}
+	return &PortScheme{
+		basePort:        basePort,
+		portsPerService: portsPerService,
+	}, nil
+}
+
+func (scheme *PortScheme) GetBasePort() uint16 {
+	return scheme.basePort
+}
+
+func (scheme *PortScheme) GetPortsPerService() uint16 {
+	return scheme.portsPerService
+}
+
+func (scheme *PortScheme) GetLocalPort(serviceSlot uint16, portIndex uint16) (uint16, error) {
+	if portIndex >= scheme.portsPerService {
+		return 0, stacktrace.NewError("Port index '%v' doesn't fit in a slot of '%v' ports per service; increase the number of ports per service", portIndex, scheme.portsPerService)
+	}
+	localPort := uint(scheme.basePort) + uint(serviceSlot)*uint(scheme.portsPerService) + uint(portIndex)
+	if localPort > services.MaxPortNum {
+		return 0, stacktrace.NewError("Slot '%v' and port index '%v' give local port '%v' which is above the maximum port number '%v'; use a lower base port", serviceSlot, portIndex, localPort, services.MaxPortNum)
+	}
+	return uint16(localPort), nil
+}
@@ -0,0 +1,40 @@
+package enclave_port_forwarder
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetLocalPort(t *testing.T) {
+	scheme, err := NewPortScheme(40000, 10)
+	require.NoError(t, err)
+
+	localPort, err := scheme.GetLocalPort(0, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint16(40000), localPort)
+
+	localPort, err = scheme.GetLocalPort(3, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint16(40032), localPort)
+}
+
+func TestGetLocalPort_FailsForPortIndexOutsideOfSlot(t *testing.T) {
+	scheme, err := NewPortScheme(40000, 10)
+	require.NoError(t, err)
+
+	_, err = scheme.GetLocalPort(0, 10)
+	require.Error(t, err)
+}
+
+func TestGetLocalPort_FailsAboveMaxPort(t *testing.T) {
+	scheme, err := NewPortScheme(65530, 10)
+	require.NoError(t, err)
+
+	_, err = scheme.GetLocalPort(1, 0)
+	require.Error(t, err)
+}
+
+func TestNewPortScheme_FailsWithoutPortsPerService(t *testing.T) {
+	_, err := NewPortScheme(40000, 0)
+	require.Error(t, err)
+}
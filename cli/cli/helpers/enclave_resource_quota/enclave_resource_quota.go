@@ -0,0 +1,87 @@
+package enclave_resource_quota
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	QuotaKeyValueDelimiter     = "="
+	QuotaDeclarationsDelimiter = ","
+
+	CpuQuotaKey      = "cpu"
+	MemoryQuotaKey   = "memory"
+	ServicesQuotaKey = "services"
+	StorageQuotaKey  = "storage"
+
+	expectedNumberKeyValueComponentsInQuotaDeclaration = 2
+
+	quotaLimitBase    = 10
+	quotaLimitBitSize = 64
+)
+
+var allQuotaKeys = map[string]bool{
+	CpuQuotaKey:      true,
+	MemoryQuotaKey:   true,
+	ServicesQuotaKey: true,
+	StorageQuotaKey:  true,
+}
+
+// ParseEnclaveResourceQuotaStr parses a string of the form "cpu=2000,memory=4096,services=10,storage=10240" into an
+// enclave resource quota, where cpu is in millicores and memory and storage are in megabytes. The resources that
+// aren't declared aren't capped. Returns nil if the string is empty
+func ParseEnclaveResourceQuotaStr(quotaStr string) (*enclave.EnclaveResourceQuota, error) {
+	if strings.TrimSpace(quotaStr) == "" {
+		return nil, nil
+	}
+
+	limits := map[string]uint64{}
+	allQuotaDeclarationStrs := strings.Split(quotaStr, QuotaDeclarationsDelimiter)
+	for _, quotaDeclarationStr := range allQuotaDeclarationStrs {
+		if len(strings.TrimSpace(quotaDeclarationStr)) == 0 {
+			continue
+		}
+
+		quotaKeyValueComponents := strings.SplitN(quotaDeclarationStr, QuotaKeyValueDelimiter, expectedNumberKeyValueComponentsInQuotaDeclaration)
+		if len(quotaKeyValueComponents) < expectedNumberKeyValueComponentsInQuotaDeclaration {
+			return nil, stacktrace.NewError("Resource quota declaration string '%v' must be of the form RESOURCE%vLIMIT", quotaDeclarationStr, QuotaKeyValueDelimiter)
+		}
+		key := strings.TrimSpace(quotaKeyValueComponents[0])
+		if _, found := allQuotaKeys[key]; !found {
+			return nil, stacktrace.NewError("Resource quota declaration string '%v' uses unknown resource '%v'; valid resources are '%v'", quotaDeclarationStr, key, getSortedQuotaKeys())
+		}
+		if _, found := limits[key]; found {
+			return nil, stacktrace.NewError("Resource '%v' has been declared more than once in the resource quota", key)
+		}
+		limitStr := strings.TrimSpace(quotaKeyValueComponents[1])
+		limit, err := strconv.ParseUint(limitStr, quotaLimitBase, quotaLimitBitSize)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the limit '%v' of resource '%v'; it should be a positive integer", limitStr, key)
+		}
+		limits[key] = limit
+	}
+
+	if limits[ServicesQuotaKey] > uint64(^uint32(0)) {
+		return nil, stacktrace.NewError("The services limit '%v' is too big", limits[ServicesQuotaKey])
+	}
+	return enclave.NewEnclaveResourceQuota(
+		compute_resources.CpuMilliCores(limits[CpuQuotaKey]),
+		compute_resources.MemoryInMegaBytes(limits[MemoryQuotaKey]),
+		uint32(limits[ServicesQuotaKey]),
+		limits[StorageQuotaKey],
+	), nil
+}
+
+func getSortedQuotaKeys() []string {
+	result := []string{}
+	for key := range allQuotaKeys {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,36 @@
+package enclave_resource_quota
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnclaveResourceQuotaStr(t *testing.T) {
+	quota, err := ParseEnclaveResourceQuotaStr("cpu=2000, memory=4096,services=10,storage=10240,")
+	require.NoError(t, err)
+	require.Equal(t, enclave.NewEnclaveResourceQuota(2000, 4096, 10, 10240), quota)
+
+	quota, err = ParseEnclaveResourceQuotaStr("services=3")
+	require.NoError(t, err)
+	require.Equal(t, enclave.NewEnclaveResourceQuota(enclave.NoResourceQuotaLimit, enclave.NoResourceQuotaLimit, 3, enclave.NoResourceQuotaLimit), quota)
+
+	quota, err = ParseEnclaveResourceQuotaStr("")
+	require.NoError(t, err)
+	require.Nil(t, quota)
+}
+
+func TestParseEnclaveResourceQuotaStr_InvalidDeclarations(t *testing.T) {
+	_, err := ParseEnclaveResourceQuotaStr("cpu")
+	require.Error(t, err)
+
+	_, err = ParseEnclaveResourceQuotaStr("gpu=1")
+	require.Error(t, err)
+
+	_, err = ParseEnclaveResourceQuotaStr("cpu=-1")
+	require.Error(t, err)
+
+	_, err = ParseEnclaveResourceQuotaStr("cpu=1000,cpu=2000")
+	require.Error(t, err)
+}
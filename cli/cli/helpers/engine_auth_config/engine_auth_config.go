@@ -0,0 +1,116 @@
+package engine_auth_config
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+	"gopkg.in/yaml.v3"
+)
+
+// engineAuthConfigFile is the YAML file passed to the engine start and restart commands, e.g.
+//
+//	tokens:
+//	  - principal: alice
+//	    role: enclave-owner
+//	    token-sha256: 2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b
+//	  - principal: ci
+//	    role: admin
+//	    token: s3cr3t
+//	server-certificate: server.crt
+//	server-key: server.key
+//	client-certificate-authority: ca.crt
+//	client-certificate-roles:
+//	  bob: read-only
+//
+// The certificate paths are relative to the directory of the file
+type engineAuthConfigFile struct {
+	Tokens                     []engineAuthTokenDeclaration   `yaml:"tokens"`
+	ServerCertificate          string                         `yaml:"server-certificate"`
+	ServerKey                  string                         `yaml:"server-key"`
+	ClientCertificateAuthority string                         `yaml:"client-certificate-authority"`
+	ClientCertificateRoles     map[string]args.EngineAuthRole `yaml:"client-certificate-roles"`
+}
+
+type engineAuthTokenDeclaration struct {
+	Principal string              `yaml:"principal"`
+	Role      args.EngineAuthRole `yaml:"role"`
+	// Either the plain token or its hex encoded SHA-256, only the hash is handed to the engine
+	Token       string `yaml:"token"`
+	TokenSha256 string `yaml:"token-sha256"`
+}
+
+// ParseEngineAuthConfigFile returns nil if the filepath is empty, meaning that the engine accepts any caller
+func ParseEngineAuthConfigFile(engineAuthConfigFilepath string) (*args.EngineAuthConfig, error) {
+	if engineAuthConfigFilepath == "" {
+		return nil, nil
+	}
+
+	fileContent, err := os.ReadFile(engineAuthConfigFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the engine auth config file '%v'", engineAuthConfigFilepath)
+	}
+	// nolint: exhaustruct
+	configFile := &engineAuthConfigFile{}
+	if err = yaml.Unmarshal(fileContent, configFile); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the engine auth config file '%v'", engineAuthConfigFilepath)
+	}
+
+	tokens := []args.EngineAuthToken{}
+	for _, declaration := range configFile.Tokens {
+		tokenSha256 := declaration.TokenSha256
+		if declaration.Token != "" {
+			if tokenSha256 != "" {
+				return nil, stacktrace.NewError("The token of principal '%v' is declared both in plain and hashed, only one is allowed", declaration.Principal)
+			}
+			tokenSha256 = args.HashEngineAuthToken(declaration.Token)
+		}
+		tokens = append(tokens, args.EngineAuthToken{
+			Principal:   declaration.Principal,
+			Role:        declaration.Role,
+			TokenSha256: tokenSha256,
+		})
+	}
+
+	configDirpath := filepath.Dir(engineAuthConfigFilepath)
+	serverCertificate, err := readPemFile(configDirpath, configFile.ServerCertificate)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the server certificate")
+	}
+	serverKey, err := readPemFile(configDirpath, configFile.ServerKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the server key")
+	}
+	clientCertificateAuthority, err := readPemFile(configDirpath, configFile.ClientCertificateAuthority)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the client certificate authority")
+	}
+
+	engineAuthConfig, err := args.NewEngineAuthConfig(
+		tokens,
+		serverCertificate,
+		serverKey,
+		clientCertificateAuthority,
+		configFile.ClientCertificateRoles,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "The engine auth config file '%v' isn't valid", engineAuthConfigFilepath)
+	}
+	return engineAuthConfig, nil
+}
+
+// readPemFile returns an empty string if the filepath is empty
+func readPemFile(configDirpath string, pemFilepath string) (string, error) {
+	if pemFilepath == "" {
+		return "", nil
+	}
+	if !filepath.IsAbs(pemFilepath) {
+		pemFilepath = filepath.Join(configDirpath, pemFilepath)
+	}
+	content, err := os.ReadFile(pemFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading file '%v'", pemFilepath)
+	}
+	return string(content), nil
+}
@@ -0,0 +1,101 @@
+package engine_auth_config
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/stacktrace"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ReuseEngineAuthConfigFilepath starts the engine with the auth config the engine of the cluster was last started
+	// with, so that restarting a secured engine, or starting it again implicitly, never turns its authentication off
+	ReuseEngineAuthConfigFilepath = ""
+
+	// NoEngineAuthConfigFilepath starts the engine without authentication even if the engine of the cluster was last
+	// started with an auth config. Paths can't hold a NUL character so it can't be mistaken for a file
+	NoEngineAuthConfigFilepath = "\x00no-auth"
+
+	engineAuthConfigFilepathsFilePerms os.FileMode = 0600
+)
+
+// ResolveEngineAuthConfigFilepath returns the absolute path of the auth config the engine of the cluster must be
+// started with, or an empty string if the engine must accept any caller
+func ResolveEngineAuthConfigFilepath(clusterName string, requestedFilepath string) (string, error) {
+	storeFilepath, err := host_machine_directories.GetEngineAuthConfigFilepathsFilepath()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the file keeping the engine auth config filepaths")
+	}
+	return resolveEngineAuthConfigFilepath(storeFilepath, clusterName, requestedFilepath)
+}
+
+// SaveEngineAuthConfigFilepath records the auth config, resolved by ResolveEngineAuthConfigFilepath, the engine of the
+// cluster was started with
+func SaveEngineAuthConfigFilepath(clusterName string, engineAuthConfigFilepath string) error {
+	storeFilepath, err := host_machine_directories.GetEngineAuthConfigFilepathsFilepath()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the file keeping the engine auth config filepaths")
+	}
+	return saveEngineAuthConfigFilepath(storeFilepath, clusterName, engineAuthConfigFilepath)
+}
+
+func resolveEngineAuthConfigFilepath(storeFilepath string, clusterName string, requestedFilepath string) (string, error) {
+	switch requestedFilepath {
+	case NoEngineAuthConfigFilepath:
+		return "", nil
+	case ReuseEngineAuthConfigFilepath:
+		engineAuthConfigFilepaths, err := readEngineAuthConfigFilepaths(storeFilepath)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred reading the engine auth config filepaths from '%v'", storeFilepath)
+		}
+		return engineAuthConfigFilepaths[clusterName], nil
+	}
+	// The engine may be started again from another working directory
+	absoluteFilepath, err := filepath.Abs(requestedFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the absolute path of engine auth config file '%v'", requestedFilepath)
+	}
+	return absoluteFilepath, nil
+}
+
+func saveEngineAuthConfigFilepath(storeFilepath string, clusterName string, engineAuthConfigFilepath string) error {
+	engineAuthConfigFilepaths, err := readEngineAuthConfigFilepaths(storeFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the engine auth config filepaths from '%v'", storeFilepath)
+	}
+	if engineAuthConfigFilepath == "" {
+		delete(engineAuthConfigFilepaths, clusterName)
+	} else {
+		engineAuthConfigFilepaths[clusterName] = engineAuthConfigFilepath
+	}
+	fileContent, err := yaml.Marshal(engineAuthConfigFilepaths)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the engine auth config filepaths")
+	}
+	if err := os.WriteFile(storeFilepath, fileContent, engineAuthConfigFilepathsFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the engine auth config filepaths to '%v'", storeFilepath)
+	}
+	return nil
+}
+
+// readEngineAuthConfigFilepaths returns the auth config filepaths keyed by cluster name, which is empty if no engine
+// was ever started with an auth config
+func readEngineAuthConfigFilepaths(storeFilepath string) (map[string]string, error) {
+	engineAuthConfigFilepaths := map[string]string{}
+	fileContent, err := os.ReadFile(storeFilepath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return engineAuthConfigFilepaths, nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred reading file '%v'", storeFilepath)
+	}
+	if err := yaml.Unmarshal(fileContent, &engineAuthConfigFilepaths); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing file '%v'", storeFilepath)
+	}
+	if engineAuthConfigFilepaths == nil {
+		engineAuthConfigFilepaths = map[string]string{}
+	}
+	return engineAuthConfigFilepaths, nil
+}
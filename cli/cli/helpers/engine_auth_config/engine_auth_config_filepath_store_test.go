@@ -0,0 +1,61 @@
+package engine_auth_config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	dockerClusterName     = "docker"
+	kubernetesClusterName = "cloud"
+)
+
+func TestResolveEngineAuthConfigFilepath_RestartKeepsAuth(t *testing.T) {
+	storeFilepath := filepath.Join(t.TempDir(), "engine-auth-config-filepaths.yml")
+	configFilepath := writeConfigFile(t, tokensConfig)
+
+	// engine start --auth-config
+	startFilepath, err := resolveEngineAuthConfigFilepath(storeFilepath, dockerClusterName, configFilepath)
+	require.NoError(t, err)
+	require.Equal(t, configFilepath, startFilepath)
+	require.NoError(t, saveEngineAuthConfigFilepath(storeFilepath, dockerClusterName, startFilepath))
+
+	// engine restart, github login or any command starting the engine implicitly
+	restartFilepath, err := resolveEngineAuthConfigFilepath(storeFilepath, dockerClusterName, ReuseEngineAuthConfigFilepath)
+	require.NoError(t, err)
+	require.Equal(t, configFilepath, restartFilepath)
+	config, err := ParseEngineAuthConfigFile(restartFilepath)
+	require.NoError(t, err)
+	require.NotNil(t, config)
+
+	// the engines of the other clusters weren't secured
+	otherClusterFilepath, err := resolveEngineAuthConfigFilepath(storeFilepath, kubernetesClusterName, ReuseEngineAuthConfigFilepath)
+	require.NoError(t, err)
+	require.Empty(t, otherClusterFilepath)
+}
+
+func TestResolveEngineAuthConfigFilepath_NoAuth(t *testing.T) {
+	storeFilepath := filepath.Join(t.TempDir(), "engine-auth-config-filepaths.yml")
+	require.NoError(t, saveEngineAuthConfigFilepath(storeFilepath, dockerClusterName, writeConfigFile(t, tokensConfig)))
+
+	// engine restart --no-auth
+	noAuthFilepath, err := resolveEngineAuthConfigFilepath(storeFilepath, dockerClusterName, NoEngineAuthConfigFilepath)
+	require.NoError(t, err)
+	require.Empty(t, noAuthFilepath)
+	require.NoError(t, saveEngineAuthConfigFilepath(storeFilepath, dockerClusterName, noAuthFilepath))
+
+	restartFilepath, err := resolveEngineAuthConfigFilepath(storeFilepath, dockerClusterName, ReuseEngineAuthConfigFilepath)
+	require.NoError(t, err)
+	require.Empty(t, restartFilepath)
+}
+
+func TestResolveEngineAuthConfigFilepath_RelativePath(t *testing.T) {
+	storeFilepath := filepath.Join(t.TempDir(), "engine-auth-config-filepaths.yml")
+
+	resolvedFilepath, err := resolveEngineAuthConfigFilepath(storeFilepath, dockerClusterName, configFilename)
+	require.NoError(t, err)
+	require.True(t, filepath.IsAbs(resolvedFilepath))
+	require.Equal(t, configFilename, filepath.Base(resolvedFilepath))
+}
@@ -0,0 +1,69 @@
+package engine_auth_config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	configFilename = "engine-auth.yml"
+
+	tokensConfig = `
+tokens:
+  - principal: alice
+    role: enclave-owner
+    token: s3cr3t
+  - principal: bob
+    role: read-only
+    token-sha256: abcdef
+`
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	configFilepath := filepath.Join(t.TempDir(), configFilename)
+	require.NoError(t, os.WriteFile(configFilepath, []byte(content), 0600))
+	return configFilepath
+}
+
+func TestParseEngineAuthConfigFile(t *testing.T) {
+	config, err := ParseEngineAuthConfigFile(writeConfigFile(t, tokensConfig))
+	require.NoError(t, err)
+	require.Equal(t, []args.EngineAuthToken{
+		{Principal: "alice", Role: args.EngineAuthRole_EnclaveOwner, TokenSha256: args.HashEngineAuthToken("s3cr3t")},
+		{Principal: "bob", Role: args.EngineAuthRole_ReadOnly, TokenSha256: "abcdef"},
+	}, config.Tokens)
+	require.False(t, config.IsTlsEnabled())
+}
+
+func TestParseEngineAuthConfigFile_NoFile(t *testing.T) {
+	config, err := ParseEngineAuthConfigFile("")
+	require.NoError(t, err)
+	require.Nil(t, config)
+}
+
+func TestParseEngineAuthConfigFile_RelativeCertificatePaths(t *testing.T) {
+	configFilepath := writeConfigFile(t, tokensConfig+"server-certificate: server.crt\nserver-key: server.key\n")
+	configDirpath := filepath.Dir(configFilepath)
+	require.NoError(t, os.WriteFile(filepath.Join(configDirpath, "server.crt"), []byte("certificate"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(configDirpath, "server.key"), []byte("key"), 0600))
+
+	config, err := ParseEngineAuthConfigFile(configFilepath)
+	require.NoError(t, err)
+	require.Equal(t, "certificate", config.ServerCertificate)
+	require.Equal(t, "key", config.ServerKey)
+}
+
+func TestParseEngineAuthConfigFile_InvalidConfigs(t *testing.T) {
+	_, err := ParseEngineAuthConfigFile(writeConfigFile(t, "tokens:\n  - principal: alice\n    role: superuser\n    token: s3cr3t\n"))
+	require.Error(t, err)
+
+	_, err = ParseEngineAuthConfigFile(writeConfigFile(t, "tokens:\n  - principal: alice\n    role: admin\n    token: s3cr3t\n    token-sha256: abcdef\n"))
+	require.Error(t, err)
+
+	_, err = ParseEngineAuthConfigFile(writeConfigFile(t, tokensConfig+"server-certificate: missing.crt\nserver-key: missing.key\n"))
+	require.Error(t, err)
+}
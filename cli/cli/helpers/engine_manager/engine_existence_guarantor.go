@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_resource_quota"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_auth_config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/github_auth_store"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/metrics_cloud_user_instance_id_helper"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/metrics_user_id_store"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
@@ -91,6 +94,14 @@ type engineExistenceGuarantor struct {
 
 	// Length of time Kurtosis will keep logs for
 	logRetentionPeriod string
+
+	// Resource quota applied by default to the enclaves, of the form "cpu=2000,memory=4096,services=10,storage=10240"
+	// Empty if the enclaves have no default quota
+	defaultEnclaveResourceQuota string
+
+	// Absolute path to the YAML file declaring the credentials accepted by the engine
+	// Empty if the engine accepts any caller
+	engineAuthConfigFilepath string
 }
 
 func newEngineExistenceGuarantorWithDefaultVersion(
@@ -111,6 +122,8 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 	restartAPIContainers bool,
 	domain string,
 	logRetentionPeriod string,
+	defaultEnclaveResourceQuota string,
+	engineAuthConfigFilepath string,
 ) *engineExistenceGuarantor {
 	return newEngineExistenceGuarantorWithCustomVersion(
 		ctx,
@@ -131,6 +144,8 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 		restartAPIContainers,
 		domain,
 		logRetentionPeriod,
+		defaultEnclaveResourceQuota,
+		engineAuthConfigFilepath,
 	)
 }
 
@@ -153,6 +168,8 @@ func newEngineExistenceGuarantorWithCustomVersion(
 	restartAPIContainers bool,
 	domain string,
 	logRetentionPeriod string,
+	defaultEnclaveResourceQuota string,
+	engineAuthConfigFilepath string,
 ) *engineExistenceGuarantor {
 	return &engineExistenceGuarantor{
 		ctx:                                  ctx,
@@ -175,6 +192,8 @@ func newEngineExistenceGuarantorWithCustomVersion(
 		restartAPIContainers:                      restartAPIContainers,
 		domain:                                    domain,
 		logRetentionPeriod:                        logRetentionPeriod,
+		defaultEnclaveResourceQuota:               defaultEnclaveResourceQuota,
+		engineAuthConfigFilepath:                  engineAuthConfigFilepath,
 	}
 }
 
@@ -215,6 +234,20 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 		}
 	}
 
+	defaultEnclaveResourceQuota, err := enclave_resource_quota.ParseEnclaveResourceQuotaStr(guarantor.defaultEnclaveResourceQuota)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the default enclave resource quota '%v'", guarantor.defaultEnclaveResourceQuota)
+	}
+
+	engineAuthConfig, err := engine_auth_config.ParseEngineAuthConfigFile(guarantor.engineAuthConfigFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the engine auth config file '%v'", guarantor.engineAuthConfigFilepath)
+	}
+	// The enclave manager UI doesn't support engine authentication yet so it isn't served by engines requiring it
+	if engineAuthConfig != nil && guarantor.domain != defaults.DefaultDomain {
+		return stacktrace.NewError("The engine can't serve the enclave manager UI on domain '%v' as it requires authentication with auth config '%v', which the UI doesn't support; start the engine without a domain or without authentication", guarantor.domain, guarantor.engineAuthConfigFilepath)
+	}
+
 	var engineLaunchErr error
 	if guarantor.imageVersionTag == defaultEngineImageVersionTag {
 		_, _, engineLaunchErr = guarantor.engineServerLauncher.LaunchWithDefaultVersion(
@@ -236,6 +269,8 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.restartAPIContainers,
 			guarantor.domain,
 			guarantor.logRetentionPeriod,
+			defaultEnclaveResourceQuota,
+			engineAuthConfig,
 		)
 	} else {
 		_, _, engineLaunchErr = guarantor.engineServerLauncher.LaunchWithCustomVersion(
@@ -258,6 +293,8 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.restartAPIContainers,
 			guarantor.domain,
 			guarantor.logRetentionPeriod,
+			defaultEnclaveResourceQuota,
+			engineAuthConfig,
 		)
 	}
 	if engineLaunchErr != nil {
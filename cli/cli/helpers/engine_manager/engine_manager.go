@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_auth_config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/portal_manager"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_cluster_setting"
@@ -17,12 +18,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -42,6 +45,7 @@ type EngineManager struct {
 	shouldSendMetrics                         bool
 	engineServerKurtosisBackendConfigSupplier engine_server_launcher.KurtosisBackendConfigSupplier
 	clusterConfig                             *resolved_config.KurtosisClusterConfig
+	clusterName                               string
 	onBastionHost                             bool
 	enclaveEnvVars                            string
 	allowedCORSOrigins                        *[]string
@@ -102,6 +106,7 @@ func NewEngineManager(ctx context.Context) (*EngineManager, error) {
 		shouldSendMetrics: kurtosisConfig.GetShouldSendMetrics(),
 		engineServerKurtosisBackendConfigSupplier: engineBackendConfigSupplier,
 		clusterConfig:      clusterConfig,
+		clusterName:        clusterName,
 		onBastionHost:      onBastionHost,
 		enclaveEnvVars:     enclaveEnvVars,
 		allowedCORSOrigins: nil,
@@ -155,7 +160,7 @@ func (manager *EngineManager) GetEngineStatus(
 		logrus.Warnf("Unable to retrieve current Kurtosis context. This is not critical, it will assume using Kurtosis default context for now.")
 	}
 
-	engineClient, engineClientCloseFunc, err := getEngineClientFromHostMachineIpAndPort(runningEngineIpAndPort)
+	engineClient, engineClientCloseFunc, err := getEngineClientFromHostMachineIpAndPort(runningEngineIpAndPort, currentContext.GetEngineAuth())
 	if err != nil {
 		return EngineStatus_ContainerRunningButServerNotResponding, runningEngineIpAndPort, "", nil
 	}
@@ -168,6 +173,9 @@ func (manager *EngineManager) GetEngineStatus(
 
 	engineInfo, err := getEngineInfoWithTimeout(ctx, engineClient)
 	if err != nil {
+		if errorCode := status.Code(stacktrace.RootCause(err)); errorCode == codes.Unauthenticated || errorCode == codes.PermissionDenied {
+			logrus.Warnf("The engine is running but rejected the credentials of the current context; set them with '%v %v %v'", command_str_consts.KurtosisCmdStr, command_str_consts.ContextCmdStr, command_str_consts.ContextSetAuthCmdStr)
+		}
 		return EngineStatus_ContainerRunningButServerNotResponding, runningEngineIpAndPort, "", nil
 	}
 
@@ -183,12 +191,18 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(
 	githubAuthTokenOverride string,
 	restartAPIContainers bool,
 	domain string,
-	logRetentionPeriodStr string) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
+	logRetentionPeriodStr string,
+	defaultEnclaveResourceQuotaStr string,
+	engineAuthConfigFilepath string) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
 	status, maybeHostMachinePortBinding, engineVersion, err := manager.GetEngineStatus(ctx)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred retrieving the Kurtosis engine status, which is necessary for creating a connection to the engine")
 	}
 	logrus.Debugf("Engine status: '%v'", status)
+	resolvedEngineAuthConfigFilepath, err := engine_auth_config.ResolveEngineAuthConfigFilepath(manager.clusterName, engineAuthConfigFilepath)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred resolving the auth config of the engine of cluster '%v'", manager.clusterName)
+	}
 	clusterType := manager.clusterConfig.GetClusterType()
 	engineGuarantor := newEngineExistenceGuarantorWithDefaultVersion(
 		ctx,
@@ -208,6 +222,8 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(
 		restartAPIContainers,
 		domain,
 		logRetentionPeriodStr,
+		defaultEnclaveResourceQuotaStr,
+		resolvedEngineAuthConfigFilepath,
 	)
 	// TODO Need to handle the Kubernetes case, where a gateway needs to be started after the engine is started but
 	//  before we can return an EngineClient
@@ -228,12 +244,18 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(
 	githubAuthTokenOverride string,
 	restartAPIContainers bool,
 	domain string,
-	logRetentionPeriodStr string) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
+	logRetentionPeriodStr string,
+	defaultEnclaveResourceQuotaStr string,
+	engineAuthConfigFilepath string) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
 	status, maybeHostMachinePortBinding, engineVersion, err := manager.GetEngineStatus(ctx)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred retrieving the Kurtosis engine status, which is necessary for creating a connection to the engine")
 	}
 
+	resolvedEngineAuthConfigFilepath, err := engine_auth_config.ResolveEngineAuthConfigFilepath(manager.clusterName, engineAuthConfigFilepath)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred resolving the auth config of the engine of cluster '%v'", manager.clusterName)
+	}
 	clusterType := manager.clusterConfig.GetClusterType()
 	engineGuarantor := newEngineExistenceGuarantorWithCustomVersion(
 		ctx,
@@ -254,6 +276,8 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(
 		restartAPIContainers,
 		domain,
 		logRetentionPeriodStr,
+		defaultEnclaveResourceQuotaStr,
+		resolvedEngineAuthConfigFilepath,
 	)
 	engineClient, engineClientCloseFunc, err := manager.startEngineWithGuarantor(ctx, status, engineGuarantor)
 	if err != nil {
@@ -356,6 +380,8 @@ func (manager *EngineManager) RestartEngineIdempotently(
 	shouldRestartAPIContainers bool,
 	domain string,
 	logRetentionPeriodStr string,
+	defaultEnclaveResourceQuotaStr string,
+	engineAuthConfigFilepath string,
 ) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
 	var versionOfNewEngine string
 	// We try to do our best to restart an engine on the same version the current on is on
@@ -381,9 +407,9 @@ func (manager *EngineManager) RestartEngineIdempotently(
 	var engineClientCloseFunc func() error
 	var restartEngineErr error
 	if versionOfNewEngine != defaultEngineVersion {
-		_, engineClientCloseFunc, restartEngineErr = manager.StartEngineIdempotentlyWithCustomVersion(ctx, versionOfNewEngine, logLevel, poolSize, shouldStartInDebugMode, githubAuthTokenOverride, shouldRestartAPIContainers, domain, logRetentionPeriodStr)
+		_, engineClientCloseFunc, restartEngineErr = manager.StartEngineIdempotentlyWithCustomVersion(ctx, versionOfNewEngine, logLevel, poolSize, shouldStartInDebugMode, githubAuthTokenOverride, shouldRestartAPIContainers, domain, logRetentionPeriodStr, defaultEnclaveResourceQuotaStr, engineAuthConfigFilepath)
 	} else {
-		_, engineClientCloseFunc, restartEngineErr = manager.StartEngineIdempotentlyWithDefaultVersion(ctx, logLevel, poolSize, githubAuthTokenOverride, shouldRestartAPIContainers, domain, logRetentionPeriodStr)
+		_, engineClientCloseFunc, restartEngineErr = manager.StartEngineIdempotentlyWithDefaultVersion(ctx, logLevel, poolSize, githubAuthTokenOverride, shouldRestartAPIContainers, domain, logRetentionPeriodStr, defaultEnclaveResourceQuotaStr, engineAuthConfigFilepath)
 	}
 	if restartEngineErr != nil {
 		return nil, nil, stacktrace.Propagate(restartEngineErr, "An error occurred starting a new engine")
@@ -400,6 +426,12 @@ func (manager *EngineManager) startEngineWithGuarantor(ctx context.Context, curr
 	if err := currentStatus.Accept(engineGuarantor); err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred guaranteeing that a Kurtosis engine is running")
 	}
+	// Only a stopped engine got started with the auth config, a running one keeps the config it was started with
+	if currentStatus == EngineStatus_Stopped {
+		if err := engine_auth_config.SaveEngineAuthConfigFilepath(manager.clusterName, engineGuarantor.engineAuthConfigFilepath); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred saving the auth config the engine of cluster '%v' was started with", manager.clusterName)
+		}
+	}
 	hostMachinePortBinding := engineGuarantor.getPostVisitingHostMachineIpAndPort()
 
 	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
@@ -419,7 +451,7 @@ func (manager *EngineManager) startEngineWithGuarantor(ctx context.Context, curr
 		logrus.Warnf("Unable to retrieve current Kurtosis context. This is not critical, it will assume using Kurtosis default context for now.")
 	}
 
-	engineClient, clientCloseFunc, err := getEngineClientFromHostMachineIpAndPort(hostMachinePortBinding)
+	engineClient, clientCloseFunc, err := getEngineClientFromHostMachineIpAndPort(hostMachinePortBinding, currentContext.GetEngineAuth())
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred connecting to the running engine; this is very strange and likely indicates a bug in the engine itself")
 	}
@@ -441,9 +473,14 @@ func (manager *EngineManager) startEngineWithGuarantor(ctx context.Context, curr
 	return engineClient, clientCloseFunc, nil
 }
 
-func getEngineClientFromHostMachineIpAndPort(hostMachineIpAndPort *hostMachineIpAndPort) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
+// engineAuth holds the credentials of the current context, nil if the engine doesn't require any
+func getEngineClientFromHostMachineIpAndPort(hostMachineIpAndPort *hostMachineIpAndPort, engineAuth *generated.EngineAuth) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
 	url := hostMachineIpAndPort.GetURL()
-	conn, err := grpc.Dial(url, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	dialOptions, err := kurtosis_context.GetEngineDialOptions(engineAuth)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred building the options to dial the Kurtosis engine")
+	}
+	conn, err := grpc.Dial(url, dialOptions...)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred dialling Kurtosis engine at URL '%v'", url)
 	}
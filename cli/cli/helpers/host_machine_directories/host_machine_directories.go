@@ -1,6 +1,7 @@
 package host_machine_directories
 
 import (
+	"fmt"
 	"github.com/adrg/xdg"
 	"github.com/kurtosis-tech/stacktrace"
 	"path"
@@ -20,6 +21,8 @@ const (
 	githubUsernameFilename  = "github-username"
 	githubAuthTokenFilename = "github-auth-token"
 
+	engineAuthConfigFilepathsFilename = "engine-auth-config-filepaths.yml"
+
 	userSendMetricsElection = "user-send-metrics-election"
 
 	LastPesteredUserAboutOldVersionFilename = "last-pestered-user-about-old-version"
@@ -29,10 +32,13 @@ const (
 	portalVersionFilename = "kurtosis-portal.version"
 	portalPidFilename     = "kurtosis-portal.pid"
 
+	enclavePortForwardsFilenameFormat = "%v.json"
+
 	// ------------ Names of dirs inside Kurtosis directory --------------
 	engineDataDirname      = "engine-data"
 	portalSubDirname       = "portal"
 	kurtosisCliLogsDirname = "cli"
+	portForwardsSubDirname = "port-forwards"
 )
 
 // TODO after 2022-07-08, when we're confident nobody is using engines without engine data directories anymore,
@@ -168,6 +174,26 @@ func GetGitHubAuthTokenFilePath() (string, error) {
 	return githubAuthTokenFilePath, nil
 }
 
+// GetEngineAuthConfigFilepathsFilepath returns the file keeping the auth config each cluster's engine was last started with
+func GetEngineAuthConfigFilepathsFilepath() (string, error) {
+	xdgRelFilepath := getRelativeFilepathForXDG(engineAuthConfigFilepathsFilename)
+	engineAuthConfigFilepathsFilepath, err := xdg.StateFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the engine auth config filepaths file path using '%s'", xdgRelFilepath)
+	}
+	return engineAuthConfigFilepathsFilepath, nil
+}
+
+// GetEnclavePortForwardsFilepath returns the default file the local ports forwarded to the services of an enclave are written to
+func GetEnclavePortForwardsFilepath(enclaveName string) (string, error) {
+	xdgRelFilepath := path.Join(applicationDirname, portForwardsSubDirname, fmt.Sprintf(enclavePortForwardsFilenameFormat, enclaveName))
+	enclavePortForwardsFilepath, err := xdg.StateFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the port forwards file path of enclave '%v' using '%s'", enclaveName, xdgRelFilepath)
+	}
+	return enclavePortForwardsFilepath, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
@@ -0,0 +1,67 @@
+package kurtosis_config_getter
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+	"sort"
+)
+
+// GetGitHostConfigs returns the git hosts configured in the Kurtosis config, ready to be sent to the enclave along
+// with a Starlark run. The SSH private key and known hosts files are read here as the enclave can't access them
+func GetGitHostConfigs() ([]*kurtosis_core_rpc_api_bindings.GitHostConfig, error) {
+	kurtosisConfig, err := getKurtosisConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting Kurtosis configuration")
+	}
+
+	hosts := []string{}
+	for host := range kurtosisConfig.GetGitHosts() {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	gitHostConfigs := []*kurtosis_core_rpc_api_bindings.GitHostConfig{}
+	for _, host := range hosts {
+		gitHostConfig := kurtosisConfig.GetGitHosts()[host]
+		sshPrivateKey, err := readOptionalFile(gitHostConfig.SshPrivateKeyFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the SSH private key of git host '%v'", host)
+		}
+		sshKnownHosts, err := readOptionalFile(gitHostConfig.SshKnownHostsFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the SSH known hosts of git host '%v'", host)
+		}
+		gitHostConfigs = append(gitHostConfigs, binding_constructors.NewGitHostConfig(
+			host,
+			gitHostConfig.CloneUrlPrefix,
+			gitHostConfig.Username,
+			gitHostConfig.Password,
+			sshPrivateKey,
+			sshKnownHosts,
+		))
+	}
+	return gitHostConfigs, nil
+}
+
+// IsConfiguredGitHost returns true if the host has an entry in the 'git-hosts' section of the Kurtosis config
+func IsConfiguredGitHost(host string) (bool, error) {
+	kurtosisConfig, err := getKurtosisConfig()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred while getting Kurtosis configuration")
+	}
+	_, found := kurtosisConfig.GetGitHosts()[host]
+	return found, nil
+}
+
+func readOptionalFile(filepath string) (string, error) {
+	if filepath == "" {
+		return "", nil
+	}
+	fileContents, err := os.ReadFile(filepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading file '%v'", filepath)
+	}
+	return string(fileContents), nil
+}
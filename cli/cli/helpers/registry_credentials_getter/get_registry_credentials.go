@@ -0,0 +1,52 @@
+package registry_credentials_getter
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/oci_artifacts"
+	"github.com/kurtosis-tech/stacktrace"
+	"sort"
+	"strings"
+)
+
+const (
+	RegistriesSplitChar = ","
+)
+
+// GetRegistryCredentials returns the credentials of the given registries from the Docker config file, the same ones
+// 'kurtosis package push' uses, ready to be sent to the enclave along with a Starlark run so that it can pull private
+// OCI packages. Only the registries the user opted in for are sent, as the run may not need any of them
+func GetRegistryCredentials(registries []string) ([]*kurtosis_core_rpc_api_bindings.RegistryCredentials, error) {
+	registryCredentials := []*kurtosis_core_rpc_api_bindings.RegistryCredentials{}
+	if len(registries) == 0 {
+		return registryCredentials, nil
+	}
+
+	credentialsByRegistry, err := oci_artifacts.GetRegistryCredentialsFromDockerConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading registry credentials from the Docker config")
+	}
+
+	sortedRegistries := append([]string{}, registries...)
+	sort.Strings(sortedRegistries)
+	for _, registry := range sortedRegistries {
+		credentials, found := credentialsByRegistry[registry]
+		if !found {
+			return nil, stacktrace.NewError("No credentials were found for registry '%v' in the Docker config; log in to it with 'docker login %v' first", registry, registry)
+		}
+		registryCredentials = append(registryCredentials, binding_constructors.NewRegistryCredentials(registry, credentials.GetUsername(), credentials.GetPassword()))
+	}
+	return registryCredentials, nil
+}
+
+// ParseRegistries splits the comma separated list of registries passed to a command, ignoring blank entries
+func ParseRegistries(registriesStr string) []string {
+	registries := []string{}
+	for _, registry := range strings.Split(registriesStr, RegistriesSplitChar) {
+		trimmedRegistry := strings.TrimSpace(registry)
+		if trimmedRegistry != "" {
+			registries = append(registries, trimmedRegistry)
+		}
+	}
+	return registries
+}
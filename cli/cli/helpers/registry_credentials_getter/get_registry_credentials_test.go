@@ -0,0 +1,23 @@
+package registry_credentials_getter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRegistries(t *testing.T) {
+	require.Empty(t, ParseRegistries(""))
+	require.Equal(t, []string{"ghcr.io", "registry.example.com"}, ParseRegistries(" ghcr.io,,registry.example.com "))
+}
+
+func TestGetRegistryCredentials_NoRegistryRequested(t *testing.T) {
+	// Nothing is read from the Docker config, let alone sent to the enclave, unless the user opts in
+	t.Setenv("HOME", t.TempDir())
+	registryCredentials, err := GetRegistryCredentials(nil)
+	require.NoError(t, err)
+	require.Empty(t, registryCredentials)
+
+	_, err = GetRegistryCredentials([]string{"ghcr.io"})
+	require.Error(t, err)
+}
@@ -6,10 +6,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_liveness_validator"
 	"github.com/kurtosis-tech/stacktrace"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"sort"
 	"strings"
 )
@@ -94,7 +94,7 @@ func GetUserServicePortBindingStrings(userService *kurtosis_core_rpc_api_binding
 	return result, nil
 }
 
-func GetUserServiceInfoMapFromAPIContainer(ctx context.Context, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, filterServiceIdentifiers map[string]bool) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
+func GetUserServiceInfoMapFromAPIContainer(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, filterServiceIdentifiers map[string]bool) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
 	apicHostMachineIp, apicHostMachineGrpcPort, err := enclave_liveness_validator.ValidateEnclaveLiveness(enclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred verifying that the enclave was running")
@@ -105,7 +105,11 @@ func GetUserServiceInfoMapFromAPIContainer(ctx context.Context, enclaveInfo *kur
 		apicHostMachineIp,
 		apicHostMachineGrpcPort,
 	)
-	conn, err := grpc.Dial(apiContainerHostGrpcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	apiContainerDialOptions, err := kurtosisCtx.GetApiContainerDialOptions(ctx, enclaveInfo.GetEnclaveUuid())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the options to connect to the API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+	conn, err := grpc.Dial(apiContainerHostGrpcUrl, apiContainerDialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
@@ -8,4 +8,7 @@ const (
 	ConfigVersion_v0 ConfigVersion = iota
 	ConfigVersion_v1
 	ConfigVersion_v2 // Fixed a typo in Kubernetes config, `enclave-size-in-Megabytes` -> `enclave-size-in-megabytes`
+	ConfigVersion_v3 // Added `git-hosts` to configure git hosts other than GitHub
+	ConfigVersion_v4 // Added `service-exposure` to the Kubernetes cluster config
+	ConfigVersion_v5 // Added `service-workload` to the Kubernetes cluster config
 )
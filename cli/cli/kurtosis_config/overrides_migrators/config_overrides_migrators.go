@@ -5,6 +5,9 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v0"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v1"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v3"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v4"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v5"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -24,11 +27,233 @@ type configOverridesMigrator = func(uncastedOldConfig interface{}) (interface{},
 // to the bottom each time
 // >>>>>>>>>>>>>>>>>>>>>>>>>>>>> INSTRUCTIONS <<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<
 var AllConfigOverridesMigrators = map[config_version.ConfigVersion]configOverridesMigrator{
+	config_version.ConfigVersion_v4: migrateFromV4,
+	config_version.ConfigVersion_v3: migrateFromV3,
+	config_version.ConfigVersion_v2: migrateFromV2,
 	config_version.ConfigVersion_v1: migrateFromV1,
 	config_version.ConfigVersion_v0: migrateFromV0,
 }
 
 // vvvvvvvvvvvvvvvvvvvvvvv REVERSE chronological order so you don't have to scroll forever vvvvvvvvvvvvvvvvvvvv
+func migrateFromV4(uncastedConfig interface{}) (interface{}, error) {
+	// cast "uncastedConfig" to current version we're upgrading from
+	castedOldConfig, ok := uncastedConfig.(*v4.KurtosisConfigV4)
+	if !ok {
+		return nil, stacktrace.NewError(
+			"Failed to cast old configuration '%+v' to expected configuration struct",
+			uncastedConfig,
+		)
+	}
+
+	// Migrate cluster configs across
+	var newClusters map[string]*v5.KurtosisClusterConfigV5
+	if castedOldConfig.KurtosisClusters != nil {
+		newClusters = map[string]*v5.KurtosisClusterConfigV5{}
+		for oldClusterName, oldClusterConfig := range castedOldConfig.KurtosisClusters {
+			oldKubernetesConfig := oldClusterConfig.Config
+
+			var newKubernetesConfig *v5.KubernetesClusterConfigV5
+			if oldKubernetesConfig != nil {
+				newKubernetesConfig = &v5.KubernetesClusterConfigV5{
+					KubernetesClusterName:  oldKubernetesConfig.KubernetesClusterName,
+					StorageClass:           oldKubernetesConfig.StorageClass,
+					EnclaveSizeInMegabytes: oldKubernetesConfig.EnclaveSizeInMegabytes,
+					ServiceExposure:        migrateServiceExposureFromV4(oldKubernetesConfig.ServiceExposure),
+					ServiceWorkload:        nil,
+					TaskHelperImage:        nil,
+				}
+			}
+
+			newClusterConfig := &v5.KurtosisClusterConfigV5{
+				Type:   oldClusterConfig.Type,
+				Config: newKubernetesConfig,
+			}
+			newClusters[oldClusterName] = newClusterConfig
+		}
+	}
+
+	var newCloudConfig *v5.KurtosisCloudConfigV5
+	if castedOldConfig.CloudConfig != nil {
+		newCloudConfig = &v5.KurtosisCloudConfigV5{
+			ApiUrl:           castedOldConfig.CloudConfig.ApiUrl,
+			Port:             castedOldConfig.CloudConfig.Port,
+			CertificateChain: castedOldConfig.CloudConfig.CertificateChain,
+		}
+	}
+
+	var newGitHosts map[string]*v5.GitHostConfigV5
+	if castedOldConfig.GitHosts != nil {
+		newGitHosts = map[string]*v5.GitHostConfigV5{}
+		for host, oldGitHostConfig := range castedOldConfig.GitHosts {
+			if oldGitHostConfig == nil {
+				newGitHosts[host] = nil
+				continue
+			}
+			newGitHosts[host] = &v5.GitHostConfigV5{
+				CloneUrlPrefix:        oldGitHostConfig.CloneUrlPrefix,
+				Username:              oldGitHostConfig.Username,
+				Password:              oldGitHostConfig.Password,
+				SshPrivateKeyFilepath: oldGitHostConfig.SshPrivateKeyFilepath,
+				SshKnownHostsFilepath: oldGitHostConfig.SshKnownHostsFilepath,
+			}
+		}
+	}
+
+	// create a new configuration object to represent the migrated work
+	newConfig := &v5.KurtosisConfigV5{
+		ConfigVersion:     config_version.ConfigVersion_v5,
+		ShouldSendMetrics: castedOldConfig.ShouldSendMetrics,
+		KurtosisClusters:  newClusters,
+		CloudConfig:       newCloudConfig,
+		GitHosts:          newGitHosts,
+	}
+
+	return newConfig, nil
+}
+
+func migrateServiceExposureFromV4(oldServiceExposureConfig *v4.ServiceExposureConfigV4) *v5.ServiceExposureConfigV5 {
+	if oldServiceExposureConfig == nil {
+		return nil
+	}
+	return &v5.ServiceExposureConfigV5{
+		Type:             oldServiceExposureConfig.Type,
+		HostnameTemplate: oldServiceExposureConfig.HostnameTemplate,
+		Https:            oldServiceExposureConfig.Https,
+		IngressClassName: oldServiceExposureConfig.IngressClassName,
+		GatewayName:      oldServiceExposureConfig.GatewayName,
+		GatewayNamespace: oldServiceExposureConfig.GatewayNamespace,
+	}
+}
+
+func migrateFromV3(uncastedConfig interface{}) (interface{}, error) {
+	// cast "uncastedConfig" to current version we're upgrading from
+	castedOldConfig, ok := uncastedConfig.(*v3.KurtosisConfigV3)
+	if !ok {
+		return nil, stacktrace.NewError(
+			"Failed to cast old configuration '%+v' to expected configuration struct",
+			uncastedConfig,
+		)
+	}
+
+	// Migrate cluster configs across
+	var newClusters map[string]*v4.KurtosisClusterConfigV4
+	if castedOldConfig.KurtosisClusters != nil {
+		newClusters = map[string]*v4.KurtosisClusterConfigV4{}
+		for oldClusterName, oldClusterConfig := range castedOldConfig.KurtosisClusters {
+			oldKubernetesConfig := oldClusterConfig.Config
+
+			var newKubernetesConfig *v4.KubernetesClusterConfigV4
+			if oldKubernetesConfig != nil {
+				newKubernetesConfig = &v4.KubernetesClusterConfigV4{
+					KubernetesClusterName:  oldKubernetesConfig.KubernetesClusterName,
+					StorageClass:           oldKubernetesConfig.StorageClass,
+					EnclaveSizeInMegabytes: oldKubernetesConfig.EnclaveSizeInMegabytes,
+					ServiceExposure:        nil,
+				}
+			}
+
+			newClusterConfig := &v4.KurtosisClusterConfigV4{
+				Type:   oldClusterConfig.Type,
+				Config: newKubernetesConfig,
+			}
+			newClusters[oldClusterName] = newClusterConfig
+		}
+	}
+
+	var newCloudConfig *v4.KurtosisCloudConfigV4
+	if castedOldConfig.CloudConfig != nil {
+		newCloudConfig = &v4.KurtosisCloudConfigV4{
+			ApiUrl:           castedOldConfig.CloudConfig.ApiUrl,
+			Port:             castedOldConfig.CloudConfig.Port,
+			CertificateChain: castedOldConfig.CloudConfig.CertificateChain,
+		}
+	}
+
+	var newGitHosts map[string]*v4.GitHostConfigV4
+	if castedOldConfig.GitHosts != nil {
+		newGitHosts = map[string]*v4.GitHostConfigV4{}
+		for host, oldGitHostConfig := range castedOldConfig.GitHosts {
+			if oldGitHostConfig == nil {
+				newGitHosts[host] = nil
+				continue
+			}
+			newGitHosts[host] = &v4.GitHostConfigV4{
+				CloneUrlPrefix:        oldGitHostConfig.CloneUrlPrefix,
+				Username:              oldGitHostConfig.Username,
+				Password:              oldGitHostConfig.Password,
+				SshPrivateKeyFilepath: oldGitHostConfig.SshPrivateKeyFilepath,
+				SshKnownHostsFilepath: oldGitHostConfig.SshKnownHostsFilepath,
+			}
+		}
+	}
+
+	// create a new configuration object to represent the migrated work
+	newConfig := &v4.KurtosisConfigV4{
+		ConfigVersion:     config_version.ConfigVersion_v4,
+		ShouldSendMetrics: castedOldConfig.ShouldSendMetrics,
+		KurtosisClusters:  newClusters,
+		CloudConfig:       newCloudConfig,
+		GitHosts:          newGitHosts,
+	}
+
+	return newConfig, nil
+}
+
+func migrateFromV2(uncastedConfig interface{}) (interface{}, error) {
+	// cast "uncastedConfig" to current version we're upgrading from
+	castedOldConfig, ok := uncastedConfig.(*v2.KurtosisConfigV2)
+	if !ok {
+		return nil, stacktrace.NewError(
+			"Failed to cast old configuration '%+v' to expected configuration struct",
+			uncastedConfig,
+		)
+	}
+
+	// Migrate cluster configs across
+	var newClusters map[string]*v3.KurtosisClusterConfigV3
+	if castedOldConfig.KurtosisClusters != nil {
+		newClusters = map[string]*v3.KurtosisClusterConfigV3{}
+		for oldClusterName, oldClusterConfig := range castedOldConfig.KurtosisClusters {
+			oldKubernetesConfig := oldClusterConfig.Config
+
+			var newKubernetesConfig *v3.KubernetesClusterConfigV3
+			if oldKubernetesConfig != nil {
+				newKubernetesConfig = &v3.KubernetesClusterConfigV3{
+					KubernetesClusterName:  oldKubernetesConfig.KubernetesClusterName,
+					StorageClass:           oldKubernetesConfig.StorageClass,
+					EnclaveSizeInMegabytes: oldKubernetesConfig.EnclaveSizeInMegabytes,
+				}
+			}
+
+			newClusterConfig := &v3.KurtosisClusterConfigV3{
+				Type:   oldClusterConfig.Type,
+				Config: newKubernetesConfig,
+			}
+			newClusters[oldClusterName] = newClusterConfig
+		}
+	}
+
+	var newCloudConfig *v3.KurtosisCloudConfigV3
+	if castedOldConfig.CloudConfig != nil {
+		newCloudConfig = &v3.KurtosisCloudConfigV3{
+			ApiUrl:           castedOldConfig.CloudConfig.ApiUrl,
+			Port:             castedOldConfig.CloudConfig.Port,
+			CertificateChain: castedOldConfig.CloudConfig.CertificateChain,
+		}
+	}
+
+	// create a new configuration object to represent the migrated work
+	newConfig := &v3.KurtosisConfigV3{
+		ConfigVersion:     config_version.ConfigVersion_v3,
+		ShouldSendMetrics: castedOldConfig.ShouldSendMetrics,
+		KurtosisClusters:  newClusters,
+		CloudConfig:       newCloudConfig,
+		GitHosts:          nil,
+	}
+
+	return newConfig, nil
+}
+
 func migrateFromV1(uncastedConfig interface{}) (interface{}, error) {
 	// cast "uncastedConfig" to current version we're upgrading from
 	castedOldConfig, ok := uncastedConfig.(*v1.KurtosisConfigV1)
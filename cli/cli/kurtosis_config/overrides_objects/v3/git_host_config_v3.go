@@ -0,0 +1,18 @@
+package v3
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type GitHostConfigV3 struct {
+	CloneUrlPrefix        *string `yaml:"clone-url-prefix,omitempty"`
+	Username              *string `yaml:"username,omitempty"`
+	Password              *string `yaml:"password,omitempty"`
+	SshPrivateKeyFilepath *string `yaml:"ssh-private-key-filepath,omitempty"`
+	SshKnownHostsFilepath *string `yaml:"ssh-known-hosts-filepath,omitempty"`
+}
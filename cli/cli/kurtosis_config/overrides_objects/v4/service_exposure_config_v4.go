@@ -0,0 +1,20 @@
+package v4
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type ServiceExposureConfigV4 struct {
+	// Either 'ingress' or 'http-route'
+	Type             *string `yaml:"type,omitempty"`
+	HostnameTemplate *string `yaml:"hostname-template,omitempty"`
+	Https            *bool   `yaml:"https,omitempty"`
+	IngressClassName *string `yaml:"ingress-class-name,omitempty"`
+	GatewayName      *string `yaml:"gateway-name,omitempty"`
+	GatewayNamespace *string `yaml:"gateway-namespace,omitempty"`
+}
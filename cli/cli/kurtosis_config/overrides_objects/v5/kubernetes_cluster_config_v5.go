@@ -0,0 +1,24 @@
+package v5
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type KubernetesClusterConfigV5 struct {
+	KubernetesClusterName  *string `yaml:"kubernetes-cluster-name,omitempty"`
+	StorageClass           *string `yaml:"storage-class,omitempty"`
+	EnclaveSizeInMegabytes *uint   `yaml:"enclave-size-in-megabytes,omitempty"`
+	// ServiceExposure makes the HTTP ports of the user services reachable from outside the cluster
+	ServiceExposure *ServiceExposureConfigV5 `yaml:"service-exposure,omitempty"`
+	// ServiceWorkload is either 'pod' or 'stateful-set', the latter letting Kubernetes reschedule the services with their
+	// persistent volumes when their pod gets evicted
+	ServiceWorkload *string `yaml:"service-workload,omitempty"`
+	// TaskHelperImage replaces the public busybox image the helper wrapping the tasks is copied from, e.g. with a mirror
+	// in a private registry
+	TaskHelperImage *string `yaml:"task-helper-image,omitempty"`
+}
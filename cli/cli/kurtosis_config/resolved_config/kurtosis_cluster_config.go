@@ -2,14 +2,17 @@ package resolved_config
 
 import (
 	"context"
-	v2 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	v5 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v5"
 	"strings"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
+	kubernetes_consts "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/service_exposure"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args/kurtosis_backend_config"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/stacktrace"
 )
@@ -26,7 +29,7 @@ type KurtosisClusterConfig struct {
 	clusterType                 KurtosisClusterType
 }
 
-func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v2.KurtosisClusterConfigV2) (*KurtosisClusterConfig, error) {
+func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v5.KurtosisClusterConfigV5) (*KurtosisClusterConfig, error) {
 	if overrides.Type == nil {
 		return nil, stacktrace.NewError("Kurtosis cluster must have a defined type")
 	}
@@ -75,7 +78,7 @@ func (clusterConfig *KurtosisClusterConfig) GetClusterType() KurtosisClusterType
 //	Private Helpers
 //
 // ====================================================================================================
-func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesConfig *v2.KubernetesClusterConfigV2) (
+func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesConfig *v5.KubernetesClusterConfigV5) (
 	kurtosisBackendSupplier,
 	engine_server_launcher.KurtosisBackendConfigSupplier,
 	error,
@@ -144,6 +147,18 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 			enclaveDataVolumeSizeInMb = *kubernetesConfig.EnclaveSizeInMegabytes
 		}
 
+		serviceExposure, err := getKubernetesServiceExposureConfig(kubernetesConfig.ServiceExposure)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid service exposure config", clusterId)
+		}
+
+		serviceWorkload := getOrEmpty(kubernetesConfig.ServiceWorkload)
+		if _, err := kubernetes_consts.GetUserServiceWorkloadType(serviceWorkload); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Cluster '%v' has an invalid service workload", clusterId)
+		}
+
+		taskHelperImage := getOrEmpty(kubernetesConfig.TaskHelperImage)
+
 		backendSupplier = func(ctx context.Context) (backend_interface.KurtosisBackend, error) {
 			backend, err := kubernetes_kurtosis_backend.GetCLIBackend(ctx, *kubernetesConfig.StorageClass)
 			if err != nil {
@@ -158,7 +173,7 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 			return backend, nil
 		}
 
-		engineConfigSupplier = engine_server_launcher.NewKubernetesKurtosisBackendConfigSupplier(storageClass, enclaveDataVolumeSizeInMb)
+		engineConfigSupplier = engine_server_launcher.NewKubernetesKurtosisBackendConfigSupplier(storageClass, enclaveDataVolumeSizeInMb, serviceExposure, serviceWorkload, taskHelperImage)
 	default:
 		// This should never happen because we enforce this via unit tests
 		return nil, nil, stacktrace.NewError(
@@ -169,3 +184,37 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 	}
 	return backendSupplier, engineConfigSupplier, nil
 }
+
+// getKubernetesServiceExposureConfig validates the service exposure here so that a broken config fails when the engine
+// starts rather than in every API container; returns nil if the services aren't exposed
+func getKubernetesServiceExposureConfig(overrides *v5.ServiceExposureConfigV5) (*kurtosis_backend_config.KubernetesServiceExposureConfig, error) {
+	if overrides == nil {
+		return nil, nil
+	}
+	if overrides.Type == nil {
+		return nil, stacktrace.NewError("The service exposure must have a type; valid values are: %v", service_exposure.AllServiceExposureTypes)
+	}
+	isHttps := false
+	if overrides.Https != nil {
+		isHttps = *overrides.Https
+	}
+	serviceExposure := &kurtosis_backend_config.KubernetesServiceExposureConfig{
+		Type:             *overrides.Type,
+		HostnameTemplate: getOrEmpty(overrides.HostnameTemplate),
+		IsHttps:          isHttps,
+		IngressClassName: getOrEmpty(overrides.IngressClassName),
+		GatewayName:      getOrEmpty(overrides.GatewayName),
+		GatewayNamespace: getOrEmpty(overrides.GatewayNamespace),
+	}
+	if _, err := service_exposure.NewServiceExposureConfig(
+		service_exposure.ServiceExposureType(serviceExposure.Type),
+		serviceExposure.HostnameTemplate,
+		serviceExposure.IsHttps,
+		serviceExposure.IngressClassName,
+		serviceExposure.GatewayName,
+		serviceExposure.GatewayNamespace,
+	); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the service exposure config")
+	}
+	return serviceExposure, nil
+}
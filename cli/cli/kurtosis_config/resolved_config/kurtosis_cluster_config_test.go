@@ -1,13 +1,13 @@
 package resolved_config
 
 import (
-	v2 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	v5 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v5"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
 
 func TestNewKurtosisClusterConfigEmptyOverrides(t *testing.T) {
-	kurtosisClusterConfigOverrides := v2.KurtosisClusterConfigV2{
+	kurtosisClusterConfigOverrides := v5.KurtosisClusterConfigV5{
 		Type:   nil,
 		Config: nil,
 	}
@@ -17,7 +17,7 @@ func TestNewKurtosisClusterConfigEmptyOverrides(t *testing.T) {
 
 func TestNewKurtosisClusterConfigDockerType(t *testing.T) {
 	dockerType := KurtosisClusterType_Docker.String()
-	kurtosisClusterConfigOverrides := v2.KurtosisClusterConfigV2{
+	kurtosisClusterConfigOverrides := v5.KurtosisClusterConfigV5{
 		Type:   &dockerType,
 		Config: nil,
 	}
@@ -27,7 +27,7 @@ func TestNewKurtosisClusterConfigDockerType(t *testing.T) {
 
 func TestNewKurtosisClusterConfigKubernetesNoConfig(t *testing.T) {
 	kubernetesType := KurtosisClusterType_Kubernetes.String()
-	kurtosisClusterConfigOverrides := v2.KurtosisClusterConfigV2{
+	kurtosisClusterConfigOverrides := v5.KurtosisClusterConfigV5{
 		Type:   &kubernetesType,
 		Config: nil,
 	}
@@ -37,7 +37,7 @@ func TestNewKurtosisClusterConfigKubernetesNoConfig(t *testing.T) {
 
 func TestNewKurtosisClusterConfigNonsenseType(t *testing.T) {
 	clusterType := "gdsfgsdfvsf"
-	kurtosisClusterConfigOverrides := v2.KurtosisClusterConfigV2{
+	kurtosisClusterConfigOverrides := v5.KurtosisClusterConfigV5{
 		Type:   &clusterType,
 		Config: nil,
 	}
@@ -48,12 +48,15 @@ func TestNewKurtosisClusterConfigNonsenseType(t *testing.T) {
 func TestNewKurtosisClusterConfigKubernetesPartialConfig(t *testing.T) {
 	kubernetesType := KurtosisClusterType_Kubernetes.String()
 	kubernetesClusterName := "some-name"
-	kubernetesPartialConfig := v2.KubernetesClusterConfigV2{
+	kubernetesPartialConfig := v5.KubernetesClusterConfigV5{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           nil,
 		EnclaveSizeInMegabytes: nil,
+		ServiceExposure:        nil,
+		ServiceWorkload:        nil,
+		TaskHelperImage:        nil,
 	}
-	kurtosisClusterConfigOverrides := v2.KurtosisClusterConfigV2{
+	kurtosisClusterConfigOverrides := v5.KurtosisClusterConfigV5{
 		Type:   &kubernetesType,
 		Config: &kubernetesPartialConfig,
 	}
@@ -66,15 +69,67 @@ func TestNewKurtosisClusterConfigKubernetesFullConfig(t *testing.T) {
 	kubernetesClusterName := "some-name"
 	kubernetesStorageClass := "some-storage-class"
 	kubernetesEnclaveSizeInMB := uint(5)
-	kubernetesFullConfig := v2.KubernetesClusterConfigV2{
+	kubernetesFullConfig := v5.KubernetesClusterConfigV5{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           &kubernetesStorageClass,
 		EnclaveSizeInMegabytes: &kubernetesEnclaveSizeInMB,
+		ServiceExposure:        nil,
+		ServiceWorkload:        nil,
+		TaskHelperImage:        nil,
 	}
-	kurtosisClusterConfigOverrides := v2.KurtosisClusterConfigV2{
+	kurtosisClusterConfigOverrides := v5.KurtosisClusterConfigV5{
 		Type:   &kubernetesType,
 		Config: &kubernetesFullConfig,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.NoError(t, err)
 }
+
+func TestNewKurtosisClusterConfigKubernetesServiceExposure(t *testing.T) {
+	exposureType := "http-route"
+	hostnameTemplate := "{{.PortId}}-{{.ServiceName}}-{{.EnclaveShortUuid}}.kurtosis.example.com"
+	gatewayName := "kurtosis-gateway"
+	serviceExposureOverrides := &v5.ServiceExposureConfigV5{
+		Type:             &exposureType,
+		HostnameTemplate: &hostnameTemplate,
+		Https:            nil,
+		IngressClassName: nil,
+		GatewayName:      &gatewayName,
+		GatewayNamespace: nil,
+	}
+	serviceExposure, err := getKubernetesServiceExposureConfig(serviceExposureOverrides)
+	require.NoError(t, err)
+	require.Equal(t, exposureType, serviceExposure.Type)
+	require.Equal(t, gatewayName, serviceExposure.GatewayName)
+	require.False(t, serviceExposure.IsHttps)
+
+	serviceExposureOverrides.GatewayName = nil
+	_, err = getKubernetesServiceExposureConfig(serviceExposureOverrides)
+	require.Error(t, err)
+}
+
+func TestNewKurtosisClusterConfigKubernetesServiceWorkload(t *testing.T) {
+	kubernetesType := KurtosisClusterType_Kubernetes.String()
+	kubernetesClusterName := "some-name"
+	kubernetesStorageClass := "some-storage-class"
+	serviceWorkload := "stateful-set"
+	kubernetesConfig := v5.KubernetesClusterConfigV5{
+		KubernetesClusterName:  &kubernetesClusterName,
+		StorageClass:           &kubernetesStorageClass,
+		EnclaveSizeInMegabytes: nil,
+		ServiceExposure:        nil,
+		ServiceWorkload:        &serviceWorkload,
+		TaskHelperImage:        nil,
+	}
+	kurtosisClusterConfigOverrides := v5.KurtosisClusterConfigV5{
+		Type:   &kubernetesType,
+		Config: &kubernetesConfig,
+	}
+	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.NoError(t, err)
+
+	invalidServiceWorkload := "deployment"
+	kubernetesConfig.ServiceWorkload = &invalidServiceWorkload
+	_, err = NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.Error(t, err)
+}
@@ -1,8 +1,9 @@
 package resolved_config
 
 import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
-	v2 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	v5 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v5"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -37,11 +38,12 @@ the overrides on top of the default config.
 */
 type KurtosisConfig struct {
 	// Only necessary to store for when we serialize overrides
-	overrides *v2.KurtosisConfigV2
+	overrides *v5.KurtosisConfigV5
 
 	shouldSendMetrics bool
 	clusters          map[string]*KurtosisClusterConfig
 	cloudConfig       *KurtosisCloudConfig
+	gitHosts          map[string]*KurtosisGitHostConfig
 }
 
 // NewKurtosisConfigFromOverrides constructs a new KurtosisConfig that uses the given overrides
@@ -58,6 +60,7 @@ func NewKurtosisConfigFromOverrides(uncastedOverrides interface{}) (*KurtosisCon
 		shouldSendMetrics: false,
 		clusters:          nil,
 		cloudConfig:       nil,
+		gitHosts:          nil,
 	}
 
 	// Get latest config version
@@ -125,21 +128,43 @@ func NewKurtosisConfigFromOverrides(uncastedOverrides interface{}) (*KurtosisCon
 		}
 	}
 
+	gitHosts := map[string]*KurtosisGitHostConfig{}
+	for host, overridesForGitHost := range overrides.GitHosts {
+		if len(host) < 1 {
+			return nil, stacktrace.NewError("The host of a git host config must be nonempty")
+		}
+		if !shared_utils.IsValidGitHost(host) {
+			return nil, stacktrace.NewError("Git host '%v' isn't valid, it must be a lowercase hostname with an optional port like 'gitlab.com' or 'gitea:3000'", host)
+		}
+		if overridesForGitHost == nil {
+			return nil, stacktrace.NewError("The config of git host '%v' must be nonempty", host)
+		}
+		gitHosts[host] = &KurtosisGitHostConfig{
+			CloneUrlPrefix:        getOrEmpty(overridesForGitHost.CloneUrlPrefix),
+			Username:              getOrEmpty(overridesForGitHost.Username),
+			Password:              getOrEmpty(overridesForGitHost.Password),
+			SshPrivateKeyFilepath: getOrEmpty(overridesForGitHost.SshPrivateKeyFilepath),
+			SshKnownHostsFilepath: getOrEmpty(overridesForGitHost.SshKnownHostsFilepath),
+		}
+	}
+
 	return &KurtosisConfig{
 		overrides:         overrides,
 		shouldSendMetrics: shouldSendMetrics,
 		clusters:          allClusterConfigs,
 		cloudConfig:       cloudConfig,
+		gitHosts:          gitHosts,
 	}, nil
 }
 
 // NOTE: We probably want to remove this function entirely
 func NewKurtosisConfigFromRequiredFields(shouldSendMetrics bool) (*KurtosisConfig, error) {
-	overrides := &v2.KurtosisConfigV2{
+	overrides := &v5.KurtosisConfigV5{
 		ConfigVersion:     0,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		GitHosts:          nil,
 	}
 	result, err := NewKurtosisConfigFromOverrides(overrides)
 	if err != nil {
@@ -154,6 +179,7 @@ func NewKurtosisConfigWithMetricsSetFromExistingConfig(config *KurtosisConfig, s
 		shouldSendMetrics: shouldSendMetrics,
 		clusters:          config.clusters,
 		cloudConfig:       config.cloudConfig,
+		gitHosts:          config.gitHosts,
 	}
 	newConfig.overrides.ShouldSendMetrics = &shouldSendMetrics
 	return newConfig
@@ -167,7 +193,7 @@ func (kurtosisConfig *KurtosisConfig) GetKurtosisClusters() map[string]*Kurtosis
 	return kurtosisConfig.clusters
 }
 
-func (kurtosisConfig *KurtosisConfig) GetOverrides() *v2.KurtosisConfigV2 {
+func (kurtosisConfig *KurtosisConfig) GetOverrides() *v5.KurtosisConfigV5 {
 	return kurtosisConfig.overrides
 }
 
@@ -175,38 +201,53 @@ func (kurtosisConfig *KurtosisConfig) GetCloudConfig() *KurtosisCloudConfig {
 	return kurtosisConfig.cloudConfig
 }
 
+// GetGitHosts returns the configs of the git hosts other than GitHub, keyed by their host, e.g. 'gitlab.com'
+func (kurtosisConfig *KurtosisConfig) GetGitHosts() map[string]*KurtosisGitHostConfig {
+	return kurtosisConfig.gitHosts
+}
+
 // ====================================================================================================
 //
 //	Private Helpers
 //
 // ====================================================================================================
 // This is a separate helper function so that we can use it to ensure that the
-func castUncastedOverrides(uncastedOverrides interface{}) (*v2.KurtosisConfigV2, error) {
-	castedOverrides, ok := uncastedOverrides.(*v2.KurtosisConfigV2)
+func castUncastedOverrides(uncastedOverrides interface{}) (*v5.KurtosisConfigV5, error) {
+	castedOverrides, ok := uncastedOverrides.(*v5.KurtosisConfigV5)
 	if !ok {
 		return nil, stacktrace.NewError("An error occurred casting the uncasted config overrides to the right version")
 	}
 	return castedOverrides, nil
 }
 
-func getDefaultKurtosisClusterConfigOverrides() map[string]*v2.KurtosisClusterConfigV2 {
+func getOrEmpty(maybeValue *string) string {
+	if maybeValue == nil {
+		return ""
+	}
+	return *maybeValue
+}
+
+func getDefaultKurtosisClusterConfigOverrides() map[string]*v5.KurtosisClusterConfigV5 {
 	dockerClusterType := KurtosisClusterType_Docker.String()
 	minikubeClusterType := KurtosisClusterType_Kubernetes.String()
 	minikubeKubernetesClusterName := defaultMinikubeClusterKubernetesClusterNameStr
 	minikubeStorageClass := defaultMinikubeStorageClass
 	minikubeEnclaveDataVolSizeMB := defaultMinikubeEnclaveDataVolumeMB
 
-	result := map[string]*v2.KurtosisClusterConfigV2{
+	result := map[string]*v5.KurtosisClusterConfigV5{
 		DefaultDockerClusterName: {
 			Type:   &dockerClusterType,
 			Config: nil, // Must be nil for Docker
 		},
 		defaultMinikubeClusterName: {
 			Type: &minikubeClusterType,
-			Config: &v2.KubernetesClusterConfigV2{
+			Config: &v5.KubernetesClusterConfigV5{
 				KubernetesClusterName:  &minikubeKubernetesClusterName,
 				StorageClass:           &minikubeStorageClass,
 				EnclaveSizeInMegabytes: &minikubeEnclaveDataVolSizeMB,
+				ServiceExposure:        nil,
+				ServiceWorkload:        nil,
+				TaskHelperImage:        nil,
 			},
 		},
 	}
@@ -3,7 +3,7 @@ package resolved_config
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects"
-	v2 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	v5 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v5"
 	"github.com/stretchr/testify/require"
 	"sort"
 	"testing"
@@ -50,11 +50,12 @@ func TestNewKurtosisConfigFromRequiredFields_MetricsElectionIsSent(t *testing.T)
 }
 
 func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
-	_, err := NewKurtosisConfigFromOverrides(&v2.KurtosisConfigV2{
+	_, err := NewKurtosisConfigFromOverrides(&v5.KurtosisConfigV5{
 		ConfigVersion:     0,
 		ShouldSendMetrics: nil,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		GitHosts:          nil,
 	})
 	// You can not initialize a Kurtosis config with empty overrides - it needs at least `ShouldSendMetrics`
 	require.Error(t, err)
@@ -63,11 +64,12 @@ func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
 func TestNewKurtosisConfigJustMetrics(t *testing.T) {
 	version := config_version.ConfigVersion_v0
 	shouldSendMetrics := true
-	originalOverrides := v2.KurtosisConfigV2{
+	originalOverrides := v5.KurtosisConfigV5{
 		ConfigVersion:     version,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		GitHosts:          nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	// You can not initialize a Kurtosis config with empty originalOverrides - it needs at least `ShouldSendMetrics`
@@ -94,15 +96,16 @@ func TestCloudConfigOverridesApiUrl(t *testing.T) {
 	version := config_version.ConfigVersion_v0
 	shouldSendMetrics := true
 	apiUrl := "test.com"
-	originalOverrides := v2.KurtosisConfigV2{
+	originalOverrides := v5.KurtosisConfigV5{
 		ConfigVersion:     version,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
-		CloudConfig: &v2.KurtosisCloudConfigV2{
+		CloudConfig: &v5.KurtosisCloudConfigV5{
 			ApiUrl:           &apiUrl,
 			Port:             nil,
 			CertificateChain: nil,
 		},
+		GitHosts: nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	require.NoError(t, err)
@@ -112,3 +115,54 @@ func TestCloudConfigOverridesApiUrl(t *testing.T) {
 	require.Nil(t, overrides.CloudConfig.Port)
 	require.Nil(t, overrides.CloudConfig.CertificateChain)
 }
+
+func TestGitHostsOverrides(t *testing.T) {
+	shouldSendMetrics := true
+	cloneUrlPrefix := "ssh://git@gitea.example.com:2222"
+	sshPrivateKeyFilepath := "/home/user/.ssh/id_ed25519"
+	originalOverrides := v5.KurtosisConfigV5{
+		ConfigVersion:     config_version.ConfigVersion_v5,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		GitHosts: map[string]*v5.GitHostConfigV5{
+			"gitea.example.com": {
+				CloneUrlPrefix:        &cloneUrlPrefix,
+				Username:              nil,
+				Password:              nil,
+				SshPrivateKeyFilepath: &sshPrivateKeyFilepath,
+				SshKnownHostsFilepath: nil,
+			},
+		},
+	}
+	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
+	require.NoError(t, err)
+
+	gitHostConfig, found := config.GetGitHosts()["gitea.example.com"]
+	require.True(t, found)
+	require.Equal(t, cloneUrlPrefix, gitHostConfig.CloneUrlPrefix)
+	require.Equal(t, sshPrivateKeyFilepath, gitHostConfig.SshPrivateKeyFilepath)
+	require.Empty(t, gitHostConfig.Password)
+}
+
+func TestGitHostsOverrides_FailsForInvalidHost(t *testing.T) {
+	shouldSendMetrics := true
+	originalOverrides := v5.KurtosisConfigV5{
+		ConfigVersion:     config_version.ConfigVersion_v5,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		GitHosts: map[string]*v5.GitHostConfigV5{
+			"https://gitea.example.com": {
+				CloneUrlPrefix:        nil,
+				Username:              nil,
+				Password:              nil,
+				SshPrivateKeyFilepath: nil,
+				SshKnownHostsFilepath: nil,
+			},
+		},
+	}
+	_, err := NewKurtosisConfigFromOverrides(&originalOverrides)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "isn't valid")
+}
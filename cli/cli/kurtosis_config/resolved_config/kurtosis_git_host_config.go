@@ -0,0 +1,11 @@
+package resolved_config
+
+// KurtosisGitHostConfig describes how to clone the packages hosted on a git host other than GitHub
+// Empty values mean the default is used, i.e. cloning over HTTPS without credentials
+type KurtosisGitHostConfig struct {
+	CloneUrlPrefix        string
+	Username              string
+	Password              string
+	SshPrivateKeyFilepath string
+	SshKnownHostsFilepath string
+}
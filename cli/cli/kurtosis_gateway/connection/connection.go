@@ -29,7 +29,8 @@ const (
 type GatewayConnectionToKurtosis interface {
 	// GetLocalPorts returns a map keyed with an identifier string describing local ports being forwarded
 	GetLocalPorts() map[string]*port_spec.PortSpec
-	GetGrpcClientConn() (*grpc.ClientConn, error)
+	// GetGrpcClientConn dials over plaintext unless dial options are passed
+	GetGrpcClientConn(dialOptions ...grpc.DialOption) (*grpc.ClientConn, error)
 	Stop()
 }
 
@@ -203,7 +204,7 @@ func (connection *gatewayConnectionToKurtosisImpl) GetLocalPorts() map[string]*p
 
 // GetGrpcClientConn returns a client conn dialed in to the local port
 // It is the caller's responsibility to call resultClientConn.close()
-func (connection *gatewayConnectionToKurtosisImpl) GetGrpcClientConn() (resultClientConn *grpc.ClientConn, resultErr error) {
+func (connection *gatewayConnectionToKurtosisImpl) GetGrpcClientConn(dialOptions ...grpc.DialOption) (resultClientConn *grpc.ClientConn, resultErr error) {
 	localPorts := connection.GetLocalPorts()
 	localGrpcPort, isFound := localPorts[grpcPortId]
 	if !isFound {
@@ -211,7 +212,10 @@ func (connection *gatewayConnectionToKurtosisImpl) GetGrpcClientConn() (resultCl
 	}
 	localGrpcPortNum := localPorts[grpcPortId].GetNumber()
 	localGrpcServerAddress := fmt.Sprintf("%v:%v", localHostIpStr, localGrpcPortNum)
-	grpcConnection, err := grpc.Dial(localGrpcServerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if len(dialOptions) == 0 {
+		dialOptions = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	grpcConnection, err := grpc.Dial(localGrpcServerAddress, dialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to create a GRPC client connection on address '%v', but a non-nil error was returned", localGrpcServerAddress)
 	}
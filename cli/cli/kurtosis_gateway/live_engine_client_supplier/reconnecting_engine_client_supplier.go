@@ -5,10 +5,12 @@ import (
 	"time"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -165,8 +167,17 @@ func (supplier *LiveEngineClientSupplier) replaceCurrentEngineInfo(newEngine *en
 			newProxyConn.Stop()
 		}
 	}()
+	// The gateway calls the engine with the credentials of the current context, if the engine requires any
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred retrieving the current Kurtosis context")
+	}
+	engineDialOptions, err := kurtosis_context.GetEngineDialOptions(currentContext.GetEngineAuth())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred building the options to dial engine '%v'", newEngine.GetGUID())
+	}
 	// Create an engine client that sends requests to our new client
-	newGrpcConnection, err := newProxyConn.GetGrpcClientConn()
+	newGrpcConnection, err := newProxyConn.GetGrpcClientConn(engineDialOptions...)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to get a GRPC client connection to engine '%v', instead a non-nil error was returned", newEngine.GetGUID())
 	}
@@ -1,10 +1,14 @@
 package api_container_gateway
 
 import (
+	"context"
+
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/server/api_container_gateway"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
 	minimal_grpc_server "github.com/kurtosis-tech/minimal-grpc-server/golang/server"
 	"github.com/kurtosis-tech/stacktrace"
 	"google.golang.org/grpc"
@@ -15,15 +19,25 @@ const (
 	grpcServerStopGracePeriod = 5 * time.Second
 )
 
-func RunApiContainerGatewayUntilStopped(connectionProvider *connection.GatewayConnectionProvider, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, gatewayPort uint16, gatewayStopChannel chan struct{}) error {
+func RunApiContainerGatewayUntilStopped(connectionProvider *connection.GatewayConnectionProvider, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, gatewayPort uint16, gatewayStopChannel chan struct{}) error {
 	apiContainerConnection, err := connectionProvider.ForEnclaveApiContainer(enclaveInfo)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to start forwarding ports to an enclave API container, instead a non nil error was returned")
 	}
 	defer apiContainerConnection.Stop()
 
+	// The gateway calls the API container with the credentials of the current context, if the engine requires any
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred retrieving the current Kurtosis context")
+	}
+	apiContainerDialOptions, err := kurtosis_context.GetApiContainerDialOptions(context.Background(), engineClient, currentContext.GetEngineAuth(), enclaveInfo.GetEnclaveUuid())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred building the options to dial the API container of enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+
 	// Dial in to our locally forwarded port
-	apiContainerGrpcClientConn, err := apiContainerConnection.GetGrpcClientConn()
+	apiContainerGrpcClientConn, err := apiContainerConnection.GetGrpcClientConn(apiContainerDialOptions...)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to create a grpc client connection to the forwarded API container port, instead a non nil error was returned")
 	}
@@ -147,6 +147,29 @@ func (service *ApiContainerGatewayServiceServer) ExecCommand(ctx context.Context
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) ExecCommandStream(server kurtosis_core_rpc_api_bindings.ApiContainerService_ExecCommandStreamServer) error {
+	ctx, cancel := context.WithCancel(server.Context())
+	defer cancel()
+	client, err := service.remoteApiContainerClient.ExecCommandStream(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	go forwardExecCommandStreamRequests(server, client)
+	for {
+		response, readErr := client.Recv()
+		if readErr == io.EOF {
+			logrus.Debug("Finished reading from the exec command stream.")
+			return nil
+		}
+		if readErr != nil {
+			return stacktrace.Propagate(readErr, "Error reading exec command output from Kurtosis core stream")
+		}
+		if writeErr := server.Send(response); writeErr != nil {
+			return stacktrace.Propagate(writeErr, "Received exec command output but failed forwarding it back to the user")
+		}
+	}
+}
+
 func (service *ApiContainerGatewayServiceServer) WaitForHttpGetEndpointAvailability(ctx context.Context, args *kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs) (*emptypb.Empty, error) {
 	remoteApiContainerResponse, err := service.remoteApiContainerClient.WaitForHttpGetEndpointAvailability(ctx, args)
 	if err != nil {
@@ -192,6 +215,15 @@ func (service *ApiContainerGatewayServiceServer) StoreFilesArtifactFromService(c
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) CopyFilesArtifactToService(ctx context.Context, args *kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.CopyFilesArtifactToService(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+
+	return remoteApiContainerResponse, nil
+}
+
 func (service *ApiContainerGatewayServiceServer) InspectFilesArtifactContents(ctx context.Context, args *kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest) (*kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse, error) {
 	remoteApiContainerResponse, err := service.remoteApiContainerClient.InspectFilesArtifactContents(ctx, args)
 	if err != nil {
@@ -230,6 +262,17 @@ func (service *ApiContainerGatewayServiceServer) GetStarlarkRun(ctx context.Cont
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) WatchStarlarkRunProgress(args *emptypb.Empty, streamToWriteTo kurtosis_core_rpc_api_bindings.ApiContainerService_WatchStarlarkRunProgressServer) error {
+	streamToReadFrom, err := service.remoteApiContainerClient.WatchStarlarkRunProgress(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding the progress of the Starlark runs from Kurtosis core back to the user")
+	}
+	return nil
+}
+
 func (service *ApiContainerGatewayServiceServer) GetStarlarkScriptPlanYaml(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs) (*kurtosis_core_rpc_api_bindings.PlanYaml, error) {
 	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetStarlarkScriptPlanYaml(ctx, args)
 	if err != nil {
@@ -246,6 +289,14 @@ func (service *ApiContainerGatewayServiceServer) GetStarlarkPackagePlanYaml(ctx
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) GetResolvedPackageDependencies(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetResolvedPackageDependenciesResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetResolvedPackageDependencies(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -450,3 +501,29 @@ func forwardDataChunkStreamWithClose[T any, R dataChunkStreamReceiverSenderAndCl
 	}
 	return nil
 }
+
+// forwardExecCommandStreamRequests forwards the STDIN and terminal resizes sent by the user to the remote API container
+// until the user stops sending, half-closing the remote stream once done
+func forwardExecCommandStreamRequests(
+	streamToReadFrom kurtosis_core_rpc_api_bindings.ApiContainerService_ExecCommandStreamServer,
+	streamToWriteTo kurtosis_core_rpc_api_bindings.ApiContainerService_ExecCommandStreamClient,
+) {
+	defer func() {
+		if err := streamToWriteTo.CloseSend(); err != nil {
+			logrus.Debugf("Error closing the exec command stream to the remote API container: %v", err)
+		}
+	}()
+	for {
+		request, readErr := streamToReadFrom.Recv()
+		if readErr != nil {
+			if readErr != io.EOF {
+				logrus.Debugf("Stopped reading exec command requests from the user: %v", readErr)
+			}
+			return
+		}
+		if writeErr := streamToWriteTo.Send(request); writeErr != nil {
+			logrus.Debugf("Failed forwarding an exec command request to the remote API container: %v", writeErr)
+			return
+		}
+	}
+}
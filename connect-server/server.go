@@ -2,6 +2,7 @@ package connect_server
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log"
 	"net/http"
@@ -26,6 +27,7 @@ type ConnectServer struct {
 	path            string
 	handler         http.Handler
 	stopGracePeriod time.Duration // How long we'll give the server to stop after asking nicely before we kill it
+	tlsConfig       *tls.Config   // Nil if the server is served in plaintext
 }
 
 func NewConnectServer(listenPort uint16, stopGracePeriod time.Duration, handler http.Handler, path string) *ConnectServer {
@@ -34,8 +36,22 @@ func NewConnectServer(listenPort uint16, stopGracePeriod time.Duration, handler
 		stopGracePeriod: stopGracePeriod,
 		handler:         handler,
 		path:            path,
+		tlsConfig:       nil,
 	}
 }
+
+// NewConnectServerWithTls creates a server serving over TLS with the given config, which must contain the server
+// certificate
+func NewConnectServerWithTls(listenPort uint16, stopGracePeriod time.Duration, handler http.Handler, path string, tlsConfig *tls.Config) *ConnectServer {
+	return &ConnectServer{
+		listenPort:      listenPort,
+		stopGracePeriod: stopGracePeriod,
+		handler:         handler,
+		path:            path,
+		tlsConfig:       tlsConfig,
+	}
+}
+
 func (server *ConnectServer) RunServerUntilInterrupted() error {
 	return server.RunServerUntilInterruptedWithCors(cors.Default())
 }
@@ -72,7 +88,15 @@ func (server *ConnectServer) RunServerUntilStopped(
 	}
 
 	go func() {
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if server.tlsConfig != nil {
+			httpServer.TLSConfig = server.tlsConfig
+			// The certificate is already in the TLS config
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = httpServer.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			logrus.Infof("Error occurred while starting the server, error: %+v", err)
 		}
 	}()
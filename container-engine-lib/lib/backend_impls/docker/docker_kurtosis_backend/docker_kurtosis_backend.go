@@ -31,6 +31,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
@@ -219,21 +220,11 @@ func (backend *DockerKurtosisBackend) StartRegisteredUserServices(ctx context.Co
 		)
 	}
 
-	logsCollector, err := backend.GetLogsCollectorForEnclave(ctx, enclaveUuid)
+	logsCollector, logsCollectorAvailabilityChecker, err := backend.getRunningLogsCollectorForEnclave(ctx, enclaveUuid)
 	if err != nil {
-		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the logs collector")
-	}
-	if logsCollector == nil || logsCollector.GetStatus() != container.ContainerStatus_Running {
-		return nil, nil, stacktrace.NewError("The user services can't be started because no logs collector is running for it to send logs to.")
-	}
-
-	logsCollectorIpAddressInEnclaveNetwork := logsCollector.GetEnclaveNetworkIpAddress()
-	if logsCollectorIpAddressInEnclaveNetwork == nil {
-		return nil, nil, stacktrace.NewError("Expected the logs collector to have an ip address in the enclave network but it does not.")
+		return nil, nil, stacktrace.Propagate(err, "The user services can't be started because no logs collector is available for them to send logs to")
 	}
 
-	logsCollectorAvailabilityChecker := fluentbit.NewFluentbitAvailabilityChecker(logsCollectorIpAddressInEnclaveNetwork, logsCollector.GetPrivateHttpPort().GetNumber())
-
 	var restartPolicy docker_manager.RestartPolicy = docker_manager.NoRestart
 	if backend.productionMode {
 		restartPolicy = docker_manager.RestartAlways
@@ -315,6 +306,16 @@ func (backend *DockerKurtosisBackend) RunUserServiceExecCommandWithStreamedOutpu
 	return user_service_functions.RunUserServiceExecCommandWithStreamedOutput(ctx, enclaveUuid, serviceUuid, cmd, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) RunUserServiceInteractiveExecCommand(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	cmd []string,
+	streams *interactive_exec.InteractiveExecStreams,
+) (int32, error) {
+	return user_service_functions.RunUserServiceInteractiveExecCommand(ctx, enclaveUuid, serviceUuid, cmd, streams, backend.dockerManager)
+}
+
 func (backend *DockerKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) error {
 	return user_service_functions.GetShellOnUserService(ctx, enclaveUuid, serviceUuid, backend.dockerManager)
 }
@@ -330,6 +331,57 @@ func (backend *DockerKurtosisBackend) CopyFilesFromUserService(
 	return user_service_functions.CopyFilesFromUserService(ctx, enclaveUuid, serviceUuid, srcPathOnContainer, output, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	destDirpathOnContainer string,
+	input io.Reader,
+) error {
+	return user_service_functions.CopyFilesToUserService(ctx, enclaveUuid, serviceUuid, destDirpathOnContainer, input, backend.dockerManager)
+}
+
+// NOTE: This function will block until the task exits
+func (backend *DockerKurtosisBackend) RunUserTask(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	taskUuid service.ServiceUUID,
+	taskName service.ServiceName,
+	taskConfig *service.ServiceConfig,
+	filesToStore map[string]io.Writer,
+	shouldRemoveTaskInBackground bool,
+) (*exec_result.ExecResult, error) {
+	freeIpAddrProviderForEnclave, found := backend.enclaveFreeIpProviders[enclaveUuid]
+	if !found {
+		return nil, stacktrace.NewError(
+			"Received a request to run a task in enclave '%v', but no free IP address provider was "+
+				"defined for this enclave; this likely means that the run request is being called where it shouldn't "+
+				"be (i.e. outside the API container)",
+			enclaveUuid,
+		)
+	}
+
+	logsCollector, logsCollectorAvailabilityChecker, err := backend.getRunningLogsCollectorForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "The task can't be run because no logs collector is available for it to send logs to")
+	}
+
+	return user_service_functions.RunUserTask(
+		ctx,
+		enclaveUuid,
+		taskUuid,
+		taskName,
+		taskConfig,
+		filesToStore,
+		shouldRemoveTaskInBackground,
+		logsCollector,
+		logsCollectorAvailabilityChecker,
+		backend.objAttrsProvider,
+		freeIpAddrProviderForEnclave,
+		backend.dockerManager,
+	)
+}
+
 func (backend *DockerKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -618,3 +670,22 @@ func (backend *DockerKurtosisBackend) getGitHubAuthStorageVolume(ctx context.Con
 	volume := foundVolumes[0]
 	return volume.Name, nil
 }
+
+// Guaranteed to either return a running logs collector, with a checker for its availability, or throw an error
+func (backend *DockerKurtosisBackend) getRunningLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (*logs_collector.LogsCollector, logs_collector_functions.LogsCollectorAvailabilityChecker, error) {
+	logsCollector, err := backend.GetLogsCollectorForEnclave(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the logs collector")
+	}
+	if logsCollector == nil || logsCollector.GetStatus() != container.ContainerStatus_Running {
+		return nil, nil, stacktrace.NewError("No logs collector is running in enclave '%v'", enclaveUuid)
+	}
+
+	logsCollectorIpAddressInEnclaveNetwork := logsCollector.GetEnclaveNetworkIpAddress()
+	if logsCollectorIpAddressInEnclaveNetwork == nil {
+		return nil, nil, stacktrace.NewError("Expected the logs collector to have an ip address in the enclave network but it does not.")
+	}
+
+	logsCollectorAvailabilityChecker := fluentbit.NewFluentbitAvailabilityChecker(logsCollectorIpAddressInEnclaveNetwork, logsCollector.GetPrivateHttpPort().GetNumber())
+	return logsCollector, logsCollectorAvailabilityChecker, nil
+}
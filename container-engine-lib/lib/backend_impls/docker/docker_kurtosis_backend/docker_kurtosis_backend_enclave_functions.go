@@ -2,6 +2,7 @@ package docker_kurtosis_backend
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 	"time"
 
@@ -35,7 +36,15 @@ type matchingNetworkInformation struct {
 	containers    []*types.Container
 }
 
-func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
+func (backend *DockerKurtosisBackend) CreateEnclave(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveName string,
+	enclaveLabels map[string]string,
+	expiration *enclave.EnclaveExpiration,
+	resourceQuota *enclave.EnclaveResourceQuota,
+	owner string,
+) (*enclave.Enclave, error) {
 	teardownCtx := context.Background() // Separate context for tearing stuff down in case the input context is cancelled
 
 	searchNetworkLabels := map[string]string{
@@ -71,7 +80,7 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 
 	creationTime := time.Now()
 
-	enclaveNetworkAttrs, err := enclaveObjAttrsProvider.ForEnclaveNetwork(enclaveName, creationTime)
+	enclaveNetworkAttrs, err := enclaveObjAttrsProvider.ForEnclaveNetwork(enclaveName, creationTime, enclaveLabels, expiration, resourceQuota, owner)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while trying to get the enclave network attributes for the enclave with ID '%v'", enclaveUuid)
 	}
@@ -144,7 +153,7 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 	}()
 
 	// TODO: return production mode for create enclave request as well
-	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, false)
+	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, false, enclaveLabels, expiration, resourceQuota, owner)
 
 	if err := backend.ConnectReverseProxyToNetwork(ctx, networkId); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred connecting the reverse proxy to the enclave network with ID '%v'", networkId)
@@ -179,6 +188,11 @@ func (backend *DockerKurtosisBackend) GetEnclaves(
 		return nil, stacktrace.Propagate(err, "An error occurred getting enclave networks matching filters '%+v'", filters)
 	}
 
+	updatedExpirations, err := backend.getUpdatedEnclaveExpirations(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the updated enclave expirations")
+	}
+
 	result := map[enclave.EnclaveUUID]*enclave.Enclave{}
 	for enclaveUuid, matchingNetworkInfo := range allMatchingNetworkInfo {
 		productionMode := false
@@ -189,6 +203,26 @@ func (backend *DockerKurtosisBackend) GetEnclaves(
 
 		enclaveName := getEnclaveNameFromNetwork(matchingNetworkInfo.dockerNetwork)
 
+		enclaveLabels, err := getEnclaveLabelsFromNetwork(matchingNetworkInfo.dockerNetwork)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the enclave's labels from enclave's Docker network '%+v'", matchingNetworkInfo.dockerNetwork)
+		}
+
+		expiration, err := getEnclaveExpirationFromNetwork(matchingNetworkInfo.dockerNetwork)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the enclave's expiration from enclave's Docker network '%+v'", matchingNetworkInfo.dockerNetwork)
+		}
+		if updatedExpiration, found := updatedExpirations[enclaveUuid]; found && expiration != nil {
+			expiration = updatedExpiration
+		}
+
+		resourceQuota, err := getEnclaveResourceQuotaFromNetwork(matchingNetworkInfo.dockerNetwork)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the enclave's resource quota from enclave's Docker network '%+v'", matchingNetworkInfo.dockerNetwork)
+		}
+
+		enclaveOwner := getEnclaveOwnerFromNetwork(matchingNetworkInfo.dockerNetwork)
+
 		// This method just looks for api-container ( which only can be one) for an enclave
 		// and extracts out whether enclave is running on production mode
 		for _, container := range matchingNetworkInfo.containers {
@@ -213,6 +247,10 @@ func (backend *DockerKurtosisBackend) GetEnclaves(
 			matchingNetworkInfo.enclaveStatus,
 			creationTime,
 			productionMode,
+			enclaveLabels,
+			expiration,
+			resourceQuota,
+			enclaveOwner,
 		)
 	}
 
@@ -414,11 +452,79 @@ func (backend *DockerKurtosisBackend) UpdateEnclave(
 	return stacktrace.NewError("UpdateEnclave isn't implemented for Docker yet")
 }
 
+// UpdateEnclaveExpiration records the new expiration in the labels of a dedicated volume, as the labels of the enclave
+// network can't be changed. The volume is removed along with the other enclave volumes when the enclave is destroyed
+func (backend *DockerKurtosisBackend) UpdateEnclaveExpiration(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	expiration *enclave.EnclaveExpiration,
+) error {
+	enclaveObjAttrsProvider, err := backend.objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", enclaveUuid)
+	}
+	expirationVolumeAttrs, err := enclaveObjAttrsProvider.ForEnclaveExpirationVolume(expiration)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave expiration volume attributes for enclave with ID '%v'", enclaveUuid)
+	}
+
+	expirationVolumeNameStr := expirationVolumeAttrs.GetName().GetString()
+	expirationVolumeLabelStrs := map[string]string{}
+	for labelKey, labelValue := range expirationVolumeAttrs.GetLabels() {
+		expirationVolumeLabelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+	if err := backend.dockerManager.CreateVolume(ctx, expirationVolumeNameStr, expirationVolumeLabelStrs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating enclave expiration volume with name '%v' and labels '%+v'", expirationVolumeNameStr, expirationVolumeLabelStrs)
+	}
+
+	// the previous records are only removed once the new one exists, so that the expiration is never lost
+	previousExpirationVolumes, err := backend.dockerManager.GetVolumesByLabels(ctx, getEnclaveExpirationVolumeSearchLabels(enclaveUuid))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave expiration volumes of enclave with ID '%v'", enclaveUuid)
+	}
+	for _, previousExpirationVolume := range previousExpirationVolumes {
+		if previousExpirationVolume.Name == expirationVolumeNameStr {
+			continue
+		}
+		if err := backend.dockerManager.RemoveVolume(ctx, previousExpirationVolume.Name); err != nil {
+			// the most recent expiration wins when reading them back, so a leftover record is harmless
+			logrus.Warnf("An error occurred removing the previous expiration volume '%v' of enclave '%v':\n%v", previousExpirationVolume.Name, enclaveUuid, err)
+		}
+	}
+	return nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
 //
 // ====================================================================================================
+// getUpdatedEnclaveExpirations returns the most recent expiration recorded by UpdateEnclaveExpiration for each enclave
+func (backend *DockerKurtosisBackend) getUpdatedEnclaveExpirations(ctx context.Context) (map[enclave.EnclaveUUID]*enclave.EnclaveExpiration, error) {
+	noEnclaveUuid := enclave.EnclaveUUID("")
+	expirationVolumes, err := backend.dockerManager.GetVolumesByLabels(ctx, getEnclaveExpirationVolumeSearchLabels(noEnclaveUuid))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave expiration volumes")
+	}
+
+	result := map[enclave.EnclaveUUID]*enclave.EnclaveExpiration{}
+	for _, expirationVolume := range expirationVolumes {
+		enclaveUuid := enclave.EnclaveUUID(expirationVolume.Labels[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()])
+		expiration, err := getEnclaveExpirationFromLabels(expirationVolume.Labels)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the enclave expiration from volume '%v'", expirationVolume.Name)
+		}
+		if expiration == nil {
+			continue
+		}
+		if previousExpiration, found := result[enclaveUuid]; found && previousExpiration.GetExpirationTime().After(expiration.GetExpirationTime()) {
+			continue
+		}
+		result[enclaveUuid] = expiration
+	}
+	return result, nil
+}
+
 func (backend *DockerKurtosisBackend) getMatchingEnclaveNetworkInfo(
 	ctx context.Context,
 	filters *enclave.EnclaveFilters,
@@ -451,6 +557,16 @@ func (backend *DockerKurtosisBackend) getMatchingEnclaveNetworkInfo(
 			}
 		}
 
+		if len(filters.Labels) > 0 {
+			enclaveLabels, err := getEnclaveLabelsFromNetwork(kurtosisNetwork)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting enclave labels from network '%+v'", kurtosisNetwork)
+			}
+			if !filters.MatchesLabels(enclaveLabels) {
+				continue
+			}
+		}
+
 		matchingKurtosisEnclaveUuidsByNetworkId[enclaveUuid] = kurtosisNetwork
 	}
 
@@ -594,6 +710,19 @@ func (backend *DockerKurtosisBackend) disconnectExternalContainersFromEnclaveNet
 	return successfulEnclaveUuids, erroredEnclaveUuids, nil
 }
 
+// getEnclaveExpirationVolumeSearchLabels returns the labels of the expiration volumes of the given enclave, or of all
+// enclaves if the UUID is empty
+func getEnclaveExpirationVolumeSearchLabels(enclaveUuid enclave.EnclaveUUID) map[string]string {
+	searchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():      label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.VolumeTypeDockerLabelKey.GetString(): label_value_consts.EnclaveExpirationVolumeTypeDockerLabelValue.GetString(),
+	}
+	if enclaveUuid != "" {
+		searchLabels[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()] = string(enclaveUuid)
+	}
+	return searchLabels
+}
+
 func getAllEnclaveVolumes(
 	ctx context.Context,
 	dockerManager *docker_manager.DockerManager,
@@ -847,3 +976,63 @@ func getEnclaveNameFromNetwork(network *types.Network) string {
 
 	return enclaveNameStr
 }
+
+func getEnclaveLabelsFromNetwork(network *types.Network) (map[string]string, error) {
+	labels := network.GetLabels()
+	serializedEnclaveLabels, found := labels[docker_label_key.EnclaveLabelsDockerLabelKey.GetString()]
+	if !found {
+		return map[string]string{}, nil
+	}
+
+	enclaveLabels := map[string]string{}
+	if err := json.Unmarshal([]byte(serializedEnclaveLabels), &enclaveLabels); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing enclave labels '%v'", serializedEnclaveLabels)
+	}
+	return enclaveLabels, nil
+}
+
+func getEnclaveExpirationFromNetwork(network *types.Network) (*enclave.EnclaveExpiration, error) {
+	return getEnclaveExpirationFromLabels(network.GetLabels())
+}
+
+func getEnclaveExpirationFromLabels(labels map[string]string) (*enclave.EnclaveExpiration, error) {
+	expirationTimeStr, found := labels[docker_label_key.EnclaveExpirationTimeDockerLabelKey.GetString()]
+	if !found {
+		// Enclave that never expires
+		return nil, nil
+	}
+
+	expirationTime, err := time.Parse(time.RFC3339, expirationTimeStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing enclave expiration time '%v' using this format '%v'", expirationTimeStr, time.RFC3339)
+	}
+
+	expiryAction := enclave.EnclaveExpiryAction(labels[docker_label_key.EnclaveExpiryActionDockerLabelKey.GetString()])
+	if !expiryAction.IsValid() {
+		return nil, stacktrace.NewError("Invalid enclave expiry action '%v'; valid actions are '%v'", expiryAction, enclave.AllEnclaveExpiryActions)
+	}
+
+	return enclave.NewEnclaveExpiration(expirationTime, expiryAction), nil
+}
+
+func getEnclaveResourceQuotaFromNetwork(network *types.Network) (*enclave.EnclaveResourceQuota, error) {
+	labels := network.GetLabels()
+	serializedResourceQuota, found := labels[docker_label_key.EnclaveResourceQuotaDockerLabelKey.GetString()]
+	if !found {
+		// Enclave without resource quota
+		return nil, nil
+	}
+
+	// nolint: exhaustruct
+	resourceQuota := &enclave.EnclaveResourceQuota{}
+	if err := json.Unmarshal([]byte(serializedResourceQuota), resourceQuota); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing enclave resource quota '%v'", serializedResourceQuota)
+	}
+	return resourceQuota, nil
+}
+
+func getEnclaveOwnerFromNetwork(network *types.Network) string {
+	labels := network.GetLabels()
+	// Enclaves created while the engine wasn't requiring authentication don't have an owner
+	return labels[docker_label_key.EnclaveOwnerDockerLabelKey.GetString()]
+}
@@ -50,6 +50,8 @@ const (
 	numContainersToDumpAtOnce            = 20
 
 	emptyUrl = ""
+
+	noContainerRestarts = 0
 )
 
 // !!!WARNING!!!
@@ -646,12 +648,14 @@ func getUserServiceObjsFromDockerResources(
 			privatePorts,
 			maybePublicIp,
 			maybePublicPorts,
+			nil,
 			container.NewContainer(
 				serviceContainerStatus,
 				serviceContainer.GetImageName(),
 				serviceContainer.GetEntrypointArgs(),
 				serviceContainer.GetCmdArgs(),
 				serviceContainer.GetEnvVars(),
+				noContainerRestarts,
 			),
 		)
 	}
@@ -26,23 +26,13 @@ func CopyFilesFromUserService(
 	output io.Writer,
 	dockerManager *docker_manager.DockerManager,
 ) error {
-
-	srcPath := srcPathOnContainer
-	srcPathBase := filepath.Base(srcPathOnContainer)
-	if srcPathBase == doNotIncludeParentDirInArchiveSymbol {
-		srcPath = filepath.Dir(srcPathOnContainer)
-		srcPath = fmt.Sprintf(ignoreParentDirInArchiveSymbolFormat, srcPath)
-	}
-
-	logrus.Debugf("Copying contents from the src path: %v and base %v", srcPath, srcPathBase)
 	_, serviceDockerResources, err := getSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting user service with UUID '%v' in enclave with ID '%v'", serviceUuid, enclaveId)
 	}
 	container := serviceDockerResources.ServiceContainer
 
-	tarStreamReadCloser, err := dockerManager.CopyFromContainer(ctx, container.GetId(), srcPath)
-	if err != nil {
+	if err := copyFilesFromContainer(ctx, container.GetId(), srcPathOnContainer, output, dockerManager); err != nil {
 		return stacktrace.Propagate(
 			err,
 			"An error occurred copying content from sourcepath '%v' in container '%v' for user service '%v' in enclave '%v'",
@@ -52,14 +42,38 @@ func CopyFilesFromUserService(
 			enclaveId,
 		)
 	}
+	return nil
+}
+
+// copyFilesFromContainer writes the files at the given path of the container, packaged as a TAR, to the output. The
+// container doesn't need to be running
+func copyFilesFromContainer(
+	ctx context.Context,
+	containerId string,
+	srcPathOnContainer string,
+	output io.Writer,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	srcPath := srcPathOnContainer
+	srcPathBase := filepath.Base(srcPathOnContainer)
+	if srcPathBase == doNotIncludeParentDirInArchiveSymbol {
+		srcPath = filepath.Dir(srcPathOnContainer)
+		srcPath = fmt.Sprintf(ignoreParentDirInArchiveSymbolFormat, srcPath)
+	}
+
+	logrus.Debugf("Copying contents from the src path: %v and base %v", srcPath, srcPathBase)
+	tarStreamReadCloser, err := dockerManager.CopyFromContainer(ctx, containerId, srcPath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying content from sourcepath '%v' in container '%v'", srcPathOnContainer, containerId)
+	}
 	defer tarStreamReadCloser.Close()
 
 	if _, err := io.Copy(output, tarStreamReadCloser); err != nil {
 		return stacktrace.Propagate(
 			err,
-			"An error occurred copying the bytes of TAR'd up files at '%v' on service '%v' to the output",
+			"An error occurred copying the bytes of TAR'd up files at '%v' on container '%v' to the output",
 			srcPathOnContainer,
-			serviceUuid,
+			containerId,
 		)
 	}
 
@@ -0,0 +1,144 @@
+package user_service_functions
+
+import (
+	"archive/tar"
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	containerRootDirpath = "/"
+
+	parentDirpath = ".."
+
+	createdDestDirPerms = 0755
+)
+
+// CopyFilesToUserService extracts the TAR stream read from input into the directory at destDirpathOnContainer
+// The archive is uploaded to the root of the container with its entries moved under the destination directory, so that
+// Docker creates the destination directory and its parents without having to run anything in the container
+func CopyFilesToUserService(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	destDirpathOnContainer string,
+	input io.Reader,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	_, serviceDockerResources, err := getSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting user service with UUID '%v' in enclave with ID '%v'", serviceUuid, enclaveId)
+	}
+	container := serviceDockerResources.ServiceContainer
+
+	doesDestDirExist, err := dockerManager.DoesContainerPathExist(ctx, container.GetId(), destDirpathOnContainer)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking whether destination directory '%v' exists in container '%v'", destDirpathOnContainer, container.GetName())
+	}
+
+	archiveReader, archiveWriter := io.Pipe()
+	defer archiveReader.Close()
+	go func() {
+		archiveWriter.CloseWithError(moveArchiveEntriesUnderDirectory(input, archiveWriter, destDirpathOnContainer, !doesDestDirExist))
+	}()
+
+	if err := dockerManager.CopyToContainer(ctx, container.GetId(), containerRootDirpath, archiveReader); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying content to destination path '%v' in container '%v' for user service '%v' in enclave '%v'",
+			destDirpathOnContainer,
+			container.GetName(),
+			serviceUuid,
+			enclaveId,
+		)
+	}
+
+	return nil
+}
+
+// moveArchiveEntriesUnderDirectory writes the TAR stream read from input to output with every entry moved under the
+// absolute dirpath, adding an entry for the directory itself if shouldCreateDir is set so that it's created even if
+// the archive is empty. The parents of the directory are created by Docker when it extracts the archive. Entries and
+// links that would land outside the directory are rejected, as the archive gets extracted at the root of the container
+func moveArchiveEntriesUnderDirectory(input io.Reader, output io.Writer, dirpath string, shouldCreateDir bool) error {
+	dirpathInArchive := strings.TrimPrefix(path.Clean(dirpath), containerRootDirpath)
+	tarReader := tar.NewReader(input)
+	tarWriter := tar.NewWriter(output)
+
+	if shouldCreateDir && dirpathInArchive != "" {
+		//nolint:exhaustruct
+		dirHeader := &tar.Header{
+			Typeflag: tar.TypeDir,
+			Name:     dirpathInArchive + containerRootDirpath,
+			Mode:     createdDestDirPerms,
+			ModTime:  time.Now(),
+		}
+		if err := tarWriter.WriteHeader(dirHeader); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the archive entry of directory '%v'", dirpath)
+		}
+	}
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the next entry of the archive to copy")
+		}
+		entryPath, err := getPathInsideArchiveRoot(header.Name)
+		if err != nil {
+			return stacktrace.Propagate(err, "Archive entry '%v' would be extracted outside of directory '%v'", header.Name, dirpath)
+		}
+		header.Name = path.Join(dirpathInArchive, entryPath)
+		switch header.Typeflag {
+		case tar.TypeLink:
+			// hard links point at another entry of the archive, which got moved as well
+			linkPath, err := getPathInsideArchiveRoot(header.Linkname)
+			if err != nil {
+				return stacktrace.Propagate(err, "Hard link '%v' of archive entry '%v' points outside of directory '%v'", header.Linkname, entryPath, dirpath)
+			}
+			header.Linkname = path.Join(dirpathInArchive, linkPath)
+		case tar.TypeSymlink:
+			// symlinks are resolved from the directory of the entry, and stay relative so they keep working once moved
+			if path.IsAbs(header.Linkname) {
+				return stacktrace.NewError("Symlink '%v' of archive entry '%v' is absolute so it points outside of directory '%v'", header.Linkname, entryPath, dirpath)
+			}
+			if _, err := getPathInsideArchiveRoot(path.Join(path.Dir(entryPath), header.Linkname)); err != nil {
+				return stacktrace.Propagate(err, "Symlink '%v' of archive entry '%v' points outside of directory '%v'", header.Linkname, entryPath, dirpath)
+			}
+			header.Linkname = path.Clean(header.Linkname)
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the archive entry of '%v'", header.Name)
+		}
+		if _, err := io.Copy(tarWriter, tarReader); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the content of archive entry '%v'", header.Name)
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the archive to copy")
+	}
+	return nil
+}
+
+// getPathInsideArchiveRoot returns the cleaned relative path of an archive entry, or an error if it's absolute or
+// leaves the root of the archive
+func getPathInsideArchiveRoot(entryPath string) (string, error) {
+	cleanedPath := path.Clean(entryPath)
+	if path.IsAbs(cleanedPath) {
+		return "", stacktrace.NewError("Path '%v' is absolute", entryPath)
+	}
+	if cleanedPath == parentDirpath || strings.HasPrefix(cleanedPath, parentDirpath+containerRootDirpath) {
+		return "", stacktrace.NewError("Path '%v' leaves the root of the archive", entryPath)
+	}
+	return cleanedPath, nil
+}
@@ -0,0 +1,95 @@
+package user_service_functions
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveArchiveEntriesUnderDirectory(t *testing.T) {
+	input := &bytes.Buffer{}
+	inputWriter := tar.NewWriter(input)
+	fileContent := []byte("key: value")
+	//nolint:exhaustruct
+	require.NoError(t, inputWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: "config/", Mode: 0755}))
+	//nolint:exhaustruct
+	require.NoError(t, inputWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: "config/node.yml", Mode: 0644, Size: int64(len(fileContent))}))
+	_, err := inputWriter.Write(fileContent)
+	require.NoError(t, err)
+	require.NoError(t, inputWriter.Close())
+
+	output := &bytes.Buffer{}
+	require.NoError(t, moveArchiveEntriesUnderDirectory(input, output, "/opt/node/", true))
+
+	outputReader := tar.NewReader(output)
+	var entryNames []string
+	for {
+		header, err := outputReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		entryNames = append(entryNames, header.Name)
+		if header.Typeflag == tar.TypeReg {
+			content, err := io.ReadAll(outputReader)
+			require.NoError(t, err)
+			require.Equal(t, fileContent, content)
+		}
+	}
+	require.Equal(t, []string{"opt/node/", "opt/node/config", "opt/node/config/node.yml"}, entryNames)
+}
+
+func TestMoveArchiveEntriesUnderDirectory_RejectsEntriesOutsideDirectory(t *testing.T) {
+	//nolint:exhaustruct
+	maliciousHeaders := map[string]*tar.Header{
+		"parent file":           {Typeflag: tar.TypeReg, Name: "../../etc/x", Mode: 0644},
+		"nested parent file":    {Typeflag: tar.TypeReg, Name: "config/../../x", Mode: 0644},
+		"absolute file":         {Typeflag: tar.TypeReg, Name: "/etc/x", Mode: 0644},
+		"parent hard link":      {Typeflag: tar.TypeLink, Name: "x", Linkname: "../etc/passwd"},
+		"parent symlink":        {Typeflag: tar.TypeSymlink, Name: "config/x", Linkname: "../../etc"},
+		"absolute symlink":      {Typeflag: tar.TypeSymlink, Name: "x", Linkname: "/etc"},
+		"parent symlink at top": {Typeflag: tar.TypeSymlink, Name: "x", Linkname: ".."},
+	}
+	for name, header := range maliciousHeaders {
+		input := &bytes.Buffer{}
+		inputWriter := tar.NewWriter(input)
+		require.NoError(t, inputWriter.WriteHeader(header), name)
+		require.NoError(t, inputWriter.Close(), name)
+
+		require.Error(t, moveArchiveEntriesUnderDirectory(input, &bytes.Buffer{}, "/opt/node", true), name)
+	}
+}
+
+func TestMoveArchiveEntriesUnderDirectory_KeepsLinksInsideDirectory(t *testing.T) {
+	input := &bytes.Buffer{}
+	inputWriter := tar.NewWriter(input)
+	//nolint:exhaustruct
+	require.NoError(t, inputWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: "./config/node.yml", Mode: 0644}))
+	//nolint:exhaustruct
+	require.NoError(t, inputWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeLink, Name: "node.yml", Linkname: "config/node.yml"}))
+	//nolint:exhaustruct
+	require.NoError(t, inputWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeSymlink, Name: "config/current.yml", Linkname: "../node.yml"}))
+	require.NoError(t, inputWriter.Close())
+
+	output := &bytes.Buffer{}
+	require.NoError(t, moveArchiveEntriesUnderDirectory(input, output, "/opt/node", false))
+
+	outputReader := tar.NewReader(output)
+	var entries [][]string
+	for {
+		header, err := outputReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		entries = append(entries, []string{header.Name, header.Linkname})
+	}
+	require.Equal(t, [][]string{
+		{"opt/node/config/node.yml", ""},
+		{"opt/node/node.yml", "opt/node/config/node.yml"},
+		{"opt/node/config/current.yml", "../node.yml"},
+	}, entries)
+}
@@ -22,6 +22,17 @@ func getOrCreatePersistentDirectories(
 	persistentDirectories := map[string]string{}
 
 	for serviceDirPath, persistentDirectory := range serviceMountpointsToPersistentKey {
+		// Docker volumes are local to the host so there's no storage class or access mode to pick; this is validated
+		// before getting here but we'd rather fail than silently ignore what was asked for
+		if persistentDirectory.HasStorageOptions() {
+			return nil, stacktrace.NewError(
+				"Persistent directory '%s' sets storage class '%s' and access mode '%s' but these are only supported on Kubernetes",
+				persistentDirectory.PersistentKey,
+				persistentDirectory.StorageClass,
+				persistentDirectory.AccessMode,
+			)
+		}
+
 		volumeAttrs, err := objAttrsProvider.ForSinglePersistentDirectoryVolume(persistentDirectory.PersistentKey)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Error creating persistent directory labels for '%s'", persistentDirectory.PersistentKey)
@@ -0,0 +1,31 @@
+package user_service_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+func RunUserServiceInteractiveExecCommand(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	cmd []string,
+	streams *interactive_exec.InteractiveExecStreams,
+	dockerManager *docker_manager.DockerManager,
+) (int32, error) {
+	_, serviceDockerResources, err := getSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting service object and Docker resources for service '%v' in enclave '%v'", serviceUuid, enclaveId)
+	}
+	container := serviceDockerResources.ServiceContainer
+
+	exitCode, err := dockerManager.RunInteractiveExecCommand(ctx, container.GetId(), cmd, streams)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred running interactive exec command '%v' on user service '%v' in enclave '%v'", cmd, serviceUuid, enclaveId)
+	}
+	return exitCode, nil
+}
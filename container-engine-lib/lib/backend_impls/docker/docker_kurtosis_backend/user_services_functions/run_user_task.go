@@ -0,0 +1,195 @@
+package user_service_functions
+
+import (
+	"bytes"
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_collector_functions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+)
+
+const (
+	taskSuccessExitCode = 0
+)
+
+// RunUserTask runs the task in a container that isn't restarted, waits for it to exit and captures its exit code and
+// logs. Like user services, the task sends its logs to the logs collector so that they stay in the logs database, under
+// the task UUID, after its container is removed
+func RunUserTask(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	taskUuid service.ServiceUUID,
+	taskName service.ServiceName,
+	taskConfig *service.ServiceConfig,
+	filesToStore map[string]io.Writer,
+	shouldRemoveTaskInBackground bool,
+	logsCollector *logs_collector.LogsCollector,
+	logsCollectorAvailabilityChecker logs_collector_functions.LogsCollectorAvailabilityChecker,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+	freeIpAddrProvider *free_ip_addr_tracker.FreeIpAddrTracker,
+	dockerManager *docker_manager.DockerManager,
+) (*exec_result.ExecResult, error) {
+	enclaveNetwork, err := shared_helpers.GetEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclave network by enclave ID '%v'", enclaveUuid)
+	}
+	enclaveNetworkId := enclaveNetwork.GetId()
+
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Couldn't get an object attribute provider for enclave '%v'", enclaveUuid)
+	}
+
+	if err = logsCollectorAvailabilityChecker.WaitForAvailability(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while waiting to see if the logs collector was available.")
+	}
+	logsCollectorEnclaveAddr, err := logsCollector.GetEnclaveNetworkAddressString()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the private TCP address")
+	}
+	fluentdLoggingDriverCnfg := docker_manager.NewFluentdLoggingDriver(
+		logsCollectorEnclaveAddr,
+		logs_collector_functions.GetKurtosisTrackedLogsCollectorLabels(),
+	)
+
+	privateIpAddr, err := freeIpAddrProvider.GetFreeIpAddr()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Couldn't get a free IP to give the container of task '%v'", taskName)
+	}
+
+	// The IP and the volumes are only released once the container is removed, which might happen in the background
+	var volumeNames []string
+	var containerId string
+	removeTaskResources := func() {
+		// Use background context, so we remove these even if input context was cancelled
+		if containerId != "" {
+			if err := dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+				logrus.Errorf("An error occurred removing the container '%v' of task '%v':\n%v", containerId, taskName, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to remove container with ID '%v' manually!", containerId)
+			}
+		}
+		for _, volumeName := range volumeNames {
+			if err := dockerManager.RemoveVolume(context.Background(), volumeName); err != nil {
+				logrus.Errorf("An error occurred removing the files artifact expansion volume '%v' of task '%v':\n%v", volumeName, taskName, err)
+				logrus.Errorf("You'll need to delete volume '%v' manually!", volumeName)
+			}
+		}
+		if err := freeIpAddrProvider.ReleaseIpAddr(privateIpAddr); err != nil {
+			logrus.Errorf("Error releasing IP address '%v' of task '%v'", privateIpAddr, taskName)
+		}
+	}
+	defer func() {
+		if shouldRemoveTaskInBackground {
+			go removeTaskResources()
+			return
+		}
+		removeTaskResources()
+	}()
+
+	volumeMounts := map[string]string{}
+	if filesArtifactsExpansion := taskConfig.GetFilesArtifactsExpansion(); filesArtifactsExpansion != nil {
+		volumeMounts, err = doFilesArtifactExpansionAndGetUserServiceVolumes(
+			ctx,
+			taskUuid,
+			enclaveObjAttrsProvider,
+			freeIpAddrProvider,
+			enclaveNetworkId,
+			filesArtifactsExpansion.ExpanderImage,
+			filesArtifactsExpansion.ExpanderEnvVars,
+			filesArtifactsExpansion.ExpanderDirpathsToServiceDirpaths,
+			dockerManager,
+		)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred doing files artifacts expansion to get the volumes of task '%v'", taskName)
+		}
+		for volumeName := range volumeMounts {
+			volumeNames = append(volumeNames, volumeName)
+		}
+	}
+
+	containerAttrs, err := enclaveObjAttrsProvider.ForUserTaskContainer(taskName, taskUuid, privateIpAddr, taskConfig.GetLabels())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the container attributes of task '%v'", taskName)
+	}
+	containerName := containerAttrs.GetName().GetString()
+	labelStrs := map[string]string{}
+	for labelKey, labelValue := range containerAttrs.GetLabels() {
+		labelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+
+	// The output is read off the container rather than from its logs, which the logging driver sends to the logs collector
+	// Tasks don't run with a TTY so STDOUT and STDERR are kept apart, and written in the order they were written
+	output := &bytes.Buffer{}
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	containerOutput := docker_manager.NewContainerOutput(io.MultiWriter(output, stdout), io.MultiWriter(output, stderr))
+
+	createAndStartArgsBuilder := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		taskConfig.GetContainerImageName(),
+		containerName,
+		enclaveNetworkId,
+	).WithStaticIP(
+		privateIpAddr,
+	).WithEnvironmentVariables(
+		taskConfig.GetEnvVars(),
+	).WithLabels(
+		labelStrs,
+	).WithCPUAllocationMillicpus(
+		taskConfig.GetCPUAllocationMillicpus(),
+	).WithMemoryAllocationMegabytes(
+		taskConfig.GetMemoryAllocationMegabytes(),
+	).WithSkipAddingToBridgeNetworkIfStaticIpIsSet(
+		skipAddingUserServiceToBridgeNetwork,
+	).WithContainerInitEnabled(
+		taskConfig.GetTiniEnabled(),
+	).WithVolumeMounts(
+		volumeMounts,
+	).WithLoggingDriver(
+		fluentdLoggingDriverCnfg,
+	).WithRestartPolicy(
+		docker_manager.NoRestart,
+	).WithUser(
+		taskConfig.GetUser(),
+	).WithOutput(
+		containerOutput,
+	)
+	if entrypointArgs := taskConfig.GetEntrypointArgs(); entrypointArgs != nil {
+		createAndStartArgsBuilder.WithEntrypointArgs(entrypointArgs)
+	}
+	if cmdArgs := taskConfig.GetCmdArgs(); cmdArgs != nil {
+		createAndStartArgsBuilder.WithCmdArgs(cmdArgs)
+	}
+
+	containerId, _, err = dockerManager.CreateAndStartContainer(ctx, createAndStartArgsBuilder.Build())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting the container of task '%v'", taskName)
+	}
+
+	exitCode, err := dockerManager.WaitForExit(ctx, containerId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred waiting for the container of task '%v' to exit", taskName)
+	}
+
+	if err = containerOutput.Wait(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the output of task '%v'", taskName)
+	}
+
+	if exitCode == taskSuccessExitCode {
+		for srcPath, fileOutput := range filesToStore {
+			if err := copyFilesFromContainer(ctx, containerId, srcPath, fileOutput, dockerManager); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred copying the files at '%v' from task '%v'", srcPath, taskName)
+			}
+		}
+	}
+
+	return exec_result.NewExecResultWithStreams(int32(exitCode), output.String(), stdout.String(), stderr.String()), nil
+}
@@ -31,6 +31,7 @@ const (
 	unlimitedReplacements                = -1
 	skipAddingUserServiceToBridgeNetwork = true
 	emptyImageName                       = ""
+	noContainerRestarts                  = 0
 )
 
 func RegisterUserServices(
@@ -422,12 +423,14 @@ func restartUserServices(
 			nil,
 			nil,
 			nil,
+			nil,
 			container.NewContainer(
 				container.ContainerStatus_Running,
 				emptyImageName,
 				nil,
 				nil,
-				nil),
+				nil,
+				noContainerRestarts),
 		)
 
 		serviceStatus := service.ServiceStatus_Started
@@ -756,12 +759,14 @@ func createStartServiceOperation(
 			privatePorts,
 			maybePublicIp,
 			maybePublicPortSpecs,
+			nil,
 			container.NewContainer(
 				container.ContainerStatus_Running,
 				containerImageName,
 				entrypointArgs,
 				cmdArgs,
-				envVars),
+				envVars,
+				noContainerRestarts),
 		)
 
 		shouldDeleteVolumes = false
@@ -0,0 +1,28 @@
+package docker_manager
+
+import (
+	"io"
+)
+
+// ContainerOutput receives the STDOUT and STDERR of a container from the moment it starts, by attaching to the
+// container rather than by reading its logs, so it works whatever the logging driver of the container
+type ContainerOutput struct {
+	stdout io.Writer
+	stderr io.Writer
+
+	// receives the error of the copy once the container exited and its output was entirely written
+	copyResult chan error
+}
+
+func NewContainerOutput(stdout io.Writer, stderr io.Writer) *ContainerOutput {
+	return &ContainerOutput{
+		stdout:     stdout,
+		stderr:     stderr,
+		copyResult: make(chan error, 1),
+	}
+}
+
+// Wait blocks until the container exited and its output was entirely written to the writers
+func (output *ContainerOutput) Wait() error {
+	return <-output.copyResult
+}
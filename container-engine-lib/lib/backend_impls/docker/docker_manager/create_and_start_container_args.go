@@ -37,6 +37,7 @@ type CreateAndStartContainerArgs struct {
 	imageDownloadMode                        image_download_mode.ImageDownloadMode
 	user                                     *service_user.ServiceUser
 	imageRegistrySpec                        *image_registry_spec.ImageRegistrySpec
+	output                                   *ContainerOutput
 }
 
 // Builder for creating CreateAndStartContainerArgs object
@@ -67,6 +68,7 @@ type CreateAndStartContainerArgsBuilder struct {
 	imageDownloadMode                        image_download_mode.ImageDownloadMode
 	user                                     *service_user.ServiceUser
 	imageRegistrySpec                        *image_registry_spec.ImageRegistrySpec
+	output                                   *ContainerOutput
 }
 
 /*
@@ -104,6 +106,7 @@ func NewCreateAndStartContainerArgsBuilder(dockerImage string, name string, netw
 		imageDownloadMode:                        image_download_mode.ImageDownloadMode_Missing,
 		user:                                     nil,
 		imageRegistrySpec:                        nil,
+		output:                                   nil,
 	}
 }
 
@@ -135,6 +138,7 @@ func (builder *CreateAndStartContainerArgsBuilder) Build() *CreateAndStartContai
 		imageDownloadMode:                        builder.imageDownloadMode,
 		user:                                     builder.user,
 		imageRegistrySpec:                        builder.imageRegistrySpec,
+		output:                                   builder.output,
 	}
 }
 
@@ -249,6 +253,13 @@ func (builder *CreateAndStartContainerArgsBuilder) WithLoggingDriver(loggingDriv
 	return builder
 }
 
+// WithOutput attaches to the container before it starts so that its whole STDOUT and STDERR are written to the output,
+// even if its logging driver doesn't support reading the logs back
+func (builder *CreateAndStartContainerArgsBuilder) WithOutput(output *ContainerOutput) *CreateAndStartContainerArgsBuilder {
+	builder.output = output
+	return builder
+}
+
 // Will configure the container restart policy (restart on failure, no restart)
 func (builder *CreateAndStartContainerArgsBuilder) WithRestartPolicy(restartPolicy RestartPolicy) *CreateAndStartContainerArgsBuilder {
 	builder.restartPolicy = restartPolicy
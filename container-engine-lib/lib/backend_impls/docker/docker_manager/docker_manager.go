@@ -41,6 +41,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/concurrent_writer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_utils"
@@ -639,6 +640,12 @@ func (manager *DockerManager) CreateAndStartContainer(
 	}
 	// TODO defer a disconnct-from-network if this function doesn't succeed??
 
+	if args.output != nil {
+		if err = manager.attachToContainerOutput(ctx, containerId, args.output); err != nil {
+			return "", nil, stacktrace.Propagate(err, "An error occurred attaching to the output of container '%v'", containerId)
+		}
+	}
+
 	err = manager.StartContainer(ctx, containerId)
 	if err != nil {
 		return "", nil, stacktrace.Propagate(err, "Could not start Docker container from image '%v'.", dockerImage)
@@ -826,6 +833,33 @@ func (manager *DockerManager) AttachToContainer(ctx context.Context, containerId
 	return hijackedResponse, nil
 }
 
+// attachToContainerOutput streams the STDOUT and STDERR of the container to the output until the container exits
+// It must be called before the container starts to receive the whole output
+func (manager *DockerManager) attachToContainerOutput(ctx context.Context, containerId string, output *ContainerOutput) error {
+	attachOpts := types.ContainerAttachOptions{
+		Stream:     true,
+		Stdin:      false,
+		Stdout:     true,
+		Stderr:     true,
+		DetachKeys: "",
+		Logs:       false,
+	}
+	hijackedResponse, err := manager.dockerClient.ContainerAttach(ctx, containerId, attachOpts)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred attaching to container '%v'", containerId)
+	}
+	go func() {
+		defer hijackedResponse.Close()
+		// containers without a TTY have their STDOUT and STDERR multiplexed on the connection
+		if _, err := stdcopy.StdCopy(output.stdout, output.stderr, hijackedResponse.Reader); err != nil {
+			output.copyResult <- stacktrace.Propagate(err, "An error occurred copying the output of container '%v'", containerId)
+			return
+		}
+		output.copyResult <- nil
+	}()
+	return nil
+}
+
 /*
 StartContainer
 Starts the container with the given container ID
@@ -1062,6 +1096,120 @@ func (manager *DockerManager) RunExecCommand(context context.Context, containerI
 	return int32ExitCode, nil
 }
 
+/*
+RunInteractiveExecCommand
+Executes the given command inside the container with the given ID with its standard streams attached to the given ones,
+optionally allocating a TTY, and blocks until the command completes
+*/
+func (manager *DockerManager) RunInteractiveExecCommand(ctx context.Context, containerId string, command []string, streams *interactive_exec.InteractiveExecStreams) (int32, error) {
+	dockerClient := manager.dockerClient
+
+	// Docker expects the console size as height then width
+	var consoleSize *[2]uint
+	if initialTerminalSize := streams.GetInitialTerminalSize(); streams.IsTty() && initialTerminalSize != nil {
+		consoleSize = &[2]uint{uint(initialTerminalSize.GetHeight()), uint(initialTerminalSize.GetWidth())}
+	}
+
+	execConfig := types.ExecConfig{
+		User:         "",
+		Privileged:   false,
+		Tty:          streams.IsTty(),
+		ConsoleSize:  consoleSize,
+		AttachStdin:  streams.GetStdin() != nil,
+		AttachStderr: true,
+		AttachStdout: true,
+		Detach:       false,
+		DetachKeys:   "",
+		Env:          nil,
+		WorkingDir:   "",
+		Cmd:          command,
+	}
+
+	createResp, err := dockerClient.ContainerExecCreate(ctx, containerId, execConfig)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred creating the exec process")
+	}
+
+	execId := createResp.ID
+	if execId == "" {
+		return 0, stacktrace.NewError("Got back an empty exec ID when running '%v' on container '%v'", command, containerId)
+	}
+
+	execStartConfig := types.ExecStartCheck{
+		Detach:      false,
+		Tty:         streams.IsTty(),
+		ConsoleSize: consoleSize,
+	}
+
+	// See RunExecCommand for why we only attach, without starting
+	attachResp, err := dockerClient.ContainerExecAttach(ctx, execId, execStartConfig)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred starting/attaching to the exec command")
+	}
+	defer attachResp.Close()
+
+	execDone := make(chan struct{})
+	defer close(execDone)
+
+	if terminalSizes := streams.GetTerminalSizes(); terminalSizes != nil {
+		go func() {
+			for {
+				select {
+				case <-execDone:
+					return
+				case size, isChanOpen := <-terminalSizes:
+					if !isChanOpen {
+						return
+					}
+					resizeOpts := types.ResizeOptions{
+						Height: uint(size.GetHeight()),
+						Width:  uint(size.GetWidth()),
+					}
+					if err := dockerClient.ContainerExecResize(ctx, execId, resizeOpts); err != nil {
+						logrus.Warnf("An error occurred resizing the TTY of exec '%v' to height %v and width %v:\n%v", execId, size.GetHeight(), size.GetWidth(), err)
+					}
+				}
+			}
+		}()
+	}
+
+	if stdin := streams.GetStdin(); stdin != nil {
+		go func() {
+			if _, err := io.Copy(attachResp.Conn, stdin); err != nil {
+				logrus.Debugf("Stopped forwarding STDIN to exec '%v': %v", execId, err)
+			}
+			// lets the command know its STDIN is over, e.g. so 'cat' returns
+			if err := attachResp.CloseWrite(); err != nil {
+				logrus.Debugf("An error occurred closing the STDIN of exec '%v': %v", execId, err)
+			}
+		}()
+	}
+
+	// with a TTY the output is a single raw stream, otherwise it's multiplexed and needs demultiplexing
+	if streams.IsTty() {
+		if _, err := io.Copy(streams.GetStdout(), attachResp.Reader); err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred copying the exec command output to the given STDOUT")
+		}
+	} else {
+		if _, err := stdcopy.StdCopy(streams.GetStdout(), streams.GetStderr(), attachResp.Reader); err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred copying the exec command output to the given STDOUT and STDERR")
+		}
+	}
+
+	inspectResponse, err := dockerClient.ContainerExecInspect(ctx, execId)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred inspecting the exec to get the response code")
+	}
+	if inspectResponse.Running {
+		return 0, stacktrace.NewError("Expected exec to have stopped, but it's still running!")
+	}
+	unsizedExitCode := inspectResponse.ExitCode
+	if unsizedExitCode > math.MaxInt32 || unsizedExitCode < math.MinInt32 {
+		return 0, stacktrace.NewError("Could not cast unsized int '%v' to int32 because it does not fit", unsizedExitCode)
+	}
+	return int32(unsizedExitCode), nil
+}
+
 func (manager *DockerManager) RunExecCommandWithStreamedOutput(context context.Context, containerId string, command []string) (chan string, chan *exec_result.ExecResult, error) {
 	dockerClient := manager.dockerClient
 	execConfig := types.ExecConfig{
@@ -1564,6 +1712,29 @@ func (manager *DockerManager) CopyFromContainer(ctx context.Context, containerId
 	return tarStreamReadCloser, nil
 }
 
+// DoesContainerPathExist returns true if a file or directory exists at the path in the container
+func (manager *DockerManager) DoesContainerPathExist(ctx context.Context, containerId string, pathInContainer string) (bool, error) {
+	if _, err := manager.dockerClient.ContainerStatPath(ctx, containerId, pathInContainer); err != nil {
+		if client.IsErrNotFound(err) {
+			return false, nil
+		}
+		return false, stacktrace.Propagate(err, "An error occurred checking whether path '%v' exists in container with ID '%v'", pathInContainer, containerId)
+	}
+	return true, nil
+}
+
+// CopyToContainer extracts the TAR'd files read from content into the directory at destPath, which must exist
+func (manager *DockerManager) CopyToContainer(ctx context.Context, containerId string, destPath string, content io.Reader) error {
+	copyOptions := types.CopyToContainerOptions{
+		AllowOverwriteDirWithFile: false,
+		CopyUIDGID:                false,
+	}
+	if err := manager.dockerClient.CopyToContainer(ctx, containerId, destPath, content, copyOptions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying content to '%v' in container with ID '%v'", destPath, containerId)
+	}
+	return nil
+}
+
 // GetAvailableCPUAndMemory returns free memory in megabytes, free cpu in millicores, information on whether cpu information is complete
 func (manager *DockerManager) GetAvailableCPUAndMemory(ctx context.Context) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, error) {
 	availableMemoryInBytes, availableCpuInMilliCores, err := getFreeMemoryAndCPU(ctx, manager.dockerClient)
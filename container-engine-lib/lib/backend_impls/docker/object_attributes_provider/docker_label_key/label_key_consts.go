@@ -32,6 +32,19 @@ const (
 
 	enclaveCreationTime = labelNamespaceStr + "enclave-creation-time"
 
+	// JSON-serialized map of the labels the user attached to the enclave
+	enclaveLabelsLabelKeyStr = labelNamespaceStr + "enclave-labels"
+
+	enclaveExpirationTimeLabelKeyStr = labelNamespaceStr + "enclave-expiration-time"
+
+	enclaveExpiryActionLabelKeyStr = labelNamespaceStr + "enclave-expiry-action"
+
+	// JSON-serialized resource quota of the enclave
+	enclaveResourceQuotaLabelKeyStr = labelNamespaceStr + "enclave-resource-quota"
+
+	// Authenticated principal that created the enclave
+	enclaveOwnerLabelKeyStr = labelNamespaceStr + "enclave-owner"
+
 	privateIpAddrLabelKeyStr = labelNamespaceStr + "private-ip"
 
 	// We create a duplicate of the enclave uuid and service uuid label key because:
@@ -64,6 +77,11 @@ var PortSpecsDockerLabelKey = MustCreateNewDockerLabelKey(portSpecsLabelKeyStr)
 var EnclaveUUIDDockerLabelKey = MustCreateNewDockerLabelKey(enclaveIdLabelKeyStr)
 var EnclaveNameDockerLabelKey = MustCreateNewDockerLabelKey(enclaveNameLabelKeyStr)
 var EnclaveCreationTimeLabelKey = MustCreateNewDockerLabelKey(enclaveCreationTime)
+var EnclaveLabelsDockerLabelKey = MustCreateNewDockerLabelKey(enclaveLabelsLabelKeyStr)
+var EnclaveExpirationTimeDockerLabelKey = MustCreateNewDockerLabelKey(enclaveExpirationTimeLabelKeyStr)
+var EnclaveExpiryActionDockerLabelKey = MustCreateNewDockerLabelKey(enclaveExpiryActionLabelKeyStr)
+var EnclaveResourceQuotaDockerLabelKey = MustCreateNewDockerLabelKey(enclaveResourceQuotaLabelKeyStr)
+var EnclaveOwnerDockerLabelKey = MustCreateNewDockerLabelKey(enclaveOwnerLabelKeyStr)
 var PrivateIPDockerLabelKey = MustCreateNewDockerLabelKey(privateIpAddrLabelKeyStr)
 var UserServiceGUIDDockerLabelKey = MustCreateNewDockerLabelKey(userServiceGuidDockerLabelKeyStr)
 var LogsEnclaveUUIDDockerLabelKey = MustCreateNewDockerLabelKey(logsEnclaveUuidLabelKeyStr)
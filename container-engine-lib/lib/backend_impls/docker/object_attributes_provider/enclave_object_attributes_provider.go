@@ -1,6 +1,7 @@
 package object_attributes_provider
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
 	"strconv"
@@ -13,6 +14,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_object_name"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_port_spec_serializer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
@@ -31,11 +33,20 @@ const (
 	// The collector is per enclave so this is a suffix
 	logsCollectorVolumeFragment = logsCollectorFragment + "-vol"
 
+	enclaveExpirationVolumeFragment = "enclave-expiration"
+
 	anyCharacterPrefixRegexToken = ".*"
 )
 
 type DockerEnclaveObjectAttributesProvider interface {
-	ForEnclaveNetwork(enclaveName string, creationTime time.Time) (DockerObjectAttributes, error)
+	ForEnclaveNetwork(
+		enclaveName string,
+		creationTime time.Time,
+		enclaveLabels map[string]string,
+		expiration *enclave.EnclaveExpiration,
+		resourceQuota *enclave.EnclaveResourceQuota,
+		owner string,
+	) (DockerObjectAttributes, error)
 	ForEnclaveDataVolume() (DockerObjectAttributes, error)
 	ForApiContainer(
 		ipAddr net.IP,
@@ -49,6 +60,12 @@ type DockerEnclaveObjectAttributesProvider interface {
 		privatePorts map[string]*port_spec.PortSpec,
 		userLabels map[string]string,
 	) (DockerObjectAttributes, error)
+	ForUserTaskContainer(
+		taskName service.ServiceName,
+		taskUuid service.ServiceUUID,
+		privateIpAddr net.IP,
+		userLabels map[string]string,
+	) (DockerObjectAttributes, error)
 	ForFilesArtifactsExpanderContainer(
 		serviceUUID service.ServiceUUID,
 	) (DockerObjectAttributes, error)
@@ -60,6 +77,7 @@ type DockerEnclaveObjectAttributesProvider interface {
 	) (DockerObjectAttributes, error)
 	ForLogsCollector(tcpPortId string, tcpPortSpec *port_spec.PortSpec, httpPortId string, httpPortSpec *port_spec.PortSpec) (DockerObjectAttributes, error)
 	ForLogsCollectorVolume() (DockerObjectAttributes, error)
+	ForEnclaveExpirationVolume(expiration *enclave.EnclaveExpiration) (DockerObjectAttributes, error)
 }
 
 // Private so it can't be instantiated
@@ -75,7 +93,14 @@ func newDockerEnclaveObjectAttributesProviderImpl(
 	}
 }
 
-func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveNetwork(enclaveName string, creationTime time.Time) (DockerObjectAttributes, error) {
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveNetwork(
+	enclaveName string,
+	creationTime time.Time,
+	enclaveLabels map[string]string,
+	expiration *enclave.EnclaveExpiration,
+	resourceQuota *enclave.EnclaveResourceQuota,
+	owner string,
+) (DockerObjectAttributes, error) {
 	// TODO: might need to revert this if we have multiple users on the same cluster (what if two people create enclaves with name test?)
 	enclaveNetworkNameStr := networkPrefix + enclaveName
 	name, err := docker_object_name.CreateNewDockerObjectName(enclaveNetworkNameStr)
@@ -115,6 +140,44 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveNetwork(enc
 	labels[docker_label_key.EnclaveCreationTimeLabelKey] = creationTimeLabelValue
 	labels[docker_label_key.EnclaveNameDockerLabelKey] = enclaveNameLabelValue
 
+	if len(enclaveLabels) > 0 {
+		serializedEnclaveLabels, err := json.Marshal(enclaveLabels)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred serializing enclave labels '%+v'", enclaveLabels)
+		}
+		enclaveLabelsLabelValue, err := docker_label_value.CreateNewDockerLabelValue(string(serializedEnclaveLabels))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from enclave labels '%v'", string(serializedEnclaveLabels))
+		}
+		labels[docker_label_key.EnclaveLabelsDockerLabelKey] = enclaveLabelsLabelValue
+	}
+
+	if expiration != nil {
+		if err := addEnclaveExpirationLabels(labels, expiration); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred adding the labels of enclave expiration '%+v'", expiration)
+		}
+	}
+
+	if resourceQuota != nil {
+		serializedResourceQuota, err := json.Marshal(resourceQuota)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred serializing enclave resource quota '%+v'", resourceQuota)
+		}
+		resourceQuotaLabelValue, err := docker_label_value.CreateNewDockerLabelValue(string(serializedResourceQuota))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from enclave resource quota '%v'", string(serializedResourceQuota))
+		}
+		labels[docker_label_key.EnclaveResourceQuotaDockerLabelKey] = resourceQuotaLabelValue
+	}
+
+	if owner != "" {
+		ownerLabelValue, err := docker_label_value.CreateNewDockerLabelValue(owner)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from enclave owner '%v'", owner)
+		}
+		labels[docker_label_key.EnclaveOwnerDockerLabelKey] = ownerLabelValue
+	}
+
 	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -273,6 +336,64 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForUserServiceContain
 	return objectAttributes, nil
 }
 
+// ForUserTaskContainer labels the task container like a user service container, so its logs get tracked under the task
+// UUID, but with its own container type so that it's never mistaken for a service
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForUserTaskContainer(
+	taskName service.ServiceName,
+	taskUuid service.ServiceUUID,
+	privateIpAddr net.IP,
+	userLabels map[string]string,
+) (DockerObjectAttributes, error) {
+	name, err := provider.getNameForUserServiceContainer(
+		taskName,
+		taskUuid,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the user task Docker container name object")
+	}
+
+	privateIpLabelValue, err := docker_label_value.CreateNewDockerLabelValue(privateIpAddr.String())
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred creating a Docker label value object from user task container private IP address '%v'",
+			privateIpAddr.String(),
+		)
+	}
+
+	labels, err := provider.getLabelsForEnclaveObjectWithIDAndGUID(string(taskName), string(taskUuid))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting labels for enclave object with UUID '%v'", taskUuid)
+	}
+	labels[docker_label_key.ContainerTypeDockerLabelKey] = label_value_consts.UserTaskContainerTypeDockerLabelValue
+	labels[docker_label_key.PrivateIPDockerLabelKey] = privateIpLabelValue
+
+	// add user custom label
+	for userLabelKey, userLabelValue := range userLabels {
+		dockerLabelKey, err := docker_label_key.CreateNewDockerUserCustomLabelKey(userLabelKey)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Docker label key '%s'", userLabelKey)
+		}
+		dockerLabelValue, err := docker_label_value.CreateNewDockerLabelValue(userLabelValue)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Docker label value '%s'", userLabelValue)
+		}
+		labels[dockerLabelKey] = dockerLabelValue
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'",
+			name.GetString(),
+			getLabelKeyValuesAsStrings(labels),
+		)
+	}
+
+	return objectAttributes, nil
+}
+
 // In Docker we get one volume per artifact being expanded
 func (provider *dockerEnclaveObjectAttributesProviderImpl) ForSingleFilesArtifactExpansionVolume(
 	serviceUUID service.ServiceUUID,
@@ -440,6 +561,30 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForLogsCollectorVolum
 	return objectAttributes, nil
 }
 
+// ForEnclaveExpirationVolume returns the attributes of the volume recording an updated expiration of the enclave, as
+// the labels of the enclave network can't be changed once it's created. The name contains the expiration time so that
+// the new record can be created before the previous one is removed
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveExpirationVolume(expiration *enclave.EnclaveExpiration) (DockerObjectAttributes, error) {
+	expirationTimeStr := strconv.FormatInt(expiration.GetExpirationTime().Unix(), 10)
+	name, err := provider.getNameForEnclaveObject([]string{enclaveExpirationVolumeFragment, expirationTimeStr})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the name for the enclave expiration volume object")
+	}
+
+	labels := provider.getLabelsForEnclaveObject()
+	labels[docker_label_key.VolumeTypeDockerLabelKey] = label_value_consts.EnclaveExpirationVolumeTypeDockerLabelValue
+	if err := addEnclaveExpirationLabels(labels, expiration); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding the labels of enclave expiration '%+v'", expiration)
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+
+	return objectAttributes, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
@@ -578,3 +723,19 @@ func getLabelKeyValuesAsStrings(labels map[*docker_label_key.DockerLabelKey]*doc
 	}
 	return result
 }
+
+func addEnclaveExpirationLabels(labels map[*docker_label_key.DockerLabelKey]*docker_label_value.DockerLabelValue, expiration *enclave.EnclaveExpiration) error {
+	expirationTimeStr := expiration.GetExpirationTime().Format(time.RFC3339)
+	expirationTimeLabelValue, err := docker_label_value.CreateNewDockerLabelValue(expirationTimeStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a Docker label value object from enclave expiration time string '%v'", expirationTimeStr)
+	}
+	expiryActionStr := string(expiration.GetAction())
+	expiryActionLabelValue, err := docker_label_value.CreateNewDockerLabelValue(expiryActionStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a Docker label value object from enclave expiry action string '%v'", expiryActionStr)
+	}
+	labels[docker_label_key.EnclaveExpirationTimeDockerLabelKey] = expirationTimeLabelValue
+	labels[docker_label_key.EnclaveExpiryActionDockerLabelKey] = expiryActionLabelValue
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/stretchr/testify/require"
@@ -78,3 +79,23 @@ func TestForUserServiceContainer(t *testing.T) {
 		}
 	}
 }
+
+func TestForEnclaveExpirationVolume(t *testing.T) {
+	objAttrsProvider := GetDockerObjectAttributesProvider()
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
+	require.NoError(t, err, "An unexpected error occurred getting the enclave object attributes provider")
+
+	expirationTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	volumeAttrs, err := enclaveObjAttrsProvider.ForEnclaveExpirationVolume(enclave.NewEnclaveExpiration(expirationTime, enclave.EnclaveExpiryAction_Stop))
+	require.NoError(t, err, "An unexpected error occurred getting the volume attributes")
+	require.Equal(t, "enclave-expiration--1704110400--65d2fb6d673249b8b4a91a2f4ae616de", volumeAttrs.GetName().GetString())
+
+	labelStrs := map[string]string{}
+	for labelKey, labelValue := range volumeAttrs.GetLabels() {
+		labelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+	require.Equal(t, "65d2fb6d673249b8b4a91a2f4ae616de", labelStrs[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()])
+	require.Equal(t, "enclave-expiration", labelStrs[docker_label_key.VolumeTypeDockerLabelKey.GetString()])
+	require.Equal(t, "2024-01-01T12:00:00Z", labelStrs[docker_label_key.EnclaveExpirationTimeDockerLabelKey.GetString()])
+	require.Equal(t, string(enclave.EnclaveExpiryAction_Stop), labelStrs[docker_label_key.EnclaveExpiryActionDockerLabelKey.GetString()])
+}
@@ -20,6 +20,7 @@ const (
 
 	apiContainerContainerTypeLabelValueStr           = "api-container"
 	userServiceContainerTypeLabelValueStr            = "user-service"
+	userTaskContainerTypeLabelValueStr               = "user-task"
 	filesArtifactsExpanderContainerTypeLabelValueStr = "files-artifacts-expander"
 
 	enclaveDataVolumeTypeLabelValueStr            = "enclave-data"
@@ -28,6 +29,7 @@ const (
 	logsStorageVolumeTypeLabelValueStr            = "kurtosis-logs-storage"
 	logsCollectorVolumeTypeLabelValueStr          = "logs-collector-data"
 	githubAuthStorageVolumeTypeLabelValueStr      = "github-auth-storage"
+	enclaveExpirationVolumeTypeLabelValueStr      = "enclave-expiration"
 )
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
@@ -46,6 +48,7 @@ var ReverseProxyTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLab
 
 var APIContainerContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(apiContainerContainerTypeLabelValueStr)
 var UserServiceContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(userServiceContainerTypeLabelValueStr)
+var UserTaskContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(userTaskContainerTypeLabelValueStr)
 var FilesArtifactExpanderContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(filesArtifactsExpanderContainerTypeLabelValueStr)
 
 var EnclaveDataVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveDataVolumeTypeLabelValueStr)
@@ -54,3 +57,6 @@ var PersistentDirectoryVolumeTypeDockerLabelValue = docker_label_value.MustCreat
 var LogsStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(logsStorageVolumeTypeLabelValueStr)
 var LogsCollectorVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(logsCollectorVolumeTypeLabelValueStr)
 var GitHubAuthStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(githubAuthStorageVolumeTypeLabelValueStr)
+
+// Network labels can't be changed, so an updated enclave expiration is recorded in the labels of an empty volume
+var EnclaveExpirationVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveExpirationVolumeTypeLabelValueStr)
@@ -2,6 +2,7 @@ package consts
 
 import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/stacktrace"
 	v1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 )
@@ -21,12 +22,44 @@ const (
 	HttpApplicationProtocol = "http"
 
 	IngressRulePathAllPaths = "/"
+
+	// The image the static busybox that wraps the tasks is copied from, when none is configured; it must have a static
+	// busybox at /bin/busybox
+	DefaultTaskHelperImage = "busybox:1.36.1"
+)
+
+// UserServiceWorkloadType is the Kubernetes object that runs the pod of each user service
+type UserServiceWorkloadType string
+
+const (
+	// A bare pod, which is lost if its node drains or it gets evicted
+	UserServiceWorkloadType_Pod UserServiceWorkloadType = "pod"
+	// A one-replica StatefulSet, which Kubernetes reschedules along with its persistent volume claims
+	UserServiceWorkloadType_StatefulSet UserServiceWorkloadType = "stateful-set"
 )
 
 var (
 	IngressRulePathTypePrefix = netv1.PathTypePrefix
+
+	AllUserServiceWorkloadTypes = []UserServiceWorkloadType{
+		UserServiceWorkloadType_Pod,
+		UserServiceWorkloadType_StatefulSet,
+	}
 )
 
+// GetUserServiceWorkloadType defaults to bare pods when the string is empty
+func GetUserServiceWorkloadType(workloadTypeStr string) (UserServiceWorkloadType, error) {
+	if workloadTypeStr == "" {
+		return UserServiceWorkloadType_Pod, nil
+	}
+	for _, workloadType := range AllUserServiceWorkloadTypes {
+		if workloadTypeStr == string(workloadType) {
+			return workloadType, nil
+		}
+	}
+	return "", stacktrace.NewError("Invalid user service workload type '%v', valid types are: %v", workloadTypeStr, AllUserServiceWorkloadTypes)
+}
+
 // This maps a Kubernetes pod's phase to a binary "is the pod considered running?" determiner
 // Its completeness is enforced via unit test
 var IsPodRunningDeterminer = map[v1.PodPhase]bool{
@@ -28,3 +28,16 @@ func TestKubernetesTransportProtocolLookupCompleteness(t *testing.T) {
 		require.True(t, found, "No Kubernetes port protocol defined for Kurtosis port protocol '%v'", kurtosisTransportProtocol.String())
 	}
 }
+
+func TestGetUserServiceWorkloadType(t *testing.T) {
+	workloadType, err := GetUserServiceWorkloadType("")
+	require.NoError(t, err)
+	require.Equal(t, UserServiceWorkloadType_Pod, workloadType)
+
+	workloadType, err = GetUserServiceWorkloadType("stateful-set")
+	require.NoError(t, err)
+	require.Equal(t, UserServiceWorkloadType_StatefulSet, workloadType)
+
+	_, err = GetUserServiceWorkloadType("deployment")
+	require.Error(t, err)
+}
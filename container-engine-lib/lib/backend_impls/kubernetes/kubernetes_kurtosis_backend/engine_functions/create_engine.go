@@ -321,50 +321,7 @@ func createEngineClusterRole(
 	}
 	clusterRoleName := clusterRolesAttributes.GetName().GetString()
 	clusterRoleLabels := shared_helpers.GetStringMapFromLabelMap(clusterRolesAttributes.GetLabels())
-	// nolint: exhaustruct
-	clusterRolePolicyRules := []rbacv1.PolicyRule{
-		{
-			Verbs: []string{
-				kubernetes_manager_consts.CreateKubernetesVerb,
-				kubernetes_manager_consts.UpdateKubernetesVerb,
-				kubernetes_manager_consts.PatchKubernetesVerb,
-				kubernetes_manager_consts.DeleteKubernetesVerb,
-				kubernetes_manager_consts.GetKubernetesVerb,
-				kubernetes_manager_consts.ListKubernetesVerb,
-				kubernetes_manager_consts.WatchKubernetesVerb,
-			},
-			APIGroups: []string{
-				rbacv1.APIGroupAll,
-			},
-			Resources: []string{
-				kubernetes_manager_consts.NamespacesKubernetesResource,
-				kubernetes_manager_consts.ServiceAccountsKubernetesResource,
-				kubernetes_manager_consts.ClusterRolesKubernetesResource,
-				kubernetes_manager_consts.ClusterRoleBindingsKubernetesResource,
-				kubernetes_manager_consts.RolesKubernetesResource,
-				kubernetes_manager_consts.RoleBindingsKubernetesResource,
-				kubernetes_manager_consts.PodsKubernetesResource,
-				kubernetes_manager_consts.PodExecsKubernetesResource,
-				kubernetes_manager_consts.PodLogsKubernetesResource,
-				kubernetes_manager_consts.ServicesKubernetesResource,
-				kubernetes_manager_consts.PersistentVolumesKubernetesResource,
-				kubernetes_manager_consts.PersistentVolumeClaimsKubernetesResource,
-				kubernetes_manager_consts.IngressesKubernetesResource,
-				kubernetes_manager_consts.JobsKubernetesResource, // Necessary so that we can give the API container the permission
-			},
-		},
-		{
-			Verbs: []string{
-				kubernetes_manager_consts.ListKubernetesVerb,
-			},
-			APIGroups: []string{
-				rbacv1.APIGroupAll,
-			},
-			Resources: []string{
-				kubernetes_manager_consts.NodesKubernetesResource,
-			},
-		},
-	}
+	clusterRolePolicyRules := GetEngineClusterRolePolicyRules()
 	clusterRole, err := kubernetesManager.CreateClusterRoles(ctx, clusterRoleName, clusterRolePolicyRules, clusterRoleLabels)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating cluster role '%v' with policy rules '%+v' and labels '%+v'", clusterRoleName, clusterRolePolicyRules, clusterRoleLabels)
@@ -579,6 +536,7 @@ func createEngineIngress(
 		engineIngressName,
 		engineIngressLabels,
 		engineIngressAnnotations,
+		nil,
 		engineIngressRules,
 	)
 	if err != nil {
@@ -620,3 +578,56 @@ func getEngineIngressRules(
 
 	return ingressRules, nil
 }
+
+// GetEngineClusterRolePolicyRules returns the rules of the engine cluster role, which must hold every permission granted
+// to the API containers as Kubernetes prevents granting permissions the granter doesn't hold
+func GetEngineClusterRolePolicyRules() []rbacv1.PolicyRule {
+	// nolint: exhaustruct
+	return []rbacv1.PolicyRule{
+		{
+			Verbs: []string{
+				kubernetes_manager_consts.CreateKubernetesVerb,
+				kubernetes_manager_consts.UpdateKubernetesVerb,
+				kubernetes_manager_consts.PatchKubernetesVerb,
+				kubernetes_manager_consts.DeleteKubernetesVerb,
+				kubernetes_manager_consts.GetKubernetesVerb,
+				kubernetes_manager_consts.ListKubernetesVerb,
+				kubernetes_manager_consts.WatchKubernetesVerb,
+			},
+			APIGroups: []string{
+				rbacv1.APIGroupAll,
+			},
+			Resources: []string{
+				kubernetes_manager_consts.NamespacesKubernetesResource,
+				kubernetes_manager_consts.ServiceAccountsKubernetesResource,
+				kubernetes_manager_consts.ClusterRolesKubernetesResource,
+				kubernetes_manager_consts.ClusterRoleBindingsKubernetesResource,
+				kubernetes_manager_consts.RolesKubernetesResource,
+				kubernetes_manager_consts.RoleBindingsKubernetesResource,
+				kubernetes_manager_consts.PodsKubernetesResource,
+				kubernetes_manager_consts.PodExecsKubernetesResource,
+				kubernetes_manager_consts.PodLogsKubernetesResource,
+				kubernetes_manager_consts.StatefulSetsKubernetesResource,
+				kubernetes_manager_consts.ServicesKubernetesResource,
+				kubernetes_manager_consts.PersistentVolumesKubernetesResource,
+				kubernetes_manager_consts.PersistentVolumeClaimsKubernetesResource,
+				kubernetes_manager_consts.IngressesKubernetesResource,
+				kubernetes_manager_consts.HttpRoutesKubernetesResource, // Necessary so that we can give the API container the permission
+				kubernetes_manager_consts.ResourceQuotasKubernetesResource,
+				kubernetes_manager_consts.LimitRangesKubernetesResource,
+				kubernetes_manager_consts.JobsKubernetesResource, // Necessary so that we can give the API container the permission
+			},
+		},
+		{
+			Verbs: []string{
+				kubernetes_manager_consts.ListKubernetesVerb,
+			},
+			APIGroups: []string{
+				rbacv1.APIGroupAll,
+			},
+			Resources: []string{
+				kubernetes_manager_consts.NodesKubernetesResource,
+			},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
 	apiv1 "k8s.io/api/core/v1"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/engine_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/user_services_functions"
@@ -16,11 +17,13 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/service_exposure"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
@@ -79,9 +82,12 @@ func NewAPIContainerKubernetesKurtosisBackend(
 	ownEnclaveUuid enclave.EnclaveUUID,
 	ownNamespaceName string,
 	storageClassName string,
+	serviceExposureConfig *service_exposure.ServiceExposureConfig,
+	userServiceWorkloadType consts.UserServiceWorkloadType,
+	taskHelperImage string,
 	productionMode bool,
 ) *KubernetesKurtosisBackend {
-	modeArgs := shared_helpers.NewApiContainerModeArgs(ownEnclaveUuid, ownNamespaceName, storageClassName)
+	modeArgs := shared_helpers.NewApiContainerModeArgs(ownEnclaveUuid, ownNamespaceName, storageClassName, serviceExposureConfig, userServiceWorkloadType, taskHelperImage)
 	return newKubernetesKurtosisBackend(
 		kubernetesManager,
 		nil,
@@ -380,6 +386,25 @@ func (backend *KubernetesKurtosisBackend) RunUserServiceExecCommandWithStreamedO
 		backend.kubernetesManager)
 }
 
+func (backend *KubernetesKurtosisBackend) RunUserServiceInteractiveExecCommand(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	cmd []string,
+	streams *interactive_exec.InteractiveExecStreams,
+) (int32, error) {
+	return user_services_functions.RunUserServiceInteractiveExecCommand(
+		ctx,
+		enclaveUuid,
+		serviceUuid,
+		cmd,
+		streams,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
 func (backend *KubernetesKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (resultErr error) {
 	objectAndResources, err := shared_helpers.GetSingleUserServiceObjectsAndResources(ctx, enclaveUuid, serviceUuid, backend.cliModeArgs, backend.apiContainerModeArgs, backend.engineServerModeArgs, backend.kubernetesManager)
 	if err != nil {
@@ -408,6 +433,48 @@ func (backend *KubernetesKurtosisBackend) CopyFilesFromUserService(
 		backend.kubernetesManager)
 }
 
+func (backend *KubernetesKurtosisBackend) RunUserTask(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	taskUuid service.ServiceUUID,
+	taskName service.ServiceName,
+	taskConfig *service.ServiceConfig,
+	filesToStore map[string]io.Writer,
+	shouldRemoveTaskInBackground bool,
+) (*exec_result.ExecResult, error) {
+	return user_services_functions.RunUserTask(
+		ctx,
+		enclaveUuid,
+		taskUuid,
+		taskName,
+		taskConfig,
+		filesToStore,
+		shouldRemoveTaskInBackground,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
+func (backend *KubernetesKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	destDirpath string,
+	input io.Reader,
+) error {
+	return user_services_functions.CopyFilesToUserService(
+		ctx,
+		enclaveUuid,
+		serviceUuid,
+		destDirpath,
+		input,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
 func (backend *KubernetesKurtosisBackend) StopUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (resultSuccessfulGuids map[service.ServiceUUID]bool, resultErroredGuids map[service.ServiceUUID]error, resultErr error) {
 	return user_services_functions.StopUserServices(
 		ctx,
@@ -40,6 +40,10 @@ const (
 	enclaveDataDirVolumeName = "enclave-data"
 
 	enclaveDataDirVolumeSize int64 = 1 * 1024 * 1024 * 1024 // 1g minimum size on Kubernetes
+
+	// the enclave data directory uses the storage class the engine was configured with and the default access mode
+	defaultStorageClass                                  = ""
+	defaultAccessMode   apiv1.PersistentVolumeAccessMode = ""
 )
 
 var noWait *port_spec.Wait = nil
@@ -234,36 +238,7 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 
 	clusterRoleName := clusterRolesAttributes.GetName().GetString()
 	clusterRoleLabels := shared_helpers.GetStringMapFromLabelMap(clusterRolesAttributes.GetLabels())
-	// nolint: exhaustruct
-	clusterRolePolicyRules := []rbacv1.PolicyRule{
-		{
-			Verbs: []string{
-				kubernetes_manager_consts.CreateKubernetesVerb,
-				kubernetes_manager_consts.DeleteKubernetesVerb,
-				kubernetes_manager_consts.GetKubernetesVerb,
-			},
-			APIGroups: []string{
-				rbacv1.APIGroupAll,
-			},
-			Resources: []string{
-				kubernetes_manager_consts.PersistentVolumesKubernetesResource,
-			},
-		},
-		{
-			// Necessary for the API container to list all nodes
-			// Temporarily adding perms to list nodes here to check for mono-node deployment for persistent volumes.
-			// TODO: remove once we support multi nodes deployments with persistent volumes
-			Verbs: []string{
-				kubernetes_manager_consts.ListKubernetesVerb,
-			},
-			APIGroups: []string{
-				rbacv1.APIGroupAll,
-			},
-			Resources: []string{
-				kubernetes_manager_consts.NodesKubernetesResource,
-			},
-		},
-	}
+	clusterRolePolicyRules := getApiContainerClusterRolePolicyRules()
 
 	apiContainerClusterRole, err := backend.kubernetesManager.CreateClusterRoles(ctx, clusterRoleName, clusterRolePolicyRules, clusterRoleLabels)
 	if err != nil {
@@ -338,36 +313,7 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 
 	roleName := rolesAttributes.GetName().GetString()
 	roleLabels := shared_helpers.GetStringMapFromLabelMap(rolesAttributes.GetLabels())
-	// nolint: exhaustruct
-	rolePolicyRules := []rbacv1.PolicyRule{
-		{
-			Verbs: []string{
-				kubernetes_manager_consts.CreateKubernetesVerb,
-				kubernetes_manager_consts.UpdateKubernetesVerb,
-				kubernetes_manager_consts.PatchKubernetesVerb,
-				kubernetes_manager_consts.DeleteKubernetesVerb,
-				kubernetes_manager_consts.GetKubernetesVerb,
-				kubernetes_manager_consts.ListKubernetesVerb,
-				kubernetes_manager_consts.WatchKubernetesVerb,
-			},
-			APIGroups: []string{rbacv1.APIGroupAll},
-			Resources: []string{
-				kubernetes_manager_consts.PodsKubernetesResource,
-				kubernetes_manager_consts.PodExecsKubernetesResource,
-				kubernetes_manager_consts.PodLogsKubernetesResource,
-				kubernetes_manager_consts.ServicesKubernetesResource,
-				kubernetes_manager_consts.JobsKubernetesResource,
-				kubernetes_manager_consts.PersistentVolumeClaimsKubernetesResource,
-				kubernetes_manager_consts.IngressesKubernetesResource,
-			},
-		},
-		{
-			// Necessary for the API container to get its own namespace
-			Verbs:     []string{kubernetes_manager_consts.GetKubernetesVerb},
-			APIGroups: []string{rbacv1.APIGroupAll},
-			Resources: []string{kubernetes_manager_consts.NamespacesKubernetesResource},
-		},
-	}
+	rolePolicyRules := getApiContainerRolePolicyRules()
 
 	apiContainerRole, err := backend.kubernetesManager.CreateRole(ctx, roleName, enclaveNamespaceName, rolePolicyRules, roleLabels)
 	if err != nil {
@@ -444,7 +390,7 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 	for key, value := range volumeAttrs.GetLabels() {
 		volumeLabelsStrs[key.GetString()] = value.GetString()
 	}
-	if _, err = backend.kubernetesManager.CreatePersistentVolumeClaim(ctx, enclaveNamespaceName, enclaveDataDirVolumeName, volumeLabelsStrs, enclaveDataDirVolumeSize); err != nil {
+	if _, err = backend.kubernetesManager.CreatePersistentVolumeClaim(ctx, enclaveNamespaceName, enclaveDataDirVolumeName, volumeLabelsStrs, enclaveDataDirVolumeSize, defaultStorageClass, defaultAccessMode); err != nil {
 		errMsg := fmt.Sprintf("An error occurred creating the persistent volume claim for enclave data dir volume for enclave '%s'", enclaveDataDirVolumeName)
 		logrus.Errorf("%s. Error was:\n%s", errMsg, err)
 		return nil, stacktrace.Propagate(err, errMsg)
@@ -1157,3 +1103,71 @@ func getApiContainerMatchLabels() map[string]string {
 	}
 	return engineMatchLabels
 }
+
+func getApiContainerClusterRolePolicyRules() []rbacv1.PolicyRule {
+	// nolint: exhaustruct
+	return []rbacv1.PolicyRule{
+		{
+			Verbs: []string{
+				kubernetes_manager_consts.CreateKubernetesVerb,
+				kubernetes_manager_consts.DeleteKubernetesVerb,
+				kubernetes_manager_consts.GetKubernetesVerb,
+			},
+			APIGroups: []string{
+				rbacv1.APIGroupAll,
+			},
+			Resources: []string{
+				kubernetes_manager_consts.PersistentVolumesKubernetesResource,
+			},
+		},
+		{
+			// Necessary for the API container to list all nodes
+			// Temporarily adding perms to list nodes here to check for mono-node deployment for persistent volumes.
+			// TODO: remove once we support multi nodes deployments with persistent volumes
+			Verbs: []string{
+				kubernetes_manager_consts.ListKubernetesVerb,
+			},
+			APIGroups: []string{
+				rbacv1.APIGroupAll,
+			},
+			Resources: []string{
+				kubernetes_manager_consts.NodesKubernetesResource,
+			},
+		},
+	}
+}
+
+func getApiContainerRolePolicyRules() []rbacv1.PolicyRule {
+	// nolint: exhaustruct
+	return []rbacv1.PolicyRule{
+		{
+			Verbs: []string{
+				kubernetes_manager_consts.CreateKubernetesVerb,
+				kubernetes_manager_consts.UpdateKubernetesVerb,
+				kubernetes_manager_consts.PatchKubernetesVerb,
+				kubernetes_manager_consts.DeleteKubernetesVerb,
+				kubernetes_manager_consts.GetKubernetesVerb,
+				kubernetes_manager_consts.ListKubernetesVerb,
+				kubernetes_manager_consts.WatchKubernetesVerb,
+			},
+			APIGroups: []string{rbacv1.APIGroupAll},
+			Resources: []string{
+				kubernetes_manager_consts.PodsKubernetesResource,
+				kubernetes_manager_consts.PodExecsKubernetesResource,
+				kubernetes_manager_consts.PodLogsKubernetesResource,
+				kubernetes_manager_consts.StatefulSetsKubernetesResource,
+				kubernetes_manager_consts.ServicesKubernetesResource,
+				kubernetes_manager_consts.JobsKubernetesResource,
+				kubernetes_manager_consts.PersistentVolumeClaimsKubernetesResource,
+				kubernetes_manager_consts.IngressesKubernetesResource,
+				kubernetes_manager_consts.HttpRoutesKubernetesResource,
+			},
+		},
+		{
+			// Necessary for the API container to get its own namespace
+			Verbs:     []string{kubernetes_manager_consts.GetKubernetesVerb},
+			APIGroups: []string{rbacv1.APIGroupAll},
+			Resources: []string{kubernetes_manager_consts.NamespacesKubernetesResource},
+		},
+	}
+}
@@ -2,6 +2,7 @@ package kubernetes_kurtosis_backend
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
 	"strings"
@@ -16,11 +17,27 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
 	apiv1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	applyconfigurationsv1 "k8s.io/client-go/applyconfigurations/core/v1"
 )
 
+const (
+	// The API container runs in the enclave namespace too, so the pod quota needs room for it on top of the services
+	numKurtosisPodsInEnclaveNamespace = 1
+
+	// Same conversions as the ones used when setting the resource requests of the user service pods and the size of
+	// their persistent volume claims, so that the quota is measured in the same unit as what is requested
+	resourceQuotaMegabytesToBytesFactor        = 1_000_000
+	resourceQuotaStorageMegabytesToBytesFactor = 1024 * 1024
+
+	// Kind of the owner of the user service pods when the services run as StatefulSets
+	statefulSetKind = "StatefulSet"
+)
+
 // TODO: MIGRATE THIS FOLDER TO USE STRUCTURE OF USER_SERVICE_FUNCTIONS MODULE
 
 // Any of these values being nil indicates that the resource doesn't exist
@@ -32,6 +49,9 @@ type enclaveKubernetesResources struct {
 	// to StopEnclave and to return an EnclaveStatus
 	pods []apiv1.Pod
 
+	// Only present when the user services run as StatefulSets, they need to be removed for StopEnclave to stop their pods
+	statefulSets []appsv1.StatefulSet
+
 	// Not technically resources that define an enclave, but we need them for
 	// StopEnclave
 	services []apiv1.Service
@@ -50,6 +70,10 @@ func (backend *KubernetesKurtosisBackend) CreateEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
 	enclaveName string,
+	enclaveLabels map[string]string,
+	expiration *enclave.EnclaveExpiration,
+	resourceQuota *enclave.EnclaveResourceQuota,
+	owner string,
 ) (
 	*enclave.Enclave,
 	error,
@@ -72,7 +96,7 @@ func (backend *KubernetesKurtosisBackend) CreateEnclave(
 
 	// Make Enclave attributes provider
 	enclaveObjAttrsProvider := backend.objAttrsProvider.ForEnclave(enclaveUuid)
-	enclaveNamespaceAttrs, err := enclaveObjAttrsProvider.ForEnclaveNamespace(creationTime, enclaveName)
+	enclaveNamespaceAttrs, err := enclaveObjAttrsProvider.ForEnclaveNamespace(creationTime, enclaveName, enclaveLabels, expiration, resourceQuota, owner)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while trying to get the enclave network attributes for the enclave with ID '%v'", enclaveUuid)
 	}
@@ -95,9 +119,17 @@ func (backend *KubernetesKurtosisBackend) CreateEnclave(
 		}
 	}()
 
+	if resourceQuota != nil && !resourceQuota.IsUnlimited() {
+		// These live in the enclave namespace so they get removed along with it
+		if err := backend.createEnclaveResourceQuota(ctx, enclaveNamespaceName, enclaveUuid, resourceQuota); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the resource quota of enclave '%v' in namespace '%v'", enclaveUuid, enclaveNamespaceName)
+		}
+	}
+
 	enclaveResources := &enclaveKubernetesResources{
 		namespace:           enclaveNamespace,
 		pods:                []apiv1.Pod{},
+		statefulSets:        []appsv1.StatefulSet{},
 		services:            nil,
 		clusterRoles:        []rbacv1.ClusterRole{},
 		clusterRoleBindings: []rbacv1.ClusterRoleBinding{},
@@ -167,6 +199,36 @@ func (backend *KubernetesKurtosisBackend) UpdateEnclave(
 	return nil
 }
 
+func (backend *KubernetesKurtosisBackend) UpdateEnclaveExpiration(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	expiration *enclave.EnclaveExpiration,
+) error {
+	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave object and Kubernetes resources for enclave ID '%v'", enclaveUuid)
+	}
+	namespace := kubernetesResources.namespace
+	if namespace == nil {
+		return stacktrace.NewError("Cannot update the expiration of enclave '%v' because no Kubernetes namespace exists for it", enclaveUuid)
+	}
+
+	updatedAnnotations := map[string]string{
+		kubernetes_annotation_key_consts.EnclaveExpirationTimeAnnotationKey.GetString(): expiration.GetExpirationTime().Format(time.RFC3339),
+		kubernetes_annotation_key_consts.EnclaveExpiryActionAnnotationKey.GetString():   string(expiration.GetAction()),
+	}
+
+	namespaceApplyConfigurator := func(namespaceApplyConfig *applyconfigurationsv1.NamespaceApplyConfiguration) {
+		namespaceApplyConfig.WithAnnotations(updatedAnnotations)
+	}
+
+	if _, err := backend.kubernetesManager.UpdateNamespace(ctx, namespace.GetName(), namespaceApplyConfigurator); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the expiration of enclave with UUID '%v', it was trying to apply these new annotations '%+v'", enclaveUuid, updatedAnnotations)
+	}
+
+	return nil
+}
+
 func (backend *KubernetesKurtosisBackend) StopEnclaves(
 	ctx context.Context,
 	filters *enclave.EnclaveFilters,
@@ -186,11 +248,39 @@ func (backend *KubernetesKurtosisBackend) StopEnclaves(
 	for enclaveId, resources := range matchingKubernetesResources {
 		namespaceName := resources.namespace.GetName()
 
+		// Stateful sets, which would otherwise recreate the pods removed below
+		if resources.statefulSets != nil {
+			errorsByStatefulSetName := map[string]error{}
+			for _, statefulSet := range resources.statefulSets {
+				statefulSetName := statefulSet.GetName()
+				if err := backend.kubernetesManager.RemoveStatefulSet(ctx, &statefulSet); err != nil {
+					errorsByStatefulSetName[statefulSetName] = err
+					continue
+				}
+			}
+
+			if len(errorsByStatefulSetName) > 0 {
+				combinedErrorTitle := fmt.Sprintf("Namespace %v - Stateful Set", namespaceName)
+				combinedError := shared_helpers.BuildCombinedError(errorsByStatefulSetName, combinedErrorTitle)
+				erroredEnclaveIds[enclaveId] = stacktrace.Propagate(
+					combinedError,
+					"An error occurred removing one or more stateful sets in namespace '%v' for enclave with ID '%v'",
+					namespaceName,
+					enclaveId,
+				)
+				continue
+			}
+		}
+
 		// Pods
 		if resources.pods != nil {
 			errorsByPodName := map[string]error{}
 			for _, pod := range resources.pods {
 				podName := pod.GetName()
+				if podController := metav1.GetControllerOf(&pod); podController != nil && podController.Kind == statefulSetKind {
+					// Already removed along with its stateful set
+					continue
+				}
 				if err := backend.kubernetesManager.RemovePod(ctx, &pod); err != nil {
 					errorsByPodName[podName] = err
 					continue
@@ -371,6 +461,10 @@ func (backend *KubernetesKurtosisBackend) getMatchingEnclaveObjectsAndKubernetes
 			}
 		}
 
+		if !filters.MatchesLabels(enclaveObj.GetLabels()) {
+			continue
+		}
+
 		resultEnclaveObjs[enclaveId] = enclaveObj
 		if _, found := matchingResources[enclaveId]; !found {
 			return nil, nil, stacktrace.NewError("Expected to find Kubernetes resources matching enclave '%v' but none was found", enclaveId)
@@ -388,6 +482,7 @@ func (backend *KubernetesKurtosisBackend) getSingleEnclaveAndKubernetesResources
 			enclaveUuid: true,
 		},
 		Statuses: nil,
+		Labels:   nil,
 	}
 	matchingEnclaveObjects, matchingKubernetesResources, err := backend.getMatchingEnclaveObjectsAndKubernetesResources(ctx, enclaveSearchFilters)
 	if err != nil {
@@ -513,6 +608,13 @@ func (backend *KubernetesKurtosisBackend) createGetEnclaveResourcesOperation(
 		var pods []apiv1.Pod
 		pods = append(pods, podsList.Items...)
 
+		statefulSetsList, err := backend.kubernetesManager.GetStatefulSetsByLabels(ctx, namespaceName, enclaveWithIDMatchLabels)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting stateful sets matching enclave ID '%v' in namespace '%v'", enclaveIdStr, namespace.GetName())
+		}
+		var statefulSets []appsv1.StatefulSet
+		statefulSets = append(statefulSets, statefulSetsList.Items...)
+
 		var services []apiv1.Service
 		services = append(services, servicesList.Items...)
 
@@ -525,6 +627,7 @@ func (backend *KubernetesKurtosisBackend) createGetEnclaveResourcesOperation(
 		enclaveResources := &enclaveKubernetesResources{
 			namespace:           namespace,
 			pods:                pods,
+			statefulSets:        statefulSets,
 			services:            services,
 			clusterRoles:        clusterRoles,
 			clusterRoleBindings: clusterRoleBindings,
@@ -560,12 +663,33 @@ func getEnclaveObjectsFromKubernetesResources(
 
 		enclaveName := getEnclaveNameFromEnclaveNamespace(resourcesForEnclaveId.namespace)
 
+		enclaveLabels, err := getEnclaveLabelsFromEnclaveNamespace(resourcesForEnclaveId.namespace)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the enclave's labels from the enclave's namespace '%+v'", resourcesForEnclaveId.namespace)
+		}
+
+		enclaveExpiration, err := getEnclaveExpirationFromEnclaveNamespace(resourcesForEnclaveId.namespace)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the enclave's expiration from the enclave's namespace '%+v'", resourcesForEnclaveId.namespace)
+		}
+
+		enclaveResourceQuota, err := getEnclaveResourceQuotaFromEnclaveNamespace(resourcesForEnclaveId.namespace)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the enclave's resource quota from the enclave's namespace '%+v'", resourcesForEnclaveId.namespace)
+		}
+
+		enclaveOwner := getEnclaveOwnerFromEnclaveNamespace(resourcesForEnclaveId.namespace)
+
 		enclaveObj := enclave.NewEnclave(
 			enclaveId,
 			enclaveName,
 			enclaveStatus,
 			enclaveCreationTime,
 			false,
+			enclaveLabels,
+			enclaveExpiration,
+			enclaveResourceQuota,
+			enclaveOwner,
 		)
 
 		result[enclaveId] = enclaveObj
@@ -623,3 +747,111 @@ func getEnclaveNameFromEnclaveNamespace(namespace *apiv1.Namespace) string {
 
 	return enclaveCreationTimeStr
 }
+
+func getEnclaveResourceQuotaFromEnclaveNamespace(namespace *apiv1.Namespace) (*enclave.EnclaveResourceQuota, error) {
+	namespaceAnnotations := namespace.Annotations
+
+	serializedResourceQuota, found := namespaceAnnotations[kubernetes_annotation_key_consts.EnclaveResourceQuotaAnnotationKey.GetString()]
+	if !found {
+		// Enclave without resource quota
+		return nil, nil
+	}
+
+	// nolint: exhaustruct
+	resourceQuota := &enclave.EnclaveResourceQuota{}
+	if err := json.Unmarshal([]byte(serializedResourceQuota), resourceQuota); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing enclave resource quota '%v'", serializedResourceQuota)
+	}
+	return resourceQuota, nil
+}
+
+func getEnclaveOwnerFromEnclaveNamespace(namespace *apiv1.Namespace) string {
+	// Enclaves created while the engine wasn't requiring authentication don't have an owner
+	return namespace.Annotations[kubernetes_annotation_key_consts.EnclaveOwnerAnnotationKey.GetString()]
+}
+
+// createEnclaveResourceQuota creates a ResourceQuota mirroring the enclave resource quota in the enclave namespace.
+// Kubernetes rejects the pods that don't request a resource capped by a ResourceQuota, which is the case of the API
+// container and of the services without min_cpu or min_memory, so a LimitRange defaults their requests to zero
+func (backend *KubernetesKurtosisBackend) createEnclaveResourceQuota(
+	ctx context.Context,
+	namespaceName string,
+	enclaveUuid enclave.EnclaveUUID,
+	resourceQuota *enclave.EnclaveResourceQuota,
+) error {
+	resourceQuotaAttrs, err := backend.objAttrsProvider.ForEnclave(enclaveUuid).ForEnclaveResourceQuota()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the resource quota attributes for enclave '%v'", enclaveUuid)
+	}
+	resourceQuotaName := resourceQuotaAttrs.GetName().GetString()
+	resourceQuotaLabels := shared_helpers.GetStringMapFromLabelMap(resourceQuotaAttrs.GetLabels())
+
+	hardLimits, defaultContainerRequests := getKubernetesResourceQuotaLimits(resourceQuota)
+
+	if len(defaultContainerRequests) > 0 {
+		if _, err := backend.kubernetesManager.CreateLimitRange(ctx, namespaceName, resourceQuotaName, resourceQuotaLabels, defaultContainerRequests); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the limit range defaulting container requests to '%+v'", defaultContainerRequests)
+		}
+	}
+	if _, err := backend.kubernetesManager.CreateResourceQuota(ctx, namespaceName, resourceQuotaName, resourceQuotaLabels, hardLimits); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the resource quota with hard limits '%+v'", hardLimits)
+	}
+	return nil
+}
+
+func getKubernetesResourceQuotaLimits(resourceQuota *enclave.EnclaveResourceQuota) (apiv1.ResourceList, apiv1.ResourceList) {
+	hardLimits := apiv1.ResourceList{}
+	defaultContainerRequests := apiv1.ResourceList{}
+	if maxCpuMilliCores := resourceQuota.GetMaxCpuMilliCores(); maxCpuMilliCores != enclave.NoResourceQuotaLimit {
+		hardLimits[apiv1.ResourceRequestsCPU] = *resource.NewMilliQuantity(int64(maxCpuMilliCores), resource.DecimalSI)
+		defaultContainerRequests[apiv1.ResourceCPU] = *resource.NewMilliQuantity(0, resource.DecimalSI)
+	}
+	if maxMemoryMegaBytes := resourceQuota.GetMaxMemoryMegaBytes(); maxMemoryMegaBytes != enclave.NoResourceQuotaLimit {
+		hardLimits[apiv1.ResourceRequestsMemory] = *resource.NewQuantity(int64(maxMemoryMegaBytes*resourceQuotaMegabytesToBytesFactor), resource.DecimalSI)
+		defaultContainerRequests[apiv1.ResourceMemory] = *resource.NewQuantity(0, resource.DecimalSI)
+	}
+	if maxServices := resourceQuota.GetMaxServices(); maxServices != enclave.NoResourceQuotaLimit {
+		hardLimits[apiv1.ResourcePods] = *resource.NewQuantity(int64(maxServices+numKurtosisPodsInEnclaveNamespace), resource.DecimalSI)
+	}
+	if maxPersistentStorageMegaBytes := resourceQuota.GetMaxPersistentStorageMegaBytes(); maxPersistentStorageMegaBytes != enclave.NoResourceQuotaLimit {
+		hardLimits[apiv1.ResourceRequestsStorage] = *resource.NewQuantity(int64(maxPersistentStorageMegaBytes*resourceQuotaStorageMegabytesToBytesFactor), resource.BinarySI)
+	}
+	return hardLimits, defaultContainerRequests
+}
+
+func getEnclaveLabelsFromEnclaveNamespace(namespace *apiv1.Namespace) (map[string]string, error) {
+	namespaceAnnotations := namespace.Annotations
+
+	serializedEnclaveLabels, found := namespaceAnnotations[kubernetes_annotation_key_consts.EnclaveLabelsAnnotationKey.GetString()]
+	if !found {
+		return map[string]string{}, nil
+	}
+
+	enclaveLabels := map[string]string{}
+	if err := json.Unmarshal([]byte(serializedEnclaveLabels), &enclaveLabels); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing enclave labels '%v'", serializedEnclaveLabels)
+	}
+	return enclaveLabels, nil
+}
+
+func getEnclaveExpirationFromEnclaveNamespace(namespace *apiv1.Namespace) (*enclave.EnclaveExpiration, error) {
+	namespaceAnnotations := namespace.Annotations
+
+	expirationTimeStr, found := namespaceAnnotations[kubernetes_annotation_key_consts.EnclaveExpirationTimeAnnotationKey.GetString()]
+	if !found {
+		// Enclave that never expires
+		return nil, nil
+	}
+
+	expirationTime, err := time.Parse(time.RFC3339, expirationTimeStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing enclave expiration time '%v' using this format '%v'", expirationTimeStr, time.RFC3339)
+	}
+
+	expiryAction := enclave.EnclaveExpiryAction(namespaceAnnotations[kubernetes_annotation_key_consts.EnclaveExpiryActionAnnotationKey.GetString()])
+	if !expiryAction.IsValid() {
+		return nil, stacktrace.NewError("Invalid enclave expiry action '%v'; valid actions are '%v'", expiryAction, enclave.AllEnclaveExpiryActions)
+	}
+
+	return enclave.NewEnclaveExpiration(expirationTime, expiryAction), nil
+}
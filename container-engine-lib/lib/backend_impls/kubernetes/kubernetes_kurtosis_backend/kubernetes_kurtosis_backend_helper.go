@@ -2,8 +2,10 @@ package kubernetes_kurtosis_backend
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/service_exposure"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/metrics_reporting"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
@@ -74,6 +76,9 @@ func GetEngineServerBackend(
 func GetApiContainerBackend(
 	ctx context.Context,
 	storageClass string,
+	serviceExposureConfig *service_exposure.ServiceExposureConfig,
+	userServiceWorkloadType consts.UserServiceWorkloadType,
+	taskHelperImage string,
 	productionMode bool,
 ) (backend_interface.KurtosisBackend, error) {
 	kubernetesConfig, err := rest.InClusterConfig()
@@ -108,6 +113,9 @@ func GetApiContainerBackend(
 			enclaveId,
 			namespaceName,
 			storageClass,
+			serviceExposureConfig,
+			userServiceWorkloadType,
+			taskHelperImage,
 			productionMode,
 		), nil
 	}
@@ -0,0 +1,86 @@
+package kubernetes_kurtosis_backend
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/engine_functions"
+	kubernetes_manager_consts "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager/consts"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+const (
+	coreApiGroup    = ""
+	appsApiGroup    = "apps"
+	batchApiGroup   = "batch"
+	gatewayApiGroup = "gateway.networking.k8s.io"
+)
+
+// the resources the engine itself reads and writes when managing enclaves, with the API group they live in
+var resourcesManagedByEngine = map[string]string{
+	kubernetes_manager_consts.NamespacesKubernetesResource:             coreApiGroup,
+	kubernetes_manager_consts.PodsKubernetesResource:                   coreApiGroup,
+	kubernetes_manager_consts.ServicesKubernetesResource:               coreApiGroup,
+	kubernetes_manager_consts.PersistentVolumeClaimsKubernetesResource: coreApiGroup,
+	kubernetes_manager_consts.ResourceQuotasKubernetesResource:         coreApiGroup,
+	kubernetes_manager_consts.LimitRangesKubernetesResource:            coreApiGroup,
+	kubernetes_manager_consts.StatefulSetsKubernetesResource:           appsApiGroup,
+	kubernetes_manager_consts.JobsKubernetesResource:                   batchApiGroup,
+	kubernetes_manager_consts.HttpRoutesKubernetesResource:             gatewayApiGroup,
+}
+
+var allVerbs = []string{
+	kubernetes_manager_consts.CreateKubernetesVerb,
+	kubernetes_manager_consts.UpdateKubernetesVerb,
+	kubernetes_manager_consts.PatchKubernetesVerb,
+	kubernetes_manager_consts.DeleteKubernetesVerb,
+	kubernetes_manager_consts.GetKubernetesVerb,
+	kubernetes_manager_consts.ListKubernetesVerb,
+	kubernetes_manager_consts.WatchKubernetesVerb,
+}
+
+func TestEngineClusterRole_CoversResourcesManagedByEngine(t *testing.T) {
+	engineRules := engine_functions.GetEngineClusterRolePolicyRules()
+	for resource, apiGroup := range resourcesManagedByEngine {
+		for _, verb := range allVerbs {
+			require.True(t, isAllowedByRules(engineRules, verb, apiGroup, resource), "Engine cluster role doesn't allow '%v' on '%v' in API group '%v'", verb, resource, apiGroup)
+		}
+	}
+}
+
+// Kubernetes rejects roles granting permissions their creator doesn't hold, so the engine needs everything the
+// API containers get
+func TestEngineClusterRole_IsSupersetOfApiContainerRules(t *testing.T) {
+	engineRules := engine_functions.GetEngineClusterRolePolicyRules()
+	apiContainerRules := append(getApiContainerClusterRolePolicyRules(), getApiContainerRolePolicyRules()...)
+	for _, rule := range apiContainerRules {
+		for _, verb := range rule.Verbs {
+			for _, apiGroup := range rule.APIGroups {
+				for _, resource := range rule.Resources {
+					require.True(t, isAllowedByRules(engineRules, verb, apiGroup, resource), "Engine cluster role doesn't allow '%v' on '%v' in API group '%v', which the API container gets", verb, resource, apiGroup)
+				}
+			}
+		}
+	}
+}
+
+func isAllowedByRules(rules []rbacv1.PolicyRule, verb string, apiGroup string, resource string) bool {
+	for _, rule := range rules {
+		if containsOrWildcard(rule.Verbs, verb, rbacv1.VerbAll) &&
+			containsOrWildcard(rule.APIGroups, apiGroup, rbacv1.APIGroupAll) &&
+			containsOrWildcard(rule.Resources, resource, rbacv1.ResourceAll) {
+			return true
+		}
+	}
+	return false
+}
+
+// a wildcard requested is only covered by a wildcard granted
+func containsOrWildcard(granted []string, requested string, wildcard string) bool {
+	for _, value := range granted {
+		if value == wildcard || value == requested {
+			return true
+		}
+	}
+	return false
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_value"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_port_spec_serializer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/service_exposure"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
@@ -32,8 +33,10 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
 	apiv1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -66,6 +69,8 @@ const (
 	dumpPodErrorTitle = "Pod"
 
 	emptyImageName = ""
+
+	noContainerRestarts = 0
 )
 
 // Kubernetes doesn't provide public IP or port information; this is instead handled by the Kurtosis gateway that the user uses
@@ -91,6 +96,15 @@ type ApiContainerModeArgs struct {
 
 	storageClassName string
 
+	// Nil if the HTTP ports of the user services aren't exposed outside the cluster
+	serviceExposureConfig *service_exposure.ServiceExposureConfig
+
+	// Whether the user services run as bare pods or as StatefulSets
+	userServiceWorkloadType consts.UserServiceWorkloadType
+
+	// The image the helper that wraps the tasks is copied from, which clusters pulling from a private registry can mirror
+	taskHelperImage string
+
 	// TODO make this more dynamic - maybe guess based on the files artifact size?
 	filesArtifactExpansionVolumeSizeInMegabytes uint
 }
@@ -102,11 +116,14 @@ type dumpPodResult struct {
 
 func NewApiContainerModeArgs(
 	ownEnclaveId enclave.EnclaveUUID,
-	ownNamespaceName string, storageClassName string) *ApiContainerModeArgs {
+	ownNamespaceName string, storageClassName string, serviceExposureConfig *service_exposure.ServiceExposureConfig, userServiceWorkloadType consts.UserServiceWorkloadType, taskHelperImage string) *ApiContainerModeArgs {
 	return &ApiContainerModeArgs{
-		ownEnclaveId:     ownEnclaveId,
-		ownNamespaceName: ownNamespaceName,
-		storageClassName: storageClassName,
+		ownEnclaveId:            ownEnclaveId,
+		ownNamespaceName:        ownNamespaceName,
+		storageClassName:        storageClassName,
+		serviceExposureConfig:   serviceExposureConfig,
+		userServiceWorkloadType: userServiceWorkloadType,
+		taskHelperImage:         taskHelperImage,
 		filesArtifactExpansionVolumeSizeInMegabytes: 0,
 	}
 }
@@ -119,6 +136,18 @@ func (apiContainerModeArgs *ApiContainerModeArgs) GetOwnNamespaceName() string {
 	return apiContainerModeArgs.ownNamespaceName
 }
 
+func (apiContainerModeArgs *ApiContainerModeArgs) GetServiceExposureConfig() *service_exposure.ServiceExposureConfig {
+	return apiContainerModeArgs.serviceExposureConfig
+}
+
+func (apiContainerModeArgs *ApiContainerModeArgs) GetUserServiceWorkloadType() consts.UserServiceWorkloadType {
+	return apiContainerModeArgs.userServiceWorkloadType
+}
+
+func (apiContainerModeArgs *ApiContainerModeArgs) GetTaskHelperImage() string {
+	return apiContainerModeArgs.taskHelperImage
+}
+
 // EngineServerModeArgs TODO(victor.colombo): Can we remove this?
 type EngineServerModeArgs struct{}
 
@@ -142,8 +171,14 @@ type UserServiceKubernetesResources struct {
 	// This can be nil if the user hasn't started a pod for the service yet, or if the pod was deleted
 	Pod *apiv1.Pod
 
+	// Only present when the services run as StatefulSets, in which case it owns the pod
+	StatefulSet *appsv1.StatefulSet
+
 	// This can be nil if the user hasn't started the service yet, or if the ingress was deleted
 	Ingress *netv1.Ingress
+
+	// The Gateway API routes of the HTTP ports, only present when the services are exposed through a Gateway
+	HttpRoutes []*unstructured.Unstructured
 }
 
 func GetEnclaveNamespaceName(
@@ -325,9 +360,11 @@ func GetUserServiceKubernetesResourcesMatchingGuids(
 		resultObj, found := results[serviceUuid]
 		if !found {
 			resultObj = &UserServiceKubernetesResources{
-				Service: nil,
-				Pod:     nil,
-				Ingress: nil,
+				Service:     nil,
+				Pod:         nil,
+				StatefulSet: nil,
+				Ingress:     nil,
+				HttpRoutes:  nil,
 			}
 		}
 		resultObj.Service = kubernetesService
@@ -364,9 +401,11 @@ func GetUserServiceKubernetesResourcesMatchingGuids(
 			resultObj, found := results[serviceUuid]
 			if !found {
 				resultObj = &UserServiceKubernetesResources{
-					Service: nil,
-					Pod:     nil,
-					Ingress: nil,
+					Service:     nil,
+					Pod:         nil,
+					StatefulSet: nil,
+					Ingress:     nil,
+					HttpRoutes:  nil,
 				}
 			}
 			resultObj.Pod = kubernetesPod
@@ -374,6 +413,41 @@ func GetUserServiceKubernetesResourcesMatchingGuids(
 		}
 	}
 
+	// Get k8s stateful sets
+	matchingKubernetesStatefulSets, err := kubernetes_resource_collectors.CollectMatchingStatefulSets(
+		ctx,
+		kubernetesManager,
+		namespaceName,
+		kubernetesResourceSearchLabels,
+		kubernetes_label_key.GUIDKubernetesLabelKey.GetString(),
+		postFilterLabelValues,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting Kubernetes stateful sets matching service UUIDs: %+v", serviceUuids)
+	}
+	for serviceGuidStr, kubernetesStatefulSetsForGuid := range matchingKubernetesStatefulSets {
+		logrus.Tracef("Found Kubernetes stateful sets for GUID '%v': %+v", serviceGuidStr, kubernetesStatefulSetsForGuid)
+		serviceUuid := service.ServiceUUID(serviceGuidStr)
+
+		numStatefulSetsForGuid := len(kubernetesStatefulSetsForGuid)
+		if numStatefulSetsForGuid != 1 {
+			return nil, stacktrace.NewError("Found %v Kubernetes stateful sets associated with service GUID '%v', but number of stateful sets should be exactly 1; this is a bug in Kurtosis", numStatefulSetsForGuid, serviceUuid)
+		}
+
+		resultObj, found := results[serviceUuid]
+		if !found {
+			resultObj = &UserServiceKubernetesResources{
+				Service:     nil,
+				Pod:         nil,
+				StatefulSet: nil,
+				Ingress:     nil,
+				HttpRoutes:  nil,
+			}
+		}
+		resultObj.StatefulSet = kubernetesStatefulSetsForGuid[0]
+		results[serviceUuid] = resultObj
+	}
+
 	// Get k8s ingresses
 	matchingKubernetesIngresses, err := kubernetes_resource_collectors.CollectMatchingIngresses(
 		ctx,
@@ -399,15 +473,47 @@ func GetUserServiceKubernetesResourcesMatchingGuids(
 		resultObj, found := results[serviceUuid]
 		if !found {
 			resultObj = &UserServiceKubernetesResources{
-				Service: nil,
-				Pod:     nil,
-				Ingress: nil,
+				Service:     nil,
+				Pod:         nil,
+				StatefulSet: nil,
+				Ingress:     nil,
+				HttpRoutes:  nil,
 			}
 		}
 		resultObj.Ingress = kubernetesIngress
 		results[serviceUuid] = resultObj
 	}
 
+	// Get Gateway API HTTP routes
+	matchingKubernetesHttpRoutes, err := kubernetes_resource_collectors.CollectMatchingHttpRoutes(
+		ctx,
+		kubernetesManager,
+		namespaceName,
+		kubernetesResourceSearchLabels,
+		kubernetes_label_key.GUIDKubernetesLabelKey.GetString(),
+		postFilterLabelValues,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting Kubernetes HTTP routes matching service UUIDs: %+v", serviceUuids)
+	}
+	for serviceGuidStr, kubernetesHttpRoutesForGuid := range matchingKubernetesHttpRoutes {
+		logrus.Tracef("Found Kubernetes HTTP routes for GUID '%v': %+v", serviceGuidStr, kubernetesHttpRoutesForGuid)
+		serviceUuid := service.ServiceUUID(serviceGuidStr)
+
+		resultObj, found := results[serviceUuid]
+		if !found {
+			resultObj = &UserServiceKubernetesResources{
+				Service:     nil,
+				Pod:         nil,
+				StatefulSet: nil,
+				Ingress:     nil,
+				HttpRoutes:  nil,
+			}
+		}
+		resultObj.HttpRoutes = kubernetesHttpRoutesForGuid
+		results[serviceUuid] = resultObj
+	}
+
 	return results, nil
 }
 
@@ -483,12 +589,14 @@ func GetUserServiceObjectsFromKubernetesResources(
 				privatePorts,
 				servicePublicIp,
 				servicePublicPorts,
+				nil,
 				container.NewContainer(
 					container.ContainerStatus_Stopped,
 					emptyImageName,
 					nil,
 					nil,
-					nil),
+					nil,
+					noContainerRestarts),
 			)
 			continue
 		}
@@ -504,17 +612,24 @@ func GetUserServiceObjectsFromKubernetesResources(
 			podContainerEnvVars[env.Name] = env.Value
 		}
 
+		publicUrls, err := getPublicUrls(kubernetesService)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deserializing the public URLs from the user service's Kubernetes service")
+		}
+
 		resultObj.Service = service.NewService(
 			serviceRegistrationObj,
 			privatePorts,
 			servicePublicIp,
 			servicePublicPorts,
+			publicUrls,
 			container.NewContainer(
 				containerStatus,
 				podContainer.Image,
 				podContainer.Command,
 				podContainer.Args,
 				podContainerEnvVars,
+				getPodRestartCount(resourcesToParse.Pod),
 			),
 		)
 	}
@@ -579,6 +694,19 @@ func GetPrivatePortsAndValidatePortExistence(kubernetesService *apiv1.Service, e
 	return privatePortSpecs, nil
 }
 
+// getPublicUrls returns nil if the services aren't exposed outside the cluster
+func getPublicUrls(kubernetesService *apiv1.Service) (map[string]string, error) {
+	publicUrlsStr, found := kubernetesService.GetAnnotations()[kubernetes_annotation_key_consts.PublicUrlsKubernetesAnnotationKey.GetString()]
+	if !found {
+		return nil, nil
+	}
+	publicUrls := map[string]string{}
+	if err := json.Unmarshal([]byte(publicUrlsStr), &publicUrls); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing public URLs string '%v'", publicUrlsStr)
+	}
+	return publicUrls, nil
+}
+
 func GetContainerStatusFromPod(pod *apiv1.Pod) (container.ContainerStatus, error) {
 	// TODO Rename this; this shouldn't be called "ContainerStatus" since there's no longer a 1:1 mapping between container:kurtosis_object
 	status := container.ContainerStatus_Stopped
@@ -597,6 +725,15 @@ func GetContainerStatusFromPod(pod *apiv1.Pod) (container.ContainerStatus, error
 	return status, nil
 }
 
+// getPodRestartCount returns how many times the containers of the pod were restarted by the kubelet
+func getPodRestartCount(pod *apiv1.Pod) uint32 {
+	restartCount := uint32(0)
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		restartCount += uint32(containerStatus.RestartCount)
+	}
+	return restartCount
+}
+
 func GetStringMapFromLabelMap(labelMap map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue) map[string]string {
 	strMap := map[string]string{}
 	for labelKey, labelValue := range labelMap {
@@ -61,19 +61,24 @@ func CopyFilesFromUserService(
 		)
 	}
 
-	// we remove trailing slash
-	srcPath = filepath.Clean(srcPath)
-	// we get the base dir | file
-	srcPathBase := filepath.Base(srcPath)
-	// we get the dir that holds base the dir | file
-	srcPathDir := filepath.Dir(srcPath)
+	if err := copyFilesFromPod(namespaceName, pod.Name, srcPath, output, kubernetesManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying path '%v' from service '%v' in enclave '%v'", srcPath, serviceUuid, enclaveId)
+	}
 
-	var commandToRun string
+	return nil
+}
 
-	if srcPathBase == doNotIncludeParentDirInArchiveSymbol {
-		srcPathBase = ignoreParentDirInArchiveSymbol
-	}
-	commandToRun = fmt.Sprintf(
+// copyFilesFromPod writes the files at the given path of the user container of the pod, packaged as a TAR, to the output.
+// The container needs to be running and to have 'tar'
+func copyFilesFromPod(
+	namespaceName string,
+	podName string,
+	srcPath string,
+	output io.Writer,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	srcPathDir, srcPathBase := getTarSrcPathDirAndBase(srcPath)
+	commandToRun := fmt.Sprintf(
 		commandString,
 		srcPathDir,
 		srcPathBase,
@@ -86,26 +91,50 @@ func CopyFilesFromUserService(
 		"-c",
 		commandToRun,
 	}
+	return runTarCommandInPod(namespaceName, podName, shWrappedCommandToRun, commandToRun, output, kubernetesManager)
+}
 
+// getTarSrcPathDirAndBase returns the dir holding the path, and the base to archive from that dir
+func getTarSrcPathDirAndBase(srcPath string) (string, string) {
+	// we remove trailing slash
+	srcPath = filepath.Clean(srcPath)
+	// we get the base dir | file
+	srcPathBase := filepath.Base(srcPath)
+	// we get the dir that holds base the dir | file
+	srcPathDir := filepath.Dir(srcPath)
+
+	if srcPathBase == doNotIncludeParentDirInArchiveSymbol {
+		srcPathBase = ignoreParentDirInArchiveSymbol
+	}
+	return srcPathDir, srcPathBase
+}
+
+func runTarCommandInPod(
+	namespaceName string,
+	podName string,
+	command []string,
+	commandToRun string,
+	output io.Writer,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
 	// NOTE: If we hit problems with very large files and connections breaking before they do, 'kubectl cp' implements a retry
 	// mechanism that we could draw inspiration from:
 	// https://github.com/kubernetes/kubectl/blob/335090af6913fb1ebf4a1f9e2463c46248b3e68d/pkg/cmd/cp/cp.go#L345
 	stdErrOutput := &bytes.Buffer{}
 	exitCode, err := kubernetesManager.RunExecCommand(
 		namespaceName,
-		pod.Name,
+		podName,
 		userServiceContainerName,
-		shWrappedCommandToRun,
+		command,
 		output,
 		stdErrOutput,
 	)
 	if err != nil {
 		return stacktrace.Propagate(
 			err,
-			"An error occurred running command '%v' on pod '%v' for service '%v' in namespace '%v'",
+			"An error occurred running command '%v' on pod '%v' in namespace '%v'",
 			commandToRun,
-			pod.Name,
-			serviceUuid,
+			podName,
 			namespaceName,
 		)
 	}
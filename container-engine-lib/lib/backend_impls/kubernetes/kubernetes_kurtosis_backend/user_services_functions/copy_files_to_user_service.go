@@ -0,0 +1,104 @@
+package user_services_functions
+
+import (
+	"bytes"
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	apiv1 "k8s.io/api/core/v1"
+	"path/filepath"
+)
+
+// creates the destination directory, passed as the first positional parameter so that it never gets interpreted by the
+// shell, and extracts the TAR read from STDIN into it
+const (
+	extractCommandScript = `if command -v 'tar' > /dev/null; then mkdir -p -- "$1" && tar xf - -C "$1"; else echo "Cannot copy files to path '$1' because the tar binary doesn't exist on the machine" >&2; exit 1; fi`
+	// the name of the script in its own positional parameters, i.e. $0
+	extractCommandScriptName = "sh"
+)
+
+func CopyFilesToUserService(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	destDirpath string,
+	input io.Reader,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	namespaceName, err := shared_helpers.GetEnclaveNamespaceName(ctx, enclaveId, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting namespace name for enclave '%v'", enclaveId)
+	}
+
+	objectAndResources, err := shared_helpers.GetSingleUserServiceObjectsAndResources(ctx, enclaveId, serviceUuid, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting user service object & Kubernetes resources for service '%v' in enclave '%v'", serviceUuid, enclaveId)
+	}
+	pod := objectAndResources.KubernetesResources.Pod
+	if pod == nil {
+		return stacktrace.NewError(
+			"Cannot copy files to path '%v' on service '%v' in enclave '%v' because no pod exists for the service",
+			destDirpath,
+			serviceUuid,
+			enclaveId,
+		)
+	}
+	if pod.Status.Phase != apiv1.PodRunning {
+		return stacktrace.NewError(
+			"Cannot copy files to path '%v' on service '%v' in enclave '%v' because the pod isn't running",
+			destDirpath,
+			serviceUuid,
+			enclaveId,
+		)
+	}
+
+	destDirpath = filepath.Clean(destDirpath)
+	shWrappedCommandToRun := []string{
+		"sh",
+		"-c",
+		extractCommandScript,
+		extractCommandScriptName,
+		destDirpath,
+	}
+
+	stdOutOutput := &bytes.Buffer{}
+	stdErrOutput := &bytes.Buffer{}
+	exitCode, err := kubernetesManager.RunExecCommandWithStdin(
+		ctx,
+		namespaceName,
+		pod.Name,
+		userServiceContainerName,
+		shWrappedCommandToRun,
+		input,
+		stdOutOutput,
+		stdErrOutput,
+	)
+	if err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred running command '%v' on pod '%v' for service '%v' in namespace '%v'",
+			shWrappedCommandToRun,
+			pod.Name,
+			serviceUuid,
+			namespaceName,
+		)
+	}
+	if exitCode != tarSuccessExitCode {
+		return stacktrace.NewError(
+			"Command '%v' exited with non-%v exit code %v and the following STDERR:\n%v",
+			shWrappedCommandToRun,
+			tarSuccessExitCode,
+			exitCode,
+			stdErrOutput.String(),
+		)
+	}
+
+	return nil
+}
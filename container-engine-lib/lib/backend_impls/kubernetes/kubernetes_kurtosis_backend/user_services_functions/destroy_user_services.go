@@ -49,8 +49,20 @@ func DestroyUserServices(
 				continue
 			}
 		}
+		statefulSetToRemove := resources.StatefulSet
+		if statefulSetToRemove != nil {
+			if err := kubernetesManager.RemoveStatefulSet(ctx, statefulSetToRemove); err != nil {
+				erroredGuids[serviceUuid] = stacktrace.Propagate(
+					err,
+					"An error occurred removing Kubernetes stateful set '%v' in namespace '%v'",
+					statefulSetToRemove.Name,
+					namespaceName,
+				)
+				continue
+			}
+		}
 		podToRemove := resources.Pod
-		if podToRemove != nil {
+		if podToRemove != nil && statefulSetToRemove == nil {
 			if err := kubernetesManager.RemovePod(ctx, podToRemove); err != nil {
 				erroredGuids[serviceUuid] = stacktrace.Propagate(
 					err,
@@ -73,6 +85,17 @@ func DestroyUserServices(
 				continue
 			}
 		}
+		for _, httpRouteToRemove := range resources.HttpRoutes {
+			if err := kubernetesManager.RemoveHttpRoute(ctx, httpRouteToRemove); err != nil {
+				erroredGuids[serviceUuid] = stacktrace.Propagate(
+					err,
+					"An error occurred removing Kubernetes HTTP route '%v' in namespace '%v'",
+					httpRouteToRemove.GetName(),
+					namespaceName,
+				)
+				break
+			}
+		}
 	}
 	return successfulGuids, erroredGuids, nil
 }
@@ -77,6 +77,23 @@ func preparePersistentDirectoriesResources(
 
 	persistentVolumesAndClaims := map[string]*kubernetesVolumeWithClaim{}
 
+	defer func() {
+		if !shouldDeleteVolumeClaims {
+			return
+		}
+		for volumeClaimNameStr := range volumeClaimsCreated {
+			// Background context so we still run this even if the input context was cancelled
+			if err := kubernetesManager.RemovePersistentVolumeClaim(context.Background(), namespace, volumeClaimNameStr); err != nil {
+				logrus.Warnf(
+					"Creating persistent directory volumes didn't complete successfully so we tried to delete volume claim '%v' that we created, but doing so threw an error:\n%v",
+					volumeClaimNameStr,
+					err,
+				)
+				logrus.Warnf("You'll need to clean up volume claim '%v' manually!", volumeClaimNameStr)
+			}
+		}
+	}()
+
 	for dirPath, persistentDirectory := range serviceMountpointsToPersistentKey {
 		volumeAttrs, err := objAttributeProviders.ForSinglePersistentDirectoryVolume(persistentDirectory.PersistentKey)
 		if err != nil {
@@ -90,15 +107,19 @@ func preparePersistentDirectoriesResources(
 		}
 
 		persistentVolumeSize := int64(persistentDirectory.Size)
+		persistentVolumeStorageClass := persistentDirectory.StorageClass
+		persistentVolumeAccessMode := apiv1.PersistentVolumeAccessMode(persistentDirectory.AccessMode)
 
 		// This claim works with a dynamic driver - it will spin up its own volume - the volume will get deleted when said claims is deleted
 		var persistentVolumeClaim *apiv1.PersistentVolumeClaim
 		if persistentVolumeClaim, err = kubernetesManager.GetPersistentVolumeClaim(ctx, namespace, volumeName); err != nil {
-			persistentVolumeClaim, err = kubernetesManager.CreatePersistentVolumeClaim(ctx, namespace, volumeName, volumeLabelsStrs, persistentVolumeSize)
+			persistentVolumeClaim, err = kubernetesManager.CreatePersistentVolumeClaim(ctx, namespace, volumeName, volumeLabelsStrs, persistentVolumeSize, persistentVolumeStorageClass, persistentVolumeAccessMode)
 			if err != nil {
 				return nil, stacktrace.Propagate(err, "An error occurred creating the persistent volume claim for '%s'", persistentDirectory.PersistentKey)
 			}
 			volumeClaimsCreated[persistentVolumeClaim.Name] = persistentVolumeClaim
+		} else if err = kubernetesManager.CheckPersistentVolumeClaimSpec(persistentVolumeClaim, persistentVolumeSize, persistentVolumeStorageClass, persistentVolumeAccessMode); err != nil {
+			return nil, stacktrace.Propagate(err, "Persistent directory '%s' can't reuse the existing persistent volume claim; use another persistent key or the same size, storage class and access mode as before", persistentDirectory.PersistentKey)
 		}
 
 		persistentVolumesAndClaims[dirPath] = &kubernetesVolumeWithClaim{
@@ -106,23 +127,6 @@ func preparePersistentDirectoriesResources(
 		}
 	}
 
-	defer func() {
-		if !shouldDeleteVolumeClaims {
-			return
-		}
-		for volumeClaimNameStr := range volumeClaimsCreated {
-			// Background context so we still run this even if the input context was cancelled
-			if err := kubernetesManager.RemovePersistentVolumeClaim(context.Background(), namespace, volumeClaimNameStr); err != nil {
-				logrus.Warnf(
-					"Creating persistent directory volumes didn't complete successfully so we tried to delete volume claim '%v' that we created, but doing so threw an error:\n%v",
-					volumeClaimNameStr,
-					err,
-				)
-				logrus.Warnf("You'll need to clean up volume claim '%v' manually!", volumeClaimNameStr)
-			}
-		}
-	}()
-
 	shouldDeleteVolumeClaims = false
 	return persistentVolumesAndClaims, nil
 }
@@ -0,0 +1,47 @@
+package user_services_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+func RunUserServiceInteractiveExecCommand(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	cmd []string,
+	streams *interactive_exec.InteractiveExecStreams,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (int32, error) {
+	namespaceName, err := shared_helpers.GetEnclaveNamespaceName(ctx, enclaveId, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting namespace name for enclave '%v'", enclaveId)
+	}
+
+	objectAndResources, err := shared_helpers.GetSingleUserServiceObjectsAndResources(ctx, enclaveId, serviceUuid, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting user service object & Kubernetes resources for service '%v' in enclave '%v'", serviceUuid, enclaveId)
+	}
+	pod := objectAndResources.KubernetesResources.Pod
+	if pod == nil {
+		return 0, stacktrace.NewError("Cannot run interactive exec command '%v' on service '%v' in enclave '%v' because no pod exists for the service", cmd, serviceUuid, enclaveId)
+	}
+	if pod.Status.Phase != apiv1.PodRunning {
+		return 0, stacktrace.NewError("Cannot run interactive exec command '%v' on service '%v' in enclave '%v' because the pod isn't running", cmd, serviceUuid, enclaveId)
+	}
+
+	exitCode, err := kubernetesManager.RunInteractiveExecCommand(ctx, namespaceName, pod.Name, userServiceContainerName, cmd, streams)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred running interactive exec command '%v' on pod '%v' for service '%v' in namespace '%v'", cmd, pod.Name, serviceUuid, namespaceName)
+	}
+	return exitCode, nil
+}
@@ -0,0 +1,368 @@
+package user_services_functions
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	batchv1 "k8s.io/api/batch/v1"
+	apiv1 "k8s.io/api/core/v1"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	taskPodTimeBetweenPolls = 500 * time.Millisecond
+
+	shouldFollowTaskContainerLogs          = false
+	shouldAddTimestampsToTaskContainerLogs = false
+
+	taskSuccessExitCode = 0
+
+	// The task helper is a static busybox copied by an init container to a volume shared with the task container, so
+	// that the task can be wrapped, its streams captured and its files copied without any tool in the task image
+	taskHelperInitContainerName    = "task-helper"
+	taskHelperVolumeName           = "task-helper"
+	taskHelperDirpath              = "/kurtosis-task-helper"
+	taskHelperSourceBinaryFilepath = "/bin/busybox"
+	taskHelperBinaryFilepath       = taskHelperDirpath + "/busybox"
+
+	taskStdoutFilepath            = taskHelperDirpath + "/stdout"
+	taskStderrFilepath            = taskHelperDirpath + "/stderr"
+	taskExitCodeFilepath          = taskHelperDirpath + "/exit-code"
+	taskOutputsReadMarkerFilepath = taskHelperDirpath + "/outputs-read"
+)
+
+// The task command is wrapped to write each of its streams both to a file and to the container logs, then its exit
+// code, and to wait for its outputs and files to be read before exiting with that code. The container stays running
+// meanwhile, which is what lets them be read
+var taskWrapperScript = fmt.Sprintf(
+	`B=%[1]s
+$B mkfifo %[2]s.fifo %[3]s.fifo
+$B tee %[2]s < %[2]s.fifo &
+$B tee %[3]s < %[3]s.fifo >&2 &
+"$@" > %[2]s.fifo 2> %[3]s.fifo
+code=$?
+wait
+echo $code > %[4]s.tmp && $B mv %[4]s.tmp %[4]s
+while [ ! -f %[5]s ]; do $B sleep 1; done
+exit $code`,
+	taskHelperBinaryFilepath,
+	taskStdoutFilepath,
+	taskStderrFilepath,
+	taskExitCodeFilepath,
+	taskOutputsReadMarkerFilepath,
+)
+
+// tries to copy contents of dir and if not dir reverts to default copy, with the tar of the task helper
+var taskHelperTarCommandString = fmt.Sprintf(`cd '%%v' && (%[1]s tar cf - -C '%%v' . || %[1]s tar cf - '%%v')`, taskHelperBinaryFilepath)
+
+var imagePullErrorContainerReasons = map[string]bool{
+	"ErrImagePull":     true,
+	"ImagePullBackOff": true,
+	"InvalidImageName": true,
+}
+
+// taskOutputs holds what's read from the task container before it's let to exit
+type taskOutputs struct {
+	exitCode int32
+	stdout   string
+	stderr   string
+}
+
+// RunUserTask runs the task in the pod of a job that doesn't restart nor retry it, waits for it to exit and captures its
+// exit code, its STDOUT and STDERR, and its logs. Kubernetes enclaves don't have a logs database, so the logs of the task
+// are only returned here. The task command gets wrapped by the task helper, so its entrypoint needs to be set, as the
+// one of the image isn't known here
+func RunUserTask(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	taskUuid service.ServiceUUID,
+	taskName service.ServiceName,
+	taskConfig *service.ServiceConfig,
+	filesToStore map[string]io.Writer,
+	shouldRemoveTaskInBackground bool,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*exec_result.ExecResult, error) {
+	namespaceName, err := shared_helpers.GetEnclaveNamespaceName(ctx, enclaveUuid, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting namespace name for enclave '%v'", enclaveUuid)
+	}
+
+	if len(taskConfig.GetEntrypointArgs()) == 0 {
+		return nil, stacktrace.NewError("Task '%v' can only run on Kubernetes if its entrypoint is set, as its command gets wrapped to tell its STDOUT and STDERR apart and to keep the container running while its files are copied", taskName)
+	}
+	wrappedTaskArgs := append(append([]string{}, taskConfig.GetEntrypointArgs()...), taskConfig.GetCmdArgs()...)
+	entrypointArgs := append([]string{taskHelperBinaryFilepath, "sh", "-c", taskWrapperScript, string(taskName)}, wrappedTaskArgs...)
+
+	var podInitContainers []apiv1.Container
+	var podVolumes []apiv1.Volume
+	var taskContainerVolumeMounts []apiv1.VolumeMount
+	if filesArtifactsExpansion := taskConfig.GetFilesArtifactsExpansion(); filesArtifactsExpansion != nil {
+		podVolumes, taskContainerVolumeMounts, podInitContainers, err = prepareFilesArtifactsExpansionResources(
+			filesArtifactsExpansion.ExpanderImage,
+			filesArtifactsExpansion.ExpanderEnvVars,
+			filesArtifactsExpansion.ExpanderDirpathsToServiceDirpaths,
+		)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the volumes necessary to perform file artifact expansion for task '%s'", taskName)
+		}
+	}
+	taskHelperImage := consts.DefaultTaskHelperImage
+	if apiContainerModeArgs != nil && apiContainerModeArgs.GetTaskHelperImage() != "" {
+		taskHelperImage = apiContainerModeArgs.GetTaskHelperImage()
+	}
+	taskHelperVolume, taskHelperVolumeMount, taskHelperInitContainer := getTaskHelperResources(taskHelperImage)
+	podVolumes = append(podVolumes, taskHelperVolume)
+	taskContainerVolumeMounts = append(taskContainerVolumeMounts, taskHelperVolumeMount)
+	podInitContainers = append(podInitContainers, taskHelperInitContainer)
+
+	podContainers, err := getUserServicePodContainerSpecs(
+		taskConfig.GetContainerImageName(),
+		entrypointArgs,
+		nil,
+		taskConfig.GetEnvVars(),
+		nil,
+		taskContainerVolumeMounts,
+		taskConfig.GetCPUAllocationMillicpus(),
+		taskConfig.GetMemoryAllocationMegabytes(),
+		taskConfig.GetMinCPUAllocationMillicpus(),
+		taskConfig.GetMinMemoryAllocationMegabytes(),
+		taskConfig.GetUser(),
+		taskConfig.GetImageDownloadMode(),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the container specs of task '%v'", taskName)
+	}
+
+	enclaveObjAttributesProvider := object_attributes_provider.GetKubernetesObjectAttributesProvider().ForEnclave(enclaveUuid)
+	jobAttributes, err := enclaveObjAttributesProvider.ForUserTaskJob(taskUuid, taskName, taskConfig.GetLabels())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting attributes for the job of task '%v'", taskName)
+	}
+	jobName := jobAttributes.GetName().GetString()
+
+	createdJob, err := kubernetesManager.CreateJob(
+		ctx,
+		namespaceName,
+		jobName,
+		shared_helpers.GetStringMapFromLabelMap(jobAttributes.GetLabels()),
+		shared_helpers.GetStringMapFromAnnotationMap(jobAttributes.GetAnnotations()),
+		podInitContainers,
+		podContainers,
+		podVolumes,
+		userServiceServiceAccountName,
+		taskConfig.GetTolerations(),
+		taskConfig.GetNodeSelectors(),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating job '%v' using image '%v'", jobName, taskConfig.GetContainerImageName())
+	}
+	defer func() {
+		removeJob := func() {
+			// Use background context, so we remove the job even if input context was cancelled
+			if err := kubernetesManager.RemoveJob(context.Background(), createdJob); err != nil {
+				logrus.Errorf("An error occurred removing the job of task '%v':\n%v", taskName, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to remove job '%v' in '%v' manually!!!", jobName, namespaceName)
+			}
+		}
+		if shouldRemoveTaskInBackground {
+			go removeJob()
+			return
+		}
+		removeJob()
+	}()
+
+	taskPod, outputs, err := waitForTaskToExit(ctx, namespaceName, createdJob, filesToStore, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred waiting for task '%v' to exit", taskName)
+	}
+
+	logsReadCloser, err := kubernetesManager.GetContainerLogs(ctx, namespaceName, taskPod.Name, userServiceContainerName, shouldFollowTaskContainerLogs, shouldAddTimestampsToTaskContainerLogs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs of task '%v'", taskName)
+	}
+	defer logsReadCloser.Close()
+	output, err := io.ReadAll(logsReadCloser)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the logs of task '%v'", taskName)
+	}
+
+	return exec_result.NewExecResultWithStreams(outputs.exitCode, string(output), outputs.stdout, outputs.stderr), nil
+}
+
+// waitForTaskToExit polls the pod of the job until its task container terminates. The outputs of the task, and its files
+// if it succeeded, are read once the wrapped task command wrote its exit code, then the container is let to exit
+func waitForTaskToExit(
+	ctx context.Context,
+	namespaceName string,
+	job *batchv1.Job,
+	filesToStore map[string]io.Writer,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*apiv1.Pod, *taskOutputs, error) {
+	var outputs *taskOutputs
+	for {
+		pod, err := kubernetesManager.GetJobPod(ctx, namespaceName, job.Name)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred getting the pod of job '%v'", job.Name)
+		}
+		if pod != nil {
+			for _, containerStatus := range pod.Status.ContainerStatuses {
+				if containerStatus.Name != userServiceContainerName {
+					continue
+				}
+				if terminatedState := containerStatus.State.Terminated; terminatedState != nil {
+					if outputs == nil {
+						return nil, nil, stacktrace.NewError("The task container of pod '%v' exited with code '%v' and reason '%v' before its outputs could be read: %v", pod.Name, terminatedState.ExitCode, terminatedState.Reason, terminatedState.Message)
+					}
+					return pod, outputs, nil
+				}
+				if waitingState := containerStatus.State.Waiting; waitingState != nil && imagePullErrorContainerReasons[waitingState.Reason] {
+					return nil, nil, stacktrace.NewError("The task container of pod '%v' can't pull image '%v': %v", pod.Name, containerStatus.Image, waitingState.Message)
+				}
+				if containerStatus.State.Running != nil && outputs == nil {
+					if outputs, err = readOutputsIfTaskExited(namespaceName, pod.Name, filesToStore, kubernetesManager); err != nil {
+						return nil, nil, stacktrace.Propagate(err, "An error occurred reading the outputs of the task in pod '%v'", pod.Name)
+					}
+				}
+			}
+			if pod.Status.Phase == apiv1.PodFailed && len(pod.Status.ContainerStatuses) == 0 {
+				return nil, nil, stacktrace.NewError("The pod '%v' of job '%v' failed before running the task: %v", pod.Name, job.Name, pod.Status.Message)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, stacktrace.Propagate(ctx.Err(), "Stopped waiting for job '%v' to complete", job.Name)
+		case <-time.After(taskPodTimeBetweenPolls):
+		}
+	}
+}
+
+// readOutputsIfTaskExited reads the exit code and the streams of the wrapped task command once it exited, copies its
+// files out of the task container if it succeeded, then lets the container exit. It returns nil if the task command is
+// still running
+func readOutputsIfTaskExited(
+	namespaceName string,
+	podName string,
+	filesToStore map[string]io.Writer,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*taskOutputs, error) {
+	exitCodeOutput := &bytes.Buffer{}
+	readExitCodeExitCode, err := kubernetesManager.RunExecCommand(namespaceName, podName, userServiceContainerName, []string{taskHelperBinaryFilepath, "cat", taskExitCodeFilepath}, exitCodeOutput, io.Discard)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the exit code of the task in pod '%v'", podName)
+	}
+	if readExitCodeExitCode != taskSuccessExitCode {
+		// the task command hasn't written its exit code yet
+		return nil, nil
+	}
+	exitCode, err := strconv.ParseInt(strings.TrimSpace(exitCodeOutput.String()), 10, 32)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the exit code '%v' of the task in pod '%v'", exitCodeOutput.String(), podName)
+	}
+
+	stdout, err := readTaskHelperFile(namespaceName, podName, taskStdoutFilepath, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the STDOUT of the task in pod '%v'", podName)
+	}
+	stderr, err := readTaskHelperFile(namespaceName, podName, taskStderrFilepath, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the STDERR of the task in pod '%v'", podName)
+	}
+
+	if exitCode == taskSuccessExitCode {
+		for srcPath, output := range filesToStore {
+			if err := copyFilesFromTaskPod(namespaceName, podName, srcPath, output, kubernetesManager); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred copying the files at '%v' from pod '%v'", srcPath, podName)
+			}
+		}
+	}
+
+	markerStderr := &bytes.Buffer{}
+	markerExitCode, err := kubernetesManager.RunExecCommand(namespaceName, podName, userServiceContainerName, []string{taskHelperBinaryFilepath, "touch", taskOutputsReadMarkerFilepath}, io.Discard, markerStderr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred letting the task container of pod '%v' exit", podName)
+	}
+	if markerExitCode != taskSuccessExitCode {
+		return nil, stacktrace.NewError("Letting the task container of pod '%v' exit failed with exit code '%v' and the following STDERR:\n%v", podName, markerExitCode, markerStderr.String())
+	}
+	return &taskOutputs{
+		exitCode: int32(exitCode),
+		stdout:   stdout,
+		stderr:   stderr,
+	}, nil
+}
+
+func readTaskHelperFile(namespaceName string, podName string, filepath string, kubernetesManager *kubernetes_manager.KubernetesManager) (string, error) {
+	output := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	exitCode, err := kubernetesManager.RunExecCommand(namespaceName, podName, userServiceContainerName, []string{taskHelperBinaryFilepath, "cat", filepath}, output, stderr)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading file '%v' in pod '%v'", filepath, podName)
+	}
+	if exitCode != taskSuccessExitCode {
+		return "", stacktrace.NewError("Reading file '%v' in pod '%v' failed with exit code '%v' and the following STDERR:\n%v", filepath, podName, exitCode, stderr.String())
+	}
+	return output.String(), nil
+}
+
+// copyFilesFromTaskPod is like copyFilesFromPod, but uses the tar of the task helper so that the task image doesn't need one
+func copyFilesFromTaskPod(
+	namespaceName string,
+	podName string,
+	srcPath string,
+	output io.Writer,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	srcPathDir, srcPathBase := getTarSrcPathDirAndBase(srcPath)
+	commandToRun := fmt.Sprintf(taskHelperTarCommandString, srcPathDir, srcPathBase, srcPathBase)
+	helperWrappedCommandToRun := []string{
+		taskHelperBinaryFilepath,
+		"sh",
+		"-c",
+		commandToRun,
+	}
+	return runTarCommandInPod(namespaceName, podName, helperWrappedCommandToRun, commandToRun, output, kubernetesManager)
+}
+
+// getTaskHelperResources returns the volume shared by the init container copying the task helper and the task container
+func getTaskHelperResources(taskHelperImage string) (apiv1.Volume, apiv1.VolumeMount, apiv1.Container) {
+	volume := apiv1.Volume{
+		Name: taskHelperVolumeName,
+		VolumeSource: apiv1.VolumeSource{ //nolint:exhaustruct
+			EmptyDir: &apiv1.EmptyDirVolumeSource{
+				Medium:    "",
+				SizeLimit: nil,
+			},
+		},
+	}
+	volumeMount := apiv1.VolumeMount{
+		Name:             taskHelperVolumeName,
+		ReadOnly:         false,
+		MountPath:        taskHelperDirpath,
+		SubPath:          "",
+		MountPropagation: nil,
+		SubPathExpr:      "",
+	}
+	initContainer := apiv1.Container{ //nolint:exhaustruct
+		Name:         taskHelperInitContainerName,
+		Image:        taskHelperImage,
+		Command:      []string{taskHelperSourceBinaryFilepath, "cp", taskHelperSourceBinaryFilepath, taskHelperBinaryFilepath},
+		VolumeMounts: []apiv1.VolumeMount{volumeMount},
+	}
+	return volume, volumeMount, initContainer
+}
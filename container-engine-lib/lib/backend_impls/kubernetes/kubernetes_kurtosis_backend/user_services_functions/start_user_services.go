@@ -2,6 +2,7 @@ package user_services_functions
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_value"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_port_spec_serializer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/service_exposure"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
@@ -24,9 +26,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
 	apiv1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	applyconfigurationsv1 "k8s.io/client-go/applyconfigurations/core/v1"
 )
@@ -177,13 +181,22 @@ func StartRegisteredUserServices(
 		serviceRegisteredThatCanBeStarted[serviceUuid] = serviceConfig
 	}
 
+	var serviceExposureConfig *service_exposure.ServiceExposureConfig
+	userServiceWorkloadType := consts.UserServiceWorkloadType_Pod
+	if apiContainerModeArgs != nil {
+		serviceExposureConfig = apiContainerModeArgs.GetServiceExposureConfig()
+		userServiceWorkloadType = apiContainerModeArgs.GetUserServiceWorkloadType()
+	}
+
 	successfulStarts, failedStarts, err := runStartServiceOperationsInParallel(
 		ctx,
 		enclaveUuid,
 		serviceRegisteredThatCanBeStarted,
 		existingObjectsAndResources,
 		kubernetesManager,
-		restartPolicy)
+		restartPolicy,
+		serviceExposureConfig,
+		userServiceWorkloadType)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while trying to start services in parallel.")
 	}
@@ -246,6 +259,8 @@ func runStartServiceOperationsInParallel(
 	servicesObjectsAndResources map[service.ServiceUUID]*shared_helpers.UserServiceObjectsAndKubernetesResources,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 	restartPolicy apiv1.RestartPolicy,
+	serviceExposureConfig *service_exposure.ServiceExposureConfig,
+	userServiceWorkloadType consts.UserServiceWorkloadType,
 ) (
 	map[service.ServiceUUID]*service.Service,
 	map[service.ServiceUUID]error,
@@ -260,7 +275,9 @@ func runStartServiceOperationsInParallel(
 			servicesObjectsAndResources,
 			enclaveUUID,
 			kubernetesManager,
-			restartPolicy)
+			restartPolicy,
+			serviceExposureConfig,
+			userServiceWorkloadType)
 	}
 
 	successfulServiceObjs, failedOperations := operation_parallelizer.RunOperationsInParallel(startServiceOperations)
@@ -294,7 +311,9 @@ func createStartServiceOperation(
 	servicesObjectsAndResources map[service.ServiceUUID]*shared_helpers.UserServiceObjectsAndKubernetesResources,
 	enclaveUuid enclave.EnclaveUUID,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
-	restartPolicy apiv1.RestartPolicy) operation_parallelizer.Operation {
+	restartPolicy apiv1.RestartPolicy,
+	serviceExposureConfig *service_exposure.ServiceExposureConfig,
+	userServiceWorkloadType consts.UserServiceWorkloadType) operation_parallelizer.Operation {
 
 	return func() (interface{}, error) {
 		filesArtifactsExpansion := serviceConfig.GetFilesArtifactsExpansion()
@@ -410,28 +429,58 @@ func createStartServiceOperation(
 		}
 
 		podName := podAttributes.GetName().GetString()
-		createdPod, err := kubernetesManager.CreatePod(
-			ctx,
-			namespaceName,
-			podName,
-			podLabelsStrs,
-			podAnnotationsStrs,
-			podInitContainers,
-			podContainers,
-			podVolumes,
-			userServiceServiceAccountName,
-			restartPolicy,
-			tolerations,
-			nodeSelectors,
-		)
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "An error occurred creating pod '%v' using image '%v'", podName, containerImageName)
+		var createdPod *apiv1.Pod
+		var createdStatefulSet *appsv1.StatefulSet
+		if userServiceWorkloadType == consts.UserServiceWorkloadType_StatefulSet {
+			// The StatefulSet gets the name of the pod, and Kubernetes recreates its pod whatever the restart policy
+			createdStatefulSet, createdPod, err = kubernetesManager.CreateStatefulSet(
+				ctx,
+				namespaceName,
+				podName,
+				kubernetesService.GetName(),
+				podLabelsStrs,
+				podAnnotationsStrs,
+				podInitContainers,
+				podContainers,
+				podVolumes,
+				userServiceServiceAccountName,
+				tolerations,
+				nodeSelectors,
+			)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred creating stateful set '%v' using image '%v'", podName, containerImageName)
+			}
+		} else {
+			createdPod, err = kubernetesManager.CreatePod(
+				ctx,
+				namespaceName,
+				podName,
+				podLabelsStrs,
+				podAnnotationsStrs,
+				podInitContainers,
+				podContainers,
+				podVolumes,
+				userServiceServiceAccountName,
+				restartPolicy,
+				tolerations,
+				nodeSelectors,
+			)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred creating pod '%v' using image '%v'", podName, containerImageName)
+			}
 		}
 		shouldDestroyPod := true
 		defer func() {
 			if !shouldDestroyPod {
 				return
 			}
+			if createdStatefulSet != nil {
+				if err := kubernetesManager.RemoveStatefulSet(ctx, createdStatefulSet); err != nil {
+					logrus.Errorf("Starting service didn't complete successfully so we tried to remove the stateful set we created but doing so threw an error:\n%v", err)
+					logrus.Errorf("ACTION REQUIRED: You'll need to remove stateful set '%v' in '%v' manually!!!", podName, namespaceName)
+				}
+				return
+			}
 			if err := kubernetesManager.RemovePod(ctx, createdPod); err != nil {
 				logrus.Errorf("Starting service didn't complete successfully so we tried to remove the pod we created but doing so threw an error:\n%v", err)
 				logrus.Errorf("ACTION REQUIRED: You'll need to remove pod '%v' in '%v' manually!!!", podName, namespaceName)
@@ -446,9 +495,19 @@ func createStartServiceOperation(
 		ingressLabelsStrs := shared_helpers.GetStringMapFromLabelMap(ingressAttributes.GetLabels())
 		ingressAnnotationsStrs := shared_helpers.GetStringMapFromAnnotationMap(ingressAttributes.GetAnnotations())
 
-		ingressRules, err := getUserServiceIngressRules(serviceRegistrationObj, privatePorts)
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "An error occurred creating the user service ingress rules for service with UUID '%v'", serviceUuid)
+		// Without a service exposure config the ingress only serves the Kurtosis Cloud reverse proxy, and no public URL is reported
+		var publicUrls map[string]string
+		var ingressRules []netv1.IngressRule
+		var ingressClassName *string
+		if serviceExposureConfig == nil || serviceExposureConfig.GetType() == service_exposure.ServiceExposureType_Ingress {
+			ingressRules, publicUrls, err = getUserServiceIngressRules(serviceRegistrationObj, privatePorts, serviceExposureConfig)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred creating the user service ingress rules for service with UUID '%v'", serviceUuid)
+			}
+			if serviceExposureConfig != nil && serviceExposureConfig.GetIngressClassName() != "" {
+				ingressClassNameStr := serviceExposureConfig.GetIngressClassName()
+				ingressClassName = &ingressClassNameStr
+			}
 		}
 
 		shouldDestroyIngress := false
@@ -460,6 +519,7 @@ func createStartServiceOperation(
 				ingressName,
 				ingressLabelsStrs,
 				ingressAnnotationsStrs,
+				ingressClassName,
 				ingressRules,
 			)
 			if err != nil {
@@ -477,7 +537,23 @@ func createStartServiceOperation(
 			}()
 		}
 
-		updatedService, undoServiceUpdateFunc, err := updateServiceWhenContainerStarted(ctx, namespaceName, kubernetesService, privatePorts, kubernetesManager)
+		shouldDestroyHttpRoutes := false
+		if serviceExposureConfig != nil && serviceExposureConfig.GetType() == service_exposure.ServiceExposureType_HttpRoute {
+			var createdHttpRoutes []*unstructured.Unstructured
+			createdHttpRoutes, publicUrls, err = createUserServiceHttpRoutes(ctx, namespaceName, serviceRegistrationObj, privatePorts, ingressLabelsStrs, serviceExposureConfig, kubernetesManager)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred creating the HTTP routes for service with UUID '%v'", serviceUuid)
+			}
+			shouldDestroyHttpRoutes = true
+			defer func() {
+				if !shouldDestroyHttpRoutes {
+					return
+				}
+				removeHttpRoutes(ctx, createdHttpRoutes, kubernetesManager)
+			}()
+		}
+
+		updatedService, undoServiceUpdateFunc, err := updateServiceWhenContainerStarted(ctx, namespaceName, kubernetesService, privatePorts, publicUrls, kubernetesManager)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred updating service '%v' to reflect its new ports: %+v", kubernetesService.GetName(), privatePorts)
 		}
@@ -490,8 +566,11 @@ func createStartServiceOperation(
 
 		kubernetesResources := map[service.ServiceUUID]*shared_helpers.UserServiceKubernetesResources{
 			serviceUuid: {
-				Service: updatedService,
-				Pod:     createdPod,
+				Service:     updatedService,
+				Pod:         createdPod,
+				StatefulSet: createdStatefulSet,
+				Ingress:     nil,
+				HttpRoutes:  nil,
 			},
 		}
 
@@ -510,6 +589,7 @@ func createStartServiceOperation(
 
 		shouldDestroyPod = false
 		shouldDestroyIngress = false
+		shouldDestroyHttpRoutes = false
 		shouldUndoServiceUpdate = false
 		shouldDestroyPersistentVolumesAndClaims = false
 		return objectsAndResources.Service, nil
@@ -518,6 +598,7 @@ func createStartServiceOperation(
 
 // Update the service to:
 //   - Set the service ports appropriately
+//   - Record the URLs where its HTTP ports are exposed outside the cluster, if any
 //   - Irrevocably record that a pod is bound to the service (so that even if the pod is deleted, the service won't
 //     be usable again
 func updateServiceWhenContainerStarted(
@@ -525,6 +606,7 @@ func updateServiceWhenContainerStarted(
 	namespaceName string,
 	kubernetesService *apiv1.Service,
 	privatePorts map[string]*port_spec.PortSpec,
+	publicUrls map[string]string,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 ) (
 	*apiv1.Service,
@@ -553,6 +635,13 @@ func updateServiceWhenContainerStarted(
 		newAnnotations = map[string]string{}
 	}
 	newAnnotations[kubernetes_annotation_key_consts.PortSpecsKubernetesAnnotationKey.GetString()] = serializedPortSpecs.GetString()
+	if len(publicUrls) > 0 {
+		serializedPublicUrls, err := json.Marshal(publicUrls)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred serializing the following public URLs: %+v", publicUrls)
+		}
+		newAnnotations[kubernetes_annotation_key_consts.PublicUrlsKubernetesAnnotationKey.GetString()] = string(serializedPublicUrls)
+	}
 
 	updatingConfigurator := func(updatesToApply *applyconfigurationsv1.ServiceApplyConfiguration) {
 		specUpdateToApply := applyconfigurationsv1.ServiceSpec()
@@ -916,8 +1005,11 @@ func createRegisterUserServiceOperation(
 
 		kubernetesResources := map[service.ServiceUUID]*shared_helpers.UserServiceKubernetesResources{
 			serviceUuid: {
-				Service: createdService,
-				Pod:     nil, // No pod yet
+				Service:     createdService,
+				Pod:         nil, // No pod yet
+				StatefulSet: nil,
+				Ingress:     nil,
+				HttpRoutes:  nil,
 			},
 		}
 
@@ -939,45 +1031,119 @@ func createRegisterUserServiceOperation(
 	}
 }
 
+// getUserServiceIngressRules also returns the URLs of the HTTP ports by port ID, which are only known when the services
+// are exposed through a service exposure config
 func getUserServiceIngressRules(
 	serviceRegistration *service.ServiceRegistration,
 	privatePorts map[string]*port_spec.PortSpec,
-) ([]netv1.IngressRule, error) {
+	serviceExposureConfig *service_exposure.ServiceExposureConfig,
+) ([]netv1.IngressRule, map[string]string, error) {
 	var ingressRules []netv1.IngressRule
+	publicUrls := map[string]string{}
 	enclaveShortUuid := uuid_generator.ShortenedUUIDString(string(serviceRegistration.GetEnclaveID()))
 	serviceShortUuid := uuid_generator.ShortenedUUIDString(string(serviceRegistration.GetUUID()))
-	for _, portSpec := range privatePorts {
-		maybeApplicationProtocol := ""
-		if portSpec.GetMaybeApplicationProtocol() != nil {
-			maybeApplicationProtocol = *portSpec.GetMaybeApplicationProtocol()
-		}
-		if maybeApplicationProtocol == consts.HttpApplicationProtocol {
-			host := fmt.Sprintf("%d-%s-%s", portSpec.GetNumber(), serviceShortUuid, enclaveShortUuid)
-			ingressRule := netv1.IngressRule{
-				Host: host,
-				IngressRuleValue: netv1.IngressRuleValue{
-					HTTP: &netv1.HTTPIngressRuleValue{
-						Paths: []netv1.HTTPIngressPath{
-							{
-								Path:     consts.IngressRulePathAllPaths,
-								PathType: &consts.IngressRulePathTypePrefix,
-								Backend: netv1.IngressBackend{
-									Service: &netv1.IngressServiceBackend{
-										Name: string(serviceRegistration.GetName()),
-										Port: netv1.ServiceBackendPort{
-											Name:   "",
-											Number: int32(portSpec.GetNumber()),
-										},
+	for portId, portSpec := range privatePorts {
+		if !isHttpPort(portSpec) {
+			continue
+		}
+		host := fmt.Sprintf("%d-%s-%s", portSpec.GetNumber(), serviceShortUuid, enclaveShortUuid)
+		if serviceExposureConfig != nil {
+			exposedHost, err := serviceExposureConfig.GetHostname(serviceRegistration.GetEnclaveID(), serviceRegistration.GetUUID(), serviceRegistration.GetName(), portId, portSpec.GetNumber())
+			if err != nil {
+				return nil, nil, stacktrace.Propagate(err, "An error occurred getting the hostname of port '%v'", portId)
+			}
+			host = exposedHost
+			publicUrls[portId] = serviceExposureConfig.GetUrl(host)
+		}
+		ingressRule := netv1.IngressRule{
+			Host: host,
+			IngressRuleValue: netv1.IngressRuleValue{
+				HTTP: &netv1.HTTPIngressRuleValue{
+					Paths: []netv1.HTTPIngressPath{
+						{
+							Path:     consts.IngressRulePathAllPaths,
+							PathType: &consts.IngressRulePathTypePrefix,
+							Backend: netv1.IngressBackend{
+								Service: &netv1.IngressServiceBackend{
+									Name: string(serviceRegistration.GetName()),
+									Port: netv1.ServiceBackendPort{
+										Name:   "",
+										Number: int32(portSpec.GetNumber()),
 									},
-									Resource: nil,
 								},
+								Resource: nil,
 							},
 						},
 					},
 				},
-			}
-			ingressRules = append(ingressRules, ingressRule)
+			},
+		}
+		ingressRules = append(ingressRules, ingressRule)
+	}
+	return ingressRules, publicUrls, nil
+}
+
+// createUserServiceHttpRoutes creates one HTTPRoute per HTTP port, and returns the URLs of the ports by port ID
+func createUserServiceHttpRoutes(
+	ctx context.Context,
+	namespaceName string,
+	serviceRegistration *service.ServiceRegistration,
+	privatePorts map[string]*port_spec.PortSpec,
+	httpRouteLabels map[string]string,
+	serviceExposureConfig *service_exposure.ServiceExposureConfig,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) ([]*unstructured.Unstructured, map[string]string, error) {
+	serviceName := serviceRegistration.GetName()
+	createdHttpRoutes := []*unstructured.Unstructured{}
+	publicUrls := map[string]string{}
+	shouldRemoveHttpRoutes := true
+	defer func() {
+		if shouldRemoveHttpRoutes {
+			removeHttpRoutes(ctx, createdHttpRoutes, kubernetesManager)
+		}
+	}()
+	for portId, portSpec := range privatePorts {
+		if !isHttpPort(portSpec) {
+			continue
+		}
+		hostname, err := serviceExposureConfig.GetHostname(serviceRegistration.GetEnclaveID(), serviceRegistration.GetUUID(), serviceName, portId, portSpec.GetNumber())
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred getting the hostname of port '%v'", portId)
+		}
+		// The port number rather than the port ID goes in the name, as port IDs aren't necessarily valid Kubernetes names
+		httpRouteName := fmt.Sprintf("%v-%v", serviceName, portSpec.GetNumber())
+		createdHttpRoute, err := kubernetesManager.CreateHttpRoute(
+			ctx,
+			namespaceName,
+			httpRouteName,
+			httpRouteLabels,
+			nil,
+			serviceExposureConfig.GetGatewayName(),
+			serviceExposureConfig.GetGatewayNamespace(),
+			hostname,
+			string(serviceName),
+			int32(portSpec.GetNumber()),
+		)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred creating HTTP route '%v' for port '%v'", httpRouteName, portId)
 		}
+		createdHttpRoutes = append(createdHttpRoutes, createdHttpRoute)
+		publicUrls[portId] = serviceExposureConfig.GetUrl(hostname)
 	}
-	return ingressRules, nil
+	shouldRemoveHttpRoutes = false
+	return createdHttpRoutes, publicUrls, nil
+}
+
+func removeHttpRoutes(ctx context.Context, httpRoutes []*unstructured.Unstructured, kubernetesManager *kubernetes_manager.KubernetesManager) {
+	for _, httpRoute := range httpRoutes {
+		if err := kubernetesManager.RemoveHttpRoute(ctx, httpRoute); err != nil {
+			logrus.Errorf("Starting service didn't complete successfully so we tried to remove the HTTP route we created but doing so threw an error:\n%v", err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to remove HTTP route '%v' in '%v' manually!!!", httpRoute.GetName(), httpRoute.GetNamespace())
+		}
+	}
+}
+
+func isHttpPort(portSpec *port_spec.PortSpec) bool {
+	maybeApplicationProtocol := portSpec.GetMaybeApplicationProtocol()
+	return maybeApplicationProtocol != nil && *maybeApplicationProtocol == consts.HttpApplicationProtocol
 }
@@ -32,8 +32,22 @@ func StopUserServices(
 	for serviceUuid, serviceObjsAndResources := range allObjectsAndResources {
 		resources := serviceObjsAndResources.KubernetesResources
 
+		// The StatefulSet would recreate its pod if only the pod were removed
+		statefulSet := resources.StatefulSet
+		if statefulSet != nil {
+			if err := kubernetesManager.RemoveStatefulSet(ctx, statefulSet); err != nil {
+				erroredUuids[serviceUuid] = stacktrace.Propagate(
+					err,
+					"An error occurred removing Kubernetes stateful set '%v' in namespace '%v'",
+					statefulSet.Name,
+					namespaceName,
+				)
+				continue
+			}
+		}
+
 		pod := resources.Pod
-		if pod != nil {
+		if pod != nil && statefulSet == nil {
 			if err := kubernetesManager.RemovePod(ctx, pod); err != nil {
 				erroredUuids[serviceUuid] = stacktrace.Propagate(
 					err,
@@ -18,12 +18,16 @@ const (
 	PodsKubernetesResource                   = "pods"
 	PodExecsKubernetesResource               = "pods/exec"
 	PodLogsKubernetesResource                = "pods/log"
+	StatefulSetsKubernetesResource           = "statefulsets"
 	ServicesKubernetesResource               = "services"
 	JobsKubernetesResource                   = "jobs"
 	NodesKubernetesResource                  = "nodes"
 	PersistentVolumesKubernetesResource      = "persistentvolumes"
 	PersistentVolumeClaimsKubernetesResource = "persistentvolumeclaims"
 	IngressesKubernetesResource              = "ingresses"
+	HttpRoutesKubernetesResource             = "httproutes"
+	ResourceQuotasKubernetesResource         = "resourcequotas"
+	LimitRangesKubernetesResource            = "limitranges"
 
 	ClusterRoleKubernetesResourceType = "ClusterRole"
 	RoleKubernetesResourceType        = "Role"
@@ -20,17 +20,24 @@ import (
 	"time"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/channel_writer"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	terminal "golang.org/x/term"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	apiv1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	applyconfigurationsv1 "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
@@ -71,6 +78,15 @@ const (
 	listOptionsTimeoutSeconds      int64 = 10
 	contextDeadlineExceeded              = "context deadline exceeded"
 	expectedStatusMessageSliceSize       = 6
+
+	httpRouteKind = "HTTPRoute"
+
+	// The user service StatefulSets run a single pod, which always gets the first ordinal
+	statefulSetReplicas   = 1
+	statefulSetPodOrdinal = 0
+
+	// Jobs run their pod once, without retrying it when it fails
+	jobBackoffLimit = 0
 )
 
 // We'll try to use the nicer-to-use shells first before we drop down to the lower shells
@@ -85,6 +101,12 @@ var commandToRunWhenCreatingUserServiceShell = []string{
 }
 
 var (
+	httpRouteGroupVersionResource = schema.GroupVersionResource{
+		Group:    "gateway.networking.k8s.io",
+		Version:  "v1",
+		Resource: "httproutes",
+	}
+
 	globalDeletePolicy  = metav1.DeletePropagationForeground
 	globalDeleteOptions = metav1.DeleteOptions{
 		TypeMeta: metav1.TypeMeta{
@@ -353,11 +375,15 @@ func (manager *KubernetesManager) CreatePersistentVolumeClaim(
 	volumeClaimName string,
 	labels map[string]string,
 	requiredSize int64,
+	maybeStorageClass string,
+	maybeAccessMode apiv1.PersistentVolumeAccessMode,
 ) (*apiv1.PersistentVolumeClaim, error) {
 	if requiredSize == 0 {
 		return nil, stacktrace.NewError("Cannot create volume '%v' of 0 size; need a value greater than 0", volumeClaimName)
 	}
 
+	storageClass, accessMode := manager.getVolumeClaimStorageClassAndAccessMode(maybeStorageClass, maybeAccessMode)
+
 	volumeClaimsClient := manager.kubernetesClientSet.CoreV1().PersistentVolumeClaims(namespace)
 
 	volumeClaimsDefinition := apiv1.PersistentVolumeClaim{
@@ -386,7 +412,7 @@ func (manager *KubernetesManager) CreatePersistentVolumeClaim(
 		},
 		Spec: apiv1.PersistentVolumeClaimSpec{
 			AccessModes: []apiv1.PersistentVolumeAccessMode{
-				apiv1.ReadWriteOnce, // ReadWriteOncePod would be better, but it's a fairly recent feature
+				accessMode,
 			},
 			Selector: nil,
 			Resources: apiv1.ResourceRequirements{
@@ -399,7 +425,7 @@ func (manager *KubernetesManager) CreatePersistentVolumeClaim(
 				Claims: nil,
 			},
 			VolumeName:       "", // we use dynamic provisioning this should happen automagically
-			StorageClassName: &manager.storageClass,
+			StorageClassName: &storageClass,
 			VolumeMode:       nil,
 			DataSource:       nil,
 			DataSourceRef:    nil,
@@ -422,6 +448,35 @@ func (manager *KubernetesManager) CreatePersistentVolumeClaim(
 	return volumeClaim, err
 }
 
+// CheckPersistentVolumeClaimSpec returns an error if the existing volume claim doesn't have the size, storage class and
+// access mode that CreatePersistentVolumeClaim would give it, as the spec of a bound claim can't be changed
+func (manager *KubernetesManager) CheckPersistentVolumeClaimSpec(
+	volumeClaim *apiv1.PersistentVolumeClaim,
+	requiredSize int64,
+	maybeStorageClass string,
+	maybeAccessMode apiv1.PersistentVolumeAccessMode,
+) error {
+	storageClass, accessMode := manager.getVolumeClaimStorageClassAndAccessMode(maybeStorageClass, maybeAccessMode)
+
+	existingStorageClass := ""
+	if volumeClaim.Spec.StorageClassName != nil {
+		existingStorageClass = *volumeClaim.Spec.StorageClassName
+	}
+	if existingStorageClass != storageClass {
+		return stacktrace.NewError("Persistent volume claim '%s' already exists with storage class '%s' but storage class '%s' was requested", volumeClaim.Name, existingStorageClass, storageClass)
+	}
+
+	if len(volumeClaim.Spec.AccessModes) != 1 || volumeClaim.Spec.AccessModes[0] != accessMode {
+		return stacktrace.NewError("Persistent volume claim '%s' already exists with access modes '%v' but access mode '%s' was requested", volumeClaim.Name, volumeClaim.Spec.AccessModes, accessMode)
+	}
+
+	existingSize, found := volumeClaim.Spec.Resources.Requests[apiv1.ResourceStorage]
+	if !found || existingSize.Value() != requiredSize {
+		return stacktrace.NewError("Persistent volume claim '%s' already exists with size '%s' but a size of '%d' bytes was requested", volumeClaim.Name, existingSize.String(), requiredSize)
+	}
+	return nil
+}
+
 func (manager *KubernetesManager) RemovePersistentVolumeClaim(
 	ctx context.Context,
 	namespace string,
@@ -453,6 +508,20 @@ func (manager *KubernetesManager) GetPersistentVolumeClaim(
 	return volumeClaim, nil
 }
 
+// getVolumeClaimStorageClassAndAccessMode returns the storage class and access mode of a volume claim, falling back to
+// the defaults of the cluster for the ones that aren't set
+func (manager *KubernetesManager) getVolumeClaimStorageClassAndAccessMode(maybeStorageClass string, maybeAccessMode apiv1.PersistentVolumeAccessMode) (string, apiv1.PersistentVolumeAccessMode) {
+	storageClass := manager.storageClass
+	if maybeStorageClass != "" {
+		storageClass = maybeStorageClass
+	}
+	accessMode := apiv1.ReadWriteOnce // ReadWriteOncePod would be better, but it's a fairly recent feature
+	if maybeAccessMode != "" {
+		accessMode = maybeAccessMode
+	}
+	return storageClass, accessMode
+}
+
 // ---------------------------namespaces------------------------------------------------------------------------------
 
 func (manager *KubernetesManager) CreateNamespace(
@@ -504,6 +573,113 @@ func (manager *KubernetesManager) CreateNamespace(
 	return namespaceResult, nil
 }
 
+// CreateResourceQuota caps the resources that all the pods and volume claims of the namespace can request together
+func (manager *KubernetesManager) CreateResourceQuota(
+	ctx context.Context,
+	namespace string,
+	name string,
+	labels map[string]string,
+	hardLimits apiv1.ResourceList,
+) (*apiv1.ResourceQuota, error) {
+	resourceQuotaClient := manager.kubernetesClientSet.CoreV1().ResourceQuotas(namespace)
+
+	resourceQuota := &apiv1.ResourceQuota{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			GenerateName:    "",
+			Namespace:       namespace,
+			SelfLink:        "",
+			UID:             "",
+			ResourceVersion: "",
+			Generation:      0,
+			CreationTimestamp: metav1.Time{
+				Time: time.Time{},
+			},
+			DeletionTimestamp:          nil,
+			DeletionGracePeriodSeconds: nil,
+			Labels:                     labels,
+			Annotations:                nil,
+			OwnerReferences:            nil,
+			Finalizers:                 nil,
+			ManagedFields:              nil,
+		},
+		Spec: apiv1.ResourceQuotaSpec{
+			Hard:          hardLimits,
+			Scopes:        nil,
+			ScopeSelector: nil,
+		},
+		Status: apiv1.ResourceQuotaStatus{
+			Hard: nil,
+			Used: nil,
+		},
+	}
+
+	resourceQuotaResult, err := resourceQuotaClient.Create(ctx, resourceQuota, globalCreateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create resource quota with name '%s' in namespace '%s'", name, namespace)
+	}
+	return resourceQuotaResult, nil
+}
+
+// CreateLimitRange sets the resources requested by the containers of the namespace that don't request them explicitly
+func (manager *KubernetesManager) CreateLimitRange(
+	ctx context.Context,
+	namespace string,
+	name string,
+	labels map[string]string,
+	defaultContainerRequests apiv1.ResourceList,
+) (*apiv1.LimitRange, error) {
+	limitRangeClient := manager.kubernetesClientSet.CoreV1().LimitRanges(namespace)
+
+	limitRange := &apiv1.LimitRange{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			GenerateName:    "",
+			Namespace:       namespace,
+			SelfLink:        "",
+			UID:             "",
+			ResourceVersion: "",
+			Generation:      0,
+			CreationTimestamp: metav1.Time{
+				Time: time.Time{},
+			},
+			DeletionTimestamp:          nil,
+			DeletionGracePeriodSeconds: nil,
+			Labels:                     labels,
+			Annotations:                nil,
+			OwnerReferences:            nil,
+			Finalizers:                 nil,
+			ManagedFields:              nil,
+		},
+		Spec: apiv1.LimitRangeSpec{
+			Limits: []apiv1.LimitRangeItem{
+				{
+					Type:                 apiv1.LimitTypeContainer,
+					Max:                  nil,
+					Min:                  nil,
+					Default:              nil,
+					DefaultRequest:       defaultContainerRequests,
+					MaxLimitRequestRatio: nil,
+				},
+			},
+		},
+	}
+
+	limitRangeResult, err := limitRangeClient.Create(ctx, limitRange, globalCreateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create limit range with name '%s' in namespace '%s'", name, namespace)
+	}
+	return limitRangeResult, nil
+}
+
 func (manager *KubernetesManager) UpdateNamespace(
 	ctx context.Context,
 	namespaceName string,
@@ -1053,68 +1229,8 @@ func (manager *KubernetesManager) CreatePod(
 ) (*apiv1.Pod, error) {
 	podClient := manager.kubernetesClientSet.CoreV1().Pods(namespaceName)
 
-	podMeta := metav1.ObjectMeta{
-		Name:            podName,
-		GenerateName:    "",
-		Namespace:       "",
-		SelfLink:        "",
-		UID:             "",
-		ResourceVersion: "",
-		Generation:      0,
-		CreationTimestamp: metav1.Time{
-			Time: time.Time{},
-		},
-		DeletionTimestamp:          nil,
-		DeletionGracePeriodSeconds: nil,
-		Labels:                     podLabels,
-		Annotations:                podAnnotations,
-		OwnerReferences:            nil,
-		Finalizers:                 nil,
-		ManagedFields:              nil,
-	}
-	podSpec := apiv1.PodSpec{
-		Volumes:                       podVolumes,
-		InitContainers:                initContainers,
-		Containers:                    podContainers,
-		EphemeralContainers:           nil,
-		RestartPolicy:                 restartPolicy,
-		TerminationGracePeriodSeconds: nil,
-		ActiveDeadlineSeconds:         nil,
-		DNSPolicy:                     "",
-		NodeSelector:                  nodeSelectors,
-		ServiceAccountName:            podServiceAccountName,
-		DeprecatedServiceAccount:      "",
-		AutomountServiceAccountToken:  nil,
-		NodeName:                      "",
-		HostNetwork:                   false,
-		HostPID:                       false,
-		HostIPC:                       false,
-		ShareProcessNamespace:         nil,
-		SecurityContext:               nil,
-		// TODO add support for ImageRegistrySpec to Kubernetes by adding the right secret here
-		// You will have to first publish the secret using the Kubernetes API
-		ImagePullSecrets:          nil,
-		Hostname:                  "",
-		Subdomain:                 "",
-		Affinity:                  nil,
-		SchedulerName:             "",
-		Tolerations:               tolerations,
-		HostAliases:               nil,
-		PriorityClassName:         "",
-		Priority:                  nil,
-		DNSConfig:                 nil,
-		ReadinessGates:            nil,
-		RuntimeClassName:          nil,
-		EnableServiceLinks:        nil,
-		PreemptionPolicy:          nil,
-		Overhead:                  nil,
-		TopologySpreadConstraints: nil,
-		SetHostnameAsFQDN:         nil,
-		OS:                        nil,
-		HostUsers:                 nil,
-		SchedulingGates:           nil,
-		ResourceClaims:            nil,
-	}
+	podMeta := newPodObjectMeta(podName, podLabels, podAnnotations)
+	podSpec := newPodSpec(initContainers, podContainers, podVolumes, podServiceAccountName, restartPolicy, tolerations, nodeSelectors)
 
 	podToCreate := &apiv1.Pod{
 		TypeMeta: metav1.TypeMeta{
@@ -1329,6 +1445,172 @@ func (manager *KubernetesManager) RunExecCommandWithContext(
 	return successExecCommandExitCode, nil
 }
 
+// RunExecCommandWithStdin runs the command like RunExecCommandWithContext but streams stdInInput to the command's STDIN,
+// e.g. to pipe a TAR archive to 'tar x'
+func (manager *KubernetesManager) RunExecCommandWithStdin(
+	ctx context.Context,
+	namespaceName string,
+	podName string,
+	containerName string,
+	command []string,
+	stdInInput io.Reader,
+	stdOutOutput io.Writer,
+	stdErrOutput io.Writer,
+) (
+	resultExitCode int32,
+	resultErr error,
+) {
+	execOptions := &apiv1.PodExecOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		Stdin:     true,
+		Stdout:    shouldAllocatedStdoutOnPodExec,
+		Stderr:    shouldAllocatedStderrOnPodExec,
+		TTY:       shouldAllocateTtyOnPodExec,
+		Container: containerName,
+		Command:   command,
+	}
+
+	//Create a RESTful command request.
+	request := manager.kubernetesClientSet.CoreV1().RESTClient().
+		Post().
+		Namespace(namespaceName).
+		Resource("pods").
+		Name(podName).
+		SubResource("exec").
+		VersionedParams(execOptions, scheme.ParameterCodec)
+	if request == nil {
+		return -1, stacktrace.NewError(
+			"Failed to build a working RESTful request for the command '%s'.",
+			execOptions.Command,
+		)
+	}
+
+	exec, err := remotecommand.NewSPDYExecutor(manager.kuberneteRestConfig, http.MethodPost, request.URL())
+	if err != nil {
+		return -1, stacktrace.Propagate(
+			err,
+			"Failed to build an executor for the command '%s' with the RESTful endpoint '%s'.",
+			execOptions.Command,
+			request.URL().String(),
+		)
+	}
+
+	if err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:             stdInInput,
+		Stdout:            stdOutOutput,
+		Stderr:            stdErrOutput,
+		Tty:               false,
+		TerminalSizeQueue: nil,
+	}); err != nil {
+		// Kubernetes returns the exit code of the command via a string in the error message, so we have to extract it
+		statusError := err.Error()
+		exitCode, err := getExitCodeFromStatusMessage(statusError)
+		if err != nil {
+			return exitCode, stacktrace.Propagate(
+				err,
+				"There was an error trying to parse the message '%s' to an exit code.",
+				statusError,
+			)
+		}
+
+		return exitCode, nil
+	}
+
+	return successExecCommandExitCode, nil
+}
+
+// RunInteractiveExecCommand runs the command in the given container with its standard streams attached to the given
+// ones, optionally allocating a TTY, and blocks until the command completes
+func (manager *KubernetesManager) RunInteractiveExecCommand(
+	ctx context.Context,
+	namespaceName string,
+	podName string,
+	containerName string,
+	command []string,
+	streams *interactive_exec.InteractiveExecStreams,
+) (
+	resultExitCode int32,
+	resultErr error,
+) {
+	isTty := streams.IsTty()
+	execOptions := &apiv1.PodExecOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		Stdin:  streams.GetStdin() != nil,
+		Stdout: shouldAllocatedStdoutOnPodExec,
+		// a TTY merges STDERR into STDOUT, and Kubernetes rejects requests asking for both
+		Stderr:    !isTty,
+		TTY:       isTty,
+		Container: containerName,
+		Command:   command,
+	}
+
+	request := manager.kubernetesClientSet.CoreV1().RESTClient().
+		Post().
+		Namespace(namespaceName).
+		Resource("pods").
+		Name(podName).
+		SubResource("exec").
+		VersionedParams(execOptions, scheme.ParameterCodec)
+	if request == nil {
+		return -1, stacktrace.NewError(
+			"Failed to build a working RESTful request for the command '%s'.",
+			execOptions.Command,
+		)
+	}
+
+	exec, err := remotecommand.NewSPDYExecutor(manager.kuberneteRestConfig, http.MethodPost, request.URL())
+	if err != nil {
+		return -1, stacktrace.Propagate(
+			err,
+			"Failed to build an executor for the command '%s' with the RESTful endpoint '%s'.",
+			execOptions.Command,
+			request.URL().String(),
+		)
+	}
+
+	streamOptions := remotecommand.StreamOptions{
+		Stdin:             streams.GetStdin(),
+		Stdout:            streams.GetStdout(),
+		Stderr:            nil,
+		Tty:               isTty,
+		TerminalSizeQueue: nil,
+	}
+	if !isTty {
+		streamOptions.Stderr = streams.GetStderr()
+	}
+	initialTerminalSize := streams.GetInitialTerminalSize()
+	terminalSizes := streams.GetTerminalSizes()
+	if isTty && (initialTerminalSize != nil || terminalSizes != nil) {
+		streamOptions.TerminalSizeQueue = &terminalSizeQueue{
+			ctx:              ctx,
+			nextTerminalSize: initialTerminalSize,
+			terminalSizes:    terminalSizes,
+		}
+	}
+
+	if err = exec.StreamWithContext(ctx, streamOptions); err != nil {
+		// Kubernetes returns the exit code of the command via a string in the error message, so we have to extract it
+		statusError := err.Error()
+		exitCode, err := getExitCodeFromStatusMessage(statusError)
+		if err != nil {
+			return exitCode, stacktrace.Propagate(
+				err,
+				"There was an error trying to parse the message '%s' to an exit code.",
+				statusError,
+			)
+		}
+		return exitCode, nil
+	}
+
+	return successExecCommandExitCode, nil
+}
+
 func (manager *KubernetesManager) RunExecCommand(
 	namespaceName string,
 	podName string,
@@ -1637,7 +1919,8 @@ func (manager *KubernetesManager) HasComputeNodes(ctx context.Context) (bool, er
 
 // ---------------------------Ingresses------------------------------------------------------------------------------
 
-func (manager *KubernetesManager) CreateIngress(ctx context.Context, namespace string, name string, labels map[string]string, annotations map[string]string, rules []netv1.IngressRule) (*netv1.Ingress, error) {
+// CreateIngress uses the cluster default ingress class if ingressClassName is nil
+func (manager *KubernetesManager) CreateIngress(ctx context.Context, namespace string, name string, labels map[string]string, annotations map[string]string, ingressClassName *string, rules []netv1.IngressRule) (*netv1.Ingress, error) {
 	client := manager.kubernetesClientSet.NetworkingV1().Ingresses(namespace)
 
 	ingress := &netv1.Ingress{
@@ -1665,7 +1948,7 @@ func (manager *KubernetesManager) CreateIngress(ctx context.Context, namespace s
 			ManagedFields:              nil,
 		},
 		Spec: netv1.IngressSpec{
-			IngressClassName: nil,
+			IngressClassName: ingressClassName,
 			DefaultBackend:   nil,
 			TLS:              nil,
 			Rules:            rules,
@@ -1696,113 +1979,323 @@ func (manager *KubernetesManager) RemoveIngress(ctx context.Context, ingress *ne
 	return nil
 }
 
-// TODO Delete this after 2022-08-01 if we're not using Jobs
-/*
-func (manager *KubernetesManager) CreateJobWithContainerAndVolume(ctx context.Context,
-	namespaceName string,
-	jobName *kubernetes_object_name.KubernetesObjectName,
-	jobLabels map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue,
-	jobAnnotations map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue,
-	containers []apiv1.Container,
-	volumes []apiv1.Volume,
-	numRetries int32,
-	ttlSecondsAfterFinished uint,
-) (*v1.Job, error) {
-
-	jobsClient := manager.kubernetesClientSet.BatchV1().Jobs(namespaceName)
-	ttlSecondsAfterFinishedInt32 := int32(ttlSecondsAfterFinished)
-
-	labelStrs := transformTypedLabelsToStrs(jobLabels)
-	annotationStrs := transformTypedAnnotationsToStrs(jobAnnotations)
-
-	jobMeta := metav1.ObjectMeta{
-		Name:                       jobName.GetString(),
-		Labels:                     labelStrs,
-		Annotations:                annotationStrs,
-	}
-
-	podSpec := apiv1.PodSpec{
-		Containers: containers,
-		Volumes: volumes,
-		// We don't want Kubernetes automagically restarting our containers
-		RestartPolicy: apiv1.RestartPolicyNever,
-	}
-
-	jobSpec := v1.JobSpec{
-		BackoffLimit: &numRetries,
-		Template:                apiv1.PodTemplateSpec{
-			Spec: podSpec,
+// ---------------------------HTTP Routes------------------------------------------------------------------------------
+
+// CreateHttpRoute creates a Gateway API HTTPRoute sending the requests for the hostname to a port of a service. The
+// Gateway API types aren't part of client-go so the route goes through the dynamic client
+func (manager *KubernetesManager) CreateHttpRoute(
+	ctx context.Context,
+	namespace string,
+	name string,
+	labels map[string]string,
+	annotations map[string]string,
+	gatewayName string,
+	gatewayNamespace string,
+	hostname string,
+	backendServiceName string,
+	backendPortNumber int32,
+) (*unstructured.Unstructured, error) {
+	client, err := manager.getHttpRoutesClient(namespace)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the HTTPRoutes client for namespace '%v'", namespace)
+	}
+
+	parentRef := map[string]interface{}{
+		"name": gatewayName,
+	}
+	if gatewayNamespace != "" {
+		parentRef["namespace"] = gatewayNamespace
+	}
+	httpRoute := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": httpRouteGroupVersionResource.GroupVersion().String(),
+			"kind":       httpRouteKind,
+			"metadata": map[string]interface{}{
+				"name": name,
+			},
+			"spec": map[string]interface{}{
+				"parentRefs": []interface{}{parentRef},
+				"hostnames":  []interface{}{hostname},
+				"rules": []interface{}{
+					map[string]interface{}{
+						"backendRefs": []interface{}{
+							map[string]interface{}{
+								"name": backendServiceName,
+								"port": int64(backendPortNumber),
+							},
+						},
+					},
+				},
+			},
 		},
-		TTLSecondsAfterFinished: &ttlSecondsAfterFinishedInt32,
 	}
+	httpRoute.SetLabels(labels)
+	httpRoute.SetAnnotations(annotations)
 
-	jobInput := v1.Job{
-		ObjectMeta: jobMeta,
-		Spec:       jobSpec,
+	httpRouteResult, err := client.Create(ctx, httpRoute, globalCreateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create the HTTPRoute with name '%s' in namespace '%v'; make sure that the Gateway API CRDs are installed in the cluster", name, namespace)
 	}
+	return httpRouteResult, nil
+}
 
-	logrus.Debugf("Job resource to create: %+v", jobInput)
+// GetHttpRoutesByLabels returns an empty list if the Gateway API CRDs aren't installed in the cluster
+func (manager *KubernetesManager) GetHttpRoutesByLabels(ctx context.Context, namespace string, httpRouteLabels map[string]string) (*unstructured.UnstructuredList, error) {
+	client, err := manager.getHttpRoutesClient(namespace)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the HTTPRoutes client for namespace '%v'", namespace)
+	}
 
-	job, err := jobsClient.Create(ctx, &jobInput, globalCreateOptions)
+	opts := buildListOptionsFromLabels(httpRouteLabels)
+	httpRouteResult, err := client.List(ctx, opts)
 	if err != nil {
-		return nil, stacktrace.Propagate(
-			err,
-			"Failed to create job '%v' in namespace '%v' with containers '%+v' and volumes '%+v'",
-			jobName,
-			namespaceName,
-			containers,
-			volumes,
-		)
+		if apierrors.IsNotFound(err) {
+			// nolint: exhaustruct
+			return &unstructured.UnstructuredList{}, nil
+		}
+		return nil, stacktrace.Propagate(err, "Failed to list HTTPRoutes with labels '%+v' in namespace '%s'", httpRouteLabels, namespace)
 	}
 
-	return job, nil
+	// Only return objects not tombstoned by Kubernetes
+	var httpRoutesNotMarkedForDeletionList []unstructured.Unstructured
+	for _, httpRoute := range httpRouteResult.Items {
+		if httpRoute.GetDeletionTimestamp() == nil {
+			httpRoutesNotMarkedForDeletionList = append(httpRoutesNotMarkedForDeletionList, httpRoute)
+		}
+	}
+	return &unstructured.UnstructuredList{
+		Object: httpRouteResult.Object,
+		Items:  httpRoutesNotMarkedForDeletionList,
+	}, nil
 }
 
-func (manager *KubernetesManager) DeleteJob(ctx context.Context, namespace string, job *v1.Job) error {
-	jobsClient := manager.kubernetesClientSet.BatchV1().Jobs(namespace)
-	if jobsClient == nil {
-		return stacktrace.NewError("Failed to create a jobs client for namespace '%v'", namespace)
+func (manager *KubernetesManager) RemoveHttpRoute(ctx context.Context, httpRoute *unstructured.Unstructured) error {
+	namespace := httpRoute.GetNamespace()
+	httpRouteName := httpRoute.GetName()
+	client, err := manager.getHttpRoutesClient(namespace)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the HTTPRoutes client for namespace '%v'", namespace)
 	}
-	jobName := job.Name
 
-	if err := jobsClient.Delete(ctx, jobName, globalDeleteOptions); err != nil {
-		return stacktrace.Propagate(err, "Failed to delete job '%v' in namespace '%v' with delete options '%+v'", jobName, namespace, globalDeleteOptions)
+	if err := client.Delete(ctx, httpRouteName, globalDeleteOptions); err != nil {
+		return stacktrace.Propagate(err, "Failed to delete HTTPRoute '%s' with delete options '%+v' in namespace '%s'", httpRouteName, globalDeleteOptions, namespace)
 	}
 
 	return nil
 }
 
-func (manager KubernetesManager) GetJobCompletionAndSuccessFlags(ctx context.Context, namespace string, jobName string) (hasCompleted bool, isSuccess bool, resultErr error) {
-	job, err := manager.kubernetesClientSet.BatchV1().Jobs(namespace).Get(ctx, jobName, metav1.GetOptions{})
+// ---------------------------Stateful Sets------------------------------------------------------------------------------
+
+// CreateStatefulSet creates a one-replica StatefulSet running the pod, so that Kubernetes recreates the pod, attached to
+// the same persistent volume claims, when it gets evicted or its node goes away. It waits for the pod to be available
+// and returns it along with the StatefulSet
+func (manager *KubernetesManager) CreateStatefulSet(
+	ctx context.Context,
+	namespaceName string,
+	statefulSetName string,
+	kubernetesServiceName string,
+	podLabels map[string]string,
+	podAnnotations map[string]string,
+	initContainers []apiv1.Container,
+	podContainers []apiv1.Container,
+	podVolumes []apiv1.Volume,
+	podServiceAccountName string,
+	tolerations []apiv1.Toleration,
+	nodeSelectors map[string]string,
+) (*appsv1.StatefulSet, *apiv1.Pod, error) {
+	client := manager.kubernetesClientSet.AppsV1().StatefulSets(namespaceName)
+
+	// Pods of a StatefulSet are named after it and their ordinal, and a StatefulSet only accepts the 'Always' restart policy
+	podName := getStatefulSetPodName(statefulSetName)
+	podSpec := newPodSpec(initContainers, podContainers, podVolumes, podServiceAccountName, apiv1.RestartPolicyAlways, tolerations, nodeSelectors)
+	replicas := int32(statefulSetReplicas)
+
+	statefulSetToCreate := &appsv1.StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ObjectMeta: newPodObjectMeta(statefulSetName, podLabels, podAnnotations),
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels:      podLabels,
+				MatchExpressions: nil,
+			},
+			Template: apiv1.PodTemplateSpec{
+				ObjectMeta: newPodObjectMeta("", podLabels, podAnnotations),
+				Spec:       podSpec,
+			},
+			VolumeClaimTemplates: nil,
+			ServiceName:          kubernetesServiceName,
+			PodManagementPolicy:  "",
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          "",
+				RollingUpdate: nil,
+			},
+			RevisionHistoryLimit:                 nil,
+			MinReadySeconds:                      0,
+			PersistentVolumeClaimRetentionPolicy: nil,
+			Ordinals:                             nil,
+		},
+		// nolint: exhaustruct
+		Status: appsv1.StatefulSetStatus{},
+	}
+
+	if statefulSetDefinitionBytes, err := json.Marshal(statefulSetToCreate); err == nil {
+		logrus.Debugf("Going to start stateful set using the following JSON: %v", string(statefulSetDefinitionBytes))
+	}
+
+	// Same as when creating a pod, the pod of a previous run of the service may not be fully deleted yet
+	if err := manager.waitForPodDeletion(ctx, namespaceName, podName); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred waiting for pod '%v' to be completely removed", podName)
+	}
+
+	createdStatefulSet, err := client.Create(ctx, statefulSetToCreate, globalCreateOptions)
 	if err != nil {
-		return false, false, stacktrace.Propagate(err, "Failed to get job status for job name '%v' in namespace '%v'", jobName, namespace)
+		return nil, nil, stacktrace.Propagate(err, "Expected to be able to create stateful set with name '%v' and labels '%+v', instead a non-nil error was returned", statefulSetName, podLabels)
 	}
+	// Unlike a failed pod, a StatefulSet whose pod doesn't come up would keep recreating it
+	shouldRemoveStatefulSet := true
+	defer func() {
+		if !shouldRemoveStatefulSet {
+			return
+		}
+		if err := manager.RemoveStatefulSet(ctx, createdStatefulSet); err != nil {
+			logrus.Errorf("Creating stateful set '%v' didn't complete successfully so we tried to remove it but doing so threw an error:\n%v", statefulSetName, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to remove stateful set '%v' in '%v' manually!!!", statefulSetName, namespaceName)
+		}
+	}()
 
-	deletionTimestamp := job.GetObjectMeta().GetDeletionTimestamp()
-	if deletionTimestamp != nil {
-		return false, false, stacktrace.Propagate(err, "Job with name '%s' in namespace '%s' has been marked for deletion", job.GetName(), namespace)
+	if err := manager.waitForStatefulSetPodCreation(ctx, namespaceName, podName); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred waiting for stateful set '%v' to create pod '%v'", statefulSetName, podName)
+	}
+	if err := manager.waitForPodAvailability(ctx, namespaceName, podName); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred waiting for pod '%v' to become available", podName)
+	}
+	createdPod, err := manager.GetPod(ctx, namespaceName, podName)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting pod '%v' of stateful set '%v'", podName, statefulSetName)
 	}
 
-	// LOGIC FROM https://stackoverflow.com/a/69262406
+	shouldRemoveStatefulSet = false
+	return createdStatefulSet, createdPod, nil
+}
+
+func (manager *KubernetesManager) GetStatefulSetsByLabels(ctx context.Context, namespace string, statefulSetLabels map[string]string) (*appsv1.StatefulSetList, error) {
+	client := manager.kubernetesClientSet.AppsV1().StatefulSets(namespace)
 
-	// Job hasn't spun up yet
-	if job.Status.Active == 0 && job.Status.Succeeded == 0 && job.Status.Failed == 0 {
-		return false, false, nil
+	opts := buildListOptionsFromLabels(statefulSetLabels)
+	statefulSets, err := client.List(ctx, opts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get stateful sets with labels '%+v', instead a non-nil error was returned", statefulSetLabels)
 	}
 
-	// Job is active
-	if job.Status.Active > 0 {
-		return false, false, nil
+	// Only return objects not tombstoned by Kubernetes
+	var statefulSetsNotMarkedForDeletionList []appsv1.StatefulSet
+	for _, statefulSet := range statefulSets.Items {
+		deletionTimestamp := statefulSet.GetObjectMeta().GetDeletionTimestamp()
+		if deletionTimestamp == nil {
+			statefulSetsNotMarkedForDeletionList = append(statefulSetsNotMarkedForDeletionList, statefulSet)
+		}
+	}
+	return &appsv1.StatefulSetList{
+		Items:    statefulSetsNotMarkedForDeletionList,
+		TypeMeta: statefulSets.TypeMeta,
+		ListMeta: statefulSets.ListMeta,
+	}, nil
+}
+
+// RemoveStatefulSet removes the StatefulSet and waits for its pod to terminate; removing the pod alone would only get it
+// recreated
+func (manager *KubernetesManager) RemoveStatefulSet(ctx context.Context, statefulSet *appsv1.StatefulSet) error {
+	name := statefulSet.Name
+	namespace := statefulSet.Namespace
+	client := manager.kubernetesClientSet.AppsV1().StatefulSets(namespace)
+
+	if err := client.Delete(ctx, name, globalDeleteOptions); err != nil {
+		return stacktrace.Propagate(err, "Failed to delete stateful set with name '%s' with delete options '%+v'", name, globalDeleteOptions)
 	}
 
-	// Job succeeded
-	if job.Status.Succeeded > 0 {
-		return true, true, nil // Job ran successfully
+	podName := getStatefulSetPodName(name)
+	if err := manager.waitForPodTermination(ctx, namespace, podName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred waiting for pod '%v' of stateful set '%v' to terminate", podName, name)
 	}
 
-	return true, false, nil
+	return nil
+}
+
+// ---------------------------Jobs------------------------------------------------------------------------------
+
+// CreateJob creates a job running its pod once: the pod isn't restarted when its containers exit, and the job doesn't
+// retry it if it fails. It doesn't wait for the pod to run; see GetJobPod
+func (manager *KubernetesManager) CreateJob(
+	ctx context.Context,
+	namespaceName string,
+	jobName string,
+	jobLabels map[string]string,
+	jobAnnotations map[string]string,
+	initContainers []apiv1.Container,
+	podContainers []apiv1.Container,
+	podVolumes []apiv1.Volume,
+	podServiceAccountName string,
+	tolerations []apiv1.Toleration,
+	nodeSelectors map[string]string,
+) (*batchv1.Job, error) {
+	client := manager.kubernetesClientSet.BatchV1().Jobs(namespaceName)
+
+	podSpec := newPodSpec(initContainers, podContainers, podVolumes, podServiceAccountName, apiv1.RestartPolicyNever, tolerations, nodeSelectors)
+	backoffLimit := int32(jobBackoffLimit)
+
+	// nolint: exhaustruct
+	jobToCreate := &batchv1.Job{
+		ObjectMeta: newPodObjectMeta(jobName, jobLabels, jobAnnotations),
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: apiv1.PodTemplateSpec{
+				ObjectMeta: newPodObjectMeta("", jobLabels, jobAnnotations),
+				Spec:       podSpec,
+			},
+		},
+	}
+
+	if jobDefinitionBytes, err := json.Marshal(jobToCreate); err == nil {
+		logrus.Debugf("Going to start job using the following JSON: %v", string(jobDefinitionBytes))
+	}
+
+	createdJob, err := client.Create(ctx, jobToCreate, globalCreateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to create job with name '%v' and labels '%+v', instead a non-nil error was returned", jobName, jobLabels)
+	}
+	return createdJob, nil
+}
+
+// GetJobPod returns the pod the job created, or nil if it hasn't created it yet
+func (manager *KubernetesManager) GetJobPod(ctx context.Context, namespaceName string, jobName string) (*apiv1.Pod, error) {
+	pods, err := manager.GetPodsByLabels(ctx, namespaceName, map[string]string{batchv1.JobNameLabel: jobName})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the pods of job '%v'", jobName)
+	}
+	if len(pods.Items) == 0 {
+		return nil, nil
+	}
+	if len(pods.Items) > 1 {
+		return nil, stacktrace.NewError("Expected job '%v' to create a single pod but it created '%d'", jobName, len(pods.Items))
+	}
+	return &pods.Items[0], nil
+}
+
+// RemoveJob removes the job along with its pod
+func (manager *KubernetesManager) RemoveJob(ctx context.Context, job *batchv1.Job) error {
+	name := job.Name
+	namespace := job.Namespace
+	client := manager.kubernetesClientSet.BatchV1().Jobs(namespace)
+
+	if err := client.Delete(ctx, name, globalDeleteOptions); err != nil {
+		return stacktrace.Propagate(err, "Failed to delete job with name '%s' with delete options '%+v'", name, globalDeleteOptions)
+	}
+	return nil
 }
-*/
 
 // ====================================================================================================
 //                                     Private Helper Methods
@@ -2071,6 +2564,47 @@ func renderContainerStatuses(containerStatuses []apiv1.ContainerStatus, prefixSt
 
 // Kubernetes doesn't seem to have a nice API for getting back the exit code of a command (though this seems strange??),
 // so we have to parse it out of a status message
+// terminalSizeQueue feeds the TTY sizes of an interactive exec to the Kubernetes executor
+type terminalSizeQueue struct {
+	ctx context.Context
+
+	// Size returned by the next call before reading from terminalSizes, used for the initial size of the TTY
+	nextTerminalSize *interactive_exec.TerminalSize
+
+	terminalSizes <-chan *interactive_exec.TerminalSize
+}
+
+// Next blocks until the next size is available, and returns nil once no more sizes will come
+func (queue *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	if size := queue.nextTerminalSize; size != nil {
+		queue.nextTerminalSize = nil
+		return &remotecommand.TerminalSize{
+			Width:  size.GetWidth(),
+			Height: size.GetHeight(),
+		}
+	}
+	select {
+	case <-queue.ctx.Done():
+		return nil
+	case size, isChanOpen := <-queue.terminalSizes:
+		if !isChanOpen {
+			return nil
+		}
+		return &remotecommand.TerminalSize{
+			Width:  size.GetWidth(),
+			Height: size.GetHeight(),
+		}
+	}
+}
+
+func (manager *KubernetesManager) getHttpRoutesClient(namespace string) (dynamic.ResourceInterface, error) {
+	dynamicClient, err := dynamic.NewForConfig(manager.kuberneteRestConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes dynamic client")
+	}
+	return dynamicClient.Resource(httpRouteGroupVersionResource).Namespace(namespace), nil
+}
+
 func getExitCodeFromStatusMessage(statusMessage string) (int32, error) {
 	messageSlice := strings.Split(statusMessage, " ")
 	if len(messageSlice) != expectedStatusMessageSliceSize {
@@ -2117,3 +2651,95 @@ func buildListOptionsFromLabels(labelsMap map[string]string) metav1.ListOptions
 		SendInitialEvents:    nil,
 	}
 }
+
+func newPodObjectMeta(podName string, podLabels map[string]string, podAnnotations map[string]string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:            podName,
+		GenerateName:    "",
+		Namespace:       "",
+		SelfLink:        "",
+		UID:             "",
+		ResourceVersion: "",
+		Generation:      0,
+		CreationTimestamp: metav1.Time{
+			Time: time.Time{},
+		},
+		DeletionTimestamp:          nil,
+		DeletionGracePeriodSeconds: nil,
+		Labels:                     podLabels,
+		Annotations:                podAnnotations,
+		OwnerReferences:            nil,
+		Finalizers:                 nil,
+		ManagedFields:              nil,
+	}
+}
+
+func newPodSpec(
+	initContainers []apiv1.Container,
+	podContainers []apiv1.Container,
+	podVolumes []apiv1.Volume,
+	podServiceAccountName string,
+	restartPolicy apiv1.RestartPolicy,
+	tolerations []apiv1.Toleration,
+	nodeSelectors map[string]string,
+) apiv1.PodSpec {
+	return apiv1.PodSpec{
+		Volumes:                       podVolumes,
+		InitContainers:                initContainers,
+		Containers:                    podContainers,
+		EphemeralContainers:           nil,
+		RestartPolicy:                 restartPolicy,
+		TerminationGracePeriodSeconds: nil,
+		ActiveDeadlineSeconds:         nil,
+		DNSPolicy:                     "",
+		NodeSelector:                  nodeSelectors,
+		ServiceAccountName:            podServiceAccountName,
+		DeprecatedServiceAccount:      "",
+		AutomountServiceAccountToken:  nil,
+		NodeName:                      "",
+		HostNetwork:                   false,
+		HostPID:                       false,
+		HostIPC:                       false,
+		ShareProcessNamespace:         nil,
+		SecurityContext:               nil,
+		// TODO add support for ImageRegistrySpec to Kubernetes by adding the right secret here
+		// You will have to first publish the secret using the Kubernetes API
+		ImagePullSecrets:          nil,
+		Hostname:                  "",
+		Subdomain:                 "",
+		Affinity:                  nil,
+		SchedulerName:             "",
+		Tolerations:               tolerations,
+		HostAliases:               nil,
+		PriorityClassName:         "",
+		Priority:                  nil,
+		DNSConfig:                 nil,
+		ReadinessGates:            nil,
+		RuntimeClassName:          nil,
+		EnableServiceLinks:        nil,
+		PreemptionPolicy:          nil,
+		Overhead:                  nil,
+		TopologySpreadConstraints: nil,
+		SetHostnameAsFQDN:         nil,
+		OS:                        nil,
+		HostUsers:                 nil,
+		SchedulingGates:           nil,
+		ResourceClaims:            nil,
+	}
+}
+
+// waitForStatefulSetPodCreation waits for the StatefulSet controller to create the pod, which happens asynchronously
+func (manager *KubernetesManager) waitForStatefulSetPodCreation(ctx context.Context, namespaceName string, podName string) error {
+	deadline := time.Now().Add(podWaitForAvailabilityTimeout)
+	for time.Now().Before(deadline) {
+		if _, err := manager.GetPod(ctx, namespaceName, podName); err == nil {
+			return nil
+		}
+		time.Sleep(podWaitForAvailabilityTimeBetweenPolls)
+	}
+	return stacktrace.NewError("Pod '%v' wasn't created after %v", podName, podWaitForAvailabilityTimeout)
+}
+
+func getStatefulSetPodName(statefulSetName string) string {
+	return fmt.Sprintf("%v-%v", statefulSetName, statefulSetPodOrdinal)
+}
@@ -0,0 +1,39 @@
+package kubernetes_manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	testDefaultStorageClass = "standard"
+	testVolumeClaimSize     = 1024 * 1024
+)
+
+func TestCheckPersistentVolumeClaimSpec(t *testing.T) {
+	manager := NewKubernetesManager(nil, nil, testDefaultStorageClass)
+	storageClass := testDefaultStorageClass
+	//nolint:exhaustruct
+	volumeClaim := &apiv1.PersistentVolumeClaim{
+		Spec: apiv1.PersistentVolumeClaimSpec{
+			AccessModes: []apiv1.PersistentVolumeAccessMode{apiv1.ReadWriteOnce},
+			Resources: apiv1.ResourceRequirements{
+				Requests: apiv1.ResourceList{
+					apiv1.ResourceStorage: *resource.NewQuantity(testVolumeClaimSize, resource.BinarySI),
+				},
+			},
+			StorageClassName: &storageClass,
+		},
+	}
+
+	// the defaults of the cluster are what the claim was created with
+	require.NoError(t, manager.CheckPersistentVolumeClaimSpec(volumeClaim, testVolumeClaimSize, "", ""))
+	require.NoError(t, manager.CheckPersistentVolumeClaimSpec(volumeClaim, testVolumeClaimSize, testDefaultStorageClass, apiv1.ReadWriteOnce))
+
+	require.Error(t, manager.CheckPersistentVolumeClaimSpec(volumeClaim, testVolumeClaimSize, "premium", ""))
+	require.Error(t, manager.CheckPersistentVolumeClaimSpec(volumeClaim, testVolumeClaimSize, "", apiv1.ReadWriteMany))
+	require.Error(t, manager.CheckPersistentVolumeClaimSpec(volumeClaim, 2*testVolumeClaimSize, "", ""))
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
 	"github.com/kurtosis-tech/stacktrace"
+	appsv1 "k8s.io/api/apps/v1"
 	apiv1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func CollectMatchingServiceAccounts(
@@ -134,6 +136,42 @@ func CollectMatchingIngresses(
 	return postFilterKubernetesResources(getListOfPointersFromListOfElements(objects.Items), postFilterLabelKey, postFilterLabelValues)
 }
 
+func CollectMatchingHttpRoutes(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+	namespace string,
+	searchLabels map[string]string,
+	postFilterLabelKey string,
+	postFilterLabelValues map[string]bool,
+) (
+	map[string][]*unstructured.Unstructured,
+	error,
+) {
+	objects, err := kubernetesManager.GetHttpRoutesByLabels(ctx, namespace, searchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting Kubernetes resources matching labels: %+v", searchLabels)
+	}
+	return postFilterKubernetesResources(getListOfPointersFromListOfElements(objects.Items), postFilterLabelKey, postFilterLabelValues)
+}
+
+func CollectMatchingStatefulSets(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+	namespace string,
+	searchLabels map[string]string,
+	postFilterLabelKey string,
+	postFilterLabelValues map[string]bool,
+) (
+	map[string][]*appsv1.StatefulSet,
+	error,
+) {
+	objects, err := kubernetesManager.GetStatefulSetsByLabels(ctx, namespace, searchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting Kubernetes resources matching labels: %+v", searchLabels)
+	}
+	return postFilterKubernetesResources(getListOfPointersFromListOfElements(objects.Items), postFilterLabelKey, postFilterLabelValues)
+}
+
 func CollectMatchingClusterRoles(
 	ctx context.Context,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
@@ -3,6 +3,7 @@ package object_attributes_provider
 import (
 	"crypto/md5"
 	"encoding/hex"
+	"encoding/json"
 	"time"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key"
@@ -25,13 +26,24 @@ const (
 
 	enclaveDataDirFragment = "enclave-data-dir"
 
+	// Used as the name of both the ResourceQuota and the LimitRange enforcing the enclave resource quota
+	enclaveResourceQuotaFragment = "enclave-resource-quota"
+
 	traefikIngressRouterEntrypointsValue = "web"
 )
 
 type KubernetesEnclaveObjectAttributesProvider interface {
-	ForEnclaveNamespace(creationTime time.Time, enclaveName string) (KubernetesObjectAttributes, error)
+	ForEnclaveNamespace(
+		creationTime time.Time,
+		enclaveName string,
+		enclaveLabels map[string]string,
+		expiration *enclave.EnclaveExpiration,
+		resourceQuota *enclave.EnclaveResourceQuota,
+		owner string,
+	) (KubernetesObjectAttributes, error)
 	ForApiContainer() KubernetesApiContainerObjectAttributesProvider
 	ForEnclaveDataDirVolume() (KubernetesObjectAttributes, error)
+	ForEnclaveResourceQuota() (KubernetesObjectAttributes, error)
 	ForUserServiceService(
 		uuid service.ServiceUUID,
 		id service.ServiceName,
@@ -42,6 +54,11 @@ type KubernetesEnclaveObjectAttributesProvider interface {
 		privatePorts map[string]*port_spec.PortSpec,
 		userLabels map[string]string,
 	) (KubernetesObjectAttributes, error)
+	ForUserTaskJob(
+		taskUuid service.ServiceUUID,
+		taskName service.ServiceName,
+		userLabels map[string]string,
+	) (KubernetesObjectAttributes, error)
 	ForSinglePersistentDirectoryVolume(
 		persistentKey service_directory.DirectoryPersistentKey,
 	) (KubernetesObjectAttributes, error)
@@ -70,7 +87,14 @@ func GetKubernetesEnclaveObjectAttributesProvider(enclaveId enclave.EnclaveUUID)
 	return newKubernetesEnclaveObjectAttributesProviderImpl(enclaveId)
 }
 
-func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespace(creationTime time.Time, enclaveName string) (KubernetesObjectAttributes, error) {
+func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespace(
+	creationTime time.Time,
+	enclaveName string,
+	enclaveLabels map[string]string,
+	expiration *enclave.EnclaveExpiration,
+	resourceQuota *enclave.EnclaveResourceQuota,
+	owner string,
+) (KubernetesObjectAttributes, error) {
 	// TODO: might need to revert this if we have multiple users on the same cluster (what if two people create enclaves with name test?)
 	name, err := getCompositeKubernetesObjectName([]string{
 		namespacePrefix,
@@ -106,6 +130,53 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespa
 		kubernetes_annotation_key_consts.EnclaveNameAnnotationKey:         enclaveNameAnnotationValue,
 	}
 
+	if len(enclaveLabels) > 0 {
+		serializedEnclaveLabels, err := json.Marshal(enclaveLabels)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred serializing enclave labels '%+v'", enclaveLabels)
+		}
+		enclaveLabelsAnnotationValue, err := kubernetes_annotation_value.CreateNewKubernetesAnnotationValue(string(serializedEnclaveLabels))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating Kubernetes annotation value from string '%v'", string(serializedEnclaveLabels))
+		}
+		customAnnotations[kubernetes_annotation_key_consts.EnclaveLabelsAnnotationKey] = enclaveLabelsAnnotationValue
+	}
+
+	if expiration != nil {
+		expirationTimeStr := expiration.GetExpirationTime().Format(time.RFC3339)
+		expirationTimeAnnotationValue, err := kubernetes_annotation_value.CreateNewKubernetesAnnotationValue(expirationTimeStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating Kubernetes annotation value from string '%v'", expirationTimeStr)
+		}
+		expiryActionStr := string(expiration.GetAction())
+		expiryActionAnnotationValue, err := kubernetes_annotation_value.CreateNewKubernetesAnnotationValue(expiryActionStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating Kubernetes annotation value from string '%v'", expiryActionStr)
+		}
+		customAnnotations[kubernetes_annotation_key_consts.EnclaveExpirationTimeAnnotationKey] = expirationTimeAnnotationValue
+		customAnnotations[kubernetes_annotation_key_consts.EnclaveExpiryActionAnnotationKey] = expiryActionAnnotationValue
+	}
+
+	if resourceQuota != nil {
+		serializedResourceQuota, err := json.Marshal(resourceQuota)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred serializing enclave resource quota '%+v'", resourceQuota)
+		}
+		resourceQuotaAnnotationValue, err := kubernetes_annotation_value.CreateNewKubernetesAnnotationValue(string(serializedResourceQuota))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating Kubernetes annotation value from string '%v'", string(serializedResourceQuota))
+		}
+		customAnnotations[kubernetes_annotation_key_consts.EnclaveResourceQuotaAnnotationKey] = resourceQuotaAnnotationValue
+	}
+
+	if owner != "" {
+		ownerAnnotationValue, err := kubernetes_annotation_value.CreateNewKubernetesAnnotationValue(owner)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating Kubernetes annotation value from string '%v'", owner)
+		}
+		customAnnotations[kubernetes_annotation_key_consts.EnclaveOwnerAnnotationKey] = ownerAnnotationValue
+	}
+
 	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, customAnnotations)
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -207,6 +278,53 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForUserServicePod
 	return objectAttributes, nil
 }
 
+// ForUserTaskJob labels the job of a task, and its pod, with their own resource type so that they're never mistaken for
+// the pod of a service
+func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForUserTaskJob(
+	taskUuid service.ServiceUUID,
+	taskName service.ServiceName,
+	userLabels map[string]string,
+) (KubernetesObjectAttributes, error) {
+	name, err := getKubernetesObjectName(taskName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get name for user task job")
+	}
+
+	labels, err := provider.getLabelsForEnclaveObjectWithIDAndGUID(string(taskName), string(taskUuid))
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"Failed to get labels for user task job with name '%s' and UUID '%s'",
+			taskName,
+			taskUuid,
+		)
+	}
+	labels[kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey] = label_value_consts.UserTaskKurtosisResourceTypeKubernetesLabelValue
+
+	// add user custom label
+	for userLabelKey, userLabelValue := range userLabels {
+		kubernetesLabelKey, err := kubernetes_label_key.CreateNewKubernetesUserCustomLabelKey(userLabelKey)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Kubernetes label key '%s'", userLabelKey)
+		}
+		kubernetesLabelValue, err := kubernetes_label_value.CreateNewKubernetesLabelValue(userLabelValue)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Kubernetes label value '%s'", userLabelValue)
+		}
+		labels[kubernetesLabelKey] = kubernetesLabelValue
+	}
+
+	// No user task job annotations
+	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create user task job object attributes")
+	}
+
+	return objectAttributes, nil
+}
+
 func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveDataDirVolume() (KubernetesObjectAttributes, error) {
 	name, err := getCompositeKubernetesObjectName([]string{
 		enclaveDataDirFragment,
@@ -241,6 +359,33 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveDataDir
 	return objectAttributes, nil
 }
 
+func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveResourceQuota() (KubernetesObjectAttributes, error) {
+	name, err := getCompositeKubernetesObjectName([]string{
+		enclaveResourceQuotaFragment,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a name object for the resource quota of enclave '%v'", provider.enclaveId)
+	}
+
+	labels, err := provider.getLabelsForEnclaveObjectWithIDAndGUID(
+		provider.enclaveId,
+		provider.enclaveId,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get labels for enclave resource quota using ID '%v'", provider.enclaveId)
+	}
+
+	//No enclave resource quota annotations.
+	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create enclave resource quota object attributes")
+	}
+
+	return objectAttributes, nil
+}
+
 func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForSinglePersistentDirectoryVolume(persistentKey service_directory.DirectoryPersistentKey) (KubernetesObjectAttributes, error) {
 	hasher := md5.New()
 	hasher.Write([]byte(provider.enclaveId))
@@ -16,6 +16,22 @@ const (
 
 	enclaveNameKeyStr = labelKeyPrefixStr + "enclave-name"
 
+	// JSON-serialized map of the labels the user attached to the enclave
+	enclaveLabelsKeyStr = labelKeyPrefixStr + "enclave-labels"
+
+	enclaveExpirationTimeKeyStr = labelKeyPrefixStr + "enclave-expiration-time"
+
+	enclaveExpiryActionKeyStr = labelKeyPrefixStr + "enclave-expiry-action"
+
+	// JSON-serialized resource quota of the enclave
+	enclaveResourceQuotaKeyStr = labelKeyPrefixStr + "enclave-resource-quota"
+
+	// Authenticated principal that created the enclave
+	enclaveOwnerKeyStr = labelKeyPrefixStr + "enclave-owner"
+
+	// JSON-serialized map of the URLs where the HTTP ports of a user service are exposed outside the cluster
+	publicUrlsAnnotationKeyStr = labelKeyPrefixStr + "public-urls"
+
 	// Traefik ingress router
 	traefikKeyIngressRouterPrefixStr = "traefik.ingress.kubernetes.io/router."
 	traefikKeyEntrypointsStr         = traefikKeyIngressRouterPrefixStr + "entrypoints"
@@ -27,4 +43,10 @@ const (
 var PortSpecsKubernetesAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(portSpecsAnnotationKeyStr)
 var EnclaveCreationTimeAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveCreationTimeKeyStr)
 var EnclaveNameAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveNameKeyStr)
+var EnclaveLabelsAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveLabelsKeyStr)
+var EnclaveExpirationTimeAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveExpirationTimeKeyStr)
+var EnclaveExpiryActionAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveExpiryActionKeyStr)
+var EnclaveResourceQuotaAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveResourceQuotaKeyStr)
+var EnclaveOwnerAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveOwnerKeyStr)
+var PublicUrlsKubernetesAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(publicUrlsAnnotationKeyStr)
 var TraefikIngressRouterEntrypointsAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(traefikKeyEntrypointsStr)
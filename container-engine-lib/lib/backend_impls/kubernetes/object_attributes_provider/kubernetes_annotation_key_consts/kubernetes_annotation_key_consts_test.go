@@ -8,17 +8,29 @@ import (
 )
 
 var labelKeyStrsToEnsure = map[string]string{
-	labelKeyPrefixStr:         "kurtosistech.com/",
-	portSpecsAnnotationKeyStr: "kurtosistech.com/ports",
-	enclaveCreationTimeKeyStr: "kurtosistech.com/enclave-creation-time",
-	enclaveNameKeyStr:         "kurtosistech.com/enclave-name",
-	traefikKeyEntrypointsStr:  "traefik.ingress.kubernetes.io/router.entrypoints",
+	labelKeyPrefixStr:           "kurtosistech.com/",
+	portSpecsAnnotationKeyStr:   "kurtosistech.com/ports",
+	enclaveCreationTimeKeyStr:   "kurtosistech.com/enclave-creation-time",
+	enclaveNameKeyStr:           "kurtosistech.com/enclave-name",
+	enclaveLabelsKeyStr:         "kurtosistech.com/enclave-labels",
+	enclaveExpirationTimeKeyStr: "kurtosistech.com/enclave-expiration-time",
+	enclaveExpiryActionKeyStr:   "kurtosistech.com/enclave-expiry-action",
+	enclaveResourceQuotaKeyStr:  "kurtosistech.com/enclave-resource-quota",
+	enclaveOwnerKeyStr:          "kurtosistech.com/enclave-owner",
+	publicUrlsAnnotationKeyStr:  "kurtosistech.com/public-urls",
+	traefikKeyEntrypointsStr:    "traefik.ingress.kubernetes.io/router.entrypoints",
 }
 
 var labelKeysToEnsure = map[*kubernetes_annotation_key.KubernetesAnnotationKey]string{
 	PortSpecsKubernetesAnnotationKey:             "kurtosistech.com/ports",
 	EnclaveCreationTimeAnnotationKey:             "kurtosistech.com/enclave-creation-time",
 	EnclaveNameAnnotationKey:                     "kurtosistech.com/enclave-name",
+	EnclaveLabelsAnnotationKey:                   "kurtosistech.com/enclave-labels",
+	EnclaveExpirationTimeAnnotationKey:           "kurtosistech.com/enclave-expiration-time",
+	EnclaveExpiryActionAnnotationKey:             "kurtosistech.com/enclave-expiry-action",
+	EnclaveResourceQuotaAnnotationKey:            "kurtosistech.com/enclave-resource-quota",
+	EnclaveOwnerAnnotationKey:                    "kurtosistech.com/enclave-owner",
+	PublicUrlsKubernetesAnnotationKey:            "kurtosistech.com/public-urls",
 	TraefikIngressRouterEntrypointsAnnotationKey: "traefik.ingress.kubernetes.io/router.entrypoints",
 }
 
@@ -0,0 +1,181 @@
+package service_exposure
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+type ServiceExposureType string
+
+const (
+	// One Ingress per service, with a rule per HTTP port
+	ServiceExposureType_Ingress ServiceExposureType = "ingress"
+	// One Gateway API HTTPRoute per HTTP port, attached to an existing Gateway
+	ServiceExposureType_HttpRoute ServiceExposureType = "http-route"
+
+	httpUrlScheme  = "http"
+	httpsUrlScheme = "https"
+
+	hostnameTemplateName = "hostname"
+)
+
+var AllServiceExposureTypes = []ServiceExposureType{
+	ServiceExposureType_Ingress,
+	ServiceExposureType_HttpRoute,
+}
+
+// HostnameTemplateData is what the hostname template of a ServiceExposureConfig gets rendered with, e.g.
+// "{{.PortId}}-{{.ServiceName}}-{{.EnclaveShortUuid}}.kurtosis.example.com"
+type HostnameTemplateData struct {
+	PortId           string
+	PortNumber       uint16
+	ServiceName      string
+	ServiceShortUuid string
+	EnclaveShortUuid string
+}
+
+// ServiceExposureConfig describes how the Kubernetes backend exposes the HTTP ports of the user services outside the
+// cluster, and the URLs that it reports for them
+type ServiceExposureConfig struct {
+	exposureType ServiceExposureType
+
+	hostnameTemplate *template.Template
+
+	// Whether the reported URLs use HTTPS, which is the case when TLS is terminated in front of the Ingress or Gateway
+	isHttps bool
+
+	// Only used by Ingress exposure, empty means that the cluster default ingress class is used
+	ingressClassName string
+
+	// Only used by HTTPRoute exposure, the Gateway the routes attach to. An empty namespace means that the Gateway is in
+	// the namespace of the enclave
+	gatewayName      string
+	gatewayNamespace string
+}
+
+func NewServiceExposureConfig(
+	exposureType ServiceExposureType,
+	hostnameTemplateStr string,
+	isHttps bool,
+	ingressClassName string,
+	gatewayName string,
+	gatewayNamespace string,
+) (*ServiceExposureConfig, error) {
+	if !isValidServiceExposureType(exposureType) {
+		return nil, stacktrace.NewError("Invalid service exposure type '%v', valid types are: %v", exposureType, AllServiceExposureTypes)
+	}
+	if hostnameTemplateStr == "" {
+		return nil, stacktrace.NewError("A hostname template is required to expose the services")
+	}
+	hostnameTemplate, err := template.New(hostnameTemplateName).Option("missingkey=error").Parse(hostnameTemplateStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing hostname template '%v'", hostnameTemplateStr)
+	}
+	if exposureType == ServiceExposureType_HttpRoute && gatewayName == "" {
+		return nil, stacktrace.NewError("A gateway name is required to expose the services with HTTPRoutes")
+	}
+	if exposureType != ServiceExposureType_HttpRoute && (gatewayName != "" || gatewayNamespace != "") {
+		return nil, stacktrace.NewError("A gateway can only be set when exposing the services with HTTPRoutes")
+	}
+	if exposureType != ServiceExposureType_Ingress && ingressClassName != "" {
+		return nil, stacktrace.NewError("An ingress class can only be set when exposing the services with Ingresses")
+	}
+
+	config := &ServiceExposureConfig{
+		exposureType:     exposureType,
+		hostnameTemplate: hostnameTemplate,
+		isHttps:          isHttps,
+		ingressClassName: ingressClassName,
+		gatewayName:      gatewayName,
+		gatewayNamespace: gatewayNamespace,
+	}
+
+	// Render the template once so that a broken template fails when the backend starts rather than when a service does
+	exampleData := HostnameTemplateData{
+		PortId:           "http",
+		PortNumber:       80, //nolint:gomnd
+		ServiceName:      "service",
+		ServiceShortUuid: "a1b2c3d4e5f6",
+		EnclaveShortUuid: "f6e5d4c3b2a1",
+	}
+	if _, err := config.renderHostname(exampleData); err != nil {
+		return nil, stacktrace.Propagate(err, "Hostname template '%v' doesn't render to a valid hostname", hostnameTemplateStr)
+	}
+	return config, nil
+}
+
+func (config *ServiceExposureConfig) GetType() ServiceExposureType {
+	return config.exposureType
+}
+
+func (config *ServiceExposureConfig) GetIngressClassName() string {
+	return config.ingressClassName
+}
+
+func (config *ServiceExposureConfig) GetGatewayName() string {
+	return config.gatewayName
+}
+
+func (config *ServiceExposureConfig) GetGatewayNamespace() string {
+	return config.gatewayNamespace
+}
+
+// GetHostname returns the hostname that routes to the port of the service
+func (config *ServiceExposureConfig) GetHostname(
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	serviceName service.ServiceName,
+	portId string,
+	portNumber uint16,
+) (string, error) {
+	data := HostnameTemplateData{
+		PortId:           portId,
+		PortNumber:       portNumber,
+		ServiceName:      string(serviceName),
+		ServiceShortUuid: uuid_generator.ShortenedUUIDString(string(serviceUuid)),
+		EnclaveShortUuid: uuid_generator.ShortenedUUIDString(string(enclaveUuid)),
+	}
+	hostname, err := config.renderHostname(data)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred rendering the hostname of port '%v' of service '%v'", portId, serviceName)
+	}
+	return hostname, nil
+}
+
+// GetUrl returns the URL where the hostname is reachable from outside the cluster
+func (config *ServiceExposureConfig) GetUrl(hostname string) string {
+	urlScheme := httpUrlScheme
+	if config.isHttps {
+		urlScheme = httpsUrlScheme
+	}
+	return fmt.Sprintf("%v://%v", urlScheme, hostname)
+}
+
+func (config *ServiceExposureConfig) renderHostname(data HostnameTemplateData) (string, error) {
+	hostnameBuffer := &bytes.Buffer{}
+	if err := config.hostnameTemplate.Execute(hostnameBuffer, data); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred executing the hostname template")
+	}
+	hostname := hostnameBuffer.String()
+	if validationErrs := validation.IsDNS1123Subdomain(hostname); len(validationErrs) > 0 {
+		return "", stacktrace.NewError("Hostname '%v' isn't a valid DNS name: %v", hostname, strings.Join(validationErrs, "; "))
+	}
+	return hostname, nil
+}
+
+func isValidServiceExposureType(exposureType ServiceExposureType) bool {
+	for _, validType := range AllServiceExposureTypes {
+		if exposureType == validType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package service_exposure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHostnameTemplate = "{{.PortId}}-{{.ServiceName}}-{{.EnclaveShortUuid}}.kurtosis.example.com"
+	testEnclaveUuid      = "65d2fb6d673249b8b4a91a2778854a5c"
+	testServiceUuid      = "3771c85af16a40a18201acf4b4b5ad28"
+)
+
+func TestGetHostnameAndUrl(t *testing.T) {
+	config, err := NewServiceExposureConfig(ServiceExposureType_Ingress, testHostnameTemplate, false, "nginx", "", "")
+	require.NoError(t, err)
+
+	hostname, err := config.GetHostname(testEnclaveUuid, testServiceUuid, "web", "http", 80)
+	require.NoError(t, err)
+	require.Equal(t, "http-web-65d2fb6d6732.kurtosis.example.com", hostname)
+	require.Equal(t, "http://http-web-65d2fb6d6732.kurtosis.example.com", config.GetUrl(hostname))
+}
+
+func TestGetUrl_Https(t *testing.T) {
+	config, err := NewServiceExposureConfig(ServiceExposureType_HttpRoute, "{{.PortNumber}}-{{.ServiceShortUuid}}.example.com", true, "", "kurtosis-gateway", "infra")
+	require.NoError(t, err)
+
+	hostname, err := config.GetHostname(testEnclaveUuid, testServiceUuid, "web", "http", 8080)
+	require.NoError(t, err)
+	require.Equal(t, "https://8080-3771c85af16a.example.com", config.GetUrl(hostname))
+}
+
+func TestGetHostname_InvalidHostname(t *testing.T) {
+	config, err := NewServiceExposureConfig(ServiceExposureType_Ingress, testHostnameTemplate, false, "", "", "")
+	require.NoError(t, err)
+
+	_, err = config.GetHostname(testEnclaveUuid, testServiceUuid, "web", "HTTP_PORT", 80)
+	require.Error(t, err)
+}
+
+func TestNewServiceExposureConfig_InvalidConfigs(t *testing.T) {
+	_, err := NewServiceExposureConfig("load-balancer", testHostnameTemplate, false, "", "", "")
+	require.Error(t, err)
+
+	_, err = NewServiceExposureConfig(ServiceExposureType_Ingress, "", false, "", "", "")
+	require.Error(t, err)
+
+	_, err = NewServiceExposureConfig(ServiceExposureType_Ingress, "{{.Unknown}}.example.com", false, "", "", "")
+	require.Error(t, err)
+
+	_, err = NewServiceExposureConfig(ServiceExposureType_Ingress, "{{.PortId", false, "", "", "")
+	require.Error(t, err)
+
+	_, err = NewServiceExposureConfig(ServiceExposureType_HttpRoute, testHostnameTemplate, false, "", "", "")
+	require.Error(t, err)
+
+	_, err = NewServiceExposureConfig(ServiceExposureType_Ingress, testHostnameTemplate, false, "", "kurtosis-gateway", "")
+	require.Error(t, err)
+
+	_, err = NewServiceExposureConfig(ServiceExposureType_HttpRoute, testHostnameTemplate, false, "nginx", "kurtosis-gateway", "")
+	require.Error(t, err)
+}
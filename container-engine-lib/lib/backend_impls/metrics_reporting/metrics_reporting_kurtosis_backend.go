@@ -16,6 +16,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
@@ -119,8 +120,16 @@ func (backend *MetricsReportingKurtosisBackend) DumpKurtosis(ctx context.Context
 	return nil
 }
 
-func (backend *MetricsReportingKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
-	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName)
+func (backend *MetricsReportingKurtosisBackend) CreateEnclave(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveName string,
+	enclaveLabels map[string]string,
+	expiration *enclave.EnclaveExpiration,
+	resourceQuota *enclave.EnclaveResourceQuota,
+	owner string,
+) (*enclave.Enclave, error) {
+	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName, enclaveLabels, expiration, resourceQuota, owner)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with UUID '%v'", enclaveUuid)
 	}
@@ -155,6 +164,17 @@ func (backend *MetricsReportingKurtosisBackend) UpdateEnclave(
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) UpdateEnclaveExpiration(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	expiration *enclave.EnclaveExpiration,
+) error {
+	if err := backend.underlying.UpdateEnclaveExpiration(ctx, enclaveUuid, expiration); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the expiration of enclave with UUID '%v' to '%+v'", enclaveUuid, expiration)
+	}
+	return nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) StopEnclaves(
 	ctx context.Context,
 	filters *enclave.EnclaveFilters,
@@ -349,6 +369,20 @@ func (backend *MetricsReportingKurtosisBackend) RunUserServiceExecCommandWithStr
 	return backend.underlying.RunUserServiceExecCommandWithStreamedOutput(ctx, enclaveUuid, serviceUuid, cmd)
 }
 
+func (backend *MetricsReportingKurtosisBackend) RunUserServiceInteractiveExecCommand(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	cmd []string,
+	streams *interactive_exec.InteractiveExecStreams,
+) (int32, error) {
+	exitCode, err := backend.underlying.RunUserServiceInteractiveExecCommand(ctx, enclaveUuid, serviceUuid, cmd, streams)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred running interactive exec command '%v' on user service with UUID '%v' in enclave with UUID '%v'", cmd, serviceUuid, enclaveUuid)
+	}
+	return exitCode, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (resultErr error) {
 	err := backend.underlying.GetShellOnUserService(ctx, enclaveUuid, serviceUuid)
 	if err != nil {
@@ -376,6 +410,47 @@ func (backend *MetricsReportingKurtosisBackend) CopyFilesFromUserService(
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	destDirpath string,
+	input io.Reader,
+) error {
+	if err := backend.underlying.CopyFilesToUserService(ctx, enclaveUuid, serviceUuid, destDirpath, input); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying files to destination path '%v' in user service with UUID '%v' in enclave with UUID '%v'",
+			destDirpath,
+			serviceUuid,
+			enclaveUuid,
+		)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) RunUserTask(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	taskUuid service.ServiceUUID,
+	taskName service.ServiceName,
+	taskConfig *service.ServiceConfig,
+	filesToStore map[string]io.Writer,
+	shouldRemoveTaskInBackground bool,
+) (*exec_result.ExecResult, error) {
+	execResult, err := backend.underlying.RunUserTask(ctx, enclaveUuid, taskUuid, taskName, taskConfig, filesToStore, shouldRemoveTaskInBackground)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred running task '%v' with UUID '%v' in enclave with UUID '%v'",
+			taskName,
+			taskUuid,
+			enclaveUuid,
+		)
+	}
+	return execResult, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -15,6 +15,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
@@ -85,7 +86,18 @@ type KurtosisBackend interface {
 	DumpKurtosis(ctx context.Context, outputDirpath string) error
 
 	// Creates an enclave with the given enclave UUID
-	CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error)
+	// The labels are arbitrary user-defined key-values, and the expiration is nil if the enclave should never expire
+	// The resource quota is nil if the enclave isn't capped; backends that can enforce it natively will do so
+	// The owner is the authenticated principal creating the enclave, empty if the engine doesn't require authentication
+	CreateEnclave(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		enclaveName string,
+		enclaveLabels map[string]string,
+		expiration *enclave.EnclaveExpiration,
+		resourceQuota *enclave.EnclaveResourceQuota,
+		owner string,
+	) (*enclave.Enclave, error)
 
 	// Update an enclave by UUID, it's only possible to udpate the name and creation time so far
 	// The newCreationTime param is optional, it won't be updated if the value is nil
@@ -96,6 +108,13 @@ type KurtosisBackend interface {
 		creationTime *time.Time,
 	) error
 
+	// Replaces the expiration of an enclave created with a TTL, so that an extended expiration survives engine restarts
+	UpdateEnclaveExpiration(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		expiration *enclave.EnclaveExpiration,
+	) error
+
 	// Gets enclaves matching the given filters
 	GetEnclaves(
 		ctx context.Context,
@@ -295,6 +314,16 @@ type KurtosisBackend interface {
 		cmd []string,
 	) (execOutputChan chan string, finalExecResultChan chan *exec_result.ExecResult, resultErr error)
 
+	// Executes a command inside an user service with its standard streams attached to the given ones, optionally with a
+	// TTY, blocking until the command completes and returning its exit code
+	RunUserServiceInteractiveExecCommand(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		cmd []string,
+		streams *interactive_exec.InteractiveExecStreams,
+	) (exitCode int32, resultErr error)
+
 	// Get a connection with user service to execute commands in
 	GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (resultErr error)
 
@@ -307,6 +336,34 @@ type KurtosisBackend interface {
 		output io.Writer,
 	) error
 
+	// Copy files, packaged as a TAR read from the given input, into the given directory of the user service
+	// The directory is created if it doesn't exist, and existing files with the same paths are overwritten
+	CopyFilesToUserService(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		destDirpathOnService string,
+		input io.Reader,
+	) error
+
+	// RunUserTask runs a one-shot task built from the given config to completion and returns its exit code and logs, with
+	// its STDOUT and STDERR on their own as far as the backend can tell them apart.
+	// A task isn't a service: it isn't registered nor returned by GetUserServices, and its resources are removed once it
+	// exits. If it exits successfully, the files at each path of filesToStore are copied, packaged as a TAR, to the
+	// matching writer before it gets removed
+	RunUserTask(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		taskUuid service.ServiceUUID,
+		taskName service.ServiceName,
+		taskConfig *service.ServiceConfig,
+		filesToStore map[string]io.Writer,
+		shouldRemoveTaskInBackground bool,
+	) (
+		*exec_result.ExecResult,
+		error,
+	)
+
 	// StopUserServices stops the user containers for the services matching the given filters
 	StopUserServices(
 		ctx context.Context,
@@ -20,6 +20,8 @@ import (
 
 	image_registry_spec "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 
+	interactive_exec "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+
 	io "io"
 
 	logs_aggregator "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
@@ -150,6 +152,52 @@ func (_c *MockKurtosisBackend_CopyFilesFromUserService_Call) RunAndReturn(run fu
 	return _c
 }
 
+// CopyFilesToUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, destDirpathOnService, input
+func (_m *MockKurtosisBackend) CopyFilesToUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, destDirpathOnService string, input io.Reader) error {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, destDirpathOnService, input)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, io.Reader) error); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, destDirpathOnService, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CopyFilesToUserService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesToUserService'
+type MockKurtosisBackend_CopyFilesToUserService_Call struct {
+	*mock.Call
+}
+
+// CopyFilesToUserService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - destDirpathOnService string
+//   - input io.Reader
+func (_e *MockKurtosisBackend_Expecter) CopyFilesToUserService(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, destDirpathOnService interface{}, input interface{}) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	return &MockKurtosisBackend_CopyFilesToUserService_Call{Call: _e.mock.On("CopyFilesToUserService", ctx, enclaveUuid, serviceUuid, destDirpathOnService, input)}
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, destDirpathOnService string, input io.Reader)) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(string), args[4].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) Return(_a0 error) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, io.Reader) error) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAPIContainer provides a mock function with given fields: ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars, shouldStartInDebugMode
 func (_m *MockKurtosisBackend) CreateAPIContainer(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, enclaveDataVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string, shouldStartInDebugMode bool) (*api_container.APIContainer, error) {
 	ret := _m.Called(ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars, shouldStartInDebugMode)
@@ -211,25 +259,25 @@ func (_c *MockKurtosisBackend_CreateAPIContainer_Call) RunAndReturn(run func(con
 	return _c
 }
 
-// CreateEnclave provides a mock function with given fields: ctx, enclaveUuid, enclaveName
-func (_m *MockKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
-	ret := _m.Called(ctx, enclaveUuid, enclaveName)
+// CreateEnclave provides a mock function with given fields: ctx, enclaveUuid, enclaveName, enclaveLabels, expiration, resourceQuota, owner
+func (_m *MockKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, enclaveLabels map[string]string, expiration *enclave.EnclaveExpiration, resourceQuota *enclave.EnclaveResourceQuota, owner string) (*enclave.Enclave, error) {
+	ret := _m.Called(ctx, enclaveUuid, enclaveName, enclaveLabels, expiration, resourceQuota, owner)
 
 	var r0 *enclave.Enclave
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string) (*enclave.Enclave, error)); ok {
-		return rf(ctx, enclaveUuid, enclaveName)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, map[string]string, *enclave.EnclaveExpiration, *enclave.EnclaveResourceQuota, string) (*enclave.Enclave, error)); ok {
+		return rf(ctx, enclaveUuid, enclaveName, enclaveLabels, expiration, resourceQuota, owner)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string) *enclave.Enclave); ok {
-		r0 = rf(ctx, enclaveUuid, enclaveName)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, map[string]string, *enclave.EnclaveExpiration, *enclave.EnclaveResourceQuota, string) *enclave.Enclave); ok {
+		r0 = rf(ctx, enclaveUuid, enclaveName, enclaveLabels, expiration, resourceQuota, owner)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*enclave.Enclave)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, string) error); ok {
-		r1 = rf(ctx, enclaveUuid, enclaveName)
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, string, map[string]string, *enclave.EnclaveExpiration, *enclave.EnclaveResourceQuota, string) error); ok {
+		r1 = rf(ctx, enclaveUuid, enclaveName, enclaveLabels, expiration, resourceQuota, owner)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -246,13 +294,17 @@ type MockKurtosisBackend_CreateEnclave_Call struct {
 //   - ctx context.Context
 //   - enclaveUuid enclave.EnclaveUUID
 //   - enclaveName string
-func (_e *MockKurtosisBackend_Expecter) CreateEnclave(ctx interface{}, enclaveUuid interface{}, enclaveName interface{}) *MockKurtosisBackend_CreateEnclave_Call {
-	return &MockKurtosisBackend_CreateEnclave_Call{Call: _e.mock.On("CreateEnclave", ctx, enclaveUuid, enclaveName)}
+//   - enclaveLabels map[string]string
+//   - expiration *enclave.EnclaveExpiration
+//   - resourceQuota *enclave.EnclaveResourceQuota
+//   - owner string
+func (_e *MockKurtosisBackend_Expecter) CreateEnclave(ctx interface{}, enclaveUuid interface{}, enclaveName interface{}, enclaveLabels interface{}, expiration interface{}, resourceQuota interface{}, owner interface{}) *MockKurtosisBackend_CreateEnclave_Call {
+	return &MockKurtosisBackend_CreateEnclave_Call{Call: _e.mock.On("CreateEnclave", ctx, enclaveUuid, enclaveName, enclaveLabels, expiration, resourceQuota, owner)}
 }
 
-func (_c *MockKurtosisBackend_CreateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string)) *MockKurtosisBackend_CreateEnclave_Call {
+func (_c *MockKurtosisBackend_CreateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, enclaveLabels map[string]string, expiration *enclave.EnclaveExpiration, resourceQuota *enclave.EnclaveResourceQuota, owner string)) *MockKurtosisBackend_CreateEnclave_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(map[string]string), args[4].(*enclave.EnclaveExpiration), args[5].(*enclave.EnclaveResourceQuota), args[6].(string))
 	})
 	return _c
 }
@@ -262,7 +314,7 @@ func (_c *MockKurtosisBackend_CreateEnclave_Call) Return(_a0 *enclave.Enclave, _
 	return _c
 }
 
-func (_c *MockKurtosisBackend_CreateEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string) (*enclave.Enclave, error)) *MockKurtosisBackend_CreateEnclave_Call {
+func (_c *MockKurtosisBackend_CreateEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string, map[string]string, *enclave.EnclaveExpiration, *enclave.EnclaveResourceQuota, string) (*enclave.Enclave, error)) *MockKurtosisBackend_CreateEnclave_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1997,6 +2049,122 @@ func (_c *MockKurtosisBackend_RunUserServiceExecCommands_Call) RunAndReturn(run
 	return _c
 }
 
+// RunUserServiceInteractiveExecCommand provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, cmd, streams
+func (_m *MockKurtosisBackend) RunUserServiceInteractiveExecCommand(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, cmd []string, streams *interactive_exec.InteractiveExecStreams) (int32, error) {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, cmd, streams)
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, *interactive_exec.InteractiveExecStreams) (int32, error)); ok {
+		return rf(ctx, enclaveUuid, serviceUuid, cmd, streams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, *interactive_exec.InteractiveExecStreams) int32); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, cmd, streams)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, *interactive_exec.InteractiveExecStreams) error); ok {
+		r1 = rf(ctx, enclaveUuid, serviceUuid, cmd, streams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunUserServiceInteractiveExecCommand'
+type MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call struct {
+	*mock.Call
+}
+
+// RunUserServiceInteractiveExecCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - cmd []string
+//   - streams *interactive_exec.InteractiveExecStreams
+func (_e *MockKurtosisBackend_Expecter) RunUserServiceInteractiveExecCommand(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, cmd interface{}, streams interface{}) *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call {
+	return &MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call{Call: _e.mock.On("RunUserServiceInteractiveExecCommand", ctx, enclaveUuid, serviceUuid, cmd, streams)}
+}
+
+func (_c *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, cmd []string, streams *interactive_exec.InteractiveExecStreams)) *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].([]string), args[4].(*interactive_exec.InteractiveExecStreams))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call) Return(exitCode int32, resultErr error) *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call {
+	_c.Call.Return(exitCode, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, *interactive_exec.InteractiveExecStreams) (int32, error)) *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunUserTask provides a mock function with given fields: ctx, enclaveUuid, taskUuid, taskName, taskConfig, filesToStore, shouldRemoveTaskInBackground
+func (_m *MockKurtosisBackend) RunUserTask(ctx context.Context, enclaveUuid enclave.EnclaveUUID, taskUuid service.ServiceUUID, taskName service.ServiceName, taskConfig *service.ServiceConfig, filesToStore map[string]io.Writer, shouldRemoveTaskInBackground bool) (*exec_result.ExecResult, error) {
+	ret := _m.Called(ctx, enclaveUuid, taskUuid, taskName, taskConfig, filesToStore, shouldRemoveTaskInBackground)
+
+	var r0 *exec_result.ExecResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, service.ServiceName, *service.ServiceConfig, map[string]io.Writer, bool) (*exec_result.ExecResult, error)); ok {
+		return rf(ctx, enclaveUuid, taskUuid, taskName, taskConfig, filesToStore, shouldRemoveTaskInBackground)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, service.ServiceName, *service.ServiceConfig, map[string]io.Writer, bool) *exec_result.ExecResult); ok {
+		r0 = rf(ctx, enclaveUuid, taskUuid, taskName, taskConfig, filesToStore, shouldRemoveTaskInBackground)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*exec_result.ExecResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, service.ServiceName, *service.ServiceConfig, map[string]io.Writer, bool) error); ok {
+		r1 = rf(ctx, enclaveUuid, taskUuid, taskName, taskConfig, filesToStore, shouldRemoveTaskInBackground)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_RunUserTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunUserTask'
+type MockKurtosisBackend_RunUserTask_Call struct {
+	*mock.Call
+}
+
+// RunUserTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - taskUuid service.ServiceUUID
+//   - taskName service.ServiceName
+//   - taskConfig *service.ServiceConfig
+//   - filesToStore map[string]io.Writer
+//   - shouldRemoveTaskInBackground bool
+func (_e *MockKurtosisBackend_Expecter) RunUserTask(ctx interface{}, enclaveUuid interface{}, taskUuid interface{}, taskName interface{}, taskConfig interface{}, filesToStore interface{}, shouldRemoveTaskInBackground interface{}) *MockKurtosisBackend_RunUserTask_Call {
+	return &MockKurtosisBackend_RunUserTask_Call{Call: _e.mock.On("RunUserTask", ctx, enclaveUuid, taskUuid, taskName, taskConfig, filesToStore, shouldRemoveTaskInBackground)}
+}
+
+func (_c *MockKurtosisBackend_RunUserTask_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, taskUuid service.ServiceUUID, taskName service.ServiceName, taskConfig *service.ServiceConfig, filesToStore map[string]io.Writer, shouldRemoveTaskInBackground bool)) *MockKurtosisBackend_RunUserTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(service.ServiceName), args[4].(*service.ServiceConfig), args[5].(map[string]io.Writer), args[6].(bool))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RunUserTask_Call) Return(_a0 *exec_result.ExecResult, _a1 error) *MockKurtosisBackend_RunUserTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RunUserTask_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, service.ServiceName, *service.ServiceConfig, map[string]io.Writer, bool) (*exec_result.ExecResult, error)) *MockKurtosisBackend_RunUserTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartRegisteredUserServices provides a mock function with given fields: ctx, enclaveUuid, services
 func (_m *MockKurtosisBackend) StartRegisteredUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, services)
@@ -2429,6 +2597,50 @@ func (_c *MockKurtosisBackend_UpdateEnclave_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// UpdateEnclaveExpiration provides a mock function with given fields: ctx, enclaveUuid, expiration
+func (_m *MockKurtosisBackend) UpdateEnclaveExpiration(ctx context.Context, enclaveUuid enclave.EnclaveUUID, expiration *enclave.EnclaveExpiration) error {
+	ret := _m.Called(ctx, enclaveUuid, expiration)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *enclave.EnclaveExpiration) error); ok {
+		r0 = rf(ctx, enclaveUuid, expiration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_UpdateEnclaveExpiration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnclaveExpiration'
+type MockKurtosisBackend_UpdateEnclaveExpiration_Call struct {
+	*mock.Call
+}
+
+// UpdateEnclaveExpiration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - expiration *enclave.EnclaveExpiration
+func (_e *MockKurtosisBackend_Expecter) UpdateEnclaveExpiration(ctx interface{}, enclaveUuid interface{}, expiration interface{}) *MockKurtosisBackend_UpdateEnclaveExpiration_Call {
+	return &MockKurtosisBackend_UpdateEnclaveExpiration_Call{Call: _e.mock.On("UpdateEnclaveExpiration", ctx, enclaveUuid, expiration)}
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveExpiration_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, expiration *enclave.EnclaveExpiration)) *MockKurtosisBackend_UpdateEnclaveExpiration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*enclave.EnclaveExpiration))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveExpiration_Call) Return(_a0 error) *MockKurtosisBackend_UpdateEnclaveExpiration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveExpiration_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *enclave.EnclaveExpiration) error) *MockKurtosisBackend_UpdateEnclaveExpiration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockKurtosisBackend creates a new instance of MockKurtosisBackend. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockKurtosisBackend(t interface {
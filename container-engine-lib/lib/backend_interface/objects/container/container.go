@@ -7,10 +7,12 @@ type Container struct {
 	entrypointArgs []string
 	cmdArgs        []string
 	envVars        map[string]string
+	// How many times the container was restarted, or its pod rescheduled, since the service started
+	restartCount uint32
 }
 
-func NewContainer(status ContainerStatus, imageName string, entrypointArgs []string, cmdArgs []string, envVars map[string]string) *Container {
-	return &Container{status: status, imageName: imageName, entrypointArgs: entrypointArgs, cmdArgs: cmdArgs, envVars: envVars}
+func NewContainer(status ContainerStatus, imageName string, entrypointArgs []string, cmdArgs []string, envVars map[string]string, restartCount uint32) *Container {
+	return &Container{status: status, imageName: imageName, entrypointArgs: entrypointArgs, cmdArgs: cmdArgs, envVars: envVars, restartCount: restartCount}
 }
 
 func (container *Container) GetStatus() ContainerStatus {
@@ -32,3 +34,7 @@ func (container *Container) GetCmdArgs() []string {
 func (container *Container) GetEnvVars() map[string]string {
 	return container.envVars
 }
+
+func (container *Container) GetRestartCount() uint32 {
+	return container.restartCount
+}
@@ -10,10 +10,38 @@ type Enclave struct {
 	status              EnclaveStatus
 	creationTime        *time.Time
 	isProductionEnclave bool
+	// Arbitrary key-value pairs set by the user when creating the enclave
+	labels map[string]string
+	// Nil if the enclave never expires
+	expiration *EnclaveExpiration
+	// Nil if the enclave has no resource quota
+	resourceQuota *EnclaveResourceQuota
+	// Principal that created the enclave; empty if the engine was running without authentication
+	owner string
 }
 
-func NewEnclave(id EnclaveUUID, name string, status EnclaveStatus, creationTime *time.Time, productionMode bool) *Enclave {
-	return &Enclave{uuid: id, name: name, status: status, creationTime: creationTime, isProductionEnclave: productionMode}
+func NewEnclave(
+	id EnclaveUUID,
+	name string,
+	status EnclaveStatus,
+	creationTime *time.Time,
+	productionMode bool,
+	labels map[string]string,
+	expiration *EnclaveExpiration,
+	resourceQuota *EnclaveResourceQuota,
+	owner string,
+) *Enclave {
+	return &Enclave{
+		uuid:                id,
+		name:                name,
+		status:              status,
+		creationTime:        creationTime,
+		isProductionEnclave: productionMode,
+		labels:              labels,
+		expiration:          expiration,
+		resourceQuota:       resourceQuota,
+		owner:               owner,
+	}
 }
 
 func (enclave *Enclave) GetUUID() EnclaveUUID {
@@ -35,3 +63,19 @@ func (enclave *Enclave) GetName() string {
 func (enclave *Enclave) IsProductionEnclave() bool {
 	return enclave.isProductionEnclave
 }
+
+func (enclave *Enclave) GetLabels() map[string]string {
+	return enclave.labels
+}
+
+func (enclave *Enclave) GetExpiration() *EnclaveExpiration {
+	return enclave.expiration
+}
+
+func (enclave *Enclave) GetResourceQuota() *EnclaveResourceQuota {
+	return enclave.resourceQuota
+}
+
+func (enclave *Enclave) GetOwner() string {
+	return enclave.owner
+}
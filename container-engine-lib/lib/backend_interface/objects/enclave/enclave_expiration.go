@@ -0,0 +1,49 @@
+package enclave
+
+import "time"
+
+// What happens to an enclave once it expires
+type EnclaveExpiryAction string
+
+const (
+	EnclaveExpiryAction_Destroy EnclaveExpiryAction = "destroy"
+	EnclaveExpiryAction_Stop    EnclaveExpiryAction = "stop"
+)
+
+var AllEnclaveExpiryActions = []EnclaveExpiryAction{
+	EnclaveExpiryAction_Destroy,
+	EnclaveExpiryAction_Stop,
+}
+
+func (action EnclaveExpiryAction) IsValid() bool {
+	for _, validAction := range AllEnclaveExpiryActions {
+		if action == validAction {
+			return true
+		}
+	}
+	return false
+}
+
+type EnclaveExpiration struct {
+	expirationTime time.Time
+	action         EnclaveExpiryAction
+}
+
+func NewEnclaveExpiration(expirationTime time.Time, action EnclaveExpiryAction) *EnclaveExpiration {
+	return &EnclaveExpiration{
+		expirationTime: expirationTime,
+		action:         action,
+	}
+}
+
+func (expiration *EnclaveExpiration) GetExpirationTime() time.Time {
+	return expiration.expirationTime
+}
+
+func (expiration *EnclaveExpiration) GetAction() EnclaveExpiryAction {
+	return expiration.action
+}
+
+func (expiration *EnclaveExpiration) IsExpired(now time.Time) bool {
+	return !now.Before(expiration.expirationTime)
+}
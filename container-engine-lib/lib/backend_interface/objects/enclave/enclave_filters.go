@@ -8,4 +8,19 @@ type EnclaveFilters struct {
 	// Disjunctive set of enclave statuses to operate on
 	// If nil or empty, will match all UUIDs
 	Statuses map[EnclaveStatus]bool
+
+	// Conjunctive set of labels the enclaves to operate on must have
+	// If nil or empty, will match all enclaves
+	Labels map[string]string
+}
+
+// MatchesLabels returns true if the given enclave labels contain all the labels of the filters
+func (filters *EnclaveFilters) MatchesLabels(enclaveLabels map[string]string) bool {
+	for key, value := range filters.Labels {
+		enclaveValue, found := enclaveLabels[key]
+		if !found || enclaveValue != value {
+			return false
+		}
+	}
+	return true
 }
@@ -0,0 +1,28 @@
+package enclave
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchesLabels(t *testing.T) {
+	enclaveLabels := map[string]string{
+		"team": "infra",
+		"ci":   "true",
+	}
+
+	noLabelsFilters := &EnclaveFilters{UUIDs: nil, Statuses: nil, Labels: nil}
+	require.True(t, noLabelsFilters.MatchesLabels(enclaveLabels))
+	require.True(t, noLabelsFilters.MatchesLabels(nil))
+
+	matchingFilters := &EnclaveFilters{UUIDs: nil, Statuses: nil, Labels: map[string]string{"team": "infra"}}
+	require.True(t, matchingFilters.MatchesLabels(enclaveLabels))
+
+	differentValueFilters := &EnclaveFilters{UUIDs: nil, Statuses: nil, Labels: map[string]string{"team": "web"}}
+	require.False(t, differentValueFilters.MatchesLabels(enclaveLabels))
+
+	partiallyMatchingFilters := &EnclaveFilters{UUIDs: nil, Statuses: nil, Labels: map[string]string{"team": "infra", "owner": "bob"}}
+	require.False(t, partiallyMatchingFilters.MatchesLabels(enclaveLabels))
+	require.False(t, partiallyMatchingFilters.MatchesLabels(nil))
+}
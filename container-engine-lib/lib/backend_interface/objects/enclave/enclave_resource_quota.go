@@ -0,0 +1,111 @@
+package enclave
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// A zero limit means that the resource isn't capped
+	NoResourceQuotaLimit = 0
+)
+
+// EnclaveResourceQuota caps the resources that the services of an enclave can claim, summing the minimum CPU, the
+// minimum memory and the persistent directory sizes of all the services
+type EnclaveResourceQuota struct {
+	// we do this way in order to have exported fields which can be marshalled
+	// and an unexported type for encapsulation
+	privateResourceQuota *privateEnclaveResourceQuota
+}
+
+type privateEnclaveResourceQuota struct {
+	MaxCpuMilliCores              compute_resources.CpuMilliCores
+	MaxMemoryMegaBytes            compute_resources.MemoryInMegaBytes
+	MaxServices                   uint32
+	MaxPersistentStorageMegaBytes uint64
+}
+
+func NewEnclaveResourceQuota(
+	maxCpuMilliCores compute_resources.CpuMilliCores,
+	maxMemoryMegaBytes compute_resources.MemoryInMegaBytes,
+	maxServices uint32,
+	maxPersistentStorageMegaBytes uint64,
+) *EnclaveResourceQuota {
+	return &EnclaveResourceQuota{
+		privateResourceQuota: &privateEnclaveResourceQuota{
+			MaxCpuMilliCores:              maxCpuMilliCores,
+			MaxMemoryMegaBytes:            maxMemoryMegaBytes,
+			MaxServices:                   maxServices,
+			MaxPersistentStorageMegaBytes: maxPersistentStorageMegaBytes,
+		},
+	}
+}
+
+func (quota *EnclaveResourceQuota) GetMaxCpuMilliCores() compute_resources.CpuMilliCores {
+	return quota.privateResourceQuota.MaxCpuMilliCores
+}
+
+func (quota *EnclaveResourceQuota) GetMaxMemoryMegaBytes() compute_resources.MemoryInMegaBytes {
+	return quota.privateResourceQuota.MaxMemoryMegaBytes
+}
+
+func (quota *EnclaveResourceQuota) GetMaxServices() uint32 {
+	return quota.privateResourceQuota.MaxServices
+}
+
+// GetMaxPersistentStorageMegaBytes uses the same megabytes as the size of the persistent directories, i.e. 1024 * 1024 bytes
+func (quota *EnclaveResourceQuota) GetMaxPersistentStorageMegaBytes() uint64 {
+	return quota.privateResourceQuota.MaxPersistentStorageMegaBytes
+}
+
+// IsUnlimited returns true if none of the resources are capped, which is the same as not having a quota
+func (quota *EnclaveResourceQuota) IsUnlimited() bool {
+	return quota.GetMaxCpuMilliCores() == NoResourceQuotaLimit &&
+		quota.GetMaxMemoryMegaBytes() == NoResourceQuotaLimit &&
+		quota.GetMaxServices() == NoResourceQuotaLimit &&
+		quota.GetMaxPersistentStorageMegaBytes() == NoResourceQuotaLimit
+}
+
+// WithDefaults returns a copy of this quota where the resources that aren't capped take their limit from the given
+// default quota, which can be nil
+func (quota *EnclaveResourceQuota) WithDefaults(defaultQuota *EnclaveResourceQuota) *EnclaveResourceQuota {
+	if defaultQuota == nil {
+		return NewEnclaveResourceQuota(quota.GetMaxCpuMilliCores(), quota.GetMaxMemoryMegaBytes(), quota.GetMaxServices(), quota.GetMaxPersistentStorageMegaBytes())
+	}
+	maxCpuMilliCores := quota.GetMaxCpuMilliCores()
+	if maxCpuMilliCores == NoResourceQuotaLimit {
+		maxCpuMilliCores = defaultQuota.GetMaxCpuMilliCores()
+	}
+	maxMemoryMegaBytes := quota.GetMaxMemoryMegaBytes()
+	if maxMemoryMegaBytes == NoResourceQuotaLimit {
+		maxMemoryMegaBytes = defaultQuota.GetMaxMemoryMegaBytes()
+	}
+	maxServices := quota.GetMaxServices()
+	if maxServices == NoResourceQuotaLimit {
+		maxServices = defaultQuota.GetMaxServices()
+	}
+	maxPersistentStorageMegaBytes := quota.GetMaxPersistentStorageMegaBytes()
+	if maxPersistentStorageMegaBytes == NoResourceQuotaLimit {
+		maxPersistentStorageMegaBytes = defaultQuota.GetMaxPersistentStorageMegaBytes()
+	}
+	return NewEnclaveResourceQuota(maxCpuMilliCores, maxMemoryMegaBytes, maxServices, maxPersistentStorageMegaBytes)
+}
+
+func (quota *EnclaveResourceQuota) MarshalJSON() ([]byte, error) {
+	return json.Marshal(quota.privateResourceQuota)
+}
+
+func (quota *EnclaveResourceQuota) UnmarshalJSON(data []byte) error {
+
+	// Suppressing exhaustruct requirement because we want an object with zero values
+	// nolint: exhaustruct
+	unmarshalledPrivateStructPtr := &privateEnclaveResourceQuota{}
+
+	if err := json.Unmarshal(data, unmarshalledPrivateStructPtr); err != nil {
+		return stacktrace.Propagate(err, "An error occurred unmarshalling the private struct")
+	}
+
+	quota.privateResourceQuota = unmarshalledPrivateStructPtr
+	return nil
+}
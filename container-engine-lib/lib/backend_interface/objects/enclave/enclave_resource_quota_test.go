@@ -0,0 +1,36 @@
+package enclave
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnclaveResourceQuota_WithDefaults(t *testing.T) {
+	quota := NewEnclaveResourceQuota(2000, NoResourceQuotaLimit, 5, NoResourceQuotaLimit)
+	defaultQuota := NewEnclaveResourceQuota(1000, 4096, 10, NoResourceQuotaLimit)
+
+	result := quota.WithDefaults(defaultQuota)
+	require.Equal(t, NewEnclaveResourceQuota(2000, 4096, 5, NoResourceQuotaLimit), result)
+	require.False(t, result.IsUnlimited())
+
+	require.Equal(t, quota, quota.WithDefaults(nil))
+}
+
+func TestEnclaveResourceQuota_IsUnlimited(t *testing.T) {
+	require.True(t, NewEnclaveResourceQuota(NoResourceQuotaLimit, NoResourceQuotaLimit, NoResourceQuotaLimit, NoResourceQuotaLimit).IsUnlimited())
+	require.False(t, NewEnclaveResourceQuota(NoResourceQuotaLimit, NoResourceQuotaLimit, NoResourceQuotaLimit, 1024).IsUnlimited())
+}
+
+func TestEnclaveResourceQuota_JsonRoundTrip(t *testing.T) {
+	quota := NewEnclaveResourceQuota(2000, 4096, 5, 10240)
+
+	marshalledQuota, err := json.Marshal(quota)
+	require.NoError(t, err)
+
+	// nolint: exhaustruct
+	unmarshalledQuota := &EnclaveResourceQuota{}
+	require.NoError(t, json.Unmarshal(marshalledQuota, unmarshalledQuota))
+	require.Equal(t, quota, unmarshalledQuota)
+}
@@ -3,10 +3,18 @@ package exec_result
 type ExecResult struct {
 	exitCode int32
 	output   string
+
+	// only set when the streams could be told apart, the output holding both of them interleaved
+	stdout string
+	stderr string
 }
 
 func NewExecResult(exitCode int32, output string) *ExecResult {
-	return &ExecResult{exitCode: exitCode, output: output}
+	return &ExecResult{exitCode: exitCode, output: output, stdout: "", stderr: ""}
+}
+
+func NewExecResultWithStreams(exitCode int32, output string, stdout string, stderr string) *ExecResult {
+	return &ExecResult{exitCode: exitCode, output: output, stdout: stdout, stderr: stderr}
 }
 
 func (execResult *ExecResult) GetExitCode() int32 {
@@ -16,3 +24,11 @@ func (execResult *ExecResult) GetExitCode() int32 {
 func (execResult *ExecResult) GetOutput() string {
 	return execResult.output
 }
+
+func (execResult *ExecResult) GetStdout() string {
+	return execResult.stdout
+}
+
+func (execResult *ExecResult) GetStderr() string {
+	return execResult.stderr
+}
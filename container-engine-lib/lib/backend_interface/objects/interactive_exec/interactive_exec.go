@@ -0,0 +1,75 @@
+package interactive_exec
+
+import "io"
+
+// TerminalSize is the size, in characters, of the TTY attached to an interactive exec
+type TerminalSize struct {
+	width  uint16
+	height uint16
+}
+
+func NewTerminalSize(width uint16, height uint16) *TerminalSize {
+	return &TerminalSize{width: width, height: height}
+}
+
+func (size *TerminalSize) GetWidth() uint16 {
+	return size.width
+}
+
+func (size *TerminalSize) GetHeight() uint16 {
+	return size.height
+}
+
+// InteractiveExecStreams are the streams an interactive exec command gets attached to
+type InteractiveExecStreams struct {
+	// Nil if the command shouldn't get any STDIN
+	stdin io.Reader
+
+	stdout io.Writer
+
+	// Unused when a TTY is allocated, as the TTY merges STDERR into STDOUT
+	stderr io.Writer
+
+	tty bool
+
+	// Size the TTY is allocated with. Nil if the runtime default should be used or no TTY is allocated
+	initialTerminalSize *TerminalSize
+
+	// Sizes the TTY gets resized to, in order. Nil if the TTY is never resized or no TTY is allocated
+	terminalSizes <-chan *TerminalSize
+}
+
+func NewInteractiveExecStreams(stdin io.Reader, stdout io.Writer, stderr io.Writer, tty bool, initialTerminalSize *TerminalSize, terminalSizes <-chan *TerminalSize) *InteractiveExecStreams {
+	return &InteractiveExecStreams{
+		stdin:               stdin,
+		stdout:              stdout,
+		stderr:              stderr,
+		tty:                 tty,
+		initialTerminalSize: initialTerminalSize,
+		terminalSizes:       terminalSizes,
+	}
+}
+
+func (streams *InteractiveExecStreams) GetStdin() io.Reader {
+	return streams.stdin
+}
+
+func (streams *InteractiveExecStreams) GetStdout() io.Writer {
+	return streams.stdout
+}
+
+func (streams *InteractiveExecStreams) GetStderr() io.Writer {
+	return streams.stderr
+}
+
+func (streams *InteractiveExecStreams) IsTty() bool {
+	return streams.tty
+}
+
+func (streams *InteractiveExecStreams) GetInitialTerminalSize() *TerminalSize {
+	return streams.initialTerminalSize
+}
+
+func (streams *InteractiveExecStreams) GetTerminalSizes() <-chan *TerminalSize {
+	return streams.terminalSizes
+}
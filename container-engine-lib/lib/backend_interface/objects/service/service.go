@@ -68,12 +68,19 @@ type Service struct {
 	// - The backend type is Docker
 	maybePublicPorts map[string]*port_spec.PortSpec
 
+	// When running in Kubernetes with service exposure configured, a mapping of service_port_id -> URL where the user
+	//  can make requests to the port from outside the cluster, through an Ingress or a Gateway API HTTPRoute
+	// This will only be non-nil if both:
+	// - The service's status is running
+	// - The backend type is Kubernetes and exposes the HTTP ports of the service
+	maybePublicUrls map[string]string
+
 	// Docker container or Kubernetes pod container
 	container *container.Container
 }
 
-func NewService(registration *ServiceRegistration, privatePorts map[string]*port_spec.PortSpec, maybePublicIp net.IP, maybePublicPorts map[string]*port_spec.PortSpec, container *container.Container) *Service {
-	return &Service{registration: registration, privatePorts: privatePorts, maybePublicIp: maybePublicIp, maybePublicPorts: maybePublicPorts, container: container}
+func NewService(registration *ServiceRegistration, privatePorts map[string]*port_spec.PortSpec, maybePublicIp net.IP, maybePublicPorts map[string]*port_spec.PortSpec, maybePublicUrls map[string]string, container *container.Container) *Service {
+	return &Service{registration: registration, privatePorts: privatePorts, maybePublicIp: maybePublicIp, maybePublicPorts: maybePublicPorts, maybePublicUrls: maybePublicUrls, container: container}
 }
 
 func (service *Service) GetRegistration() *ServiceRegistration {
@@ -96,6 +103,10 @@ func (service *Service) GetMaybePublicPorts() map[string]*port_spec.PortSpec {
 	return service.maybePublicPorts
 }
 
+func (service *Service) GetMaybePublicUrls() map[string]string {
+	return service.maybePublicUrls
+}
+
 func IsServiceNameValid(serviceName ServiceName) bool {
 	return ServiceNameRegex.MatchString(string(serviceName))
 }
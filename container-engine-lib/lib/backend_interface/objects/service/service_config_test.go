@@ -76,7 +76,7 @@ func getServiceConfigForTest(t *testing.T, imageName string) *ServiceConfig {
 func testPersistentDirectory() *service_directory.PersistentDirectories {
 	persistentDirectoriesMap := map[string]service_directory.PersistentDirectory{
 		"dirpath1": {PersistentKey: service_directory.DirectoryPersistentKey("dirpath1_persistent_directory_key"), Size: service_directory.DirectoryPersistentSize(int64(0))},
-		"dirpath2": {PersistentKey: service_directory.DirectoryPersistentKey("dirpath2_persistent_directory_key"), Size: service_directory.DirectoryPersistentSize(int64(0))},
+		"dirpath2": {PersistentKey: service_directory.DirectoryPersistentKey("dirpath2_persistent_directory_key"), Size: service_directory.DirectoryPersistentSize(int64(0)), StorageClass: "fast-ssd", AccessMode: service_directory.AccessMode_ReadWriteMany},
 	}
 
 	return service_directory.NewPersistentDirectories(persistentDirectoriesMap)
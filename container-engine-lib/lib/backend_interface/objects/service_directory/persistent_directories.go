@@ -12,6 +12,18 @@ const (
 	// https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-label-names
 	PersistentKeyRegex            = "[a-z]([-a-z0-9]{0,61}[a-z0-9])?"
 	WordWrappedPersistentKeyRegex = "^" + PersistentKeyRegex + "$"
+
+	// DefaultStorageClass and DefaultAccessMode leave the choice to the backend, i.e. the storage class the backend was
+	// configured with and a volume only writable from a single node
+	DefaultStorageClass = ""
+	DefaultAccessMode   = DirectoryAccessMode("")
+
+	// The access modes mirror the Kubernetes persistent volume access modes
+	// https://kubernetes.io/docs/concepts/storage/persistent-volumes/#access-modes
+	AccessMode_ReadWriteOnce    = DirectoryAccessMode("ReadWriteOnce")
+	AccessMode_ReadOnlyMany     = DirectoryAccessMode("ReadOnlyMany")
+	AccessMode_ReadWriteMany    = DirectoryAccessMode("ReadWriteMany")
+	AccessMode_ReadWriteOncePod = DirectoryAccessMode("ReadWriteOncePod")
 )
 
 var (
@@ -20,10 +32,26 @@ var (
 
 type DirectoryPersistentKey string
 type DirectoryPersistentSize int64
+type DirectoryAccessMode string
+
+var AllAccessModes = []DirectoryAccessMode{
+	AccessMode_ReadWriteOnce,
+	AccessMode_ReadOnlyMany,
+	AccessMode_ReadWriteMany,
+	AccessMode_ReadWriteOncePod,
+}
 
 type PersistentDirectory struct {
 	PersistentKey DirectoryPersistentKey
 	Size          DirectoryPersistentSize
+	// StorageClass and AccessMode are only honored by backends that provision volumes on demand, i.e. Kubernetes
+	StorageClass string
+	AccessMode   DirectoryAccessMode
+}
+
+// HasStorageOptions returns true if the directory asks for a storage class or an access mode other than the backend's
+func (persistentDirectory PersistentDirectory) HasStorageOptions() bool {
+	return persistentDirectory.StorageClass != DefaultStorageClass || persistentDirectory.AccessMode != DefaultAccessMode
 }
 
 type PersistentDirectories struct {
@@ -39,3 +67,12 @@ func NewPersistentDirectories(persistentDirectories map[string]PersistentDirecto
 func IsPersistentKeyValid(persistentKey DirectoryPersistentKey) bool {
 	return compiledWordWrappedPersistentKeyRegex.MatchString(string(persistentKey))
 }
+
+func IsAccessModeValid(accessMode DirectoryAccessMode) bool {
+	for _, validAccessMode := range AllAccessModes {
+		if accessMode == validAccessMode {
+			return true
+		}
+	}
+	return false
+}
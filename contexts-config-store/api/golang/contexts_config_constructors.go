@@ -54,3 +54,10 @@ func NewRemoteV0Context(
 		},
 	}
 }
+
+func NewEngineAuth(token string, tlsConfig *generated.TlsConfig) *generated.EngineAuth {
+	return &generated.EngineAuth{
+		Token:     token,
+		TlsConfig: tlsConfig,
+	}
+}
@@ -30,6 +30,11 @@ type ContextsConfigStore interface {
 	// RemoveContext removes the contexts passed as an argument.
 	// It does nothing if the contextUuid does not point to any known context.
 	RemoveContext(contextUuid *generated.ContextUuid) error
+
+	// SetEngineAuth sets the credentials used to call the engine of the context passed as an argument, or clears them
+	// if engineAuth is nil.
+	// It throws an error if the contextUuid does not point to any known context.
+	SetEngineAuth(contextUuid *generated.ContextUuid, engineAuth *generated.EngineAuth) error
 }
 
 func GetContextsConfigStore() ContextsConfigStore {
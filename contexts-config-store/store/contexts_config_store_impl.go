@@ -5,6 +5,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store/persistence"
 	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/protobuf/proto"
 	"strings"
 	"sync"
 )
@@ -162,3 +163,41 @@ func (store *contextConfigStoreImpl) RemoveContext(contextUuid *generated.Contex
 	}
 	return nil
 }
+
+func (store *contextConfigStoreImpl) SetEngineAuth(contextUuid *generated.ContextUuid, engineAuth *generated.EngineAuth) error {
+	store.Lock()
+	defer store.Unlock()
+
+	contextsConfig, err := store.storage.LoadContextsConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to load the list of contexts currently stored")
+	}
+
+	foundContextToUpdate := false
+	var contextUuidsInStore []string
+	var newContexts []*generated.KurtosisContext
+	for _, kurtosisContextInStore := range contextsConfig.GetContexts() {
+		contextUuidsInStore = append(contextUuidsInStore, kurtosisContextInStore.GetUuid().GetValue())
+		if kurtosisContextInStore.GetUuid().GetValue() == contextUuid.GetValue() {
+			updatedContext, ok := proto.Clone(kurtosisContextInStore).(*generated.KurtosisContext)
+			if !ok {
+				return stacktrace.NewError("Unable to copy context '%s'", contextUuid.GetValue())
+			}
+			updatedContext.EngineAuth = engineAuth
+			newContexts = append(newContexts, updatedContext)
+			foundContextToUpdate = true
+		} else {
+			newContexts = append(newContexts, kurtosisContextInStore)
+		}
+	}
+	if !foundContextToUpdate {
+		return stacktrace.NewError("Context with UUID '%s' does not exist in store. Known contexts are: '%s'",
+			contextUuid.GetValue(), strings.Join(contextUuidsInStore, contextUuidsSeparator))
+	}
+
+	newContextConfigToPersist := api.NewKurtosisContextsConfig(contextsConfig.GetCurrentContextUuid(), newContexts...)
+	if err = store.storage.PersistContextsConfig(newContextConfigToPersist); err != nil {
+		return stacktrace.Propagate(err, "Unable to persist new context config to store")
+	}
+	return nil
+}
@@ -226,3 +226,45 @@ func TestRemoveContext_NonExistingContext(t *testing.T) {
 	require.True(t, found)
 	storage.AssertNotCalled(t, persistMethod.Name, mock.Anything)
 }
+
+func TestSetEngineAuth(t *testing.T) {
+	// Setup storage mock
+	storage := persistence.NewMockConfigPersistence(t)
+	contextsConfig := api.NewKurtosisContextsConfig(contextUuid, localContext, otherLocalContext)
+	storage.EXPECT().LoadContextsConfig().Return(contextsConfig, nil)
+
+	engineAuth := api.NewEngineAuth("token", nil)
+	otherLocalContextWithEngineAuth := api.NewLocalOnlyContext(otherContextUuid, "other-context-name")
+	otherLocalContextWithEngineAuth.EngineAuth = engineAuth
+	expectContextsConfigAfterUpdate := api.NewKurtosisContextsConfig(contextUuid, localContext, otherLocalContextWithEngineAuth)
+	storage.EXPECT().PersistContextsConfig(mock.MatchedBy(func(persistedContextsConfig proto.Message) bool {
+		return proto.Equal(expectContextsConfigAfterUpdate, persistedContextsConfig)
+	})).Times(1).Return(nil)
+
+	// Run test
+	testContextConfigStore := NewContextConfigStore(storage)
+	err := testContextConfigStore.SetEngineAuth(otherContextUuid, engineAuth)
+	require.NoError(t, err)
+	// The stored context is left untouched
+	require.Nil(t, otherLocalContext.GetEngineAuth())
+}
+
+func TestSetEngineAuth_NonExistingContextFailure(t *testing.T) {
+	// Setup storage mock
+	storage := persistence.NewMockConfigPersistence(t)
+	contextsConfig := api.NewKurtosisContextsConfig(contextUuid, localContext)
+	storage.EXPECT().LoadContextsConfig().Return(contextsConfig, nil)
+
+	// Run test
+	testContextConfigStore := NewContextConfigStore(storage)
+	err := testContextConfigStore.SetEngineAuth(otherContextUuid, api.NewEngineAuth("token", nil))
+	require.Error(t, err)
+	expectedErr := fmt.Sprintf("Context with UUID '%s' does not exist in store. Known contexts are: '%s'",
+		otherContextUuid.GetValue(), contextUuid.GetValue())
+	require.Contains(t, err.Error(), expectedErr)
+
+	// Need to check the method exist first because if the method name changes in the future this test would do nothing
+	persistMethod, found := reflect.TypeOf(storage).MethodByName(persistMethodName)
+	require.True(t, found)
+	storage.AssertNotCalled(t, persistMethod.Name, mock.Anything)
+}
@@ -257,6 +257,49 @@ type mockConstructorTestingTNewMockContextsConfigStore interface {
 	Cleanup(func())
 }
 
+// SetEngineAuth provides a mock function with given fields: contextUuid, engineAuth
+func (_m *MockContextsConfigStore) SetEngineAuth(contextUuid *generated.ContextUuid, engineAuth *generated.EngineAuth) error {
+	ret := _m.Called(contextUuid, engineAuth)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*generated.ContextUuid, *generated.EngineAuth) error); ok {
+		r0 = rf(contextUuid, engineAuth)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContextsConfigStore_SetEngineAuth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEngineAuth'
+type MockContextsConfigStore_SetEngineAuth_Call struct {
+	*mock.Call
+}
+
+// SetEngineAuth is a helper method to define mock.On call
+//   - contextUuid *generated.ContextUuid
+//   - engineAuth *generated.EngineAuth
+func (_e *MockContextsConfigStore_Expecter) SetEngineAuth(contextUuid interface{}, engineAuth interface{}) *MockContextsConfigStore_SetEngineAuth_Call {
+	return &MockContextsConfigStore_SetEngineAuth_Call{Call: _e.mock.On("SetEngineAuth", contextUuid, engineAuth)}
+}
+
+func (_c *MockContextsConfigStore_SetEngineAuth_Call) Run(run func(contextUuid *generated.ContextUuid, engineAuth *generated.EngineAuth)) *MockContextsConfigStore_SetEngineAuth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*generated.ContextUuid), args[1].(*generated.EngineAuth))
+	})
+	return _c
+}
+
+func (_c *MockContextsConfigStore_SetEngineAuth_Call) Return(_a0 error) *MockContextsConfigStore_SetEngineAuth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContextsConfigStore_SetEngineAuth_Call) RunAndReturn(run func(*generated.ContextUuid, *generated.EngineAuth) error) *MockContextsConfigStore_SetEngineAuth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockContextsConfigStore creates a new instance of MockContextsConfigStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 func NewMockContextsConfigStore(t mockConstructorTestingTNewMockContextsConfigStore) *MockContextsConfigStore {
 	mock := &MockContextsConfigStore{}
@@ -17,11 +17,19 @@ const (
 	jsonFieldTag = "json"
 )
 
+var skipValidation = map[string]bool{
+	"apiContainerAuthToken": true,
+}
+
 // Fields are public for JSON de/serialization
 type FilesArtifactsExpanderArgs struct {
 	APIContainerIpAddress   string                   `json:"apiContainerIpAddress"`
 	ApiContainerPort        uint16                   `json:"apiContainerPort"`
 	FilesArtifactExpansions []FilesArtifactExpansion `json:"filesArtifactExpansions"`
+
+	// Token the expander authenticates to the API container with; empty if the API container doesn't require
+	// authentication
+	ApiContainerAuthToken string `json:"apiContainerAuthToken"`
 }
 
 type FilesArtifactExpansion struct {
@@ -31,11 +39,12 @@ type FilesArtifactExpansion struct {
 	DirPathToExpandTo string `json:"dirPathToExpandTo"`
 }
 
-func NewFilesArtifactsExpanderArgs(apiContainerIpAddress string, apiContainerPort uint16, filesArtifactExpansions []FilesArtifactExpansion) (*FilesArtifactsExpanderArgs, error) {
+func NewFilesArtifactsExpanderArgs(apiContainerIpAddress string, apiContainerPort uint16, filesArtifactExpansions []FilesArtifactExpansion, apiContainerAuthToken string) (*FilesArtifactsExpanderArgs, error) {
 	result := &FilesArtifactsExpanderArgs{
 		APIContainerIpAddress:   apiContainerIpAddress,
 		ApiContainerPort:        apiContainerPort,
 		FilesArtifactExpansions: filesArtifactExpansions,
+		ApiContainerAuthToken:   apiContainerAuthToken,
 	}
 	logrus.Debugf("Expander args: %+v", result)
 	if err := result.validate(); err != nil {
@@ -54,6 +63,10 @@ func (args FilesArtifactsExpanderArgs) validate() error {
 		field := reflectValType.Field(i)
 		jsonFieldName := field.Tag.Get(jsonFieldTag)
 
+		if _, found := skipValidation[jsonFieldName]; found {
+			continue
+		}
+
 		// Ensure no empty strings
 		strVal := reflectVal.Field(i).String()
 		if strings.TrimSpace(strVal) == "" {
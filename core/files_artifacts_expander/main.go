@@ -32,8 +32,27 @@ const (
 
 	forceColors   = true
 	fullTimestamp = true
+
+	authorizationMetadataKey = "authorization"
+	bearerTokenPrefix        = "Bearer "
 )
 
+// apiContainerTokenCredentials sends the token of the expander with every call to the API container
+type apiContainerTokenCredentials struct {
+	token string
+}
+
+func (creds apiContainerTokenCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{
+		authorizationMetadataKey: bearerTokenPrefix + creds.token,
+	}, nil
+}
+
+// RequireTransportSecurity is false as the API container is called over plaintext inside the enclave
+func (creds apiContainerTokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
 func main() {
 	// NOTE: we'll want to change the ForceColors to false if we ever want structured logging
 	logrus.SetFormatter(&logrus.TextFormatter{
@@ -76,7 +95,11 @@ func runMain() error {
 	}
 	apiContainerPortNum := filesArtifactExpanderArgs.ApiContainerPort
 	grpcUrl := fmt.Sprintf("%v:%v", apiContainerIpAddr, apiContainerPortNum)
-	apiContainerConnection, err := grpc.Dial(grpcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if filesArtifactExpanderArgs.ApiContainerAuthToken != "" {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(apiContainerTokenCredentials{token: filesArtifactExpanderArgs.ApiContainerAuthToken}))
+	}
+	apiContainerConnection, err := grpc.Dial(grpcUrl, dialOptions...)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to create a client connection to API container at address '%v', instead a non-nil error was returned", grpcUrl)
 	}
@@ -46,6 +46,10 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
 	shouldStartInDebugMode bool,
+	// Nil if the enclave has no resource quota
+	enclaveResourceQuota *enclave.EnclaveResourceQuota,
+	// Nil if the engine doesn't require authentication
+	authConfig *args.ApiContainerAuthConfig,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -65,6 +69,8 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 		cloudUserID,
 		cloudInstanceID,
 		shouldStartInDebugMode,
+		enclaveResourceQuota,
+		authConfig,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred launching the API container with default version tag '%v'", kurtosis_version.KurtosisVersion)
@@ -87,6 +93,10 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
 	shouldStartInDebugMode bool,
+	// Nil if the enclave has no resource quota
+	enclaveResourceQuota *enclave.EnclaveResourceQuota,
+	// Nil if the engine doesn't require authentication
+	authConfig *args.ApiContainerAuthConfig,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -107,6 +117,8 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 		isCI,
 		cloudUserID,
 		cloudInstanceID,
+		enclaveResourceQuota,
+		authConfig,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the API container args")
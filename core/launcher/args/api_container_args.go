@@ -2,6 +2,7 @@ package args
 
 import (
 	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args/kurtosis_backend_config"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"reflect"
@@ -54,6 +55,13 @@ type APIContainerArgs struct {
 
 	// The Cloud Instance ID of the current user if available
 	CloudInstanceID metrics_client.CloudInstanceID `json:"cloud_instance_id"`
+
+	// The resources the plans run in the enclave must fit in; nil if the enclave has no resource quota
+	EnclaveResourceQuota *enclave.EnclaveResourceQuota `json:"enclaveResourceQuota"`
+
+	// How the callers of the API container are authenticated and authorized; nil if the engine doesn't require
+	// authentication, in which case any caller can make any call
+	AuthConfig *ApiContainerAuthConfig `json:"authConfig"`
 }
 
 var skipValidation = map[string]bool{
@@ -110,6 +118,8 @@ func NewAPIContainerArgs(
 	isCI bool,
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
+	enclaveResourceQuota *enclave.EnclaveResourceQuota,
+	authConfig *ApiContainerAuthConfig,
 ) (*APIContainerArgs, error) {
 	result := &APIContainerArgs{
 		Version:                     version,
@@ -126,6 +136,8 @@ func NewAPIContainerArgs(
 		IsCI:                        isCI,
 		CloudUserID:                 cloudUserID,
 		CloudInstanceID:             cloudInstanceID,
+		EnclaveResourceQuota:        enclaveResourceQuota,
+		AuthConfig:                  authConfig,
 	}
 
 	if err := result.validate(); err != nil {
@@ -7,13 +7,15 @@ package args
 
 import (
 	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
 
 const (
-	kubernetesArgsJson = `{"version": "X.X.X", "grpcListenPortNum":9710,"logLevelStr":"debug", "enclaveUuid": "enclave-id", "metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be", "enclaveDataVolumeDirpath": "/path/", "didUserAcceptSendingMetrics":true,"kurtosisBackendType":"kubernetes","kurtosisBackendConfig":{}}`
-	dockerArgsJson     = `{"version": "X.X.X", "grpcListenPortNum":9710,"logLevelStr":"debug", "enclaveUuid": "enclave-id", "metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be", "enclaveDataVolumeDirpath": "/path/", "didUserAcceptSendingMetrics":true,"kurtosisBackendType":"docker","kurtosisBackendConfig":{}}`
+	kubernetesArgsJson              = `{"version": "X.X.X", "grpcListenPortNum":9710,"logLevelStr":"debug", "enclaveUuid": "enclave-id", "metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be", "enclaveDataVolumeDirpath": "/path/", "didUserAcceptSendingMetrics":true,"kurtosisBackendType":"kubernetes","kurtosisBackendConfig":{}}`
+	dockerArgsWithResourceQuotaJson = `{"version": "X.X.X", "grpcListenPortNum":9710,"logLevelStr":"debug", "enclaveUuid": "enclave-id", "metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be", "enclaveDataVolumeDirpath": "/path/", "didUserAcceptSendingMetrics":true,"kurtosisBackendType":"docker","kurtosisBackendConfig":{},"enclaveResourceQuota":{"MaxCpuMilliCores":2000,"MaxMemoryMegaBytes":4096,"MaxServices":5,"MaxPersistentStorageMegaBytes":0}}`
+	dockerArgsJson                  = `{"version": "X.X.X", "grpcListenPortNum":9710,"logLevelStr":"debug", "enclaveUuid": "enclave-id", "metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be", "enclaveDataVolumeDirpath": "/path/", "didUserAcceptSendingMetrics":true,"kurtosisBackendType":"docker","kurtosisBackendConfig":{}}`
 )
 
 func TestArgsUnmarshalKubernetes(t *testing.T) {
@@ -29,3 +31,17 @@ func TestArgsUnmarshalDocker(t *testing.T) {
 	err := json.Unmarshal(paramsJsonBytes, &args)
 	require.NoError(t, err)
 }
+
+func TestArgsUnmarshalEnclaveResourceQuota(t *testing.T) {
+	paramsJsonBytes := []byte(dockerArgsWithResourceQuotaJson)
+	var args APIContainerArgs
+	err := json.Unmarshal(paramsJsonBytes, &args)
+	require.NoError(t, err)
+	require.Equal(t, enclave.NewEnclaveResourceQuota(2000, 4096, 5, enclave.NoResourceQuotaLimit), args.EnclaveResourceQuota)
+
+	paramsJsonBytes = []byte(dockerArgsJson)
+	var argsWithoutQuota APIContainerArgs
+	err = json.Unmarshal(paramsJsonBytes, &argsWithoutQuota)
+	require.NoError(t, err)
+	require.Nil(t, argsWithoutQuota.EnclaveResourceQuota)
+}
@@ -0,0 +1,194 @@
+package args
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	apiContainerCredentialSeparator = "."
+	apiContainerCredentialNumParts  = 2
+
+	certificatePemBlockType = "CERTIFICATE"
+)
+
+// ApiContainerAuthRole mirrors the roles of the engine principals, the API container applies them to its own API
+type ApiContainerAuthRole string
+
+const (
+	// Can only make the calls reading the enclave
+	ApiContainerAuthRole_ReadOnly ApiContainerAuthRole = "read-only"
+	// Can make any call in the enclaves it owns, and only the reading calls in the others
+	ApiContainerAuthRole_EnclaveOwner ApiContainerAuthRole = "enclave-owner"
+	// Can make any call
+	ApiContainerAuthRole_Admin ApiContainerAuthRole = "admin"
+)
+
+// AuthPrincipalKind is how a principal authenticated to the engine. Principals of different kinds never share
+// ownership, even if they have the same name, so that a client certificate CN can't claim the enclaves of a token
+type AuthPrincipalKind string
+
+const (
+	AuthPrincipalKind_Token             AuthPrincipalKind = "token"
+	AuthPrincipalKind_ClientCertificate AuthPrincipalKind = "certificate"
+)
+
+// GetAuthPrincipalId returns the identifier of a principal, which is what's recorded as the owner of the enclaves
+func GetAuthPrincipalId(kind AuthPrincipalKind, name string) string {
+	return fmt.Sprintf("%v:%v", kind, name)
+}
+
+// ApiContainerAuthToken is an engine token accepted by the API container; only its hash is handed to the API container
+// Fields are public for JSON de/serialization
+type ApiContainerAuthToken struct {
+	// Name of the principal authenticated by the token
+	Principal string `json:"principal"`
+
+	Role ApiContainerAuthRole `json:"role"`
+
+	// Hex encoded SHA-256 of the token, see HashApiContainerAuthToken
+	TokenSha256 string `json:"tokenSha256"`
+}
+
+// ApiContainerAuthConfig configures how the API container authenticates and authorizes the callers of its API, it's
+// derived by the engine from its own auth config
+// Fields are public for JSON de/serialization
+type ApiContainerAuthConfig struct {
+	Tokens []ApiContainerAuthToken `json:"tokens"`
+
+	// PEM encoded certificate of the engine, whose key signs the credentials the engine issues to the principals that
+	// didn't authenticate with a token; empty if the engine doesn't serve TLS, in which case no credential is accepted
+	EngineCertificate string `json:"engineCertificate"`
+
+	// Identifier of the principal owning the enclave, see GetAuthPrincipalId; empty if the enclave has no owner
+	EnclaveOwner string `json:"enclaveOwner"`
+}
+
+func NewApiContainerAuthConfig(tokens []ApiContainerAuthToken, engineCertificate string, enclaveOwner string) *ApiContainerAuthConfig {
+	return &ApiContainerAuthConfig{
+		Tokens:            tokens,
+		EngineCertificate: engineCertificate,
+		EnclaveOwner:      enclaveOwner,
+	}
+}
+
+// HashApiContainerAuthToken returns the hex encoded SHA-256 of the token, which is what the API container compares
+func HashApiContainerAuthToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// ApiContainerCredentialClaims is what an engine issued credential asserts about its bearer
+// Fields are public for JSON de/serialization
+type ApiContainerCredentialClaims struct {
+	PrincipalId string `json:"principalId"`
+
+	Role ApiContainerAuthRole `json:"role"`
+
+	// The credential is only accepted by the API container of this enclave
+	EnclaveUUID string `json:"enclaveUuid"`
+
+	ExpiresAtUnix int64 `json:"expiresAt"`
+}
+
+// SignApiContainerCredential issues a credential for the API container of an enclave, signed with the TLS key of the
+// engine. The credential is the base64 encoded JSON claims and the base64 encoded signature, joined by a dot
+func SignApiContainerCredential(signer crypto.Signer, claims ApiContainerCredentialClaims) (string, error) {
+	claimsBytes, err := json.Marshal(claims)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the API container credential claims")
+	}
+	encodedClaims := base64.RawURLEncoding.EncodeToString(claimsBytes)
+
+	digest, hashFunc := getSignedDigest(signer.Public(), encodedClaims)
+	signature, err := signer.Sign(nil, digest, hashFunc)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred signing the API container credential")
+	}
+	return encodedClaims + apiContainerCredentialSeparator + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// VerifyApiContainerCredential checks that the credential was signed by the key of the engine certificate, and that
+// it's valid for the enclave at the given time
+func VerifyApiContainerCredential(credential string, engineCertificatePem string, enclaveUuid string, now time.Time) (*ApiContainerCredentialClaims, error) {
+	credentialParts := strings.Split(credential, apiContainerCredentialSeparator)
+	if len(credentialParts) != apiContainerCredentialNumParts {
+		return nil, stacktrace.NewError("Expected the API container credential to have '%v' parts but it has '%v'", apiContainerCredentialNumParts, len(credentialParts))
+	}
+	encodedClaims := credentialParts[0]
+	signature, err := base64.RawURLEncoding.DecodeString(credentialParts[1])
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding the signature of the API container credential")
+	}
+
+	pemBlock, _ := pem.Decode([]byte(engineCertificatePem))
+	if pemBlock == nil || pemBlock.Type != certificatePemBlockType {
+		return nil, stacktrace.NewError("No certificate could be parsed from the engine certificate PEM")
+	}
+	engineCertificate, err := x509.ParseCertificate(pemBlock.Bytes)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the engine certificate")
+	}
+	if err := verifySignature(engineCertificate.PublicKey, encodedClaims, signature); err != nil {
+		return nil, stacktrace.Propagate(err, "The API container credential wasn't signed by the engine")
+	}
+
+	claimsBytes, err := base64.RawURLEncoding.DecodeString(encodedClaims)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding the claims of the API container credential")
+	}
+	claims := &ApiContainerCredentialClaims{} //nolint:exhaustruct
+	if err := json.Unmarshal(claimsBytes, claims); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the claims of the API container credential")
+	}
+	if claims.EnclaveUUID != enclaveUuid {
+		return nil, stacktrace.NewError("The API container credential was issued for enclave '%v', not for enclave '%v'", claims.EnclaveUUID, enclaveUuid)
+	}
+	if !now.Before(time.Unix(claims.ExpiresAtUnix, 0)) {
+		return nil, stacktrace.NewError("The API container credential expired at '%v'", time.Unix(claims.ExpiresAtUnix, 0))
+	}
+	return claims, nil
+}
+
+// getSignedDigest returns what gets signed for the key type, Ed25519 signs the message itself rather than its hash
+func getSignedDigest(publicKey crypto.PublicKey, encodedClaims string) ([]byte, crypto.Hash) {
+	if _, isEd25519 := publicKey.(ed25519.PublicKey); isEd25519 {
+		return []byte(encodedClaims), crypto.Hash(0)
+	}
+	digest := sha256.Sum256([]byte(encodedClaims))
+	return digest[:], crypto.SHA256
+}
+
+func verifySignature(publicKey crypto.PublicKey, encodedClaims string, signature []byte) error {
+	digest, _ := getSignedDigest(publicKey, encodedClaims)
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest, signature) {
+			return stacktrace.NewError("Invalid ECDSA signature")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest, signature); err != nil {
+			return stacktrace.Propagate(err, "Invalid RSA signature")
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, digest, signature) {
+			return stacktrace.NewError("Invalid Ed25519 signature")
+		}
+	default:
+		return stacktrace.NewError("Unsupported engine certificate key type '%T'", publicKey)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package args
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid      = "enclave-uuid"
+	testOtherEnclaveUuid = "other-enclave-uuid"
+	testRsaKeyBits       = 2048
+)
+
+var testNow = time.Unix(1700000000, 0)
+
+func TestGetAuthPrincipalId_KindsDontCollide(t *testing.T) {
+	require.NotEqual(t, GetAuthPrincipalId(AuthPrincipalKind_Token, "alice"), GetAuthPrincipalId(AuthPrincipalKind_ClientCertificate, "alice"))
+}
+
+func TestApiContainerCredential_AllKeyTypes(t *testing.T) {
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, testRsaKeyBits)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	for _, signer := range []crypto.Signer{ecdsaKey, rsaKey, ed25519Key} {
+		certificatePem := generateTestCertificate(t, signer)
+		claims := ApiContainerCredentialClaims{
+			PrincipalId:   GetAuthPrincipalId(AuthPrincipalKind_ClientCertificate, "alice"),
+			Role:          ApiContainerAuthRole_EnclaveOwner,
+			EnclaveUUID:   testEnclaveUuid,
+			ExpiresAtUnix: testNow.Add(time.Hour).Unix(),
+		}
+		credential, err := SignApiContainerCredential(signer, claims)
+		require.NoError(t, err)
+
+		verifiedClaims, err := VerifyApiContainerCredential(credential, certificatePem, testEnclaveUuid, testNow)
+		require.NoError(t, err)
+		require.Equal(t, claims, *verifiedClaims)
+	}
+}
+
+func TestApiContainerCredential_Rejected(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	certificatePem := generateTestCertificate(t, key)
+
+	credential, err := SignApiContainerCredential(key, ApiContainerCredentialClaims{
+		PrincipalId:   GetAuthPrincipalId(AuthPrincipalKind_ClientCertificate, "alice"),
+		Role:          ApiContainerAuthRole_Admin,
+		EnclaveUUID:   testEnclaveUuid,
+		ExpiresAtUnix: testNow.Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	_, err = VerifyApiContainerCredential(credential, certificatePem, testOtherEnclaveUuid, testNow)
+	require.Error(t, err)
+	_, err = VerifyApiContainerCredential(credential, certificatePem, testEnclaveUuid, testNow.Add(2*time.Hour))
+	require.Error(t, err)
+	_, err = VerifyApiContainerCredential(credential, generateTestCertificate(t, otherKey), testEnclaveUuid, testNow)
+	require.Error(t, err)
+	_, err = VerifyApiContainerCredential("not-a-credential", certificatePem, testEnclaveUuid, testNow)
+	require.Error(t, err)
+}
+
+func generateTestCertificate(t *testing.T, signer crypto.Signer) string {
+	//nolint:exhaustruct
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "engine"},
+		NotBefore:    testNow.Add(-time.Hour),
+		NotAfter:     testNow.Add(time.Hour),
+	}
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: certificatePemBlockType, Headers: nil, Bytes: certificateBytes}))
+}
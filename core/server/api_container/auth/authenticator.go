@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+	bearerTokenPrefix        = "Bearer "
+
+	// The principal of the containers started by the API container, like the files artifacts expander
+	internalPrincipalId = "internal:api-container"
+)
+
+// Principal is an authenticated caller of the API container
+type Principal struct {
+	// See args.GetAuthPrincipalId
+	id   string
+	role args.ApiContainerAuthRole
+}
+
+func NewPrincipal(id string, role args.ApiContainerAuthRole) *Principal {
+	return &Principal{
+		id:   id,
+		role: role,
+	}
+}
+
+func (principal *Principal) GetId() string {
+	return principal.id
+}
+
+func (principal *Principal) GetRole() args.ApiContainerAuthRole {
+	return principal.role
+}
+
+// Authenticator identifies the callers of the API container, either by the engine token they send or by the
+// credential the engine issued to them
+type Authenticator struct {
+	enclaveUuid string
+
+	principalsByTokenHash map[string]*Principal
+
+	// Empty if the engine doesn't issue credentials
+	engineCertificate string
+}
+
+// NewAuthenticator also accepts the internal token, which the containers started by the API container use to read
+// from it
+func NewAuthenticator(config *args.ApiContainerAuthConfig, enclaveUuid string, internalToken string) *Authenticator {
+	principalsByTokenHash := map[string]*Principal{}
+	if internalToken != "" {
+		principalsByTokenHash[args.HashApiContainerAuthToken(internalToken)] = NewPrincipal(internalPrincipalId, args.ApiContainerAuthRole_ReadOnly)
+	}
+	for _, token := range config.Tokens {
+		principalId := args.GetAuthPrincipalId(args.AuthPrincipalKind_Token, token.Principal)
+		principalsByTokenHash[token.TokenSha256] = NewPrincipal(principalId, token.Role)
+	}
+
+	return &Authenticator{
+		enclaveUuid:           enclaveUuid,
+		principalsByTokenHash: principalsByTokenHash,
+		engineCertificate:     config.EngineCertificate,
+	}
+}
+
+func (authenticator *Authenticator) Authenticate(ctx context.Context) (*Principal, error) {
+	incomingMetadata, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return nil, stacktrace.NewError("No '%v' metadata was provided", authorizationMetadataKey)
+	}
+	authorizationValues := incomingMetadata.Get(authorizationMetadataKey)
+	if len(authorizationValues) == 0 {
+		return nil, stacktrace.NewError("No '%v' metadata was provided", authorizationMetadataKey)
+	}
+	if !strings.HasPrefix(authorizationValues[0], bearerTokenPrefix) {
+		return nil, stacktrace.NewError("The '%v' metadata must hold a bearer token", authorizationMetadataKey)
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(authorizationValues[0], bearerTokenPrefix))
+
+	if principal, found := authenticator.principalsByTokenHash[args.HashApiContainerAuthToken(token)]; found {
+		return principal, nil
+	}
+	if authenticator.engineCertificate == "" {
+		return nil, stacktrace.NewError("The provided token isn't valid")
+	}
+	claims, err := args.VerifyApiContainerCredential(token, authenticator.engineCertificate, authenticator.enclaveUuid, time.Now())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "The provided token is neither a valid token nor a valid credential issued by the engine")
+	}
+	return NewPrincipal(claims.PrincipalId, claims.Role), nil
+}
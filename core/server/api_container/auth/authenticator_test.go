@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	testEnclaveUuid      = "enclave-uuid"
+	testOtherEnclaveUuid = "other-enclave-uuid"
+
+	ownerToken    = "owner-token"
+	internalToken = "internal-token"
+)
+
+func TestAuthenticate_Tokens(t *testing.T) {
+	config := args.NewApiContainerAuthConfig(
+		[]args.ApiContainerAuthToken{
+			{Principal: ownerPrincipalName, Role: args.ApiContainerAuthRole_EnclaveOwner, TokenSha256: args.HashApiContainerAuthToken(ownerToken)},
+		},
+		"",
+		ownerPrincipalId,
+	)
+	authenticator := NewAuthenticator(config, testEnclaveUuid, internalToken)
+
+	principal, err := authenticator.Authenticate(newContextWithAuthorization(bearerTokenPrefix + ownerToken))
+	require.NoError(t, err)
+	require.Equal(t, ownerPrincipalId, principal.GetId())
+	require.Equal(t, args.ApiContainerAuthRole_EnclaveOwner, principal.GetRole())
+
+	principal, err = authenticator.Authenticate(newContextWithAuthorization(bearerTokenPrefix + internalToken))
+	require.NoError(t, err)
+	require.Equal(t, args.ApiContainerAuthRole_ReadOnly, principal.GetRole())
+
+	_, err = authenticator.Authenticate(newContextWithAuthorization(bearerTokenPrefix + "unknown-token"))
+	require.Error(t, err)
+	_, err = authenticator.Authenticate(newContextWithAuthorization(ownerToken))
+	require.Error(t, err)
+	_, err = authenticator.Authenticate(context.Background())
+	require.Error(t, err)
+}
+
+func TestAuthenticate_EngineIssuedCredential(t *testing.T) {
+	engineKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	//nolint:exhaustruct
+	certificateTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "engine"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplate, engineKey.Public(), engineKey)
+	require.NoError(t, err)
+	engineCertificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Headers: nil, Bytes: certificateBytes}))
+
+	certificatePrincipalId := args.GetAuthPrincipalId(args.AuthPrincipalKind_ClientCertificate, ownerPrincipalName)
+	config := args.NewApiContainerAuthConfig(nil, engineCertificate, certificatePrincipalId)
+	authenticator := NewAuthenticator(config, testEnclaveUuid, internalToken)
+
+	credential, err := args.SignApiContainerCredential(engineKey, args.ApiContainerCredentialClaims{
+		PrincipalId:   certificatePrincipalId,
+		Role:          args.ApiContainerAuthRole_EnclaveOwner,
+		EnclaveUUID:   testEnclaveUuid,
+		ExpiresAtUnix: time.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	principal, err := authenticator.Authenticate(newContextWithAuthorization(bearerTokenPrefix + credential))
+	require.NoError(t, err)
+	require.Equal(t, certificatePrincipalId, principal.GetId())
+
+	otherEnclaveCredential, err := args.SignApiContainerCredential(engineKey, args.ApiContainerCredentialClaims{
+		PrincipalId:   certificatePrincipalId,
+		Role:          args.ApiContainerAuthRole_EnclaveOwner,
+		EnclaveUUID:   testOtherEnclaveUuid,
+		ExpiresAtUnix: time.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	_, err = authenticator.Authenticate(newContextWithAuthorization(bearerTokenPrefix + otherEnclaveCredential))
+	require.Error(t, err)
+}
+
+func newContextWithAuthorization(authorization string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationMetadataKey, authorization))
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// The methods of the API container service that only read the enclave; the others require managing it
+var readMethods = map[string]bool{
+	"GetServices": true,
+	"GetExistingAndHistoricalServiceIdentifiers": true,
+	"WaitForHttpGetEndpointAvailability":         true,
+	"WaitForHttpPostEndpointAvailability":        true,
+	"DownloadFilesArtifact":                      true,
+	"ListFilesArtifactNamesAndUuids":             true,
+	"InspectFilesArtifactContents":               true,
+	"GetStarlarkRun":                             true,
+	"WatchStarlarkRunProgress":                   true,
+	"GetStarlarkScriptPlanYaml":                  true,
+	"GetStarlarkPackagePlanYaml":                 true,
+	"GetResolvedPackageDependencies":             true,
+}
+
+// The methods of the API container service that manage the enclave; every method must be in exactly one of these sets
+var manageMethods = map[string]bool{
+	"ExecCommand":                   true,
+	"ExecCommandStream":             true,
+	"StoreWebFilesArtifact":         true,
+	"StoreFilesArtifactFromService": true,
+	"CopyFilesArtifactToService":    true,
+	"UploadFilesArtifact":           true,
+	"ConnectServices":               true,
+	"RunStarlarkScript":             true,
+	"UploadStarlarkPackage":         true,
+	"RunStarlarkPackage":            true,
+}
+
+// Authorizer enforces the role of the authenticated principals, same as the engine does for the enclave it owns
+type Authorizer struct {
+	// Empty if the enclave has no owner, in which case only the admins can manage it
+	enclaveOwner string
+}
+
+func NewAuthorizer(config *args.ApiContainerAuthConfig) *Authorizer {
+	return &Authorizer{
+		enclaveOwner: config.EnclaveOwner,
+	}
+}
+
+// Authorize returns an error if the principal isn't allowed to call the method of the API container service
+func (authorizer *Authorizer) Authorize(principal *Principal, methodName string) error {
+	switch principal.GetRole() {
+	case args.ApiContainerAuthRole_Admin:
+		return nil
+	case args.ApiContainerAuthRole_ReadOnly:
+		if readMethods[methodName] {
+			return nil
+		}
+		return stacktrace.NewError("Principal '%v' has the '%v' role which only allows reading", principal.GetId(), principal.GetRole())
+	case args.ApiContainerAuthRole_EnclaveOwner:
+		if readMethods[methodName] {
+			return nil
+		}
+		if authorizer.enclaveOwner == "" || principal.GetId() != authorizer.enclaveOwner {
+			return stacktrace.NewError("Principal '%v' has the '%v' role and can't manage this enclave which it doesn't own", principal.GetId(), principal.GetRole())
+		}
+		return nil
+	}
+	return stacktrace.NewError("Principal '%v' has the unknown role '%v'", principal.GetId(), principal.GetRole())
+}
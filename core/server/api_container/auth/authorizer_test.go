@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ownerPrincipalName = "alice"
+	otherPrincipalName = "bob"
+
+	readMethod   = "GetServices"
+	manageMethod = "RunStarlarkPackage"
+)
+
+var ownerPrincipalId = args.GetAuthPrincipalId(args.AuthPrincipalKind_Token, ownerPrincipalName)
+
+func TestMethodsAreClassified(t *testing.T) {
+	methodNames := map[string]bool{}
+	for _, methodDesc := range kurtosis_core_rpc_api_bindings.ApiContainerService_ServiceDesc.Methods {
+		methodNames[methodDesc.MethodName] = true
+	}
+	for _, streamDesc := range kurtosis_core_rpc_api_bindings.ApiContainerService_ServiceDesc.Streams {
+		methodNames[streamDesc.StreamName] = true
+	}
+	for methodName := range methodNames {
+		require.NotEqual(t, readMethods[methodName], manageMethods[methodName], "Method '%v' of the API container service must be classified as either a read or a manage method", methodName)
+	}
+	for readMethodName := range readMethods {
+		require.True(t, methodNames[readMethodName], "Read method '%v' isn't a method of the API container service", readMethodName)
+	}
+	for manageMethodName := range manageMethods {
+		require.True(t, methodNames[manageMethodName], "Manage method '%v' isn't a method of the API container service", manageMethodName)
+	}
+}
+
+func TestAuthorize_Admin(t *testing.T) {
+	authorizer := NewAuthorizer(args.NewApiContainerAuthConfig(nil, "", ownerPrincipalId))
+	principal := NewPrincipal(args.GetAuthPrincipalId(args.AuthPrincipalKind_Token, otherPrincipalName), args.ApiContainerAuthRole_Admin)
+
+	require.NoError(t, authorizer.Authorize(principal, readMethod))
+	require.NoError(t, authorizer.Authorize(principal, manageMethod))
+}
+
+func TestAuthorize_ReadOnly(t *testing.T) {
+	authorizer := NewAuthorizer(args.NewApiContainerAuthConfig(nil, "", ownerPrincipalId))
+	principal := NewPrincipal(ownerPrincipalId, args.ApiContainerAuthRole_ReadOnly)
+
+	require.NoError(t, authorizer.Authorize(principal, readMethod))
+	require.Error(t, authorizer.Authorize(principal, manageMethod))
+}
+
+func TestAuthorize_EnclaveOwner(t *testing.T) {
+	authorizer := NewAuthorizer(args.NewApiContainerAuthConfig(nil, "", ownerPrincipalId))
+
+	owner := NewPrincipal(ownerPrincipalId, args.ApiContainerAuthRole_EnclaveOwner)
+	require.NoError(t, authorizer.Authorize(owner, readMethod))
+	require.NoError(t, authorizer.Authorize(owner, manageMethod))
+
+	other := NewPrincipal(args.GetAuthPrincipalId(args.AuthPrincipalKind_Token, otherPrincipalName), args.ApiContainerAuthRole_EnclaveOwner)
+	require.NoError(t, authorizer.Authorize(other, readMethod))
+	require.Error(t, authorizer.Authorize(other, manageMethod))
+
+	// A client certificate with the same common name as the owner token principal doesn't own the enclave
+	certificateNamesake := NewPrincipal(args.GetAuthPrincipalId(args.AuthPrincipalKind_ClientCertificate, ownerPrincipalName), args.ApiContainerAuthRole_EnclaveOwner)
+	require.Error(t, authorizer.Authorize(certificateNamesake, manageMethod))
+}
+
+func TestAuthorize_EnclaveOwnerOfUnownedEnclave(t *testing.T) {
+	authorizer := NewAuthorizer(args.NewApiContainerAuthConfig(nil, "", ""))
+	principal := NewPrincipal(ownerPrincipalId, args.ApiContainerAuthRole_EnclaveOwner)
+
+	require.NoError(t, authorizer.Authorize(principal, readMethod))
+	require.Error(t, authorizer.Authorize(principal, manageMethod))
+}
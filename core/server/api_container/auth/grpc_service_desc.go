@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewAuthenticatedServiceDesc returns a copy of the gRPC service description whose handlers authenticate and authorize
+// the calls before handing them to the service, as the gRPC server of the API container doesn't take interceptors
+func NewAuthenticatedServiceDesc(serviceDesc *grpc.ServiceDesc, authenticator *Authenticator, authorizer *Authorizer) *grpc.ServiceDesc {
+	result := *serviceDesc
+
+	result.Methods = make([]grpc.MethodDesc, len(serviceDesc.Methods))
+	for idx, methodDesc := range serviceDesc.Methods {
+		methodName := methodDesc.MethodName
+		handler := methodDesc.Handler
+		result.Methods[idx] = grpc.MethodDesc{
+			MethodName: methodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				if err := authenticateAndAuthorize(ctx, methodName, authenticator, authorizer); err != nil {
+					return nil, err
+				}
+				return handler(srv, ctx, dec, interceptor)
+			},
+		}
+	}
+
+	result.Streams = make([]grpc.StreamDesc, len(serviceDesc.Streams))
+	for idx, streamDesc := range serviceDesc.Streams {
+		streamName := streamDesc.StreamName
+		handler := streamDesc.Handler
+		result.Streams[idx] = grpc.StreamDesc{
+			StreamName: streamName,
+			Handler: func(srv interface{}, stream grpc.ServerStream) error {
+				if err := authenticateAndAuthorize(stream.Context(), streamName, authenticator, authorizer); err != nil {
+					return err
+				}
+				return handler(srv, stream)
+			},
+			ServerStreams: streamDesc.ServerStreams,
+			ClientStreams: streamDesc.ClientStreams,
+		}
+	}
+	return &result
+}
+
+func authenticateAndAuthorize(ctx context.Context, methodName string, authenticator *Authenticator, authorizer *Authorizer) error {
+	principal, err := authenticator.Authenticate(ctx)
+	if err != nil {
+		logrus.Debugf("Rejected an unauthenticated call to '%v':\n%v", methodName, err)
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := authorizer.Authorize(principal, methodName); err != nil {
+		logrus.Debugf("Rejected an unauthorized call to '%v':\n%v", methodName, err)
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
@@ -7,10 +7,13 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_run"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/git_package_content_provider"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/oci_package_content_provider"
 	"net"
 	"os"
 	"path"
@@ -21,12 +24,15 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
+	kubernetes_consts "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/service_exposure"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args/kurtosis_backend_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/auth"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
@@ -58,6 +64,8 @@ const (
 	emptyFunctionName         = ""
 
 	shouldFlushMetricsClientQueueOnEachEvent = false
+
+	internalAuthTokenNumBytes = 32
 )
 
 func main() {
@@ -136,6 +144,13 @@ func runMain() error {
 	githubAuthProvider := git_package_content_provider.NewGitHubPackageAuthProvider(githubAuthDirPath)
 	gitPackageContentProvider := git_package_content_provider.NewGitPackageContentProvider(repositoriesDirPath, tempDirectoriesDirPath, githubAuthProvider, enclaveDb)
 
+	ociArtifactsCacheDirpath, err := enclaveDataDir.GetOciArtifactsCacheDirpath()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the OCI artifacts cache directory path of the enclave data directory.")
+	}
+	registryCredentialsProvider := oci_package_content_provider.NewRegistryCredentialsProvider()
+	packageContentProvider := oci_package_content_provider.NewOciPackageContentProvider(gitPackageContentProvider, registryCredentialsProvider, ociArtifactsCacheDirpath)
+
 	// TODO Extract into own function
 	var kurtosisBackend backend_interface.KurtosisBackend
 	switch serverArgs.KurtosisBackendType {
@@ -162,7 +177,26 @@ func runMain() error {
 			)
 		}
 		// TODO wrap up APIContainerModeArgs if the parameter list keeps on going up (currently just IsProductionEnclave)
-		kurtosisBackend, err = kubernetes_kurtosis_backend.GetApiContainerBackend(ctx, clusterConfigK8s.StorageClass, serverArgs.IsProductionEnclave)
+		var serviceExposureConfig *service_exposure.ServiceExposureConfig
+		if clusterConfigK8s.ServiceExposure != nil {
+			serviceExposure := clusterConfigK8s.ServiceExposure
+			serviceExposureConfig, err = service_exposure.NewServiceExposureConfig(
+				service_exposure.ServiceExposureType(serviceExposure.Type),
+				serviceExposure.HostnameTemplate,
+				serviceExposure.IsHttps,
+				serviceExposure.IngressClassName,
+				serviceExposure.GatewayName,
+				serviceExposure.GatewayNamespace,
+			)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred creating the service exposure config from '%+v'", serviceExposure)
+			}
+		}
+		userServiceWorkloadType, err := kubernetes_consts.GetUserServiceWorkloadType(clusterConfigK8s.ServiceWorkload)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the user service workload type from '%v'", clusterConfigK8s.ServiceWorkload)
+		}
+		kurtosisBackend, err = kubernetes_kurtosis_backend.GetApiContainerBackend(ctx, clusterConfigK8s.StorageClass, serviceExposureConfig, userServiceWorkloadType, clusterConfigK8s.TaskHelperImage, serverArgs.IsProductionEnclave)
 		if err != nil {
 			return stacktrace.Propagate(
 				err,
@@ -173,7 +207,17 @@ func runMain() error {
 		return stacktrace.NewError("Backend type '%v' was not recognized by API container.", serverArgs.KurtosisBackendType.String())
 	}
 
-	serviceNetwork, err := createServiceNetwork(kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb)
+	// The containers the API container starts, like the files artifacts expander, authenticate to it with this token
+	internalAuthToken := ""
+	if serverArgs.AuthConfig != nil {
+		internalAuthTokenBytes := make([]byte, internalAuthTokenNumBytes)
+		if _, err := rand.Read(internalAuthTokenBytes); err != nil {
+			return stacktrace.Propagate(err, "An error occurred generating the internal auth token")
+		}
+		internalAuthToken = hex.EncodeToString(internalAuthTokenBytes)
+	}
+
+	serviceNetwork, err := createServiceNetwork(kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb, internalAuthToken)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the service network")
 	}
@@ -221,10 +265,10 @@ func runMain() error {
 	}
 
 	// TODO: Consolidate Interpreter, Validator and Executor into a single interface
-	startosisInterpreter := startosis_engine.NewStartosisInterpreter(serviceNetwork, gitPackageContentProvider, runtimeValueStore, starlarkValueSerde, serverArgs.EnclaveEnvVars, interpretationTimeValueStore)
+	startosisInterpreter := startosis_engine.NewStartosisInterpreter(serviceNetwork, packageContentProvider, runtimeValueStore, starlarkValueSerde, serverArgs.EnclaveEnvVars, interpretationTimeValueStore)
 	startosisRunner := startosis_engine.NewStartosisRunner(
 		startosisInterpreter,
-		startosis_engine.NewStartosisValidator(&kurtosisBackend, serviceNetwork, filesArtifactStore),
+		startosis_engine.NewStartosisValidator(&kurtosisBackend, serverArgs.KurtosisBackendType, serviceNetwork, filesArtifactStore, serverArgs.EnclaveResourceQuota),
 		startosis_engine.NewStartosisExecutor(starlarkValueSerde, runtimeValueStore, enclavePlan, enclaveDb))
 
 	starlarkRunRepository, err := starlark_run.GetOrCreateNewStarlarkRunRepository(enclaveDb)
@@ -242,10 +286,11 @@ func runMain() error {
 		serviceNetwork,
 		startosisRunner,
 		startosisInterpreter,
-		gitPackageContentProvider,
+		packageContentProvider,
 		restartPolicy,
 		metricsClient,
 		githubAuthProvider,
+		registryCredentialsProvider,
 		starlarkRunRepository,
 	)
 	if err != nil {
@@ -255,6 +300,16 @@ func runMain() error {
 	apiContainerServiceRegistrationFunc := func(grpcServer *grpc.Server) {
 		kurtosis_core_rpc_api_bindings.RegisterApiContainerServiceServer(grpcServer, apiContainerService)
 	}
+	if serverArgs.AuthConfig != nil {
+		authenticatedServiceDesc := auth.NewAuthenticatedServiceDesc(
+			&kurtosis_core_rpc_api_bindings.ApiContainerService_ServiceDesc,
+			auth.NewAuthenticator(serverArgs.AuthConfig, serverArgs.EnclaveUUID, internalAuthToken),
+			auth.NewAuthorizer(serverArgs.AuthConfig),
+		)
+		apiContainerServiceRegistrationFunc = func(grpcServer *grpc.Server) {
+			grpcServer.RegisterService(authenticatedServiceDesc, apiContainerService)
+		}
+	}
 	apiContainerServer := minimal_grpc_server.NewMinimalGRPCServer(
 		serverArgs.GrpcListenPortNum,
 		grpcServerStopGracePeriod,
@@ -277,6 +332,7 @@ func createServiceNetwork(
 	args *args.APIContainerArgs,
 	ownIpAddress net.IP,
 	enclaveDb *enclave_db.EnclaveDB,
+	internalAuthToken string,
 ) (service_network.ServiceNetwork, error) {
 	enclaveIdStr := args.EnclaveUUID
 	enclaveUuid := enclave.EnclaveUUID(enclaveIdStr)
@@ -285,6 +341,7 @@ func createServiceNetwork(
 		ownIpAddress,
 		args.GrpcListenPortNum,
 		args.Version,
+		internalAuthToken,
 	)
 
 	serviceNetwork, err := service_network.NewDefaultServiceNetwork(
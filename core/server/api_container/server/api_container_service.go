@@ -19,12 +19,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_run"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/git_package_content_provider"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/oci_package_content_provider"
 	"io"
 	"math"
 	"net/http"
 	"os"
 	"path"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
@@ -34,9 +36,12 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/oci_artifacts"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
@@ -85,6 +90,12 @@ var apiContainerPortProtoToPortSpecPortProto = map[kurtosis_core_rpc_api_binding
 	kurtosis_core_rpc_api_bindings.Port_UDP:  port_spec.TransportProtocol_UDP,
 }
 
+// credentials are dropped at the end of every run
+var (
+	noRegistryCredentials = map[string]*oci_artifacts.RegistryCredentials{}
+	noGitHostConfigs      = []*git_package_content_provider.GitHostConfig{}
+)
+
 type ApiContainerService struct {
 	filesArtifactStore *enclave_data_directory.FilesArtifactStore
 
@@ -101,6 +112,15 @@ type ApiContainerService struct {
 	metricsClient metrics_client.MetricsClient
 
 	githubAuthProvider *git_package_content_provider.GitHubPackageAuthProvider
+
+	registryCredentialsProvider *oci_package_content_provider.RegistryCredentialsProvider
+
+	// Starlark runs are serialized, so that the credentials sent along with a run, and the package lock and dependencies
+	// resolved during it, are only used by that run
+	starlarkRunMutex *sync.Mutex
+
+	// Publishes the progress of every run to the watchers of the enclave, as not all of them started the run
+	starlarkRunProgressBroker *starlarkRunProgressBroker
 }
 
 func NewApiContainerService(
@@ -112,6 +132,7 @@ func NewApiContainerService(
 	restartPolicy kurtosis_core_rpc_api_bindings.RestartPolicy,
 	metricsClient metrics_client.MetricsClient,
 	githubAuthProvider *git_package_content_provider.GitHubPackageAuthProvider,
+	registryCredentialsProvider *oci_package_content_provider.RegistryCredentialsProvider,
 	starlarkRunRepository *starlark_run.StarlarkRunRepository,
 ) (*ApiContainerService, error) {
 
@@ -120,14 +141,17 @@ func NewApiContainerService(
 	}
 
 	service := &ApiContainerService{
-		filesArtifactStore:     filesArtifactStore,
-		serviceNetwork:         serviceNetwork,
-		startosisRunner:        startosisRunner,
-		startosisInterpreter:   startosisInterpreter,
-		packageContentProvider: startosisModuleContentProvider,
-		starlarkRunRepository:  starlarkRunRepository,
-		metricsClient:          metricsClient,
-		githubAuthProvider:     githubAuthProvider,
+		filesArtifactStore:          filesArtifactStore,
+		serviceNetwork:              serviceNetwork,
+		startosisRunner:             startosisRunner,
+		startosisInterpreter:        startosisInterpreter,
+		packageContentProvider:      startosisModuleContentProvider,
+		starlarkRunRepository:       starlarkRunRepository,
+		metricsClient:               metricsClient,
+		githubAuthProvider:          githubAuthProvider,
+		registryCredentialsProvider: registryCredentialsProvider,
+		starlarkRunMutex:            &sync.Mutex{},
+		starlarkRunProgressBroker:   newStarlarkRunProgressBroker(),
 	}
 
 	return service, nil
@@ -152,6 +176,17 @@ func (apicService *ApiContainerService) RunStarlarkScript(args *kurtosis_core_rp
 		logrus.Warn("An error occurred tracking kurtosis run event")
 	}
 	noPackageReplaceOptions := map[string]string{}
+	apicService.starlarkRunMutex.Lock()
+	defer apicService.endStarlarkRun()
+	apicService.packageContentProvider.ResetPackageResolution()
+
+	if err := apicService.storeGitHostConfigs(args.GetGitHostConfigs()); err != nil {
+		if err = stream.SendMsg(binding_constructors.NewStarlarkExecutionError(err.Error())); err != nil {
+			return stacktrace.Propagate(err, "Error occurred providing the git host configs for the script")
+		}
+		return nil
+	}
+	apicService.storeRegistryCredentials(args.GetRegistryCredentials())
 
 	apicService.runStarlark(
 		int(parallelism),
@@ -306,6 +341,9 @@ func (apicService *ApiContainerService) RunStarlarkPackage(args *kurtosis_core_r
 	downloadMode := convertFromImageDownloadModeAPI(ApiDownloadMode)
 	nonBlockingMode := args.GetNonBlockingMode()
 
+	apicService.starlarkRunMutex.Lock()
+	defer apicService.endStarlarkRun()
+
 	packageGitHubAuthToken := args.GetGithubAuthToken()
 	if packageGitHubAuthToken != "" {
 		err := apicService.githubAuthProvider.StoreGitHubTokenForPackage(packageIdFromArgs, args.GetGithubAuthToken())
@@ -316,6 +354,14 @@ func (apicService *ApiContainerService) RunStarlarkPackage(args *kurtosis_core_r
 		}
 	}
 
+	if err := apicService.storeGitHostConfigs(args.GetGitHostConfigs()); err != nil {
+		if err = stream.SendMsg(binding_constructors.NewStarlarkExecutionError(err.Error())); err != nil {
+			return stacktrace.Propagate(err, "Error occurred providing the git host configs for package: '%s'", packageIdFromArgs)
+		}
+		return nil
+	}
+	apicService.storeRegistryCredentials(args.GetRegistryCredentials())
+
 	var actualRelativePathToMainFile string
 	if args.ClonePackage != nil {
 		scriptWithRunFunction, actualRelativePathToMainFile, detectedPackageId, detectedPackageReplaceOptions, interpretationError =
@@ -381,6 +427,44 @@ func (apicService *ApiContainerService) ExecCommand(ctx context.Context, args *k
 	return resp, nil
 }
 
+func (apicService *ApiContainerService) ExecCommandStream(stream kurtosis_core_rpc_api_bindings.ApiContainerService_ExecCommandStreamServer) error {
+	firstRequest, err := stream.Recv()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred receiving the request starting the exec command")
+	}
+	start := firstRequest.GetStart()
+	if start == nil {
+		return stacktrace.NewError("Expected the first exec command stream request to start the command, but got '%T' instead", firstRequest.GetRequest())
+	}
+	serviceIdentifier := start.GetServiceIdentifier()
+	command := start.GetCommandArgs()
+
+	stdinReader, stdinWriter := io.Pipe()
+	// unblocks any pending STDIN write once the command is over
+	defer stdinReader.Close()
+
+	var initialTerminalSize *interactive_exec.TerminalSize
+	if start.TerminalSize != nil {
+		initialTerminalSize = interactive_exec.NewTerminalSize(uint16(start.GetTerminalSize().GetWidth()), uint16(start.GetTerminalSize().GetHeight()))
+	}
+	terminalSizes := make(chan *interactive_exec.TerminalSize, maxPendingTerminalResizes)
+	go forwardExecCommandStreamRequests(stream, stdinWriter, terminalSizes)
+
+	sender := newExecCommandStreamSender(stream)
+	streams := interactive_exec.NewInteractiveExecStreams(stdinReader, sender.getStdoutWriter(), sender.getStderrWriter(), start.GetTty(), initialTerminalSize, terminalSizes)
+	exitCode, err := apicService.serviceNetwork.RunInteractiveExec(stream.Context(), serviceIdentifier, command, streams)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running exec command '%v' against service '%v' in the service network", command, serviceIdentifier)
+	}
+
+	if err = sender.send(&kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse{
+		Response: &kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse_ExitCode{ExitCode: exitCode},
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the exit code of exec command '%v' run against service '%v'", command, serviceIdentifier)
+	}
+	return nil
+}
+
 func (apicService *ApiContainerService) WaitForHttpGetEndpointAvailability(ctx context.Context, args *kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs) (*emptypb.Empty, error) {
 
 	serviceIdentifier := args.GetServiceIdentifier()
@@ -616,6 +700,28 @@ func (apicService *ApiContainerService) StoreFilesArtifactFromService(ctx contex
 	return response, nil
 }
 
+func (apicService *ApiContainerService) CopyFilesArtifactToService(ctx context.Context, args *kurtosis_core_rpc_api_bindings.CopyFilesArtifactToServiceArgs) (*emptypb.Empty, error) {
+	serviceIdentifier := args.ServiceIdentifier
+	filesArtifactIdentifier := args.FilesArtifactIdentifier
+	destPath := args.DestPath
+
+	if args.RemoveFilesArtifactAfterCopy {
+		defer func() {
+			if err := apicService.filesArtifactStore.RemoveFile(filesArtifactIdentifier); err != nil {
+				logrus.Errorf("We tried to remove files artifact '%v' once copied to service '%v' but failed:\n%v", filesArtifactIdentifier, serviceIdentifier, err)
+			}
+		}()
+	}
+
+	if !path.IsAbs(destPath) {
+		return nil, stacktrace.NewError("The destination path on service '%v' must be absolute but got '%v'", serviceIdentifier, destPath)
+	}
+	if err := apicService.serviceNetwork.CopyFilesArtifactToService(ctx, serviceIdentifier, filesArtifactIdentifier, destPath); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred copying files artifact '%v' to '%v' on service with identifier '%v'", filesArtifactIdentifier, destPath, serviceIdentifier)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (apicService *ApiContainerService) ListFilesArtifactNamesAndUuids(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse, error) {
 	filesArtifactsNamesAndUuids := apicService.filesArtifactStore.GetFileNamesAndUuids()
 	var filesArtifactNamesAndUuids []*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid
@@ -658,6 +764,21 @@ func (apicService *ApiContainerService) GetStarlarkRun(_ context.Context, _ *emp
 	return getStarlarkRunResponse, nil
 }
 
+func (apicService *ApiContainerService) WatchStarlarkRunProgress(_ *emptypb.Empty, stream kurtosis_core_rpc_api_bindings.ApiContainerService_WatchStarlarkRunProgressServer) error {
+	runProgressLines, unsubscribeFunc := apicService.starlarkRunProgressBroker.subscribe()
+	defer unsubscribeFunc()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case line := <-runProgressLines:
+			if err := stream.Send(line); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending the progress of a Starlark run to the watcher")
+			}
+		}
+	}
+}
+
 func (apicService *ApiContainerService) GetStarlarkPackagePlanYaml(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs) (*kurtosis_core_rpc_api_bindings.PlanYaml, error) {
 	packageIdFromArgs := args.GetPackageId()
 	serializedParams := args.GetSerializedParams()
@@ -669,6 +790,8 @@ func (apicService *ApiContainerService) GetStarlarkPackagePlanYaml(ctx context.C
 	var detectedPackageId string
 	var detectedPackageReplaceOptions map[string]string
 	var actualRelativePathToMainFile string
+	apicService.starlarkRunMutex.Lock()
+	defer apicService.starlarkRunMutex.Unlock()
 	scriptWithRunFunction, actualRelativePathToMainFile, detectedPackageId, detectedPackageReplaceOptions, interpretationError =
 		apicService.runStarlarkPackageSetup(packageIdFromArgs, true, nil, requestedRelativePathToMainFile)
 	if interpretationError != nil {
@@ -708,6 +831,9 @@ func (apicService *ApiContainerService) GetStarlarkScriptPlanYaml(ctx context.Co
 	serializedParams := args.GetSerializedParams()
 	mainFuncName := args.GetMainFunctionName()
 	noPackageReplaceOptions := map[string]string{}
+	apicService.starlarkRunMutex.Lock()
+	defer apicService.starlarkRunMutex.Unlock()
+	apicService.packageContentProvider.ResetPackageResolution()
 
 	_, instructionsPlan, apiInterpretationError := apicService.startosisInterpreter.Interpret(
 		ctx,
@@ -732,11 +858,62 @@ func (apicService *ApiContainerService) GetStarlarkScriptPlanYaml(ctx context.Co
 	return &kurtosis_core_rpc_api_bindings.PlanYaml{PlanYaml: planYamlStr}, nil
 }
 
+func (apicService *ApiContainerService) GetResolvedPackageDependencies(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetResolvedPackageDependenciesResponse, error) {
+	// the dependencies are the ones of the last run, once it's over
+	apicService.starlarkRunMutex.Lock()
+	defer apicService.starlarkRunMutex.Unlock()
+	resolvedPackages := apicService.packageContentProvider.GetResolvedPackages()
+	resolvedPackageDependencies := []*kurtosis_core_rpc_api_bindings.ResolvedPackageDependency{}
+	for repositoryLocator, resolvedPackage := range resolvedPackages {
+		resolvedPackageDependency := binding_constructors.NewResolvedPackageDependency(repositoryLocator, resolvedPackage.Version, resolvedPackage.Commit, resolvedPackage.Hash)
+		resolvedPackageDependencies = append(resolvedPackageDependencies, resolvedPackageDependency)
+	}
+	return binding_constructors.NewGetResolvedPackageDependenciesResponse(resolvedPackageDependencies), nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
 //
 // ====================================================================================================
+// storeGitHostConfigs replaces the git host configs of the previous run, so that the credentials sent along with a run
+// are only used by that run
+func (apicService *ApiContainerService) storeGitHostConfigs(gitHostConfigs []*kurtosis_core_rpc_api_bindings.GitHostConfig) error {
+	newGitHostConfigs := []*git_package_content_provider.GitHostConfig{}
+	for _, gitHostConfig := range gitHostConfigs {
+		newGitHostConfigs = append(newGitHostConfigs, git_package_content_provider.NewGitHostConfig(
+			gitHostConfig.GetHost(),
+			gitHostConfig.GetCloneUrlPrefix(),
+			gitHostConfig.GetUsername(),
+			gitHostConfig.GetPassword(),
+			gitHostConfig.GetSshPrivateKey(),
+			gitHostConfig.GetSshKnownHosts(),
+		))
+	}
+	if err := apicService.githubAuthProvider.ReplaceGitHostConfigs(newGitHostConfigs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing the git host configs")
+	}
+	return nil
+}
+
+// endStarlarkRun forgets the credentials sent along with the run that just finished and lets the next run start
+func (apicService *ApiContainerService) endStarlarkRun() {
+	defer apicService.starlarkRunMutex.Unlock()
+	apicService.registryCredentialsProvider.SetRegistryCredentials(noRegistryCredentials)
+	if err := apicService.githubAuthProvider.ReplaceGitHostConfigs(noGitHostConfigs); err != nil {
+		logrus.Errorf("An error occurred removing the git host configs of the Starlark run that just finished. They will be replaced by the ones of the next run. Error was:\n%v", err.Error())
+	}
+}
+
+// storeRegistryCredentials replaces the registry credentials of the previous run, they are only kept in memory
+func (apicService *ApiContainerService) storeRegistryCredentials(registryCredentials []*kurtosis_core_rpc_api_bindings.RegistryCredentials) {
+	credentialsByRegistry := map[string]*oci_artifacts.RegistryCredentials{}
+	for _, credentials := range registryCredentials {
+		credentialsByRegistry[credentials.GetRegistry()] = oci_artifacts.NewRegistryCredentials(credentials.GetUsername(), credentials.GetPassword())
+	}
+	apicService.registryCredentialsProvider.SetRegistryCredentials(credentialsByRegistry)
+}
+
 func transformPortSpecToApiPort(port *port_spec.PortSpec) (*kurtosis_core_rpc_api_bindings.Port, error) {
 	portNumUint16 := port.GetNumber()
 	portSpecProto := port.GetTransportProtocol()
@@ -906,6 +1083,9 @@ func (apicService *ApiContainerService) runStarlarkPackageSetup(
 	var packageRootPathOnDisk string
 	var interpretationError *startosis_errors.InterpretationError
 
+	// neither the lock nor the dependencies of a previously run package apply to this one
+	apicService.packageContentProvider.ResetPackageResolution()
+
 	if clonePackage {
 		packageRootPathOnDisk, interpretationError = apicService.packageContentProvider.ClonePackage(packageIdFromArgs)
 	} else if moduleContentIfLocal != nil {
@@ -921,6 +1101,16 @@ func (apicService *ApiContainerService) runStarlarkPackageSetup(
 		return "", "", "", nil, interpretationError
 	}
 
+	// If kurtosis.lock exists in root, the remote dependencies have to match it
+	candidatePackageLockAbsFilepath := path.Join(packageRootPathOnDisk, package_lock.LockFileName)
+	if _, err := os.Stat(candidatePackageLockAbsFilepath); err == nil {
+		packageLock, err := package_lock.ParsePackageLock(candidatePackageLockAbsFilepath)
+		if err != nil {
+			return "", "", "", nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred reading the '%v' of package '%v'", package_lock.LockFileName, packageIdFromArgs)
+		}
+		apicService.packageContentProvider.SetPackageLock(packageLock)
+	}
+
 	// If kurtosis.yml exists in root, treat as kurtosis package
 	candidateKurtosisYmlAbsFilepath := path.Join(packageRootPathOnDisk, startosis_constants.KurtosisYamlName)
 	if _, err := os.Stat(candidateKurtosisYmlAbsFilepath); err == nil {
@@ -991,6 +1181,10 @@ func (apicService *ApiContainerService) runStarlark(
 		case <-stream.Context().Done():
 			// TODO: maybe add the ability to kill the execution
 			logrus.Infof("Stream was closed by client. The script ouput won't be returned anymore but note that the execution won't be interrupted. There's currently no way to stop a Kurtosis script execution.")
+			// the run is over only once the runner has returned; until then, its output only goes to the watchers
+			for responseLine := range responseLineStream {
+				apicService.starlarkRunProgressBroker.publish(responseLine)
+			}
 			return
 		case responseLine, isChanOpen := <-responseLineStream:
 			if !isChanOpen {
@@ -1026,6 +1220,7 @@ func (apicService *ApiContainerService) runStarlark(
 			}
 			// in addition to send the msg to the RPC stream, we also print the lines to the APIC logs at debug level
 			logrus.Debugf("Received response line from Starlark runner: '%v'", responseLine)
+			apicService.starlarkRunProgressBroker.publish(responseLine)
 			if err := stream.SendMsg(responseLine); err != nil {
 				logrus.Errorf("Starlark response line sent through the channel but could not be forwarded to API Container client. Some log lines will not be returned to the user.\nResponse line was: \n%v. Error was: \n%v", responseLine, err.Error())
 			}
@@ -1083,6 +1278,19 @@ func getServiceInfoFromServiceObj(serviceObj *service.Service) (*kurtosis_core_r
 		EntrypointArgs: serviceContainer.GetEntrypointArgs(),
 		CmdArgs:        serviceContainer.GetCmdArgs(),
 		EnvVars:        serviceContainer.GetEnvVars(),
+		RestartCount:   serviceContainer.GetRestartCount(),
+	}
+
+	persistentDirectories := map[string]*kurtosis_core_rpc_api_bindings.PersistentDirectory{}
+	if serviceConfig := serviceObj.GetRegistration().GetConfig(); serviceConfig != nil && serviceConfig.GetPersistentDirectories() != nil {
+		for mountPath, persistentDirectory := range serviceConfig.GetPersistentDirectories().ServiceDirpathToPersistentDirectory {
+			persistentDirectories[mountPath] = binding_constructors.NewPersistentDirectory(
+				string(persistentDirectory.PersistentKey),
+				int64(persistentDirectory.Size),
+				persistentDirectory.StorageClass,
+				string(persistentDirectory.AccessMode),
+			)
+		}
 	}
 
 	serviceInfoResponse := binding_constructors.NewServiceInfo(
@@ -1095,6 +1303,8 @@ func getServiceInfoFromServiceObj(serviceObj *service.Service) (*kurtosis_core_r
 		publicApiPorts,
 		serviceStatus,
 		serviceInfoContainer,
+		persistentDirectories,
+		serviceObj.GetMaybePublicUrls(),
 	)
 	return serviceInfoResponse, nil
 }
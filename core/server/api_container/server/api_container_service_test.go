@@ -7,9 +7,13 @@ package server
 
 import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/git_package_content_provider"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/oci_package_content_provider"
 	"github.com/stretchr/testify/require"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -50,3 +54,23 @@ line
 	require.NotNil(t, output)
 	require.Equal(t, expectedOutput, *output)
 }
+
+func TestEndStarlarkRun_ForgetsTheCredentialsOfTheRun(t *testing.T) {
+	registryCredentialsProvider := oci_package_content_provider.NewRegistryCredentialsProvider()
+	apicService := &ApiContainerService{ //nolint:exhaustruct
+		githubAuthProvider:          git_package_content_provider.NewGitHubPackageAuthProvider(t.TempDir()),
+		registryCredentialsProvider: registryCredentialsProvider,
+		starlarkRunMutex:            &sync.Mutex{},
+	}
+
+	apicService.starlarkRunMutex.Lock()
+	apicService.storeRegistryCredentials([]*kurtosis_core_rpc_api_bindings.RegistryCredentials{
+		binding_constructors.NewRegistryCredentials("ghcr.io", "alice", "secret"),
+	})
+	require.Len(t, registryCredentialsProvider.GetRegistryCredentials(), 1)
+	require.False(t, apicService.starlarkRunMutex.TryLock(), "Another run must not start while a run is in progress")
+
+	apicService.endStarlarkRun()
+	require.Empty(t, registryCredentialsProvider.GetRegistryCredentials())
+	require.True(t, apicService.starlarkRunMutex.TryLock(), "The next run must be able to start once the run ended")
+}
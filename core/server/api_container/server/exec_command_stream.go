@@ -0,0 +1,138 @@
+package server
+
+import (
+	"io"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// resizes beyond this many pending ones are dropped, as only the latest size matters
+	maxPendingTerminalResizes = 16
+	// STDIN sent while the command doesn't read it is queued up to this many chunks; past that, the requests stop being
+	// received until the command catches up
+	maxPendingStdinChunks = 64
+)
+
+// execCommandStreamSender serializes the responses sent on an exec command stream, as the command's STDOUT and STDERR
+// can be written to concurrently while gRPC streams don't support concurrent sends
+type execCommandStreamSender struct {
+	mutex  *sync.Mutex
+	stream kurtosis_core_rpc_api_bindings.ApiContainerService_ExecCommandStreamServer
+}
+
+func newExecCommandStreamSender(stream kurtosis_core_rpc_api_bindings.ApiContainerService_ExecCommandStreamServer) *execCommandStreamSender {
+	return &execCommandStreamSender{
+		mutex:  &sync.Mutex{},
+		stream: stream,
+	}
+}
+
+func (sender *execCommandStreamSender) send(response *kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse) error {
+	sender.mutex.Lock()
+	defer sender.mutex.Unlock()
+	return sender.stream.Send(response)
+}
+
+func (sender *execCommandStreamSender) getStdoutWriter() io.Writer {
+	return &execCommandStreamWriter{
+		send: func(data []byte) error {
+			return sender.send(&kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse{
+				Response: &kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse_Stdout{Stdout: data},
+			})
+		},
+	}
+}
+
+func (sender *execCommandStreamSender) getStderrWriter() io.Writer {
+	return &execCommandStreamWriter{
+		send: func(data []byte) error {
+			return sender.send(&kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse{
+				Response: &kurtosis_core_rpc_api_bindings.ExecCommandStreamResponse_Stderr{Stderr: data},
+			})
+		},
+	}
+}
+
+type execCommandStreamWriter struct {
+	send func(data []byte) error
+}
+
+func (writer *execCommandStreamWriter) Write(data []byte) (int, error) {
+	// the caller is free to reuse the buffer once Write returns
+	dataCopy := make([]byte, len(data))
+	copy(dataCopy, data)
+	if err := writer.send(dataCopy); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// forwardExecCommandStreamRequests forwards the requests received after the start one to the running command until the
+// stream ends, closing the terminal sizes channel once it's done. STDIN is written by a separate goroutine, so that a
+// command not reading it doesn't hold back the resizes
+func forwardExecCommandStreamRequests(
+	stream kurtosis_core_rpc_api_bindings.ApiContainerService_ExecCommandStreamServer,
+	stdinWriter *io.PipeWriter,
+	terminalSizes chan<- *interactive_exec.TerminalSize,
+) {
+	defer close(terminalSizes)
+	stdinChunks := make(chan []byte, maxPendingStdinChunks)
+	go writeStdin(stdinWriter, stdinChunks)
+	isStdinClosed := false
+	// STDIN is closed once everything sent before has been written
+	closeStdin := func() {
+		if !isStdinClosed {
+			close(stdinChunks)
+			isStdinClosed = true
+		}
+	}
+	for {
+		request, err := stream.Recv()
+		if err != nil {
+			if err != io.EOF {
+				logrus.Debugf("Stopped receiving exec command stream requests: %v", err)
+			}
+			// the client is done sending, so the command won't get any more STDIN
+			closeStdin()
+			return
+		}
+		switch typedRequest := request.GetRequest().(type) {
+		case *kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest_Stdin:
+			if isStdinClosed {
+				logrus.Debugf("Dropping STDIN sent after STDIN was closed")
+				continue
+			}
+			stdinChunks <- typedRequest.Stdin
+		case *kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest_CloseStdin:
+			closeStdin()
+		case *kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest_Resize:
+			sendTerminalSizeWithoutBlocking(terminalSizes, typedRequest.Resize)
+		case *kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest_Start:
+			logrus.Warn("Ignoring exec command stream start request received after the command was already started")
+		default:
+			logrus.Warnf("Ignoring unknown exec command stream request of type '%T'", typedRequest)
+		}
+	}
+}
+
+// writeStdin writes the STDIN chunks to the command in order, closing its STDIN once there are no more
+func writeStdin(stdinWriter *io.PipeWriter, stdinChunks <-chan []byte) {
+	defer stdinWriter.Close()
+	for stdinChunk := range stdinChunks {
+		if _, err := stdinWriter.Write(stdinChunk); err != nil {
+			logrus.Debugf("Dropping STDIN sent to a command that doesn't read it anymore: %v", err)
+		}
+	}
+}
+
+func sendTerminalSizeWithoutBlocking(terminalSizes chan<- *interactive_exec.TerminalSize, size *kurtosis_core_rpc_api_bindings.TerminalSize) {
+	select {
+	case terminalSizes <- interactive_exec.NewTerminalSize(uint16(size.GetWidth()), uint16(size.GetHeight())):
+	default:
+		logrus.Debugf("Dropping terminal resize to %vx%v as too many resizes are pending", size.GetWidth(), size.GetHeight())
+	}
+}
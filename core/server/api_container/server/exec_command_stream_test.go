@@ -0,0 +1,112 @@
+package server
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	execCommandStreamTestTimeout = 5 * time.Second
+)
+
+// fakeExecCommandStream only implements receiving requests, which is all forwardExecCommandStreamRequests uses
+type fakeExecCommandStream struct {
+	kurtosis_core_rpc_api_bindings.ApiContainerService_ExecCommandStreamServer
+
+	requests chan *kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest
+}
+
+func (stream *fakeExecCommandStream) Recv() (*kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest, error) {
+	request, isChanOpen := <-stream.requests
+	if !isChanOpen {
+		return nil, io.EOF
+	}
+	return request, nil
+}
+
+func startForwardingExecCommandStreamRequests() (chan *kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest, *io.PipeReader, chan *interactive_exec.TerminalSize) {
+	requests := make(chan *kurtosis_core_rpc_api_bindings.ExecCommandStreamRequest)
+	stream := &fakeExecCommandStream{ //nolint:exhaustruct
+		requests: requests,
+	}
+	stdinReader, stdinWriter := io.Pipe()
+	terminalSizes := make(chan *interactive_exec.TerminalSize, maxPendingTerminalResizes)
+	go forwardExecCommandStreamRequests(stream, stdinWriter, terminalSizes)
+	return requests, stdinReader, terminalSizes
+}
+
+func readAllStdin(t *testing.T, stdinReader io.Reader) string {
+	stdinRead := make(chan string)
+	go func() {
+		data, err := io.ReadAll(stdinReader)
+		require.NoError(t, err)
+		stdinRead <- string(data)
+	}()
+	select {
+	case data := <-stdinRead:
+		return data
+	case <-time.After(execCommandStreamTestTimeout):
+		require.FailNow(t, "STDIN wasn't closed")
+		return ""
+	}
+}
+
+func TestForwardExecCommandStreamRequests_ForwardsStdinInOrder(t *testing.T) {
+	requests, stdinReader, _ := startForwardingExecCommandStreamRequests()
+
+	requests <- binding_constructors.NewExecCommandStreamStdinRequest([]byte("hello "))
+	requests <- binding_constructors.NewExecCommandStreamStdinRequest([]byte("world"))
+	requests <- binding_constructors.NewExecCommandStreamCloseStdinRequest()
+
+	require.Equal(t, "hello world", readAllStdin(t, stdinReader))
+	close(requests)
+}
+
+func TestForwardExecCommandStreamRequests_ResizesWhileStdinIsNotRead(t *testing.T) {
+	requests, stdinReader, terminalSizes := startForwardingExecCommandStreamRequests()
+	defer stdinReader.Close()
+
+	// nothing reads STDIN yet, this must not hold back the resize
+	requests <- binding_constructors.NewExecCommandStreamStdinRequest([]byte("pending"))
+	requests <- binding_constructors.NewExecCommandStreamResizeRequest(binding_constructors.NewTerminalSize(120, 40))
+
+	select {
+	case terminalSize := <-terminalSizes:
+		require.Equal(t, interactive_exec.NewTerminalSize(120, 40), terminalSize)
+	case <-time.After(execCommandStreamTestTimeout):
+		require.FailNow(t, "The resize was held back by the pending STDIN")
+	}
+	close(requests)
+}
+
+func TestForwardExecCommandStreamRequests_ClosesStdinAfterPendingStdin(t *testing.T) {
+	requests, stdinReader, terminalSizes := startForwardingExecCommandStreamRequests()
+
+	requests <- binding_constructors.NewExecCommandStreamStdinRequest([]byte("before close"))
+	requests <- binding_constructors.NewExecCommandStreamCloseStdinRequest()
+	requests <- binding_constructors.NewExecCommandStreamStdinRequest([]byte("after close"))
+	close(requests)
+
+	require.Equal(t, "before close", readAllStdin(t, stdinReader))
+	select {
+	case _, isChanOpen := <-terminalSizes:
+		require.False(t, isChanOpen, "No resize was sent")
+	case <-time.After(execCommandStreamTestTimeout):
+		require.FailNow(t, "The terminal sizes channel wasn't closed at the end of the stream")
+	}
+}
+
+func TestForwardExecCommandStreamRequests_ClosesStdinAtTheEndOfTheStream(t *testing.T) {
+	requests, stdinReader, _ := startForwardingExecCommandStreamRequests()
+
+	requests <- binding_constructors.NewExecCommandStreamStdinRequest([]byte("last words"))
+	close(requests)
+
+	require.Equal(t, "last words", readAllStdin(t, stdinReader))
+}
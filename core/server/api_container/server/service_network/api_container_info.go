@@ -8,17 +8,23 @@ type ApiContainerInfo struct {
 	grpcPortNum uint16
 
 	version string
+
+	// Token the containers started by the API container, like the files artifacts expander, authenticate to it with;
+	// empty if the API container doesn't require authentication
+	authToken string
 }
 
 func NewApiContainerInfo(
 	ipAddress net.IP,
 	grpcPortNum uint16,
 	version string,
+	authToken string,
 ) *ApiContainerInfo {
 	return &ApiContainerInfo{
 		ipAddress:   ipAddress,
 		grpcPortNum: grpcPortNum,
 		version:     version,
+		authToken:   authToken,
 	}
 }
 
@@ -33,3 +39,7 @@ func (apic *ApiContainerInfo) GetGrpcPortNum() uint16 {
 func (apic *ApiContainerInfo) GetVersion() string {
 	return apic.version
 }
+
+func (apic *ApiContainerInfo) GetAuthToken() string {
+	return apic.authToken
+}
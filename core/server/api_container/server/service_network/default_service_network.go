@@ -6,11 +6,13 @@
 package service_network
 
 import (
+	"archive/tar"
 	"bytes"
 	"compress/gzip"
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
@@ -29,6 +31,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/store_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
@@ -41,6 +44,9 @@ const (
 	defaultMemoryAllocMegabytes uint64 = 0
 
 	tempDirForRenderedTemplatesPrefix = "temp-dir-for-rendered-templates-"
+	tempDirForTaskFilesPrefix         = "temp-dir-for-task-files-"
+
+	successfulTaskExitCode = 0
 
 	enforceMaxFileSizeLimit = false
 
@@ -54,6 +60,9 @@ const (
 
 	publicPortsSuffix = "-public"
 
+	httpScheme  = "http"
+	httpsScheme = "https"
+
 	serviceLogsHeader = "== SERVICE '%s' LOGS ==================================="
 	serviceLogsFooter = "== FINISHED SERVICE '%s' LOGS ==================================="
 
@@ -560,6 +569,25 @@ func (network *DefaultServiceNetwork) StopServices(
 	return successfulUuids, erroredUuids, nil
 }
 
+func (network *DefaultServiceNetwork) RunInteractiveExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string, streams *interactive_exec.InteractiveExecStreams) (int32, error) {
+	// the lock is only held while resolving the service, as interactive sessions can stay open for a long time
+	network.mutex.Lock()
+	serviceRegistration, err := network.getServiceRegistrationForIdentifierUnlocked(serviceIdentifier)
+	network.mutex.Unlock()
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred while getting service registration for identifier '%v'", serviceIdentifier)
+	}
+	if serviceRegistration.GetStatus() != service.ServiceStatus_Started {
+		return 0, stacktrace.NewError("Cannot run exec command '%v' on service '%v' because it isn't running", userServiceCommand, serviceRegistration.GetName())
+	}
+
+	exitCode, err := network.kurtosisBackend.RunUserServiceInteractiveExecCommand(ctx, network.enclaveUuid, serviceRegistration.GetUUID(), userServiceCommand, streams)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred running interactive exec command '%v' against service '%v'", userServiceCommand, serviceIdentifier)
+	}
+	return exitCode, nil
+}
+
 func (network *DefaultServiceNetwork) RunExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string) (*exec_result.ExecResult, error) {
 	// NOTE: This will block all other operations while this command is running!!!! We might need to change this so it's
 	// asynchronous
@@ -628,8 +656,82 @@ func (network *DefaultServiceNetwork) RunExecs(ctx context.Context, userServiceC
 	return successfulExecs, failedExecs, nil
 }
 
+func (network *DefaultServiceNetwork) RunTask(
+	ctx context.Context,
+	taskName service.ServiceName,
+	taskConfig *service.ServiceConfig,
+	storeSpecs []*store_spec.StoreSpec,
+	shouldRemoveTaskInBackground bool,
+) (*exec_result.ExecResult, error) {
+	taskUuidStr, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating a UUID for task '%v'", taskName)
+	}
+	taskUuid := service.ServiceUUID(taskUuidStr)
+
+	// The task is tracked like a service identifier so that its logs can be fetched by name once it's gone
+	if err := network.addServiceIdentifier(taskUuid, taskName); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding the identifier of task '%v' into the repository", taskName)
+	}
+
+	tempDirForTaskFiles, err := os.MkdirTemp("", tempDirForTaskFilesPrefix)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a temporary directory to store the files of task '%v'", taskName)
+	}
+	defer os.RemoveAll(tempDirForTaskFiles)
+
+	// NOTE: the task runs without holding the network lock, as it can take as long as it wants
+	filesToStore := map[string]io.Writer{}
+	taskFilepathsByArtifactName := map[string]string{}
+	var taskFilesWriteClosers []io.Closer
+	closeTaskFilesWriters := func() error {
+		var closeErr error
+		for _, writeCloser := range taskFilesWriteClosers {
+			if err := writeCloser.Close(); err != nil && closeErr == nil {
+				closeErr = err
+			}
+		}
+		taskFilesWriteClosers = nil
+		return closeErr
+	}
+	defer closeTaskFilesWriters()
+	for _, storeSpec := range storeSpecs {
+		taskFile, err := os.CreateTemp(tempDirForTaskFiles, "")
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a temporary file to store the files at '%v' of task '%v'", storeSpec.GetSrc(), taskName)
+		}
+		// Need to compress the TAR bytes on our side, since we're not guaranteed that gzip exists in the task container
+		gzippingTaskFile := gzip.NewWriter(taskFile)
+		taskFilesWriteClosers = append(taskFilesWriteClosers, gzippingTaskFile, taskFile)
+		filesToStore[storeSpec.GetSrc()] = gzippingTaskFile
+		taskFilepathsByArtifactName[storeSpec.GetName()] = taskFile.Name()
+	}
+
+	execResult, err := network.kurtosisBackend.RunUserTask(ctx, network.enclaveUuid, taskUuid, taskName, taskConfig, filesToStore, shouldRemoveTaskInBackground)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred running task '%v'", taskName)
+	}
+	if err := closeTaskFilesWriters(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred flushing the files copied from task '%v'", taskName)
+	}
+	if execResult.GetExitCode() != successfulTaskExitCode {
+		return execResult, nil
+	}
+
+	for artifactName, taskFilepath := range taskFilepathsByArtifactName {
+		if err := network.storeTaskFilesArtifact(artifactName, taskFilepath); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred storing files artifact '%v' from task '%v'", artifactName, taskName)
+		}
+	}
+	return execResult, nil
+}
+
 func (network *DefaultServiceNetwork) HttpRequestService(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string, headers map[string]string) (*http.Response, error) {
-	logrus.Debugf("Making a request '%v' '%v' '%v' '%v' '%v' '%v'", serviceIdentifier, portId, method, contentType, endpoint, body)
+	return network.HttpRequestServiceWithOptions(ctx, serviceIdentifier, portId, method, contentType, endpoint, body, headers, NewDefaultHttpRequestOptions())
+}
+
+func (network *DefaultServiceNetwork) HttpRequestServiceWithOptions(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string, headers map[string]string, options *HttpRequestOptions) (*http.Response, error) {
+	logrus.Debugf("Making a request '%v' '%v' '%v' '%v' '%v' '%v' with options '%+v'", serviceIdentifier, portId, method, contentType, endpoint, body, options)
 	userService, getServiceErr := network.GetService(ctx, serviceIdentifier)
 	if getServiceErr != nil {
 		return nil, stacktrace.Propagate(getServiceErr, "An error occurred when getting service '%v' for HTTP request", serviceIdentifier)
@@ -638,7 +740,11 @@ func (network *DefaultServiceNetwork) HttpRequestService(ctx context.Context, se
 	if !found {
 		return nil, stacktrace.NewError("An error occurred when getting port '%v' from service '%v' for HTTP request", serviceIdentifier, portId)
 	}
-	url := fmt.Sprintf("http://%v:%v%v", userService.GetRegistration().GetPrivateIP(), port.GetNumber(), endpoint)
+	scheme := httpScheme
+	if options.UseTls {
+		scheme = httpsScheme
+	}
+	url := fmt.Sprintf("%v://%v:%v%v", scheme, userService.GetRegistration().GetPrivateIP(), port.GetNumber(), endpoint)
 	req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
 	if err != nil {
 		return nil, stacktrace.NewError("An error occurred building HTTP request on service '%v', URL '%v'", userService, url)
@@ -649,7 +755,11 @@ func (network *DefaultServiceNetwork) HttpRequestService(ctx context.Context, se
 	if contentType != "" {
 		req.Header.Set("Content-Type", contentType)
 	}
-	resp, err := http.DefaultClient.Do(req)
+	client, err := newHttpRequestClient(userService.GetRegistration().GetHostname(), options)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building the HTTP client for the request on service '%v'", serviceIdentifier)
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred on HTTP request on service '%v', URL '%v'", userService, url)
 	}
@@ -689,6 +799,7 @@ func (network *DefaultServiceNetwork) GetServices(ctx context.Context) (map[serv
 			return nil, stacktrace.NewError("couldn't find service with uuid '%v' and name '%v' in backend", uuid, name)
 		}
 		serviceObj.GetRegistration().SetStatus(registration.GetStatus())
+		serviceObj.GetRegistration().SetConfig(registration.GetConfig())
 		filteredServicesToRegisteredServices[uuid] = serviceObj
 	}
 
@@ -732,8 +843,9 @@ func (network *DefaultServiceNetwork) GetService(ctx context.Context, serviceIde
 		return nil, stacktrace.NewError("Found exactly one service object, but it didn't match expected UUID '%v'", serviceUuid)
 	}
 
-	// The service status is managed at the service network layer so we copy it to the response
+	// The service status and config are managed at the service network layer so we copy them to the response
 	serviceObj.GetRegistration().SetStatus(serviceRegistration.GetStatus())
+	serviceObj.GetRegistration().SetConfig(serviceRegistration.GetConfig())
 	return serviceObj, nil
 }
 
@@ -760,6 +872,95 @@ func (network *DefaultServiceNetwork) CopyFilesFromService(ctx context.Context,
 	return filesArtifactUuid, nil
 }
 
+func (network *DefaultServiceNetwork) CopyFilesArtifactToService(ctx context.Context, serviceIdentifier string, filesArtifactIdentifier string, destDirpath string) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceRegistration, err := network.getServiceRegistrationForIdentifierUnlocked(serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while fetching registration for service identifier '%v'", serviceIdentifier)
+	}
+	if serviceRegistration.GetStatus() != service.ServiceStatus_Started {
+		return stacktrace.NewError("Cannot copy files to service '%v' because it isn't running", serviceRegistration.GetName())
+	}
+
+	store, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the files artifact store")
+	}
+	_, filesArtifact, _, found, err := store.GetFile(filesArtifactIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", filesArtifactIdentifier)
+	}
+	if !found {
+		return stacktrace.NewError("Files artifact '%v' doesn't exist in the enclave", filesArtifactIdentifier)
+	}
+
+	filesArtifactFile, err := os.Open(filesArtifact.GetAbsoluteFilepath())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening files artifact '%v'", filesArtifactIdentifier)
+	}
+	defer filesArtifactFile.Close()
+
+	// files artifacts are stored as .tgz while the backend expects a plain TAR stream
+	tarReader, err := gzip.NewReader(filesArtifactFile)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred decompressing files artifact '%v'", filesArtifactIdentifier)
+	}
+	defer tarReader.Close()
+
+	if err = network.kurtosisBackend.CopyFilesToUserService(ctx, network.enclaveUuid, serviceRegistration.GetUUID(), destDirpath, tarReader); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying files artifact '%v' to '%v' on service '%v'", filesArtifactIdentifier, destDirpath, serviceRegistration.GetName())
+	}
+	return nil
+}
+
+func (network *DefaultServiceNetwork) GetFilesArtifactContents(filesArtifactIdentifier string) (map[string][]byte, error) {
+	store, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the files artifact store")
+	}
+	_, filesArtifact, _, found, err := store.GetFile(filesArtifactIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", filesArtifactIdentifier)
+	}
+	if !found {
+		return nil, stacktrace.NewError("Files artifact '%v' doesn't exist in the enclave", filesArtifactIdentifier)
+	}
+
+	filesArtifactFile, err := os.Open(filesArtifact.GetAbsoluteFilepath())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening files artifact '%v'", filesArtifactIdentifier)
+	}
+	defer filesArtifactFile.Close()
+	gzipReader, err := gzip.NewReader(filesArtifactFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decompressing files artifact '%v'", filesArtifactIdentifier)
+	}
+	defer gzipReader.Close()
+
+	contents := map[string][]byte{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading files artifact '%v'", filesArtifactIdentifier)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading file '%v' of files artifact '%v'", header.Name, filesArtifactIdentifier)
+		}
+		contents[strings.TrimPrefix(path.Clean(header.Name), "/")] = content
+	}
+	return contents, nil
+}
+
 func (network *DefaultServiceNetwork) ExistServiceRegistration(serviceName service.ServiceName) (bool, error) {
 	network.mutex.Lock()
 	defer network.mutex.Unlock()
@@ -1194,6 +1395,44 @@ func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.C
 	return storeFileResult.filesArtifactUuid, nil
 }
 
+func (network *DefaultServiceNetwork) addServiceIdentifier(serviceUuid service.ServiceUUID, serviceName service.ServiceName) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	return network.serviceIdentifiersRepository.AddServiceIdentifier(service_identifiers.NewServiceIdentifier(serviceUuid, serviceName))
+}
+
+// storeTaskFilesArtifact stores the gzipped TAR at the given path as the files artifact, replacing its content if it
+// already exists
+func (network *DefaultServiceNetwork) storeTaskFilesArtifact(artifactName string, tgzFilepath string) error {
+	store, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the files artifact store")
+	}
+
+	tgzFile, err := os.Open(tgzFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening file '%v'", tgzFilepath)
+	}
+	defer tgzFile.Close()
+
+	existingFilesArtifactUuid, _, _, filesArtifactAlreadyExists, err := store.GetFile(artifactName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An unexpected error occurred checking for file artifact '%s' existence in the store", artifactName)
+	}
+	// As for files stored from services, the hash is left empty and the files get re-stored every time
+	if filesArtifactAlreadyExists {
+		if err := store.UpdateFile(existingFilesArtifactUuid, tgzFile, []byte{}); err != nil {
+			return stacktrace.Propagate(err, "An error occurred updating files artifact '%v'", artifactName)
+		}
+		return nil
+	}
+	if _, err := store.StoreFile(tgzFile, []byte{}, artifactName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing files artifact '%v'", artifactName)
+	}
+	return nil
+}
+
 func (network *DefaultServiceNetwork) gzipAndPushTarredFileBytesToOutput(
 	ctx context.Context,
 	output io.WriteCloser,
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"io"
 	"net"
 	"net/netip"
 	"os"
@@ -21,8 +22,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/store_spec"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/pkg/errors"
@@ -52,6 +56,7 @@ var (
 		testIpFromInt(0),
 		uint16(1234),
 		"0.0.0",
+		"",
 	)
 	unusedEnclaveDataDir *enclave_data_directory.EnclaveDataDirectory
 
@@ -67,7 +72,7 @@ func TestAddService_Successful(t *testing.T) {
 	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
 	successfulServiceIp := testIpFromInt(serviceInternalTestId)
 	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, successfulServiceIp, string(serviceName))
-	serviceObj := service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+	serviceObj := service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, nil, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil, 0))
 	serviceConfig := testServiceConfig(t, testContainerImageName)
 
 	file, err := os.CreateTemp("/tmp", "*.db")
@@ -242,7 +247,7 @@ func TestAddServices_Success(t *testing.T) {
 	successfulServiceUuid := testServiceUuidFromInt(successfulServiceIndex)
 	successfulServiceIp := testIpFromInt(successfulServiceIndex)
 	successfulServiceRegistration := service.NewServiceRegistration(successfulServiceName, successfulServiceUuid, enclaveName, successfulServiceIp, string(successfulServiceName))
-	successfulService := service.NewService(successfulServiceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+	successfulService := service.NewService(successfulServiceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, nil, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil, 0))
 	successfulServiceConfig := testServiceConfig(t, testContainerImageName)
 
 	file, err := os.CreateTemp("/tmp", "*.db")
@@ -327,7 +332,7 @@ func TestAddServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 	successfulServiceUuid := testServiceUuidFromInt(successfulServiceIndex)
 	successfulServiceIp := testIpFromInt(successfulServiceIndex)
 	successfulServiceRegistration := service.NewServiceRegistration(successfulServiceName, successfulServiceUuid, enclaveName, successfulServiceIp, string(successfulServiceName))
-	successfulService := service.NewService(successfulServiceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+	successfulService := service.NewService(successfulServiceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, nil, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil, 0))
 	successfulServiceConfig := testServiceConfig(t, testContainerImageName)
 
 	// One service will fail to be started
@@ -701,6 +706,169 @@ func TestStopService_ServiceAlreadyStopped(t *testing.T) {
 	require.Equal(t, serviceRegistrationAfterBeingStopped.GetStatus(), service.ServiceStatus_Stopped)
 }
 
+func TestCopyFilesArtifactToService_ServiceNotRunning(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	successfulServiceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, successfulServiceIp, string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Stopped)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+	)
+	require.Nil(t, err)
+	err = network.serviceRegistrationRepository.Save(serviceRegistration)
+	require.NoError(t, err)
+
+	// the backend mock has no expectations set, so any call to CopyFilesToUserService would fail the test
+	err = network.CopyFilesArtifactToService(ctx, string(serviceName), "some-artifact", "/tmp/dest")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "isn't running")
+}
+
+func TestRunInteractiveExec_Successful(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	successfulServiceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, successfulServiceIp, string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Started)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+	)
+	require.Nil(t, err)
+	err = network.serviceRegistrationRepository.Save(serviceRegistration)
+	require.NoError(t, err)
+
+	command := []string{"sh"}
+	streams := interactive_exec.NewInteractiveExecStreams(nil, io.Discard, io.Discard, true, interactive_exec.NewTerminalSize(80, 24), nil)
+	expectedExitCode := int32(130)
+	backend.EXPECT().RunUserServiceInteractiveExecCommand(ctx, enclaveName, serviceUuid, command, streams).Times(1).Return(expectedExitCode, nil)
+
+	exitCode, err := network.RunInteractiveExec(ctx, string(serviceName), command, streams)
+	require.NoError(t, err)
+	require.Equal(t, expectedExitCode, exitCode)
+}
+
+func TestRunInteractiveExec_ServiceNotRunning(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	successfulServiceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, successfulServiceIp, string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Stopped)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+	)
+	require.Nil(t, err)
+	err = network.serviceRegistrationRepository.Save(serviceRegistration)
+	require.NoError(t, err)
+
+	// the backend mock has no expectations set, so any call to RunUserServiceInteractiveExecCommand would fail the test
+	streams := interactive_exec.NewInteractiveExecStreams(nil, io.Discard, io.Discard, false, nil, nil)
+	_, err = network.RunInteractiveExec(ctx, string(serviceName), []string{"sh"}, streams)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "isn't running")
+}
+
+func TestRunTask_FailedTaskDoesNotStoreFiles(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	taskName := service.ServiceName("task-1")
+	taskConfig := testServiceConfig(t, testContainerImageName)
+	storeSpecs := []*store_spec.StoreSpec{
+		store_spec.NewStoreSpec("/output", "output-artifact"),
+	}
+	failedTaskResult := exec_result.NewExecResult(1, "something went wrong")
+	backend.EXPECT().RunUserTask(ctx, enclaveName, mock.Anything, taskName, taskConfig, mock.Anything, false).RunAndReturn(
+		func(_ context.Context, _ enclave.EnclaveUUID, _ service.ServiceUUID, _ service.ServiceName, _ *service.ServiceConfig, filesToStore map[string]io.Writer, _ bool) (*exec_result.ExecResult, error) {
+			require.Len(t, filesToStore, 1)
+			require.Contains(t, filesToStore, "/output")
+			return failedTaskResult, nil
+		}).Times(1)
+
+	// the files artifact store isn't touched, as the enclave data directory of this network is unusable
+	execResult, err := network.RunTask(ctx, taskName, taskConfig, storeSpecs, false)
+	require.NoError(t, err)
+	require.Equal(t, failedTaskResult, execResult)
+
+	serviceIdentifiers, err := network.GetExistingAndHistoricalServiceIdentifiers()
+	require.NoError(t, err)
+	require.Len(t, serviceIdentifiers, 1)
+	require.Equal(t, taskName, serviceIdentifiers[0].GetName())
+
+	// the task isn't registered as a service
+	serviceRegistrations, err := network.serviceRegistrationRepository.GetAll()
+	require.NoError(t, err)
+	require.Empty(t, serviceRegistrations)
+}
+
 func TestStartService_Successful(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -713,7 +881,7 @@ func TestStartService_Successful(t *testing.T) {
 	serviceRegistration.SetStatus(service.ServiceStatus_Stopped)
 	serviceConfig := testServiceConfig(t, testContainerImageName)
 	serviceRegistration.SetConfig(serviceConfig)
-	serviceObj := service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+	serviceObj := service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, nil, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil, 0))
 
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer os.Remove(file.Name())
@@ -821,7 +989,7 @@ func TestStartService_ServiceAlreadyStarted(t *testing.T) {
 	serviceRegistration.SetStatus(service.ServiceStatus_Started)
 	serviceConfig := testServiceConfig(t, testContainerImageName)
 	serviceRegistration.SetConfig(serviceConfig)
-	serviceObj := service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+	serviceObj := service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, nil, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil, 0))
 
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer os.Remove(file.Name())
@@ -961,7 +1129,7 @@ func TestUpdateService(t *testing.T) {
 	)
 
 	// The services will then be re-created
-	serviceObj := service.NewService(existingServiceRegistration, map[string]*port_spec.PortSpec{}, existingServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, "", nil, nil, nil))
+	serviceObj := service.NewService(existingServiceRegistration, map[string]*port_spec.PortSpec{}, existingServiceIp, map[string]*port_spec.PortSpec{}, nil, container.NewContainer(container.ContainerStatus_Running, "", nil, nil, nil, 0))
 	backend.EXPECT().StartRegisteredUserServices(
 		ctx,
 		enclaveName,
@@ -0,0 +1,95 @@
+package service_network
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"github.com/kurtosis-tech/stacktrace"
+	"net/http"
+)
+
+// HttpRequestOptions configures how HttpRequestServiceWithOptions reaches the service
+type HttpRequestOptions struct {
+	// UseTls sends the request over HTTPS. The certificate of the service is verified against its hostname
+	UseTls bool
+
+	// CaCertificatesPem holds the PEM certificates the certificate of the service is verified against, on top of the
+	// system ones. Ignored unless UseTls is set
+	CaCertificatesPem []byte
+
+	// ClientCertificatePem and ClientKeyPem hold the PEM certificate chain and private key presented to services
+	// requiring mutual TLS. Ignored unless UseTls is set
+	ClientCertificatePem []byte
+	ClientKeyPem         []byte
+
+	// FollowRedirects makes the client follow the redirects the service answers with rather than returning them
+	FollowRedirects bool
+}
+
+// NewDefaultHttpRequestOptions returns the options of a plain HTTP request following redirects, which is what
+// HttpRequestService sends
+func NewDefaultHttpRequestOptions() *HttpRequestOptions {
+	return &HttpRequestOptions{
+		UseTls:               false,
+		CaCertificatesPem:    nil,
+		ClientCertificatePem: nil,
+		ClientKeyPem:         nil,
+		FollowRedirects:      true,
+	}
+}
+
+// newHttpRequestClient returns the client sending the request to the service with the given hostname
+func newHttpRequestClient(serviceHostname string, options *HttpRequestOptions) (*http.Client, error) {
+	if !options.UseTls && options.FollowRedirects {
+		return http.DefaultClient, nil
+	}
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, stacktrace.NewError("The default HTTP transport isn't an '*http.Transport'. This is a Kurtosis internal bug")
+	}
+	transport := defaultTransport.Clone()
+	// the transport is built for a single request so its connections aren't kept around once the response is read,
+	// otherwise every request would leave an idle connection, and the goroutines serving it, behind
+	transport.DisableKeepAlives = true
+	if options.UseTls {
+		tlsConfig, err := newHttpRequestTlsConfig(serviceHostname, options)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred building the TLS configuration of the request")
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+	client := &http.Client{ // nolint: exhaustruct
+		Transport: transport,
+	}
+	if !options.FollowRedirects {
+		client.CheckRedirect = func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+	return client, nil
+}
+
+func newHttpRequestTlsConfig(serviceHostname string, options *HttpRequestOptions) (*tls.Config, error) {
+	// The request goes to the private IP of the service, so the hostname is what its certificate is checked against
+	tlsConfig := &tls.Config{ // nolint: exhaustruct
+		ServerName: serviceHostname,
+		MinVersion: tls.VersionTLS12,
+	}
+	if len(options.CaCertificatesPem) > 0 {
+		rootCas, err := x509.SystemCertPool()
+		if err != nil {
+			rootCas = x509.NewCertPool()
+		}
+		if !rootCas.AppendCertsFromPEM(options.CaCertificatesPem) {
+			return nil, stacktrace.NewError("No PEM certificate could be parsed out of the CA certificates")
+		}
+		tlsConfig.RootCAs = rootCas
+	}
+	if len(options.ClientCertificatePem) > 0 || len(options.ClientKeyPem) > 0 {
+		clientCertificate, err := tls.X509KeyPair(options.ClientCertificatePem, options.ClientKeyPem)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the client certificate and key")
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCertificate}
+	}
+	return tlsConfig, nil
+}
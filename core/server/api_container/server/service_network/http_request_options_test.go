@@ -0,0 +1,80 @@
+package service_network
+
+import (
+	"encoding/pem"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// The certificate of httptest servers is issued for 'example.com'
+const httptestServerHostname = "example.com"
+
+func TestNewHttpRequestClient_DefaultOptions(t *testing.T) {
+	client, err := newHttpRequestClient(httptestServerHostname, NewDefaultHttpRequestOptions())
+	require.NoError(t, err)
+	require.Equal(t, http.DefaultClient, client)
+}
+
+func TestNewHttpRequestClient_TlsWithCa(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	caCertificatePem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Headers: nil, Bytes: server.Certificate().Raw})
+
+	options := NewDefaultHttpRequestOptions()
+	options.UseTls = true
+	client, err := newHttpRequestClient(httptestServerHostname, options)
+	require.NoError(t, err)
+	_, err = client.Get(server.URL)
+	require.Error(t, err, "The certificate of the server shouldn't be trusted without the CA")
+
+	options.CaCertificatesPem = caCertificatePem
+	client, err = newHttpRequestClient(httptestServerHostname, options)
+	require.NoError(t, err)
+	response, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestNewHttpRequestClient_InvalidCa(t *testing.T) {
+	options := NewDefaultHttpRequestOptions()
+	options.UseTls = true
+	options.CaCertificatesPem = []byte("not a certificate")
+	_, err := newHttpRequestClient(httptestServerHostname, options)
+	require.Error(t, err)
+}
+
+func TestNewHttpRequestClient_DoesNotFollowRedirects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/old" {
+			http.Redirect(writer, request, "/new", http.StatusMovedPermanently)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	options := NewDefaultHttpRequestOptions()
+	options.FollowRedirects = false
+	client, err := newHttpRequestClient(httptestServerHostname, options)
+	require.NoError(t, err)
+	response, err := client.Get(server.URL + "/old")
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusMovedPermanently, response.StatusCode)
+	require.Equal(t, "/new", response.Header.Get("Location"))
+}
+
+func TestNewHttpRequestClient_DoesNotKeepConnectionsAlive(t *testing.T) {
+	options := NewDefaultHttpRequestOptions()
+	options.UseTls = true
+	client, err := newHttpRequestClient(httptestServerHostname, options)
+	require.NoError(t, err)
+	transport, ok := client.Transport.(*http.Transport)
+	require.True(t, ok)
+	require.True(t, transport.DisableKeepAlives)
+}
@@ -12,6 +12,8 @@ import (
 
 	http "net/http"
 
+	interactive_exec "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+
 	io "io"
 
 	mock "github.com/stretchr/testify/mock"
@@ -20,6 +22,8 @@ import (
 
 	service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 
+	store_spec "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/store_spec"
+
 	service_identifiers "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
 )
 
@@ -212,6 +216,51 @@ func (_c *MockServiceNetwork_CopyFilesFromService_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// CopyFilesArtifactToService provides a mock function with given fields: ctx, serviceIdentifier, filesArtifactIdentifier, destDirpath
+func (_m *MockServiceNetwork) CopyFilesArtifactToService(ctx context.Context, serviceIdentifier string, filesArtifactIdentifier string, destDirpath string) error {
+	ret := _m.Called(ctx, serviceIdentifier, filesArtifactIdentifier, destDirpath)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, serviceIdentifier, filesArtifactIdentifier, destDirpath)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_CopyFilesArtifactToService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesArtifactToService'
+type MockServiceNetwork_CopyFilesArtifactToService_Call struct {
+	*mock.Call
+}
+
+// CopyFilesArtifactToService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - filesArtifactIdentifier string
+//   - destDirpath string
+func (_e *MockServiceNetwork_Expecter) CopyFilesArtifactToService(ctx interface{}, serviceIdentifier interface{}, filesArtifactIdentifier interface{}, destDirpath interface{}) *MockServiceNetwork_CopyFilesArtifactToService_Call {
+	return &MockServiceNetwork_CopyFilesArtifactToService_Call{Call: _e.mock.On("CopyFilesArtifactToService", ctx, serviceIdentifier, filesArtifactIdentifier, destDirpath)}
+}
+
+func (_c *MockServiceNetwork_CopyFilesArtifactToService_Call) Run(run func(ctx context.Context, serviceIdentifier string, filesArtifactIdentifier string, destDirpath string)) *MockServiceNetwork_CopyFilesArtifactToService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_CopyFilesArtifactToService_Call) Return(_a0 error) *MockServiceNetwork_CopyFilesArtifactToService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_CopyFilesArtifactToService_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockServiceNetwork_CopyFilesArtifactToService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExistServiceRegistration provides a mock function with given fields: serviceName
 func (_m *MockServiceNetwork) ExistServiceRegistration(serviceName service.ServiceName) (bool, error) {
 	ret := _m.Called(serviceName)
@@ -401,6 +450,60 @@ func (_c *MockServiceNetwork_GetExistingAndHistoricalServiceIdentifiers_Call) Ru
 	return _c
 }
 
+// GetFilesArtifactContents provides a mock function with given fields: filesArtifactIdentifier
+func (_m *MockServiceNetwork) GetFilesArtifactContents(filesArtifactIdentifier string) (map[string][]byte, error) {
+	ret := _m.Called(filesArtifactIdentifier)
+
+	var r0 map[string][]byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string][]byte, error)); ok {
+		return rf(filesArtifactIdentifier)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[string][]byte); ok {
+		r0 = rf(filesArtifactIdentifier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(filesArtifactIdentifier)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetFilesArtifactContents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFilesArtifactContents'
+type MockServiceNetwork_GetFilesArtifactContents_Call struct {
+	*mock.Call
+}
+
+// GetFilesArtifactContents is a helper method to define mock.On call
+//   - filesArtifactIdentifier string
+func (_e *MockServiceNetwork_Expecter) GetFilesArtifactContents(filesArtifactIdentifier interface{}) *MockServiceNetwork_GetFilesArtifactContents_Call {
+	return &MockServiceNetwork_GetFilesArtifactContents_Call{Call: _e.mock.On("GetFilesArtifactContents", filesArtifactIdentifier)}
+}
+
+func (_c *MockServiceNetwork_GetFilesArtifactContents_Call) Run(run func(filesArtifactIdentifier string)) *MockServiceNetwork_GetFilesArtifactContents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetFilesArtifactContents_Call) Return(_a0 map[string][]byte, _a1 error) *MockServiceNetwork_GetFilesArtifactContents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetFilesArtifactContents_Call) RunAndReturn(run func(string) (map[string][]byte, error)) *MockServiceNetwork_GetFilesArtifactContents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFilesArtifactMd5 provides a mock function with given fields: artifactName
 func (_m *MockServiceNetwork) GetFilesArtifactMd5(artifactName string) (enclave_data_directory.FilesArtifactUUID, []byte, bool, error) {
 	ret := _m.Called(artifactName)
@@ -743,6 +846,68 @@ func (_c *MockServiceNetwork_HttpRequestService_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// HttpRequestServiceWithOptions provides a mock function with given fields: ctx, serviceIdentifier, portId, method, contentType, endpoint, body, headers, options
+func (_m *MockServiceNetwork) HttpRequestServiceWithOptions(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string, headers map[string]string, options *HttpRequestOptions) (*http.Response, error) {
+	ret := _m.Called(ctx, serviceIdentifier, portId, method, contentType, endpoint, body, headers, options)
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, string, map[string]string, *HttpRequestOptions) (*http.Response, error)); ok {
+		return rf(ctx, serviceIdentifier, portId, method, contentType, endpoint, body, headers, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, string, map[string]string, *HttpRequestOptions) *http.Response); ok {
+		r0 = rf(ctx, serviceIdentifier, portId, method, contentType, endpoint, body, headers, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string, string, map[string]string, *HttpRequestOptions) error); ok {
+		r1 = rf(ctx, serviceIdentifier, portId, method, contentType, endpoint, body, headers, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_HttpRequestServiceWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HttpRequestServiceWithOptions'
+type MockServiceNetwork_HttpRequestServiceWithOptions_Call struct {
+	*mock.Call
+}
+
+// HttpRequestServiceWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - portId string
+//   - method string
+//   - contentType string
+//   - endpoint string
+//   - body string
+//   - headers map[string]string
+//   - options *HttpRequestOptions
+func (_e *MockServiceNetwork_Expecter) HttpRequestServiceWithOptions(ctx interface{}, serviceIdentifier interface{}, portId interface{}, method interface{}, contentType interface{}, endpoint interface{}, body interface{}, headers interface{}, options interface{}) *MockServiceNetwork_HttpRequestServiceWithOptions_Call {
+	return &MockServiceNetwork_HttpRequestServiceWithOptions_Call{Call: _e.mock.On("HttpRequestServiceWithOptions", ctx, serviceIdentifier, portId, method, contentType, endpoint, body, headers, options)}
+}
+
+func (_c *MockServiceNetwork_HttpRequestServiceWithOptions_Call) Run(run func(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string, headers map[string]string, options *HttpRequestOptions)) *MockServiceNetwork_HttpRequestServiceWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(string), args[6].(string), args[7].(map[string]string), args[8].(*HttpRequestOptions))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_HttpRequestServiceWithOptions_Call) Return(_a0 *http.Response, _a1 error) *MockServiceNetwork_HttpRequestServiceWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_HttpRequestServiceWithOptions_Call) RunAndReturn(run func(context.Context, string, string, string, string, string, string, map[string]string, *HttpRequestOptions) (*http.Response, error)) *MockServiceNetwork_HttpRequestServiceWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) RemoveService(ctx context.Context, serviceIdentifier string) (service.ServiceUUID, error) {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -969,6 +1134,119 @@ func (_c *MockServiceNetwork_RunExecs_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// RunInteractiveExec provides a mock function with given fields: ctx, serviceIdentifier, userServiceCommand, streams
+func (_m *MockServiceNetwork) RunInteractiveExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string, streams *interactive_exec.InteractiveExecStreams) (int32, error) {
+	ret := _m.Called(ctx, serviceIdentifier, userServiceCommand, streams)
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, *interactive_exec.InteractiveExecStreams) (int32, error)); ok {
+		return rf(ctx, serviceIdentifier, userServiceCommand, streams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, *interactive_exec.InteractiveExecStreams) int32); ok {
+		r0 = rf(ctx, serviceIdentifier, userServiceCommand, streams)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, *interactive_exec.InteractiveExecStreams) error); ok {
+		r1 = rf(ctx, serviceIdentifier, userServiceCommand, streams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_RunInteractiveExec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunInteractiveExec'
+type MockServiceNetwork_RunInteractiveExec_Call struct {
+	*mock.Call
+}
+
+// RunInteractiveExec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - userServiceCommand []string
+//   - streams *interactive_exec.InteractiveExecStreams
+func (_e *MockServiceNetwork_Expecter) RunInteractiveExec(ctx interface{}, serviceIdentifier interface{}, userServiceCommand interface{}, streams interface{}) *MockServiceNetwork_RunInteractiveExec_Call {
+	return &MockServiceNetwork_RunInteractiveExec_Call{Call: _e.mock.On("RunInteractiveExec", ctx, serviceIdentifier, userServiceCommand, streams)}
+}
+
+func (_c *MockServiceNetwork_RunInteractiveExec_Call) Run(run func(ctx context.Context, serviceIdentifier string, userServiceCommand []string, streams *interactive_exec.InteractiveExecStreams)) *MockServiceNetwork_RunInteractiveExec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(*interactive_exec.InteractiveExecStreams))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_RunInteractiveExec_Call) Return(_a0 int32, _a1 error) *MockServiceNetwork_RunInteractiveExec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_RunInteractiveExec_Call) RunAndReturn(run func(context.Context, string, []string, *interactive_exec.InteractiveExecStreams) (int32, error)) *MockServiceNetwork_RunInteractiveExec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunTask provides a mock function with given fields: ctx, taskName, taskConfig, storeSpecs, shouldRemoveTaskInBackground
+func (_m *MockServiceNetwork) RunTask(ctx context.Context, taskName service.ServiceName, taskConfig *service.ServiceConfig, storeSpecs []*store_spec.StoreSpec, shouldRemoveTaskInBackground bool) (*exec_result.ExecResult, error) {
+	ret := _m.Called(ctx, taskName, taskConfig, storeSpecs, shouldRemoveTaskInBackground)
+
+	var r0 *exec_result.ExecResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.ServiceName, *service.ServiceConfig, []*store_spec.StoreSpec, bool) (*exec_result.ExecResult, error)); ok {
+		return rf(ctx, taskName, taskConfig, storeSpecs, shouldRemoveTaskInBackground)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, service.ServiceName, *service.ServiceConfig, []*store_spec.StoreSpec, bool) *exec_result.ExecResult); ok {
+		r0 = rf(ctx, taskName, taskConfig, storeSpecs, shouldRemoveTaskInBackground)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*exec_result.ExecResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, service.ServiceName, *service.ServiceConfig, []*store_spec.StoreSpec, bool) error); ok {
+		r1 = rf(ctx, taskName, taskConfig, storeSpecs, shouldRemoveTaskInBackground)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_RunTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunTask'
+type MockServiceNetwork_RunTask_Call struct {
+	*mock.Call
+}
+
+// RunTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskName service.ServiceName
+//   - taskConfig *service.ServiceConfig
+//   - storeSpecs []*store_spec.StoreSpec
+//   - shouldRemoveTaskInBackground bool
+func (_e *MockServiceNetwork_Expecter) RunTask(ctx interface{}, taskName interface{}, taskConfig interface{}, storeSpecs interface{}, shouldRemoveTaskInBackground interface{}) *MockServiceNetwork_RunTask_Call {
+	return &MockServiceNetwork_RunTask_Call{Call: _e.mock.On("RunTask", ctx, taskName, taskConfig, storeSpecs, shouldRemoveTaskInBackground)}
+}
+
+func (_c *MockServiceNetwork_RunTask_Call) Run(run func(ctx context.Context, taskName service.ServiceName, taskConfig *service.ServiceConfig, storeSpecs []*store_spec.StoreSpec, shouldRemoveTaskInBackground bool)) *MockServiceNetwork_RunTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.ServiceName), args[2].(*service.ServiceConfig), args[3].([]*store_spec.StoreSpec), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_RunTask_Call) Return(_a0 *exec_result.ExecResult, _a1 error) *MockServiceNetwork_RunTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_RunTask_Call) RunAndReturn(run func(context.Context, service.ServiceName, *service.ServiceConfig, []*store_spec.StoreSpec, bool) (*exec_result.ExecResult, error)) *MockServiceNetwork_RunTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) StartService(ctx context.Context, serviceIdentifier string) error {
 	ret := _m.Called(ctx, serviceIdentifier)
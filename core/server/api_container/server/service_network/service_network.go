@@ -4,7 +4,9 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/store_spec"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
@@ -81,6 +83,10 @@ type ServiceNetwork interface {
 
 	RunExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string) (*exec_result.ExecResult, error)
 
+	// RunInteractiveExec runs the command with its standard streams attached to the given ones, without blocking other
+	// service network operations while it runs, and returns the command's exit code
+	RunInteractiveExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string, streams *interactive_exec.InteractiveExecStreams) (int32, error)
+
 	RunExecs(
 		ctx context.Context,
 		userServiceCommands map[string][]string,
@@ -90,14 +96,27 @@ type ServiceNetwork interface {
 		error,
 	)
 
+	// RunTask runs a one-shot task to completion, without registering it as a service, and returns its exit code and
+	// logs. If the task succeeds, the files at the source of each store spec are stored as a files artifact
+	RunTask(ctx context.Context, taskName service.ServiceName, taskConfig *service.ServiceConfig, storeSpecs []*store_spec.StoreSpec, shouldRemoveTaskInBackground bool) (*exec_result.ExecResult, error)
+
 	HttpRequestService(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string, headers map[string]string) (*http.Response, error)
 
+	// HttpRequestServiceWithOptions is HttpRequestService with control over TLS and redirects
+	HttpRequestServiceWithOptions(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string, headers map[string]string, options *HttpRequestOptions) (*http.Response, error)
+
 	GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error)
 
 	GetServices(ctx context.Context) (map[service.ServiceUUID]*service.Service, error)
 
 	CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
 
+	// CopyFilesArtifactToService extracts the content of the files artifact into the destination directory of the running service
+	CopyFilesArtifactToService(ctx context.Context, serviceIdentifier string, filesArtifactIdentifier string, destDirpath string) error
+
+	// GetFilesArtifactContents returns the content of the regular files of the files artifact, by path relative to its root
+	GetFilesArtifactContents(filesArtifactIdentifier string) (map[string][]byte, error)
+
 	GetServiceNames() (map[service.ServiceName]bool, error)
 
 	GetExistingAndHistoricalServiceIdentifiers() (service_identifiers.ServiceIdentifiers, error)
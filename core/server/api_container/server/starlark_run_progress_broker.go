@@ -0,0 +1,58 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+)
+
+const (
+	// Lines are dropped for the watchers that fall this far behind, rather than slowing the run down
+	starlarkRunProgressSubscriptionBufferSize = 100
+)
+
+// starlarkRunProgressBroker fans the progress of the Starlark runs executed in the enclave out to its watchers, no
+// matter which client started the run
+type starlarkRunProgressBroker struct {
+	mutex         *sync.Mutex
+	subscriptions map[chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]bool
+}
+
+func newStarlarkRunProgressBroker() *starlarkRunProgressBroker {
+	return &starlarkRunProgressBroker{
+		mutex:         &sync.Mutex{},
+		subscriptions: map[chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]bool{},
+	}
+}
+
+// subscribe returns the channel the progress and run finished lines of the runs are sent to, and the function ending
+// the subscription
+func (broker *starlarkRunProgressBroker) subscribe() (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, func()) {
+	subscription := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, starlarkRunProgressSubscriptionBufferSize)
+
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+	broker.subscriptions[subscription] = true
+
+	unsubscribeFunc := func() {
+		broker.mutex.Lock()
+		defer broker.mutex.Unlock()
+		delete(broker.subscriptions, subscription)
+	}
+	return subscription, unsubscribeFunc
+}
+
+// publish sends the line to the watchers if it reports progress or the end of the run; other lines are ignored
+func (broker *starlarkRunProgressBroker) publish(line *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) {
+	if line.GetProgressInfo() == nil && line.GetRunFinishedEvent() == nil {
+		return
+	}
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+	for subscription := range broker.subscriptions {
+		select {
+		case subscription <- line:
+		default:
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStarlarkRunProgressBroker(t *testing.T) {
+	broker := newStarlarkRunProgressBroker()
+	subscription, unsubscribeFunc := broker.subscribe()
+	otherSubscription, unsubscribeOtherFunc := broker.subscribe()
+	defer unsubscribeOtherFunc()
+
+	progressLine := &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_ProgressInfo{
+			ProgressInfo: &kurtosis_core_rpc_api_bindings.StarlarkRunProgress{
+				CurrentStepInfo:   []string{"Adding service"},
+				TotalSteps:        2,
+				CurrentStepNumber: 1,
+			},
+		},
+	}
+	infoLine := &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_Info{
+			Info: &kurtosis_core_rpc_api_bindings.StarlarkInfo{InfoMessage: "info"},
+		},
+	}
+	broker.publish(infoLine)
+	broker.publish(progressLine)
+
+	require.Len(t, subscription, 1)
+	require.Equal(t, progressLine, <-subscription)
+	require.Len(t, otherSubscription, 1)
+
+	unsubscribeFunc()
+	broker.publish(progressLine)
+	require.Empty(t, subscription)
+	require.Len(t, otherSubscription, 2)
+}
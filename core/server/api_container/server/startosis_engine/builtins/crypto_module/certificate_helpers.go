@@ -0,0 +1,221 @@
+package crypto_module
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"net"
+)
+
+const (
+	CaCertificateBuiltinName = "ca_certificate"
+	CertificateBuiltinName   = "certificate"
+
+	CommonNameArgName         = "common_name"
+	CaArgName                 = "ca"
+	DnsNamesArgName           = "dns_names"
+	IpAddressesArgName        = "ip_addresses"
+	CertificateKeyTypeArgName = "key_type"
+	ValidityDaysArgName       = "validity_days"
+)
+
+// NewCaCertificateHelper returns the helper generating a self-signed CA certificate
+func NewCaCertificateHelper() *kurtosis_helper.KurtosisHelper {
+	return &kurtosis_helper.KurtosisHelper{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: CaCertificateBuiltinName,
+			Arguments: append([]*builtin_argument.BuiltinArgument{
+				seedArgument(),
+				commonNameArgument(),
+			}, keyTypeAndValidityArguments()...),
+		},
+
+		Capabilities: &certificateCapabilities{isCa: true},
+	}
+}
+
+// NewCertificateHelper returns the helper generating a certificate signed by a CA, usable by both servers and clients
+func NewCertificateHelper() *kurtosis_helper.KurtosisHelper {
+	return &kurtosis_helper.KurtosisHelper{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: CertificateBuiltinName,
+			Arguments: append([]*builtin_argument.BuiltinArgument{
+				seedArgument(),
+				{
+					Name:              CaArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlarkstruct.Struct],
+					Validator:         nil,
+				},
+				commonNameArgument(),
+				{
+					Name:              DnsNamesArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringListWithNotEmptyValues(value, DnsNamesArgName)
+					},
+				},
+				{
+					Name:              IpAddressesArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, interpretationErr := parseIpAddresses(value)
+						return interpretationErr
+					},
+				},
+			}, keyTypeAndValidityArguments()...),
+		},
+
+		Capabilities: &certificateCapabilities{isCa: false},
+	}
+}
+
+func seedArgument() *builtin_argument.BuiltinArgument {
+	return &builtin_argument.BuiltinArgument{
+		Name:              SeedArgName,
+		IsOptional:        false,
+		ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+		Validator:         validateSeed,
+	}
+}
+
+func commonNameArgument() *builtin_argument.BuiltinArgument {
+	return &builtin_argument.BuiltinArgument{
+		Name:              CommonNameArgName,
+		IsOptional:        false,
+		ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+		Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+			return builtin_argument.NonEmptyString(value, CommonNameArgName)
+		},
+	}
+}
+
+func keyTypeAndValidityArguments() []*builtin_argument.BuiltinArgument {
+	return []*builtin_argument.BuiltinArgument{
+		{
+			Name:              CertificateKeyTypeArgName,
+			IsOptional:        true,
+			ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+			Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+				return builtin_argument.StringValues(value, CertificateKeyTypeArgName, SupportedCertificateKeyTypes)
+			},
+		},
+		{
+			Name:              ValidityDaysArgName,
+			IsOptional:        true,
+			ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+			Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+				return builtin_argument.Int64InRange(value, ValidityDaysArgName, 1, MaxCertificateValidityDays)
+			},
+		},
+	}
+}
+
+type certificateCapabilities struct {
+	isCa bool
+}
+
+func (builtin *certificateCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	seed, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, SeedArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", SeedArgName)
+	}
+	commonName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, CommonNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", CommonNameArgName)
+	}
+	request := &certificateRequest{
+		seed:         seed.GoString(),
+		commonName:   commonName.GoString(),
+		dnsNames:     nil,
+		ipAddresses:  nil,
+		keyType:      DefaultCertificateKeyType,
+		validityDays: DefaultCertificateValidityDays,
+		ca:           nil,
+	}
+	if arguments.IsSet(CertificateKeyTypeArgName) {
+		keyType, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, CertificateKeyTypeArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", CertificateKeyTypeArgName)
+		}
+		request.keyType = keyType.GoString()
+	}
+	if arguments.IsSet(ValidityDaysArgName) {
+		validityDays, err := builtin_argument.ExtractArgumentValue[starlark.Int](arguments, ValidityDaysArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", ValidityDaysArgName)
+		}
+		validityDaysInt64, _ := validityDays.Int64()
+		request.validityDays = int(validityDaysInt64)
+	}
+
+	if !builtin.isCa {
+		if interpretationErr := fillSignedCertificateRequest(request, arguments); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	certificate, err := generateCertificate(request)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred generating certificate '%s'", request.commonName)
+	}
+	return newCertificateStruct(certificate), nil
+}
+
+func fillSignedCertificateRequest(request *certificateRequest, arguments *builtin_argument.ArgumentValuesSet) *startosis_errors.InterpretationError {
+	caValue, err := builtin_argument.ExtractArgumentValue[*starlarkstruct.Struct](arguments, CaArgName)
+	if err != nil {
+		return startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", CaArgName)
+	}
+	ca, interpretationErr := certificateFromStruct(caValue, CaArgName)
+	if interpretationErr != nil {
+		return interpretationErr
+	}
+	request.ca = ca
+
+	if arguments.IsSet(DnsNamesArgName) {
+		dnsNamesValue, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, DnsNamesArgName)
+		if err != nil {
+			return startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", DnsNamesArgName)
+		}
+		dnsNames, interpretationErr := kurtosis_types.SafeCastToStringSlice(dnsNamesValue, DnsNamesArgName)
+		if interpretationErr != nil {
+			return interpretationErr
+		}
+		request.dnsNames = dnsNames
+	}
+	if arguments.IsSet(IpAddressesArgName) {
+		ipAddressesValue, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, IpAddressesArgName)
+		if err != nil {
+			return startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", IpAddressesArgName)
+		}
+		ipAddresses, interpretationErr := parseIpAddresses(ipAddressesValue)
+		if interpretationErr != nil {
+			return interpretationErr
+		}
+		request.ipAddresses = ipAddresses
+	}
+	return nil
+}
+
+func parseIpAddresses(value starlark.Value) ([]net.IP, *startosis_errors.InterpretationError) {
+	ipAddressStrings, interpretationErr := kurtosis_types.SafeCastToStringSlice(value, IpAddressesArgName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	ipAddresses := make([]net.IP, 0, len(ipAddressStrings))
+	for _, ipAddressString := range ipAddressStrings {
+		ipAddress := net.ParseIP(ipAddressString)
+		if ipAddress == nil {
+			return nil, startosis_errors.NewInterpretationError("Value '%s' in '%s' isn't a valid IP address", ipAddressString, IpAddressesArgName)
+		}
+		ipAddresses = append(ipAddresses, ipAddress)
+	}
+	return ipAddresses, nil
+}
@@ -0,0 +1,145 @@
+package crypto_module
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"github.com/kurtosis-tech/stacktrace"
+	"math/big"
+	"net"
+	"time"
+)
+
+const (
+	DefaultCertificateKeyType      = RsaKeyType
+	DefaultCertificateValidityDays = 36500
+	MaxCertificateValidityDays     = 36500
+
+	certificatePemType          = "CERTIFICATE"
+	certificateSerialNumberBits = 127
+	hoursInDay                  = 24
+)
+
+var (
+	// certificateNotBefore is the same for all certificates, as using the current time would make them change on every
+	// run. It's far enough in the past for the certificates to be valid as soon as they are generated
+	certificateNotBefore = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// SupportedCertificateKeyTypes are the key types with deterministic signatures, ECDSA signatures being random
+	SupportedCertificateKeyTypes = []string{RsaKeyType, Ed25519KeyType}
+)
+
+// certificateWithKey holds a PEM certificate and its PEM private key
+type certificateWithKey struct {
+	certificatePem string
+	privateKeyPem  string
+}
+
+// certificateRequest describes the certificate to generate. The CA is nil for a self-signed CA certificate
+type certificateRequest struct {
+	seed         string
+	commonName   string
+	dnsNames     []string
+	ipAddresses  []net.IP
+	keyType      string
+	validityDays int
+	ca           *certificateWithKey
+}
+
+func generateCertificate(request *certificateRequest) (*certificateWithKey, error) {
+	isCa := request.ca == nil
+	purpose := "certificate/" + request.keyType + "/" + request.commonName
+	if isCa {
+		purpose = "ca/" + request.keyType + "/" + request.commonName
+	}
+	stream := newSeedStream(request.seed, purpose)
+
+	privateKey, err := generateCertificateKey(stream, request.keyType)
+	if err != nil {
+		return nil, err
+	}
+	serialNumber := new(big.Int).SetBytes(stream.readBytes((certificateSerialNumberBits + 1) / 8))
+	serialNumber.SetBit(serialNumber, certificateSerialNumberBits, 0)
+
+	template := &x509.Certificate{ // nolint: exhaustruct
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{ // nolint: exhaustruct
+			CommonName: request.commonName,
+		},
+		NotBefore:             certificateNotBefore,
+		NotAfter:              certificateNotBefore.Add(time.Duration(request.validityDays) * hoursInDay * time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  isCa,
+		DNSNames:              request.dnsNames,
+		IPAddresses:           request.ipAddresses,
+	}
+
+	parentCertificate := template
+	var signingKey crypto.Signer = privateKey
+	if isCa {
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
+	} else {
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+		if _, isRsa := privateKey.(*rsa.PrivateKey); isRsa {
+			template.KeyUsage |= x509.KeyUsageKeyEncipherment
+		}
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+		parentCertificate, signingKey, err = parseCa(request.ca)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the CA signing the certificate")
+		}
+	}
+
+	certificateDer, err := x509.CreateCertificate(stream, template, parentCertificate, privateKey.Public(), signingKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating certificate '%s'", request.commonName)
+	}
+	privateKeyPem, err := encodePrivateKeyPem(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &certificateWithKey{
+		certificatePem: string(pem.EncodeToMemory(&pem.Block{Type: certificatePemType, Headers: nil, Bytes: certificateDer})),
+		privateKeyPem:  privateKeyPem,
+	}, nil
+}
+
+func generateCertificateKey(stream *seedStream, keyType string) (crypto.Signer, error) {
+	switch keyType {
+	case RsaKeyType:
+		return generateRsaKey(stream, DefaultRsaBits)
+	case Ed25519KeyType:
+		return generateEd25519Key(stream), nil
+	default:
+		return nil, stacktrace.NewError("Key type '%s' can't be used for certificates", keyType)
+	}
+}
+
+func parseCa(ca *certificateWithKey) (*x509.Certificate, crypto.Signer, error) {
+	certificateBlock, _ := pem.Decode([]byte(ca.certificatePem))
+	if certificateBlock == nil || certificateBlock.Type != certificatePemType {
+		return nil, nil, stacktrace.NewError("No PEM certificate could be found in the CA certificate")
+	}
+	certificate, err := x509.ParseCertificate(certificateBlock.Bytes)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred parsing the CA certificate")
+	}
+	if !certificate.IsCA {
+		return nil, nil, stacktrace.NewError("Certificate '%s' isn't a CA certificate", certificate.Subject.CommonName)
+	}
+	privateKeyBlock, _ := pem.Decode([]byte(ca.privateKeyPem))
+	if privateKeyBlock == nil || privateKeyBlock.Type != privateKeyPemType {
+		return nil, nil, stacktrace.NewError("No PKCS #8 PEM private key could be found in the CA private key")
+	}
+	privateKey, err := x509.ParsePKCS8PrivateKey(privateKeyBlock.Bytes)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred parsing the CA private key")
+	}
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, nil, stacktrace.NewError("The CA private key of type '%T' can't sign certificates", privateKey)
+	}
+	return certificate, signer, nil
+}
@@ -0,0 +1,96 @@
+package crypto_module
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+const (
+	CryptoModuleName = "crypto"
+
+	SeedArgName = "seed"
+
+	privateKeyAttr    = "private_key"
+	publicKeyAttr     = "public_key"
+	privateKeyPemAttr = "private_key_pem"
+	publicKeyPemAttr  = "public_key_pem"
+	addressAttr       = "address"
+	certificateAttr   = "certificate"
+)
+
+// CryptoModule is the 'kurtosis.crypto' module. Its helpers derive keys and certificates from a seed at
+// interpretation time, so that the same seed always returns the same keys and plans stay idempotent
+func CryptoModule() *starlarkstruct.Module {
+	helpers := []*kurtosis_helper.KurtosisHelper{
+		NewKeypairHelper(),
+		NewCaCertificateHelper(),
+		NewCertificateHelper(),
+	}
+	members := starlark.StringDict{}
+	for _, helper := range helpers {
+		members[helper.GetName()] = starlark.NewBuiltin(helper.GetName(), helper.CreateBuiltin())
+	}
+	return &starlarkstruct.Module{
+		Name:    CryptoModuleName,
+		Members: members,
+	}
+}
+
+func validateSeed(value starlark.Value) *startosis_errors.InterpretationError {
+	seed, ok := value.(starlark.String)
+	if !ok || seed.GoString() == "" {
+		return startosis_errors.NewInterpretationError("Value for '%s' was expected to be a non empty string", SeedArgName)
+	}
+	return nil
+}
+
+func newKeypairStruct(keys *keypair) *starlarkstruct.Struct {
+	fields := starlark.StringDict{
+		privateKeyAttr: starlark.String(keys.privateKey),
+		publicKeyAttr:  starlark.String(keys.publicKey),
+	}
+	if keys.privateKeyPem != "" {
+		fields[privateKeyPemAttr] = starlark.String(keys.privateKeyPem)
+		fields[publicKeyPemAttr] = starlark.String(keys.publicKeyPem)
+	}
+	if keys.address != "" {
+		fields[addressAttr] = starlark.String(keys.address)
+	}
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, fields)
+}
+
+func newCertificateStruct(certificate *certificateWithKey) *starlarkstruct.Struct {
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		certificateAttr: starlark.String(certificate.certificatePem),
+		privateKeyAttr:  starlark.String(certificate.privateKeyPem),
+	})
+}
+
+// certificateFromStruct reads back a certificate returned by one of the certificate helpers
+func certificateFromStruct(value *starlarkstruct.Struct, argNameForLogging string) (*certificateWithKey, *startosis_errors.InterpretationError) {
+	readAttr := func(attrName string) (string, *startosis_errors.InterpretationError) {
+		attrValue, err := value.Attr(attrName)
+		if err != nil {
+			return "", startosis_errors.WrapWithInterpretationError(err, "'%s' was expected to have a '%s' attribute, like the certificates returned by '%s.%s'", argNameForLogging, attrName, CryptoModuleName, CaCertificateBuiltinName)
+		}
+		attrString, ok := attrValue.(starlark.String)
+		if !ok {
+			return "", startosis_errors.NewInterpretationError("Attribute '%s' of '%s' was expected to be a string, got '%s'", attrName, argNameForLogging, attrValue.Type())
+		}
+		return attrString.GoString(), nil
+	}
+	certificatePem, interpretationErr := readAttr(certificateAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	privateKeyPem, interpretationErr := readAttr(privateKeyAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &certificateWithKey{
+		certificatePem: certificatePem,
+		privateKeyPem:  privateKeyPem,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package crypto_module
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	KeypairBuiltinName = "keypair"
+
+	KeyTypeArgName = "type"
+	BitsArgName    = "bits"
+)
+
+func NewKeypairHelper() *kurtosis_helper.KurtosisHelper {
+	return &kurtosis_helper.KurtosisHelper{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: KeypairBuiltinName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              KeyTypeArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringValues(value, KeyTypeArgName, SupportedKeyTypes)
+					},
+				},
+				{
+					Name:              SeedArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         validateSeed,
+				},
+				{
+					Name:              BitsArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator:         validateRsaBits,
+				},
+			},
+		},
+
+		Capabilities: &keypairCapabilities{},
+	}
+}
+
+type keypairCapabilities struct{}
+
+func (builtin *keypairCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	keyType, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, KeyTypeArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", KeyTypeArgName)
+	}
+	seed, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, SeedArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", SeedArgName)
+	}
+	bits := DefaultRsaBits
+	if arguments.IsSet(BitsArgName) {
+		if keyType.GoString() != RsaKeyType {
+			return nil, startosis_errors.NewInterpretationError("Argument '%s' can only be set for '%s' keys", BitsArgName, RsaKeyType)
+		}
+		bitsValue, err := builtin_argument.ExtractArgumentValue[starlark.Int](arguments, BitsArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", BitsArgName)
+		}
+		bitsInt64, _ := bitsValue.Int64()
+		bits = int(bitsInt64)
+	}
+
+	keys, err := generateKeypair(keyType.GoString(), seed.GoString(), bits)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred generating a '%s' keypair", keyType.GoString())
+	}
+	return newKeypairStruct(keys), nil
+}
+
+func validateRsaBits(value starlark.Value) *startosis_errors.InterpretationError {
+	bits, ok := value.(starlark.Int)
+	if !ok {
+		return startosis_errors.NewInterpretationError("Value for '%s' was expected to be an integer, got '%s'", BitsArgName, value.Type())
+	}
+	bitsInt64, ok := bits.Int64()
+	for _, supportedBits := range SupportedRsaBits {
+		if ok && bitsInt64 == supportedBits {
+			return nil
+		}
+	}
+	return startosis_errors.NewInterpretationError("Value for '%s' was expected to be one of %v, got %v", BitsArgName, SupportedRsaBits, bits)
+}
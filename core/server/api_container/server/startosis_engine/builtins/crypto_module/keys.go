@@ -0,0 +1,274 @@
+package crypto_module
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"github.com/cloudflare/circl/ecc/bls12381"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/kurtosis-tech/stacktrace"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/sha3"
+	"math/big"
+	"strings"
+)
+
+const (
+	Secp256k1KeyType = "secp256k1"
+	Ed25519KeyType   = "ed25519"
+	BlsKeyType       = "bls"
+	RsaKeyType       = "rsa"
+
+	DefaultRsaBits = 2048
+
+	secp256k1PrivateKeyLength = 32
+	ethereumAddressLength     = 20
+	ethereumAddressPrefix     = "0x"
+
+	blsPrivateKeyLength       = 32
+	blsKeyGenSalt             = "BLS-SIG-KEYGEN-SALT-"
+	blsKeyGenOutputLength     = 48
+	blsKeyGenInputLength      = 32
+	rsaPublicExponent         = 65537
+	rsaPrimeSearchTopBitsMask = 0xc0
+	rsaPrimalityTestRounds    = 20
+
+	privateKeyPemType = "PRIVATE KEY"
+	publicKeyPemType  = "PUBLIC KEY"
+)
+
+var (
+	blsScalarOrder = new(big.Int).SetBytes(bls12381.Order())
+
+	SupportedKeyTypes = []string{Secp256k1KeyType, Ed25519KeyType, BlsKeyType, RsaKeyType}
+
+	// SupportedRsaBits stops at 3072 bits, as the deterministic prime search runs during interpretation and takes
+	// several seconds for bigger keys
+	SupportedRsaBits = []int64{2048, 3072}
+)
+
+// keypair holds the encoded keys returned to Starlark. The PEM keys are only set for the key types x509 supports
+type keypair struct {
+	privateKey    string
+	publicKey     string
+	privateKeyPem string
+	publicKeyPem  string
+	// address is only set for secp256k1 keys
+	address string
+}
+
+func generateKeypair(keyType string, seed string, rsaBits int) (*keypair, error) {
+	stream := newSeedStream(seed, "keypair/"+keyType)
+	switch keyType {
+	case Secp256k1KeyType:
+		return generateSecp256k1Keypair(stream), nil
+	case Ed25519KeyType:
+		privateKey := generateEd25519Key(stream)
+		keys, err := encodeX509Keypair(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		keys.privateKey = hex.EncodeToString(privateKey.Seed())
+		keys.publicKey = hex.EncodeToString(privateKey.Public().(ed25519.PublicKey))
+		return keys, nil
+	case BlsKeyType:
+		return generateBlsKeypair(stream), nil
+	case RsaKeyType:
+		privateKey, err := generateRsaKey(stream, rsaBits)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred generating a %d bits RSA key", rsaBits)
+		}
+		keys, err := encodeX509Keypair(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		// RSA keys have no raw encoding in common use, so the PEM keys are the keys
+		keys.privateKey = keys.privateKeyPem
+		keys.publicKey = keys.publicKeyPem
+		return keys, nil
+	default:
+		return nil, stacktrace.NewError("Key type '%s' isn't supported. Supported key types are %s", keyType, strings.Join(SupportedKeyTypes, ", "))
+	}
+}
+
+func generateSecp256k1Keypair(stream *seedStream) *keypair {
+	privateKey := secp256k1.NewPrivateKey(randomSecp256k1Scalar(stream))
+	publicKey := privateKey.PubKey().SerializeUncompressed()
+	return &keypair{
+		privateKey:    hex.EncodeToString(privateKey.Serialize()),
+		publicKey:     hex.EncodeToString(publicKey),
+		privateKeyPem: "",
+		publicKeyPem:  "",
+		address:       ethereumAddress(publicKey),
+	}
+}
+
+// randomSecp256k1Scalar returns a scalar in [1, n-1], rejecting the values of the stream out of that range
+func randomSecp256k1Scalar(stream *seedStream) *secp256k1.ModNScalar {
+	scalar := new(secp256k1.ModNScalar)
+	for {
+		overflows := scalar.SetByteSlice(stream.readBytes(secp256k1PrivateKeyLength))
+		if !overflows && !scalar.IsZero() {
+			return scalar
+		}
+	}
+}
+
+// ethereumAddress returns the EIP-55 checksummed address of the uncompressed public key, i.e. the last 20 bytes of
+// the Keccak-256 of its coordinates
+func ethereumAddress(uncompressedPublicKey []byte) string {
+	// The first byte is the uncompressed format prefix, which isn't part of the hashed coordinates
+	publicKeyHash := keccak256(uncompressedPublicKey[1:])
+	addressHex := hex.EncodeToString(publicKeyHash[len(publicKeyHash)-ethereumAddressLength:])
+	checksum := hex.EncodeToString(keccak256([]byte(addressHex)))
+	checksummedAddress := strings.Builder{}
+	for index, character := range addressHex {
+		if character >= 'a' && checksum[index] >= '8' {
+			checksummedAddress.WriteRune(character - 'a' + 'A')
+		} else {
+			checksummedAddress.WriteRune(character)
+		}
+	}
+	return ethereumAddressPrefix + checksummedAddress.String()
+}
+
+func keccak256(data []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	_, _ = hasher.Write(data)
+	return hasher.Sum(nil)
+}
+
+func generateEd25519Key(stream *seedStream) ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(stream.readBytes(ed25519.SeedSize))
+}
+
+// generateBlsKeypair derives the key with the KeyGen of the IETF BLS signature draft, also used by EIP-2333, and
+// returns the public key in G1 compressed, as Ethereum consensus clients expect
+func generateBlsKeypair(stream *seedStream) *keypair {
+	privateKey := blsKeyGen(stream.readBytes(blsKeyGenInputLength))
+	return &keypair{
+		privateKey:    hex.EncodeToString(fillBytes(privateKey, blsPrivateKeyLength)),
+		publicKey:     hex.EncodeToString(blsPublicKey(privateKey)),
+		privateKeyPem: "",
+		publicKeyPem:  "",
+		address:       "",
+	}
+}
+
+func blsKeyGen(inputKeyMaterial []byte) *big.Int {
+	salt := []byte(blsKeyGenSalt)
+	privateKey := new(big.Int)
+	for privateKey.Sign() == 0 {
+		saltHash := sha256.Sum256(salt)
+		salt = saltHash[:]
+		pseudoRandomKey := hkdf.Extract(sha256.New, append(append([]byte{}, inputKeyMaterial...), 0), salt)
+		outputKeyMaterial := make([]byte, blsKeyGenOutputLength)
+		keyInfo := []byte{0, blsKeyGenOutputLength}
+		_, _ = hkdf.Expand(sha256.New, pseudoRandomKey, keyInfo).Read(outputKeyMaterial)
+		privateKey.SetBytes(outputKeyMaterial).Mod(privateKey, blsScalarOrder)
+	}
+	return privateKey
+}
+
+// blsPublicKey returns the public key of the private key in G1 compressed
+func blsPublicKey(privateKey *big.Int) []byte {
+	scalar := new(bls12381.Scalar)
+	scalar.SetBytes(fillBytes(privateKey, blsPrivateKeyLength))
+	publicKey := new(bls12381.G1)
+	publicKey.ScalarMult(scalar, bls12381.G1Generator())
+	return publicKey.BytesCompressed()
+}
+
+// fillBytes returns the big endian representation of value on exactly length bytes
+func fillBytes(value *big.Int, length int) []byte {
+	return value.FillBytes(make([]byte, length))
+}
+
+// generateRsaKey searches the primes itself, as rsa.GenerateKey deliberately isn't deterministic even with a
+// deterministic source of randomness
+func generateRsaKey(stream *seedStream, bits int) (*rsa.PrivateKey, error) {
+	publicExponent := big.NewInt(rsaPublicExponent)
+	one := big.NewInt(1)
+	for {
+		firstPrime := generateRsaPrime(stream, bits/2, publicExponent)
+		secondPrime := generateRsaPrime(stream, bits-bits/2, publicExponent)
+		if firstPrime.Cmp(secondPrime) == 0 {
+			continue
+		}
+		modulus := new(big.Int).Mul(firstPrime, secondPrime)
+		if modulus.BitLen() != bits {
+			continue
+		}
+		totient := new(big.Int).Mul(new(big.Int).Sub(firstPrime, one), new(big.Int).Sub(secondPrime, one))
+		privateExponent := new(big.Int).ModInverse(publicExponent, totient)
+		if privateExponent == nil {
+			continue
+		}
+		privateKey := &rsa.PrivateKey{ // nolint: exhaustruct
+			PublicKey: rsa.PublicKey{
+				N: modulus,
+				E: rsaPublicExponent,
+			},
+			D:      privateExponent,
+			Primes: []*big.Int{firstPrime, secondPrime},
+		}
+		if err := privateKey.Validate(); err != nil {
+			return nil, stacktrace.Propagate(err, "The generated RSA key is invalid. This is a Kurtosis internal bug")
+		}
+		privateKey.Precompute()
+		return privateKey, nil
+	}
+}
+
+// generateRsaPrime returns a prime of exactly the given bits, with its two top bits set so that the product of two
+// of them has twice the bits, and coprime with the public exponent once decremented
+func generateRsaPrime(stream *seedStream, bits int, publicExponent *big.Int) *big.Int {
+	bytesLength := (bits + 7) / 8
+	extraBits := uint(bytesLength*8 - bits)
+	one := big.NewInt(1)
+	for {
+		candidateBytes := stream.readBytes(bytesLength)
+		candidateBytes[0] &= 0xff >> extraBits
+		candidateBytes[0] |= rsaPrimeSearchTopBitsMask >> extraBits
+		candidateBytes[bytesLength-1] |= 1
+		candidate := new(big.Int).SetBytes(candidateBytes)
+		if !candidate.ProbablyPrime(rsaPrimalityTestRounds) {
+			continue
+		}
+		if new(big.Int).GCD(nil, nil, new(big.Int).Sub(candidate, one), publicExponent).Cmp(one) != 0 {
+			continue
+		}
+		return candidate
+	}
+}
+
+// encodeX509Keypair sets the PEM keys, the private one in PKCS #8 and the public one in PKIX
+func encodeX509Keypair(privateKey crypto.Signer) (*keypair, error) {
+	privateKeyPem, err := encodePrivateKeyPem(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	publicKeyDer, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred encoding the public key")
+	}
+	return &keypair{
+		privateKey:    "",
+		publicKey:     "",
+		privateKeyPem: privateKeyPem,
+		publicKeyPem:  string(pem.EncodeToMemory(&pem.Block{Type: publicKeyPemType, Headers: nil, Bytes: publicKeyDer})),
+		address:       "",
+	}, nil
+}
+
+func encodePrivateKeyPem(privateKey crypto.Signer) (string, error) {
+	privateKeyDer, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred encoding the private key")
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: privateKeyPemType, Headers: nil, Bytes: privateKeyDer})), nil
+}
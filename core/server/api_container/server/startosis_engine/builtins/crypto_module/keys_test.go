@@ -0,0 +1,108 @@
+package crypto_module
+
+import (
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestEthereumAddress_PrivateKeyOne(t *testing.T) {
+	publicKey := secp256k1.NewPrivateKey(new(secp256k1.ModNScalar).SetInt(1)).PubKey()
+	require.Equal(t, "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf", ethereumAddress(publicKey.SerializeUncompressed()))
+}
+
+func TestBlsKeyGen_Eip2333MasterKey(t *testing.T) {
+	seed, err := hex.DecodeString("c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04")
+	require.NoError(t, err)
+	expectedPrivateKey, ok := new(big.Int).SetString("6083874454709270928345386274498605044986640685124978867557563392430687146096", 10)
+	require.True(t, ok)
+	require.Equal(t, expectedPrivateKey, blsKeyGen(seed))
+}
+
+func TestGenerateKeypair_IsDeterministic(t *testing.T) {
+	for _, keyType := range SupportedKeyTypes {
+		keys, err := generateKeypair(keyType, "seed", DefaultRsaBits)
+		require.NoError(t, err)
+		sameKeys, err := generateKeypair(keyType, "seed", DefaultRsaBits)
+		require.NoError(t, err)
+		otherKeys, err := generateKeypair(keyType, "other-seed", DefaultRsaBits)
+		require.NoError(t, err)
+		require.Equal(t, keys, sameKeys, "Key type '%s' isn't deterministic", keyType)
+		require.NotEqual(t, keys.privateKey, otherKeys.privateKey, "Key type '%s' ignores the seed", keyType)
+	}
+}
+
+func TestGenerateKeypair_Encodings(t *testing.T) {
+	secp256k1Keys, err := generateKeypair(Secp256k1KeyType, "seed", DefaultRsaBits)
+	require.NoError(t, err)
+	require.Len(t, secp256k1Keys.privateKey, 64)
+	require.Len(t, secp256k1Keys.publicKey, 130)
+	require.Regexp(t, "^0x[0-9a-fA-F]{40}$", secp256k1Keys.address)
+
+	blsKeys, err := generateKeypair(BlsKeyType, "seed", DefaultRsaBits)
+	require.NoError(t, err)
+	require.Len(t, blsKeys.privateKey, 64)
+	require.Len(t, blsKeys.publicKey, 96)
+
+	ed25519Keys, err := generateKeypair(Ed25519KeyType, "seed", DefaultRsaBits)
+	require.NoError(t, err)
+	require.Len(t, ed25519Keys.privateKey, 64)
+	require.Len(t, ed25519Keys.publicKey, 64)
+	block, _ := pem.Decode([]byte(ed25519Keys.privateKeyPem))
+	require.NotNil(t, block)
+	_, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	require.NoError(t, err)
+}
+
+func TestBlsPublicKey_PrivateKeyOneIsGenerator(t *testing.T) {
+	require.Equal(t, "97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb", hex.EncodeToString(blsPublicKey(big.NewInt(1))))
+}
+
+func TestGenerateCertificate_ChainVerifies(t *testing.T) {
+	for _, keyType := range SupportedCertificateKeyTypes {
+		ca, err := generateCertificate(&certificateRequest{
+			seed:         "seed",
+			commonName:   "Test CA",
+			dnsNames:     nil,
+			ipAddresses:  nil,
+			keyType:      keyType,
+			validityDays: DefaultCertificateValidityDays,
+			ca:           nil,
+		})
+		require.NoError(t, err)
+		leafRequest := &certificateRequest{
+			seed:         "seed",
+			commonName:   "node",
+			dnsNames:     []string{"node", "node.local"},
+			ipAddresses:  []net.IP{net.ParseIP("10.0.0.1")},
+			keyType:      keyType,
+			validityDays: 365,
+			ca:           ca,
+		}
+		leaf, err := generateCertificate(leafRequest)
+		require.NoError(t, err)
+		sameLeaf, err := generateCertificate(leafRequest)
+		require.NoError(t, err)
+		require.Equal(t, leaf, sameLeaf, "Certificates of key type '%s' aren't deterministic", keyType)
+
+		roots := x509.NewCertPool()
+		require.True(t, roots.AppendCertsFromPEM([]byte(ca.certificatePem)))
+		block, _ := pem.Decode([]byte(leaf.certificatePem))
+		require.NotNil(t, block)
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+		_, err = certificate.Verify(x509.VerifyOptions{ // nolint: exhaustruct
+			DNSName:     "node.local",
+			Roots:       roots,
+			CurrentTime: certificateNotBefore.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		require.Equal(t, "10.0.0.1", certificate.IPAddresses[0].String())
+	}
+}
@@ -0,0 +1,64 @@
+package crypto_module
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+const (
+	seedStreamKeySalt = "kurtosis-crypto-seed"
+)
+
+// seedStream is an endless stream of bytes deterministically derived from a seed and a purpose. Different purposes
+// give independent streams, so that the same seed can be used to derive several keys.
+//
+// Block i of the stream is HMAC-SHA256(key, len(purpose) || purpose || i), the key being HMAC-SHA256 of the seed.
+type seedStream struct {
+	key     []byte
+	purpose string
+	counter uint64
+	buffer  []byte
+}
+
+func newSeedStream(seed string, purpose string) *seedStream {
+	mac := hmac.New(sha256.New, []byte(seedStreamKeySalt))
+	// Writing to a hash never returns an error
+	_, _ = mac.Write([]byte(seed))
+	return &seedStream{
+		key:     mac.Sum(nil),
+		purpose: purpose,
+		counter: 0,
+		buffer:  nil,
+	}
+}
+
+// Read always fills the whole slice, as the stream never ends
+func (stream *seedStream) Read(bytes []byte) (int, error) {
+	read := 0
+	for read < len(bytes) {
+		if len(stream.buffer) == 0 {
+			stream.buffer = stream.nextBlock()
+		}
+		copied := copy(bytes[read:], stream.buffer)
+		stream.buffer = stream.buffer[copied:]
+		read += copied
+	}
+	return read, nil
+}
+
+// readBytes returns the next length bytes of the stream
+func (stream *seedStream) readBytes(length int) []byte {
+	bytes := make([]byte, length)
+	_, _ = stream.Read(bytes)
+	return bytes
+}
+
+func (stream *seedStream) nextBlock() []byte {
+	mac := hmac.New(sha256.New, stream.key)
+	_ = binary.Write(mac, binary.BigEndian, uint32(len(stream.purpose)))
+	_, _ = mac.Write([]byte(stream.purpose))
+	_ = binary.Write(mac, binary.BigEndian, stream.counter)
+	stream.counter++
+	return mac.Sum(nil)
+}
@@ -0,0 +1,62 @@
+package encoding_modules
+
+import (
+	"encoding/base64"
+	"fmt"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+const (
+	Base64ModuleName = "base64"
+
+	urlSafeArgName = "url_safe"
+)
+
+// Base64Module encodes data to standard or URL-safe base64, with padding, and decodes it
+func Base64Module() *starlarkstruct.Module {
+	return &starlarkstruct.Module{
+		Name: Base64ModuleName,
+		Members: starlark.StringDict{
+			encodeBuiltinName: starlark.NewBuiltin(Base64ModuleName+"."+encodeBuiltinName, base64Encode),
+			decodeBuiltinName: starlark.NewBuiltin(Base64ModuleName+"."+decodeBuiltinName, base64Decode),
+		},
+	}
+}
+
+func base64Encode(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	data, encoding, err := unpackBase64Args(builtin, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.String(encoding.EncodeToString([]byte(data))), nil
+}
+
+// base64Decode returns a string, so that the decoded data can be passed to the other builtins taking strings
+func base64Decode(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	data, encoding, err := unpackBase64Args(builtin, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	decodedData, err := encoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", builtin.Name(), err)
+	}
+	return starlark.String(decodedData), nil
+}
+
+func unpackBase64Args(builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (string, *base64.Encoding, error) {
+	var rawData starlark.Value
+	urlSafe := false
+	if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, dataArgName, &rawData, urlSafeArgName+"?", &urlSafe); err != nil {
+		return "", nil, err
+	}
+	data, err := dataToString(builtin.Name(), rawData)
+	if err != nil {
+		return "", nil, err
+	}
+	if urlSafe {
+		return data, base64.URLEncoding, nil
+	}
+	return data, base64.StdEncoding, nil
+}
@@ -0,0 +1,140 @@
+package encoding_modules
+
+import (
+	"fmt"
+	"go.starlark.net/starlark"
+	"sort"
+	"time"
+)
+
+const (
+	encodeBuiltinName = "encode"
+	decodeBuiltinName = "decode"
+
+	valueArgName = "value"
+	dataArgName  = "data"
+)
+
+// dataToString returns the content of a string or bytes value, so that the modules work both on strings, e.g. the
+// content returned by read_file, and on the bytes other modules return
+func dataToString(builtinName string, value starlark.Value) (string, error) {
+	switch value := value.(type) {
+	case starlark.String:
+		return value.GoString(), nil
+	case starlark.Bytes:
+		return string(value), nil
+	default:
+		return "", fmt.Errorf("%s: for parameter %s: got %s, want string or bytes", builtinName, dataArgName, value.Type())
+	}
+}
+
+// unpackDataArg unpacks the single string or bytes argument most builtins of these modules take
+func unpackDataArg(builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (string, error) {
+	var rawData starlark.Value
+	if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, dataArgName, &rawData); err != nil {
+		return "", err
+	}
+	return dataToString(builtin.Name(), rawData)
+}
+
+// goValueToStarlark converts the values decoders return. Maps are converted to dictionaries with sorted keys, as the
+// iteration order of Go maps would otherwise make the result change from one run to the other
+func goValueToStarlark(value any) (starlark.Value, error) {
+	switch value := value.(type) {
+	case nil:
+		return starlark.None, nil
+	case bool:
+		return starlark.Bool(value), nil
+	case string:
+		return starlark.String(value), nil
+	case int:
+		return starlark.MakeInt(value), nil
+	case int64:
+		return starlark.MakeInt64(value), nil
+	case uint64:
+		return starlark.MakeUint64(value), nil
+	case float64:
+		return starlark.Float(value), nil
+	case time.Time:
+		return starlark.String(value.Format(time.RFC3339Nano)), nil
+	case fmt.Stringer:
+		return starlark.String(value.String()), nil
+	case []any:
+		list := make([]starlark.Value, 0, len(value))
+		for _, element := range value {
+			starlarkElement, err := goValueToStarlark(element)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, starlarkElement)
+		}
+		return starlark.NewList(list), nil
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		dict := starlark.NewDict(len(value))
+		for _, key := range keys {
+			starlarkValue, err := goValueToStarlark(value[key])
+			if err != nil {
+				return nil, err
+			}
+			if err := dict.SetKey(starlark.String(key), starlarkValue); err != nil {
+				return nil, err
+			}
+		}
+		return dict, nil
+	default:
+		return nil, fmt.Errorf("values of type '%T' can't be converted to Starlark", value)
+	}
+}
+
+// starlarkValueToGo converts the values encoders take. Dictionaries must have string keys
+func starlarkValueToGo(value starlark.Value) (any, error) {
+	switch value := value.(type) {
+	case starlark.NoneType:
+		return nil, nil
+	case starlark.Bool:
+		return bool(value), nil
+	case starlark.String:
+		return value.GoString(), nil
+	case starlark.Bytes:
+		return string(value), nil
+	case starlark.Int:
+		intValue, ok := value.Int64()
+		if !ok {
+			return nil, fmt.Errorf("integer '%v' doesn't fit in 64 bits", value)
+		}
+		return intValue, nil
+	case starlark.Float:
+		return float64(value), nil
+	case starlark.Indexable: // lists and tuples
+		list := make([]any, 0, value.Len())
+		for index := 0; index < value.Len(); index++ {
+			element, err := starlarkValueToGo(value.Index(index))
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, element)
+		}
+		return list, nil
+	case *starlark.Dict:
+		goMap := make(map[string]any, value.Len())
+		for _, item := range value.Items() {
+			key, ok := item[0].(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("dictionary keys must be strings, got %s", item[0].Type())
+			}
+			element, err := starlarkValueToGo(item[1])
+			if err != nil {
+				return nil, err
+			}
+			goMap[key.GoString()] = element
+		}
+		return goMap, nil
+	default:
+		return nil, fmt.Errorf("values of type '%s' can't be encoded", value.Type())
+	}
+}
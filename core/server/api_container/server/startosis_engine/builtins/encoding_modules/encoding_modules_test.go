@@ -0,0 +1,131 @@
+package encoding_modules
+
+import (
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+func evalWithModules(t *testing.T, expression string) (starlark.Value, error) {
+	thread := &starlark.Thread{Name: t.Name()} // nolint: exhaustruct
+	predeclared := starlark.StringDict{
+		YamlModuleName:    YamlModule(),
+		TomlModuleName:    TomlModule(),
+		Base64ModuleName:  Base64Module(),
+		HexModuleName:     HexModule(),
+		HashlibModuleName: HashlibModule(),
+	}
+	return starlark.Eval(thread, t.Name(), expression, predeclared)
+}
+
+func requireEvalsTo(t *testing.T, expected string, expression string) {
+	result, err := evalWithModules(t, expression)
+	require.NoError(t, err)
+	require.Equal(t, expected, result.String())
+}
+
+func TestYamlModule_EncodeKeepsKeyOrder(t *testing.T) {
+	result, err := evalWithModules(t, `yaml.encode({"b": 1, "a": [True, None, 1.0, "true"], "c": {"d": "e"}})`)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String(`b: 1
+a:
+  - true
+  - null
+  - 1.0
+  - "true"
+c:
+  d: e
+`), result)
+}
+
+func TestYamlModule_Decode(t *testing.T) {
+	requireEvalsTo(t, `{"b": 1, "a": [True, None, 1.5, "x"], "c": {"d": "e"}}`, `yaml.decode("b: 1\na: [true, null, 1.5, x]\nc:\n  d: e\n")`)
+	requireEvalsTo(t, `{"base": {"port": 80}, "child": {"port": 80, "name": "child"}}`, `yaml.decode("base: &base\n  port: 80\nchild:\n  <<: *base\n  name: child\n")`)
+	requireEvalsTo(t, `None`, `yaml.decode("")`)
+	requireEvalsTo(t, `[{"a": 1}, {"b": 2}]`, `yaml.decode_all("a: 1\n---\nb: 2\n")`)
+}
+
+func TestYamlModule_RecursiveAliasFails(t *testing.T) {
+	for _, expression := range []string{
+		`yaml.decode("a: &x [1, *x]\n")`,
+		`yaml.decode("a: &x {b: *x}\n")`,
+		`yaml.decode("a: &x\n  <<: *x\n")`,
+	} {
+		_, err := evalWithModules(t, expression)
+		require.ErrorContains(t, err, "refers to a node holding it", "Expression '%v' was expected to fail", expression)
+	}
+}
+
+func TestYamlModule_AliasExpansionIsCapped(t *testing.T) {
+	billionLaughs := `a: &a ["lol","lol","lol","lol","lol","lol","lol","lol","lol"]
+b: &b [*a,*a,*a,*a,*a,*a,*a,*a,*a]
+c: &c [*b,*b,*b,*b,*b,*b,*b,*b,*b]
+d: &d [*c,*c,*c,*c,*c,*c,*c,*c,*c]
+e: &e [*d,*d,*d,*d,*d,*d,*d,*d,*d]
+f: &f [*e,*e,*e,*e,*e,*e,*e,*e,*e]
+g: &g [*f,*f,*f,*f,*f,*f,*f,*f,*f]
+h: &h [*g,*g,*g,*g,*g,*g,*g,*g,*g]
+i: &i [*h,*h,*h,*h,*h,*h,*h,*h,*h]
+`
+	_, err := evalWithModules(t, `yaml.decode(`+starlark.String(billionLaughs).String()+`)`)
+	require.ErrorContains(t, err, "expand to more than")
+}
+
+func TestYamlModule_RoundTrip(t *testing.T) {
+	requireEvalsTo(t, `True`, `yaml.decode(yaml.encode({"x": [1, 2.5, "3", {"y": None}]})) == {"x": [1, 2.5, "3", {"y": None}]}`)
+}
+
+func TestYamlModule_Failures(t *testing.T) {
+	for _, expression := range []string{
+		`yaml.decode("a: 1\n---\nb: 2\n")`,
+		`yaml.decode("a: [")`,
+		`yaml.encode({1: "a"})`,
+		`yaml.decode(1)`,
+	} {
+		_, err := evalWithModules(t, expression)
+		require.Error(t, err, "Expression '%v' was expected to fail", expression)
+	}
+}
+
+func TestTomlModule(t *testing.T) {
+	result, err := evalWithModules(t, `toml.encode({"title": "node", "server": {"port": 8080, "hosts": ["a", "b"]}, "debug": False})`)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String(`debug = false
+title = 'node'
+
+[server]
+hosts = ['a', 'b']
+port = 8080
+`), result)
+	requireEvalsTo(t, `{"debug": False, "server": {"hosts": ["a", "b"], "port": 8080}, "title": "node"}`, `toml.decode(`+result.String()+`)`)
+	requireEvalsTo(t, `{"date": "1979-05-27"}`, `toml.decode("date = 1979-05-27")`)
+
+	for _, expression := range []string{`toml.encode({"a": None})`, `toml.encode([1])`, `toml.decode("a = ")`} {
+		_, err := evalWithModules(t, expression)
+		require.Error(t, err, "Expression '%v' was expected to fail", expression)
+	}
+}
+
+func TestBase64Module(t *testing.T) {
+	requireEvalsTo(t, `"a3VydG9zaXM/Pw=="`, `base64.encode("kurtosis??")`)
+	requireEvalsTo(t, `"a3VydG9zaXM_Pw=="`, `base64.encode("kurtosis??", url_safe=True)`)
+	requireEvalsTo(t, `"kurtosis??"`, `base64.decode("a3VydG9zaXM/Pw==")`)
+	requireEvalsTo(t, `"kurtosis??"`, `base64.decode(b"a3VydG9zaXM_Pw==", url_safe=True)`)
+	_, err := evalWithModules(t, `base64.decode("not base64")`)
+	require.Error(t, err)
+}
+
+func TestHexModule(t *testing.T) {
+	requireEvalsTo(t, `"6b7572746f736973"`, `hex.encode("kurtosis")`)
+	requireEvalsTo(t, `"kurtosis"`, `hex.decode("6b7572746f736973")`)
+	requireEvalsTo(t, `"kurtosis"`, `hex.decode("0x6b7572746f736973")`)
+	_, err := evalWithModules(t, `hex.decode("0xzz")`)
+	require.Error(t, err)
+}
+
+func TestHashlibModule(t *testing.T) {
+	requireEvalsTo(t, `"e72501931443584936731e88dc7eb0ca7d66391c06c02ccf72bfb213367accaa"`, `hashlib.sha256("kurtosis")`)
+	requireEvalsTo(t, `"c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"`, `hashlib.keccak256("")`)
+	requireEvalsTo(t, `"cf83e1357eefb8bdf1542850d66d8007d620e4050b5715dc83f4a921d36ce9ce47d0d13c5d85f2b0ff8318d2877eec2f63b931bd47417a81a538327af927da3e"`, `hashlib.sha512("")`)
+	requireEvalsTo(t, `"c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"`, `hashlib.keccak256(hex.decode("0x"))`)
+}
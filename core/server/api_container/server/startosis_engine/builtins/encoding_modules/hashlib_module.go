@@ -0,0 +1,46 @@
+package encoding_modules
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"golang.org/x/crypto/sha3"
+	"hash"
+)
+
+const (
+	HashlibModuleName = "hashlib"
+
+	sha256BuiltinName    = "sha256"
+	sha512BuiltinName    = "sha512"
+	keccak256BuiltinName = "keccak256"
+)
+
+// HashlibModule hashes data. Unlike Python's hashlib, each builtin directly returns the hexadecimal digest, without
+// the '0x' prefix
+func HashlibModule() *starlarkstruct.Module {
+	return &starlarkstruct.Module{
+		Name: HashlibModuleName,
+		Members: starlark.StringDict{
+			sha256BuiltinName: starlark.NewBuiltin(HashlibModuleName+"."+sha256BuiltinName, newHashBuiltin(sha256.New)),
+			sha512BuiltinName: starlark.NewBuiltin(HashlibModuleName+"."+sha512BuiltinName, newHashBuiltin(sha512.New)),
+			// The Keccak-256 Ethereum uses, which pads differently than the standardized SHA3-256
+			keccak256BuiltinName: starlark.NewBuiltin(HashlibModuleName+"."+keccak256BuiltinName, newHashBuiltin(sha3.NewLegacyKeccak256)),
+		},
+	}
+}
+
+func newHashBuiltin(newHash func() hash.Hash) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		data, err := unpackDataArg(builtin, args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+		hasher := newHash()
+		// Writing to a hash never returns an error
+		_, _ = hasher.Write([]byte(data))
+		return starlark.String(hex.EncodeToString(hasher.Sum(nil))), nil
+	}
+}
@@ -0,0 +1,47 @@
+package encoding_modules
+
+import (
+	"encoding/hex"
+	"fmt"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"strings"
+)
+
+const (
+	HexModuleName = "hex"
+
+	hexPrefix = "0x"
+)
+
+// HexModule encodes data to lowercase hexadecimal and decodes it. Decoding accepts the '0x' prefix Ethereum tooling
+// writes, while encoding never adds it
+func HexModule() *starlarkstruct.Module {
+	return &starlarkstruct.Module{
+		Name: HexModuleName,
+		Members: starlark.StringDict{
+			encodeBuiltinName: starlark.NewBuiltin(HexModuleName+"."+encodeBuiltinName, hexEncode),
+			decodeBuiltinName: starlark.NewBuiltin(HexModuleName+"."+decodeBuiltinName, hexDecode),
+		},
+	}
+}
+
+func hexEncode(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	data, err := unpackDataArg(builtin, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.String(hex.EncodeToString([]byte(data))), nil
+}
+
+func hexDecode(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	data, err := unpackDataArg(builtin, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	decodedData, err := hex.DecodeString(strings.TrimPrefix(data, hexPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", builtin.Name(), err)
+	}
+	return starlark.String(decodedData), nil
+}
@@ -0,0 +1,83 @@
+package encoding_modules
+
+import (
+	"fmt"
+	"github.com/pelletier/go-toml/v2"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+const (
+	TomlModuleName = "toml"
+)
+
+// TomlModule encodes dictionaries to TOML documents and decodes them. TOML tables have no order, so keys are sorted
+// both ways to keep the result stable
+func TomlModule() *starlarkstruct.Module {
+	return &starlarkstruct.Module{
+		Name: TomlModuleName,
+		Members: starlark.StringDict{
+			encodeBuiltinName: starlark.NewBuiltin(TomlModuleName+"."+encodeBuiltinName, tomlEncode),
+			decodeBuiltinName: starlark.NewBuiltin(TomlModuleName+"."+decodeBuiltinName, tomlDecode),
+		},
+	}
+}
+
+func tomlEncode(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var value *starlark.Dict
+	if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, valueArgName, &value); err != nil {
+		return nil, err
+	}
+	goValue, err := starlarkValueToGo(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", builtin.Name(), err)
+	}
+	if err := checkNoNoneInToml(goValue); err != nil {
+		return nil, fmt.Errorf("%s: %w", builtin.Name(), err)
+	}
+	encodedValue, err := toml.Marshal(goValue)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", builtin.Name(), err)
+	}
+	return starlark.String(encodedValue), nil
+}
+
+func tomlDecode(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	data, err := unpackDataArg(builtin, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	var decodedValue map[string]any
+	if err := toml.Unmarshal([]byte(data), &decodedValue); err != nil {
+		return nil, fmt.Errorf("%s: %w", builtin.Name(), err)
+	}
+	if decodedValue == nil {
+		return starlark.NewDict(0), nil
+	}
+	value, err := goValueToStarlark(decodedValue)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", builtin.Name(), err)
+	}
+	return value, nil
+}
+
+// checkNoNoneInToml fails on None values, as TOML has no null and would otherwise silently drop them
+func checkNoNoneInToml(value any) error {
+	switch value := value.(type) {
+	case nil:
+		return fmt.Errorf("TOML has no null value, None can't be encoded")
+	case []any:
+		for _, element := range value {
+			if err := checkNoNoneInToml(element); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		for key, element := range value {
+			if err := checkNoNoneInToml(element); err != nil {
+				return fmt.Errorf("key '%s': %w", key, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+package encoding_modules
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"gopkg.in/yaml.v3"
+	"io"
+	"math"
+	"strconv"
+)
+
+const (
+	YamlModuleName = "yaml"
+
+	decodeAllBuiltinName = "decode_all"
+
+	yamlIndent      = 2
+	yamlMergeKeyTag = "!!merge"
+
+	// caps the nodes produced by expanding aliases, as a few nested aliases can expand to billions of them
+	maxYamlAliasExpandedNodes = 100_000
+)
+
+// YamlModule encodes Starlark values to YAML and decodes YAML documents. Dictionaries keep the order of their keys
+// both ways, so that encoding the same value always returns the same document
+func YamlModule() *starlarkstruct.Module {
+	return &starlarkstruct.Module{
+		Name: YamlModuleName,
+		Members: starlark.StringDict{
+			encodeBuiltinName:    starlark.NewBuiltin(YamlModuleName+"."+encodeBuiltinName, yamlEncode),
+			decodeBuiltinName:    starlark.NewBuiltin(YamlModuleName+"."+decodeBuiltinName, yamlDecode),
+			decodeAllBuiltinName: starlark.NewBuiltin(YamlModuleName+"."+decodeAllBuiltinName, yamlDecodeAll),
+		},
+	}
+}
+
+func yamlEncode(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var value starlark.Value
+	if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, valueArgName, &value); err != nil {
+		return nil, err
+	}
+	node, err := starlarkValueToYamlNode(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", builtin.Name(), err)
+	}
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(yamlIndent)
+	if err := encoder.Encode(node); err != nil {
+		return nil, fmt.Errorf("%s: %w", builtin.Name(), err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("%s: %w", builtin.Name(), err)
+	}
+	return starlark.String(buffer.String()), nil
+}
+
+func yamlDecode(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	documents, err := decodeYamlDocuments(builtin, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	switch len(documents) {
+	case 0:
+		return starlark.None, nil
+	case 1:
+		return documents[0], nil
+	default:
+		return nil, fmt.Errorf("%s: the input holds %d documents, use %s.%s to decode all of them", builtin.Name(), len(documents), YamlModuleName, decodeAllBuiltinName)
+	}
+}
+
+func yamlDecodeAll(_ *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	documents, err := decodeYamlDocuments(builtin, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.NewList(documents), nil
+}
+
+func decodeYamlDocuments(builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) ([]starlark.Value, error) {
+	data, err := unpackDataArg(builtin, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	decoder := yaml.NewDecoder(bytes.NewBufferString(data))
+	documents := []starlark.Value{}
+	for {
+		var document yaml.Node
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("%s: %w", builtin.Name(), err)
+		}
+		value, err := newYamlNodeConverter().toStarlarkValue(&document)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", builtin.Name(), err)
+		}
+		documents = append(documents, value)
+	}
+	return documents, nil
+}
+
+// yamlNodeConverter walks the node tree rather than decoding into Go maps, to keep the order of the keys. It tracks
+// the aliases being expanded, as an alias can point to a node holding it, and the nodes aliases expanded to
+type yamlNodeConverter struct {
+	aliasesBeingExpanded map[*yaml.Node]bool
+	aliasExpandedNodes   int
+}
+
+func newYamlNodeConverter() *yamlNodeConverter {
+	return &yamlNodeConverter{
+		aliasesBeingExpanded: map[*yaml.Node]bool{},
+		aliasExpandedNodes:   0,
+	}
+}
+
+func (converter *yamlNodeConverter) toStarlarkValue(node *yaml.Node) (starlark.Value, error) {
+	if err := converter.countNode(); err != nil {
+		return nil, err
+	}
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return starlark.None, nil
+		}
+		return converter.toStarlarkValue(node.Content[0])
+	case yaml.AliasNode:
+		if err := converter.startExpandingAlias(node); err != nil {
+			return nil, err
+		}
+		defer converter.stopExpandingAlias(node)
+		return converter.toStarlarkValue(node.Alias)
+	case yaml.SequenceNode:
+		list := make([]starlark.Value, 0, len(node.Content))
+		for _, elementNode := range node.Content {
+			element, err := converter.toStarlarkValue(elementNode)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, element)
+		}
+		return starlark.NewList(list), nil
+	case yaml.MappingNode:
+		dict := starlark.NewDict(len(node.Content) / 2)
+		if err := converter.addMappingToDict(dict, node); err != nil {
+			return nil, err
+		}
+		return dict, nil
+	case yaml.ScalarNode:
+		var value any
+		if err := node.Decode(&value); err != nil {
+			return nil, err
+		}
+		return goValueToStarlark(value)
+	default:
+		return nil, fmt.Errorf("YAML node of kind '%v' at line %d isn't supported", node.Kind, node.Line)
+	}
+}
+
+func (converter *yamlNodeConverter) addMappingToDict(dict *starlark.Dict, node *yaml.Node) error {
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		keyNode, valueNode := node.Content[index], node.Content[index+1]
+		if keyNode.Tag == yamlMergeKeyTag {
+			if err := converter.mergeMappingsIntoDict(dict, valueNode); err != nil {
+				return err
+			}
+			continue
+		}
+		key, err := converter.toStarlarkValue(keyNode)
+		if err != nil {
+			return err
+		}
+		value, err := converter.toStarlarkValue(valueNode)
+		if err != nil {
+			return err
+		}
+		if err := dict.SetKey(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeMappingsIntoDict resolves the '<<' merge key, whose value is a mapping or a list of mappings
+func (converter *yamlNodeConverter) mergeMappingsIntoDict(dict *starlark.Dict, node *yaml.Node) error {
+	if node.Kind == yaml.AliasNode {
+		if err := converter.startExpandingAlias(node); err != nil {
+			return err
+		}
+		defer converter.stopExpandingAlias(node)
+		return converter.mergeMappingsIntoDict(dict, node.Alias)
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		return converter.addMappingToDict(dict, node)
+	case yaml.SequenceNode:
+		for _, mappingNode := range node.Content {
+			if err := converter.mergeMappingsIntoDict(dict, mappingNode); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("the merge key at line %d must be followed by a mapping or a list of mappings", node.Line)
+	}
+}
+
+func (converter *yamlNodeConverter) startExpandingAlias(aliasNode *yaml.Node) error {
+	if converter.aliasesBeingExpanded[aliasNode.Alias] {
+		return fmt.Errorf("the alias '%s' at line %d refers to a node holding it", aliasNode.Value, aliasNode.Line)
+	}
+	converter.aliasesBeingExpanded[aliasNode.Alias] = true
+	return nil
+}
+
+func (converter *yamlNodeConverter) stopExpandingAlias(aliasNode *yaml.Node) {
+	delete(converter.aliasesBeingExpanded, aliasNode.Alias)
+}
+
+func (converter *yamlNodeConverter) countNode() error {
+	if len(converter.aliasesBeingExpanded) == 0 {
+		return nil
+	}
+	converter.aliasExpandedNodes++
+	if converter.aliasExpandedNodes > maxYamlAliasExpandedNodes {
+		return fmt.Errorf("the aliases of the document expand to more than %d nodes", maxYamlAliasExpandedNodes)
+	}
+	return nil
+}
+
+func starlarkValueToYamlNode(value starlark.Value) (*yaml.Node, error) {
+	switch value := value.(type) {
+	case starlark.NoneType:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil // nolint: exhaustruct
+	case starlark.Bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(bool(value))}, nil // nolint: exhaustruct
+	case starlark.Int:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: value.String()}, nil // nolint: exhaustruct
+	case starlark.Float:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: formatYamlFloat(float64(value))}, nil // nolint: exhaustruct
+	case starlark.String:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value.GoString()}, nil // nolint: exhaustruct
+	case starlark.Bytes:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: string(value)}, nil // nolint: exhaustruct
+	case *starlark.Dict:
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"} // nolint: exhaustruct
+		for _, item := range value.Items() {
+			key, ok := item[0].(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("dictionary keys must be strings, got %s", item[0].Type())
+			}
+			keyNode, err := starlarkValueToYamlNode(key)
+			if err != nil {
+				return nil, err
+			}
+			valueNode, err := starlarkValueToYamlNode(item[1])
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, keyNode, valueNode)
+		}
+		return node, nil
+	case starlark.Indexable: // lists and tuples
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"} // nolint: exhaustruct
+		for index := 0; index < value.Len(); index++ {
+			elementNode, err := starlarkValueToYamlNode(value.Index(index))
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, elementNode)
+		}
+		return node, nil
+	default:
+		return nil, fmt.Errorf("values of type '%s' can't be encoded", value.Type())
+	}
+}
+
+func formatYamlFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return ".inf"
+	case math.IsInf(value, -1):
+		return "-.inf"
+	case math.IsNaN(value):
+		return ".nan"
+	default:
+		// Unlike strconv, Starlark always writes a decimal point, so that the float isn't decoded back as an integer
+		return starlark.Float(value).String()
+	}
+}
@@ -3,6 +3,7 @@ package builtins
 import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/crypto_module"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	starlarkjson "go.starlark.net/lib/json"
 	"go.starlark.net/starlark"
@@ -26,9 +27,12 @@ func KurtosisModule(thread *starlark.Thread, enclaveUuid enclave.EnclaveUUID, en
 		return nil, interpretationErr
 	}
 
+	members := *enclaveEnvVarsStringDict
+	members[crypto_module.CryptoModuleName] = crypto_module.CryptoModule()
+
 	return &starlarkstruct.Module{
 		Name:    KurtosisModuleName,
-		Members: *enclaveEnvVarsStringDict,
+		Members: members,
 	}, nil
 }
 
@@ -3,6 +3,7 @@ package enclave_plan_persistence
 import (
 	"bytes"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"strings"
 )
 
 type EnclavePlanInstruction struct {
@@ -18,6 +19,10 @@ type EnclavePlanInstruction struct {
 
 	// mapping between files artifact name and files artifact MD5
 	FilesArtifacts map[string][]byte `json:"filesArtifacts"` // FSA byte arrays are automatically serialized as base64 encoded strings
+
+	// the instructions of the sub-plans of instructions like `if_`, which are resolved along with the instruction
+	// running them. Their UUIDs and returned values are left empty
+	SubPlanInstructions []*EnclavePlanInstruction `json:"subPlanInstructions,omitempty"`
 }
 
 // HasOnlyServiceName is a convenience function that returns true if the enclave plan instruction has only
@@ -63,12 +68,26 @@ func (enclavePlanInstruction *EnclavePlanInstruction) Clone() *EnclavePlanInstru
 		copy(filesArtifactMd5, clonedFilesArtifactMd5)
 		clonedFilesArtifacts[filesArtifactName] = clonedFilesArtifactMd5
 	}
+
+	var clonedSubPlanInstructions []*EnclavePlanInstruction
+	for _, subPlanInstruction := range enclavePlanInstruction.SubPlanInstructions {
+		clonedSubPlanInstructions = append(clonedSubPlanInstructions, subPlanInstruction.Clone())
+	}
 	return &EnclavePlanInstruction{
-		Uuid:           enclavePlanInstruction.Uuid,
-		Type:           enclavePlanInstruction.Type,
-		StarlarkCode:   enclavePlanInstruction.StarlarkCode,
-		ReturnedValue:  enclavePlanInstruction.ReturnedValue,
-		ServiceNames:   clonedServiceNames,
-		FilesArtifacts: clonedFilesArtifacts,
+		Uuid:                enclavePlanInstruction.Uuid,
+		Type:                enclavePlanInstruction.Type,
+		StarlarkCode:        enclavePlanInstruction.StarlarkCode,
+		ReturnedValue:       enclavePlanInstruction.ReturnedValue,
+		ServiceNames:        clonedServiceNames,
+		FilesArtifacts:      clonedFilesArtifacts,
+		SubPlanInstructions: clonedSubPlanInstructions,
+	}
+}
+
+// ReplaceInStarlarkCode replaces a string in the Starlark code of the instruction and of its sub-plan instructions
+func (enclavePlanInstruction *EnclavePlanInstruction) ReplaceInStarlarkCode(old string, new string) {
+	enclavePlanInstruction.StarlarkCode = strings.ReplaceAll(enclavePlanInstruction.StarlarkCode, old, new)
+	for _, subPlanInstruction := range enclavePlanInstruction.SubPlanInstructions {
+		subPlanInstruction.ReplaceInStarlarkCode(old, new)
 	}
 }
@@ -3,6 +3,7 @@ package enclave_plan_persistence
 import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/stacktrace"
+	"strings"
 )
 
 type EnclavePlanInstructionBuilder struct {
@@ -17,6 +18,8 @@ type EnclavePlanInstructionBuilder struct {
 	serviceNames []string
 
 	filesArtifacts map[string][]byte
+
+	subPlanInstructions []*EnclavePlanInstructionBuilder
 }
 
 func NewEnclavePlanInstructionBuilder() *EnclavePlanInstructionBuilder {
@@ -27,6 +30,8 @@ func NewEnclavePlanInstructionBuilder() *EnclavePlanInstructionBuilder {
 		returnedValue:   "",
 		serviceNames:    []string{},
 		filesArtifacts:  map[string][]byte{},
+
+		subPlanInstructions: nil,
 	}
 }
 
@@ -60,16 +65,60 @@ func (builder *EnclavePlanInstructionBuilder) AddFilesArtifact(filesArtifactName
 	return builder
 }
 
+// AddSubPlanInstruction adds the attributes of an instruction of a sub-plan of this instruction. Its UUID and returned
+// value aren't needed
+func (builder *EnclavePlanInstructionBuilder) AddSubPlanInstruction(subPlanInstructionBuilder *EnclavePlanInstructionBuilder) *EnclavePlanInstructionBuilder {
+	builder.subPlanInstructions = append(builder.subPlanInstructions, subPlanInstructionBuilder)
+	return builder
+}
+
+// ReplaceInStarlarkCode replaces a string in the Starlark code of the instruction and of its sub-plan instructions
+func (builder *EnclavePlanInstructionBuilder) ReplaceInStarlarkCode(old string, new string) *EnclavePlanInstructionBuilder {
+	builder.starlarkCode = strings.ReplaceAll(builder.starlarkCode, old, new)
+	for _, subPlanInstructionBuilder := range builder.subPlanInstructions {
+		subPlanInstructionBuilder.ReplaceInStarlarkCode(old, new)
+	}
+	return builder
+}
+
 func (builder *EnclavePlanInstructionBuilder) Build() (*EnclavePlanInstruction, error) {
 	if builder.uuid == "" || builder.instructionType == "" || builder.starlarkCode == "" || builder.returnedValue == "" {
 		return nil, stacktrace.NewError("Some required attributes aren't set on this builder")
 	}
+	subPlanInstructions, err := builder.buildSubPlanInstructions()
+	if err != nil {
+		return nil, err
+	}
 	return &EnclavePlanInstruction{
-		Uuid:           builder.uuid,
-		Type:           builder.instructionType,
-		StarlarkCode:   builder.starlarkCode,
-		ReturnedValue:  builder.returnedValue,
-		ServiceNames:   builder.serviceNames,
-		FilesArtifacts: builder.filesArtifacts,
+		Uuid:                builder.uuid,
+		Type:                builder.instructionType,
+		StarlarkCode:        builder.starlarkCode,
+		ReturnedValue:       builder.returnedValue,
+		ServiceNames:        builder.serviceNames,
+		FilesArtifacts:      builder.filesArtifacts,
+		SubPlanInstructions: subPlanInstructions,
 	}, nil
 }
+
+func (builder *EnclavePlanInstructionBuilder) buildSubPlanInstructions() ([]*EnclavePlanInstruction, error) {
+	var subPlanInstructions []*EnclavePlanInstruction
+	for _, subPlanInstructionBuilder := range builder.subPlanInstructions {
+		if subPlanInstructionBuilder.instructionType == "" || subPlanInstructionBuilder.starlarkCode == "" {
+			return nil, stacktrace.NewError("Some required attributes aren't set on the builder of a sub-plan instruction")
+		}
+		nestedSubPlanInstructions, err := subPlanInstructionBuilder.buildSubPlanInstructions()
+		if err != nil {
+			return nil, err
+		}
+		subPlanInstructions = append(subPlanInstructions, &EnclavePlanInstruction{
+			Uuid:                "",
+			Type:                subPlanInstructionBuilder.instructionType,
+			StarlarkCode:        subPlanInstructionBuilder.starlarkCode,
+			ReturnedValue:       "",
+			ServiceNames:        subPlanInstructionBuilder.serviceNames,
+			FilesArtifacts:      subPlanInstructionBuilder.filesArtifacts,
+			SubPlanInstructions: nestedSubPlanInstructions,
+		})
+	}
+	return subPlanInstructions, nil
+}
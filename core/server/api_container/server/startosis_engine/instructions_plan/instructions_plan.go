@@ -12,7 +12,8 @@ import (
 // InstructionsPlan is the object to store a sequence of instructions which forms a "plan" for the enclave.
 // Right now, the object is fairly simple in the sense of it just stores literally the sequence of instructions, and
 // a bit of metadata about each instruction (i.e. whether it has been executed of not, for example)
-// The plan is "append-only", i.e. when an instruction is added, it cannot be removed.
+// The plan is "append-only", i.e. when an instruction is added, it cannot be removed, apart from the instructions
+// taken out of it by the instructions running sub-plans (see TakeInstructionsAddedPastSize).
 // The only read method is GeneratePlan unwraps the plan into an actual list of instructions that can be submitted to
 // the executor.
 type InstructionsPlan struct {
@@ -91,6 +92,24 @@ func (plan *InstructionsPlan) GenerateYaml(planYaml *plan_yaml.PlanYaml) (string
 	return planYaml.GenerateYaml()
 }
 
+// TakeInstructionsAddedPastSize removes the instructions added to the plan once it had reached the given size, and
+// returns them in order. The instructions running sub-plans, like `plan.if_`, take this way the instructions added by
+// their Starlark bodies, which they then run themselves
+func (plan *InstructionsPlan) TakeInstructionsAddedPastSize(size int) []*ScheduledInstruction {
+	if size >= len(plan.instructionsSequence) {
+		return []*ScheduledInstruction{}
+	}
+	var takenInstructions []*ScheduledInstruction
+	for _, instructionUuid := range plan.instructionsSequence[size:] {
+		if instruction, found := plan.scheduledInstructionsIndex[instructionUuid]; found {
+			takenInstructions = append(takenInstructions, instruction)
+		}
+		delete(plan.scheduledInstructionsIndex, instructionUuid)
+	}
+	plan.instructionsSequence = plan.instructionsSequence[:size]
+	return takenInstructions
+}
+
 func (plan *InstructionsPlan) Size() int {
 	return len(plan.instructionsSequence)
 }
@@ -7,10 +7,12 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/directory"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/port_spec"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
@@ -78,7 +80,10 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 			if !service_directory.IsPersistentKeyValid(directory.PersistentKey) {
 				return startosis_errors.NewValidationError(invalidPersistentKeyErrorText(directory.PersistentKey))
 			}
-			validatorEnvironment.AddPersistentKey(directory.PersistentKey)
+			if directory.HasStorageOptions() && validatorEnvironment.GetKurtosisBackendType() != args.KurtosisBackendType_Kubernetes {
+				return startosis_errors.NewValidationError(unsupportedPersistentDirectoryStorageOptionsErrorText(serviceName, directory, validatorEnvironment.GetKurtosisBackendType()))
+			}
+			validatorEnvironment.AddPersistentKey(directory.PersistentKey, directory.Size)
 		}
 	}
 
@@ -148,6 +153,24 @@ func invalidPortNameErrorText(
 	)
 }
 
+func unsupportedPersistentDirectoryStorageOptionsErrorText(
+	serviceName service.ServiceName,
+	persistentDirectory service_directory.PersistentDirectory,
+	kurtosisBackendType args.KurtosisBackendType,
+) string {
+	return fmt.Sprintf(
+		"Persistent directory '%v' of service '%v' sets '%v' to '%v' and '%v' to '%v' but the '%v' backend doesn't support them; they are only supported on Kubernetes. Remove them to use the default storage of the '%v' backend.",
+		persistentDirectory.PersistentKey,
+		serviceName,
+		directory.StorageClassAttr,
+		persistentDirectory.StorageClass,
+		directory.AccessModeAttr,
+		persistentDirectory.AccessMode,
+		kurtosisBackendType,
+		kurtosisBackendType,
+	)
+}
+
 func invalidPersistentKeyErrorText(
 	persistentKey service_directory.DirectoryPersistentKey,
 ) string {
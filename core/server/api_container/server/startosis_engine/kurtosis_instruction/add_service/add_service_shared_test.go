@@ -7,10 +7,13 @@ import (
 	"testing"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/stretchr/testify/require"
 	bolt "go.etcd.io/bbolt"
 	"go.starlark.net/starlark"
@@ -129,6 +132,28 @@ func TestAddServiceShared_ServiceNameWithRuntimeValuesAreReplaced(t *testing.T)
 	require.Equal(t, service.ServiceName("database-1"), replacedServiceName)
 }
 
+func TestValidateSingleService_PersistentDirectoryStorageOptionsOnlyOnKubernetes(t *testing.T) {
+	serviceName := service.ServiceName("example-datastore-server")
+	persistentDirectories := service_directory.NewPersistentDirectories(map[string]service_directory.PersistentDirectory{
+		"/data": {
+			PersistentKey: service_directory.DirectoryPersistentKey("data"),
+			Size:          service_directory.DirectoryPersistentSize(1024),
+			StorageClass:  "fast-ssd",
+			AccessMode:    service_directory.AccessMode_ReadWriteOnce,
+		},
+	})
+	serviceConfig, err := service.CreateServiceConfig(testContainerImageName, nil, nil, nil, nil, nil, nil, nil, nil, nil, persistentDirectories, 0, 0, "", 0, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true)
+	require.NoError(t, err)
+
+	dockerValidatorEnvironment := startosis_validator.NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, 0, 0, false, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, nil)
+	validationErr := validateSingleService(dockerValidatorEnvironment, serviceName, serviceConfig)
+	require.NotNil(t, validationErr)
+	require.Contains(t, validationErr.Error(), "only supported on Kubernetes")
+
+	kubernetesValidatorEnvironment := startosis_validator.NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, 0, 0, false, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Kubernetes, nil)
+	require.Nil(t, validateSingleService(kubernetesValidatorEnvironment, serviceName, serviceConfig))
+}
+
 func getEnclaveDBForTest(t *testing.T) *enclave_db.EnclaveDB {
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer func() {
@@ -0,0 +1,180 @@
+package control_flow
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"reflect"
+)
+
+const (
+	ForEachBuiltinName = "for_each"
+
+	ItemsArgName = "items"
+	FnArgName    = "fn"
+
+	// itemRuntimeValueField is the field of the runtime value the body gets as its item
+	itemRuntimeValueField = "item"
+
+	forEachBodyName = "the body of the loop"
+
+	forEachDescription = "Running the body of the loop for each item of a list known at execution time"
+)
+
+func NewForEach(runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: ForEachBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ItemsArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Comparable],
+					Validator:         validateItems,
+				},
+				{
+					Name:              FnArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Callable],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return validateCallable(value, FnArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &ForEachCapabilities{
+				runtimeValueStore: runtimeValueStore,
+				subPlanRecorder:   nil, // set by the framework before interpretation
+
+				items:            nil, // populated at interpretation time
+				itemUuid:         "",  // populated at interpretation time
+				bodyInstructions: nil, // populated at interpretation time
+				description:      "",  // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ItemsArgName: true,
+		},
+	}
+}
+
+// ForEachCapabilities records the instructions of the body once at interpretation time, the body getting a runtime
+// value as its item. At execution time, the runtime value is set to each item of the list in turn before the
+// instructions of the body run
+type ForEachCapabilities struct {
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+	subPlanRecorder   *kurtosis_plan_instruction.SubPlanRecorder
+
+	items starlark.Comparable
+
+	itemUuid string
+
+	bodyInstructions []kurtosis_instruction.KurtosisInstruction
+
+	description string
+}
+
+func (builtin *ForEachCapabilities) SetSubPlanRecorder(recorder *kurtosis_plan_instruction.SubPlanRecorder) {
+	builtin.subPlanRecorder = recorder
+}
+
+func (builtin *ForEachCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	items, err := builtin_argument.ExtractArgumentValue[starlark.Comparable](arguments, ItemsArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ItemsArgName)
+	}
+	builtin.items = items
+
+	fn, err := builtin_argument.ExtractArgumentValue[starlark.Callable](arguments, FnArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", FnArgName)
+	}
+	itemUuid, err := builtin.runtimeValueStore.CreateValue()
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating the runtime value holding the item of the loop")
+	}
+	builtin.itemUuid = itemUuid
+	item := starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, itemUuid, itemRuntimeValueField))
+
+	var interpretationErr *startosis_errors.InterpretationError
+	if builtin.bodyInstructions, interpretationErr = builtin.subPlanRecorder.Record(fn, starlark.Tuple{item}); interpretationErr != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(interpretationErr, "An error occurred interpreting %s", forEachBodyName)
+	}
+
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, forEachDescription)
+	return starlark.None, nil
+}
+
+func (builtin *ForEachCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	return validateSubPlan(forEachBodyName, builtin.bodyInstructions, validatorEnvironment)
+}
+
+func (builtin *ForEachCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	items, err := resolveRuntimeValue(builtin.items, builtin.runtimeValueStore)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred resolving the items of the loop")
+	}
+	indexableItems, ok := items.(starlark.Indexable)
+	if !ok {
+		return "", stacktrace.NewError("The items of the loop should be a list, got '%s' of type '%s'", items.String(), items.Type())
+	}
+
+	var outputs []string
+	for idx := 0; idx < indexableItems.Len(); idx++ {
+		item, ok := indexableItems.Index(idx).(starlark.Comparable)
+		if !ok {
+			return "", stacktrace.NewError("Item '%s' at index %d of the loop can't be stored as a runtime value", indexableItems.Index(idx).String(), idx)
+		}
+		if err := builtin.runtimeValueStore.SetValue(builtin.itemUuid, map[string]starlark.Comparable{itemRuntimeValueField: item}); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred setting the runtime value holding the item of the loop to '%s'", item.String())
+		}
+		itemOutputs, err := executeSubPlan(ctx, fmt.Sprintf("%s for item '%s' at index %d", forEachBodyName, item.String(), idx), builtin.bodyInstructions)
+		if err != nil {
+			return "", err
+		}
+		outputs = append(outputs, formatSubPlanOutputs(fmt.Sprintf("Item '%s' at index %d:", item.String(), idx), itemOutputs))
+	}
+	return formatSubPlanOutputs(fmt.Sprintf("Ran %s for %d items", forEachBodyName, indexableItems.Len()), outputs), nil
+}
+
+func (builtin *ForEachCapabilities) TryResolveWith(instructionsAreEqual bool, other *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	return resolveSubPlan(ForEachBuiltinName, instructionsAreEqual, other, builtin.bodyInstructions, []string{builtin.itemUuid}, enclaveComponents)
+}
+
+func (builtin *ForEachCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	fillSubPlanPersistableAttributes(ForEachBuiltinName, builtin.bodyInstructions, []string{builtin.itemUuid}, builder)
+}
+
+func (builtin *ForEachCapabilities) UpdatePlan(plan *plan_yaml.PlanYaml) error {
+	return updatePlanWithSubPlan(forEachBodyName, builtin.bodyInstructions, plan)
+}
+
+func (builtin *ForEachCapabilities) Description() string {
+	return builtin.description
+}
+
+// validateItems accepts a list known at interpretation time, or a string holding the runtime value of a list
+func validateItems(value starlark.Value) *startosis_errors.InterpretationError {
+	switch value.(type) {
+	case *starlark.List, starlark.Tuple, starlark.String:
+		return nil
+	default:
+		return startosis_errors.NewInterpretationError("The '%s' argument should be a list or a runtime value holding a list, got '%s'", ItemsArgName, reflect.TypeOf(value))
+	}
+}
@@ -0,0 +1,247 @@
+package control_flow
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/verify"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	IfBuiltinName = "if_"
+
+	ConditionArgName = "condition"
+	ThenArgName      = "then"
+	ElseArgName      = "else_"
+	AssertionArgName = "assertion"
+	TargetArgName    = "target_value"
+
+	thenBranchName = "the 'then' branch"
+	elseBranchName = "the 'else_' branch"
+
+	ifDescription = "Running the branch matching a condition evaluated at execution time"
+)
+
+func NewIf(runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: IfBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ConditionArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Comparable],
+					Validator:         nil,
+				},
+				{
+					Name:              ThenArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Callable],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return validateCallable(value, ThenArgName)
+					},
+				},
+				{
+					Name:              ElseArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Callable],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return validateCallable(value, ElseArgName)
+					},
+				},
+				{
+					Name:              AssertionArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         verify.ValidateVerificationToken,
+				},
+				{
+					Name:              TargetArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Comparable],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &IfCapabilities{
+				runtimeValueStore: runtimeValueStore,
+				subPlanRecorder:   nil, // set by the framework before interpretation
+
+				condition:        nil, // populated at interpretation time
+				assertion:        "",  // populated at interpretation time
+				target:           nil, // populated at interpretation time
+				thenInstructions: nil, // populated at interpretation time
+				elseInstructions: nil, // populated at interpretation time
+				description:      "",  // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ConditionArgName: true,
+			AssertionArgName: true,
+			TargetArgName:    true,
+		},
+	}
+}
+
+// IfCapabilities records the instructions of both branches at interpretation time, and runs the ones of the branch
+// matching the condition at execution time, once the runtime values the condition refers to are known
+type IfCapabilities struct {
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+	subPlanRecorder   *kurtosis_plan_instruction.SubPlanRecorder
+
+	condition starlark.Comparable
+
+	// assertion and target are empty when the truth value of the condition is used
+	assertion string
+	target    starlark.Comparable
+
+	thenInstructions []kurtosis_instruction.KurtosisInstruction
+	elseInstructions []kurtosis_instruction.KurtosisInstruction
+
+	description string
+}
+
+func (builtin *IfCapabilities) SetSubPlanRecorder(recorder *kurtosis_plan_instruction.SubPlanRecorder) {
+	builtin.subPlanRecorder = recorder
+}
+
+func (builtin *IfCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	condition, err := builtin_argument.ExtractArgumentValue[starlark.Comparable](arguments, ConditionArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ConditionArgName)
+	}
+	builtin.condition = condition
+
+	if arguments.IsSet(AssertionArgName) != arguments.IsSet(TargetArgName) {
+		return nil, startosis_errors.NewInterpretationError("The '%s' and '%s' arguments should be set together", AssertionArgName, TargetArgName)
+	}
+	if arguments.IsSet(AssertionArgName) {
+		assertion, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, AssertionArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", AssertionArgName)
+		}
+		target, err := builtin_argument.ExtractArgumentValue[starlark.Comparable](arguments, TargetArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", TargetArgName)
+		}
+		builtin.assertion = assertion.GoString()
+		builtin.target = target
+	}
+
+	thenBody, err := builtin_argument.ExtractArgumentValue[starlark.Callable](arguments, ThenArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ThenArgName)
+	}
+	var interpretationErr *startosis_errors.InterpretationError
+	if builtin.thenInstructions, interpretationErr = builtin.subPlanRecorder.Record(thenBody, starlark.Tuple{}); interpretationErr != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(interpretationErr, "An error occurred interpreting %s", thenBranchName)
+	}
+	if arguments.IsSet(ElseArgName) {
+		elseBody, err := builtin_argument.ExtractArgumentValue[starlark.Callable](arguments, ElseArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ElseArgName)
+		}
+		if builtin.elseInstructions, interpretationErr = builtin.subPlanRecorder.Record(elseBody, starlark.Tuple{}); interpretationErr != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(interpretationErr, "An error occurred interpreting %s", elseBranchName)
+		}
+	}
+
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, ifDescription)
+	return starlark.None, nil
+}
+
+// Validate validates both branches, as which one runs is only known at execution time. As only one of them runs, each
+// branch is validated against its own copy of the environment, the copies being merged afterwards
+func (builtin *IfCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	thenEnvironment := validatorEnvironment.Clone()
+	if validationErr := validateSubPlan(thenBranchName, builtin.thenInstructions, thenEnvironment); validationErr != nil {
+		return validationErr
+	}
+	elseEnvironment := validatorEnvironment.Clone()
+	if validationErr := validateSubPlan(elseBranchName, builtin.elseInstructions, elseEnvironment); validationErr != nil {
+		return validationErr
+	}
+	validatorEnvironment.MergeBranches(thenEnvironment, elseEnvironment)
+	return nil
+}
+
+func (builtin *IfCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	isConditionMet, conditionStr, err := builtin.evaluateCondition()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred evaluating the condition")
+	}
+	branchName, branchInstructions := elseBranchName, builtin.elseInstructions
+	if isConditionMet {
+		branchName, branchInstructions = thenBranchName, builtin.thenInstructions
+	}
+	outputs, err := executeSubPlan(ctx, branchName, branchInstructions)
+	if err != nil {
+		return "", err
+	}
+	return formatSubPlanOutputs(fmt.Sprintf("Condition '%s' is %s, ran %s", conditionStr, starlark.Bool(isConditionMet).String(), branchName), outputs), nil
+}
+
+func (builtin *IfCapabilities) TryResolveWith(instructionsAreEqual bool, other *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	return resolveSubPlan(IfBuiltinName, instructionsAreEqual, other, builtin.subPlanInstructions(), nil, enclaveComponents)
+}
+
+func (builtin *IfCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	fillSubPlanPersistableAttributes(IfBuiltinName, builtin.subPlanInstructions(), nil, builder)
+}
+
+// subPlanInstructions returns the instructions of both branches, as they're all persisted and resolved with the
+// instruction
+func (builtin *IfCapabilities) subPlanInstructions() []kurtosis_instruction.KurtosisInstruction {
+	var instructions []kurtosis_instruction.KurtosisInstruction
+	instructions = append(instructions, builtin.thenInstructions...)
+	return append(instructions, builtin.elseInstructions...)
+}
+
+// UpdatePlan lists the instructions of both branches, as which one runs is only known at execution time
+func (builtin *IfCapabilities) UpdatePlan(plan *plan_yaml.PlanYaml) error {
+	if err := updatePlanWithSubPlan(thenBranchName, builtin.thenInstructions, plan); err != nil {
+		return err
+	}
+	return updatePlanWithSubPlan(elseBranchName, builtin.elseInstructions, plan)
+}
+
+func (builtin *IfCapabilities) Description() string {
+	return builtin.description
+}
+
+// evaluateCondition returns whether the condition is met, along with its string representation once its runtime
+// values are resolved
+func (builtin *IfCapabilities) evaluateCondition() (bool, string, error) {
+	condition, err := resolveRuntimeValue(builtin.condition, builtin.runtimeValueStore)
+	if err != nil {
+		return false, "", err
+	}
+	if builtin.assertion == "" {
+		return bool(condition.Truth()), condition.String(), nil
+	}
+	target, err := resolveRuntimeValue(builtin.target, builtin.runtimeValueStore)
+	if err != nil {
+		return false, "", err
+	}
+	conditionStr := fmt.Sprintf("%s %s %s", condition.String(), builtin.assertion, target.String())
+	if _, isComparison := verify.StringTokenToComparisonStarlarkToken[builtin.assertion]; isComparison && condition.Type() != target.Type() {
+		// a failed verification would otherwise be mistaken for a condition that isn't met
+		return false, "", stacktrace.NewError("Condition '%s' compares '%v' of type '%v' with '%v' of type '%v'", conditionStr, condition, condition.Type(), target, target.Type())
+	}
+	return verify.Verify(condition, builtin.assertion, target) == nil, conditionStr, nil
+}
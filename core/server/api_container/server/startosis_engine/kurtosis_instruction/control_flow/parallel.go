@@ -0,0 +1,212 @@
+package control_flow
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/exec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/request"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/store_service_files"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/tasks"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"golang.org/x/sync/errgroup"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	ParallelBuiltinName = "parallel"
+
+	BranchesArgName = "branches"
+
+	parallelDescription = "Running branches of instructions in parallel"
+)
+
+// instructionsAllowedInParallel are the instructions that can safely run alongside each other
+var instructionsAllowedInParallel = map[string]bool{
+	exec.ExecBuiltinName:                             true,
+	request.RequestBuiltinName:                       true,
+	tasks.RunShBuiltinName:                           true,
+	tasks.RunContainerBuiltinName:                    true,
+	add_service.AddServiceBuiltinName:                true,
+	store_service_files.StoreServiceFilesBuiltinName: true,
+}
+
+func NewParallel() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: ParallelBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              BranchesArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         validateBranches,
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &ParallelCapabilities{
+				subPlanRecorder: nil, // set by the framework before interpretation
+
+				branchesInstructions: nil, // populated at interpretation time
+				description:          "",  // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{},
+	}
+}
+
+// ParallelCapabilities records the instructions of each branch at interpretation time. At execution time, the
+// branches run concurrently up to the parallelism of the run, the instructions of a branch running in order
+type ParallelCapabilities struct {
+	subPlanRecorder *kurtosis_plan_instruction.SubPlanRecorder
+
+	branchesInstructions [][]kurtosis_instruction.KurtosisInstruction
+
+	description string
+}
+
+func (builtin *ParallelCapabilities) SetSubPlanRecorder(recorder *kurtosis_plan_instruction.SubPlanRecorder) {
+	builtin.subPlanRecorder = recorder
+}
+
+func (builtin *ParallelCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	branches, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, BranchesArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", BranchesArgName)
+	}
+
+	for idx := 0; idx < branches.Len(); idx++ {
+		branch, ok := branches.Index(idx).(starlark.Callable)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("Branch at index %d of the '%s' argument should be a function, got '%s'", idx, BranchesArgName, reflect.TypeOf(branches.Index(idx)))
+		}
+		branchInstructions, interpretationErr := builtin.subPlanRecorder.Record(branch, starlark.Tuple{})
+		if interpretationErr != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(interpretationErr, "An error occurred interpreting %s", branchName(idx))
+		}
+		for _, instruction := range branchInstructions {
+			instructionName := instruction.GetCanonicalInstruction(false).GetInstructionName()
+			if !instructionsAllowedInParallel[instructionName] {
+				return nil, startosis_errors.NewInterpretationError("Instruction '%s' of %s can't run in parallel. Only the following instructions can: %s", instructionName, branchName(idx), strings.Join(getInstructionsAllowedInParallel(), ", "))
+			}
+		}
+		builtin.branchesInstructions = append(builtin.branchesInstructions, branchInstructions)
+	}
+
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, parallelDescription)
+	return starlark.None, nil
+}
+
+func (builtin *ParallelCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	for idx, branchInstructions := range builtin.branchesInstructions {
+		if validationErr := validateSubPlan(branchName(idx), branchInstructions, validatorEnvironment); validationErr != nil {
+			return validationErr
+		}
+	}
+	return nil
+}
+
+// Execute runs the branches concurrently, streaming the outputs of each branch to the reporter the executor stores in
+// the context as soon as it completes. The first branch failing cancels the others
+func (builtin *ParallelCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	parallelism, ok := ctx.Value(startosis_constants.ParallelismParam).(int)
+	if !ok {
+		return "", stacktrace.NewError("An error occurred when getting parallelism level from execution context")
+	}
+	if parallelism < 1 {
+		return "", stacktrace.NewError("The parallelism of the run should be at least 1 to run branches in parallel, got '%d'", parallelism)
+	}
+	reportResult, _ := ctx.Value(startosis_constants.InstructionResultReporterParam).(func(string))
+
+	branchesErrGroup, ctxWithCancellation := errgroup.WithContext(ctx)
+	branchesErrGroup.SetLimit(parallelism)
+	numberOfBranches := len(builtin.branchesInstructions)
+	for idx, branchInstructions := range builtin.branchesInstructions {
+		idx, branchInstructions := idx, branchInstructions
+		branchesErrGroup.Go(func() error {
+			if ctxWithCancellation.Err() != nil {
+				// another branch failed before this one got to run
+				return nil
+			}
+			outputs, err := executeSubPlan(ctxWithCancellation, branchName(idx), branchInstructions)
+			if err != nil {
+				return err
+			}
+			if reportResult != nil && ctxWithCancellation.Err() == nil {
+				reportResult(formatSubPlanOutputs(fmt.Sprintf("Branch %d of %d completed:", idx+1, numberOfBranches), outputs))
+			}
+			return nil
+		})
+	}
+	if err := branchesErrGroup.Wait(); err != nil {
+		return "", stacktrace.Propagate(err, "A branch failed, the other branches were cancelled")
+	}
+	return fmt.Sprintf("Ran %d branches in parallel, at most %d at a time", numberOfBranches, parallelism), nil
+}
+
+func (builtin *ParallelCapabilities) TryResolveWith(instructionsAreEqual bool, other *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	return resolveSubPlan(ParallelBuiltinName, instructionsAreEqual, other, builtin.subPlanInstructions(), nil, enclaveComponents)
+}
+
+func (builtin *ParallelCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	fillSubPlanPersistableAttributes(ParallelBuiltinName, builtin.subPlanInstructions(), nil, builder)
+}
+
+// subPlanInstructions returns the instructions of all branches, in the order of the branches
+func (builtin *ParallelCapabilities) subPlanInstructions() []kurtosis_instruction.KurtosisInstruction {
+	var instructions []kurtosis_instruction.KurtosisInstruction
+	for _, branchInstructions := range builtin.branchesInstructions {
+		instructions = append(instructions, branchInstructions...)
+	}
+	return instructions
+}
+
+func (builtin *ParallelCapabilities) UpdatePlan(plan *plan_yaml.PlanYaml) error {
+	for idx, branchInstructions := range builtin.branchesInstructions {
+		if err := updatePlanWithSubPlan(branchName(idx), branchInstructions, plan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (builtin *ParallelCapabilities) Description() string {
+	return builtin.description
+}
+
+func validateBranches(value starlark.Value) *startosis_errors.InterpretationError {
+	if _, ok := value.(*starlark.List); !ok {
+		return startosis_errors.NewInterpretationError("The '%s' argument should be a list of functions, got '%s'", BranchesArgName, reflect.TypeOf(value))
+	}
+	return nil
+}
+
+func branchName(idx int) string {
+	return fmt.Sprintf("the branch at index %d", idx)
+}
+
+func getInstructionsAllowedInParallel() []string {
+	instructionNames := make([]string, 0, len(instructionsAllowedInParallel))
+	for instructionName := range instructionsAllowedInParallel {
+		instructionNames = append(instructionNames, instructionName)
+	}
+	sort.Strings(instructionNames)
+	return instructionNames
+}
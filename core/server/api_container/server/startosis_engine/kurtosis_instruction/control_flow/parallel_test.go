@@ -0,0 +1,106 @@
+package control_flow
+
+import (
+	"context"
+	"errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/mock_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	testParallelism = 2
+)
+
+func newTestParallelContext(reportedResults *[]string, mutex *sync.Mutex) context.Context {
+	ctx := context.WithValue(context.Background(), startosis_constants.ParallelismParam, testParallelism)
+	return context.WithValue(ctx, startosis_constants.InstructionResultReporterParam, func(result string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		*reportedResults = append(*reportedResults, result)
+	})
+}
+
+func newMockInstruction(t *testing.T, execute func(ctx context.Context) (*string, error)) *mock_instruction.MockKurtosisInstruction {
+	instruction := mock_instruction.NewMockKurtosisInstruction(t)
+	instruction.EXPECT().String().Maybe().Return("mock_instruction()")
+	instruction.EXPECT().Execute(mock.Anything).RunAndReturn(execute)
+	return instruction
+}
+
+func TestParallel_RunsBranchesUpToParallelism(t *testing.T) {
+	var runningBranches, maxRunningBranches int32
+	execute := func(ctx context.Context) (*string, error) {
+		running := atomic.AddInt32(&runningBranches, 1)
+		for {
+			currentMax := atomic.LoadInt32(&maxRunningBranches)
+			if running <= currentMax || atomic.CompareAndSwapInt32(&maxRunningBranches, currentMax, running) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&runningBranches, -1)
+		output := "done"
+		return &output, nil
+	}
+
+	numberOfBranches := 5
+	var branchesInstructions [][]kurtosis_instruction.KurtosisInstruction
+	for i := 0; i < numberOfBranches; i++ {
+		branchesInstructions = append(branchesInstructions, []kurtosis_instruction.KurtosisInstruction{newMockInstruction(t, execute)})
+	}
+	parallel := &ParallelCapabilities{
+		subPlanRecorder:      nil,
+		branchesInstructions: branchesInstructions,
+		description:          "",
+	}
+
+	var reportedResults []string
+	result, err := parallel.Execute(newTestParallelContext(&reportedResults, &sync.Mutex{}), nil)
+	require.NoError(t, err)
+	require.Equal(t, "Ran 5 branches in parallel, at most 2 at a time", result)
+	require.Equal(t, int32(testParallelism), maxRunningBranches)
+	require.Len(t, reportedResults, numberOfBranches)
+	require.Contains(t, reportedResults[0], "completed:\n  done")
+}
+
+func TestParallel_FailingBranchCancelsTheOthers(t *testing.T) {
+	blockingBranchStarted := make(chan bool)
+	failingBranch := newMockInstruction(t, func(ctx context.Context) (*string, error) {
+		<-blockingBranchStarted
+		return nil, errors.New("branch failed")
+	})
+	wasCancelled := false
+	blockingBranch := newMockInstruction(t, func(ctx context.Context) (*string, error) {
+		close(blockingBranchStarted)
+		select {
+		case <-ctx.Done():
+			wasCancelled = true
+			return nil, ctx.Err()
+		case <-time.After(10 * time.Second):
+			output := "done"
+			return &output, nil
+		}
+	})
+	parallel := &ParallelCapabilities{
+		subPlanRecorder: nil,
+		branchesInstructions: [][]kurtosis_instruction.KurtosisInstruction{
+			{blockingBranch},
+			{failingBranch},
+		},
+		description: "",
+	}
+
+	var reportedResults []string
+	_, err := parallel.Execute(newTestParallelContext(&reportedResults, &sync.Mutex{}), nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "branch failed")
+	require.True(t, wasCancelled)
+	require.Empty(t, reportedResults)
+}
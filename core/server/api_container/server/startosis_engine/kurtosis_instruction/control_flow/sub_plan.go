@@ -0,0 +1,131 @@
+package control_flow
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"reflect"
+	"strings"
+)
+
+const (
+	persistedRuntimeValuePlaceholderFormat = "__sub_plan_runtime_value_%d__"
+)
+
+// validateSubPlan validates the instructions of a sub-plan in order, updating the environment as if they all ran
+func validateSubPlan(subPlanName string, instructions []kurtosis_instruction.KurtosisInstruction, environment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	for _, instruction := range instructions {
+		if err := instruction.ValidateAndUpdateEnvironment(environment); err != nil {
+			return startosis_errors.WrapWithValidationError(err, "Instruction '%s' of %s failed validation", instruction.String(), subPlanName)
+		}
+	}
+	return nil
+}
+
+func updatePlanWithSubPlan(subPlanName string, instructions []kurtosis_instruction.KurtosisInstruction, plan *plan_yaml.PlanYaml) error {
+	for _, instruction := range instructions {
+		if err := instruction.UpdatePlan(plan); err != nil {
+			return stacktrace.Propagate(err, "An error occurred updating the plan with instruction '%s' of %s", instruction.String(), subPlanName)
+		}
+	}
+	return nil
+}
+
+// executeSubPlan runs the instructions of a sub-plan in order, stopping at the first failing one, and returns their
+// outputs
+func executeSubPlan(ctx context.Context, subPlanName string, instructions []kurtosis_instruction.KurtosisInstruction) ([]string, error) {
+	var outputs []string
+	for _, instruction := range instructions {
+		output, err := instruction.Execute(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred executing instruction '%s' of %s", instruction.String(), subPlanName)
+		}
+		if output != nil {
+			outputs = append(outputs, *output)
+		}
+	}
+	return outputs, nil
+}
+
+// resolveSubPlan resolves an instruction running a sub-plan as a single unit: it's equal to the one of the enclave plan
+// only if its own code and every instruction of its sub-plan are, in which case the whole block is skipped. Otherwise
+// the block runs again. Either way, every instruction of the sub-plan registers the components it touches.
+// The runtime values the instruction creates for its sub-plan get new UUIDs on every interpretation, so they're
+// persisted as placeholders and put back before comparing
+func resolveSubPlan(instructionType string, instructionsAreEqual bool, other *enclave_plan_persistence.EnclavePlanInstruction, instructions []kurtosis_instruction.KurtosisInstruction, ownRuntimeValueUuids []string, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	if other == nil || other.Type != instructionType || !instructionsAreEqual || len(other.SubPlanInstructions) != len(instructions) {
+		for _, instruction := range instructions {
+			instruction.TryResolveWith(nil, enclaveComponents)
+		}
+		return enclave_structure.InstructionIsUnknown
+	}
+	allInstructionsAreEqual := true
+	for idx, instruction := range instructions {
+		persistedInstruction := other.SubPlanInstructions[idx].Clone()
+		for runtimeValueIdx, runtimeValueUuid := range ownRuntimeValueUuids {
+			persistedInstruction.ReplaceInStarlarkCode(getPersistedRuntimeValuePlaceholder(runtimeValueIdx), runtimeValueUuid)
+		}
+		if instruction.TryResolveWith(persistedInstruction, enclaveComponents) != enclave_structure.InstructionIsEqual {
+			allInstructionsAreEqual = false
+		}
+	}
+	if !allInstructionsAreEqual {
+		return enclave_structure.InstructionIsUnknown
+	}
+	return enclave_structure.InstructionIsEqual
+}
+
+// fillSubPlanPersistableAttributes persists the instructions of a sub-plan along with the instruction running it, so
+// that re-runs can resolve it
+func fillSubPlanPersistableAttributes(instructionType string, instructions []kurtosis_instruction.KurtosisInstruction, ownRuntimeValueUuids []string, builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(instructionType)
+	for _, instruction := range instructions {
+		subPlanInstructionBuilder := instruction.GetPersistableAttributes()
+		for runtimeValueIdx, runtimeValueUuid := range ownRuntimeValueUuids {
+			subPlanInstructionBuilder.ReplaceInStarlarkCode(runtimeValueUuid, getPersistedRuntimeValuePlaceholder(runtimeValueIdx))
+		}
+		builder.AddSubPlanInstruction(subPlanInstructionBuilder)
+	}
+}
+
+func getPersistedRuntimeValuePlaceholder(runtimeValueIdx int) string {
+	return fmt.Sprintf(persistedRuntimeValuePlaceholderFormat, runtimeValueIdx)
+}
+
+// formatSubPlanOutputs indents the outputs of the instructions of a sub-plan under the header
+func formatSubPlanOutputs(header string, outputs []string) string {
+	lines := []string{header}
+	for _, output := range outputs {
+		lines = append(lines, "  "+strings.ReplaceAll(output, "\n", "\n  "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// resolveRuntimeValue returns the value a string holding a runtime value refers to. Other values are returned as is
+func resolveRuntimeValue(value starlark.Comparable, runtimeValueStore *runtime_value_store.RuntimeValueStore) (starlark.Comparable, error) {
+	valueStr, ok := value.(starlark.String)
+	if !ok {
+		return value, nil
+	}
+	resolvedValue, err := magic_string_helper.GetOrReplaceRuntimeValueFromString(valueStr.GoString(), runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving the runtime values of '%s'", valueStr.GoString())
+	}
+	return resolvedValue, nil
+}
+
+func validateCallable(value starlark.Value, argNameForLogging string) *startosis_errors.InterpretationError {
+	if _, ok := value.(starlark.Callable); !ok {
+		return startosis_errors.NewInterpretationError("The '%s' argument should be a function, got '%s'", argNameForLogging, reflect.TypeOf(value))
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package copy_files
+
+import (
+	"context"
+	"fmt"
+	kurtosis_backend_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"path"
+)
+
+const (
+	CopyFilesBuiltinName = "copy_files"
+
+	ServiceArgName     = "service"
+	SrcArtifactArgName = "src_artifact"
+	DestArgName        = "dest"
+)
+
+const (
+	descriptionFormatStr = "Copying files artifact '%v' to path '%v' on service '%v'"
+)
+
+func NewCopyFiles(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: CopyFilesBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceArgName)
+					},
+				},
+				{
+					Name:              SrcArtifactArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, SrcArtifactArgName)
+					},
+				},
+				{
+					Name:              DestArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, DestArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &CopyFilesCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				serviceName:  "", // populated at interpretation time
+				artifactName: "", // populated at interpretation time
+				dest:         "", // populated at interpretation time
+				description:  "", // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceArgName:     true,
+			SrcArtifactArgName: true,
+			DestArgName:        true,
+		},
+	}
+}
+
+type CopyFilesCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	serviceName  kurtosis_backend_service.ServiceName
+	artifactName string
+	dest         string
+	description  string
+}
+
+func (builtin *CopyFilesCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceArgName)
+	}
+
+	src, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, SrcArtifactArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", SrcArtifactArgName)
+	}
+
+	dest, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, DestArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", DestArgName)
+	}
+	if !path.IsAbs(dest.GoString()) {
+		return nil, startosis_errors.NewInterpretationError("The '%s' argument of '%s' must be an absolute path but got '%s'", DestArgName, CopyFilesBuiltinName, dest.GoString())
+	}
+
+	builtin.serviceName = kurtosis_backend_service.ServiceName(serviceName.GoString())
+	builtin.artifactName = src.GoString()
+	builtin.dest = dest.GoString()
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(descriptionFormatStr, builtin.artifactName, builtin.dest, builtin.serviceName))
+	return starlark.None, nil
+}
+
+func (builtin *CopyFilesCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if validatorEnvironment.DoesServiceNameExist(builtin.serviceName) == startosis_validator.ComponentNotFound {
+		return startosis_errors.NewValidationError("There was an error validating '%v' with service name '%v' that does not exist", CopyFilesBuiltinName, builtin.serviceName)
+	}
+	if validatorEnvironment.DoesArtifactNameExist(builtin.artifactName) == startosis_validator.ComponentNotFound {
+		return startosis_errors.NewValidationError("Files artifact '%v' required by '%v' instruction doesn't exist", builtin.artifactName, CopyFilesBuiltinName)
+	}
+	return nil
+}
+
+func (builtin *CopyFilesCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if err := builtin.serviceNetwork.CopyFilesArtifactToService(ctx, string(builtin.serviceName), builtin.artifactName, builtin.dest); err != nil {
+		return "", stacktrace.Propagate(err, "Failed to copy files artifact '%v' to '%v' on service '%v'", builtin.artifactName, builtin.dest, builtin.serviceName)
+	}
+	instructionResult := fmt.Sprintf("Files artifact '%s' copied to '%s' on service '%s'", builtin.artifactName, builtin.dest, builtin.serviceName)
+	return instructionResult, nil
+}
+
+func (builtin *CopyFilesCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	// the files need to be copied again if either the service was re-created or the files artifact content changed
+	if instructionsAreEqual && (enclaveComponents.HasServiceBeenUpdated(builtin.serviceName) || enclaveComponents.HasFilesArtifactBeenUpdated(builtin.artifactName)) {
+		return enclave_structure.InstructionIsUpdate
+	} else if instructionsAreEqual {
+		return enclave_structure.InstructionIsEqual
+	}
+	return enclave_structure.InstructionIsUnknown
+}
+
+func (builtin *CopyFilesCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(CopyFilesBuiltinName)
+}
+
+func (builtin *CopyFilesCapabilities) UpdatePlan(_ *plan_yaml.PlanYaml) error {
+	// copying files into a running service does not affect the plan
+	return nil
+}
+
+func (builtin *CopyFilesCapabilities) Description() string {
+	return builtin.description
+}
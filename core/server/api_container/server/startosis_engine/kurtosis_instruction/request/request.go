@@ -11,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/retry"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
@@ -19,22 +20,8 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"go.starlark.net/starlark"
-	"net/http"
 )
 
-var defaultAcceptableCodes = []int64{
-	http.StatusOK,
-	http.StatusCreated,
-	http.StatusAccepted,
-	http.StatusNonAuthoritativeInfo,
-	http.StatusNoContent,
-	http.StatusResetContent,
-	http.StatusPartialContent,
-	http.StatusMultiStatus,
-	http.StatusAlreadyReported,
-	http.StatusIMUsed,
-}
-
 const (
 	defaultSkipCodeCheck = false
 	descriptionFormatStr = "Running '%v' request on service '%v'"
@@ -66,7 +53,7 @@ func NewRequest(serviceNetwork service_network.ServiceNetwork, runtimeValueStore
 				{
 					Name:              RecipeArgName,
 					IsOptional:        false,
-					ZeroValueProvider: builtin_argument.ZeroValueProvider[recipe.HttpRequestRecipe],
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[recipe.PortRequestRecipe],
 					Validator:         nil,
 				},
 				{
@@ -90,7 +77,7 @@ func NewRequest(serviceNetwork service_network.ServiceNetwork, runtimeValueStore
 				runtimeValueStore: runtimeValueStore,
 
 				serviceName:       "",    // populated at interpretation time
-				httpRequestRecipe: nil,   // populated at interpretation time
+				portRequestRecipe: nil,   // populated at interpretation time
 				resultUuid:        "",    // populated at interpretation time
 				acceptableCodes:   nil,   // populated at interpretation time
 				skipCodeCheck:     false, // populated at interpretation time
@@ -109,7 +96,7 @@ type RequestCapabilities struct {
 	runtimeValueStore *runtime_value_store.RuntimeValueStore
 
 	serviceName       service.ServiceName
-	httpRequestRecipe recipe.HttpRequestRecipe
+	portRequestRecipe recipe.PortRequestRecipe
 	resultUuid        string
 	acceptableCodes   []int64
 	skipCodeCheck     bool
@@ -125,12 +112,12 @@ func (builtin *RequestCapabilities) Interpret(_ string, arguments *builtin_argum
 	}
 	serviceName := service.ServiceName(serviceNameArgumentValue.GoString())
 
-	httpRequestRecipe, err := builtin_argument.ExtractArgumentValue[recipe.HttpRequestRecipe](arguments, RecipeArgName)
+	portRequestRecipe, err := builtin_argument.ExtractArgumentValue[recipe.PortRequestRecipe](arguments, RecipeArgName)
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", RecipeArgName)
 	}
 
-	acceptableCodes := defaultAcceptableCodes
+	acceptableCodes := portRequestRecipe.GetDefaultAcceptableCodes()
 	if arguments.IsSet(AcceptableCodesArgName) {
 		acceptableCodesValue, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, AcceptableCodesArgName)
 		if err != nil {
@@ -157,13 +144,13 @@ func (builtin *RequestCapabilities) Interpret(_ string, arguments *builtin_argum
 	}
 
 	builtin.serviceName = serviceName
-	builtin.httpRequestRecipe = httpRequestRecipe
+	builtin.portRequestRecipe = portRequestRecipe
 	builtin.resultUuid = resultUuid
 	builtin.acceptableCodes = acceptableCodes
 	builtin.skipCodeCheck = skipCodeCheck
-	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(descriptionFormatStr, builtin.httpRequestRecipe.RequestType(), builtin.serviceName))
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(descriptionFormatStr, builtin.portRequestRecipe.RequestType(), builtin.serviceName))
 
-	returnValue, interpretationErr := builtin.httpRequestRecipe.CreateStarlarkReturnValue(builtin.resultUuid)
+	returnValue, interpretationErr := builtin.portRequestRecipe.CreateStarlarkReturnValue(builtin.resultUuid)
 	if interpretationErr != nil {
 		return nil, startosis_errors.NewInterpretationError("An error occurred while creating return value for %v instruction", RequestBuiltinName)
 	}
@@ -174,25 +161,25 @@ func (builtin *RequestCapabilities) Validate(_ *builtin_argument.ArgumentValuesS
 	if validatorEnvironment.DoesServiceNameExist(builtin.serviceName) == startosis_validator.ComponentNotFound {
 		return startosis_errors.NewValidationError("Tried creating a request for service '%s' which doesn't exist", builtin.serviceName)
 	}
-	if validationErr := recipe.ValidateHttpRequestRecipe(builtin.httpRequestRecipe, builtin.serviceName, validatorEnvironment); validationErr != nil {
+	if validationErr := recipe.ValidatePortRequestRecipe(builtin.portRequestRecipe, builtin.serviceName, validatorEnvironment); validationErr != nil {
 		return validationErr
 	}
 	return nil
 }
 
 func (builtin *RequestCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
-	result, err := builtin.httpRequestRecipe.Execute(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, builtin.serviceName)
+	result, err := builtin.portRequestRecipe.Execute(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, builtin.serviceName)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "Error executing http recipe")
+		return "", stacktrace.Propagate(err, "Error executing request recipe")
 	}
 	if !builtin.skipCodeCheck && !builtin.isAcceptableCode(result) {
-		return "", stacktrace.NewError("Request returned status code '%v' that is not part of the acceptable status codes '%v'", result["code"], builtin.acceptableCodes)
+		return "", retry.NewErrorWithResultCode(result["code"], "Request returned status code '%v' that is not part of the acceptable status codes '%v'", result["code"], builtin.acceptableCodes)
 	}
 	if err := builtin.runtimeValueStore.SetValue(builtin.resultUuid, result); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred setting value '%+v' using key UUID '%s' in the runtime value store", result, builtin.resultUuid)
 	}
 
-	instructionResult := builtin.httpRequestRecipe.ResultMapToString(result)
+	instructionResult := builtin.portRequestRecipe.ResultMapToString(result)
 	return instructionResult, err
 }
 
@@ -1,6 +1,8 @@
 package magic_string_helper
 
 import (
+	"bytes"
+	"encoding/json"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/stacktrace"
 	"go.starlark.net/starlark"
@@ -25,6 +27,9 @@ const (
 	RuntimeValueReplacementPlaceholderFormat = "{{" + kurtosisNamespace + ":%v:%v.runtime_value}}"
 
 	subExpNotFound = -1
+
+	jsonStringDelimiter  = `"`
+	jsonEncoderLineBreak = "\n"
 )
 
 // The compiled regular expression to do IP address replacements
@@ -32,6 +37,20 @@ const (
 var compiledRuntimeValueReplacementRegex = regexp.MustCompile(runtimeValueReplacementRegex)
 
 func ReplaceRuntimeValueInString(originalString string, recipeEngine *runtime_value_store.RuntimeValueStore) (string, error) {
+	return replaceRuntimeValuesInString(originalString, recipeEngine, getRuntimeValueString)
+}
+
+// ReplaceRuntimeValueInJsonString replaces the runtime values found in a JSON document, escaping them as they always
+// sit inside its strings, so that values holding quotes, backslashes or line breaks keep the document valid
+func ReplaceRuntimeValueInJsonString(originalJson string, recipeEngine *runtime_value_store.RuntimeValueStore) (string, error) {
+	return replaceRuntimeValuesInString(originalJson, recipeEngine, getJsonEscapedRuntimeValueString)
+}
+
+func replaceRuntimeValuesInString(
+	originalString string,
+	recipeEngine *runtime_value_store.RuntimeValueStore,
+	stringifyRuntimeValue func(starlark.Comparable) (string, error),
+) (string, error) {
 	matches := compiledRuntimeValueReplacementRegex.FindAllStringSubmatch(originalString, unlimitedMatches)
 	replacedString := originalString
 	for _, match := range matches {
@@ -44,12 +63,11 @@ func ReplaceRuntimeValueInString(originalString string, recipeEngine *runtime_va
 			return "", stacktrace.NewError("There was an error in finding the sub group '%v' in regexp '%v'. This is a Kurtosis Bug", serviceNameSubgroupName, compiledRuntimeValueReplacementRegex.String())
 		}
 		allMatch := match[allMatchIndex]
-		switch value := selectedRuntimeValue.(type) {
-		case starlark.String:
-			replacedString = strings.Replace(replacedString, allMatch, value.GoString(), singleMatch)
-		default:
-			replacedString = strings.Replace(replacedString, allMatch, value.String(), singleMatch)
+		runtimeValueStr, err := stringifyRuntimeValue(selectedRuntimeValue)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error happened converting runtime value '%v' to a string", selectedRuntimeValue)
 		}
+		replacedString = strings.Replace(replacedString, allMatch, runtimeValueStr, singleMatch)
 	}
 	return replacedString, nil
 }
@@ -83,3 +101,28 @@ func getRuntimeValueFromRegexMatch(match []string, runtimeValueStore *runtime_va
 	}
 	return selectedRuntimeValue, nil
 }
+
+func getRuntimeValueString(runtimeValue starlark.Comparable) (string, error) {
+	if stringValue, ok := runtimeValue.(starlark.String); ok {
+		return stringValue.GoString(), nil
+	}
+	return runtimeValue.String(), nil
+}
+
+// getJsonEscapedRuntimeValueString returns the runtime value as the content of a JSON string, without its quotes
+func getJsonEscapedRuntimeValueString(runtimeValue starlark.Comparable) (string, error) {
+	runtimeValueStr, err := getRuntimeValueString(runtimeValue)
+	if err != nil {
+		return "", err
+	}
+	escapedRuntimeValue := &bytes.Buffer{}
+	encoder := json.NewEncoder(escapedRuntimeValue)
+	// the value is displayed to users, so HTML characters are left as is
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(runtimeValueStr); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred escaping runtime value '%v' for JSON", runtimeValueStr)
+	}
+	// the encoder quotes the string and ends it with a line break
+	jsonString := strings.TrimSuffix(escapedRuntimeValue.String(), jsonEncoderLineBreak)
+	return jsonString[len(jsonStringDelimiter) : len(jsonString)-len(jsonStringDelimiter)], nil
+}
@@ -2,6 +2,7 @@ package magic_string_helper
 
 import (
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/plan_builder"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
@@ -90,6 +91,39 @@ func TestReplaceRuntimeValueFromString(t *testing.T) {
 	require.Equal(t, resolvedInterpolatedString, testExpectedInterpolatedString.GoString())
 }
 
+func TestReplaceRuntimeValueInJsonString_ResultParses(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := newDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	require.NoError(t, err)
+	execOutput := "say \"hi\" from C:\\tmp\nwith <html> & \x01 control characters\n"
+	stringValueUuid, err := runtimeValueStore.CreateValue()
+	require.Nil(t, err)
+	err = runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{testRuntimeValueField: starlark.String(execOutput)})
+	require.NoError(t, err)
+	intValueUuid, err := runtimeValueStore.CreateValue()
+	require.Nil(t, err)
+	err = runtimeValueStore.SetValue(intValueUuid, map[string]starlark.Comparable{testRuntimeValueField: testIntRuntimeValue})
+	require.NoError(t, err)
+	stringRuntimeValue := fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, stringValueUuid, testRuntimeValueField)
+	intRuntimeValue := fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, intValueUuid, testRuntimeValueField)
+
+	serializedOutput := fmt.Sprintf(`{"outputs": {"output": "%v", "interpolated": "code %v: %v"}, "services": {}, "values": {}}`, stringRuntimeValue, intRuntimeValue, stringRuntimeValue)
+	replacedOutput, err := ReplaceRuntimeValueInJsonString(serializedOutput, runtimeValueStore)
+	require.NoError(t, err)
+
+	planResult, err := plan_builder.NewPlanResult(replacedOutput)
+	require.NoError(t, err)
+	var output string
+	require.NoError(t, planResult.GetOutput("output", &output))
+	require.Equal(t, execOutput, output)
+	var interpolated string
+	require.NoError(t, planResult.GetOutput("interpolated", &interpolated))
+	require.Equal(t, "code 0: "+execOutput, interpolated)
+}
+
 func getEnclaveDBForTest(t *testing.T) *enclave_db.EnclaveDB {
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer func() {
@@ -0,0 +1,365 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/store_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+const (
+	RunContainerBuiltinName = "run_container"
+
+	EntrypointArgName = "entrypoint"
+	CmdArgName        = "cmd"
+
+	runResultStdoutKey = "stdout"
+	runResultStderrKey = "stderr"
+
+	runningContainerDescriptionFormat = "Running container with image '%v'"
+)
+
+func NewRunContainerService(
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	nonBlockingMode bool,
+	packageId string,
+	packageContentProvider startosis_packages.PackageContentProvider,
+	packageReplaceOptions map[string]string) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: RunContainerBuiltinName,
+
+			Arguments: append([]*builtin_argument.BuiltinArgument{
+				{
+					Name:              ImageNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator:         nil,
+				},
+				{
+					Name:              CmdArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              EntrypointArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              TaskNameArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+				},
+				{
+					Name:              FilesArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+				},
+				{
+					Name:              EnvVarsArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              StoreFilesArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+				},
+				{
+					Name:              WaitArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					// the value can be a string duration, or it can be a Starlark none value (because we are preparing
+					// the signature to receive a custom type in the future) when users want to disable it
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.DurationOrNone(value, WaitArgName)
+					},
+				},
+			}, getTaskResourcesArguments()...),
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &RunContainerCapabilities{
+				serviceNetwork:         serviceNetwork,
+				runtimeValueStore:      runtimeValueStore,
+				packageId:              packageId,
+				packageReplaceOptions:  packageReplaceOptions,
+				packageContentProvider: packageContentProvider,
+				name:                   "",
+				nonBlockingMode:        nonBlockingMode,
+				entrypoint:             nil, // populated at interpretation time
+				cmd:                    nil, // populated at interpretation time
+				serviceConfig:          nil, // populated at interpretation time
+				resultUuid:             "",  // populated at interpretation time
+				storeSpecList:          nil,
+				wait:                   DefaultWaitTimeoutDurationStr,
+				description:            "",  // populated at interpretation time
+				returnValue:            nil, // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ImageNameArgName:  true,
+			EntrypointArgName: true,
+			CmdArgName:        true,
+			FilesArgName:      true,
+			StoreFilesArgName: true,
+			WaitArgName:       true,
+			EnvVarsArgName:    true,
+		},
+	}
+}
+
+// RunContainerCapabilities runs the image with the exact entrypoint and command given, without wrapping them in a shell,
+// so that images without one can run as tasks too
+type RunContainerCapabilities struct {
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+	serviceNetwork    service_network.ServiceNetwork
+
+	resultUuid      string
+	name            string
+	entrypoint      []string
+	cmd             []string
+	nonBlockingMode bool
+
+	// fields required for image building
+	packageId              string
+	packageContentProvider startosis_packages.PackageContentProvider
+	packageReplaceOptions  map[string]string
+
+	serviceConfig *service.ServiceConfig
+	storeSpecList []*store_spec.StoreSpec
+	returnValue   *starlarkstruct.Struct
+	wait          string
+	description   string
+}
+
+func (builtin *RunContainerCapabilities) Interpret(locatorOfModuleInWhichThisBuiltinIsBeingCalled string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	taskName, err := getTaskNameFromArgs(arguments)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to get task name from args.")
+	}
+	builtin.name = taskName
+
+	rawImageVal, err := builtin_argument.ExtractArgumentValue[starlark.Value](arguments, ImageNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract raw image attribute.")
+	}
+	imageName, maybeImageBuildSpec, maybeImageRegistrySpec, maybeNixBuildSpec, interpretationErr := service_config.ConvertImage(
+		rawImageVal,
+		locatorOfModuleInWhichThisBuiltinIsBeingCalled,
+		builtin.packageId,
+		builtin.packageContentProvider,
+		builtin.packageReplaceOptions)
+	if interpretationErr != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(interpretationErr, "An error occurred converting image for run container.")
+	}
+
+	// the entrypoint and command of the image are kept when they aren't set
+	if arguments.IsSet(EntrypointArgName) {
+		entrypointStarlark, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, EntrypointArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", EntrypointArgName)
+		}
+		builtin.entrypoint, interpretationErr = kurtosis_types.SafeCastToStringSlice(entrypointStarlark, EntrypointArgName)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+	if arguments.IsSet(CmdArgName) {
+		cmdStarlark, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, CmdArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", CmdArgName)
+		}
+		builtin.cmd, interpretationErr = kurtosis_types.SafeCastToStringSlice(cmdStarlark, CmdArgName)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	var filesArtifactExpansion *service_directory.FilesArtifactsExpansion
+	if arguments.IsSet(FilesArgName) {
+		filesStarlark, err := builtin_argument.ExtractArgumentValue[*starlark.Dict](arguments, FilesArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", FilesArgName)
+		}
+		if filesStarlark.Len() > 0 {
+			filesArtifactMountDirPaths, interpretationErr := kurtosis_types.SafeCastToMapStringString(filesStarlark, FilesArgName)
+			if interpretationErr != nil {
+				return nil, interpretationErr
+			}
+			multipleFilesArtifactsMountDirPaths := map[string][]string{}
+			for pathToFile, fileArtifactName := range filesArtifactMountDirPaths {
+				multipleFilesArtifactsMountDirPaths[pathToFile] = []string{fileArtifactName}
+			}
+			filesArtifactExpansion, interpretationErr = service_config.ConvertFilesArtifactsMounts(multipleFilesArtifactsMountDirPaths, builtin.serviceNetwork)
+			if interpretationErr != nil {
+				return nil, interpretationErr
+			}
+		}
+	}
+
+	envVars, interpretationErr := extractEnvVarsIfDefined(arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	resources, interpretationErr := parseTaskResourcesArgs(arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	// build a service config from image, files artifacts expansion and resources.
+	builtin.serviceConfig, err = getServiceConfig(imageName, maybeImageBuildSpec, maybeImageRegistrySpec, maybeNixBuildSpec, filesArtifactExpansion, envVars, resources)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating service config for run container.")
+	}
+
+	if arguments.IsSet(StoreFilesArgName) {
+		storeSpecList, interpretationErr := parseStoreFilesArg(builtin.serviceNetwork, arguments)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		builtin.storeSpecList = storeSpecList
+	}
+
+	if arguments.IsSet(WaitArgName) {
+		waitTimeout, interpretationErr := parseWaitArg(arguments)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		builtin.wait = waitTimeout
+	}
+
+	resultUuid, err := builtin.runtimeValueStore.CreateValue()
+	if err != nil {
+		return nil, startosis_errors.NewInterpretationError("An error occurred while generating UUID for future reference for %v instruction", RunContainerBuiltinName)
+	}
+	builtin.resultUuid = resultUuid
+
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(runningContainerDescriptionFormat, imageName))
+
+	builtin.returnValue = createRunContainerInterpretationResult(resultUuid, builtin.storeSpecList)
+	return builtin.returnValue, nil
+}
+
+func (builtin *RunContainerCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	var serviceDirpathsToArtifactIdentifiers map[string][]string
+	if builtin.serviceConfig.GetFilesArtifactsExpansion() != nil {
+		serviceDirpathsToArtifactIdentifiers = builtin.serviceConfig.GetFilesArtifactsExpansion().ServiceDirpathsToArtifactIdentifiers
+	}
+	return validateTasksCommon(validatorEnvironment, builtin.storeSpecList, serviceDirpathsToArtifactIdentifiers, builtin.serviceConfig)
+}
+
+// Execute runs the container as a one-shot task, which is removed once it exits
+func (builtin *RunContainerCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	entrypoint, err := replaceRuntimeValuesInArgs(builtin.entrypoint, builtin.runtimeValueStore)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred replacing runtime values in the entrypoint of the container")
+	}
+	cmd, err := replaceRuntimeValuesInArgs(builtin.cmd, builtin.runtimeValueStore)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred replacing runtime values in the command of the container")
+	}
+
+	taskConfig, err := renderTaskConfig(builtin.runtimeValueStore, builtin.serviceConfig, entrypoint, cmd)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred rendering the config of the run_container task.")
+	}
+
+	// If the user indicated not to block on removing tasks, the task container gets removed in the background.
+	runContainerExecutionResult, err := runTaskWithWait(ctx, builtin.serviceNetwork, builtin.name, taskConfig, builtin.storeSpecList, builtin.wait, builtin.nonBlockingMode)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "error occurred while running container with image: %v", builtin.serviceConfig.GetContainerImageName())
+	}
+
+	result := map[string]starlark.Comparable{
+		runResultOutputKey: starlark.String(runContainerExecutionResult.GetOutput()),
+		runResultStdoutKey: starlark.String(runContainerExecutionResult.GetStdout()),
+		runResultStderrKey: starlark.String(runContainerExecutionResult.GetStderr()),
+		runResultCodeKey:   starlark.MakeInt(int(runContainerExecutionResult.GetExitCode())),
+	}
+
+	if err := builtin.runtimeValueStore.SetValue(builtin.resultUuid, result); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred setting value '%+v' using key UUID '%s' in the runtime value store", result, builtin.resultUuid)
+	}
+	instructionResult := resultMapToString(result, RunContainerBuiltinName)
+
+	// throw an error as execution of the container failed
+	if runContainerExecutionResult.GetExitCode() != 0 {
+		errorMessage := fmt.Sprintf("Container with image '%v' exited with code %d and output", builtin.serviceConfig.GetContainerImageName(), runContainerExecutionResult.GetExitCode())
+		return "", stacktrace.NewError(formatErrorMessage(errorMessage, runContainerExecutionResult.GetOutput()))
+	}
+
+	return instructionResult, nil
+}
+
+func (builtin *RunContainerCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	if instructionsAreEqual {
+		return enclave_structure.InstructionIsEqual
+	}
+	return enclave_structure.InstructionIsUnknown
+}
+
+func (builtin *RunContainerCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(RunContainerBuiltinName)
+}
+
+func (builtin *RunContainerCapabilities) UpdatePlan(plan *plan_yaml.PlanYaml) error {
+	err := plan.AddRunContainer(builtin.entrypoint, builtin.cmd, builtin.description, builtin.returnValue, builtin.serviceConfig, builtin.storeSpecList)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred adding run container task to the plan")
+	}
+	return nil
+}
+
+func (builtin *RunContainerCapabilities) Description() string {
+	return builtin.description
+}
+
+func createRunContainerInterpretationResult(resultUuid string, storeSpecList []*store_spec.StoreSpec) *starlarkstruct.Struct {
+	result := createInterpretationResult(resultUuid, storeSpecList)
+	dict := starlark.StringDict{}
+	result.ToStringDict(dict)
+	dict[runResultStdoutKey] = starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, runResultStdoutKey))
+	dict[runResultStderrKey] = starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, runResultStderrKey))
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, dict)
+}
+
+func replaceRuntimeValuesInArgs(args []string, runtimeValueStore *runtime_value_store.RuntimeValueStore) ([]string, error) {
+	if args == nil {
+		return nil, nil
+	}
+	argsWithRuntimeValuesReplaced := make([]string, len(args))
+	for index, arg := range args {
+		argWithRuntimeValueReplaced, err := magic_string_helper.ReplaceRuntimeValueInString(arg, runtimeValueStore)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred replacing runtime value in arg '%v'", arg)
+		}
+		argsWithRuntimeValuesReplaced[index] = argWithRuntimeValueReplaced
+	}
+	return argsWithRuntimeValuesReplaced, nil
+}
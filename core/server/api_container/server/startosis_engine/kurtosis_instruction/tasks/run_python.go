@@ -3,7 +3,6 @@ package tasks
 import (
 	"context"
 	"fmt"
-	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
@@ -40,9 +39,7 @@ const (
 
 	spaceDelimiter = " "
 
-	pipInstallCmd = "pip install"
-
-	successfulPipRunExitCode = 0
+	pipInstallCmd = "pip install --quiet"
 
 	runPythonDefaultDescription = "Running Python script"
 )
@@ -58,7 +55,7 @@ func NewRunPythonService(
 		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
 			Name: RunPythonBuiltinName,
 
-			Arguments: []*builtin_argument.BuiltinArgument{
+			Arguments: append([]*builtin_argument.BuiltinArgument{
 				{
 					Name:              TaskNameArgName,
 					IsOptional:        true,
@@ -106,7 +103,7 @@ func NewRunPythonService(
 						return builtin_argument.DurationOrNone(value, WaitArgName)
 					},
 				},
-			},
+			}, getTaskResourcesArguments()...),
 		},
 
 		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
@@ -250,12 +247,17 @@ func (builtin *RunPythonCapabilities) Interpret(locatorOfModuleInWhichThisBuilti
 	}
 
 	envVars, interpretationErr := extractEnvVarsIfDefined(arguments)
-	if err != nil {
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	resources, interpretationErr := parseTaskResourcesArgs(arguments)
+	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
 
-	// build a service config from image and files artifacts expansion.
-	builtin.serviceConfig, err = getServiceConfig(maybeImageName, maybeImageBuildSpec, maybeImageRegistrySpec, maybeNixBuildSpec, filesArtifactExpansion, envVars)
+	// build a service config from image, files artifacts expansion and resources.
+	builtin.serviceConfig, err = getServiceConfig(maybeImageName, maybeImageBuildSpec, maybeImageRegistrySpec, maybeNixBuildSpec, filesArtifactExpansion, envVars, resources)
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating service config for run python.")
 	}
@@ -297,33 +299,20 @@ func (builtin *RunPythonCapabilities) Validate(_ *builtin_argument.ArgumentValue
 	return validateTasksCommon(validatorEnvironment, builtin.storeSpecList, serviceDirpathsToArtifactIdentifiers, builtin.serviceConfig)
 }
 
-// Execute This is just v0 for run_python task - we can later improve on it.
-//
-//	TODO Create an mechanism for other services to retrieve files from the task container
-//	TODO Make task as its own entity instead of currently shown under services
+// Execute installs the packages and runs the script in a one-shot container, which is removed once it exits
 func (builtin *RunPythonCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
-	_, err := builtin.serviceNetwork.AddService(ctx, service.ServiceName(builtin.name), builtin.serviceConfig)
-	if err != nil {
-		return "", stacktrace.Propagate(err, "error occurred while creating a run_python task with image: %v", builtin.serviceConfig.GetContainerImageName())
-	}
-
-	pipInstallationResult, err := setupRequiredPackages(ctx, builtin)
+	commandToRun, err := getPythonCommandToRun(builtin)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "an error occurred while installing dependencies")
-	}
-
-	if pipInstallationResult != nil && pipInstallationResult.GetExitCode() != successfulPipRunExitCode {
-		return "", stacktrace.NewError("an error occurred while installing dependencies as pip exited with code '%v' instead of '%v'. The error was:\n%v", pipInstallationResult.GetExitCode(), successfulPipRunExitCode, pipInstallationResult.GetOutput())
+		return "", stacktrace.Propagate(err, "error occurred while preparing the sh command to execute on the image")
 	}
 
-	commandToRun, err := getPythonCommandToRun(builtin)
+	taskConfig, err := renderTaskConfig(builtin.runtimeValueStore, builtin.serviceConfig, []string{shellWrapperCommand, "-c", commandToRun}, nil)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "error occurred while preparing the sh command to execute on the image")
+		return "", stacktrace.Propagate(err, "An error occurred rendering the config of the run_python task.")
 	}
-	fullCommandToRun := getCommandToRunForStreamingLogs(commandToRun)
 
-	// run the command passed in by user in the container
-	runPythonExecutionResult, err := executeWithWait(ctx, builtin.serviceNetwork, builtin.name, builtin.wait, fullCommandToRun)
+	// If the user indicated not to block on removing tasks, the task container gets removed in the background.
+	runPythonExecutionResult, err := runTaskWithWait(ctx, builtin.serviceNetwork, builtin.name, taskConfig, builtin.storeSpecList, builtin.wait, builtin.nonBlockingMode)
 	if err != nil {
 		return "", stacktrace.Propagate(err, fmt.Sprintf("error occurred while executing one time task command: %v ", builtin.run))
 	}
@@ -344,22 +333,7 @@ func (builtin *RunPythonCapabilities) Execute(ctx context.Context, _ *builtin_ar
 		return "", stacktrace.NewError(formatErrorMessage(errorMessage, runPythonExecutionResult.GetOutput()))
 	}
 
-	if builtin.storeSpecList != nil {
-		err = copyFilesFromTask(ctx, builtin.serviceNetwork, builtin.name, builtin.storeSpecList)
-		if err != nil {
-			return "", stacktrace.Propagate(err, "error occurred while copying files from  a task")
-		}
-	}
-
-	// If the user indicated not to block on removing services after tasks, don't remove the service.
-	// The user will have to remove the task service themselves or it will get cleaned up with Kurtosis clean.
-	if !builtin.nonBlockingMode {
-		if err = removeService(ctx, builtin.serviceNetwork, builtin.name); err != nil {
-			return "", stacktrace.Propagate(err, "attempted to remove the temporary task container but failed")
-		}
-	}
-
-	return instructionResult, err
+	return instructionResult, nil
 }
 
 func (builtin *RunPythonCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
@@ -385,27 +359,6 @@ func (builtin *RunPythonCapabilities) Description() string {
 	return builtin.description
 }
 
-func setupRequiredPackages(ctx context.Context, builtin *RunPythonCapabilities) (*exec_result.ExecResult, error) {
-	if len(builtin.packages) == 0 {
-		return nil, nil
-	}
-
-	packageInstallationSubCommand := fmt.Sprintf("%v %v", pipInstallCmd, strings.Join(builtin.packages, spaceDelimiter))
-	packageInstallationCommand := []string{shellWrapperCommand, "-c", packageInstallationSubCommand}
-
-	executionResult, err := builtin.serviceNetwork.RunExec(
-		ctx,
-		builtin.name,
-		packageInstallationCommand,
-	)
-
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred while installing required dependencies")
-	}
-
-	return executionResult, nil
-}
-
 func getPythonCommandToRun(builtin *RunPythonCapabilities) (string, error) {
 	var maybePythonArgumentsWithRuntimeValueReplaced []string
 	for _, pythonArgument := range builtin.pythonArguments {
@@ -417,8 +370,13 @@ func getPythonCommandToRun(builtin *RunPythonCapabilities) (string, error) {
 	}
 	argumentsAsString := strings.Join(maybePythonArgumentsWithRuntimeValueReplaced, spaceDelimiter)
 	runEscaped := strings.ReplaceAll(builtin.run, `"`, `\"`)
+	pythonCommand := fmt.Sprintf(`python -u -c "%s"`, runEscaped)
 	if len(argumentsAsString) > 0 {
-		return fmt.Sprintf(`python -u -c "%s" %s`, runEscaped, argumentsAsString), nil
+		pythonCommand = fmt.Sprintf(`%s %s`, pythonCommand, argumentsAsString)
+	}
+	// the packages are installed in the task container itself, right before the script runs
+	if len(builtin.packages) > 0 {
+		return fmt.Sprintf(`%s %s && %s`, pipInstallCmd, strings.Join(builtin.packages, spaceDelimiter), pythonCommand), nil
 	}
-	return fmt.Sprintf(`python -u -c "%s"`, runEscaped), nil
+	return pythonCommand, nil
 }
@@ -47,7 +47,7 @@ func NewRunShService(
 		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
 			Name: RunShBuiltinName,
 
-			Arguments: []*builtin_argument.BuiltinArgument{
+			Arguments: append([]*builtin_argument.BuiltinArgument{
 				{
 					Name:              TaskNameArgName,
 					IsOptional:        true,
@@ -90,7 +90,7 @@ func NewRunShService(
 						return builtin_argument.DurationOrNone(value, WaitArgName)
 					},
 				},
-			},
+			}, getTaskResourcesArguments()...),
 		},
 
 		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
@@ -204,12 +204,17 @@ func (builtin *RunShCapabilities) Interpret(locatorOfModuleInWhichThisBuiltinIsB
 	}
 
 	envVars, interpretationErr := extractEnvVarsIfDefined(arguments)
-	if err != nil {
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	resources, interpretationErr := parseTaskResourcesArgs(arguments)
+	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
 
-	// build a service config from image and files artifacts expansion.
-	builtin.serviceConfig, err = getServiceConfig(maybeImageName, maybeImageBuildSpec, maybeImageRegistrySpec, maybeNixBuildSpec, filesArtifactExpansion, envVars)
+	// build a service config from image, files artifacts expansion and resources.
+	builtin.serviceConfig, err = getServiceConfig(maybeImageName, maybeImageBuildSpec, maybeImageRegistrySpec, maybeNixBuildSpec, filesArtifactExpansion, envVars, resources)
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating service config using for run sh task.")
 	}
@@ -255,38 +260,28 @@ func (builtin *RunShCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet
 	return validateTasksCommon(validatorEnvironment, builtin.storeSpecList, serviceDirpathsToArtifactIdentifiers, builtin.serviceConfig)
 }
 
-// Execute This is just v0 for run_sh task - we can later improve on it.
-//
-//	TODO Create an mechanism for other services to retrieve files from the task container
-//	Make task as its own entity instead of currently shown under services
+// Execute runs the script in a one-shot container, which is removed once it exits
 func (builtin *RunShCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
-	// swap env vars with their runtime value
-	serviceConfigWithReplacedEnvVars, err := replaceMagicStringsInEnvVars(builtin.runtimeValueStore, builtin.serviceConfig)
-	if err != nil {
-		return "", stacktrace.Propagate(err, "An error occurred replacing magic strings in env vars.")
-	}
-
-	_, err = builtin.serviceNetwork.AddService(ctx, service.ServiceName(builtin.name), serviceConfigWithReplacedEnvVars)
+	commandToRun, err := getCommandToRun(builtin)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "error occurred while creating a run_sh task with image: %v", builtin.serviceConfig.GetContainerImageName())
+		return "", stacktrace.Propagate(err, "error occurred while preparing the sh command to execute on the image")
 	}
 
-	// create work directory and cd into that directory
-	commandToRun, err := getCommandToRun(builtin)
+	// swap env vars with their runtime value
+	taskConfig, err := renderTaskConfig(builtin.runtimeValueStore, builtin.serviceConfig, []string{shellWrapperCommand, "-c", commandToRun}, nil)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "error occurred while preparing the sh command to execute on the image")
+		return "", stacktrace.Propagate(err, "An error occurred rendering the config of the run_sh task.")
 	}
-	fullCommandToRun := getCommandToRunForStreamingLogs(commandToRun)
 
-	// run the command passed in by user in the container
-	createDefaultDirectoryResult, err := executeWithWait(ctx, builtin.serviceNetwork, builtin.name, builtin.wait, fullCommandToRun)
+	// If the user indicated not to block on removing tasks, the task container gets removed in the background.
+	runShExecutionResult, err := runTaskWithWait(ctx, builtin.serviceNetwork, builtin.name, taskConfig, builtin.storeSpecList, builtin.wait, builtin.nonBlockingMode)
 	if err != nil {
 		return "", stacktrace.Propagate(err, fmt.Sprintf("error occurred while executing one time task command: %v ", builtin.run))
 	}
 
 	result := map[string]starlark.Comparable{
-		runResultOutputKey: starlark.String(createDefaultDirectoryResult.GetOutput()),
-		runResultCodeKey:   starlark.MakeInt(int(createDefaultDirectoryResult.GetExitCode())),
+		runResultOutputKey: starlark.String(runShExecutionResult.GetOutput()),
+		runResultCodeKey:   starlark.MakeInt(int(runShExecutionResult.GetExitCode())),
 	}
 
 	if err := builtin.runtimeValueStore.SetValue(builtin.resultUuid, result); err != nil {
@@ -295,27 +290,12 @@ func (builtin *RunShCapabilities) Execute(ctx context.Context, _ *builtin_argume
 	instructionResult := resultMapToString(result, RunShBuiltinName)
 
 	// throw an error as execution of the command failed
-	if createDefaultDirectoryResult.GetExitCode() != 0 {
-		errorMessage := fmt.Sprintf("Shell command: %q exited with code %d and output", commandToRun, createDefaultDirectoryResult.GetExitCode())
-		return "", stacktrace.NewError(formatErrorMessage(errorMessage, createDefaultDirectoryResult.GetOutput()))
+	if runShExecutionResult.GetExitCode() != 0 {
+		errorMessage := fmt.Sprintf("Shell command: %q exited with code %d and output", commandToRun, runShExecutionResult.GetExitCode())
+		return "", stacktrace.NewError(formatErrorMessage(errorMessage, runShExecutionResult.GetOutput()))
 	}
 
-	if builtin.storeSpecList != nil {
-		err = copyFilesFromTask(ctx, builtin.serviceNetwork, builtin.name, builtin.storeSpecList)
-		if err != nil {
-			return "", stacktrace.Propagate(err, "error occurred while copying files from  a task")
-		}
-	}
-
-	// If the user indicated not to block on removing services after tasks, don't remove the service.
-	// The user will have to remove the task service themselves, or it will get cleaned up with Kurtosis clean.
-	if !builtin.nonBlockingMode {
-		if err = removeService(ctx, builtin.serviceNetwork, builtin.name); err != nil {
-			return "", stacktrace.Propagate(err, "attempted to remove the temporary task container but failed")
-		}
-	}
-
-	return instructionResult, err
+	return instructionResult, nil
 }
 
 func (builtin *RunShCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
@@ -350,26 +330,3 @@ func getCommandToRun(builtin *RunShCapabilities) (string, error) {
 
 	return maybeSubCommandWithRuntimeValues, nil
 }
-
-func replaceMagicStringsInEnvVars(runtimeValueStore *runtime_value_store.RuntimeValueStore, serviceConfig *service.ServiceConfig) (
-	*service.ServiceConfig,
-	error) {
-	var envVars map[string]string
-	if serviceConfig.GetEnvVars() != nil {
-		envVars = make(map[string]string, len(serviceConfig.GetEnvVars()))
-		for envVarName, envVarValue := range serviceConfig.GetEnvVars() {
-			envVarValueWithRuntimeValueReplaced, err := magic_string_helper.ReplaceRuntimeValueInString(envVarValue, runtimeValueStore)
-			if err != nil {
-				return nil, stacktrace.Propagate(err, "Error occurred while replacing runtime value in command args for '%s': '%s'", envVarName, envVarValue)
-			}
-			envVars[envVarName] = envVarValueWithRuntimeValueReplaced
-		}
-	}
-
-	renderedServiceConfig, err := service.CreateServiceConfig(serviceConfig.GetContainerImageName(), serviceConfig.GetImageBuildSpec(), serviceConfig.GetImageRegistrySpec(), serviceConfig.GetNixBuildSpec(), serviceConfig.GetPrivatePorts(), serviceConfig.GetPublicPorts(), serviceConfig.GetEntrypointArgs(), serviceConfig.GetCmdArgs(), envVars, serviceConfig.GetFilesArtifactsExpansion(), serviceConfig.GetPersistentDirectories(), serviceConfig.GetCPUAllocationMillicpus(), serviceConfig.GetMemoryAllocationMegabytes(), serviceConfig.GetPrivateIPAddrPlaceholder(), serviceConfig.GetMinCPUAllocationMillicpus(), serviceConfig.GetMinMemoryAllocationMegabytes(), serviceConfig.GetLabels(), serviceConfig.GetUser(), serviceConfig.GetTolerations(), serviceConfig.GetNodeSelectors(), serviceConfig.GetImageDownloadMode(), tiniEnabled)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred creating a service config with env var magric strings replaced.")
-	}
-
-	return renderedServiceConfig, nil
-}
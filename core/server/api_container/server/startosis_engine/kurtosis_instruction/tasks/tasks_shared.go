@@ -2,12 +2,16 @@ package tasks
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_user"
 	"github.com/xtgo/uuid"
+	v1 "k8s.io/api/core/v1"
+	"math"
 	"reflect"
 	"strings"
 	"time"
@@ -22,6 +26,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
 	store_spec_starlark_type "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/store_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/stacktrace"
@@ -40,6 +45,16 @@ const (
 	FilesArgName      = "files"
 	EnvVarsArgName    = "env_vars"
 
+	// the resources and scheduling of a task are set like the ones of a service
+	MinCpuArgName        = service_config.MinCpuMilliCoresAttr
+	MaxCpuArgName        = service_config.MaxCpuMilliCoresAttr
+	MinMemoryArgName     = service_config.MinMemoryMegaBytesAttr
+	MaxMemoryArgName     = service_config.MaxMemoryMegaBytesAttr
+	LabelsArgName        = service_config.LabelsAttr
+	UserArgName          = service_config.UserAttr
+	TolerationsArgName   = service_config.TolerationsAttr
+	NodeSelectorsArgName = service_config.NodeSelectorsAttr
+
 	newlineChar = "\n"
 
 	DefaultWaitTimeoutDurationStr = "180s"
@@ -50,23 +65,173 @@ const (
 	runFilesArtifactsKey = "files_artifacts"
 
 	shellWrapperCommand = "/bin/sh"
-	taskLogFilePath     = "/tmp/kurtosis-task.log"
 	noNameSet           = ""
 	uniqueNameGenErrStr = "error occurred while generating unique name for the file artifact"
 
 	//  enables init mode on containers; cleaning up any zombie processes
 	tiniEnabled = true
+
+	minimumTaskMemoryAllocationMegabytes = 6 // Docker doesn't allow memory limits less than 6 megabytes
 )
 
-var runCommandToStreamTaskLogs = []string{shellWrapperCommand, "-c", fmt.Sprintf("touch %s && tail -F %s", taskLogFilePath, taskLogFilePath)}
+// taskResources holds the resources and scheduling constraints of a task, which are passed through to its ServiceConfig
+type taskResources struct {
+	minCpuMilliCores   uint64
+	maxCpuMilliCores   uint64
+	minMemoryMegaBytes uint64
+	maxMemoryMegaBytes uint64
+	labels             map[string]string
+	user               *service_user.ServiceUser
+	tolerations        []v1.Toleration
+	nodeSelectors      map[string]string
+}
+
+// getTaskResourcesArguments returns the arguments shared by the tasks to set their resources and scheduling
+func getTaskResourcesArguments() []*builtin_argument.BuiltinArgument {
+	return []*builtin_argument.BuiltinArgument{
+		{
+			Name:              MinCpuArgName,
+			IsOptional:        true,
+			ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+			Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+				return builtin_argument.Uint64InRange(value, MinCpuArgName, 0, math.MaxUint64)
+			},
+		},
+		{
+			Name:              MaxCpuArgName,
+			IsOptional:        true,
+			ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+			Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+				return builtin_argument.Uint64InRange(value, MaxCpuArgName, 0, math.MaxUint64)
+			},
+		},
+		{
+			Name:              MinMemoryArgName,
+			IsOptional:        true,
+			ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+			Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+				return builtin_argument.Uint64InRange(value, MinMemoryArgName, 0, math.MaxUint64)
+			},
+		},
+		{
+			Name:              MaxMemoryArgName,
+			IsOptional:        true,
+			ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+			Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+				return builtin_argument.Uint64InRange(value, MaxMemoryArgName, minimumTaskMemoryAllocationMegabytes, math.MaxUint64)
+			},
+		},
+		{
+			Name:              LabelsArgName,
+			IsOptional:        true,
+			ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+			Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+				return builtin_argument.ServiceLabelsValidator(value, LabelsArgName)
+			},
+		},
+		{
+			Name:              UserArgName,
+			IsOptional:        true,
+			ZeroValueProvider: builtin_argument.ZeroValueProvider[*service_config.User],
+			Validator:         nil,
+		},
+		{
+			Name:              TolerationsArgName,
+			IsOptional:        true,
+			ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+			Validator:         nil,
+		},
+		{
+			Name:              NodeSelectorsArgName,
+			IsOptional:        true,
+			ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+			Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+				return builtin_argument.StringMappingToString(value, NodeSelectorsArgName)
+			},
+		},
+	}
+}
+
+func parseTaskResourcesArgs(arguments *builtin_argument.ArgumentValuesSet) (*taskResources, *startosis_errors.InterpretationError) {
+	resources := &taskResources{
+		minCpuMilliCores:   0,
+		maxCpuMilliCores:   0,
+		minMemoryMegaBytes: 0,
+		maxMemoryMegaBytes: 0,
+		labels:             map[string]string{},
+		user:               nil,
+		tolerations:        nil,
+		nodeSelectors:      map[string]string{},
+	}
+
+	uint64ArgsDestinations := map[string]*uint64{
+		MinCpuArgName:    &resources.minCpuMilliCores,
+		MaxCpuArgName:    &resources.maxCpuMilliCores,
+		MinMemoryArgName: &resources.minMemoryMegaBytes,
+		MaxMemoryArgName: &resources.maxMemoryMegaBytes,
+	}
+	for argName, destination := range uint64ArgsDestinations {
+		if !arguments.IsSet(argName) {
+			continue
+		}
+		valueStarlark, err := builtin_argument.ExtractArgumentValue[starlark.Int](arguments, argName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", argName)
+		}
+		value, ok := valueStarlark.Uint64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("An error occurred parsing argument '%v' with value '%v' to uint64", argName, valueStarlark)
+		}
+		*destination = value
+	}
+
+	mapArgsDestinations := map[string]*map[string]string{
+		LabelsArgName:        &resources.labels,
+		NodeSelectorsArgName: &resources.nodeSelectors,
+	}
+	for argName, destination := range mapArgsDestinations {
+		if !arguments.IsSet(argName) {
+			continue
+		}
+		valueStarlark, err := builtin_argument.ExtractArgumentValue[*starlark.Dict](arguments, argName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", argName)
+		}
+		if valueStarlark.Len() == 0 {
+			continue
+		}
+		value, interpretationErr := kurtosis_types.SafeCastToMapStringString(valueStarlark, argName)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		*destination = value
+	}
+
+	if arguments.IsSet(UserArgName) {
+		userStarlark, err := builtin_argument.ExtractArgumentValue[*service_config.User](arguments, UserArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", UserArgName)
+		}
+		user, interpretationErr := service_config.ConvertUser(userStarlark)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		resources.user = user
+	}
+
+	if arguments.IsSet(TolerationsArgName) {
+		tolerationsStarlark, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, TolerationsArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", TolerationsArgName)
+		}
+		tolerations, interpretationErr := service_config.ConvertTolerations(tolerationsStarlark)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		resources.tolerations = tolerations
+	}
 
-// Wraps [commandToRun] to enable streaming logs from tasks.
-// Uses curly braces to execute the command(s) in the current shell.
-// Adds an extra echo to ensure each log ends with a newline.
-// Uses tee to direct output to the task log file while maintaining output to stdout.
-// Redirects stderr to stdout.
-func getCommandToRunForStreamingLogs(commandToRun string) []string {
-	return []string{shellWrapperCommand, "-c", fmt.Sprintf("{ %v; } %v %v %v %v %v %v %v %v", commandToRun, "2>&1", "|", "tee", taskLogFilePath, "&&", "echo", ">>", taskLogFilePath)}
+	return resources, nil
 }
 
 func parseStoreFilesArg(serviceNetwork service_network.ServiceNetwork, arguments *builtin_argument.ArgumentValuesSet) ([]*store_spec.StoreSpec, *startosis_errors.InterpretationError) {
@@ -188,41 +353,34 @@ func validateTasksCommon(validatorEnvironment *startosis_validator.ValidatorEnvi
 
 }
 
-func executeWithWait(ctx context.Context, serviceNetwork service_network.ServiceNetwork, serviceName string, wait string, commandToRun []string) (*exec_result.ExecResult, error) {
+// runTaskWithWait runs the task as a one-shot workload, which gets removed if it doesn't exit before the wait timeout
+func runTaskWithWait(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	taskName string,
+	taskConfig *service.ServiceConfig,
+	storeSpecList []*store_spec.StoreSpec,
+	wait string,
+	shouldRemoveTaskInBackground bool,
+) (*exec_result.ExecResult, error) {
 	// Wait is set to None
 	if wait == DisableWaitTimeoutDurationStr {
-		return serviceNetwork.RunExec(ctx, serviceName, commandToRun)
+		return serviceNetwork.RunTask(ctx, service.ServiceName(taskName), taskConfig, storeSpecList, shouldRemoveTaskInBackground)
 	}
 
-	resultChan := make(chan *exec_result.ExecResult, 1)
-	errChan := make(chan error, 1)
-
-	timoutStr := wait
-
 	// we validate timeout string during the validation stage so it cannot be invalid at this stage
-	parsedTimeout, _ := time.ParseDuration(timoutStr)
-
-	timeDuration := time.After(parsedTimeout)
+	parsedTimeout, _ := time.ParseDuration(wait)
 	contextWithDeadline, cancelContext := context.WithTimeout(ctx, parsedTimeout)
 	defer cancelContext()
 
-	go func() {
-		executionResult, err := serviceNetwork.RunExec(contextWithDeadline, serviceName, commandToRun)
-		if err != nil {
-			errChan <- err
-		} else {
-			resultChan <- executionResult
+	executionResult, err := serviceNetwork.RunTask(contextWithDeadline, service.ServiceName(taskName), taskConfig, storeSpecList, shouldRemoveTaskInBackground)
+	if err != nil {
+		if errors.Is(contextWithDeadline.Err(), context.DeadlineExceeded) {
+			return nil, stacktrace.NewError("The task timed out after %v seconds", parsedTimeout.Seconds())
 		}
-	}()
-
-	select {
-	case result := <-resultChan:
-		return result, nil
-	case err := <-errChan:
 		return nil, err
-	case <-timeDuration: // Timeout duration
-		return nil, stacktrace.NewError("The exec request timed out after %v seconds", parsedTimeout.Seconds())
 	}
+	return executionResult, nil
 }
 
 func validatePathIsUniqueWhileCreatingFileArtifact(storeSpecList []*store_spec.StoreSpec) *startosis_errors.ValidationError {
@@ -240,20 +398,6 @@ func validatePathIsUniqueWhileCreatingFileArtifact(storeSpecList []*store_spec.S
 	return nil
 }
 
-func copyFilesFromTask(ctx context.Context, serviceNetwork service_network.ServiceNetwork, serviceName string, storeSpecList []*store_spec.StoreSpec) error {
-	if storeSpecList == nil {
-		return nil
-	}
-
-	for _, storeSpec := range storeSpecList {
-		_, err := serviceNetwork.CopyFilesFromService(ctx, serviceName, storeSpec.GetSrc(), storeSpec.GetName())
-		if err != nil {
-			return stacktrace.Propagate(err, fmt.Sprintf("error occurred while copying file or directory at path: %v", storeSpec.GetSrc()))
-		}
-	}
-	return nil
-}
-
 // Copied some of the command from: exec_recipe.ResultMapToString
 // TODO: create a utility method that can be used by add_service(s) and run_sh method.
 func resultMapToString(resultMap map[string]starlark.Comparable, builtinNameForLogging string) string {
@@ -285,8 +429,10 @@ func getServiceConfig(
 	maybeNixBuildSpec *nix_build_spec.NixBuildSpec,
 	filesArtifactExpansion *service_directory.FilesArtifactsExpansion,
 	envVars *map[string]string,
+	resources *taskResources,
 ) (*service.ServiceConfig, error) {
-	serviceConfig, err := service.CreateServiceConfig(maybeImageName, maybeImageBuildSpec, maybeImageRegistrySpec, maybeNixBuildSpec, nil, nil, runCommandToStreamTaskLogs, nil, *envVars, filesArtifactExpansion, nil, 0, 0, service_config.DefaultPrivateIPAddrPlaceholder, 0, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, tiniEnabled)
+	// the command is only known at execution time, once its runtime values are replaced
+	serviceConfig, err := service.CreateServiceConfig(maybeImageName, maybeImageBuildSpec, maybeImageRegistrySpec, maybeNixBuildSpec, nil, nil, nil, nil, *envVars, filesArtifactExpansion, nil, resources.maxCpuMilliCores, resources.maxMemoryMegaBytes, service_config.DefaultPrivateIPAddrPlaceholder, resources.minCpuMilliCores, resources.minMemoryMegaBytes, resources.labels, resources.user, resources.tolerations, resources.nodeSelectors, image_download_mode.ImageDownloadMode_Missing, tiniEnabled)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating service config")
 	}
@@ -299,14 +445,6 @@ func formatErrorMessage(errorMessage string, errorFromExec string) string {
 	return fmt.Sprintf("%v\n  %v", errorMessage, reformattedErrorMessage)
 }
 
-func removeService(ctx context.Context, serviceNetwork service_network.ServiceNetwork, serviceName string) error {
-	_, err := serviceNetwork.RemoveService(ctx, serviceName)
-	if err != nil {
-		return stacktrace.Propagate(err, "error occurred while removing task with name %v", serviceName)
-	}
-	return nil
-}
-
 func extractEnvVarsIfDefined(arguments *builtin_argument.ArgumentValuesSet) (*map[string]string, *startosis_errors.InterpretationError) {
 	envVars := map[string]string{}
 	if arguments.IsSet(EnvVarsArgName) {
@@ -337,3 +475,27 @@ func getTaskNameFromArgs(arguments *builtin_argument.ArgumentValuesSet) (string,
 		return fmt.Sprintf("task-%v", randomUuid.String()), nil
 	}
 }
+
+// renderTaskConfig returns the config of the task with the command to run and the runtime values of its env vars replaced
+func renderTaskConfig(runtimeValueStore *runtime_value_store.RuntimeValueStore, serviceConfig *service.ServiceConfig, entrypointArgs []string, cmdArgs []string) (
+	*service.ServiceConfig,
+	error) {
+	var envVars map[string]string
+	if serviceConfig.GetEnvVars() != nil {
+		envVars = make(map[string]string, len(serviceConfig.GetEnvVars()))
+		for envVarName, envVarValue := range serviceConfig.GetEnvVars() {
+			envVarValueWithRuntimeValueReplaced, err := magic_string_helper.ReplaceRuntimeValueInString(envVarValue, runtimeValueStore)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Error occurred while replacing runtime value in command args for '%s': '%s'", envVarName, envVarValue)
+			}
+			envVars[envVarName] = envVarValueWithRuntimeValueReplaced
+		}
+	}
+
+	renderedServiceConfig, err := service.CreateServiceConfig(serviceConfig.GetContainerImageName(), serviceConfig.GetImageBuildSpec(), serviceConfig.GetImageRegistrySpec(), serviceConfig.GetNixBuildSpec(), serviceConfig.GetPrivatePorts(), serviceConfig.GetPublicPorts(), entrypointArgs, cmdArgs, envVars, serviceConfig.GetFilesArtifactsExpansion(), serviceConfig.GetPersistentDirectories(), serviceConfig.GetCPUAllocationMillicpus(), serviceConfig.GetMemoryAllocationMegabytes(), serviceConfig.GetPrivateIPAddrPlaceholder(), serviceConfig.GetMinCPUAllocationMillicpus(), serviceConfig.GetMinMemoryAllocationMegabytes(), serviceConfig.GetLabels(), serviceConfig.GetUser(), serviceConfig.GetTolerations(), serviceConfig.GetNodeSelectors(), serviceConfig.GetImageDownloadMode(), tiniEnabled)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a task config with its command and env var magic strings replaced.")
+	}
+
+	return renderedServiceConfig, nil
+}
@@ -144,7 +144,7 @@ func (builtin *WaitCapabilities) Interpret(_ string, arguments *builtin_argument
 	serviceName := service.ServiceName(serviceNameArgumentValue.GoString())
 
 	var genericRecipe recipe.Recipe
-	httpRecipe, err := builtin_argument.ExtractArgumentValue[recipe.HttpRequestRecipe](arguments, RecipeArgName)
+	portRequestRecipe, err := builtin_argument.ExtractArgumentValue[recipe.PortRequestRecipe](arguments, RecipeArgName)
 	if err != nil {
 		execRecipe, err := builtin_argument.ExtractArgumentValue[*recipe.ExecRecipe](arguments, RecipeArgName)
 		if err != nil {
@@ -152,7 +152,7 @@ func (builtin *WaitCapabilities) Interpret(_ string, arguments *builtin_argument
 		}
 		genericRecipe = execRecipe
 	} else {
-		genericRecipe = httpRecipe
+		genericRecipe = portRequestRecipe
 	}
 
 	valueField, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ValueFieldArgName)
@@ -232,12 +232,12 @@ func (builtin *WaitCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet,
 		return startosis_errors.NewValidationError("Tried creating a wait for service '%s' which doesn't exist", builtin.serviceName)
 	}
 
-	httpRequestRecipe, ok := builtin.recipe.(recipe.HttpRequestRecipe)
-	// if the passed recipe isn't http request recipe we can't do much
+	portRequestRecipe, ok := builtin.recipe.(recipe.PortRequestRecipe)
+	// if the passed recipe doesn't send a request to a port we can't do much
 	if !ok {
 		return nil
 	}
-	if validationErr := recipe.ValidateHttpRequestRecipe(httpRequestRecipe, builtin.serviceName, validatorEnvironment); validationErr != nil {
+	if validationErr := recipe.ValidatePortRequestRecipe(portRequestRecipe, builtin.serviceName, validatorEnvironment); validationErr != nil {
 		return validationErr
 	}
 	return nil
@@ -101,6 +101,9 @@ func deepCopyArgumentValueInternal(genericArgValue starlark.Value) (starlark.Val
 		valueCopy = starlarkstruct.FromStringDict(argValue.Constructor(), copiedStructDict)
 	case KurtosisValueType:
 		valueCopy, err = argValue.Copy()
+	case *starlark.Function:
+		// functions are immutable, the bodies of the instructions running sub-plans are passed as is
+		valueCopy = argValue
 	default:
 		logrus.Warnf("Cannot copy value of argument '%s' as the type is not handled, returning the original "+
 			"object but it might provoke unexpected behaviour downstream", argValue.String())
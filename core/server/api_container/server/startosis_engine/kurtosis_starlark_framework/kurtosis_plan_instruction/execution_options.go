@@ -0,0 +1,178 @@
+package kurtosis_plan_instruction
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/retry"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"time"
+)
+
+const (
+	// RetryArgName and TimeoutArgName are injected into all plan instructions. The timeout is left to the
+	// instructions already defining their own, like `wait`
+	RetryArgName   = "retry"
+	TimeoutArgName = "timeout"
+
+	noTimeout = time.Duration(0)
+)
+
+// executionOptions holds how the framework executes an instruction: how many times it's attempted, how long it waits
+// between two attempts, and how long each attempt is allowed to run
+type executionOptions struct {
+	attempts int
+
+	backoff time.Duration
+
+	// onCodes restricts the retries to the errors carrying one of these codes. Empty means any error is retried
+	onCodes []int64
+
+	timeout time.Duration
+}
+
+func newDefaultExecutionOptions() *executionOptions {
+	return &executionOptions{
+		attempts: 1,
+		backoff:  retry.DefaultBackoff,
+		onCodes:  []int64{},
+		timeout:  noTimeout,
+	}
+}
+
+// withExecutionOptionsArguments returns a copy of the builtin with the arguments of the execution options appended,
+// along with whether the framework handles the timeout of the instruction
+func withExecutionOptionsArguments(baseBuiltin *kurtosis_starlark_framework.KurtosisBaseBuiltin) (*kurtosis_starlark_framework.KurtosisBaseBuiltin, bool) {
+	handlesTimeout := true
+	for _, argument := range baseBuiltin.Arguments {
+		if argument.Name == TimeoutArgName {
+			handlesTimeout = false
+		}
+	}
+
+	arguments := make([]*builtin_argument.BuiltinArgument, 0, len(baseBuiltin.Arguments)+2)
+	arguments = append(arguments, baseBuiltin.Arguments...)
+	arguments = append(arguments, &builtin_argument.BuiltinArgument{
+		Name:              RetryArgName,
+		IsOptional:        true,
+		ZeroValueProvider: builtin_argument.ZeroValueProvider[*retry.Retry],
+		Validator:         nil,
+		Deprecation:       nil,
+	})
+	if handlesTimeout {
+		arguments = append(arguments, &builtin_argument.BuiltinArgument{
+			Name:              TimeoutArgName,
+			IsOptional:        true,
+			ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+			Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+				if interpretationErr := builtin_argument.NonEmptyString(value, TimeoutArgName); interpretationErr != nil {
+					return interpretationErr
+				}
+				return builtin_argument.Duration(value, TimeoutArgName)
+			},
+			Deprecation: nil,
+		})
+	}
+	return &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+		Name:        baseBuiltin.Name,
+		Arguments:   arguments,
+		Deprecation: baseBuiltin.Deprecation,
+	}, handlesTimeout
+}
+
+func parseExecutionOptions(arguments *builtin_argument.ArgumentValuesSet, handlesTimeout bool) (*executionOptions, *startosis_errors.InterpretationError) {
+	options := newDefaultExecutionOptions()
+	if arguments.IsSet(RetryArgName) {
+		retryValue, err := builtin_argument.ExtractArgumentValue[*retry.Retry](arguments, RetryArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", RetryArgName)
+		}
+		var interpretationErr *startosis_errors.InterpretationError
+		if options.attempts, interpretationErr = retryValue.GetAttempts(); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		if options.backoff, interpretationErr = retryValue.GetBackoffOrDefault(); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		if options.onCodes, interpretationErr = retryValue.GetOnCodes(); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+	if handlesTimeout && arguments.IsSet(TimeoutArgName) {
+		timeoutValue, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, TimeoutArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", TimeoutArgName)
+		}
+		timeout, err := time.ParseDuration(timeoutValue.GoString())
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to parse '%s' argument '%s'", TimeoutArgName, timeoutValue.GoString())
+		}
+		options.timeout = timeout
+	}
+	return options, nil
+}
+
+// executeWithOptions runs the execution until an attempt succeeds, an attempt fails with an error not worth
+// retrying, or all attempts are used up. Each failed attempt followed by another one is reported to the reporter the
+// executor stores in the context, if any
+func executeWithOptions(ctx context.Context, options *executionOptions, execute func(ctx context.Context) (string, error)) (string, error) {
+	reportAttempt, _ := ctx.Value(startosis_constants.InstructionResultReporterParam).(func(string))
+	backoff := options.backoff
+	for attempt := 1; ; attempt++ {
+		result, err := executeAttempt(ctx, options.timeout, execute)
+		if err == nil {
+			if attempt > 1 {
+				result = fmt.Sprintf("%s\nSucceeded on attempt %d of %d", result, attempt, options.attempts)
+			}
+			return result, nil
+		}
+		if attempt >= options.attempts {
+			if options.attempts > 1 {
+				return "", stacktrace.Propagate(err, "The instruction failed on all of its %d attempts", options.attempts)
+			}
+			return "", err
+		}
+		if !retry.IsRetryableCode(err, options.onCodes) {
+			return "", stacktrace.Propagate(err, "The instruction failed on attempt %d of %d with an error whose code isn't part of the codes to retry on '%v'", attempt, options.attempts, options.onCodes)
+		}
+		if reportAttempt != nil {
+			reportAttempt(fmt.Sprintf("Attempt %d of %d failed, retrying in %v. Error was:\n%v", attempt, options.attempts, backoff, err.Error()))
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return "", stacktrace.Propagate(ctx.Err(), "The execution was cancelled while waiting to retry the instruction after attempt %d of %d failed with error:\n%v", attempt, options.attempts, err.Error())
+		}
+		backoff = nextBackoff(backoff)
+	}
+}
+
+// executeAttempt runs a single attempt, failing it once the timeout is reached. The attempt returns once it notices its
+// context got cancelled, so that it never runs alongside the next attempt nor reports anything once the execution ended
+func executeAttempt(ctx context.Context, timeout time.Duration, execute func(ctx context.Context) (string, error)) (string, error) {
+	if timeout == noTimeout {
+		return execute(ctx)
+	}
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result, err := execute(ctxWithTimeout)
+	if err == nil || ctxWithTimeout.Err() == nil {
+		return result, err
+	}
+	if ctx.Err() != nil {
+		return "", stacktrace.Propagate(ctx.Err(), "The execution was cancelled while the instruction was running")
+	}
+	return "", stacktrace.NewError("The instruction didn't complete within its timeout of %v. It returned error:\n%v", timeout, err.Error())
+}
+
+func nextBackoff(backoff time.Duration) time.Duration {
+	if 2*backoff > retry.MaxBackoff {
+		return retry.MaxBackoff
+	}
+	return 2 * backoff
+}
@@ -0,0 +1,130 @@
+package kurtosis_plan_instruction
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/retry"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+	"time"
+)
+
+const (
+	testBackoff = time.Millisecond
+)
+
+func newTestExecutionOptions(attempts int, onCodes []int64, timeout time.Duration) *executionOptions {
+	return &executionOptions{
+		attempts: attempts,
+		backoff:  testBackoff,
+		onCodes:  onCodes,
+		timeout:  timeout,
+	}
+}
+
+func newFailingExecution(numberOfFailures int, resultCode int) (func(ctx context.Context) (string, error), *int) {
+	numberOfCalls := 0
+	return func(ctx context.Context) (string, error) {
+		numberOfCalls += 1
+		if numberOfCalls <= numberOfFailures {
+			return "", retry.NewErrorWithResultCode(starlark.MakeInt(resultCode), "attempt failed with code '%d'", resultCode)
+		}
+		return "done", nil
+	}, &numberOfCalls
+}
+
+func TestExecuteWithOptions_NoRetry(t *testing.T) {
+	execution, numberOfCalls := newFailingExecution(1, 1)
+	_, err := executeWithOptions(context.Background(), newDefaultExecutionOptions(), execution)
+	require.Error(t, err)
+	require.Equal(t, 1, *numberOfCalls)
+}
+
+func TestExecuteWithOptions_SucceedsAfterRetries(t *testing.T) {
+	var reportedAttempts []string
+	ctx := context.WithValue(context.Background(), startosis_constants.InstructionResultReporterParam, func(attemptResult string) {
+		reportedAttempts = append(reportedAttempts, attemptResult)
+	})
+
+	execution, numberOfCalls := newFailingExecution(2, 1)
+	result, err := executeWithOptions(ctx, newTestExecutionOptions(3, []int64{}, noTimeout), execution)
+	require.NoError(t, err)
+	require.Equal(t, "done\nSucceeded on attempt 3 of 3", result)
+	require.Equal(t, 3, *numberOfCalls)
+	require.Len(t, reportedAttempts, 2)
+	require.Contains(t, reportedAttempts[0], "Attempt 1 of 3 failed, retrying in 1ms")
+	require.Contains(t, reportedAttempts[1], "Attempt 2 of 3 failed, retrying in 2ms")
+}
+
+func TestExecuteWithOptions_FailsOnAllAttempts(t *testing.T) {
+	execution, numberOfCalls := newFailingExecution(3, 1)
+	_, err := executeWithOptions(context.Background(), newTestExecutionOptions(3, []int64{}, noTimeout), execution)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "The instruction failed on all of its 3 attempts")
+	require.Equal(t, 3, *numberOfCalls)
+}
+
+func TestExecuteWithOptions_RetriesOnlyOnCodes(t *testing.T) {
+	execution, numberOfCalls := newFailingExecution(1, 503)
+	result, err := executeWithOptions(context.Background(), newTestExecutionOptions(3, []int64{502, 503}, noTimeout), execution)
+	require.NoError(t, err)
+	require.Equal(t, "done\nSucceeded on attempt 2 of 3", result)
+	require.Equal(t, 2, *numberOfCalls)
+
+	execution, numberOfCalls = newFailingExecution(1, 500)
+	_, err = executeWithOptions(context.Background(), newTestExecutionOptions(3, []int64{502, 503}, noTimeout), execution)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "isn't part of the codes to retry on '[502 503]'")
+	require.Equal(t, 1, *numberOfCalls)
+}
+
+func TestExecuteWithOptions_CodeIsKeptWhenPropagated(t *testing.T) {
+	execution := func(ctx context.Context) (string, error) {
+		err := retry.NewErrorWithResultCode(starlark.MakeInt(503), "request failed")
+		return "", stacktrace.Propagate(err, "Error executing request recipe")
+	}
+	_, err := executeWithOptions(context.Background(), newTestExecutionOptions(2, []int64{503}, noTimeout), execution)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "The instruction failed on all of its 2 attempts")
+}
+
+func TestExecuteWithOptions_Timeout(t *testing.T) {
+	execution := func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	}
+	_, err := executeWithOptions(context.Background(), newTestExecutionOptions(1, []int64{}, 10*time.Millisecond), execution)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "The instruction didn't complete within its timeout of 10ms")
+}
+
+func TestExecuteWithOptions_TimedOutAttemptReturnsBeforeNextOne(t *testing.T) {
+	runningAttempts := 0
+	maxRunningAttempts := 0
+	numberOfCalls := 0
+	execution := func(ctx context.Context) (string, error) {
+		numberOfCalls += 1
+		runningAttempts += 1
+		defer func() { runningAttempts -= 1 }()
+		if runningAttempts > maxRunningAttempts {
+			maxRunningAttempts = runningAttempts
+		}
+		<-ctx.Done()
+		// an execution noticing the cancellation late
+		time.Sleep(5 * time.Millisecond)
+		return "", ctx.Err()
+	}
+	_, err := executeWithOptions(context.Background(), newTestExecutionOptions(3, []int64{}, time.Millisecond), execution)
+	require.Error(t, err)
+	require.Equal(t, 3, numberOfCalls)
+	require.Equal(t, 1, maxRunningAttempts)
+	require.Equal(t, 0, runningAttempts)
+}
+
+func TestNextBackoff_IsCapped(t *testing.T) {
+	require.Equal(t, 2*time.Second, nextBackoff(time.Second))
+	require.Equal(t, retry.MaxBackoff, nextBackoff(retry.MaxBackoff-time.Second))
+	require.Equal(t, retry.MaxBackoff, nextBackoff(retry.MaxBackoff))
+}
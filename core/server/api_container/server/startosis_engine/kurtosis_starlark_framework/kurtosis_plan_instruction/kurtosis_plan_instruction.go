@@ -47,12 +47,21 @@ func NewKurtosisPlanInstructionWrapper(instruction *KurtosisPlanInstruction, enc
 
 func (builtin *KurtosisPlanInstructionWrapper) CreateBuiltin() func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
 	return func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
-		wrappedBuiltin, interpretationErr := kurtosis_starlark_framework.WrapKurtosisBaseBuiltin(builtin.KurtosisBaseBuiltin, thread, args, kwargs)
+		baseBuiltinWithExecutionOptions, handlesTimeout := withExecutionOptionsArguments(builtin.KurtosisBaseBuiltin)
+		wrappedBuiltin, interpretationErr := kurtosis_starlark_framework.WrapKurtosisBaseBuiltin(baseBuiltinWithExecutionOptions, thread, args, kwargs)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		instructionExecutionOptions, interpretationErr := parseExecutionOptions(wrappedBuiltin.GetArguments(), handlesTimeout)
 		if interpretationErr != nil {
 			return nil, interpretationErr
 		}
 
-		instructionWrapper := newKurtosisPlanInstructionInternal(wrappedBuiltin, builtin.Capabilities(), builtin.DefaultDisplayArguments)
+		capabilities := builtin.Capabilities()
+		if blockCapabilities, ok := capabilities.(KurtosisPlanBlockCapabilities); ok {
+			blockCapabilities.SetSubPlanRecorder(newSubPlanRecorder(thread, builtin.instructionsPlan))
+		}
+		instructionWrapper := newKurtosisPlanInstructionInternal(wrappedBuiltin, capabilities, builtin.DefaultDisplayArguments, instructionExecutionOptions)
 
 		returnedFutureValue, interpretationErr := instructionWrapper.interpret()
 		if interpretationErr != nil {
@@ -61,7 +70,7 @@ func (builtin *KurtosisPlanInstructionWrapper) CreateBuiltin() func(thread *star
 
 		var enclavePlanInstructionPulledFromMaskMaybe *enclave_plan_persistence.EnclavePlanInstruction
 		var instructionResolutionStatus enclave_structure.InstructionResolutionStatus
-		if builtin.instructionPlanMask.HasNext() {
+		if builtin.instructionPlanMask.HasNext() && !isRecordingSubPlan(thread) {
 			_, enclavePlanInstructionPulledFromMaskMaybe = builtin.instructionPlanMask.Next()
 			if enclavePlanInstructionPulledFromMaskMaybe != nil {
 				instructionResolutionStatus = instructionWrapper.TryResolveWith(enclavePlanInstructionPulledFromMaskMaybe, builtin.enclaveComponents)
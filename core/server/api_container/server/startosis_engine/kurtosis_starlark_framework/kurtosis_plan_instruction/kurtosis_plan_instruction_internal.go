@@ -20,15 +20,19 @@ type kurtosisPlanInstructionInternal struct {
 	capabilities KurtosisPlanInstructionCapabilities
 
 	defaultDisplayArguments map[string]bool
+
+	executionOptions *executionOptions
 }
 
-func newKurtosisPlanInstructionInternal(internalBuiltin *kurtosis_starlark_framework.KurtosisBaseBuiltinInternal, capabilities KurtosisPlanInstructionCapabilities, defaultDisplayArguments map[string]bool) *kurtosisPlanInstructionInternal {
+func newKurtosisPlanInstructionInternal(internalBuiltin *kurtosis_starlark_framework.KurtosisBaseBuiltinInternal, capabilities KurtosisPlanInstructionCapabilities, defaultDisplayArguments map[string]bool, executionOptions *executionOptions) *kurtosisPlanInstructionInternal {
 	return &kurtosisPlanInstructionInternal{
 		KurtosisBaseBuiltinInternal: internalBuiltin,
 
 		capabilities: capabilities,
 
 		defaultDisplayArguments: defaultDisplayArguments,
+
+		executionOptions: executionOptions,
 	}
 }
 
@@ -71,7 +75,9 @@ func (builtin *kurtosisPlanInstructionInternal) Validate(validatorEnvironment *s
 }
 
 func (builtin *kurtosisPlanInstructionInternal) Execute(ctx context.Context) (*string, error) {
-	result, err := builtin.capabilities.Execute(ctx, builtin.GetArguments())
+	result, err := executeWithOptions(ctx, builtin.executionOptions, func(ctx context.Context) (string, error) {
+		return builtin.capabilities.Execute(ctx, builtin.GetArguments())
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,58 @@
+package kurtosis_plan_instruction
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+// isRecordingSubPlanThreadLocalKey is set on the thread while a sub-plan is being recorded. The instructions of
+// sub-plans are resolved against the enclave plan by the instruction running them, so they don't read the plan mask
+const isRecordingSubPlanThreadLocalKey = "isRecordingSubPlan"
+
+// KurtosisPlanBlockCapabilities is implemented by the capabilities of the instructions running sub-plans, like
+// `plan.if_`. The framework hands them a SubPlanRecorder before interpreting them, so that they can record the
+// instructions their Starlark bodies add to the plan
+type KurtosisPlanBlockCapabilities interface {
+	SetSubPlanRecorder(recorder *SubPlanRecorder)
+}
+
+// SubPlanRecorder calls Starlark bodies at interpretation time and records the plan instructions they add. The
+// recorded instructions are taken out of the plan, the instruction running the sub-plan being the one executing them
+type SubPlanRecorder struct {
+	thread *starlark.Thread
+
+	instructionsPlan *instructions_plan.InstructionsPlan
+}
+
+func newSubPlanRecorder(thread *starlark.Thread, instructionsPlan *instructions_plan.InstructionsPlan) *SubPlanRecorder {
+	return &SubPlanRecorder{
+		thread:           thread,
+		instructionsPlan: instructionsPlan,
+	}
+}
+
+// Record calls the body with the given arguments and returns the instructions it added to the plan, in order
+func (recorder *SubPlanRecorder) Record(body starlark.Callable, args starlark.Tuple) ([]kurtosis_instruction.KurtosisInstruction, *startosis_errors.InterpretationError) {
+	wasRecordingSubPlan := recorder.thread.Local(isRecordingSubPlanThreadLocalKey)
+	recorder.thread.SetLocal(isRecordingSubPlanThreadLocalKey, true)
+	defer recorder.thread.SetLocal(isRecordingSubPlanThreadLocalKey, wasRecordingSubPlan)
+
+	planSizeBeforeBody := recorder.instructionsPlan.Size()
+	if _, err := starlark.Call(recorder.thread, body, args, nil); err != nil {
+		// drop what the body added before failing so that the plan doesn't hold half a sub-plan
+		recorder.instructionsPlan.TakeInstructionsAddedPastSize(planSizeBeforeBody)
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred calling '%s' to record the instructions of its sub-plan", body.Name())
+	}
+	var instructions []kurtosis_instruction.KurtosisInstruction
+	for _, scheduledInstruction := range recorder.instructionsPlan.TakeInstructionsAddedPastSize(planSizeBeforeBody) {
+		instructions = append(instructions, scheduledInstruction.GetInstruction())
+	}
+	return instructions, nil
+}
+
+func isRecordingSubPlan(thread *starlark.Thread) bool {
+	isRecording, ok := thread.Local(isRecordingSubPlanThreadLocalKey).(bool)
+	return ok && isRecording
+}
@@ -44,7 +44,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddService() {
 			return true
 		}),
 	).Times(1).Return(
-		service.NewService(service.NewServiceRegistration(testServiceName, testServiceUuid, testEnclaveUuid, nil, string(testServiceName)), nil, nil, nil, container.NewContainer(container.ContainerStatus_Running, "", nil, nil, nil)),
+		service.NewService(service.NewServiceRegistration(testServiceName, testServiceUuid, testEnclaveUuid, nil, string(testServiceName)), nil, nil, nil, nil, container.NewContainer(container.ContainerStatus_Running, "", nil, nil, nil, 0)),
 		nil,
 	)
 
@@ -0,0 +1,56 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/copy_files"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	testCopyFilesDestPath = "/path/to/dest"
+)
+
+type copyFilesTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestCopyFiles() {
+	suite.serviceNetwork.EXPECT().CopyFilesArtifactToService(
+		mock.Anything,
+		string(testServiceName),
+		testArtifactName,
+		testCopyFilesDestPath,
+	).Times(1).Return(
+		nil,
+	)
+
+	suite.run(&copyFilesTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *copyFilesTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return copy_files.NewCopyFiles(t.serviceNetwork)
+}
+
+func (t *copyFilesTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%q)", copy_files.CopyFilesBuiltinName, copy_files.ServiceArgName, testServiceName, copy_files.SrcArtifactArgName, testArtifactName, copy_files.DestArgName, testCopyFilesDestPath)
+}
+
+func (t *copyFilesTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *copyFilesTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Files artifact '%s' copied to '%s' on service '%s'", testArtifactName, testCopyFilesDestPath, testServiceName)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
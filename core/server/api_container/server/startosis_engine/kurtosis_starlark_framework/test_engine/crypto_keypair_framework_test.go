@@ -0,0 +1,93 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/crypto_module"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_helper"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"testing"
+)
+
+const (
+	testCryptoSeed = "kurtosis"
+)
+
+type cryptoKeypairTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisHelperTestSuite) TestCryptoKeypair() {
+	suite.run(&cryptoKeypairTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *cryptoKeypairTestCase) GetHelper() *kurtosis_helper.KurtosisHelper {
+	return crypto_module.NewKeypairHelper()
+}
+
+func (t *cryptoKeypairTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf(`%s(%s=%q, %s=%q)`, crypto_module.KeypairBuiltinName, crypto_module.KeyTypeArgName, crypto_module.Secp256k1KeyType, crypto_module.SeedArgName, testCryptoSeed)
+}
+
+func (t *cryptoKeypairTestCase) Assert(result starlark.Value) {
+	keypair, ok := result.(*starlarkstruct.Struct)
+	require.True(t, ok)
+	// The keys must never change for a given seed, or re-running a package would replace all of its keys
+	privateKey, err := keypair.Attr("private_key")
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("90a86c96c5216037c16db906166f9a31827a1d05eab62feb932aef2f294c9a91"), privateKey)
+	address, err := keypair.Attr("address")
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("0x035b589a89fb97f6e08AcA9c8c789150418d2509"), address)
+}
+
+type cryptoBlsKeypairTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisHelperTestSuite) TestCryptoBlsKeypair() {
+	suite.run(&cryptoBlsKeypairTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *cryptoBlsKeypairTestCase) GetHelper() *kurtosis_helper.KurtosisHelper {
+	return crypto_module.NewKeypairHelper()
+}
+
+func (t *cryptoBlsKeypairTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf(`%s(%q, %q)`, crypto_module.KeypairBuiltinName, crypto_module.BlsKeyType, testCryptoSeed)
+}
+
+func (t *cryptoBlsKeypairTestCase) Assert(result starlark.Value) {
+	keypair, ok := result.(*starlarkstruct.Struct)
+	require.True(t, ok)
+	privateKey, err := keypair.Attr("private_key")
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("07b68671d22cfedf71d5e444eaa1a9336191b63a6f2cc3cec87fa6bdb69b4296"), privateKey)
+	publicKey, err := keypair.Attr("public_key")
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("8ba7e63ba6936f07c8ded706d5d6b55f18b2bb26f16939ed4210034dd9ff9d84589b58e65b448d81520fb7d6001791c2"), publicKey)
+}
+
+func (suite *KurtosisHelperTestSuite) TestCryptoKeypair_BitsOnlyForRsa() {
+	suite.runShouldFail(testModulePackageId, &cryptoKeypairWithBitsTestCase{T: suite.T()}, "Argument 'bits' can only be set for 'rsa' keys")
+}
+
+type cryptoKeypairWithBitsTestCase struct {
+	*testing.T
+}
+
+func (t *cryptoKeypairWithBitsTestCase) GetHelper() *kurtosis_helper.KurtosisHelper {
+	return crypto_module.NewKeypairHelper()
+}
+
+func (t *cryptoKeypairWithBitsTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf(`%s(%q, %q, %s=3072)`, crypto_module.KeypairBuiltinName, crypto_module.Ed25519KeyType, testCryptoSeed, crypto_module.BitsArgName)
+}
+
+func (t *cryptoKeypairWithBitsTestCase) Assert(_ starlark.Value) {
+}
@@ -2,6 +2,7 @@ package test_engine
 
 import (
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/directory"
 	"github.com/stretchr/testify/require"
@@ -39,4 +40,12 @@ func (t *directoryPersistentDirectoryTestCase) Assert(typeValue builtin_argument
 	size, err := directoryStarlark.GetSizeOrDefault()
 	require.Nil(t, err)
 	require.Equal(t, testPersistentDirectorySizeInBytes, size)
+
+	storageClass, err := directoryStarlark.GetStorageClassOrDefault()
+	require.Nil(t, err)
+	require.Equal(t, service_directory.DefaultStorageClass, storageClass)
+
+	accessMode, err := directoryStarlark.GetAccessModeOrDefault()
+	require.Nil(t, err)
+	require.Equal(t, service_directory.DefaultAccessMode, accessMode)
 }
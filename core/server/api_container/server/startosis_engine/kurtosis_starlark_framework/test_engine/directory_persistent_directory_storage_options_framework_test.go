@@ -0,0 +1,42 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/directory"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type directoryPersistentDirectoryStorageOptionsTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestDirectoryPersistentDirectoryStorageOptions() {
+	suite.run(&directoryPersistentDirectoryStorageOptionsTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *directoryPersistentDirectoryStorageOptionsTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%d, %s=%q, %s=%q)", directory.DirectoryTypeName, directory.PersistentKeyAttr, testPersistentDirectoryKey, directory.SizeKeyAttr, testPersistentDirectorySize, directory.StorageClassAttr, testPersistentDirectoryStorageClass, directory.AccessModeAttr, testPersistentDirectoryAccessMode)
+}
+
+func (t *directoryPersistentDirectoryStorageOptionsTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	directoryStarlark, ok := typeValue.(*directory.Directory)
+	require.True(t, ok)
+
+	persistentKey, found, err := directoryStarlark.GetPersistentKeyIfSet()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, testPersistentDirectoryKey, persistentKey)
+
+	storageClass, err := directoryStarlark.GetStorageClassOrDefault()
+	require.Nil(t, err)
+	require.Equal(t, testPersistentDirectoryStorageClass, storageClass)
+
+	accessMode, err := directoryStarlark.GetAccessModeOrDefault()
+	require.Nil(t, err)
+	require.Equal(t, service_directory.AccessMode_ReadWriteMany, accessMode)
+}
@@ -0,0 +1,66 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/exec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type execWithRetryTestCase struct {
+	*testing.T
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestExecWithRetry() {
+	suite.serviceNetwork.EXPECT().RunExec(
+		mock.Anything,
+		string(execServiceName),
+		[]string{"mkdir", "-p", "/tmp/store"},
+	).Times(1).Return(
+		exec_result.NewExecResult(1, "mkdir: cannot create directory '/tmp/store'"),
+		nil,
+	)
+	suite.serviceNetwork.EXPECT().RunExec(
+		mock.Anything,
+		string(execServiceName),
+		[]string{"mkdir", "-p", "/tmp/store"},
+	).Times(1).Return(
+		exec_result.NewExecResult(0, ""),
+		nil,
+	)
+
+	suite.run(&execWithRetryTestCase{
+		T:                 suite.T(),
+		serviceNetwork:    suite.serviceNetwork,
+		runtimeValueStore: suite.runtimeValueStore,
+	})
+}
+
+func (t *execWithRetryTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return exec.NewExec(t.serviceNetwork, t.runtimeValueStore)
+}
+
+func (t *execWithRetryTestCase) GetStarlarkCode() string {
+	recipe := `ExecRecipe(command=["mkdir", "-p", "/tmp/store"])`
+	retry := `Retry(attempts=3, backoff="10ms", on_codes=[1])`
+	return fmt.Sprintf("%s(%s=%q, %s=%s, %s=%s, %s=%q)", exec.ExecBuiltinName, exec.ServiceNameArgName, execServiceName, exec.RecipeArgName, recipe, kurtosis_plan_instruction.RetryArgName, retry, kurtosis_plan_instruction.TimeoutArgName, "10s")
+}
+
+func (t *execWithRetryTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *execWithRetryTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	expectedInterpretationResultMap := `{"code": "{{kurtosis:[0-9a-f]{32}:code.runtime_value}}", "output": "{{kurtosis:[0-9a-f]{32}:output.runtime_value}}"}`
+	require.Regexp(t, expectedInterpretationResultMap, interpretationResult.String())
+
+	require.Equal(t, "Command returned with exit code '0' with no output\nSucceeded on attempt 2 of 3", *executionResult)
+}
@@ -0,0 +1,91 @@
+package test_engine
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	httpRequestRecipeResponseJson = `{"id": "1234"}`
+)
+
+type httpRequestRecipeTestCase struct {
+	*testing.T
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestHttpRequestRecipe() {
+	expectedOptions := &service_network.HttpRequestOptions{
+		UseTls:               true,
+		CaCertificatesPem:    nil,
+		ClientCertificatePem: nil,
+		ClientKeyPem:         nil,
+		FollowRedirects:      false,
+	}
+	suite.serviceNetwork.EXPECT().HttpRequestServiceWithOptions(
+		mock.Anything,
+		string(testServiceName),
+		testPrivatePortId,
+		"PUT",
+		"application/json",
+		"/items/1234",
+		`{"name": "item"}`,
+		map[string]string{"Authorization": "Bearer token"},
+		expectedOptions,
+	).Times(1).Return(
+		&http.Response{ // nolint: exhaustruct
+			Status:     "201 Created",
+			StatusCode: 201,
+			Header:     http.Header{"Location": []string{"/items/1234"}},
+			Body:       io.NopCloser(strings.NewReader(httpRequestRecipeResponseJson)),
+		},
+		nil,
+	)
+
+	suite.run(&httpRequestRecipeTestCase{
+		T:                 suite.T(),
+		serviceNetwork:    suite.serviceNetwork,
+		runtimeValueStore: suite.runtimeValueStore,
+	})
+}
+
+func (t *httpRequestRecipeTestCase) GetStarlarkCode() string {
+	extractors := `{"id": ".id", "location": "$headers[\"Location\"]"}`
+	headers := `{"Authorization": "Bearer token"}`
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%q, %s=%q, %s=%q, %s=%s, %s=%s, %s=%s, %s=%s)", recipe.HttpRequestRecipeTypeName, recipe.PortIdAttr, testPrivatePortId, recipe.EndpointAttr, "/items/1234", recipe.MethodAttr, "PUT", recipe.RequestBodyAttr, `{"name": "item"}`, recipe.ContentTypeAttr, "application/json", recipe.HeadersAttr, headers, recipe.ExtractAttr, extractors, recipe.TlsAttr, "True", recipe.FollowRedirectsAttr, "False")
+}
+
+func (t *httpRequestRecipeTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	httpRequestRecipe, ok := typeValue.(*recipe.GenericHttpRequestRecipe)
+	require.True(t, ok)
+	require.Equal(t, "PUT", httpRequestRecipe.RequestType())
+
+	result, err := httpRequestRecipe.Execute(context.Background(), t.serviceNetwork, t.runtimeValueStore, testServiceName)
+	require.NoError(t, err)
+	require.Equal(t, starlark.MakeInt(201), result["code"])
+	require.Equal(t, starlark.String("1234"), result["extract.id"])
+	require.Equal(t, starlark.String("/items/1234"), result["extract.location"])
+	responseHeaders, ok := result["headers"].(*starlark.Dict)
+	require.True(t, ok)
+	location, found, err := responseHeaders.Get(starlark.String("Location"))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, starlark.String("/items/1234"), location)
+
+	returnValue, interpretationErr := httpRequestRecipe.CreateStarlarkReturnValue("result-fake-uuid")
+	require.Nil(t, interpretationErr)
+	expectedInterpretationResult := `{"body": "{{kurtosis:result-fake-uuid:body.runtime_value}}", "code": "{{kurtosis:result-fake-uuid:code.runtime_value}}", "headers": "{{kurtosis:result-fake-uuid:headers.runtime_value}}", "extract.id": "{{kurtosis:result-fake-uuid:extract.id.runtime_value}}", "extract.location": "{{kurtosis:result-fake-uuid:extract.location.runtime_value}}"}`
+	require.Equal(t, expectedInterpretationResult, returnValue.String())
+}
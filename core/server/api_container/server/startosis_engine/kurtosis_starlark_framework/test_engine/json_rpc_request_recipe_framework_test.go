@@ -0,0 +1,75 @@
+package test_engine
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	jsonRpcRequestRecipeRequestJson  = `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["latest",false]}`
+	jsonRpcRequestRecipeResponseJson = `{"jsonrpc": "2.0", "id": 1, "result": {"number": "0x1b4"}}`
+)
+
+type jsonRpcRequestRecipeTestCase struct {
+	*testing.T
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestJsonRpcRequestRecipe() {
+	suite.serviceNetwork.EXPECT().HttpRequestService(
+		mock.Anything,
+		string(testServiceName),
+		testPrivatePortId,
+		"POST",
+		"application/json",
+		"/rpc",
+		jsonRpcRequestRecipeRequestJson,
+		map[string]string{},
+	).Times(1).Return(
+		&http.Response{ // nolint: exhaustruct
+			Status:     "200 OK",
+			StatusCode: 200,
+			Body:       io.NopCloser(strings.NewReader(jsonRpcRequestRecipeResponseJson)),
+		},
+		nil,
+	)
+
+	suite.run(&jsonRpcRequestRecipeTestCase{
+		T:                 suite.T(),
+		serviceNetwork:    suite.serviceNetwork,
+		runtimeValueStore: suite.runtimeValueStore,
+	})
+}
+
+func (t *jsonRpcRequestRecipeTestCase) GetStarlarkCode() string {
+	extractors := `{"block": ".number"}`
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%s, %s=%q, %s=%s)", recipe.JsonRpcRequestRecipeTypeName, recipe.PortIdAttr, testPrivatePortId, recipe.MethodAttr, "eth_getBlockByNumber", recipe.ParamsAttr, `["latest", False]`, recipe.EndpointAttr, "/rpc", recipe.ExtractAttr, extractors)
+}
+
+func (t *jsonRpcRequestRecipeTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	jsonRpcRequestRecipe, ok := typeValue.(*recipe.JsonRpcRequestRecipe)
+	require.True(t, ok)
+
+	result, err := jsonRpcRequestRecipe.Execute(context.Background(), t.serviceNetwork, t.runtimeValueStore, testServiceName)
+	require.NoError(t, err)
+	require.Equal(t, starlark.MakeInt(200), result["code"])
+	require.Equal(t, starlark.MakeInt(0), result["error_code"])
+	require.Equal(t, starlark.String("0x1b4"), result["extract.block"])
+
+	returnValue, interpretationErr := jsonRpcRequestRecipe.CreateStarlarkReturnValue("result-fake-uuid")
+	require.Nil(t, interpretationErr)
+	expectedInterpretationResult := `{"body": "{{kurtosis:result-fake-uuid:body.runtime_value}}", "code": "{{kurtosis:result-fake-uuid:code.runtime_value}}", "error_code": "{{kurtosis:result-fake-uuid:error_code.runtime_value}}", "error_message": "{{kurtosis:result-fake-uuid:error_message.runtime_value}}", "extract.block": "{{kurtosis:result-fake-uuid:extract.block.runtime_value}}"}`
+	require.Equal(t, expectedInterpretationResult, returnValue.String())
+}
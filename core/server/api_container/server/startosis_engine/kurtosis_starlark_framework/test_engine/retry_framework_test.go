@@ -0,0 +1,41 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/retry"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type retryTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestRetry() {
+	suite.run(&retryTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *retryTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%d, %s=%q, %s=%s)", retry.RetryTypeName, retry.AttemptsAttr, 5, retry.BackoffAttr, "2s", retry.OnCodesAttr, "[1, 503]")
+}
+
+func (t *retryTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	retryValue, ok := typeValue.(*retry.Retry)
+	require.True(t, ok)
+
+	attempts, interpretationErr := retryValue.GetAttempts()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, 5, attempts)
+
+	backoff, interpretationErr := retryValue.GetBackoffOrDefault()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, 2*time.Second, backoff)
+
+	onCodes, interpretationErr := retryValue.GetOnCodes()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, []int64{1, 503}, onCodes)
+}
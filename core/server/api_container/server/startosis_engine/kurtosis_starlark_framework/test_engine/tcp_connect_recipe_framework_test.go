@@ -0,0 +1,80 @@
+package test_engine
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"net"
+	"testing"
+)
+
+type tcpConnectRecipeTestCase struct {
+	*testing.T
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestTcpConnectRecipe() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	suite.Require().NoError(err)
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buffer := make([]byte, 4)
+		if _, err = conn.Read(buffer); err == nil && string(buffer) == "PING" {
+			_, _ = conn.Write([]byte("+PONG"))
+		}
+	}()
+
+	listenerPort, err := port_spec.NewPortSpec(uint16(listener.Addr().(*net.TCPAddr).Port), port_spec.TransportProtocol_TCP, "", nil, "")
+	suite.Require().NoError(err)
+	suite.serviceNetwork.EXPECT().GetService(mock.Anything, string(testServiceName)).Times(1).Return(
+		service.NewService(
+			service.NewServiceRegistration(testServiceName, testServiceUuid, testEnclaveUuid, net.ParseIP("127.0.0.1"), string(testServiceName)),
+			map[string]*port_spec.PortSpec{testPrivatePortId: listenerPort},
+			nil,
+			nil,
+			nil,
+			container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil, 0),
+		),
+		nil,
+	)
+
+	suite.run(&tcpConnectRecipeTestCase{
+		T:                 suite.T(),
+		serviceNetwork:    suite.serviceNetwork,
+		runtimeValueStore: suite.runtimeValueStore,
+	})
+}
+
+func (t *tcpConnectRecipeTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%q)", recipe.TcpConnectRecipeTypeName, recipe.PortIdAttr, testPrivatePortId, recipe.PayloadAttr, "PING", recipe.TimeoutAttr, "2s")
+}
+
+func (t *tcpConnectRecipeTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	tcpConnectRecipe, ok := typeValue.(*recipe.TcpConnectRecipe)
+	require.True(t, ok)
+
+	result, err := tcpConnectRecipe.Execute(context.Background(), t.serviceNetwork, t.runtimeValueStore, testServiceName)
+	require.NoError(t, err)
+	require.Equal(t, starlark.MakeInt(0), result["code"])
+	require.Equal(t, starlark.String("+PONG"), result["body"])
+
+	returnValue, interpretationErr := tcpConnectRecipe.CreateStarlarkReturnValue("result-fake-uuid")
+	require.Nil(t, interpretationErr)
+	expectedInterpretationResult := `{"body": "{{kurtosis:result-fake-uuid:body.runtime_value}}", "code": "{{kurtosis:result-fake-uuid:code.runtime_value}}"}`
+	require.Equal(t, expectedInterpretationResult, returnValue.String())
+}
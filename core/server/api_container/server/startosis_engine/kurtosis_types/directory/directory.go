@@ -1,6 +1,7 @@
 package directory
 
 import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
@@ -17,6 +18,8 @@ const (
 	ArtifactNamesAttr = "artifact_names"
 	PersistentKeyAttr = "persistent_key"
 	SizeKeyAttr       = "size"
+	StorageClassAttr  = "storage_class"
+	AccessModeAttr    = "access_mode"
 
 	atleastOneMegabyte       = 1
 	megaByteToByteMultiplier = 1024 * 1024
@@ -52,6 +55,22 @@ func NewDirectoryType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 						return builtin_argument.Int64InRange(value, SizeKeyAttr, atleastOneMegabyte, math.MaxInt64)
 					},
 				},
+				{
+					Name:              StorageClassAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, StorageClassAttr)
+					},
+				},
+				{
+					Name:              AccessModeAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringValues(value, AccessModeAttr, getAllAccessModeStrs())
+					},
+				},
 			},
 		},
 
@@ -82,6 +101,8 @@ func CreateDirectoryFromFilesArtifact(
 		starlark.String(filesArtifactName),
 		nil,
 		nil,
+		nil,
+		nil,
 	}
 
 	argumentDefinitions := NewDirectoryType().KurtosisBaseBuiltin.Arguments
@@ -162,3 +183,35 @@ func (directory *Directory) GetSizeOrDefault() (int64, *startosis_errors.Interpr
 	}
 	return sizeInt64 * megaByteToByteMultiplier, nil
 }
+
+func (directory *Directory) GetStorageClassOrDefault() (string, *startosis_errors.InterpretationError) {
+	storageClass, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		directory.KurtosisValueTypeDefault, StorageClassAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return service_directory.DefaultStorageClass, nil
+	}
+	return storageClass.GoString(), nil
+}
+
+func (directory *Directory) GetAccessModeOrDefault() (service_directory.DirectoryAccessMode, *startosis_errors.InterpretationError) {
+	accessMode, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		directory.KurtosisValueTypeDefault, AccessModeAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return service_directory.DefaultAccessMode, nil
+	}
+	return service_directory.DirectoryAccessMode(accessMode.GoString()), nil
+}
+
+func getAllAccessModeStrs() []string {
+	accessModeStrs := []string{}
+	for _, accessMode := range service_directory.AllAccessModes {
+		accessModeStrs = append(accessModeStrs, string(accessMode))
+	}
+	return accessModeStrs
+}
@@ -0,0 +1,187 @@
+package retry
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"reflect"
+	"time"
+)
+
+const (
+	RetryTypeName = "Retry"
+
+	AttemptsAttr = "attempts"
+	BackoffAttr  = "backoff"
+	OnCodesAttr  = "on_codes"
+
+	minAttempts = 1
+	maxAttempts = 100
+
+	DefaultBackoff = 1 * time.Second
+	// MaxBackoff caps the doubling wait, as up to maxAttempts attempts can be made
+	MaxBackoff = 1 * time.Minute
+
+	// the codes are carried by the stacktrace errors, whose codes are uint16 with the max value meaning no code
+	minCode = 0
+	maxCode = int64(stacktrace.NoCode) - 1
+)
+
+func NewRetryType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: RetryTypeName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              AttemptsAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Int64InRange(value, AttemptsAttr, minAttempts, maxAttempts)
+					},
+				},
+				{
+					Name:              BackoffAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, BackoffAttr)
+					},
+				},
+				{
+					Name:              OnCodesAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         validateOnCodes,
+				},
+			},
+		},
+
+		Instantiate: instantiate,
+	}
+}
+
+func instantiate(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(RetryTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &Retry{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// Retry tells how many times a plan instruction is attempted before its failure fails the run, how long to wait
+// between two attempts, and optionally which error codes are worth another attempt
+type Retry struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (retry *Retry) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := retry.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &Retry{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (retry *Retry) GetAttempts() (int, *startosis_errors.InterpretationError) {
+	attempts, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](
+		retry.KurtosisValueTypeDefault, AttemptsAttr)
+	if interpretationErr != nil {
+		return 0, interpretationErr
+	}
+	if !found {
+		return 0, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", AttemptsAttr, RetryTypeName)
+	}
+	attemptsInt64, ok := attempts.Int64()
+	if !ok {
+		return 0, startosis_errors.NewInterpretationError("Couldn't convert attempts '%v' to int64", attempts)
+	}
+	return int(attemptsInt64), nil
+}
+
+// GetBackoffOrDefault returns the time waited after the first failed attempt. It doubles after each failed attempt,
+// up to MaxBackoff
+func (retry *Retry) GetBackoffOrDefault() (time.Duration, *startosis_errors.InterpretationError) {
+	backoff, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		retry.KurtosisValueTypeDefault, BackoffAttr)
+	if interpretationErr != nil {
+		return 0, interpretationErr
+	}
+	if !found || backoff.GoString() == "" {
+		return DefaultBackoff, nil
+	}
+	backoffDuration, err := time.ParseDuration(backoff.GoString())
+	if err != nil {
+		return 0, startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing backoff '%v' of type '%s'", backoff.GoString(), RetryTypeName)
+	}
+	return backoffDuration, nil
+}
+
+// GetOnCodes returns the codes that are worth another attempt. An empty slice means any failure is
+func (retry *Retry) GetOnCodes() ([]int64, *startosis_errors.InterpretationError) {
+	onCodes, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](
+		retry.KurtosisValueTypeDefault, OnCodesAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return []int64{}, nil
+	}
+	codes, err := kurtosis_types.SafeCastToIntegerSlice(onCodes)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing the '%s' attribute of type '%s'", OnCodesAttr, RetryTypeName)
+	}
+	return codes, nil
+}
+
+// NewErrorWithResultCode returns an error carrying the code of a recipe result, e.g. the exit code of an exec or the
+// status code of a request, such that a Retry limited to some codes can tell whether the failure is worth another
+// attempt. The code is kept by the errors propagating this one
+func NewErrorWithResultCode(resultCode starlark.Value, msg string, args ...interface{}) error {
+	code := stacktrace.NoCode
+	if resultCodeInt, ok := resultCode.(starlark.Int); ok {
+		if resultCodeInt64, ok := resultCodeInt.Int64(); ok && resultCodeInt64 >= minCode && resultCodeInt64 <= maxCode {
+			code = stacktrace.ErrorCode(resultCodeInt64)
+		}
+	}
+	return stacktrace.NewErrorWithCode(code, msg, args...)
+}
+
+// IsRetryableCode returns whether an error is worth another attempt given the codes of the Retry
+func IsRetryableCode(err error, onCodes []int64) bool {
+	if len(onCodes) == 0 {
+		return true
+	}
+	code := stacktrace.GetCode(err)
+	if code == stacktrace.NoCode {
+		return false
+	}
+	for _, onCode := range onCodes {
+		if int64(code) == onCode {
+			return true
+		}
+	}
+	return false
+}
+
+func validateOnCodes(value starlark.Value) *startosis_errors.InterpretationError {
+	codes, ok := value.(*starlark.List)
+	if !ok {
+		return startosis_errors.NewInterpretationError("The '%s' attribute is not a list (was '%s').", OnCodesAttr, reflect.TypeOf(value))
+	}
+	for idx := 0; idx < codes.Len(); idx++ {
+		if interpretationErr := builtin_argument.Int64InRange(codes.Index(idx), OnCodesAttr, minCode, maxCode); interpretationErr != nil {
+			return interpretationErr
+		}
+	}
+	return nil
+}
@@ -106,13 +106,13 @@ func (readyCondition *ReadyCondition) Copy() (builtin_argument.KurtosisValueType
 
 func (readyCondition *ReadyCondition) GetRecipe() (recipe.Recipe, *startosis_errors.InterpretationError) {
 	//TODO we should rework the recipe types to inherit a single common type, this will avoid the double parsing here.
-	httpRecipe, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[recipe.HttpRequestRecipe](readyCondition.KurtosisValueTypeDefault, RecipeAttr)
+	portRequestRecipe, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[recipe.PortRequestRecipe](readyCondition.KurtosisValueTypeDefault, RecipeAttr)
 	if !found {
 		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'",
 			RecipeAttr, ReadyConditionTypeName)
 	}
 	if interpretationErr == nil {
-		return httpRecipe, nil
+		return portRequestRecipe, nil
 	}
 	execRecipe, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*recipe.ExecRecipe](readyCondition.KurtosisValueTypeDefault, RecipeAttr)
 	if interpretationErr == nil {
@@ -199,7 +199,7 @@ func (readyCondition *ReadyCondition) GetTimeout() (time.Duration, *startosis_er
 }
 
 func validateRecipe(value starlark.Value) *startosis_errors.InterpretationError {
-	_, ok := value.(recipe.HttpRequestRecipe)
+	_, ok := value.(recipe.PortRequestRecipe)
 	if !ok {
 		//TODO we should rework the recipe types to inherit a single common type, this will avoid the double parsing here.
 		_, ok := value.(*recipe.ExecRecipe)
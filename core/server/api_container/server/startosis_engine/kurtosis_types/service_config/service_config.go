@@ -481,18 +481,10 @@ func (config *ServiceConfig) ToKurtosisType(
 		return nil, interpretationErr
 	}
 	if found {
-		uid, interpretationErr := user.GetUID()
+		serviceUser, interpretationErr = ConvertUser(user)
 		if interpretationErr != nil {
 			return nil, interpretationErr
 		}
-		serviceUser = service_user.NewServiceUser(service_user.UID(uid))
-		gid, gidFound, interpretationErr := user.GetGIDIfSet()
-		if interpretationErr != nil {
-			return nil, interpretationErr
-		}
-		if gidFound {
-			serviceUser.SetGID(service_user.GID(gid))
-		}
 	}
 
 	var tolerations []v1.Toleration
@@ -501,7 +493,7 @@ func (config *ServiceConfig) ToKurtosisType(
 		return nil, interpretationErr
 	}
 	if found {
-		tolerations, interpretationErr = convertTolerations(tolerationsStarlarkList)
+		tolerations, interpretationErr = ConvertTolerations(tolerationsStarlarkList)
 		if interpretationErr != nil {
 			return nil, interpretationErr
 		}
@@ -612,6 +604,7 @@ func ConvertFilesArtifactsMounts(filesArtifactsMountDirpathsMap map[string][]str
 		apiContainerInfo.GetIpAddress().String(),
 		apiContainerInfo.GetGrpcPortNum(),
 		filesArtifactsExpansions,
+		apiContainerInfo.GetAuthToken(),
 	)
 	if err != nil {
 		return nil, startosis_errors.NewInterpretationError("An error occurred creating files artifacts expander args")
@@ -693,11 +686,23 @@ func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict)
 		if interpretationErr != nil {
 			return nil, nil, interpretationErr
 		}
+		persistentDirectoryStorageClass, interpretationErr := directoryObj.GetStorageClassOrDefault()
+		if interpretationErr != nil {
+			return nil, nil, interpretationErr
+		}
+		persistentDirectoryAccessMode, interpretationErr := directoryObj.GetAccessModeOrDefault()
+		if interpretationErr != nil {
+			return nil, nil, interpretationErr
+		}
 		if artifactNameSet == persistentKeySet {
 			// this condition is a XOR
 			return nil, nil, startosis_errors.NewInterpretationError("Parameter '%s' and '%s' cannot be set on the same '%s' object: '%s'",
 				directory.ArtifactNamesAttr, directory.PersistentKeyAttr, directory.DirectoryTypeName, directoryObj.String())
 		}
+		if artifactNameSet && (persistentDirectoryStorageClass != service_directory.DefaultStorageClass || persistentDirectoryAccessMode != service_directory.DefaultAccessMode) {
+			return nil, nil, startosis_errors.NewInterpretationError("Parameters '%s' and '%s' can only be set on a '%s' object with a '%s': '%s'",
+				directory.StorageClassAttr, directory.AccessModeAttr, directory.DirectoryTypeName, directory.PersistentKeyAttr, directoryObj.String())
+		}
 		if artifactNameSet {
 			filesArtifacts[dirPath.GoString()] = artifactNames
 		} else {
@@ -705,6 +710,8 @@ func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict)
 			persistentDirectories[dirPath.GoString()] = service_directory.PersistentDirectory{
 				PersistentKey: service_directory.DirectoryPersistentKey(persistentKey),
 				Size:          service_directory.DirectoryPersistentSize(persistentDirectorySize),
+				StorageClass:  persistentDirectoryStorageClass,
+				AccessMode:    persistentDirectoryAccessMode,
 			}
 		}
 	}
@@ -752,7 +759,25 @@ func ConvertImage(
 	}
 }
 
-func convertTolerations(tolerationsList *starlark.List) ([]v1.Toleration, *startosis_errors.InterpretationError) {
+// ConvertUser converts the User Starlark value to the user the container runs as
+func ConvertUser(user *User) (*service_user.ServiceUser, *startosis_errors.InterpretationError) {
+	uid, interpretationErr := user.GetUID()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	serviceUser := service_user.NewServiceUser(service_user.UID(uid))
+	gid, gidFound, interpretationErr := user.GetGIDIfSet()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if gidFound {
+		serviceUser.SetGID(service_user.GID(gid))
+	}
+	return serviceUser, nil
+}
+
+// ConvertTolerations converts the list of Toleration Starlark values to their Kubernetes type
+func ConvertTolerations(tolerationsList *starlark.List) ([]v1.Toleration, *startosis_errors.InterpretationError) {
 	var outputValue []v1.Toleration
 	iterator := tolerationsList.Iterate()
 	defer iterator.Done()
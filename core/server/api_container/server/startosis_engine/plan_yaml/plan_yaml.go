@@ -3,9 +3,10 @@ package plan_yaml
 import "sort"
 
 const (
-	shell  TaskType = "sh"
-	python TaskType = "python"
-	exec   TaskType = "exec"
+	shell     TaskType = "sh"
+	python    TaskType = "python"
+	exec      TaskType = "exec"
+	container TaskType = "container"
 )
 
 type privatePlanYaml struct {
@@ -108,5 +109,5 @@ type Task struct {
 	AcceptableCodes []int64 `yaml:"acceptableCodes,omitempty"`
 }
 
-// TaskType represents the type of task (either python or shell)
+// TaskType represents the type of task (python, shell, exec or container)
 type TaskType string
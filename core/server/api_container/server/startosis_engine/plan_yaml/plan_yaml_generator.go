@@ -20,6 +20,8 @@ const (
 	codeFutureRefType      = "code"
 	hostnameFutureRefType  = "hostname"
 	outputFutureRefType    = "output"
+	stdoutFutureRefType    = "stdout"
+	stderrFutureRefType    = "stderr"
 )
 
 // PlanYaml is a yaml representation of the effect of an Instructions Plan or sequence of instructions on the state of the Enclave.
@@ -205,6 +207,73 @@ func (planYaml *PlanYaml) AddRunSh(
 	return nil
 }
 
+func (planYaml *PlanYaml) AddRunContainer(
+	entrypoint []string,
+	cmd []string,
+	description string,
+	returnValue *starlarkstruct.Struct,
+	serviceConfig *service.ServiceConfig,
+	storeSpecList []*store_spec2.StoreSpec,
+) error {
+	uuid := planYaml.generateUuid()
+
+	// store run container future references
+	for _, futureRefType := range []string{codeFutureRefType, outputFutureRefType, stdoutFutureRefType, stderrFutureRefType} {
+		futureRefVal, err := returnValue.Attr(futureRefType)
+		if err != nil {
+			return err
+		}
+		futureRef, interpErr := kurtosis_types.SafeCastToString(futureRefVal, "run container "+futureRefType)
+		if interpErr != nil {
+			return interpErr
+		}
+		planYaml.storeFutureReference(uuid, futureRef, futureRefType)
+	}
+
+	// create task yaml object
+	taskYaml := &Task{} //nolint exhaustruct
+	taskYaml.Name = description
+	taskYaml.Uuid = uuid
+	taskYaml.TaskType = container
+
+	// the argv is kept as is, as no shell is involved in running it
+	for _, arg := range append(append([]string{}, entrypoint...), cmd...) {
+		taskYaml.RunCmd = append(taskYaml.RunCmd, planYaml.swapFutureReference(arg))
+	}
+	taskYaml.Image = serviceConfig.GetContainerImageName()
+
+	var envVars []*EnvironmentVariable
+	for key, val := range serviceConfig.GetEnvVars() {
+		envVars = append(envVars, &EnvironmentVariable{
+			Key:   key,
+			Value: planYaml.swapFutureReference(val),
+		})
+	}
+	taskYaml.EnvVars = envVars
+
+	taskYaml.Files = planYaml.getFileMountsFromFilesArtifacts(serviceConfig.GetFilesArtifactsExpansion())
+
+	var store []*FilesArtifact
+	for _, storeSpec := range storeSpecList {
+		var newFilesArtifactFromStoreSpec = &FilesArtifact{
+			Uuid:  planYaml.generateUuid(),
+			Name:  storeSpec.GetName(),
+			Files: []string{storeSpec.GetSrc()},
+		}
+		planYaml.addFilesArtifactYaml(newFilesArtifactFromStoreSpec)
+
+		store = append(store, &FilesArtifact{
+			Uuid:  newFilesArtifactFromStoreSpec.Uuid,
+			Name:  newFilesArtifactFromStoreSpec.Name,
+			Files: []string{}, // don't want to repeat the files on a referenced files artifact
+		})
+	}
+	taskYaml.Store = store
+
+	planYaml.addTaskYaml(taskYaml)
+	return nil
+}
+
 func (planYaml *PlanYaml) AddRunPython(
 	runCommand string,
 	description string,
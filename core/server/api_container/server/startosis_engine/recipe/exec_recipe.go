@@ -138,7 +138,7 @@ func (recipe *ExecRecipe) Execute(
 		execOutputKey:   starlark.String(commandOutput),
 		execExitCodeKey: starlark.MakeInt(int(execResult.GetExitCode())),
 	}
-	extractDict, err := runExtractors([]byte(fmt.Sprintf("%q", commandOutput)), extractors)
+	extractDict, err := runExtractorsOnText(commandOutput, extractors)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while running extractors '%v' on command output '%v'", extractors, commandOutput)
 	}
@@ -12,17 +12,40 @@ import (
 const extractKeyPrefix = "extract"
 
 func extract(input []byte, query string) (starlark.Comparable, error) {
-	logrus.Debugf("Running extractor against query '%v' and input '%v'", string(input), query)
+	return extractWithVariables(input, query, nil)
+}
+
+// extractWithVariables runs the query with the given variables bound, e.g. '$headers'. As the query may only read the
+// variables, an input that isn't JSON is then passed to the query as null rather than failing the extraction
+func extractWithVariables(input []byte, query string, variables map[string]any) (starlark.Comparable, error) {
+	var jsonBody interface{}
+	if err := json.Unmarshal(input, &jsonBody); err != nil {
+		if len(variables) == 0 {
+			return nil, stacktrace.Propagate(err, "An error occurred when parsing JSON response body:\n'%v'", string(input))
+		}
+		jsonBody = nil
+	}
+	return runJqQuery(jsonBody, string(input), query, variables)
+}
+
+// runJqQuery runs the query against the already parsed input; the raw input is only used in messages
+func runJqQuery(parsedInput any, rawInput string, query string, variables map[string]any) (starlark.Comparable, error) {
+	logrus.Debugf("Running extractor against query '%v' and input '%v'", query, rawInput)
 	jqQuery, err := gojq.Parse(query)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred when parsing field extractor '%v'", query)
 	}
-	var jsonBody interface{}
-	err = json.Unmarshal(input, &jsonBody)
+	variableNames := make([]string, 0, len(variables))
+	variableValues := make([]any, 0, len(variables))
+	for variableName, variableValue := range variables {
+		variableNames = append(variableNames, variableName)
+		variableValues = append(variableValues, variableValue)
+	}
+	jqCode, err := gojq.Compile(jqQuery, gojq.WithVariables(variableNames))
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred when parsing JSON response body:\n'%v'", string(input))
+		return nil, stacktrace.Propagate(err, "An error occurred when compiling field extractor '%v'", query)
 	}
-	matchIterator := jqQuery.Run(jsonBody)
+	matchIterator := jqCode.Run(parsedInput, variableValues...)
 	parsedMatchList := []starlark.Value{}
 	for {
 		matchValue, ok := matchIterator.Next()
@@ -41,12 +64,17 @@ func extract(input []byte, query string) (starlark.Comparable, error) {
 		}
 	}
 	if len(parsedMatchList) == 0 {
-		return nil, stacktrace.NewError("No field '%v' was found on input '%v'", query, string(input))
+		return nil, stacktrace.NewError("No field '%v' was found on input '%v'", query, rawInput)
 	}
-	if len(parsedMatchList) == 1 {
-		return parsedMatchList[0].(starlark.Comparable), nil
+	return toExtractedValue(parsedMatchList), nil
+}
+
+// toExtractedValue returns the only match as is, and several matches as a list
+func toExtractedValue(matches []starlark.Value) starlark.Comparable {
+	if len(matches) == 1 {
+		return matches[0].(starlark.Comparable)
 	}
-	return starlark.NewList(parsedMatchList), nil
+	return starlark.NewList(matches)
 }
 
 func parseJsonValueToStarlark(value any) starlark.Value {
@@ -83,9 +111,30 @@ func parseJsonValueToStarlark(value any) starlark.Value {
 // runExtractors takes in `input` and a map of `extractors`. Each entry of extractors has an `id` and a `query` string.
 // For each extractor, we run `query` against `input`, returning a map with key being extract.id and the Starlark result.
 func runExtractors(input []byte, extractors map[string]string) (map[string]starlark.Comparable, error) {
+	return runExtractorsWithVariables(input, extractors, nil)
+}
+
+// runExtractorsWithVariables is runExtractors with the given variables bound in the queries
+func runExtractorsWithVariables(input []byte, extractors map[string]string, variables map[string]any) (map[string]starlark.Comparable, error) {
+	return runExtractorsInternal(input, input, extractors, variables)
+}
+
+// runExtractorsOnText runs the extractors against a plain text input. The jq extractors read it as a JSON string
+func runExtractorsOnText(input string, extractors map[string]string) (map[string]starlark.Comparable, error) {
+	return runExtractorsInternal([]byte(input), []byte(fmt.Sprintf("%q", input)), extractors, nil)
+}
+
+// runExtractorsInternal passes the raw input to the regex, YAML and lines extractors, and the JSON input to the jq ones
+func runExtractorsInternal(rawInput []byte, jsonInput []byte, extractors map[string]string, variables map[string]any) (map[string]starlark.Comparable, error) {
 	extractResult := map[string]starlark.Comparable{}
 	for extractorName, query := range extractors {
-		extractedValue, err := extract(input, query)
+		var extractedValue starlark.Comparable
+		var err error
+		if extractorType, extractorQuery, isTextExtractor := parseTextExtractor(query); isTextExtractor {
+			extractedValue, err = runTextExtractor(rawInput, extractorType, extractorQuery, variables)
+		} else {
+			extractedValue, err = extractWithVariables(jsonInput, query, variables)
+		}
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred running extractor '%v' on recipe", query)
 		}
@@ -123,3 +123,24 @@ func TestRunExtractors_FailureQueryInvalid(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Nil(t, result)
 }
+
+func TestExtractor_WithVariables(t *testing.T) {
+	variables := map[string]any{
+		"$headers": map[string]any{"Content-Type": "application/json"},
+	}
+	result, extractErr := extractWithVariables(jsonObject, `$headers["Content-Type"]`, variables)
+	assert.Nil(t, extractErr)
+	assert.Equal(t, starlark.String("application/json"), result)
+
+	result, extractErr = extractWithVariables(jsonObject, ".str", variables)
+	assert.Nil(t, extractErr)
+	assert.Equal(t, starlark.String("a"), result)
+
+	// A body that isn't JSON doesn't prevent reading the variables
+	result, extractErr = extractWithVariables([]byte(""), `$headers["Content-Type"]`, variables)
+	assert.Nil(t, extractErr)
+	assert.Equal(t, starlark.String("application/json"), result)
+
+	_, extractErr = extractWithVariables([]byte(""), ".str", variables)
+	assert.NotNil(t, extractErr)
+}
@@ -0,0 +1,416 @@
+package recipe
+
+import (
+	"context"
+	"encoding/pem"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+	"golang.org/x/exp/maps"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	HttpRequestRecipeTypeName = "HttpRequestRecipe"
+
+	TlsAttr             = "tls"
+	CaAttr              = "ca"
+	ClientCertAttr      = "client_cert"
+	FollowRedirectsAttr = "follow_redirects"
+
+	responseHeadersKey = "headers"
+	// The name extractors read the response headers through, e.g. '$headers["Content-Type"]'
+	responseHeadersExtractorVariable = "$headers"
+
+	defaultHttpMethod           = http.MethodGet
+	defaultShouldFollowRedirect = true
+
+	pemCertificateBlockType   = "CERTIFICATE"
+	pemPrivateKeyBlockSuffix  = "PRIVATE KEY"
+	responseHeaderValueJoiner = ", "
+)
+
+var httpMethodRegex = regexp.MustCompile(`^[A-Z]+(-[A-Z]+)*$`)
+
+func NewGenericHttpRequestRecipeType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: HttpRequestRecipeTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PortIdAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, PortIdAttr)
+					},
+				},
+				{
+					Name:              EndpointAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, EndpointAttr)
+					},
+				},
+				{
+					Name:              MethodAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         validateHttpMethod,
+				},
+				{
+					Name:              RequestBodyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              ContentTypeAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              HeadersAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, interpretationErr := convertHeadersToMapStringString(true, value)
+						return interpretationErr
+					},
+				},
+				{
+					Name:              ExtractAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, interpretationErr := convertExtractorsToDict(true, value)
+						return interpretationErr
+					},
+				},
+				{
+					Name:              TlsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator:         nil,
+				},
+				{
+					Name:              CaAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, CaAttr)
+					},
+				},
+				{
+					Name:              ClientCertAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ClientCertAttr)
+					},
+				},
+				{
+					Name:              FollowRedirectsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator:         nil,
+				},
+			},
+		},
+		Instantiate: instantiateGenericHttpRequestRecipe,
+	}
+}
+
+func instantiateGenericHttpRequestRecipe(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(HttpRequestRecipeTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &GenericHttpRequestRecipe{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// GenericHttpRequestRecipe is the HttpRequestRecipe Starlark type: an HTTP request with any method, optionally over
+// TLS or mutual TLS. On top of the code and the body, its result holds the response headers, which its extractors can
+// read through the '$headers' variable
+type GenericHttpRequestRecipe struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (recipe *GenericHttpRequestRecipe) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := recipe.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &GenericHttpRequestRecipe{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (recipe *GenericHttpRequestRecipe) Execute(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+) (map[string]starlark.Comparable, error) {
+	logrus.Debugf("Running HTTP request recipe '%s'", recipe.String())
+
+	portId, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, PortIdAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Mandatory attribute '%s' was not set on '%s'. This is unexpected and should have been caught earlier", PortIdAttr, HttpRequestRecipeTypeName)
+	}
+
+	endpoint, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, EndpointAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Mandatory attribute '%s' was not set on '%s'. This is unexpected and should have been caught earlier", EndpointAttr, HttpRequestRecipeTypeName)
+	}
+	endpointWithRuntimeValues, err := magic_string_helper.ReplaceRuntimeValueInString(endpoint.GoString(), runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while replacing runtime values in the endpoint of the HTTP recipe")
+	}
+
+	requestBody, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, RequestBodyAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	requestBodyWithRuntimeValues, err := magic_string_helper.ReplaceRuntimeValueInString(requestBody.GoString(), runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while replacing runtime values in the body of the HTTP recipe")
+	}
+
+	contentType, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, ContentTypeAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	rawHeaders, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](recipe.KurtosisValueTypeDefault, HeadersAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	headers, interpretationErr := convertHeadersToMapStringString(found, rawHeaders)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	extractors, interpretationErr := recipe.getExtractors()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	options, err := recipe.getHttpRequestOptions(serviceNetwork)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the TLS and redirect options of HTTP request recipe '%v'", recipe.String())
+	}
+
+	if serviceName == "" {
+		return nil, stacktrace.NewError("The service name parameter can't be an empty string")
+	}
+	response, err := serviceNetwork.HttpRequestServiceWithOptions(ctx, string(serviceName), portId.GoString(), recipe.getMethod(), contentType.GoString(), endpointWithRuntimeValues, requestBodyWithRuntimeValues, headers, options)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred when running HTTP request recipe '%v'", recipe.String())
+	}
+	defer func() {
+		if err := response.Body.Close(); err != nil {
+			logrus.Errorf("An error occurred when closing response body: %v", err)
+		}
+	}()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while reading HTTP response body")
+	}
+	logrus.Debugf("Got response '%v'", string(responseBody))
+
+	responseHeaders := map[string]any{}
+	responseHeadersDict := starlark.NewDict(len(response.Header))
+	for headerName, headerValues := range response.Header {
+		headerValue := strings.Join(headerValues, responseHeaderValueJoiner)
+		responseHeaders[headerName] = headerValue
+		if err = responseHeadersDict.SetKey(starlark.String(headerName), starlark.String(headerValue)); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred storing response header '%v'", headerName)
+		}
+	}
+
+	resultDict := map[string]starlark.Comparable{
+		bodyKey:            starlark.String(responseBody),
+		statusCodeKey:      starlark.MakeInt(response.StatusCode),
+		responseHeadersKey: responseHeadersDict,
+	}
+	extractDict, err := runExtractorsWithVariables(responseBody, extractors, map[string]any{
+		responseHeadersExtractorVariable: responseHeaders,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while running extractors from HTTP recipe")
+	}
+	maps.Copy(resultDict, extractDict)
+	return resultDict, nil
+}
+
+func (recipe *GenericHttpRequestRecipe) ResultMapToString(resultMap map[string]starlark.Comparable) string {
+	return resultMapToStringInternal(resultMap)
+}
+
+func (recipe *GenericHttpRequestRecipe) CreateStarlarkReturnValue(resultUuid string) (*starlark.Dict, *startosis_errors.InterpretationError) {
+	extractors, interpretationErr := recipe.getExtractors()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return createRecipeStarlarkReturnValue(resultUuid, []string{bodyKey, statusCodeKey, responseHeadersKey}, extractors)
+}
+
+func (recipe *GenericHttpRequestRecipe) RequestType() string {
+	return recipe.getMethod()
+}
+
+func (recipe *GenericHttpRequestRecipe) GetDefaultAcceptableCodes() []int64 {
+	return httpDefaultAcceptableCodes
+}
+
+func (recipe *GenericHttpRequestRecipe) getFilesArtifactNames() ([]string, *startosis_errors.InterpretationError) {
+	filesArtifactNames := []string{}
+	for _, filesArtifactAttr := range []string{CaAttr, ClientCertAttr} {
+		filesArtifactName, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, filesArtifactAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		if found {
+			filesArtifactNames = append(filesArtifactNames, filesArtifactName.GoString())
+		}
+	}
+	return filesArtifactNames, nil
+}
+
+func (recipe *GenericHttpRequestRecipe) getMethod() string {
+	method, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, MethodAttr)
+	if interpretationErr != nil || !found {
+		return defaultHttpMethod
+	}
+	return method.GoString()
+}
+
+func (recipe *GenericHttpRequestRecipe) getExtractors() (map[string]string, *startosis_errors.InterpretationError) {
+	rawExtractors, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](recipe.KurtosisValueTypeDefault, ExtractAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return convertExtractorsToDict(found, rawExtractors)
+}
+
+// getHttpRequestOptions loads the certificates out of the files artifacts. Setting one of them implies TLS
+func (recipe *GenericHttpRequestRecipe) getHttpRequestOptions(serviceNetwork service_network.ServiceNetwork) (*service_network.HttpRequestOptions, error) {
+	options := service_network.NewDefaultHttpRequestOptions()
+
+	useTls, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Bool](recipe.KurtosisValueTypeDefault, TlsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	options.UseTls = found && bool(useTls)
+
+	followRedirects, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Bool](recipe.KurtosisValueTypeDefault, FollowRedirectsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	options.FollowRedirects = defaultShouldFollowRedirect
+	if found {
+		options.FollowRedirects = bool(followRedirects)
+	}
+
+	caFilesArtifactName, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, CaAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		caCertificatesPem, _, err := getPemBlocksFromFilesArtifact(serviceNetwork, caFilesArtifactName.GoString())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the CA certificates out of files artifact '%v'", caFilesArtifactName)
+		}
+		if len(caCertificatesPem) == 0 {
+			return nil, stacktrace.NewError("Files artifact '%v' passed as '%v' holds no PEM certificate", caFilesArtifactName, CaAttr)
+		}
+		options.UseTls = true
+		options.CaCertificatesPem = caCertificatesPem
+	}
+
+	clientCertFilesArtifactName, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, ClientCertAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		clientCertificatePem, clientKeyPem, err := getPemBlocksFromFilesArtifact(serviceNetwork, clientCertFilesArtifactName.GoString())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the client certificate out of files artifact '%v'", clientCertFilesArtifactName)
+		}
+		if len(clientCertificatePem) == 0 || len(clientKeyPem) == 0 {
+			return nil, stacktrace.NewError("Files artifact '%v' passed as '%v' must hold both a PEM certificate and a PEM private key", clientCertFilesArtifactName, ClientCertAttr)
+		}
+		options.UseTls = true
+		options.ClientCertificatePem = clientCertificatePem
+		options.ClientKeyPem = clientKeyPem
+	}
+	return options, nil
+}
+
+// getPemBlocksFromFilesArtifact returns the PEM certificates and the PEM private keys found in the files of the
+// artifact, whatever the files are named
+func getPemBlocksFromFilesArtifact(serviceNetwork service_network.ServiceNetwork, filesArtifactName string) ([]byte, []byte, error) {
+	filesArtifactContents, err := serviceNetwork.GetFilesArtifactContents(filesArtifactName)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the contents of files artifact '%v'", filesArtifactName)
+	}
+	fileNames := maps.Keys(filesArtifactContents)
+	sort.Strings(fileNames)
+
+	certificatesPem := []byte{}
+	privateKeysPem := []byte{}
+	for _, fileName := range fileNames {
+		rest := filesArtifactContents[fileName]
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if block.Type == pemCertificateBlockType {
+				certificatesPem = append(certificatesPem, pem.EncodeToMemory(block)...)
+			} else if strings.HasSuffix(block.Type, pemPrivateKeyBlockSuffix) {
+				privateKeysPem = append(privateKeysPem, pem.EncodeToMemory(block)...)
+			}
+		}
+	}
+	return certificatesPem, privateKeysPem, nil
+}
+
+func validateHttpMethod(value starlark.Value) *startosis_errors.InterpretationError {
+	method, ok := value.(starlark.String)
+	if !ok {
+		return startosis_errors.NewInterpretationError("Attribute '%s' on '%s' is expected to be a string, got '%s'", MethodAttr, HttpRequestRecipeTypeName, value.Type())
+	}
+	if !httpMethodRegex.MatchString(method.GoString()) {
+		return startosis_errors.NewInterpretationError("Attribute '%s' on '%s' is expected to be an uppercase HTTP method like 'PUT' or 'DELETE', got '%s'", MethodAttr, HttpRequestRecipeTypeName, method.GoString())
+	}
+	return nil
+}
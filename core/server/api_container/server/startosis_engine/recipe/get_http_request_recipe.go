@@ -186,3 +186,7 @@ func (recipe *GetHttpRequestRecipe) CreateStarlarkReturnValue(resultUuid string)
 func (recipe *GetHttpRequestRecipe) RequestType() string {
 	return getMethod
 }
+
+func (recipe *GetHttpRequestRecipe) GetDefaultAcceptableCodes() []int64 {
+	return httpDefaultAcceptableCodes
+}
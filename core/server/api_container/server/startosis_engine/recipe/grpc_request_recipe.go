@@ -0,0 +1,474 @@
+package recipe
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/protocompile"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+	"golang.org/x/exp/maps"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"sort"
+	"strings"
+)
+
+const (
+	GrpcRequestRecipeTypeName = "GrpcRequestRecipe"
+
+	ProtoFilesAttr = "proto_files"
+
+	grpcRequestType          = "GRPC"
+	grpcMethodSeparator      = "/"
+	defaultGrpcRequestBody   = "{}"
+	protoFileExtension       = ".proto"
+	grpcStatusMessageKey     = "message"
+	grpcOkStatusCode         = int64(codes.OK)
+	grpcFullMethodPathFormat = "/%s/%s"
+)
+
+var grpcDefaultAcceptableCodes = []int64{
+	grpcOkStatusCode,
+}
+
+func NewGrpcRequestRecipeType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: GrpcRequestRecipeTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PortIdAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, PortIdAttr)
+					},
+				},
+				{
+					Name:              MethodAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         validateGrpcMethod,
+				},
+				{
+					Name:              RequestBodyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              ProtoFilesAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ProtoFilesAttr)
+					},
+				},
+				{
+					Name:              ExtractAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, interpretationErr := convertExtractorsToDict(true, value)
+						return interpretationErr
+					},
+				},
+				{
+					Name:              HeadersAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, interpretationErr := convertHeadersToMapStringString(true, value)
+						return interpretationErr
+					},
+				},
+			},
+		},
+		Instantiate: instantiateGrpcRequestRecipe,
+	}
+}
+
+func instantiateGrpcRequestRecipe(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(GrpcRequestRecipeTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &GrpcRequestRecipe{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// GrpcRequestRecipe calls a unary gRPC method over a plaintext connection. The method is resolved through the server
+// reflection of the service, or by compiling the proto files of a files artifact when one is passed
+type GrpcRequestRecipe struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (recipe *GrpcRequestRecipe) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := recipe.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &GrpcRequestRecipe{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (recipe *GrpcRequestRecipe) Execute(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+) (map[string]starlark.Comparable, error) {
+	logrus.Debugf("Running gRPC request recipe '%s'", recipe.String())
+
+	portId, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, PortIdAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Mandatory attribute '%s' was not set on '%s'. This is unexpected and should have been caught earlier", PortIdAttr, GrpcRequestRecipeTypeName)
+	}
+
+	rawMethod, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, MethodAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Mandatory attribute '%s' was not set on '%s'. This is unexpected and should have been caught earlier", MethodAttr, GrpcRequestRecipeTypeName)
+	}
+	grpcServiceName, grpcMethodName := splitGrpcMethod(rawMethod.GoString())
+
+	requestBody, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, RequestBodyAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found || requestBody == "" {
+		requestBody = defaultGrpcRequestBody
+	}
+	requestBodyWithRuntimeValues, err := magic_string_helper.ReplaceRuntimeValueInString(requestBody.GoString(), runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while replacing runtime values in the body of the gRPC recipe")
+	}
+
+	extractors, interpretationErr := recipe.getExtractors()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	rawHeaders, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](recipe.KurtosisValueTypeDefault, HeadersAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	headers, interpretationErr := convertHeadersToMapStringString(found, rawHeaders)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	protoFilesArtifactName, isProtoFilesArtifactSet, interpretationErr := recipe.getProtoFilesArtifactName()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	address, err := getServicePortAddress(ctx, serviceNetwork, serviceName, portId.GoString())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the address of port '%v' of service '%v'", portId, serviceName)
+	}
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred dialing gRPC server at '%v'", address)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logrus.Warnf("An error occurred closing the connection to gRPC server at '%v': %v", address, err)
+		}
+	}()
+
+	var serviceDescriptor protoreflect.ServiceDescriptor
+	if isProtoFilesArtifactSet {
+		serviceDescriptor, err = getGrpcServiceDescriptorFromProtoFiles(ctx, serviceNetwork, protoFilesArtifactName, grpcServiceName)
+	} else {
+		serviceDescriptor, err = getGrpcServiceDescriptorFromReflection(ctx, conn, grpcServiceName)
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving gRPC service '%v'", grpcServiceName)
+	}
+	methodDescriptor := serviceDescriptor.Methods().ByName(protoreflect.Name(grpcMethodName))
+	if methodDescriptor == nil {
+		return nil, stacktrace.NewError("gRPC service '%v' has no method '%v'", grpcServiceName, grpcMethodName)
+	}
+	if methodDescriptor.IsStreamingClient() || methodDescriptor.IsStreamingServer() {
+		return nil, stacktrace.NewError("gRPC method '%v' is a streaming method; only unary methods can be called by '%v'", methodDescriptor.FullName(), GrpcRequestRecipeTypeName)
+	}
+
+	request := dynamicpb.NewMessage(methodDescriptor.Input())
+	if err = protojson.Unmarshal([]byte(requestBodyWithRuntimeValues), request); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing body '%v' into a '%v' message", requestBodyWithRuntimeValues, methodDescriptor.Input().FullName())
+	}
+	response := dynamicpb.NewMessage(methodDescriptor.Output())
+	invokeCtx := metadata.NewOutgoingContext(ctx, metadata.New(headers))
+	invokeErr := conn.Invoke(invokeCtx, fmt.Sprintf(grpcFullMethodPathFormat, grpcServiceName, grpcMethodName), request, response)
+
+	callStatus := status.Convert(invokeErr)
+	responseBody := ""
+	if invokeErr == nil {
+		serializedResponse, err := protojson.Marshal(response)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred serializing the response of gRPC method '%v' to JSON", methodDescriptor.FullName())
+		}
+		responseBody = string(serializedResponse)
+	}
+	logrus.Debugf("Got status '%v' and response '%v'", callStatus.Code(), responseBody)
+
+	resultDict := map[string]starlark.Comparable{
+		bodyKey:              starlark.String(responseBody),
+		statusCodeKey:        starlark.MakeInt64(int64(callStatus.Code())),
+		grpcStatusMessageKey: starlark.String(callStatus.Message()),
+	}
+	if len(extractors) == 0 {
+		return resultDict, nil
+	}
+	if invokeErr != nil {
+		return nil, stacktrace.Propagate(invokeErr, "gRPC method '%v' failed so the extractors can't be run", methodDescriptor.FullName())
+	}
+	extractDict, err := runExtractors([]byte(responseBody), extractors)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while running extractors on the gRPC response")
+	}
+	maps.Copy(resultDict, extractDict)
+	return resultDict, nil
+}
+
+func (recipe *GrpcRequestRecipe) ResultMapToString(resultMap map[string]starlark.Comparable) string {
+	result := resultMapToStringInternal(resultMap)
+	if message, ok := resultMap[grpcStatusMessageKey].(starlark.String); ok && message != "" {
+		return fmt.Sprintf("%s\nThe call returned status message %v", result, message)
+	}
+	return result
+}
+
+func (recipe *GrpcRequestRecipe) CreateStarlarkReturnValue(resultUuid string) (*starlark.Dict, *startosis_errors.InterpretationError) {
+	extractors, interpretationErr := recipe.getExtractors()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return createRecipeStarlarkReturnValue(resultUuid, []string{bodyKey, statusCodeKey, grpcStatusMessageKey}, extractors)
+}
+
+func (recipe *GrpcRequestRecipe) RequestType() string {
+	return grpcRequestType
+}
+
+func (recipe *GrpcRequestRecipe) GetDefaultAcceptableCodes() []int64 {
+	return grpcDefaultAcceptableCodes
+}
+
+func (recipe *GrpcRequestRecipe) getExtractors() (map[string]string, *startosis_errors.InterpretationError) {
+	rawExtractors, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](recipe.KurtosisValueTypeDefault, ExtractAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return convertExtractorsToDict(found, rawExtractors)
+}
+
+func (recipe *GrpcRequestRecipe) getProtoFilesArtifactName() (string, bool, *startosis_errors.InterpretationError) {
+	protoFilesArtifactName, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, ProtoFilesAttr)
+	if interpretationErr != nil {
+		return "", false, interpretationErr
+	}
+	return protoFilesArtifactName.GoString(), found, nil
+}
+
+func (recipe *GrpcRequestRecipe) getFilesArtifactNames() ([]string, *startosis_errors.InterpretationError) {
+	protoFilesArtifactName, found, interpretationErr := recipe.getProtoFilesArtifactName()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, nil
+	}
+	return []string{protoFilesArtifactName}, nil
+}
+
+// splitGrpcMethod splits 'package.Service/Method' into the full name of the service and the name of the method
+func splitGrpcMethod(method string) (string, string) {
+	serviceName, methodName, _ := strings.Cut(strings.TrimPrefix(method, grpcMethodSeparator), grpcMethodSeparator)
+	return serviceName, methodName
+}
+
+func validateGrpcMethod(value starlark.Value) *startosis_errors.InterpretationError {
+	if interpretationErr := builtin_argument.NonEmptyString(value, MethodAttr); interpretationErr != nil {
+		return interpretationErr
+	}
+	method, _ := starlark.AsString(value)
+	serviceName, methodName := splitGrpcMethod(method)
+	if serviceName == "" || methodName == "" || strings.Contains(methodName, grpcMethodSeparator) {
+		return startosis_errors.NewInterpretationError("Attribute '%s' on '%s' is expected to be of the form 'package.Service/Method', got '%s'", MethodAttr, GrpcRequestRecipeTypeName, method)
+	}
+	return nil
+}
+
+func getGrpcServiceDescriptorFromProtoFiles(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	protoFilesArtifactName string,
+	grpcServiceName string,
+) (protoreflect.ServiceDescriptor, error) {
+	filesArtifactContents, err := serviceNetwork.GetFilesArtifactContents(protoFilesArtifactName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the contents of files artifact '%v'", protoFilesArtifactName)
+	}
+	protoFileSources := map[string]string{}
+	protoFileNames := []string{}
+	for fileName, fileContent := range filesArtifactContents {
+		if !strings.HasSuffix(fileName, protoFileExtension) {
+			continue
+		}
+		protoFileSources[fileName] = string(fileContent)
+		protoFileNames = append(protoFileNames, fileName)
+	}
+	if len(protoFileNames) == 0 {
+		return nil, stacktrace.NewError("Files artifact '%v' holds no '%v' files", protoFilesArtifactName, protoFileExtension)
+	}
+	sort.Strings(protoFileNames)
+
+	compiler := protocompile.Compiler{ // nolint: exhaustruct
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{ // nolint: exhaustruct
+			Accessor: protocompile.SourceAccessorFromMap(protoFileSources),
+		}),
+	}
+	compiledFiles, err := compiler.Compile(ctx, protoFileNames...)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred compiling the proto files of files artifact '%v'", protoFilesArtifactName)
+	}
+	descriptor, err := compiledFiles.AsResolver().FindDescriptorByName(protoreflect.FullName(grpcServiceName))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "gRPC service '%v' isn't defined in the proto files of files artifact '%v'", grpcServiceName, protoFilesArtifactName)
+	}
+	return toGrpcServiceDescriptor(descriptor)
+}
+
+func getGrpcServiceDescriptorFromReflection(ctx context.Context, conn *grpc.ClientConn, grpcServiceName string) (protoreflect.ServiceDescriptor, error) {
+	reflectionCtx, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+	reflectionStream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(reflectionCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening the server reflection stream; is server reflection enabled on the service?")
+	}
+	defer func() {
+		if err := reflectionStream.CloseSend(); err != nil {
+			logrus.Debugf("An error occurred closing the server reflection stream: %v", err)
+		}
+	}()
+
+	fileDescriptorProtos := map[string]*descriptorpb.FileDescriptorProto{}
+	if err = requestReflectionFileDescriptors(reflectionStream, &reflectionpb.ServerReflectionRequest{ // nolint: exhaustruct
+		MessageRequest: &reflectionpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: grpcServiceName},
+	}, fileDescriptorProtos); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the file defining gRPC service '%v' through server reflection", grpcServiceName)
+	}
+
+	files := &protoregistry.Files{}
+	for _, fileName := range maps.Keys(fileDescriptorProtos) {
+		if err = registerReflectionFileDescriptor(reflectionStream, fileName, fileDescriptorProtos, files); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred building the descriptor of file '%v' got through server reflection", fileName)
+		}
+	}
+	descriptor, err := files.FindDescriptorByName(protoreflect.FullName(grpcServiceName))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "gRPC service '%v' isn't exposed through server reflection", grpcServiceName)
+	}
+	return toGrpcServiceDescriptor(descriptor)
+}
+
+// registerReflectionFileDescriptor registers the file and, first, its dependencies. The dependencies the server didn't
+// already send are asked for, unless they're well-known files
+func registerReflectionFileDescriptor(
+	reflectionStream reflectionpb.ServerReflection_ServerReflectionInfoClient,
+	fileName string,
+	fileDescriptorProtos map[string]*descriptorpb.FileDescriptorProto,
+	files *protoregistry.Files,
+) error {
+	if _, err := files.FindFileByPath(fileName); err == nil {
+		return nil
+	}
+	fileDescriptorProto, found := fileDescriptorProtos[fileName]
+	if !found {
+		if globalFileDescriptor, err := protoregistry.GlobalFiles.FindFileByPath(fileName); err == nil {
+			return files.RegisterFile(globalFileDescriptor)
+		}
+		if err := requestReflectionFileDescriptors(reflectionStream, &reflectionpb.ServerReflectionRequest{ // nolint: exhaustruct
+			MessageRequest: &reflectionpb.ServerReflectionRequest_FileByFilename{FileByFilename: fileName},
+		}, fileDescriptorProtos); err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting file '%v' through server reflection", fileName)
+		}
+		if fileDescriptorProto, found = fileDescriptorProtos[fileName]; !found {
+			return stacktrace.NewError("The server didn't send file '%v' when asked for it through server reflection", fileName)
+		}
+	}
+	for _, dependency := range fileDescriptorProto.GetDependency() {
+		if err := registerReflectionFileDescriptor(reflectionStream, dependency, fileDescriptorProtos, files); err != nil {
+			return stacktrace.Propagate(err, "An error occurred registering dependency '%v' of file '%v'", dependency, fileName)
+		}
+	}
+	fileDescriptor, err := protodesc.NewFile(fileDescriptorProto, files)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred building the descriptor of file '%v'", fileName)
+	}
+	return files.RegisterFile(fileDescriptor)
+}
+
+func requestReflectionFileDescriptors(
+	reflectionStream reflectionpb.ServerReflection_ServerReflectionInfoClient,
+	request *reflectionpb.ServerReflectionRequest,
+	fileDescriptorProtos map[string]*descriptorpb.FileDescriptorProto,
+) error {
+	if err := reflectionStream.Send(request); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the server reflection request")
+	}
+	response, err := reflectionStream.Recv()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred receiving the server reflection response")
+	}
+	if errorResponse := response.GetErrorResponse(); errorResponse != nil {
+		return stacktrace.NewError("Server reflection returned error code '%v' with message '%v'", errorResponse.GetErrorCode(), errorResponse.GetErrorMessage())
+	}
+	for _, serializedFileDescriptorProto := range response.GetFileDescriptorResponse().GetFileDescriptorProto() {
+		fileDescriptorProto := &descriptorpb.FileDescriptorProto{} // nolint: exhaustruct
+		if err = proto.Unmarshal(serializedFileDescriptorProto, fileDescriptorProto); err != nil {
+			return stacktrace.Propagate(err, "An error occurred deserializing a file descriptor sent through server reflection")
+		}
+		fileDescriptorProtos[fileDescriptorProto.GetName()] = fileDescriptorProto
+	}
+	return nil
+}
+
+func toGrpcServiceDescriptor(descriptor protoreflect.Descriptor) (protoreflect.ServiceDescriptor, error) {
+	serviceDescriptor, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, stacktrace.NewError("'%v' is not a gRPC service", descriptor.FullName())
+	}
+	return serviceDescriptor, nil
+}
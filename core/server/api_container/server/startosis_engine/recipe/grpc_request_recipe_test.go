@@ -0,0 +1,90 @@
+package recipe
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"net"
+	"testing"
+)
+
+const (
+	greeterProto = `syntax = "proto3";
+package greeter;
+
+import "api/types.proto";
+
+service Greeter {
+  rpc SayHello(HelloRequest) returns (HelloResponse);
+}
+`
+	typesProto = `syntax = "proto3";
+package greeter;
+
+import "google/protobuf/timestamp.proto";
+
+message HelloRequest {
+  string name = 1;
+}
+
+message HelloResponse {
+  string message = 1;
+  google.protobuf.Timestamp sent_at = 2;
+}
+`
+)
+
+func TestGetGrpcServiceDescriptorFromReflection(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	reflection.Register(server)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	serviceDescriptor, err := getGrpcServiceDescriptorFromReflection(context.Background(), conn, "grpc.health.v1.Health")
+	require.NoError(t, err)
+	require.NotNil(t, serviceDescriptor.Methods().ByName("Check"))
+
+	_, err = getGrpcServiceDescriptorFromReflection(context.Background(), conn, "grpc.health.v1.Unknown")
+	require.Error(t, err)
+}
+
+func TestGetGrpcServiceDescriptorFromProtoFiles(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	serviceNetwork.EXPECT().GetFilesArtifactContents("protos").Times(1).Return(map[string][]byte{
+		"api/greeter.proto": []byte(greeterProto),
+		"api/types.proto":   []byte(typesProto),
+		"README.md":         []byte("not a proto file"),
+	}, nil)
+
+	serviceDescriptor, err := getGrpcServiceDescriptorFromProtoFiles(context.Background(), serviceNetwork, "protos", "greeter.Greeter")
+	require.NoError(t, err)
+	methodDescriptor := serviceDescriptor.Methods().ByName("SayHello")
+	require.NotNil(t, methodDescriptor)
+	require.Equal(t, "greeter.HelloRequest", string(methodDescriptor.Input().FullName()))
+}
+
+func TestSplitGrpcMethod(t *testing.T) {
+	serviceName, methodName := splitGrpcMethod("/grpc.health.v1.Health/Check")
+	require.Equal(t, "grpc.health.v1.Health", serviceName)
+	require.Equal(t, "Check", methodName)
+
+	require.Nil(t, validateGrpcMethod(starlark.String("grpc.health.v1.Health/Check")))
+	require.NotNil(t, validateGrpcMethod(starlark.String("grpc.health.v1.Health")))
+	require.NotNil(t, validateGrpcMethod(starlark.String("grpc.health.v1.Health/Check/Extra")))
+}
@@ -6,11 +6,9 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
-	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
-	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"go.starlark.net/starlark"
@@ -32,13 +30,21 @@ const (
 	ExtractAttr  = "extract"
 )
 
-type HttpRequestRecipe interface {
-	builtin_argument.KurtosisValueType
-
-	Recipe
+var httpDefaultAcceptableCodes = []int64{
+	http.StatusOK,
+	http.StatusCreated,
+	http.StatusAccepted,
+	http.StatusNonAuthoritativeInfo,
+	http.StatusNoContent,
+	http.StatusResetContent,
+	http.StatusPartialContent,
+	http.StatusMultiStatus,
+	http.StatusAlreadyReported,
+	http.StatusIMUsed,
+}
 
-	// RequestType as of 2023-04-18 this only exists so that ExecRecipe doesn't implement HttpRequestRecipe
-	RequestType() string
+type HttpRequestRecipe interface {
+	PortRequestRecipe
 }
 
 func executeInternal(
@@ -110,24 +116,7 @@ func resultMapToStringInternal(resultMap map[string]starlark.Comparable) string
 }
 
 func createStarlarkReturnValueInternal(resultUuid string, extractors map[string]string) (*starlark.Dict, *startosis_errors.InterpretationError) {
-	dict := &starlark.Dict{}
-	err := dict.SetKey(starlark.String(bodyKey), starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, bodyKey)))
-	if err != nil {
-		return nil, startosis_errors.NewInterpretationError("An error has occurred when creating return value for request recipe, setting field '%v'", bodyKey)
-	}
-	err = dict.SetKey(starlark.String(statusCodeKey), starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, statusCodeKey)))
-	if err != nil {
-		return nil, startosis_errors.NewInterpretationError("An error has occurred when creating return value for request recipe, setting field '%v'", statusCodeKey)
-	}
-	for extractorKey := range extractors {
-		fullExtractorKey := fmt.Sprintf("%v.%v", extractKeyPrefix, extractorKey)
-		err = dict.SetKey(starlark.String(fullExtractorKey), starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, fullExtractorKey)))
-		if err != nil {
-			return nil, startosis_errors.NewInterpretationError("An error has occurred when creating return value for request recipe, setting field '%v'", fullExtractorKey)
-		}
-	}
-	dict.Freeze()
-	return dict, nil
+	return createRecipeStarlarkReturnValue(resultUuid, []string{bodyKey, statusCodeKey}, extractors)
 }
 
 func convertHeadersToMapStringString(isSet bool, headersStarlarkValue starlark.Value) (map[string]string, *startosis_errors.InterpretationError) {
@@ -171,22 +160,10 @@ func convertExtractorsToDict(isAttrSet bool, extractorsValue starlark.Value) (ma
 		if !ok {
 			return nil, startosis_errors.NewInterpretationError("Value associated to key '%s' in dictionary '%s' was expected to be a string, got '%s'", extractorKeyStr, ExtractAttr, reflect.TypeOf(extractorsValue))
 		}
+		if err := validateExtractor(extractorValueStr.GoString()); err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Extractor '%s' in dictionary '%s' is invalid", extractorKeyStr, ExtractAttr)
+		}
 		extractorStringMap[extractorKeyStr.GoString()] = extractorValueStr.GoString()
 	}
 	return extractorStringMap, nil
 }
-
-func ValidateHttpRequestRecipe(httpRequestRecipe HttpRequestRecipe, serviceName service.ServiceName, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
-	portIdValue, err := httpRequestRecipe.Attr(PortIdAttr)
-	if err != nil {
-		return startosis_errors.NewValidationError("Tried fetching port ID for request on service '%s' but failed", serviceName)
-	}
-	portIdStringValue, ok := starlark.AsString(portIdValue)
-	if !ok {
-		return startosis_errors.NewValidationError("Tried getting string value for port ID '%v' for request to service '%s' but failed", portIdValue, serviceName)
-	}
-	if portIdExists := validatorEnvironment.DoesPrivatePortIDExistForService(portIdStringValue, serviceName); !portIdExists {
-		return startosis_errors.NewValidationError("Request required port ID '%v' to exist on service '%v' but it doesn't", portIdStringValue, serviceName)
-	}
-	return nil
-}
@@ -0,0 +1,386 @@
+package recipe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	starlarkjson "go.starlark.net/lib/json"
+	"go.starlark.net/starlark"
+	"golang.org/x/exp/maps"
+	"io"
+	"reflect"
+)
+
+const (
+	JsonRpcRequestRecipeTypeName = "JsonRpcRequestRecipe"
+
+	MethodAttr = "method"
+	ParamsAttr = "params"
+
+	jsonRpcRequestType     = "JSON-RPC"
+	jsonRpcVersion         = "2.0"
+	jsonRpcRequestId       = 1
+	jsonRpcContentType     = "application/json"
+	defaultJsonRpcEndpoint = "/"
+
+	jsonRpcErrorCodeKey    = "error_code"
+	jsonRpcErrorMessageKey = "error_message"
+
+	starlarkJsonEncoderKey = "encode"
+)
+
+type jsonRpcRequest struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Id      int             `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type jsonRpcResponse struct {
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+type jsonRpcError struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+func NewJsonRpcRequestRecipeType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: JsonRpcRequestRecipeTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PortIdAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, PortIdAttr)
+					},
+				},
+				{
+					Name:              MethodAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, MethodAttr)
+					},
+				},
+				{
+					Name:              ParamsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator:         validateJsonRpcParams,
+				},
+				{
+					Name:              EndpointAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              ExtractAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, interpretationErr := convertExtractorsToDict(true, value)
+						return interpretationErr
+					},
+				},
+				{
+					Name:              HeadersAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, interpretationErr := convertHeadersToMapStringString(true, value)
+						return interpretationErr
+					},
+				},
+			},
+		},
+		Instantiate: instantiateJsonRpcRequestRecipe,
+	}
+}
+
+func instantiateJsonRpcRequestRecipe(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(JsonRpcRequestRecipeTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &JsonRpcRequestRecipe{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// JsonRpcRequestRecipe sends a JSON-RPC 2.0 request over HTTP. Its extractors run against the 'result' member of the
+// response, or against its 'error' member if the call failed
+type JsonRpcRequestRecipe struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (recipe *JsonRpcRequestRecipe) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := recipe.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &JsonRpcRequestRecipe{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (recipe *JsonRpcRequestRecipe) Execute(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+) (map[string]starlark.Comparable, error) {
+	logrus.Debugf("Running JSON-RPC request recipe '%s'", recipe.String())
+
+	portId, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, PortIdAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Mandatory attribute '%s' was not set on '%s'. This is unexpected and should have been caught earlier", PortIdAttr, JsonRpcRequestRecipeTypeName)
+	}
+
+	method, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, MethodAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Mandatory attribute '%s' was not set on '%s'. This is unexpected and should have been caught earlier", MethodAttr, JsonRpcRequestRecipeTypeName)
+	}
+
+	endpoint, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe.KurtosisValueTypeDefault, EndpointAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		endpoint = defaultJsonRpcEndpoint
+	}
+
+	// runtime values are replaced before serializing the request, so that they get escaped like any other string
+	methodWithRuntimeValues, err := magic_string_helper.ReplaceRuntimeValueInString(method.GoString(), runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while replacing runtime values in the method of the JSON-RPC recipe")
+	}
+	serializedParams, err := recipe.getSerializedParams(runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the params of JSON-RPC request recipe '%v'", recipe.String())
+	}
+	requestBody, err := json.Marshal(&jsonRpcRequest{
+		JsonRpc: jsonRpcVersion,
+		Id:      jsonRpcRequestId,
+		Method:  methodWithRuntimeValues,
+		Params:  serializedParams,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the JSON-RPC request")
+	}
+
+	extractors, interpretationErr := recipe.getExtractors()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	rawHeaders, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](recipe.KurtosisValueTypeDefault, HeadersAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	headers, interpretationErr := convertHeadersToMapStringString(found, rawHeaders)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	if serviceName == "" {
+		return nil, stacktrace.NewError("The service name parameter can't be an empty string")
+	}
+	response, err := serviceNetwork.HttpRequestService(ctx, string(serviceName), portId.GoString(), postMethod, jsonRpcContentType, endpoint.GoString(), string(requestBody), headers)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred when running JSON-RPC request recipe '%v'", recipe.String())
+	}
+	defer func() {
+		if err := response.Body.Close(); err != nil {
+			logrus.Errorf("An error occurred when closing response body: %v", err)
+		}
+	}()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while reading the JSON-RPC response body")
+	}
+	logrus.Debugf("Got response '%v'", string(responseBody))
+
+	resultDict, err := parseJsonRpcResponse(response.StatusCode, responseBody, extractors)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the response of JSON-RPC request recipe '%v'", recipe.String())
+	}
+	return resultDict, nil
+}
+
+func (recipe *JsonRpcRequestRecipe) ResultMapToString(resultMap map[string]starlark.Comparable) string {
+	result := resultMapToStringInternal(resultMap)
+	if errorCode := resultMap[jsonRpcErrorCodeKey]; errorCode != starlark.MakeInt(0) {
+		return fmt.Sprintf("%s\nThe call failed with error code '%v' and message %v", result, errorCode, resultMap[jsonRpcErrorMessageKey])
+	}
+	return result
+}
+
+func (recipe *JsonRpcRequestRecipe) CreateStarlarkReturnValue(resultUuid string) (*starlark.Dict, *startosis_errors.InterpretationError) {
+	extractors, interpretationErr := recipe.getExtractors()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return createRecipeStarlarkReturnValue(resultUuid, []string{bodyKey, statusCodeKey, jsonRpcErrorCodeKey, jsonRpcErrorMessageKey}, extractors)
+}
+
+func (recipe *JsonRpcRequestRecipe) RequestType() string {
+	return jsonRpcRequestType
+}
+
+func (recipe *JsonRpcRequestRecipe) GetDefaultAcceptableCodes() []int64 {
+	return httpDefaultAcceptableCodes
+}
+
+func (recipe *JsonRpcRequestRecipe) getExtractors() (map[string]string, *startosis_errors.InterpretationError) {
+	rawExtractors, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](recipe.KurtosisValueTypeDefault, ExtractAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return convertExtractorsToDict(found, rawExtractors)
+}
+
+// getSerializedParams returns the params as JSON with their runtime values replaced, or nil if they weren't set
+func (recipe *JsonRpcRequestRecipe) getSerializedParams(runtimeValueStore *runtime_value_store.RuntimeValueStore) (json.RawMessage, error) {
+	rawParams, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Value](recipe.KurtosisValueTypeDefault, ParamsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found || rawParams == starlark.None {
+		return nil, nil
+	}
+	params, err := replaceRuntimeValuesInJsonRpcParams(rawParams, runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while replacing runtime values in params '%v'", rawParams)
+	}
+	encoder, ok := starlarkjson.Module.Members[starlarkJsonEncoderKey].(*starlark.Builtin)
+	if !ok {
+		return nil, stacktrace.NewError("The Starlark JSON encoder could not be loaded. This is a Kurtosis internal bug")
+	}
+	serializedParams, err := encoder.CallInternal(&starlark.Thread{}, starlark.Tuple{params}, nil) // nolint: exhaustruct
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing params '%v' to JSON", params)
+	}
+	serializedParamsStr, ok := starlark.AsString(serializedParams)
+	if !ok {
+		return nil, stacktrace.NewError("The Starlark JSON encoder returned '%v' rather than a string. This is a Kurtosis internal bug", serializedParams)
+	}
+	return json.RawMessage(serializedParamsStr), nil
+}
+
+// replaceRuntimeValuesInJsonRpcParams returns a copy of the params where runtime values are replaced in every string,
+// whether it's a value or a key
+func replaceRuntimeValuesInJsonRpcParams(value starlark.Value, runtimeValueStore *runtime_value_store.RuntimeValueStore) (starlark.Value, error) {
+	switch typedValue := value.(type) {
+	case starlark.String:
+		valueWithRuntimeValues, err := magic_string_helper.ReplaceRuntimeValueInString(typedValue.GoString(), runtimeValueStore)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while replacing runtime values in '%v'", typedValue.GoString())
+		}
+		return starlark.String(valueWithRuntimeValues), nil
+	case *starlark.List:
+		elements := make([]starlark.Value, typedValue.Len())
+		for idx := 0; idx < typedValue.Len(); idx++ {
+			element, err := replaceRuntimeValuesInJsonRpcParams(typedValue.Index(idx), runtimeValueStore)
+			if err != nil {
+				return nil, err
+			}
+			elements[idx] = element
+		}
+		return starlark.NewList(elements), nil
+	case starlark.Tuple:
+		elements := make(starlark.Tuple, len(typedValue))
+		for idx, rawElement := range typedValue {
+			element, err := replaceRuntimeValuesInJsonRpcParams(rawElement, runtimeValueStore)
+			if err != nil {
+				return nil, err
+			}
+			elements[idx] = element
+		}
+		return elements, nil
+	case *starlark.Dict:
+		dict := starlark.NewDict(typedValue.Len())
+		for _, item := range typedValue.Items() {
+			key, err := replaceRuntimeValuesInJsonRpcParams(item[0], runtimeValueStore)
+			if err != nil {
+				return nil, err
+			}
+			dictValue, err := replaceRuntimeValuesInJsonRpcParams(item[1], runtimeValueStore)
+			if err != nil {
+				return nil, err
+			}
+			if err = dict.SetKey(key, dictValue); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred setting key '%v' of the params", key)
+			}
+		}
+		return dict, nil
+	default:
+		return value, nil
+	}
+}
+
+// parseJsonRpcResponse unwraps the result or the error out of the response envelope for the extractors
+func parseJsonRpcResponse(statusCode int, responseBody []byte, extractors map[string]string) (map[string]starlark.Comparable, error) {
+	response := &jsonRpcResponse{Result: nil, Error: nil}
+	if err := json.Unmarshal(responseBody, response); err != nil {
+		return nil, stacktrace.Propagate(err, "Request had response code '%v' and body '%v', which isn't a JSON-RPC response", statusCode, string(responseBody))
+	}
+	resultDict := map[string]starlark.Comparable{
+		bodyKey:                starlark.String(responseBody),
+		statusCodeKey:          starlark.MakeInt(statusCode),
+		jsonRpcErrorCodeKey:    starlark.MakeInt(0),
+		jsonRpcErrorMessageKey: starlark.String(""),
+	}
+
+	unwrappedResponse := response.Result
+	if len(response.Error) > 0 && string(response.Error) != "null" {
+		responseError := &jsonRpcError{Code: 0, Message: ""}
+		if err := json.Unmarshal(response.Error, responseError); err != nil {
+			return nil, stacktrace.Propagate(err, "The error of JSON-RPC response '%v' isn't a JSON-RPC error object", string(responseBody))
+		}
+		resultDict[jsonRpcErrorCodeKey] = starlark.MakeInt64(responseError.Code)
+		resultDict[jsonRpcErrorMessageKey] = starlark.String(responseError.Message)
+		unwrappedResponse = response.Error
+	}
+	if len(extractors) == 0 {
+		return resultDict, nil
+	}
+	extractDict, err := runExtractors(unwrappedResponse, extractors)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while running extractors on the JSON-RPC response")
+	}
+	maps.Copy(resultDict, extractDict)
+	return resultDict, nil
+}
+
+func validateJsonRpcParams(value starlark.Value) *startosis_errors.InterpretationError {
+	switch value.(type) {
+	case *starlark.List, starlark.Tuple, *starlark.Dict, starlark.NoneType:
+		return nil
+	default:
+		return startosis_errors.NewInterpretationError("Attribute '%s' on '%s' is expected to be a list or a dictionary, got '%s'", ParamsAttr, JsonRpcRequestRecipeTypeName, reflect.TypeOf(value))
+	}
+}
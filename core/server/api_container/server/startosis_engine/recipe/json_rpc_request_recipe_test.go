@@ -0,0 +1,67 @@
+package recipe
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+	starlarkjson "go.starlark.net/lib/json"
+	"go.starlark.net/starlark"
+	"os"
+	"testing"
+)
+
+func TestParseJsonRpcResponse_Result(t *testing.T) {
+	resultDict, err := parseJsonRpcResponse(200, []byte(`{"jsonrpc": "2.0", "id": 1, "result": {"number": "0x1b4"}}`), map[string]string{"block": ".number"})
+	require.NoError(t, err)
+	require.Equal(t, starlark.MakeInt(200), resultDict[statusCodeKey])
+	require.Equal(t, starlark.MakeInt(0), resultDict[jsonRpcErrorCodeKey])
+	require.Equal(t, starlark.String(""), resultDict[jsonRpcErrorMessageKey])
+	require.Equal(t, starlark.String("0x1b4"), resultDict["extract.block"])
+}
+
+func TestParseJsonRpcResponse_Error(t *testing.T) {
+	resultDict, err := parseJsonRpcResponse(200, []byte(`{"jsonrpc": "2.0", "id": 1, "error": {"code": -32601, "message": "Method not found"}}`), map[string]string{"message": ".message"})
+	require.NoError(t, err)
+	require.Equal(t, starlark.MakeInt(-32601), resultDict[jsonRpcErrorCodeKey])
+	require.Equal(t, starlark.String("Method not found"), resultDict[jsonRpcErrorMessageKey])
+	require.Equal(t, starlark.String("Method not found"), resultDict["extract.message"])
+}
+
+func TestParseJsonRpcResponse_NotJsonRpc(t *testing.T) {
+	_, err := parseJsonRpcResponse(502, []byte(`<html>Bad Gateway</html>`), nil)
+	require.Error(t, err)
+}
+
+func TestReplaceRuntimeValuesInJsonRpcParams_ValuesGetEscaped(t *testing.T) {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	defer db.Close()
+	//nolint:exhaustruct
+	serde := kurtosis_types.NewStarlarkValueSerde(&starlark.Thread{}, starlark.StringDict{})
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(serde, &enclave_db.EnclaveDB{DB: db})
+	require.NoError(t, err)
+
+	valueUuid, err := runtimeValueStore.CreateValue()
+	require.NoError(t, err)
+	require.NoError(t, runtimeValueStore.SetValue(valueUuid, map[string]starlark.Comparable{"body": starlark.String(`{"key": "value"}`)}))
+	runtimeValue := starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, valueUuid, "body"))
+
+	params := starlark.NewDict(1)
+	require.NoError(t, params.SetKey(starlark.String("data"), starlark.NewList([]starlark.Value{runtimeValue, starlark.MakeInt(1)})))
+	paramsWithRuntimeValues, err := replaceRuntimeValuesInJsonRpcParams(params, runtimeValueStore)
+	require.NoError(t, err)
+
+	encoder, ok := starlarkjson.Module.Members[starlarkJsonEncoderKey].(*starlark.Builtin)
+	require.True(t, ok)
+	//nolint:exhaustruct
+	serializedParams, err := encoder.CallInternal(&starlark.Thread{}, starlark.Tuple{paramsWithRuntimeValues}, nil)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String(`{"data":["{\"key\": \"value\"}",1]}`), serializedParams)
+}
@@ -222,3 +222,7 @@ func (recipe *PostHttpRequestRecipe) CreateStarlarkReturnValue(resultUuid string
 func (recipe *PostHttpRequestRecipe) RequestType() string {
 	return postMethod
 }
+
+func (recipe *PostHttpRequestRecipe) GetDefaultAcceptableCodes() []int64 {
+	return httpDefaultAcceptableCodes
+}
@@ -2,11 +2,18 @@ package recipe
 
 import (
 	"context"
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
 	"go.starlark.net/starlark"
+	"golang.org/x/exp/maps"
+	"sort"
 )
 
 type Recipe interface {
@@ -19,3 +26,88 @@ type Recipe interface {
 	CreateStarlarkReturnValue(resultUuid string) (*starlark.Dict, *startosis_errors.InterpretationError)
 	ResultMapToString(resultMap map[string]starlark.Comparable) string
 }
+
+// PortRequestRecipe is a recipe sending a request to one of the ports of the service, as opposed to running a command
+// in it. All of them can be used by request, wait and ReadyCondition
+type PortRequestRecipe interface {
+	builtin_argument.KurtosisValueType
+
+	Recipe
+
+	// RequestType as of 2023-04-18 this only exists so that ExecRecipe doesn't implement PortRequestRecipe
+	RequestType() string
+
+	// GetDefaultAcceptableCodes returns the codes request accepts when none are passed to it
+	GetDefaultAcceptableCodes() []int64
+}
+
+// filesArtifactsConsumingRecipe is implemented by the recipes reading files artifacts when executed, so that the
+// existence of the artifacts is validated
+type filesArtifactsConsumingRecipe interface {
+	getFilesArtifactNames() ([]string, *startosis_errors.InterpretationError)
+}
+
+func ValidatePortRequestRecipe(portRequestRecipe PortRequestRecipe, serviceName service.ServiceName, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	portIdValue, err := portRequestRecipe.Attr(PortIdAttr)
+	if err != nil {
+		return startosis_errors.NewValidationError("Tried fetching port ID for request on service '%s' but failed", serviceName)
+	}
+	portIdStringValue, ok := starlark.AsString(portIdValue)
+	if !ok {
+		return startosis_errors.NewValidationError("Tried getting string value for port ID '%v' for request to service '%s' but failed", portIdValue, serviceName)
+	}
+	if portIdExists := validatorEnvironment.DoesPrivatePortIDExistForService(portIdStringValue, serviceName); !portIdExists {
+		return startosis_errors.NewValidationError("Request required port ID '%v' to exist on service '%v' but it doesn't", portIdStringValue, serviceName)
+	}
+	filesArtifactsConsumer, ok := portRequestRecipe.(filesArtifactsConsumingRecipe)
+	if !ok {
+		return nil
+	}
+	filesArtifactNames, interpretationErr := filesArtifactsConsumer.getFilesArtifactNames()
+	if interpretationErr != nil {
+		return startosis_errors.WrapWithValidationError(interpretationErr, "Tried getting the files artifacts used by the request to service '%s' but failed", serviceName)
+	}
+	for _, filesArtifactName := range filesArtifactNames {
+		if validatorEnvironment.DoesArtifactNameExist(filesArtifactName) == startosis_validator.ComponentNotFound {
+			return startosis_errors.NewValidationError("Request required files artifact '%v' to exist but it doesn't", filesArtifactName)
+		}
+	}
+	return nil
+}
+
+// getServicePortAddress returns the address the APIC reaches the port of the service at
+func getServicePortAddress(ctx context.Context, serviceNetwork service_network.ServiceNetwork, serviceName service.ServiceName, portId string) (string, error) {
+	if serviceName == "" {
+		return "", stacktrace.NewError("The service name parameter can't be an empty string")
+	}
+	userService, err := serviceNetwork.GetService(ctx, string(serviceName))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting service '%v'", serviceName)
+	}
+	port, found := userService.GetPrivatePorts()[portId]
+	if !found {
+		return "", stacktrace.NewError("Port '%v' doesn't exist on service '%v'", portId, serviceName)
+	}
+	return fmt.Sprintf("%v:%v", userService.GetRegistration().GetPrivateIP(), port.GetNumber()), nil
+}
+
+// createRecipeStarlarkReturnValue returns a dict holding a runtime value placeholder for each of the fields of the result
+// of the recipe and for each of its extractors
+func createRecipeStarlarkReturnValue(resultUuid string, fields []string, extractors map[string]string) (*starlark.Dict, *startosis_errors.InterpretationError) {
+	dict := &starlark.Dict{}
+	for _, field := range fields {
+		if err := dict.SetKey(starlark.String(field), starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, field))); err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "An error has occurred when creating return value for recipe, setting field '%v'", field)
+		}
+	}
+	extractorKeys := maps.Keys(extractors)
+	sort.Strings(extractorKeys)
+	for _, extractorKey := range extractorKeys {
+		fullExtractorKey := fmt.Sprintf("%v.%v", extractKeyPrefix, extractorKey)
+		if err := dict.SetKey(starlark.String(fullExtractorKey), starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, fullExtractorKey))); err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "An error has occurred when creating return value for recipe, setting field '%v'", fullExtractorKey)
+		}
+	}
+	dict.Freeze()
+	return dict, nil
+}
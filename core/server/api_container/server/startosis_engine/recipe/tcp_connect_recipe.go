@@ -0,0 +1,214 @@
+package recipe
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+	"net"
+	"time"
+)
+
+const (
+	TcpConnectRecipeTypeName = "TcpConnectRecipe"
+
+	PayloadAttr = "payload"
+	TimeoutAttr = "timeout"
+
+	tcpRequestType = "TCP"
+	tcpNetwork     = "tcp"
+
+	defaultProbeTimeout = 5 * time.Second
+	// The probes only read the first chunk of the response
+	probeResponseBufferSize = 64 * 1024
+	probeSuccessCode        = int64(0)
+)
+
+var probeDefaultAcceptableCodes = []int64{
+	probeSuccessCode,
+}
+
+func NewTcpConnectRecipeType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: TcpConnectRecipeTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PortIdAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, PortIdAttr)
+					},
+				},
+				{
+					Name:              PayloadAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              TimeoutAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, TimeoutAttr)
+					},
+				},
+			},
+		},
+		Instantiate: instantiateTcpConnectRecipe,
+	}
+}
+
+func instantiateTcpConnectRecipe(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(TcpConnectRecipeTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &TcpConnectRecipe{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// TcpConnectRecipe checks that the port of the service accepts TCP connections. When a payload is passed, it is sent
+// once connected and the first chunk of the response is returned as the body
+type TcpConnectRecipe struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (recipe *TcpConnectRecipe) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := recipe.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &TcpConnectRecipe{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (recipe *TcpConnectRecipe) Execute(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+) (map[string]starlark.Comparable, error) {
+	address, payload, isPayloadSet, timeout, err := getProbeParameters(ctx, recipe.KurtosisValueTypeDefault, serviceNetwork, runtimeValueStore, serviceName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the parameters of TCP connect recipe '%v'", recipe.String())
+	}
+
+	dialer := &net.Dialer{Timeout: timeout} // nolint: exhaustruct
+	conn, err := dialer.DialContext(ctx, tcpNetwork, address)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to '%v'", address)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logrus.Warnf("An error occurred closing the TCP connection to '%v': %v", address, err)
+		}
+	}()
+
+	responseBody := ""
+	if isPayloadSet {
+		responseBody, err = sendProbePayload(conn, payload, timeout)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred exchanging the payload with '%v'", address)
+		}
+	}
+	return map[string]starlark.Comparable{
+		bodyKey:       starlark.String(responseBody),
+		statusCodeKey: starlark.MakeInt64(probeSuccessCode),
+	}, nil
+}
+
+func (recipe *TcpConnectRecipe) ResultMapToString(resultMap map[string]starlark.Comparable) string {
+	return resultMapToStringInternal(resultMap)
+}
+
+func (recipe *TcpConnectRecipe) CreateStarlarkReturnValue(resultUuid string) (*starlark.Dict, *startosis_errors.InterpretationError) {
+	return createRecipeStarlarkReturnValue(resultUuid, []string{bodyKey, statusCodeKey}, nil)
+}
+
+func (recipe *TcpConnectRecipe) RequestType() string {
+	return tcpRequestType
+}
+
+func (recipe *TcpConnectRecipe) GetDefaultAcceptableCodes() []int64 {
+	return probeDefaultAcceptableCodes
+}
+
+// getProbeParameters returns the address, the payload with its runtime values replaced and the timeout of a TCP or UDP probe
+func getProbeParameters(
+	ctx context.Context,
+	recipe *kurtosis_type_constructor.KurtosisValueTypeDefault,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+) (string, string, bool, time.Duration, error) {
+	portId, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe, PortIdAttr)
+	if interpretationErr != nil {
+		return "", "", false, 0, interpretationErr
+	}
+	if !found {
+		return "", "", false, 0, startosis_errors.NewInterpretationError("Mandatory attribute '%s' was not set. This is unexpected and should have been caught earlier", PortIdAttr)
+	}
+
+	payload, isPayloadSet, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe, PayloadAttr)
+	if interpretationErr != nil {
+		return "", "", false, 0, interpretationErr
+	}
+	payloadWithRuntimeValues := ""
+	if isPayloadSet {
+		var err error
+		payloadWithRuntimeValues, err = magic_string_helper.ReplaceRuntimeValueInString(payload.GoString(), runtimeValueStore)
+		if err != nil {
+			return "", "", false, 0, stacktrace.Propagate(err, "An error occurred while replacing runtime values in the payload of the recipe")
+		}
+	}
+
+	timeout := defaultProbeTimeout
+	rawTimeout, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](recipe, TimeoutAttr)
+	if interpretationErr != nil {
+		return "", "", false, 0, interpretationErr
+	}
+	if found {
+		var err error
+		timeout, err = time.ParseDuration(rawTimeout.GoString())
+		if err != nil {
+			return "", "", false, 0, stacktrace.Propagate(err, "An error occurred parsing timeout '%v'", rawTimeout)
+		}
+	}
+
+	address, err := getServicePortAddress(ctx, serviceNetwork, serviceName, portId.GoString())
+	if err != nil {
+		return "", "", false, 0, stacktrace.Propagate(err, "An error occurred getting the address of port '%v' of service '%v'", portId, serviceName)
+	}
+	return address, payloadWithRuntimeValues, isPayloadSet, timeout, nil
+}
+
+// sendProbePayload writes the payload to the connection and returns the first chunk read back before the timeout
+func sendProbePayload(conn net.Conn, payload string, timeout time.Duration) (string, error) {
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred setting the deadline of the connection")
+	}
+	if _, err := conn.Write([]byte(payload)); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred sending the payload")
+	}
+	responseBuffer := make([]byte, probeResponseBufferSize)
+	responseLength, err := conn.Read(responseBuffer)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading the response to the payload")
+	}
+	return string(responseBuffer[:responseLength]), nil
+}
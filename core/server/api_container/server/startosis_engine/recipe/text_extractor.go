@@ -0,0 +1,232 @@
+package recipe
+
+import (
+	"fmt"
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Extractors are jq queries run against the JSON input unless they start with one of the prefixes below, in which case
+// they run against the raw input instead, e.g. the output of a command that isn't JSON
+const (
+	// regexExtractorPrefix is followed by a regular expression. Each match returns its single capture group, the list of
+	// its capture groups when there are several, or the whole match when there are none
+	regexExtractorPrefix = "regex:"
+
+	// yamlExtractorPrefix is followed by a jq query run against the input parsed as YAML
+	yamlExtractorPrefix = "yaml:"
+
+	// linesExtractorPrefix is followed by the index of a line, e.g. 'lines:0' or 'lines:-1', or by a Python-like slice
+	// of lines, e.g. 'lines:1:' or 'lines:-3:'
+	linesExtractorPrefix = "lines:"
+
+	linesSliceSeparator = ":"
+	newLine             = "\n"
+	carriageReturn      = "\r"
+)
+
+var textExtractorPrefixes = []string{regexExtractorPrefix, yamlExtractorPrefix, linesExtractorPrefix}
+
+// parseTextExtractor returns the prefix of the extractor and the query following it, if it runs against the raw input
+func parseTextExtractor(extractor string) (string, string, bool) {
+	for _, prefix := range textExtractorPrefixes {
+		if query, found := strings.CutPrefix(extractor, prefix); found {
+			return prefix, query, true
+		}
+	}
+	return "", "", false
+}
+
+// validateExtractor catches the regex and lines extractors that can never run, so that they fail at interpretation
+// rather than on every attempt of the recipe. The jq queries are only parsed when they run, as they always were
+func validateExtractor(extractor string) error {
+	extractorType, query, isTextExtractor := parseTextExtractor(extractor)
+	if !isTextExtractor {
+		return nil
+	}
+	switch extractorType {
+	case regexExtractorPrefix:
+		if _, err := regexp.Compile(query); err != nil {
+			return stacktrace.Propagate(err, "'%v' isn't a valid regular expression", query)
+		}
+	case linesExtractorPrefix:
+		if _, err := parseLinesSelection(query); err != nil {
+			return stacktrace.Propagate(err, "'%v' isn't a valid line index or slice", query)
+		}
+	}
+	return nil
+}
+
+func runTextExtractor(input []byte, extractorType string, query string, variables map[string]any) (starlark.Comparable, error) {
+	switch extractorType {
+	case regexExtractorPrefix:
+		return extractWithRegex(string(input), query)
+	case yamlExtractorPrefix:
+		return extractFromYaml(input, query, variables)
+	case linesExtractorPrefix:
+		return extractLines(string(input), query)
+	default:
+		return nil, stacktrace.NewError("Extractor type '%v' isn't supported. This is a Kurtosis internal bug", extractorType)
+	}
+}
+
+func extractWithRegex(input string, pattern string) (starlark.Comparable, error) {
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred compiling regular expression '%v'", pattern)
+	}
+	matches := regex.FindAllStringSubmatch(input, -1)
+	if len(matches) == 0 {
+		return nil, stacktrace.NewError("Regular expression '%v' didn't match input '%v'", pattern, input)
+	}
+	parsedMatchList := make([]starlark.Value, 0, len(matches))
+	for _, match := range matches {
+		groups := match[1:]
+		switch len(groups) {
+		case 0:
+			parsedMatchList = append(parsedMatchList, starlark.String(match[0]))
+		case 1:
+			parsedMatchList = append(parsedMatchList, starlark.String(groups[0]))
+		default:
+			groupList := make([]starlark.Value, 0, len(groups))
+			for _, group := range groups {
+				groupList = append(groupList, starlark.String(group))
+			}
+			parsedMatchList = append(parsedMatchList, starlark.NewList(groupList))
+		}
+	}
+	return toExtractedValue(parsedMatchList), nil
+}
+
+func extractFromYaml(input []byte, query string, variables map[string]any) (starlark.Comparable, error) {
+	var yamlBody any
+	if err := yaml.Unmarshal(input, &yamlBody); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred when parsing YAML input:\n'%v'", string(input))
+	}
+	return runJqQuery(normalizeYamlValue(yamlBody), string(input), query, variables)
+}
+
+// normalizeYamlValue turns the values the YAML parser returns into the JSON-like ones jq runs against
+func normalizeYamlValue(value any) any {
+	switch value := value.(type) {
+	case map[any]any:
+		normalizedMap := make(map[string]any, len(value))
+		for key, element := range value {
+			normalizedMap[fmt.Sprintf("%v", key)] = normalizeYamlValue(element)
+		}
+		return normalizedMap
+	case map[string]any:
+		normalizedMap := make(map[string]any, len(value))
+		for key, element := range value {
+			normalizedMap[key] = normalizeYamlValue(element)
+		}
+		return normalizedMap
+	case []any:
+		normalizedList := make([]any, 0, len(value))
+		for _, element := range value {
+			normalizedList = append(normalizedList, normalizeYamlValue(element))
+		}
+		return normalizedList
+	case int64:
+		return int(value)
+	case uint64:
+		return int(value)
+	case float32:
+		return float64(value)
+	case time.Time:
+		return value.Format(time.RFC3339Nano)
+	default:
+		return value
+	}
+}
+
+// linesSelection is the index of a single line unless isSlice is set, in which case either bound may be missing
+type linesSelection struct {
+	start   *int
+	end     *int
+	isSlice bool
+}
+
+func parseLinesSelection(query string) (*linesSelection, error) {
+	parseBound := func(bound string) (*int, error) {
+		bound = strings.TrimSpace(bound)
+		if bound == "" {
+			return nil, nil
+		}
+		parsedBound, err := strconv.Atoi(bound)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Line bound '%v' isn't an integer", bound)
+		}
+		return &parsedBound, nil
+	}
+	startStr, endStr, isSlice := strings.Cut(query, linesSliceSeparator)
+	start, err := parseBound(startStr)
+	if err != nil {
+		return nil, err
+	}
+	if !isSlice {
+		if start == nil {
+			return nil, stacktrace.NewError("A line index is expected after '%v'", linesExtractorPrefix)
+		}
+		return &linesSelection{start: start, end: nil, isSlice: false}, nil
+	}
+	end, err := parseBound(endStr)
+	if err != nil {
+		return nil, err
+	}
+	return &linesSelection{start: start, end: end, isSlice: true}, nil
+}
+
+func extractLines(input string, query string) (starlark.Comparable, error) {
+	selection, err := parseLinesSelection(query)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing lines extractor '%v'", query)
+	}
+	lines := strings.Split(strings.TrimSuffix(input, newLine), newLine)
+	for index, line := range lines {
+		lines[index] = strings.TrimSuffix(line, carriageReturn)
+	}
+	resolveIndex := func(index int) int {
+		if index < 0 {
+			return index + len(lines)
+		}
+		return index
+	}
+
+	if !selection.isSlice {
+		index := resolveIndex(*selection.start)
+		if index < 0 || index >= len(lines) {
+			return nil, stacktrace.NewError("Line '%v' is out of range of input '%v', which has %v lines", *selection.start, input, len(lines))
+		}
+		return starlark.String(lines[index]), nil
+	}
+
+	clamp := func(bound *int, defaultValue int) int {
+		if bound == nil {
+			return defaultValue
+		}
+		index := resolveIndex(*bound)
+		if index < 0 {
+			return 0
+		}
+		if index > len(lines) {
+			return len(lines)
+		}
+		return index
+	}
+	start := clamp(selection.start, 0)
+	end := clamp(selection.end, len(lines))
+	if start >= end {
+		return nil, stacktrace.NewError("Lines '%v' select no line of input '%v', which has %v lines", query, input, len(lines))
+	}
+	selectedLines := make([]starlark.Value, 0, end-start)
+	for _, line := range lines[start:end] {
+		selectedLines = append(selectedLines, starlark.String(line))
+	}
+	return starlark.NewList(selectedLines), nil
+}
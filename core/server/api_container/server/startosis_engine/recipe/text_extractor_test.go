@@ -0,0 +1,91 @@
+package recipe
+
+import (
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+	"testing"
+)
+
+const commandOutput = "first line\nversion: 1.2.3\r\nlast line\n"
+
+func requireExtractedValue(t *testing.T, expected starlark.Comparable, actual starlark.Comparable) {
+	equal, err := expected.CompareSameType(syntax.EQL, actual, 2)
+	require.NoError(t, err)
+	require.True(t, equal, "Expected '%v' but got '%v'", expected, actual)
+}
+
+func TestTextExtractor_Regex(t *testing.T) {
+	result, err := runExtractorsOnText(commandOutput, map[string]string{
+		"version":  `regex:version: (\S+)`,
+		"parts":    `regex:(\d+)\.(\d+)\.(\d+)`,
+		"lines":    `regex:\w+ line`,
+		"no_group": `regex:\d\.\d`,
+	})
+	require.NoError(t, err)
+	requireExtractedValue(t, starlark.String("1.2.3"), result["extract.version"])
+	requireExtractedValue(t, starlark.NewList([]starlark.Value{starlark.String("1"), starlark.String("2"), starlark.String("3")}), result["extract.parts"])
+	requireExtractedValue(t, starlark.NewList([]starlark.Value{starlark.String("first line"), starlark.String("last line")}), result["extract.lines"])
+	requireExtractedValue(t, starlark.String("1.2"), result["extract.no_group"])
+}
+
+func TestTextExtractor_RegexNoMatch(t *testing.T) {
+	_, err := runExtractorsOnText(commandOutput, map[string]string{"missing": `regex:not found`})
+	require.Error(t, err)
+}
+
+func TestTextExtractor_Yaml(t *testing.T) {
+	yamlInput := []byte("name: node\nports:\n  - 8080\n  - 9090\nnested:\n  1: one\n")
+	result, err := runExtractors(yamlInput, map[string]string{
+		"name":   "yaml:.name",
+		"port":   "yaml:.ports[1]",
+		"nested": `yaml:.nested["1"]`,
+	})
+	require.NoError(t, err)
+	requireExtractedValue(t, starlark.String("node"), result["extract.name"])
+	requireExtractedValue(t, starlark.MakeInt(9090), result["extract.port"])
+	requireExtractedValue(t, starlark.String("one"), result["extract.nested"])
+}
+
+func TestTextExtractor_YamlInvalid(t *testing.T) {
+	_, err := runExtractorsOnText("key: [unclosed", map[string]string{"key": "yaml:.key"})
+	require.Error(t, err)
+}
+
+func TestTextExtractor_Lines(t *testing.T) {
+	result, err := runExtractorsOnText(commandOutput, map[string]string{
+		"first":  "lines:0",
+		"second": "lines:1",
+		"last":   "lines:-1",
+		"tail":   "lines:1:",
+		"head":   "lines::-1",
+	})
+	require.NoError(t, err)
+	requireExtractedValue(t, starlark.String("first line"), result["extract.first"])
+	requireExtractedValue(t, starlark.String("version: 1.2.3"), result["extract.second"])
+	requireExtractedValue(t, starlark.String("last line"), result["extract.last"])
+	requireExtractedValue(t, starlark.NewList([]starlark.Value{starlark.String("version: 1.2.3"), starlark.String("last line")}), result["extract.tail"])
+	requireExtractedValue(t, starlark.NewList([]starlark.Value{starlark.String("first line"), starlark.String("version: 1.2.3")}), result["extract.head"])
+}
+
+func TestTextExtractor_LinesOutOfRange(t *testing.T) {
+	for _, query := range []string{"lines:3", "lines:-4", "lines:5:", "lines:2:1"} {
+		_, err := runExtractorsOnText(commandOutput, map[string]string{"line": query})
+		require.Error(t, err, "Query '%v' was expected to fail", query)
+	}
+}
+
+func TestTextExtractor_JqStillReadsOutputAsString(t *testing.T) {
+	result, err := runExtractorsOnText(commandOutput, map[string]string{"trimmed": `. | split("\n") | .[0]`})
+	require.NoError(t, err)
+	requireExtractedValue(t, starlark.String("first line"), result["extract.trimmed"])
+}
+
+func TestValidateExtractor(t *testing.T) {
+	for _, extractor := range []string{".key", "regex:(a|b)", "yaml:.key", "lines:0", "lines:-2:", "lines::"} {
+		require.NoError(t, validateExtractor(extractor), "Extractor '%v' was expected to be valid", extractor)
+	}
+	for _, extractor := range []string{"regex:(unclosed", "lines:", "lines:a", "lines:1:b"} {
+		require.Error(t, validateExtractor(extractor), "Extractor '%v' was expected to be invalid", extractor)
+	}
+}
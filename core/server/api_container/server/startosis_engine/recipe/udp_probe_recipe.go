@@ -0,0 +1,132 @@
+package recipe
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+	"net"
+)
+
+const (
+	UdpProbeRecipeTypeName = "UdpProbeRecipe"
+
+	udpRequestType = "UDP"
+	udpNetwork     = "udp"
+)
+
+func NewUdpProbeRecipeType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: UdpProbeRecipeTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PortIdAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, PortIdAttr)
+					},
+				},
+				{
+					Name:              PayloadAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, PayloadAttr)
+					},
+				},
+				{
+					Name:              TimeoutAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, TimeoutAttr)
+					},
+				},
+			},
+		},
+		Instantiate: instantiateUdpProbeRecipe,
+	}
+}
+
+func instantiateUdpProbeRecipe(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(UdpProbeRecipeTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &UdpProbeRecipe{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// UdpProbeRecipe sends the payload to the port of the service as a single datagram and returns the datagram it gets
+// back as the body. UDP being connectionless, getting no response before the timeout is the only way for it to fail
+type UdpProbeRecipe struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (recipe *UdpProbeRecipe) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := recipe.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &UdpProbeRecipe{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (recipe *UdpProbeRecipe) Execute(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+) (map[string]starlark.Comparable, error) {
+	address, payload, _, timeout, err := getProbeParameters(ctx, recipe.KurtosisValueTypeDefault, serviceNetwork, runtimeValueStore, serviceName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the parameters of UDP probe recipe '%v'", recipe.String())
+	}
+
+	dialer := &net.Dialer{Timeout: timeout} // nolint: exhaustruct
+	conn, err := dialer.DialContext(ctx, udpNetwork, address)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening a UDP socket to '%v'", address)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logrus.Warnf("An error occurred closing the UDP socket to '%v': %v", address, err)
+		}
+	}()
+
+	responseBody, err := sendProbePayload(conn, payload, timeout)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred exchanging the payload with '%v'", address)
+	}
+	return map[string]starlark.Comparable{
+		bodyKey:       starlark.String(responseBody),
+		statusCodeKey: starlark.MakeInt64(probeSuccessCode),
+	}, nil
+}
+
+func (recipe *UdpProbeRecipe) ResultMapToString(resultMap map[string]starlark.Comparable) string {
+	return resultMapToStringInternal(resultMap)
+}
+
+func (recipe *UdpProbeRecipe) CreateStarlarkReturnValue(resultUuid string) (*starlark.Dict, *startosis_errors.InterpretationError) {
+	return createRecipeStarlarkReturnValue(resultUuid, []string{bodyKey, statusCodeKey}, nil)
+}
+
+func (recipe *UdpProbeRecipe) RequestType() string {
+	return udpRequestType
+}
+
+func (recipe *UdpProbeRecipe) GetDefaultAcceptableCodes() []int64 {
+	return probeDefaultAcceptableCodes
+}
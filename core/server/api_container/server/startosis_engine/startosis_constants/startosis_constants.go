@@ -12,6 +12,9 @@ const (
 	PackageIdPlaceholderForStandaloneScript                          = "DEFAULT_PACKAGE_ID_FOR_SCRIPT"
 	PlaceHolderMainFileForPlaceStandAloneScript                      = ""
 	ParallelismParam                            StarlarkContextParam = "PARALLELISM"
+	// InstructionResultReporterParam holds the func(string) the intermediate results of an instruction are streamed to,
+	// like the failed attempts of a retried instruction or the branches of `plan.parallel` as they complete
+	InstructionResultReporterParam StarlarkContextParam = "INSTRUCTION_RESULT_REPORTER"
 
 	// DefaultPersistentDirectorySize 1Gi Megabytes is the default value and what most drivers support
 	DefaultPersistentDirectorySize int64 = 1024 * 1024 * 1024
@@ -62,10 +62,27 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 	executor.mutex.Lock()
 	starlarkRunResponseLineStream := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 	ctxWithParallelism := context.WithValue(ctx, startosis_constants.ParallelismParam, parallelism)
+	// intermediate results, like the failed attempts of the retried instructions, are streamed before the result of
+	// the instruction. Results reported once the stream is closed, by a branch outliving the execution, are dropped
+	reportMutex := &sync.Mutex{}
+	isStreamClosed := false
+	reportInstructionResult := func(result string) {
+		reportMutex.Lock()
+		defer reportMutex.Unlock()
+		if isStreamClosed {
+			logrus.Debugf("Dropping instruction result reported after the end of the execution: '%v'", result)
+			return
+		}
+		starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstructionResult(result)
+	}
+	ctxWithParallelism = context.WithValue(ctxWithParallelism, startosis_constants.InstructionResultReporterParam, reportInstructionResult)
 	go func() {
 		defer func() {
 			executor.mutex.Unlock()
+			reportMutex.Lock()
+			isStreamClosed = true
 			close(starlarkRunResponseLineStream)
+			reportMutex.Unlock()
 		}()
 
 		// TODO: for now the plan is append only, as each Starlark run happens on top of whatever exists in the enclave
@@ -135,7 +152,8 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 
 		if !dryRun {
 			logrus.Debugf("Serialized script output before runtime value replace: '%v'", serializedScriptOutput)
-			scriptWithValuesReplaced, err := magic_string_helper.ReplaceRuntimeValueInString(serializedScriptOutput, executor.runtimeValueStore)
+			// the output is the JSON serialized return value of the script, so the runtime values get escaped
+			scriptWithValuesReplaced, err := magic_string_helper.ReplaceRuntimeValueInJsonString(serializedScriptOutput, executor.runtimeValueStore)
 			if err != nil {
 				sendErrorAndFail(starlarkRunResponseLineStream, err, "An error occurred while replacing the runtime values in the output of the script")
 				return
@@ -69,7 +69,7 @@ func (suite *StartosisInterpreterIdempotentTestSuite) SetupTest() {
 
 	serviceNetwork := service_network.NewMockServiceNetwork(suite.T())
 	serviceNetwork.EXPECT().GetApiContainerInfo().Maybe().Return(
-		service_network.NewApiContainerInfo(net.IPv4(0, 0, 0, 0), uint16(1234), "0.0.0"),
+		service_network.NewApiContainerInfo(net.IPv4(0, 0, 0, 0), uint16(1234), "0.0.0", ""),
 	)
 	serviceNetwork.EXPECT().GetEnclaveUuid().Maybe().Return(enclaveUuid)
 	suite.interpreter = NewStartosisInterpreter(serviceNetwork, suite.packageContentProvider, runtimeValueStore, starlarkValueSerde, "", interpretationTimeValueStore)
@@ -736,6 +736,81 @@ def run(plan, args):
 	require.False(suite.T(), secondScheduledInstruction3.IsExecuted()) // set service should also be executed because it hasn't been run, but its a noop - its effect is to swap out the service config during interpretation time
 }
 
+// Blocks are resolved as a single unit against the enclave plan, and their sub-plans don't consume its instructions
+// Current plan ->     [`if_(...)`  `for_each(...)`  `parallel(...)`  `print("after blocks")`]
+// Package to run ->   [`if_(...)`  `for_each(...)`  `parallel(...)`  `print("after blocks")`]
+// Check that the blocks get skipped, along with the instruction following them
+func (suite *StartosisInterpreterIdempotentTestSuite) TestInterpretAndOptimize_IdenticalBlocks() {
+	script := `def run(plan, args):
+	plan.if_(condition=True, then=lambda: plan.print("then"), else_=lambda: plan.print("else"))
+	plan.for_each(items=["a", "b"], fn=lambda item: plan.print("item " + item))
+	plan.parallel(branches=[lambda: plan.run_sh(run="echo first"), lambda: plan.run_sh(run="echo second")])
+	plan.print("after blocks")
+`
+	instructionSequence := suite.interpretTwice(script, script)
+	require.Equal(suite.T(), 4, len(instructionSequence))
+	for _, scheduledInstruction := range instructionSequence {
+		require.True(suite.T(), scheduledInstruction.IsExecuted(), "Instruction '%s' should be skipped", scheduledInstruction.GetInstruction().String())
+	}
+}
+
+// A block whose sub-plan changed can't be resolved, like any other updated instruction
+// Current plan ->     [`print("before block")`  `for_each(... print("item " + item))`  `print("after block")`]
+// Package to run ->   [`print("before block")`  `for_each(... print("element " + item))`  `print("after block")`]
+// Check that the package runs again in full
+func (suite *StartosisInterpreterIdempotentTestSuite) TestInterpretAndOptimize_BlockWithUpdatedSubPlan() {
+	initialScript := `def run(plan, args):
+	plan.print("before block")
+	plan.for_each(items=["a", "b"], fn=lambda item: plan.print("item " + item))
+	plan.print("after block")
+`
+	updatedScript := `def run(plan, args):
+	plan.print("before block")
+	plan.for_each(items=["a", "b"], fn=lambda item: plan.print("element " + item))
+	plan.print("after block")
+`
+	instructionSequence := suite.interpretTwice(initialScript, updatedScript)
+	require.Equal(suite.T(), 3, len(instructionSequence))
+	for _, scheduledInstruction := range instructionSequence {
+		require.False(suite.T(), scheduledInstruction.IsExecuted(), "Instruction '%s' should run again", scheduledInstruction.GetInstruction().String())
+	}
+}
+
+func (suite *StartosisInterpreterIdempotentTestSuite) interpretTwice(initialScript string, updatedScript string) []*instructions_plan.ScheduledInstruction {
+	_, currentEnclavePlan, interpretationApiErr := suite.interpreter.Interpret(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		noPackageReplaceOptions,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		initialScript,
+		noInputParams,
+		defaultNonBlockingMode,
+		enclave_structure.NewEnclaveComponents(),
+		resolver.NewInstructionsPlanMask(0),
+		image_download_mode.ImageDownloadMode_Missing)
+	require.Nil(suite.T(), interpretationApiErr)
+	convertedEnclavePlan := suite.convertInstructionPlanToEnclavePlan(currentEnclavePlan)
+
+	_, instructionsPlan, interpretationError := suite.interpreter.InterpretAndOptimizePlan(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		noPackageReplaceOptions,
+		useDefaultMainFunctionName,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		updatedScript,
+		noInputParams,
+		defaultNonBlockingMode,
+		convertedEnclavePlan,
+		image_download_mode.ImageDownloadMode_Missing,
+	)
+	require.Nil(suite.T(), interpretationError)
+
+	instructionSequence, err := instructionsPlan.GeneratePlan()
+	require.Nil(suite.T(), err)
+	return instructionSequence
+}
+
 func (suite *StartosisInterpreterIdempotentTestSuite) convertInstructionPlanToEnclavePlan(instructionPlan *instructions_plan.InstructionsPlan) *enclave_plan_persistence.EnclavePlan {
 	enclavePlan := enclave_plan_persistence.NewEnclavePlan()
 	instructionPlanSequence, interpretationErr := instructionPlan.GeneratePlan()
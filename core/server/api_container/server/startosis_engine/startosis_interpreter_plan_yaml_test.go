@@ -66,7 +66,8 @@ func (suite *StartosisIntepreterPlanYamlTestSuite) SetupTest() {
 	apiContainerInfo := service_network.NewApiContainerInfo(
 		net.IP{},
 		mockApicPortNum,
-		mockApicVersion)
+		mockApicVersion,
+		"")
 	suite.serviceNetwork.EXPECT().GetApiContainerInfo().Return(apiContainerInfo)
 
 	suite.interpreter = NewStartosisInterpreter(suite.serviceNetwork, suite.packageContentProvider, suite.runtimeValueStore, nil, "", suite.interpretationTimeValueStore)
@@ -216,6 +217,75 @@ tasks:
 	require.Equal(suite.T(), expectedYaml, planYaml)
 }
 
+func (suite *StartosisIntepreterPlanYamlTestSuite) TestRunContainer() {
+	script := `def run(plan, hi_files_artifact):
+    plan.run_container(
+        image = "gcr.io/distroless/static",
+        entrypoint = ["/bin/tool"],
+        cmd = ["--out", "/out/bye.txt"],
+        env_vars = {
+            "HELLO": "Hello!"
+        },
+        files = {
+            "/root": hi_files_artifact,
+        },
+        store=[
+            StoreSpec(src="/out/bye.txt", name="bye-file")
+        ],
+		description = "Run tool",
+    )
+`
+	inputArgs := `{"hi_files_artifact": "hi-file"}`
+	_, instructionsPlan, interpretationError := suite.interpreter.Interpret(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		noPackageReplaceOptions,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		script,
+		inputArgs,
+		defaultNonBlockingMode,
+		emptyEnclaveComponents,
+		emptyInstructionsPlanMask,
+		image_download_mode.ImageDownloadMode_Always)
+	require.Nil(suite.T(), interpretationError)
+	require.Equal(suite.T(), 1, instructionsPlan.Size())
+
+	planYaml, err := instructionsPlan.GenerateYaml(plan_yaml.CreateEmptyPlan(startosis_constants.PackageIdPlaceholderForStandaloneScript))
+	require.NoError(suite.T(), err)
+
+	expectedYaml := `packageId: DEFAULT_PACKAGE_ID_FOR_SCRIPT
+filesArtifacts:
+- uuid: "2"
+  name: hi-file
+- uuid: "3"
+  name: bye-file
+  files:
+  - /out/bye.txt
+tasks:
+- uuid: "1"
+  name: Run tool
+  taskType: container
+  command:
+  - /bin/tool
+  - --out
+  - /out/bye.txt
+  image: gcr.io/distroless/static
+  files:
+  - mountPath: /root
+    filesArtifacts:
+    - uuid: "2"
+      name: hi-file
+  store:
+  - uuid: "3"
+    name: bye-file
+  envVar:
+  - key: HELLO
+    value: Hello!
+`
+	require.Equal(suite.T(), expectedYaml, planYaml)
+}
+
 func (suite *StartosisIntepreterPlanYamlTestSuite) TestRunPython() {
 	script := `def run(plan, hi_files_artifact):
      plan.run_python(
@@ -366,6 +436,95 @@ tasks:
 	require.Equal(suite.T(), expectedYaml, planYaml)
 }
 
+func (suite *StartosisIntepreterPlanYamlTestSuite) TestIfListsBothBranches() {
+	script := `def run(plan, hi_files_artifact):
+	plan.add_service(
+		name="db",
+		config=ServiceConfig(
+			image="postgres:latest",
+			files = {
+				"/root": hi_files_artifact,
+			}
+		),
+	)
+	result = plan.exec(
+		service_name="db",
+		recipe=ExecRecipe(command=["pg_isready"]),
+		skip_code_check=True,
+		description="Check readiness"
+	)
+	plan.if_(
+		condition=result["code"],
+		assertion="==",
+		target_value=0,
+		then=lambda: plan.exec(service_name="db", recipe=ExecRecipe(command=["echo", "ready"]), description="Say ready"),
+		else_=lambda: plan.exec(service_name="db", recipe=ExecRecipe(command=["echo", "not ready"]), description="Say not ready"),
+	)
+`
+	inputArgs := `{"hi_files_artifact": "hi-file"}`
+	_, instructionsPlan, interpretationError := suite.interpreter.Interpret(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		noPackageReplaceOptions,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		script,
+		inputArgs,
+		defaultNonBlockingMode,
+		emptyEnclaveComponents,
+		emptyInstructionsPlanMask,
+		image_download_mode.ImageDownloadMode_Always)
+	require.Nil(suite.T(), interpretationError)
+	require.Equal(suite.T(), 3, instructionsPlan.Size())
+
+	planYaml, err := instructionsPlan.GenerateYaml(plan_yaml.CreateEmptyPlan(startosis_constants.PackageIdPlaceholderForStandaloneScript))
+	require.NoError(suite.T(), err)
+
+	expectedYaml := `packageId: DEFAULT_PACKAGE_ID_FOR_SCRIPT
+services:
+- uuid: "1"
+  name: db
+  image:
+    name: postgres:latest
+  files:
+  - mountPath: /root
+    filesArtifacts:
+    - uuid: "2"
+      name: hi-file
+filesArtifacts:
+- uuid: "2"
+  name: hi-file
+tasks:
+- uuid: "3"
+  name: Check readiness
+  taskType: exec
+  command:
+  - pg_isready
+  serviceName: db
+  acceptableCodes:
+  - 0
+- uuid: "4"
+  name: Say ready
+  taskType: exec
+  command:
+  - echo
+  - ready
+  serviceName: db
+  acceptableCodes:
+  - 0
+- uuid: "5"
+  name: Say not ready
+  taskType: exec
+  command:
+  - echo
+  - not ready
+  serviceName: db
+  acceptableCodes:
+  - 0
+`
+	require.Equal(suite.T(), expectedYaml, planYaml)
+}
+
 func (suite *StartosisIntepreterPlanYamlTestSuite) TestRenderTemplate() {
 	script := `def run(plan, args):
     bye_files_artifact = plan.render_templates(
@@ -8,6 +8,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/print_builtin"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/time_now_builtin"
@@ -26,8 +27,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/mock_package_content_provider"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.starlark.net/starlark"
 	"net"
 	"strings"
 	"testing"
@@ -1081,6 +1084,105 @@ def run(plan):
 	require.Nil(suite.T(), interpretationError)
 }
 
+func (suite *StartosisInterpreterTestSuite) TestStarlarkInterpreter_EncodingModules() {
+	script := `
+def run(plan):
+	config = yaml.decode("name: node\nports: [8080]\n")
+	plan.print(toml.encode({"node": config}))
+	plan.print(hashlib.sha256(base64.decode(base64.encode("kurtosis"))))
+	plan.print(hex.encode("ab"))
+`
+
+	_, instructionsPlan, interpretationError := suite.interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, useDefaultMainFunctionName, noPackageReplaceOptions, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, script, startosis_constants.EmptyInputArgs, defaultNonBlockingMode, emptyEnclaveComponents, emptyInstructionsPlanMask, defaultImageDownloadMode)
+	require.Nil(suite.T(), interpretationError)
+	require.Equal(suite.T(), 3, instructionsPlan.Size())
+
+	expectedOutput := `[node]
+name = 'node'
+ports = [8080]
+
+e72501931443584936731e88dc7eb0ca7d66391c06c02ccf72bfb213367accaa
+6162
+`
+	validateScriptOutputFromPrintInstructions(suite.T(), instructionsPlan, expectedOutput)
+}
+
+func (suite *StartosisInterpreterTestSuite) TestStarlarkInterpreter_IfAndForEachRunSubPlansAtExecutionTime() {
+	resultUuid, err := suite.runtimeValueStore.CreateValue()
+	require.NoError(suite.T(), err)
+	require.NoError(suite.T(), suite.runtimeValueStore.SetValue(resultUuid, map[string]starlark.Comparable{
+		"code":  starlark.MakeInt(1),
+		"items": starlark.NewList([]starlark.Value{starlark.String("a"), starlark.String("b")}),
+	}))
+	script := fmt.Sprintf(`
+def run(plan):
+	code = "{{kurtosis:%[1]s:code.runtime_value}}"
+	plan.if_(condition=code, assertion="==", target_value=0, then=lambda: plan.print("succeeded"), else_=lambda: plan.print("failed"))
+	plan.for_each(items="{{kurtosis:%[1]s:items.runtime_value}}", fn=lambda item: plan.print("item " + item))
+`, resultUuid)
+
+	_, instructionsPlan, interpretationError := suite.interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, useDefaultMainFunctionName, noPackageReplaceOptions, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, script, startosis_constants.EmptyInputArgs, defaultNonBlockingMode, emptyEnclaveComponents, emptyInstructionsPlanMask, defaultImageDownloadMode)
+	require.Nil(suite.T(), interpretationError)
+	// the instructions of the sub-plans belong to the instructions running them
+	require.Equal(suite.T(), 2, instructionsPlan.Size())
+	assertInstructionTypeAndPosition(suite.T(), instructionsPlan, 0, "if_", startosis_constants.PackageIdPlaceholderForStandaloneScript, 4, 10)
+	assertInstructionTypeAndPosition(suite.T(), instructionsPlan, 1, "for_each", startosis_constants.PackageIdPlaceholderForStandaloneScript, 5, 15)
+
+	scheduledInstructions, interpretationErr := instructionsPlan.GeneratePlan()
+	require.Nil(suite.T(), interpretationErr)
+	ifOutput, err := scheduledInstructions[0].GetInstruction().Execute(context.Background())
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "Condition '1 == 0' is False, ran the 'else_' branch\n  failed", *ifOutput)
+	forEachOutput, err := scheduledInstructions[1].GetInstruction().Execute(context.Background())
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "Ran the body of the loop for 2 items\n  Item '\"a\"' at index 0:\n    item a\n  Item '\"b\"' at index 1:\n    item b", *forEachOutput)
+}
+
+func (suite *StartosisInterpreterTestSuite) TestStarlarkInterpreter_IfBranchesAddingTheSameServiceAreValid() {
+	script := `
+def run(plan):
+	plan.if_(
+		condition=True,
+		then=lambda: plan.add_service(name="db", config=ServiceConfig(image="` + testContainerImageName + `")),
+		else_=lambda: plan.add_service(name="db", config=ServiceConfig(image="` + testContainerImageName + `", cmd=["--replica"])),
+	)
+`
+
+	_, instructionsPlan, interpretationError := suite.interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, useDefaultMainFunctionName, noPackageReplaceOptions, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, script, startosis_constants.EmptyInputArgs, defaultNonBlockingMode, emptyEnclaveComponents, emptyInstructionsPlanMask, defaultImageDownloadMode)
+	require.Nil(suite.T(), interpretationError)
+	scheduledInstructions, interpretationErr := instructionsPlan.GeneratePlan()
+	require.Nil(suite.T(), interpretationErr)
+	require.Len(suite.T(), scheduledInstructions, 1)
+
+	validatorEnvironment := startosis_validator.NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, 0, 0, false, defaultImageDownloadMode, args.KurtosisBackendType_Docker, nil)
+	require.NoError(suite.T(), scheduledInstructions[0].GetInstruction().ValidateAndUpdateEnvironment(validatorEnvironment))
+	require.Equal(suite.T(), startosis_validator.ComponentCreatedOrUpdatedDuringPackageRun, validatorEnvironment.DoesServiceNameExist("db"))
+}
+
+func (suite *StartosisInterpreterTestSuite) TestStarlarkInterpreter_IfFailsWhenBranchFailsInterpretation() {
+	script := `
+def run(plan):
+	plan.if_(condition=True, then=lambda: plan.print(unknown_variable))
+`
+
+	_, instructionsPlan, interpretationError := suite.interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, useDefaultMainFunctionName, noPackageReplaceOptions, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, script, startosis_constants.EmptyInputArgs, defaultNonBlockingMode, emptyEnclaveComponents, emptyInstructionsPlanMask, defaultImageDownloadMode)
+	require.Nil(suite.T(), instructionsPlan)
+	require.NotNil(suite.T(), interpretationError)
+	require.Contains(suite.T(), interpretationError.GetErrorMessage(), "unknown_variable")
+}
+
+func (suite *StartosisInterpreterTestSuite) TestStarlarkInterpreter_ParallelRejectsInstructionsNotAllowedInParallel() {
+	script := `
+def run(plan):
+	plan.parallel(branches=[lambda: plan.print("hello")])
+`
+
+	_, instructionsPlan, interpretationError := suite.interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, useDefaultMainFunctionName, noPackageReplaceOptions, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, script, startosis_constants.EmptyInputArgs, defaultNonBlockingMode, emptyEnclaveComponents, emptyInstructionsPlanMask, defaultImageDownloadMode)
+	require.Nil(suite.T(), instructionsPlan)
+	require.NotNil(suite.T(), interpretationError)
+	require.Contains(suite.T(), interpretationError.GetErrorMessage(), "Instruction 'print' of the branch at index 0 can't run in parallel")
+}
+
 // #####################################################################################################################
 //
 //	TEST HELPERS
@@ -0,0 +1,217 @@
+package git_package_content_provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// filenames for storing the config of a git host, the known hosts are stored separately as go-git reads them from a file
+	gitHostConfigFilenameFormat     = "git-host-%v.json"
+	gitHostKnownHostsFilenameFormat = "git-host-%v-known-hosts"
+	gitHostConfigFilenamesGlob      = "git-host-*.json"
+	gitHostKnownHostsFilenamesGlob  = "git-host-*-known-hosts"
+
+	// the config of a git host can contain private keys, so it's only readable by the APIC
+	gitHostConfigFilePerms = 0600
+
+	sshCloneUrlScheme   = "ssh"
+	defaultSshUser      = "git"
+	gitRepositorySuffix = ".git"
+
+	githubTokenUsername = "token"
+	noSshKeyPassword    = ""
+)
+
+// GitHostConfig describes how to clone the repositories of a git host, e.g. 'gitlab.com'
+// fields are public because it's needed for JSON encoding
+type GitHostConfig struct {
+	Host string `json:"host"`
+	// the URL the '<author>/<repository>.git' paths are appended to, defaults to 'https://<host>'
+	CloneUrlPrefix string `json:"clone_url_prefix,omitempty"`
+	Username       string `json:"username,omitempty"`
+	Password       string `json:"password,omitempty"`
+	SshPrivateKey  string `json:"ssh_private_key,omitempty"`
+	SshKnownHosts  string `json:"ssh_known_hosts,omitempty"`
+}
+
+func NewGitHostConfig(host string, cloneUrlPrefix string, username string, password string, sshPrivateKey string, sshKnownHosts string) *GitHostConfig {
+	return &GitHostConfig{
+		Host:           host,
+		CloneUrlPrefix: cloneUrlPrefix,
+		Username:       username,
+		Password:       password,
+		SshPrivateKey:  sshPrivateKey,
+		SshKnownHosts:  sshKnownHosts,
+	}
+}
+
+// ReplaceGitHostConfigs removes the configs stored by a previous run before storing the given ones, so that the
+// credentials of a git host are only used by the run they were sent with
+func (gitAuth *GitHubPackageAuthProvider) ReplaceGitHostConfigs(gitHostConfigs []*GitHostConfig) error {
+	for _, gitHostFilenamesGlob := range []string{gitHostConfigFilenamesGlob, gitHostKnownHostsFilenamesGlob} {
+		previousGitHostFilepaths, err := filepath.Glob(path.Join(gitAuth.githubAuthStorageDirPath, gitHostFilenamesGlob))
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred listing the files of the previously stored git host configs")
+		}
+		for _, previousGitHostFilepath := range previousGitHostFilepaths {
+			if err = os.Remove(previousGitHostFilepath); err != nil {
+				return stacktrace.Propagate(err, "An error occurred removing the previously stored git host file '%v'", previousGitHostFilepath)
+			}
+		}
+	}
+	for _, gitHostConfig := range gitHostConfigs {
+		if err := gitAuth.StoreGitHostConfig(gitHostConfig); err != nil {
+			return stacktrace.Propagate(err, "An error occurred storing the config of git host '%v'", gitHostConfig.Host)
+		}
+	}
+	return nil
+}
+
+func (gitAuth *GitHubPackageAuthProvider) StoreGitHostConfig(gitHostConfig *GitHostConfig) error {
+	serializedGitHostConfig, err := json.Marshal(gitHostConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the config of git host '%v'", gitHostConfig.Host)
+	}
+	gitHostConfigFilepath, err := gitAuth.getGitHostConfigFilepath(gitHostConfig.Host)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the file to store the config of git host '%v' in", gitHostConfig.Host)
+	}
+	if err = os.WriteFile(gitHostConfigFilepath, serializedGitHostConfig, gitHostConfigFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing the config of git host '%v'", gitHostConfig.Host)
+	}
+	if gitHostConfig.SshKnownHosts != "" {
+		gitHostKnownHostsFilepath, err := gitAuth.getGitHostKnownHostsFilepath(gitHostConfig.Host)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the file to store the SSH known hosts of git host '%v' in", gitHostConfig.Host)
+		}
+		if err = os.WriteFile(gitHostKnownHostsFilepath, []byte(gitHostConfig.SshKnownHosts), gitHostConfigFilePerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred storing the SSH known hosts of git host '%v'", gitHostConfig.Host)
+		}
+	}
+	logrus.Infof("Successfully stored the config of git host: '%v'", gitHostConfig.Host)
+	return nil
+}
+
+// GetGitHostConfig returns nil if no config was stored for the host
+func (gitAuth *GitHubPackageAuthProvider) GetGitHostConfig(host string) *GitHostConfig {
+	gitHostConfigFilepath, err := gitAuth.getGitHostConfigFilepath(host)
+	if err != nil {
+		return nil
+	}
+	serializedGitHostConfig, err := os.ReadFile(gitHostConfigFilepath)
+	if err != nil {
+		return nil
+	}
+	gitHostConfig := NewGitHostConfig(host, "", "", "", "", "")
+	if err = json.Unmarshal(serializedGitHostConfig, gitHostConfig); err != nil {
+		logrus.Warnf("Ignoring the config of git host '%v' as it couldn't be parsed:\n%v", host, err)
+		return nil
+	}
+	return gitHostConfig
+}
+
+func (gitAuth *GitHubPackageAuthProvider) getGitHostConfigFilepath(host string) (string, error) {
+	return gitAuth.getGitHostFilepath(gitHostConfigFilenameFormat, host)
+}
+
+func (gitAuth *GitHubPackageAuthProvider) getGitHostKnownHostsFilepath(host string) (string, error) {
+	return gitAuth.getGitHostFilepath(gitHostKnownHostsFilenameFormat, host)
+}
+
+// getGitHostFilepath errors if the host isn't a hostname with an optional port, as it's part of the filename
+func (gitAuth *GitHubPackageAuthProvider) getGitHostFilepath(filenameFormat string, host string) (string, error) {
+	if !shared_utils.IsValidGitHost(host) {
+		return "", stacktrace.NewError("Git host '%v' isn't valid, it must be a lowercase hostname with an optional port like 'gitlab.com' or 'gitea:3000'", host)
+	}
+	return path.Join(gitAuth.githubAuthStorageDirPath, fmt.Sprintf(filenameFormat, host)), nil
+}
+
+// getCloneUrlAndAuth returns the URL to clone the repository from and how to authenticate against it
+// A token given for the package takes precedence over the config of its host; the token of the GitHub user is only
+// used for GitHub repositories when nothing else is configured
+func (provider *GitPackageContentProvider) getCloneUrlAndAuth(parsedURL *shared_utils.ParsedGitURL, packageId string) (string, transport.AuthMethod, *startosis_errors.InterpretationError) {
+	gitHostConfig := provider.githubAuthProvider.GetGitHostConfig(parsedURL.GetHost())
+	cloneUrl := parsedURL.GetGitURL()
+	if gitHostConfig != nil && gitHostConfig.CloneUrlPrefix != "" {
+		cloneUrl = strings.TrimSuffix(gitHostConfig.CloneUrlPrefix, shared_utils.UrlPathSeparator) + shared_utils.UrlPathSeparator +
+			path.Join(parsedURL.GetRepositoryAuthor(), parsedURL.GetRepositoryName()) + gitRepositorySuffix
+	}
+
+	if packageToken := provider.githubAuthProvider.GetGitHubTokenForPackage(packageId); packageToken != "" {
+		return cloneUrl, newTokenAuth(packageToken), nil
+	}
+
+	if gitHostConfig != nil {
+		parsedCloneUrl, err := url.Parse(cloneUrl)
+		if err != nil {
+			return "", nil, startosis_errors.WrapWithInterpretationError(err, "The URL '%v' to clone '%v' from isn't valid; check the clone URL configured for git host '%v'", cloneUrl, parsedURL.GetRepositoryLocator(), parsedURL.GetHost())
+		}
+		if parsedCloneUrl.Scheme == sshCloneUrlScheme {
+			sshAuth, interpretationErr := provider.getSshAuth(gitHostConfig, parsedCloneUrl)
+			if interpretationErr != nil {
+				return "", nil, interpretationErr
+			}
+			return cloneUrl, sshAuth, nil
+		}
+		if gitHostConfig.Password != "" {
+			return cloneUrl, &http.BasicAuth{
+				Username: gitHostConfig.Username,
+				Password: gitHostConfig.Password,
+			}, nil
+		}
+	}
+
+	if parsedURL.GetHost() == shared_utils.GithubDomainPrefix {
+		if userToken := provider.githubAuthProvider.GetGitHubTokenForUser(); userToken != "" {
+			return cloneUrl, newTokenAuth(userToken), nil
+		}
+	}
+	return cloneUrl, nil, nil
+}
+
+func (provider *GitPackageContentProvider) getSshAuth(gitHostConfig *GitHostConfig, parsedCloneUrl *url.URL) (transport.AuthMethod, *startosis_errors.InterpretationError) {
+	if gitHostConfig.SshPrivateKey == "" {
+		return nil, startosis_errors.NewInterpretationError("Git host '%v' is configured to be cloned from over SSH but no SSH private key is configured for it", gitHostConfig.Host)
+	}
+	if gitHostConfig.SshKnownHosts == "" {
+		return nil, startosis_errors.NewInterpretationError("Git host '%v' is configured to be cloned from over SSH but no SSH known hosts are configured to verify it", gitHostConfig.Host)
+	}
+	sshUser := defaultSshUser
+	if parsedCloneUrl.User != nil && parsedCloneUrl.User.Username() != "" {
+		sshUser = parsedCloneUrl.User.Username()
+	}
+	sshAuth, err := ssh.NewPublicKeys(sshUser, []byte(gitHostConfig.SshPrivateKey), noSshKeyPassword)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing the SSH private key configured for git host '%v'", gitHostConfig.Host)
+	}
+	gitHostKnownHostsFilepath, err := provider.githubAuthProvider.getGitHostKnownHostsFilepath(gitHostConfig.Host)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred getting the SSH known hosts file of git host '%v'", gitHostConfig.Host)
+	}
+	hostKeyCallback, err := ssh.NewKnownHostsCallback(gitHostKnownHostsFilepath)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing the SSH known hosts configured for git host '%v'", gitHostConfig.Host)
+	}
+	sshAuth.HostKeyCallback = hostKeyCallback
+	return sshAuth, nil
+}
+
+func newTokenAuth(token string) *http.BasicAuth {
+	return &http.BasicAuth{
+		Username: githubTokenUsername,
+		Password: token,
+	}
+}
@@ -0,0 +1,142 @@
+package git_package_content_provider
+
+import (
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+const (
+	testGitHost          = "git.example.com"
+	testGitHostPackageId = "git.example.com/test-org/test-package"
+	testMainStarContents = "def run(plan):\n    pass\n"
+	testRepoFilePerms    = 0644
+)
+
+func TestGitPackageProvider_ClonesFromConfiguredGitHost(t *testing.T) {
+	reposDir := t.TempDir()
+	createBareTestRepository(t, path.Join(reposDir, "test-org", "test-package.git"))
+
+	githubAuthProvider := NewGitHubPackageAuthProvider(t.TempDir())
+	gitHostConfig := NewGitHostConfig(testGitHost, "file://"+reposDir, "", "", "", "")
+	require.NoError(t, githubAuthProvider.StoreGitHostConfig(gitHostConfig))
+
+	packageDir := t.TempDir()
+	provider := NewGitPackageContentProvider(packageDir, t.TempDir(), githubAuthProvider, nil)
+
+	mainStarLocator := startosis_packages.NewPackageAbsoluteLocator(testGitHostPackageId+"/main.star", defaultMainBranch)
+	contents, interpretationErr := provider.GetModuleContents(mainStarLocator)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, testMainStarContents, contents)
+
+	// repositories of hosts other than GitHub are stored under their host
+	_, err := os.Stat(path.Join(packageDir, testGitHost, "test-org", "test-package", "kurtosis.yml"))
+	require.NoError(t, err)
+}
+
+func TestGitPackageProvider_FailsForUnreachableGitHost(t *testing.T) {
+	githubAuthProvider := NewGitHubPackageAuthProvider(t.TempDir())
+	gitHostConfig := NewGitHostConfig(testGitHost, "file://"+t.TempDir(), "", "", "", "")
+	require.NoError(t, githubAuthProvider.StoreGitHostConfig(gitHostConfig))
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), githubAuthProvider, nil)
+
+	mainStarLocator := startosis_packages.NewPackageAbsoluteLocator(testGitHostPackageId+"/main.star", defaultMainBranch)
+	_, interpretationErr := provider.GetModuleContents(mainStarLocator)
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), "configured in the 'git-hosts' section")
+}
+
+func TestGetCloneUrlAndAuth(t *testing.T) {
+	githubAuthProvider := NewGitHubPackageAuthProvider(t.TempDir())
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), githubAuthProvider, nil)
+
+	parsedURL, err := shared_utils.ParseGitURL(testGitHostPackageId)
+	require.NoError(t, err)
+
+	cloneUrl, auth, interpretationErr := provider.getCloneUrlAndAuth(parsedURL, testGitHostPackageId)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, "https://git.example.com/test-org/test-package.git", cloneUrl)
+	require.Nil(t, auth)
+
+	gitHostConfig := NewGitHostConfig(testGitHost, "https://git.example.com:3000/", "user", "password", "", "")
+	require.NoError(t, githubAuthProvider.StoreGitHostConfig(gitHostConfig))
+	cloneUrl, auth, interpretationErr = provider.getCloneUrlAndAuth(parsedURL, testGitHostPackageId)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, "https://git.example.com:3000/test-org/test-package.git", cloneUrl)
+	require.Equal(t, &http.BasicAuth{Username: "user", Password: "password"}, auth)
+
+	// the token given for the package takes precedence
+	require.NoError(t, githubAuthProvider.StoreGitHubTokenForPackage(testGitHostPackageId, "package-token"))
+	_, auth, interpretationErr = provider.getCloneUrlAndAuth(parsedURL, testGitHostPackageId)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, newTokenAuth("package-token"), auth)
+}
+
+func TestGetCloneUrlAndAuth_FailsForSshWithoutKnownHosts(t *testing.T) {
+	githubAuthProvider := NewGitHubPackageAuthProvider(t.TempDir())
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), githubAuthProvider, nil)
+
+	parsedURL, err := shared_utils.ParseGitURL(testGitHostPackageId)
+	require.NoError(t, err)
+
+	gitHostConfig := NewGitHostConfig(testGitHost, "ssh://git@git.example.com:2222", "", "", "private-key", "")
+	require.NoError(t, githubAuthProvider.StoreGitHostConfig(gitHostConfig))
+	_, _, interpretationErr := provider.getCloneUrlAndAuth(parsedURL, testGitHostPackageId)
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), "no SSH known hosts are configured")
+}
+
+func TestReplaceGitHostConfigs_RemovesTheConfigsOfThePreviousRun(t *testing.T) {
+	githubAuthProvider := NewGitHubPackageAuthProvider(t.TempDir())
+	require.NoError(t, githubAuthProvider.ReplaceGitHostConfigs([]*GitHostConfig{
+		NewGitHostConfig(testGitHost, "", "user", "password", "", "known-hosts"),
+	}))
+	require.NotNil(t, githubAuthProvider.GetGitHostConfig(testGitHost))
+
+	otherGitHost := "gitea:3000"
+	require.NoError(t, githubAuthProvider.ReplaceGitHostConfigs([]*GitHostConfig{
+		NewGitHostConfig(otherGitHost, "", "user", "password", "", ""),
+	}))
+	require.Nil(t, githubAuthProvider.GetGitHostConfig(testGitHost))
+	require.NotNil(t, githubAuthProvider.GetGitHostConfig(otherGitHost))
+	knownHostsFilepath, err := githubAuthProvider.getGitHostKnownHostsFilepath(testGitHost)
+	require.NoError(t, err)
+	_, err = os.Stat(knownHostsFilepath)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestStoreGitHostConfig_FailsForInvalidHost(t *testing.T) {
+	githubAuthProvider := NewGitHubPackageAuthProvider(t.TempDir())
+	err := githubAuthProvider.StoreGitHostConfig(NewGitHostConfig("../../etc", "", "user", "password", "", ""))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "isn't valid")
+}
+
+func createBareTestRepository(t *testing.T, bareRepoPath string) {
+	workDir := t.TempDir()
+	repo, err := git.PlainInit(workDir, false)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path.Join(workDir, "kurtosis.yml"), []byte("name: "+testGitHostPackageId+"\n"), testRepoFilePerms))
+	require.NoError(t, os.WriteFile(path.Join(workDir, "main.star"), []byte(testMainStarContents), testRepoFilePerms))
+
+	workTree, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = workTree.Add(".")
+	require.NoError(t, err)
+	//nolint:exhaustruct
+	_, err = workTree.Commit("Initial commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	//nolint:exhaustruct
+	_, err = git.PlainClone(bareRepoPath, true, &git.CloneOptions{URL: workDir})
+	require.NoError(t, err)
+}
@@ -5,7 +5,7 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/transport"
-	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/user_support_constants"
@@ -21,6 +21,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -41,8 +42,7 @@ const (
 	// this gets us the entire history - useful for fetching commits on a repo
 	depthAssumingBranchTagsCommitsAreSpecified = 0
 
-	filePathToKurtosisOrComposeYamlNotFound  = ""
-	replaceCountPackageDirWithGithubConstant = 1
+	filePathToKurtosisOrComposeYamlNotFound = ""
 
 	osPathSeparatorString = string(os.PathSeparator)
 
@@ -60,6 +60,16 @@ type GitPackageContentProvider struct {
 	repositoriesDir                 string
 	packageReplaceOptionsRepository *packageReplaceOptionsRepository
 	githubAuthProvider              *GitHubPackageAuthProvider
+
+	// the lock of the package being run, nil if it doesn't have one
+	packageLock *package_lock.PackageLock
+	// repository locator -> version, commit and content hash of what is cloned on disk
+	clonedPackages map[string]*package_lock.LockedPackage
+	// repository locator -> version, commit and content hash of what the current run uses
+	resolvedPackages map[string]*package_lock.LockedPackage
+	// repositories whose content was uploaded rather than cloned; the lock doesn't apply to them
+	uploadedRepositories map[string]bool
+	packageLockMutex     *sync.RWMutex
 }
 
 func NewGitPackageContentProvider(repositoriesDir, tmpDir string, githubAuthProvider *GitHubPackageAuthProvider, enclaveDb *enclave_db.EnclaveDB) *GitPackageContentProvider {
@@ -68,6 +78,11 @@ func NewGitPackageContentProvider(repositoriesDir, tmpDir string, githubAuthProv
 		repositoriesTmpDir:              tmpDir,
 		githubAuthProvider:              githubAuthProvider,
 		packageReplaceOptionsRepository: newPackageReplaceOptionsRepository(enclaveDb),
+		packageLock:                     nil,
+		clonedPackages:                  map[string]*package_lock.LockedPackage{},
+		resolvedPackages:                map[string]*package_lock.LockedPackage{},
+		uploadedRepositories:            map[string]bool{},
+		packageLockMutex:                &sync.RWMutex{},
 	}
 }
 
@@ -77,7 +92,7 @@ func (provider *GitPackageContentProvider) ClonePackage(packageId string) (strin
 		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing Git URL for package ID '%s'", packageId)
 	}
 
-	if interpretationError := provider.atomicClone(parsedURL, provider.getGitHubAuthToken(packageId)); interpretationError != nil {
+	if interpretationError := provider.atomicClone(parsedURL, packageId); interpretationError != nil {
 		return "", interpretationError
 	}
 
@@ -128,21 +143,30 @@ func (provider *GitPackageContentProvider) getOnDiskAbsolutePath(absoluteLocator
 		pathToFileOnDisk = pathToPackageOnDisk
 	}
 
-	// Return the file path straight if it exists
-	if _, err := os.Stat(pathToFileOnDisk); err == nil {
-		return pathToFileOnDisk, nil
+	// What is on disk can only be used if it's the commit locked by the package lock, if any
+	shouldCloneToHonorPackageLock, interpretationError := provider.shouldCloneToHonorPackageLock(parsedURL)
+	if interpretationError != nil {
+		return "", interpretationError
 	}
 
-	// Check if the repo exists
-	// If the repo exists but the `pathToFileOnDisk` doesn't exist, the locator is invalid
-	if _, err := os.Stat(pathToPackageOnDisk); err == nil {
-		relativeFilePathWithoutPackageName := strings.Replace(parsedURL.GetRelativeFilePath(), parsedURL.GetRelativeRepoPath(), replacedWithEmptyString, onlyOneReplacement)
-		return "", startosis_errors.NewInterpretationError("'%v' doesn't exist in the package '%v'", relativeFilePathWithoutPackageName, parsedURL.GetRelativeRepoPath())
+	if !shouldCloneToHonorPackageLock {
+		// Return the file path straight if it exists
+		if _, err := os.Stat(pathToFileOnDisk); err == nil {
+			provider.recordReusedRepository(parsedURL)
+			return pathToFileOnDisk, nil
+		}
+
+		// Check if the repo exists
+		// If the repo exists but the `pathToFileOnDisk` doesn't exist, the locator is invalid
+		if _, err := os.Stat(pathToPackageOnDisk); err == nil {
+			relativeFilePathWithoutPackageName := strings.Replace(parsedURL.GetRelativeFilePath(), parsedURL.GetRelativeRepoPath(), replacedWithEmptyString, onlyOneReplacement)
+			return "", startosis_errors.NewInterpretationError("'%v' doesn't exist in the package '%v'", relativeFilePathWithoutPackageName, parsedURL.GetRelativeRepoPath())
+		}
 	}
 
 	// Otherwise clone the repo and return the absolute path of the requested file
 	emptyPackageId := ""
-	if interpretationError := provider.atomicClone(parsedURL, provider.getGitHubAuthToken(emptyPackageId)); interpretationError != nil {
+	if interpretationError := provider.atomicClone(parsedURL, emptyPackageId); interpretationError != nil {
 		return "", interpretationError
 	}
 
@@ -242,6 +266,7 @@ func (provider *GitPackageContentProvider) StorePackageContents(packageId string
 	if err != nil {
 		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred while unarchiving '%v' to '%v'", tempFile.Name(), packageAbsolutePathOnDisk)
 	}
+	provider.recordUploadedRepository(parsedPackageId)
 
 	return packageAbsolutePathOnDisk, nil
 }
@@ -329,7 +354,7 @@ func (provider *GitPackageContentProvider) CloneReplacedPackagesIfNeeded(current
 
 // atomicClone This first clones to a temporary directory and then moves it into the package file system
 // TODO make this support versioning via tags, commit hashes or branches
-func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.ParsedGitURL, githubAuthToken string) *startosis_errors.InterpretationError {
+func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.ParsedGitURL, packageId string) *startosis_errors.InterpretationError {
 	// First we clone into a temporary directory
 	tempRepoDirPath, err := os.MkdirTemp(provider.repositoriesTmpDir, temporaryRepoDirPattern)
 	if err != nil {
@@ -338,28 +363,40 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.P
 	defer os.RemoveAll(tempRepoDirPath)
 	gitClonePath := path.Join(tempRepoDirPath, parsedURL.GetRelativeRepoPath())
 
+	// a locked repository is always checked out at its locked commit, whatever the requested version points to now
+	lockedPackage, interpretationError := provider.getLockedPackage(parsedURL)
+	if interpretationError != nil {
+		return interpretationError
+	}
+	versionToCheckout := parsedURL.GetTagBranchOrCommit()
+	if lockedPackage != nil {
+		versionToCheckout = lockedPackage.Commit
+	}
+
 	depth := defaultDepth
-	if parsedURL.GetTagBranchOrCommit() != emptyTagBranchOrCommit {
+	if versionToCheckout != emptyTagBranchOrCommit {
 		depth = depthAssumingBranchTagsCommitsAreSpecified
 	}
 
-	var githubAuth *http.BasicAuth
-	if githubAuthToken != "" {
-		githubAuth = &http.BasicAuth{
-			Username: "token",
-			Password: githubAuthToken,
-		}
+	cloneUrl, auth, interpretationError := provider.getCloneUrlAndAuth(parsedURL, packageId)
+	if interpretationError != nil {
+		return interpretationError
 	}
 
-	repo, interpretationError := provider.cloneWithRetries(parsedURL, gitClonePath, githubAuth, depth)
+	repo, interpretationError := provider.cloneWithRetries(parsedURL, cloneUrl, gitClonePath, auth, depth)
 	if interpretationError != nil {
 		return interpretationError
 	}
 
-	if parsedURL.GetTagBranchOrCommit() != emptyTagBranchOrCommit {
-		referenceTagOrBranch, found, referenceErr := getReferenceName(repo, parsedURL)
-		if err != nil {
-			return referenceErr
+	if versionToCheckout != emptyTagBranchOrCommit {
+		var referenceTagOrBranch plumbing.ReferenceName
+		found := false
+		if lockedPackage == nil {
+			var referenceErr *startosis_errors.InterpretationError
+			referenceTagOrBranch, found, referenceErr = getReferenceName(repo, parsedURL)
+			if referenceErr != nil {
+				return referenceErr
+			}
 		}
 
 		checkoutOptions := &git.CheckoutOptions{
@@ -374,11 +411,11 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.P
 			// if we have a tag or branch we set it
 			checkoutOptions.Branch = referenceTagOrBranch
 		} else {
-			maybeHash := plumbing.NewHash(parsedURL.GetTagBranchOrCommit())
+			maybeHash := plumbing.NewHash(versionToCheckout)
 			// check whether the hash is a valid hash
 			_, err = repo.CommitObject(maybeHash)
 			if err != nil {
-				return startosis_errors.NewInterpretationError("Tried using the passed version '%v' as commit as we couldn't find a tag/branch for it in the repo '%v' but failed", parsedURL.GetTagBranchOrCommit(), parsedURL.GetGitURL())
+				return startosis_errors.NewInterpretationError("Tried using the passed version '%v' as commit as we couldn't find a tag/branch for it in the repo '%v' but failed", versionToCheckout, parsedURL.GetGitURL())
 			}
 			checkoutOptions.Hash = maybeHash
 		}
@@ -389,19 +426,28 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.P
 		}
 
 		if err := workTree.Checkout(checkoutOptions); err != nil {
-			return startosis_errors.NewInterpretationError("Tried checking out '%v' on repository '%v' but failed", parsedURL.GetTagBranchOrCommit(), parsedURL.GetGitURL())
+			return startosis_errors.NewInterpretationError("Tried checking out '%v' on repository '%v' but failed", versionToCheckout, parsedURL.GetGitURL())
 		}
 	}
 
+	head, err := repo.Head()
+	if err != nil {
+		return startosis_errors.WrapWithInterpretationError(err, "An error occurred getting the commit checked out for repository '%v'", parsedURL.GetGitURL())
+	}
+	contentHash, interpretationError := verifyContentHash(parsedURL, gitClonePath, lockedPackage)
+	if interpretationError != nil {
+		return interpretationError
+	}
+
 	// Then we move it into the target directory
-	packageAuthorPath := path.Join(provider.repositoriesDir, parsedURL.GetRepositoryAuthor())
 	packagePath := path.Join(provider.repositoriesDir, parsedURL.GetRelativeRepoPath())
+	packageAuthorPath := path.Dir(packagePath)
 	fileMode, err := os.Stat(packageAuthorPath)
 	if err == nil && !fileMode.IsDir() {
 		return startosis_errors.WrapWithInterpretationError(err, "Expected '%s' to be a directory but it is something else", packageAuthorPath)
 	}
 	if err != nil {
-		if err = os.Mkdir(packageAuthorPath, moduleDirPermission); err != nil {
+		if err = os.MkdirAll(packageAuthorPath, moduleDirPermission); err != nil {
 			return startosis_errors.WrapWithInterpretationError(err, "Cloning the repository '%s' failed. An error occurred while creating the directory '%s'.", parsedURL.GetGitURL(), packageAuthorPath)
 		}
 	}
@@ -414,10 +460,16 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *shared_utils.P
 	if err = os.Rename(gitClonePath, packagePath); err != nil {
 		return startosis_errors.NewInterpretationError("Cloning the repository '%s' failed. An error occurred while moving repository at temporary destination '%s' to final destination '%s'", parsedURL.GetGitURL(), gitClonePath, packagePath)
 	}
+
+	resolvedVersion := parsedURL.GetTagBranchOrCommit()
+	if lockedPackage != nil {
+		resolvedVersion = lockedPackage.Version
+	}
+	provider.recordClonedRepository(parsedURL, resolvedVersion, head.Hash().String(), contentHash)
 	return nil
 }
 
-func (provider *GitPackageContentProvider) cloneWithRetries(parsedURL *shared_utils.ParsedGitURL, gitClonePath string, githubAuth *http.BasicAuth, depth int) (*git.Repository, *startosis_errors.InterpretationError) {
+func (provider *GitPackageContentProvider) cloneWithRetries(parsedURL *shared_utils.ParsedGitURL, cloneUrl string, gitClonePath string, auth transport.AuthMethod, depth int) (*git.Repository, *startosis_errors.InterpretationError) {
 	retryDelay := retryDelayStartValue
 
 	var repo *git.Repository
@@ -429,8 +481,8 @@ func (provider *GitPackageContentProvider) cloneWithRetries(parsedURL *shared_ut
 		//TODO and the Starlark package could be just a small sub-folder inside a giant mono-repository
 		//TODO and even now, in the upload_files instruction, we are allowing to upload files or a folder for any repository, but we are cloning the entire repository for this
 		repo, err = git.PlainClone(gitClonePath, isNotBareClone, &git.CloneOptions{
-			URL:               parsedURL.GetGitURL(),
-			Auth:              githubAuth,
+			URL:               cloneUrl,
+			Auth:              auth,
 			RemoteName:        "",
 			ReferenceName:     "",
 			SingleBranch:      false,
@@ -464,24 +516,15 @@ func (provider *GitPackageContentProvider) cloneWithRetries(parsedURL *shared_ut
 	if err != nil {
 		// We silence the underlying error here as it can be confusing to the user. For example, when there's a typo in
 		// the repo name, pointing to a non existing repo, the underlying error is: "authentication required"
-		logrus.Errorf("Error cloning git repository: '%s' to '%s'. Error was: \n%s", parsedURL.GetGitURL(), gitClonePath, err.Error())
-		return nil, startosis_errors.NewInterpretationError("Error in cloning git repository '%s' to '%s'. Make sure that '%v' exists or if it's a private repository, that you are logged into GitHub via `kurtosis github login`.", parsedURL.GetGitURL(), gitClonePath, parsedURL.GetGitURL())
+		logrus.Errorf("Error cloning git repository: '%s' to '%s'. Error was: \n%s", cloneUrl, gitClonePath, err.Error())
+		if parsedURL.GetHost() == shared_utils.GithubDomainPrefix {
+			return nil, startosis_errors.NewInterpretationError("Error in cloning git repository '%s' to '%s'. Make sure that '%v' exists or if it's a private repository, that you are logged into GitHub via `kurtosis github login`.", cloneUrl, gitClonePath, cloneUrl)
+		}
+		return nil, startosis_errors.NewInterpretationError("Error in cloning git repository '%s' to '%s'. Make sure that '%v' exists or if it's a private repository, that credentials for '%v' are configured in the 'git-hosts' section of the Kurtosis config.", cloneUrl, gitClonePath, cloneUrl, parsedURL.GetHost())
 	}
 	return repo, nil
 }
 
-// Returns empty string if no token found by [githubAuthProvider]
-// If packageId is empty string, only checks for and returns github token for the user if it exists
-func (provider *GitPackageContentProvider) getGitHubAuthToken(packageId string) string {
-	var githubAuthToken string
-	githubAuthToken = provider.githubAuthProvider.GetGitHubTokenForPackage(packageId)
-	if githubAuthToken != "" {
-		return githubAuthToken
-	}
-	githubAuthToken = provider.githubAuthProvider.GetGitHubTokenForUser()
-	return githubAuthToken
-}
-
 // methods checks whether the root of the package is same as repository root
 // or it is a sub-folder under it
 func getPathToPackageRoot(parsedPackagePath *shared_utils.ParsedGitURL) string {
@@ -546,7 +589,7 @@ func validateAndGetKurtosisYaml(absPathToKurtosisYmlInThePackage string, package
 // this method validates whether the package name found in kurtosis yml is same as the location where kurtosis.yml is found
 func validatePackageNameMatchesKurtosisYamlLocation(kurtosisYaml *yaml_parser.KurtosisYaml, absPathToKurtosisYmlInThePackage string, packageDir string) *startosis_errors.InterpretationError {
 	// get package name from absolute path to package
-	packageNameFromAbsPackagePath := strings.Replace(absPathToKurtosisYmlInThePackage, packageDir, shared_utils.GithubDomainPrefix, replaceCountPackageDirWithGithubConstant)
+	packageNameFromAbsPackagePath := shared_utils.GetLocatorFromRelativePath(strings.TrimPrefix(absPathToKurtosisYmlInThePackage, packageDir))
 	packageName := kurtosisYaml.GetPackageName()
 
 	if strings.HasSuffix(packageName, osPathSeparatorString) {
@@ -0,0 +1,113 @@
+package git_package_content_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+)
+
+const (
+	updateLockFileHint  = "Run 'kurtosis package lock' to update it"
+	upgradeLockFileHint = "run 'kurtosis package lock --upgrade' to update it"
+)
+
+func (provider *GitPackageContentProvider) SetPackageLock(packageLock *package_lock.PackageLock) {
+	provider.packageLockMutex.Lock()
+	defer provider.packageLockMutex.Unlock()
+	provider.packageLock = packageLock
+}
+
+func (provider *GitPackageContentProvider) ResetPackageResolution() {
+	provider.packageLockMutex.Lock()
+	defer provider.packageLockMutex.Unlock()
+	provider.packageLock = nil
+	provider.resolvedPackages = map[string]*package_lock.LockedPackage{}
+}
+
+func (provider *GitPackageContentProvider) GetResolvedPackages() map[string]*package_lock.LockedPackage {
+	provider.packageLockMutex.RLock()
+	defer provider.packageLockMutex.RUnlock()
+	resolvedPackages := map[string]*package_lock.LockedPackage{}
+	for repositoryLocator, resolvedPackage := range provider.resolvedPackages {
+		resolvedPackages[repositoryLocator] = resolvedPackage
+	}
+	return resolvedPackages
+}
+
+// getLockedPackage returns the locked version of the repository the URL points to, nil if the repository isn't locked
+// or if its content was uploaded rather than cloned (i.e. it's the package being run or it's replaced by a local package)
+func (provider *GitPackageContentProvider) getLockedPackage(parsedURL *shared_utils.ParsedGitURL) (*package_lock.LockedPackage, *startosis_errors.InterpretationError) {
+	provider.packageLockMutex.RLock()
+	defer provider.packageLockMutex.RUnlock()
+	repositoryLocator := parsedURL.GetRepositoryLocator()
+	if provider.packageLock == nil || provider.uploadedRepositories[repositoryLocator] {
+		return nil, nil
+	}
+	lockedPackage, found := provider.packageLock.GetLockedPackage(repositoryLocator)
+	if !found {
+		return nil, nil
+	}
+	requestedVersion := parsedURL.GetTagBranchOrCommit()
+	if requestedVersion != emptyTagBranchOrCommit && requestedVersion != lockedPackage.Version && requestedVersion != lockedPackage.Commit {
+		return nil, startosis_errors.NewInterpretationError("Version '%v' of '%v' is requested but '%v' locks it at version '%v'. %v", requestedVersion, repositoryLocator, package_lock.LockFileName, lockedPackage.Version, updateLockFileHint)
+	}
+	return lockedPackage, nil
+}
+
+// shouldCloneToHonorPackageLock returns true if the repository is locked but what is on disk, if anything, isn't the
+// locked commit
+func (provider *GitPackageContentProvider) shouldCloneToHonorPackageLock(parsedURL *shared_utils.ParsedGitURL) (bool, *startosis_errors.InterpretationError) {
+	lockedPackage, interpretationErr := provider.getLockedPackage(parsedURL)
+	if interpretationErr != nil {
+		return false, interpretationErr
+	}
+	if lockedPackage == nil {
+		return false, nil
+	}
+	provider.packageLockMutex.RLock()
+	defer provider.packageLockMutex.RUnlock()
+	clonedPackage, found := provider.clonedPackages[parsedURL.GetRepositoryLocator()]
+	return !found || clonedPackage.Commit != lockedPackage.Commit, nil
+}
+
+// verifyContentHash computes the content hash of a fresh clone and makes sure it matches the lock, if the repository
+// is locked
+func verifyContentHash(parsedURL *shared_utils.ParsedGitURL, gitClonePath string, lockedPackage *package_lock.LockedPackage) (string, *startosis_errors.InterpretationError) {
+	contentHash, err := package_lock.ComputeContentHash(gitClonePath)
+	if err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred computing the content hash of repository '%v'", parsedURL.GetGitURL())
+	}
+	if lockedPackage != nil && contentHash != lockedPackage.Hash {
+		return "", startosis_errors.NewInterpretationError("The content of '%v' at commit '%v' has hash '%v' but '%v' expects '%v'. The repository history might have been rewritten; if this change is expected, %v", parsedURL.GetRepositoryLocator(), lockedPackage.Commit, contentHash, package_lock.LockFileName, lockedPackage.Hash, upgradeLockFileHint)
+	}
+	return contentHash, nil
+}
+
+func (provider *GitPackageContentProvider) recordClonedRepository(parsedURL *shared_utils.ParsedGitURL, version string, commit string, contentHash string) {
+	provider.packageLockMutex.Lock()
+	defer provider.packageLockMutex.Unlock()
+	repositoryLocator := parsedURL.GetRepositoryLocator()
+	delete(provider.uploadedRepositories, repositoryLocator)
+	clonedPackage := package_lock.NewLockedPackage(version, commit, contentHash)
+	provider.clonedPackages[repositoryLocator] = clonedPackage
+	provider.resolvedPackages[repositoryLocator] = clonedPackage
+}
+
+// recordReusedRepository marks the repository, cloned by a previous run, as used by the current run
+func (provider *GitPackageContentProvider) recordReusedRepository(parsedURL *shared_utils.ParsedGitURL) {
+	provider.packageLockMutex.Lock()
+	defer provider.packageLockMutex.Unlock()
+	repositoryLocator := parsedURL.GetRepositoryLocator()
+	if clonedPackage, found := provider.clonedPackages[repositoryLocator]; found {
+		provider.resolvedPackages[repositoryLocator] = clonedPackage
+	}
+}
+
+func (provider *GitPackageContentProvider) recordUploadedRepository(parsedURL *shared_utils.ParsedGitURL) {
+	provider.packageLockMutex.Lock()
+	defer provider.packageLockMutex.Unlock()
+	repositoryLocator := parsedURL.GetRepositoryLocator()
+	delete(provider.clonedPackages, repositoryLocator)
+	delete(provider.resolvedPackages, repositoryLocator)
+	provider.uploadedRepositories[repositoryLocator] = true
+}
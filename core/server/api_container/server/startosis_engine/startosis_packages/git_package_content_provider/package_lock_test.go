@@ -0,0 +1,113 @@
+package git_package_content_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+)
+
+const (
+	lockedRepositoryLocator = "github.com/kurtosis-tech/sample-dependency-package"
+	lockedVersion           = "v0.1.0"
+	lockedCommit            = "0123456789abcdef0123456789abcdef01234567"
+	lockedHash              = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+)
+
+func newProviderWithPackageLock(t *testing.T) *GitPackageContentProvider {
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), nil, nil)
+	provider.SetPackageLock(package_lock.NewPackageLock(map[string]*package_lock.LockedPackage{
+		lockedRepositoryLocator: package_lock.NewLockedPackage(lockedVersion, lockedCommit, lockedHash),
+	}))
+	return provider
+}
+
+func TestGetLockedPackage_ReturnsLockedCommit(t *testing.T) {
+	provider := newProviderWithPackageLock(t)
+	parsedURL, err := shared_utils.ParseGitURL(lockedRepositoryLocator + "/main.star")
+	require.NoError(t, err)
+
+	lockedPackage, interpretationErr := provider.getLockedPackage(parsedURL)
+	require.Nil(t, interpretationErr)
+	require.NotNil(t, lockedPackage)
+	require.Equal(t, lockedCommit, lockedPackage.Commit)
+
+	shouldClone, interpretationErr := provider.shouldCloneToHonorPackageLock(parsedURL)
+	require.Nil(t, interpretationErr)
+	require.True(t, shouldClone)
+
+	provider.recordClonedRepository(parsedURL, lockedVersion, lockedCommit, lockedHash)
+	shouldClone, interpretationErr = provider.shouldCloneToHonorPackageLock(parsedURL)
+	require.Nil(t, interpretationErr)
+	require.False(t, shouldClone)
+	require.Equal(t, package_lock.NewLockedPackage(lockedVersion, lockedCommit, lockedHash), provider.GetResolvedPackages()[lockedRepositoryLocator])
+}
+
+func TestResetPackageResolution_ForgetsThePreviousRun(t *testing.T) {
+	provider := newProviderWithPackageLock(t)
+	parsedURL, err := shared_utils.ParseGitURL(lockedRepositoryLocator + "/main.star")
+	require.NoError(t, err)
+	provider.recordClonedRepository(parsedURL, lockedVersion, lockedCommit, lockedHash)
+
+	provider.ResetPackageResolution()
+	require.Empty(t, provider.GetResolvedPackages())
+	lockedPackage, interpretationErr := provider.getLockedPackage(parsedURL)
+	require.Nil(t, interpretationErr)
+	require.Nil(t, lockedPackage)
+
+	// what was cloned by the previous run is still on disk, so it is reused rather than cloned again
+	provider.SetPackageLock(package_lock.NewPackageLock(map[string]*package_lock.LockedPackage{
+		lockedRepositoryLocator: package_lock.NewLockedPackage(lockedVersion, lockedCommit, lockedHash),
+	}))
+	shouldClone, interpretationErr := provider.shouldCloneToHonorPackageLock(parsedURL)
+	require.Nil(t, interpretationErr)
+	require.False(t, shouldClone)
+	provider.recordReusedRepository(parsedURL)
+	require.Equal(t, package_lock.NewLockedPackage(lockedVersion, lockedCommit, lockedHash), provider.GetResolvedPackages()[lockedRepositoryLocator])
+}
+
+func TestGetLockedPackage_FailsOnVersionNotMatchingLock(t *testing.T) {
+	provider := newProviderWithPackageLock(t)
+	parsedURL, err := shared_utils.ParseGitURL(lockedRepositoryLocator + "/main.star@v0.2.0")
+	require.NoError(t, err)
+
+	_, interpretationErr := provider.getLockedPackage(parsedURL)
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), updateLockFileHint)
+}
+
+func TestGetLockedPackage_IgnoresUploadedAndUnlockedRepositories(t *testing.T) {
+	provider := newProviderWithPackageLock(t)
+	unlockedURL, err := shared_utils.ParseGitURL("github.com/kurtosis-tech/another-package/main.star")
+	require.NoError(t, err)
+	lockedPackage, interpretationErr := provider.getLockedPackage(unlockedURL)
+	require.Nil(t, interpretationErr)
+	require.Nil(t, lockedPackage)
+
+	lockedURL, err := shared_utils.ParseGitURL(lockedRepositoryLocator)
+	require.NoError(t, err)
+	provider.recordUploadedRepository(lockedURL)
+	lockedPackage, interpretationErr = provider.getLockedPackage(lockedURL)
+	require.Nil(t, interpretationErr)
+	require.Nil(t, lockedPackage)
+	require.Empty(t, provider.GetResolvedPackages())
+}
+
+func TestVerifyContentHash_FailsOnMismatch(t *testing.T) {
+	cloneDirpath := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(cloneDirpath, "main.star"), []byte("def run(plan):\n    pass\n"), 0644))
+	parsedURL, err := shared_utils.ParseGitURL(lockedRepositoryLocator)
+	require.NoError(t, err)
+
+	contentHash, interpretationErr := verifyContentHash(parsedURL, cloneDirpath, nil)
+	require.Nil(t, interpretationErr)
+
+	_, interpretationErr = verifyContentHash(parsedURL, cloneDirpath, package_lock.NewLockedPackage(lockedVersion, lockedCommit, contentHash))
+	require.Nil(t, interpretationErr)
+
+	_, interpretationErr = verifyContentHash(parsedURL, cloneDirpath, package_lock.NewLockedPackage(lockedVersion, lockedCommit, lockedHash))
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), upgradeLockFileHint)
+}
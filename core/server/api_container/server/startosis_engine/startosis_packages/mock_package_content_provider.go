@@ -5,6 +5,7 @@ package startosis_packages
 import (
 	io "io"
 
+	package_lock "github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
 	startosis_errors "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	yaml_parser "github.com/kurtosis-tech/kurtosis/core/server/commons/yaml_parser"
 	mock "github.com/stretchr/testify/mock"
@@ -452,6 +453,114 @@ func (_c *MockPackageContentProvider_GetOnDiskAbsolutePath_Call) RunAndReturn(ru
 	return _c
 }
 
+// GetResolvedPackages provides a mock function with given fields:
+func (_m *MockPackageContentProvider) GetResolvedPackages() map[string]*package_lock.LockedPackage {
+	ret := _m.Called()
+
+	var r0 map[string]*package_lock.LockedPackage
+	if rf, ok := ret.Get(0).(func() map[string]*package_lock.LockedPackage); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*package_lock.LockedPackage)
+		}
+	}
+
+	return r0
+}
+
+// MockPackageContentProvider_GetResolvedPackages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResolvedPackages'
+type MockPackageContentProvider_GetResolvedPackages_Call struct {
+	*mock.Call
+}
+
+// GetResolvedPackages is a helper method to define mock.On call
+func (_e *MockPackageContentProvider_Expecter) GetResolvedPackages() *MockPackageContentProvider_GetResolvedPackages_Call {
+	return &MockPackageContentProvider_GetResolvedPackages_Call{Call: _e.mock.On("GetResolvedPackages")}
+}
+
+func (_c *MockPackageContentProvider_GetResolvedPackages_Call) Run(run func()) *MockPackageContentProvider_GetResolvedPackages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPackageContentProvider_GetResolvedPackages_Call) Return(_a0 map[string]*package_lock.LockedPackage) *MockPackageContentProvider_GetResolvedPackages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPackageContentProvider_GetResolvedPackages_Call) RunAndReturn(run func() map[string]*package_lock.LockedPackage) *MockPackageContentProvider_GetResolvedPackages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPackageResolution provides a mock function with given fields:
+func (_m *MockPackageContentProvider) ResetPackageResolution() {
+	_m.Called()
+}
+
+// MockPackageContentProvider_ResetPackageResolution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPackageResolution'
+type MockPackageContentProvider_ResetPackageResolution_Call struct {
+	*mock.Call
+}
+
+// ResetPackageResolution is a helper method to define mock.On call
+func (_e *MockPackageContentProvider_Expecter) ResetPackageResolution() *MockPackageContentProvider_ResetPackageResolution_Call {
+	return &MockPackageContentProvider_ResetPackageResolution_Call{Call: _e.mock.On("ResetPackageResolution")}
+}
+
+func (_c *MockPackageContentProvider_ResetPackageResolution_Call) Run(run func()) *MockPackageContentProvider_ResetPackageResolution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPackageContentProvider_ResetPackageResolution_Call) Return() *MockPackageContentProvider_ResetPackageResolution_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockPackageContentProvider_ResetPackageResolution_Call) RunAndReturn(run func()) *MockPackageContentProvider_ResetPackageResolution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPackageLock provides a mock function with given fields: packageLock
+func (_m *MockPackageContentProvider) SetPackageLock(packageLock *package_lock.PackageLock) {
+	_m.Called(packageLock)
+}
+
+// MockPackageContentProvider_SetPackageLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPackageLock'
+type MockPackageContentProvider_SetPackageLock_Call struct {
+	*mock.Call
+}
+
+// SetPackageLock is a helper method to define mock.On call
+//   - packageLock *package_lock.PackageLock
+func (_e *MockPackageContentProvider_Expecter) SetPackageLock(packageLock interface{}) *MockPackageContentProvider_SetPackageLock_Call {
+	return &MockPackageContentProvider_SetPackageLock_Call{Call: _e.mock.On("SetPackageLock", packageLock)}
+}
+
+func (_c *MockPackageContentProvider_SetPackageLock_Call) Run(run func(packageLock *package_lock.PackageLock)) *MockPackageContentProvider_SetPackageLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*package_lock.PackageLock))
+	})
+	return _c
+}
+
+func (_c *MockPackageContentProvider_SetPackageLock_Call) Return() *MockPackageContentProvider_SetPackageLock_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockPackageContentProvider_SetPackageLock_Call) RunAndReturn(run func(*package_lock.PackageLock)) *MockPackageContentProvider_SetPackageLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StorePackageContents provides a mock function with given fields: packageId, packageContent, overwriteExisting
 func (_m *MockPackageContentProvider) StorePackageContents(packageId string, packageContent io.Reader, overwriteExisting bool) (string, *startosis_errors.InterpretationError) {
 	ret := _m.Called(packageId, packageContent, overwriteExisting)
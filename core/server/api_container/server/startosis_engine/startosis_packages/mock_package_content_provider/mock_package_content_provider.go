@@ -1,6 +1,7 @@
 package mock_package_content_provider
 
 import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
@@ -76,6 +77,18 @@ func (provider *MockPackageContentProvider) CloneReplacedPackagesIfNeeded(curren
 	return nil
 }
 
+func (provider *MockPackageContentProvider) SetPackageLock(_ *package_lock.PackageLock) {
+	// no-op: the mock never clones anything
+}
+
+func (provider *MockPackageContentProvider) ResetPackageResolution() {
+	// no-op: the mock never clones anything
+}
+
+func (provider *MockPackageContentProvider) GetResolvedPackages() map[string]*package_lock.LockedPackage {
+	return map[string]*package_lock.LockedPackage{}
+}
+
 func (provider *MockPackageContentProvider) GetModuleContents(absoluteModuleLocator *startosis_packages.PackageAbsoluteLocator) (string, *startosis_errors.InterpretationError) {
 	absFilePath, found := provider.starlarkPackages[absoluteModuleLocator.GetLocator()]
 	if !found {
@@ -0,0 +1,346 @@
+package oci_package_content_provider
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/oci_artifacts"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/yaml_parser"
+	"github.com/mholt/archiver"
+	"github.com/sirupsen/logrus"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	cachedArchiveExtension          = ".tgz"
+	temporaryArchiveFilePattern     = "oci-package-archive-*" + cachedArchiveExtension
+	temporaryExtractionDirPattern   = "oci-package-extraction-*"
+	digestAlgorithmSeparator        = ":"
+	digestAlgorithmSeparatorOnDisk  = "-"
+	doOverwriteExistingPackage      = true
+	defaultMainBranch               = ""
+	localLocatorPrefixRootIndicator = "/"
+	localLocatorPrefixDotIndicator  = "."
+
+	registryRequestsTimeout = 5 * time.Minute
+)
+
+// OciPackageContentProvider adds support for packages published as OCI artifacts ('oci://' locators) on top of
+// another PackageContentProvider, which handles every other locator.
+//
+// Package archives are cached and extracted by manifest digest, so references pinned to a digest never hit the registry
+// twice and several versions of a same package can be used in a run without overwriting each other. OCI locators are
+// always resolved inside the extracted version they reference.
+//
+// The package being run is additionally stored using the underlying provider, under the name declared in its
+// kurtosis.yml, which must be the registry and repository of its locator (e.g. 'ghcr.io/my-org/my-package').
+// This means that once it has been cloned, it is run exactly as if it had been uploaded from a local directory.
+type OciPackageContentProvider struct {
+	underlyingProvider startosis_packages.PackageContentProvider
+	httpClient         *http.Client
+
+	// credentials of the current run, a new registry client is built with them for every pull
+	registryCredentialsProvider *RegistryCredentialsProvider
+
+	// directory where package archives and their extracted content are cached, keyed by manifest digest
+	archivesCacheDirpath string
+
+	// OCI package locator (without path inside the package, but with its tag or digest) -> absolute path of the
+	// extracted package root on disk
+	pulledPackageRootPaths map[string]string
+	mutex                  *sync.Mutex
+}
+
+func NewOciPackageContentProvider(underlyingProvider startosis_packages.PackageContentProvider, registryCredentialsProvider *RegistryCredentialsProvider, archivesCacheDirpath string) *OciPackageContentProvider {
+	httpClient := &http.Client{Timeout: registryRequestsTimeout} //nolint:exhaustruct
+	return newOciPackageContentProviderWithHttpClient(underlyingProvider, httpClient, registryCredentialsProvider, archivesCacheDirpath)
+}
+
+func newOciPackageContentProviderWithHttpClient(underlyingProvider startosis_packages.PackageContentProvider, httpClient *http.Client, registryCredentialsProvider *RegistryCredentialsProvider, archivesCacheDirpath string) *OciPackageContentProvider {
+	return &OciPackageContentProvider{
+		underlyingProvider:          underlyingProvider,
+		httpClient:                  httpClient,
+		registryCredentialsProvider: registryCredentialsProvider,
+		archivesCacheDirpath:        archivesCacheDirpath,
+		pulledPackageRootPaths:      map[string]string{},
+		mutex:                       &sync.Mutex{},
+	}
+}
+
+func (provider *OciPackageContentProvider) ClonePackage(packageId string) (string, *startosis_errors.InterpretationError) {
+	if !oci_artifacts.IsOciLocator(packageId) {
+		return provider.underlyingProvider.ClonePackage(packageId)
+	}
+	reference, interpretationErr := parseOciReference(packageId)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	// the package is always pulled here, because a tag might have been moved since the last run
+	packageRootPath, interpretationErr := provider.pullPackage(reference)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	return provider.storePackageUnderItsName(reference, packageRootPath)
+}
+
+func (provider *OciPackageContentProvider) GetOnDiskAbsolutePackageFilePath(absoluteModuleLocator *startosis_packages.PackageAbsoluteLocator) (string, *startosis_errors.InterpretationError) {
+	if !oci_artifacts.IsOciLocator(absoluteModuleLocator.GetLocator()) {
+		return provider.underlyingProvider.GetOnDiskAbsolutePackageFilePath(absoluteModuleLocator)
+	}
+	return provider.getOnDiskAbsolutePath(absoluteModuleLocator.GetLocator())
+}
+
+func (provider *OciPackageContentProvider) GetOnDiskAbsolutePath(absoluteModuleLocator *startosis_packages.PackageAbsoluteLocator) (string, *startosis_errors.InterpretationError) {
+	if !oci_artifacts.IsOciLocator(absoluteModuleLocator.GetLocator()) {
+		return provider.underlyingProvider.GetOnDiskAbsolutePath(absoluteModuleLocator)
+	}
+	return provider.getOnDiskAbsolutePath(absoluteModuleLocator.GetLocator())
+}
+
+func (provider *OciPackageContentProvider) GetModuleContents(absoluteModuleLocator *startosis_packages.PackageAbsoluteLocator) (string, *startosis_errors.InterpretationError) {
+	if !oci_artifacts.IsOciLocator(absoluteModuleLocator.GetLocator()) {
+		return provider.underlyingProvider.GetModuleContents(absoluteModuleLocator)
+	}
+	pathToFile, interpretationErr := provider.getOnDiskAbsolutePath(absoluteModuleLocator.GetLocator())
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	contentsBytes, err := os.ReadFile(pathToFile)
+	if err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "Loading module content for module '%s' failed. An error occurred in reading contents of the file '%v'", absoluteModuleLocator.GetLocator(), pathToFile)
+	}
+	return string(contentsBytes), nil
+}
+
+func (provider *OciPackageContentProvider) GetOnDiskAbsolutePackagePath(packageId string) (string, *startosis_errors.InterpretationError) {
+	if !oci_artifacts.IsOciLocator(packageId) {
+		return provider.underlyingProvider.GetOnDiskAbsolutePackagePath(packageId)
+	}
+	reference, interpretationErr := parseOciReference(packageId)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+	packageRootPath, found := provider.pulledPackageRootPaths[reference.GetPackageLocator()]
+	if !found {
+		return "", startosis_errors.NewInterpretationError("Package '%v' hasn't been pulled yet", packageId)
+	}
+	return packageRootPath, nil
+}
+
+func (provider *OciPackageContentProvider) StorePackageContents(packageId string, packageContent io.Reader, overwriteExisting bool) (string, *startosis_errors.InterpretationError) {
+	if oci_artifacts.IsOciLocator(packageId) {
+		return "", startosis_errors.NewInterpretationError("Package '%v' is an OCI package; its content can only be pulled from its registry", packageId)
+	}
+	return provider.underlyingProvider.StorePackageContents(packageId, packageContent, overwriteExisting)
+}
+
+func (provider *OciPackageContentProvider) GetAbsoluteLocator(packageId string, locatorOfModuleInWhichThisBuiltInIsBeingCalled string, relativeOrAbsoluteLocator string, packageReplaceOptions map[string]string) (*startosis_packages.PackageAbsoluteLocator, *startosis_errors.InterpretationError) {
+	if oci_artifacts.IsOciLocator(relativeOrAbsoluteLocator) {
+		reference, interpretationErr := parseOciReference(relativeOrAbsoluteLocator)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		return startosis_packages.NewPackageAbsoluteLocator(reference.GetLocator(), defaultMainBranch), nil
+	}
+
+	// relative locators used inside a module pulled from an OCI registry stay in that same artifact
+	if oci_artifacts.IsOciLocator(locatorOfModuleInWhichThisBuiltInIsBeingCalled) && isRelativeLocator(relativeOrAbsoluteLocator) {
+		sourceModuleReference, interpretationErr := parseOciReference(locatorOfModuleInWhichThisBuiltInIsBeingCalled)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		absoluteLocator := sourceModuleReference.GetAbsoluteLocatorRelativeToThisReference(relativeOrAbsoluteLocator)
+		return startosis_packages.NewPackageAbsoluteLocator(absoluteLocator, defaultMainBranch), nil
+	}
+
+	return provider.underlyingProvider.GetAbsoluteLocator(packageId, locatorOfModuleInWhichThisBuiltInIsBeingCalled, relativeOrAbsoluteLocator, packageReplaceOptions)
+}
+
+func (provider *OciPackageContentProvider) GetKurtosisYaml(packageAbsolutePathOnDisk string) (*yaml_parser.KurtosisYaml, *startosis_errors.InterpretationError) {
+	return provider.underlyingProvider.GetKurtosisYaml(packageAbsolutePathOnDisk)
+}
+
+func (provider *OciPackageContentProvider) CloneReplacedPackagesIfNeeded(currentPackageReplaceOptions map[string]string) *startosis_errors.InterpretationError {
+	return provider.underlyingProvider.CloneReplacedPackagesIfNeeded(currentPackageReplaceOptions)
+}
+
+// SetPackageLock only applies to the packages handled by the underlying provider; OCI packages that need to be
+// reproducible are pinned by digest directly in their locator
+func (provider *OciPackageContentProvider) SetPackageLock(packageLock *package_lock.PackageLock) {
+	provider.underlyingProvider.SetPackageLock(packageLock)
+}
+
+func (provider *OciPackageContentProvider) GetResolvedPackages() map[string]*package_lock.LockedPackage {
+	return provider.underlyingProvider.GetResolvedPackages()
+}
+
+func (provider *OciPackageContentProvider) ResetPackageResolution() {
+	provider.underlyingProvider.ResetPackageResolution()
+}
+
+// getOnDiskAbsolutePath returns the path on disk of the file or folder the locator points to, pulling the package if
+// it hasn't been pulled yet
+func (provider *OciPackageContentProvider) getOnDiskAbsolutePath(locator string) (string, *startosis_errors.InterpretationError) {
+	reference, interpretationErr := parseOciReference(locator)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+
+	provider.mutex.Lock()
+	packageRootPath, found := provider.pulledPackageRootPaths[reference.GetPackageLocator()]
+	provider.mutex.Unlock()
+	if !found {
+		packageRootPath, interpretationErr = provider.pullPackage(reference)
+		if interpretationErr != nil {
+			return "", interpretationErr
+		}
+	}
+
+	pathOnDisk := path.Join(packageRootPath, reference.GetRelativeFilePath())
+	if _, err := os.Stat(pathOnDisk); err != nil {
+		return "", startosis_errors.NewInterpretationError("'%v' doesn't exist in the package '%v'", reference.GetRelativeFilePath(), reference.GetPackageLocator())
+	}
+	return pathOnDisk, nil
+}
+
+// pullPackage makes sure the package archive is in the cache and extracted, after checking the name declared in its
+// kurtosis.yml matches the reference. It returns the absolute path of the extracted package root
+func (provider *OciPackageContentProvider) pullPackage(reference *oci_artifacts.OciReference) (string, *startosis_errors.InterpretationError) {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), registryRequestsTimeout)
+	defer cancelFunc()
+	registryClient := oci_artifacts.NewRegistryClient(provider.httpClient, provider.registryCredentialsProvider.GetRegistryCredentials())
+
+	manifestDigest := reference.GetDigest()
+	if !reference.IsPinnedToDigest() {
+		resolvedDigest, err := registryClient.ResolveDigest(ctx, reference)
+		if err != nil {
+			return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred resolving OCI package '%v'. Make sure it exists and, if the registry is private, that you are logged in to it with 'docker login'", reference.GetPackageLocator())
+		}
+		manifestDigest = resolvedDigest
+	}
+	// pulling by digest guarantees that we get what was just resolved, even if the tag is moved in between
+	pinnedReference := oci_artifacts.NewOciReference(reference.GetRegistry(), reference.GetRepository(), reference.GetTag(), manifestDigest, "")
+
+	cachedArchivePath, interpretationErr := provider.getOrPullArchive(ctx, registryClient, pinnedReference)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+
+	packageRootPath, interpretationErr := provider.getOrExtractArchive(cachedArchivePath, pinnedReference)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if interpretationErr = validatePackageName(packageRootPath, reference); interpretationErr != nil {
+		return "", interpretationErr
+	}
+	logrus.Infof("Pulled OCI package '%v' (digest '%v')", reference.GetPackageLocator(), manifestDigest)
+
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+	provider.pulledPackageRootPaths[reference.GetPackageLocator()] = packageRootPath
+	provider.pulledPackageRootPaths[pinnedReference.GetPackageLocator()] = packageRootPath
+	return packageRootPath, nil
+}
+
+// storePackageUnderItsName stores the content of the pulled package using the underlying provider, so that it can be
+// run like any other package. It returns the absolute path of the stored package root
+func (provider *OciPackageContentProvider) storePackageUnderItsName(reference *oci_artifacts.OciReference, extractedPackageRootPath string) (string, *startosis_errors.InterpretationError) {
+	packageName := reference.GetPackageName()
+	// the archive is cached next to its extracted content
+	packageArchive, err := os.Open(extractedPackageRootPath + cachedArchiveExtension)
+	if err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred opening the cached archive of OCI package '%v'", reference.GetPackageLocator())
+	}
+	defer packageArchive.Close()
+	packageRootPath, interpretationErr := provider.underlyingProvider.StorePackageContents(packageName, packageArchive, doOverwriteExistingPackage)
+	if interpretationErr != nil {
+		return "", startosis_errors.WrapWithInterpretationError(interpretationErr, "An error occurred storing the content of OCI package '%v' as package '%v'", reference.GetPackageLocator(), packageName)
+	}
+	return packageRootPath, nil
+}
+
+func (provider *OciPackageContentProvider) getOrPullArchive(ctx context.Context, registryClient *oci_artifacts.RegistryClient, pinnedReference *oci_artifacts.OciReference) (string, *startosis_errors.InterpretationError) {
+	cachedArchiveFilename := strings.Replace(pinnedReference.GetDigest(), digestAlgorithmSeparator, digestAlgorithmSeparatorOnDisk, 1) + cachedArchiveExtension
+	cachedArchivePath := path.Join(provider.archivesCacheDirpath, cachedArchiveFilename)
+	if _, err := os.Stat(cachedArchivePath); err == nil {
+		logrus.Debugf("OCI package '%v' found in cache at '%v'", pinnedReference.GetPackageLocator(), cachedArchivePath)
+		return cachedArchivePath, nil
+	}
+
+	// download next to the cache so that the final rename is atomic
+	tmpArchive, err := os.CreateTemp(provider.archivesCacheDirpath, temporaryArchiveFilePattern)
+	if err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred creating a temporary file to download OCI package '%v'", pinnedReference.GetPackageLocator())
+	}
+	defer os.Remove(tmpArchive.Name())
+	_, err = registryClient.PullPackage(ctx, pinnedReference, tmpArchive)
+	tmpArchive.Close()
+	if err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred pulling OCI package '%v'", pinnedReference.GetPackageLocator())
+	}
+	if err = os.Rename(tmpArchive.Name(), cachedArchivePath); err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred moving the archive of OCI package '%v' into the cache", pinnedReference.GetPackageLocator())
+	}
+	return cachedArchivePath, nil
+}
+
+// getOrExtractArchive extracts the cached archive next to it, unless it has already been extracted, and returns the
+// absolute path of the extracted package root. Extracted packages are never modified, as they are keyed by digest
+func (provider *OciPackageContentProvider) getOrExtractArchive(cachedArchivePath string, pinnedReference *oci_artifacts.OciReference) (string, *startosis_errors.InterpretationError) {
+	extractedPackageRootPath := strings.TrimSuffix(cachedArchivePath, cachedArchiveExtension)
+	if _, err := os.Stat(extractedPackageRootPath); err == nil {
+		return extractedPackageRootPath, nil
+	}
+
+	// extract next to the cache so that the final rename is atomic
+	extractionDirpath, err := os.MkdirTemp(provider.archivesCacheDirpath, temporaryExtractionDirPattern)
+	if err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred creating a temporary directory to extract OCI package '%v'", pinnedReference.GetPackageLocator())
+	}
+	defer os.RemoveAll(extractionDirpath)
+	if err = archiver.Unarchive(cachedArchivePath, extractionDirpath); err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred extracting OCI package '%v'", pinnedReference.GetPackageLocator())
+	}
+	if err = os.Rename(extractionDirpath, extractedPackageRootPath); err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred moving the extracted content of OCI package '%v' into the cache", pinnedReference.GetPackageLocator())
+	}
+	return extractedPackageRootPath, nil
+}
+
+// validatePackageName checks that the package declares the name of the reference in its kurtosis.yml, as the package
+// being run is stored under its declared name and must not be able to replace another one
+func validatePackageName(packageRootPath string, reference *oci_artifacts.OciReference) *startosis_errors.InterpretationError {
+	kurtosisYaml, err := yaml_parser.ParseKurtosisYaml(path.Join(packageRootPath, startosis_constants.KurtosisYamlName))
+	if err != nil {
+		return startosis_errors.WrapWithInterpretationError(err, "OCI package '%v' must contain a valid '%v' at its root", reference.GetPackageLocator(), startosis_constants.KurtosisYamlName)
+	}
+	if kurtosisYaml.GetPackageName() == "" {
+		return startosis_errors.NewInterpretationError("The '%v' of OCI package '%v' doesn't declare a package name", startosis_constants.KurtosisYamlName, reference.GetPackageLocator())
+	}
+	if kurtosisYaml.GetPackageName() != reference.GetPackageName() {
+		return startosis_errors.NewInterpretationError("OCI package '%v' declares the name '%v' in its '%v' but packages pulled from '%v' must be named '%v'", reference.GetPackageLocator(), kurtosisYaml.GetPackageName(), startosis_constants.KurtosisYamlName, oci_artifacts.OciLocatorPrefix+reference.GetPackageName(), reference.GetPackageName())
+	}
+	return nil
+}
+
+func parseOciReference(locator string) (*oci_artifacts.OciReference, *startosis_errors.InterpretationError) {
+	reference, err := oci_artifacts.ParseOciReference(locator)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing OCI locator '%v'", locator)
+	}
+	return reference, nil
+}
+
+func isRelativeLocator(locator string) bool {
+	return strings.HasPrefix(locator, localLocatorPrefixRootIndicator) || strings.HasPrefix(locator, localLocatorPrefixDotIndicator)
+}
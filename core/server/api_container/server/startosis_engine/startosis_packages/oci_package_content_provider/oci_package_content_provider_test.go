@@ -0,0 +1,129 @@
+package oci_package_content_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/git_package_content_provider"
+	"github.com/mholt/archiver"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+const (
+	testPackageName     = "registry.example.com/kurtosis/sample-package"
+	testDigest          = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	testPackageLocator  = "oci://registry.example.com/kurtosis/sample-package@" + testDigest
+	testOtherDigest     = "sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+	testOtherLocator    = "oci://registry.example.com/kurtosis/sample-package@" + testOtherDigest
+	testOtherMainFile   = "helpers = import_module(\"./lib/other-helpers.star\")\n"
+	testMainFileContent = "helpers = import_module(\"./lib/helpers.star\")\n"
+	testLibFileContent  = "greeting = \"hello\"\n"
+)
+
+var noPackageReplaceOptions = map[string]string{}
+
+func TestGetModuleContents_ReadsPackageFromCachedArchive(t *testing.T) {
+	provider := newProviderWithCachedTestPackage(t)
+
+	mainFileLocator := startosis_packages.NewPackageAbsoluteLocator(testPackageLocator+"/main.star", defaultMainBranch)
+	contents, interpretationErr := provider.GetModuleContents(mainFileLocator)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, testMainFileContent, contents)
+
+	packageRootPath, interpretationErr := provider.GetOnDiskAbsolutePackagePath(testPackageLocator)
+	require.Nil(t, interpretationErr)
+	require.True(t, strings.HasSuffix(packageRootPath, strings.Replace(testDigest, digestAlgorithmSeparator, digestAlgorithmSeparatorOnDisk, 1)))
+}
+
+func TestGetModuleContents_KeepsEveryVersionOfAPackage(t *testing.T) {
+	provider := newProviderWithCachedTestPackage(t)
+	cachePackageArchive(t, provider.archivesCacheDirpath, testPackageName, testOtherDigest, testOtherMainFile)
+
+	// the package being run is stored under its name, that must not change what its other versions resolve to
+	packageRootPath, interpretationErr := provider.ClonePackage(testPackageLocator)
+	require.Nil(t, interpretationErr)
+	require.True(t, strings.HasSuffix(packageRootPath, testPackageName))
+
+	otherMainFileLocator := startosis_packages.NewPackageAbsoluteLocator(testOtherLocator+"/main.star", defaultMainBranch)
+	contents, interpretationErr := provider.GetModuleContents(otherMainFileLocator)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, testOtherMainFile, contents)
+
+	mainFileLocator := startosis_packages.NewPackageAbsoluteLocator(testPackageLocator+"/main.star", defaultMainBranch)
+	contents, interpretationErr = provider.GetModuleContents(mainFileLocator)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, testMainFileContent, contents)
+
+	storedMainFileContents, err := os.ReadFile(path.Join(packageRootPath, "main.star"))
+	require.NoError(t, err)
+	require.Equal(t, testMainFileContent, string(storedMainFileContents))
+}
+
+func TestGetAbsoluteLocator_RelativeLocatorsStayInTheOciPackage(t *testing.T) {
+	provider := newProviderWithCachedTestPackage(t)
+
+	absoluteLocator, interpretationErr := provider.GetAbsoluteLocator(testPackageName, testPackageLocator+"/main.star", "./lib/helpers.star", noPackageReplaceOptions)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, testPackageLocator+"/lib/helpers.star", absoluteLocator.GetLocator())
+
+	contents, interpretationErr := provider.GetModuleContents(absoluteLocator)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, testLibFileContent, contents)
+}
+
+func TestGetModuleContents_FailsForFileNotInPackage(t *testing.T) {
+	provider := newProviderWithCachedTestPackage(t)
+
+	missingFileLocator := startosis_packages.NewPackageAbsoluteLocator(testPackageLocator+"/does-not-exist.star", defaultMainBranch)
+	_, interpretationErr := provider.GetModuleContents(missingFileLocator)
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), "doesn't exist in the package")
+}
+
+func TestGetModuleContents_FailsForPackageNameNotMatchingTheLocator(t *testing.T) {
+	provider := newProviderWithCachedPackage(t, "github.com/kurtosis-tech/postgres-package")
+
+	mainFileLocator := startosis_packages.NewPackageAbsoluteLocator(testPackageLocator+"/main.star", defaultMainBranch)
+	_, interpretationErr := provider.GetModuleContents(mainFileLocator)
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), "must be named '"+testPackageName+"'")
+
+	_, interpretationErr = provider.GetOnDiskAbsolutePackagePath(testPackageLocator)
+	require.NotNil(t, interpretationErr)
+}
+
+// newProviderWithCachedTestPackage builds a provider whose cache already contains the archive of the test package,
+// so that no registry is ever contacted as the test locator is pinned to a digest
+func newProviderWithCachedTestPackage(t *testing.T) *OciPackageContentProvider {
+	return newProviderWithCachedPackage(t, testPackageName)
+}
+
+func newProviderWithCachedPackage(t *testing.T, declaredPackageName string) *OciPackageContentProvider {
+	repositoriesDirpath := t.TempDir()
+	tmpDirpath := t.TempDir()
+	archivesCacheDirpath := t.TempDir()
+	cachePackageArchive(t, archivesCacheDirpath, declaredPackageName, testDigest, testMainFileContent)
+
+	githubAuthProvider := git_package_content_provider.NewGitHubPackageAuthProvider(t.TempDir())
+	gitProvider := git_package_content_provider.NewGitPackageContentProvider(repositoriesDirpath, tmpDirpath, githubAuthProvider, nil)
+	return newOciPackageContentProviderWithHttpClient(gitProvider, http.DefaultClient, NewRegistryCredentialsProvider(), archivesCacheDirpath)
+}
+
+func cachePackageArchive(t *testing.T, archivesCacheDirpath string, declaredPackageName string, digest string, mainFileContent string) {
+	packageDirpath := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(packageDirpath, "kurtosis.yml"), []byte("name: "+declaredPackageName+"\n"), 0644))
+	require.NoError(t, os.WriteFile(path.Join(packageDirpath, "main.star"), []byte(mainFileContent), 0644))
+	require.NoError(t, os.Mkdir(path.Join(packageDirpath, "lib"), 0755))
+	require.NoError(t, os.WriteFile(path.Join(packageDirpath, "lib", "helpers.star"), []byte(testLibFileContent), 0644))
+
+	cachedArchivePath := path.Join(archivesCacheDirpath, strings.Replace(digest, digestAlgorithmSeparator, digestAlgorithmSeparatorOnDisk, 1)+cachedArchiveExtension)
+	packageFiles := []string{
+		path.Join(packageDirpath, "kurtosis.yml"),
+		path.Join(packageDirpath, "main.star"),
+		path.Join(packageDirpath, "lib"),
+	}
+	require.NoError(t, archiver.Archive(packageFiles, cachedArchivePath))
+}
@@ -0,0 +1,43 @@
+package oci_package_content_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/oci_artifacts"
+	"sync"
+)
+
+// RegistryCredentialsProvider holds the registry credentials sent along with the current Starlark run, so that OCI
+// packages can be pulled from private registries. They are set at the start of every run, dropped at its end and never
+// written to disk; as runs are serialized, a run only ever uses the credentials of the client that started it
+type RegistryCredentialsProvider struct {
+	// registry host -> credentials
+	credentialsByRegistry map[string]*oci_artifacts.RegistryCredentials
+	mutex                 *sync.RWMutex
+}
+
+func NewRegistryCredentialsProvider() *RegistryCredentialsProvider {
+	return &RegistryCredentialsProvider{
+		credentialsByRegistry: map[string]*oci_artifacts.RegistryCredentials{},
+		mutex:                 &sync.RWMutex{},
+	}
+}
+
+// SetRegistryCredentials replaces the credentials of the previous run
+func (provider *RegistryCredentialsProvider) SetRegistryCredentials(credentialsByRegistry map[string]*oci_artifacts.RegistryCredentials) {
+	credentialsByRegistryCopy := map[string]*oci_artifacts.RegistryCredentials{}
+	for registry, credentials := range credentialsByRegistry {
+		credentialsByRegistryCopy[registry] = credentials
+	}
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+	provider.credentialsByRegistry = credentialsByRegistryCopy
+}
+
+func (provider *RegistryCredentialsProvider) GetRegistryCredentials() map[string]*oci_artifacts.RegistryCredentials {
+	provider.mutex.RLock()
+	defer provider.mutex.RUnlock()
+	credentialsByRegistryCopy := map[string]*oci_artifacts.RegistryCredentials{}
+	for registry, credentials := range provider.credentialsByRegistry {
+		credentialsByRegistryCopy[registry] = credentials
+	}
+	return credentialsByRegistryCopy
+}
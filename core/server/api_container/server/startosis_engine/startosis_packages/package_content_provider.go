@@ -1,6 +1,7 @@
 package startosis_packages
 
 import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/yaml_parser"
 	"io"
@@ -44,4 +45,16 @@ type PackageContentProvider interface {
 	// CloneReplacedPackagesIfIsNeeded will compare the received currentPackageReplaceOptions with the historical replace options (from previous run)
 	// and will clone the packages depending on the comparison result
 	CloneReplacedPackagesIfNeeded(currentPackageReplaceOptions map[string]string) *startosis_errors.InterpretationError
+
+	// SetPackageLock sets the lock the following clones have to honor: locked repositories are checked out at their
+	// locked commit and their content hash is verified. A nil lock disables the verification
+	SetPackageLock(packageLock *package_lock.PackageLock)
+
+	// GetResolvedPackages returns the version, commit and content hash of every repository used by the last run, keyed
+	// by repository locator. Packages whose content was uploaded instead of cloned aren't part of it
+	GetResolvedPackages() map[string]*package_lock.LockedPackage
+
+	// ResetPackageResolution forgets the package lock and the packages resolved by the previous run; it must be called
+	// at the start of every run
+	ResetPackageResolution()
 }
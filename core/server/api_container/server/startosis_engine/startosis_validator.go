@@ -9,8 +9,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
@@ -43,16 +45,22 @@ type StartosisValidator struct {
 	serviceNetwork    service_network.ServiceNetwork
 	fileArtifactStore *enclave_data_directory.FilesArtifactStore
 
-	backend *backend_interface.KurtosisBackend
+	backend     *backend_interface.KurtosisBackend
+	backendType args.KurtosisBackendType
+
+	// Nil if the enclave has no resource quota
+	enclaveResourceQuota *enclave.EnclaveResourceQuota
 }
 
-func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, serviceNetwork service_network.ServiceNetwork, fileArtifactStore *enclave_data_directory.FilesArtifactStore) *StartosisValidator {
+func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, kurtosisBackendType args.KurtosisBackendType, serviceNetwork service_network.ServiceNetwork, fileArtifactStore *enclave_data_directory.FilesArtifactStore, enclaveResourceQuota *enclave.EnclaveResourceQuota) *StartosisValidator {
 	imagesValidator := startosis_validator.NewImagesValidator(kurtosisBackend)
 	return &StartosisValidator{
 		imagesValidator,
 		serviceNetwork,
 		fileArtifactStore,
 		kurtosisBackend,
+		kurtosisBackendType,
+		enclaveResourceQuota,
 	}
 }
 
@@ -73,7 +81,7 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 			return
 		}
 
-		serviceNamePortIdMapping, err := getServiceNameToPortIDsMap(serviceNames, validator.serviceNetwork)
+		serviceNamePortIdMapping, serviceNameToConfig, err := getServiceNameToPortIDsAndConfigMaps(serviceNames, validator.serviceNetwork)
 		if err != nil {
 			wrappedValidationError := startosis_errors.WrapWithValidationError(err, "Couldn't create validator environment as we ran into errors fetching existing services and ports")
 			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(wrappedValidationError.ToAPIType())
@@ -96,10 +104,21 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 			availableCpuInMilliCores,
 			availableMemoryInMegaBytes,
 			isResourceInformationComplete,
-			imageDownloadMode)
+			imageDownloadMode,
+			validator.backendType,
+			validator.enclaveResourceQuota)
+		for serviceName, serviceConfig := range serviceNameToConfig {
+			environment.AddExistingServiceResources(serviceName, serviceConfig)
+		}
 
 		isValidationFailure = isValidationFailure ||
 			validator.validateAndUpdateEnvironment(instructionsSequence, environment, starlarkRunResponseLineStream)
+
+		// the quota is checked against the whole plan before pulling any image, so that a plan too big for the enclave fails early
+		if resourceQuotaViolation := environment.GetResourceQuotaViolation(); resourceQuotaViolation != nil {
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(resourceQuotaViolation.ToAPIType())
+			isValidationFailure = true
+		}
 		logrus.Debug("Finished validating environment. Validating container images...")
 
 		isValidationFailure = isValidationFailure ||
@@ -283,19 +302,25 @@ func removeIfPresent(slice []string, valueToRemove string) []string {
 	return append(newSlice, slice[valueToRemoveIndex+1:]...)
 }
 
-func getServiceNameToPortIDsMap(serviceNames map[service.ServiceName]bool, network service_network.ServiceNetwork) (map[service.ServiceName][]string, error) {
+// getServiceNameToPortIDsAndConfigMaps returns the private port IDs and the configs of the existing services, the
+// configs being needed to count the resources the services already claim towards the enclave resource quota
+func getServiceNameToPortIDsAndConfigMaps(serviceNames map[service.ServiceName]bool, network service_network.ServiceNetwork) (map[service.ServiceName][]string, map[service.ServiceName]*service.ServiceConfig, error) {
 	serviceToPrivatePortIds := make(map[service.ServiceName][]string, len(serviceNames))
+	serviceToConfig := make(map[service.ServiceName]*service.ServiceConfig, len(serviceNames))
 	ctx := context.Background()
 	for serviceName := range serviceNames {
 		service, err := network.GetService(ctx, string(serviceName))
 		if err != nil {
-			return nil, stacktrace.NewError("An error occurred while fetching service '%s' for its private port mappings", serviceName)
+			return nil, nil, stacktrace.NewError("An error occurred while fetching service '%s' for its private port mappings", serviceName)
 		}
 		serviceToPrivatePortIds[serviceName] = []string{}
 		privatePorts := service.GetPrivatePorts()
 		for portId := range privatePorts {
 			serviceToPrivatePortIds[serviceName] = append(serviceToPrivatePortIds[serviceName], portId)
 		}
+		if serviceConfig := service.GetRegistration().GetConfig(); serviceConfig != nil {
+			serviceToConfig[serviceName] = serviceConfig
+		}
 	}
-	return serviceToPrivatePortIds, nil
+	return serviceToPrivatePortIds, serviceToConfig, nil
 }
@@ -1,17 +1,35 @@
 package startosis_validator
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// persistent directory sizes are in bytes while the storage quota uses the same megabytes as the directory `size` attribute
+	persistentStorageBytesPerMegaByte = 1024 * 1024
+
+	resourceQuotaViolationHeader         = "The plan exceeds the resource quota of this enclave:"
+	resourceQuotaViolationLineFormat     = "  - %s: the plan requires %d %s but the quota allows %d %s%s"
+	resourceQuotaContributionsFormat     = " (%s)"
+	resourceQuotaContributionFormat      = "'%s': %d"
+	resourceQuotaContributionsSeparator  = ", "
+	resourceQuotaViolationLinesSeparator = "\n"
+)
+
 // ValidatorEnvironment fields are not exported so that only validators can access its fields
 type ValidatorEnvironment struct {
 	imagesToPull                  map[string]*image_registry_spec.ImageRegistrySpec // "set" of images that need to be downloaded
@@ -20,6 +38,7 @@ type ValidatorEnvironment struct {
 	serviceNames                  map[service.ServiceName]ComponentExistence
 	artifactNames                 map[string]ComponentExistence
 	persistentKeys                map[service_directory.DirectoryPersistentKey]ComponentExistence
+	persistentKeySizes            map[service_directory.DirectoryPersistentKey]service_directory.DirectoryPersistentSize
 	serviceNameToPrivatePortIDs   map[service.ServiceName][]string
 	availableCpuInMilliCores      compute_resources.CpuMilliCores
 	availableMemoryInMegaBytes    compute_resources.MemoryInMegaBytes
@@ -27,9 +46,12 @@ type ValidatorEnvironment struct {
 	minCPUByServiceName           map[service.ServiceName]compute_resources.CpuMilliCores
 	minMemoryByServiceName        map[service.ServiceName]compute_resources.MemoryInMegaBytes
 	imageDownloadMode             image_download_mode.ImageDownloadMode
+	kurtosisBackendType           args.KurtosisBackendType
+	// Nil if the enclave has no resource quota
+	resourceQuota *enclave.EnclaveResourceQuota
 }
 
-func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifactNames map[string]bool, serviceNameToPrivatePortIds map[service.ServiceName][]string, availableCpuInMilliCores compute_resources.CpuMilliCores, availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes, isResourceInformationComplete bool, imageDownloadMode image_download_mode.ImageDownloadMode) *ValidatorEnvironment {
+func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifactNames map[string]bool, serviceNameToPrivatePortIds map[service.ServiceName][]string, availableCpuInMilliCores compute_resources.CpuMilliCores, availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes, isResourceInformationComplete bool, imageDownloadMode image_download_mode.ImageDownloadMode, kurtosisBackendType args.KurtosisBackendType, resourceQuota *enclave.EnclaveResourceQuota) *ValidatorEnvironment {
 	serviceNamesWithComponentExistence := map[service.ServiceName]ComponentExistence{}
 	for serviceName := range serviceNames {
 		serviceNamesWithComponentExistence[serviceName] = ComponentExistedBeforePackageRun
@@ -50,12 +72,67 @@ func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifact
 		isResourceInformationComplete: isResourceInformationComplete,
 		// TODO account for idempotent runs on this and make it pre-load the cache whenever we create a NewValidatorEnvironment
 		persistentKeys:         map[service_directory.DirectoryPersistentKey]ComponentExistence{},
+		persistentKeySizes:     map[service_directory.DirectoryPersistentKey]service_directory.DirectoryPersistentSize{},
 		minMemoryByServiceName: map[service.ServiceName]compute_resources.MemoryInMegaBytes{},
 		minCPUByServiceName:    map[service.ServiceName]compute_resources.CpuMilliCores{},
 		imageDownloadMode:      imageDownloadMode,
+		kurtosisBackendType:    kurtosisBackendType,
+		resourceQuota:          resourceQuota,
 	}
 }
 
+// Clone returns a deep copy of the environment, so that instructions which might not run, like the ones of the
+// branches of a condition, can be validated without affecting each other
+func (environment *ValidatorEnvironment) Clone() *ValidatorEnvironment {
+	return &ValidatorEnvironment{
+		imagesToPull:                  copyMap(environment.imagesToPull),
+		imagesToBuild:                 copyMap(environment.imagesToBuild),
+		nixToBuild:                    copyMap(environment.nixToBuild),
+		serviceNames:                  copyMap(environment.serviceNames),
+		artifactNames:                 copyMap(environment.artifactNames),
+		persistentKeys:                copyMap(environment.persistentKeys),
+		persistentKeySizes:            copyMap(environment.persistentKeySizes),
+		serviceNameToPrivatePortIDs:   copyMap(environment.serviceNameToPrivatePortIDs),
+		availableCpuInMilliCores:      environment.availableCpuInMilliCores,
+		availableMemoryInMegaBytes:    environment.availableMemoryInMegaBytes,
+		isResourceInformationComplete: environment.isResourceInformationComplete,
+		minCPUByServiceName:           copyMap(environment.minCPUByServiceName),
+		minMemoryByServiceName:        copyMap(environment.minMemoryByServiceName),
+		imageDownloadMode:             environment.imageDownloadMode,
+		kurtosisBackendType:           environment.kurtosisBackendType,
+		resourceQuota:                 environment.resourceQuota,
+	}
+}
+
+// MergeBranches sets the environment to what it can be once any one of the branches ran, each branch having been
+// validated against its own clone of the environment. The components of every branch are kept, and the resources of
+// the most demanding branch are counted
+func (environment *ValidatorEnvironment) MergeBranches(branches ...*ValidatorEnvironment) {
+	if len(branches) == 0 {
+		return
+	}
+	merged := branches[0].Clone()
+	for _, branch := range branches[1:] {
+		mergeMap(merged.imagesToPull, branch.imagesToPull, keepLast[*image_registry_spec.ImageRegistrySpec])
+		mergeMap(merged.imagesToBuild, branch.imagesToBuild, keepLast[*image_build_spec.ImageBuildSpec])
+		mergeMap(merged.nixToBuild, branch.nixToBuild, keepLast[*nix_build_spec.NixBuildSpec])
+		mergeMap(merged.serviceNames, branch.serviceNames, keepMax[ComponentExistence])
+		mergeMap(merged.artifactNames, branch.artifactNames, keepMax[ComponentExistence])
+		mergeMap(merged.persistentKeys, branch.persistentKeys, keepMax[ComponentExistence])
+		mergeMap(merged.persistentKeySizes, branch.persistentKeySizes, keepMax[service_directory.DirectoryPersistentSize])
+		mergeMap(merged.serviceNameToPrivatePortIDs, branch.serviceNameToPrivatePortIDs, keepLast[[]string])
+		mergeMap(merged.minCPUByServiceName, branch.minCPUByServiceName, keepMax[compute_resources.CpuMilliCores])
+		mergeMap(merged.minMemoryByServiceName, branch.minMemoryByServiceName, keepMax[compute_resources.MemoryInMegaBytes])
+		if branch.availableCpuInMilliCores < merged.availableCpuInMilliCores {
+			merged.availableCpuInMilliCores = branch.availableCpuInMilliCores
+		}
+		if branch.availableMemoryInMegaBytes < merged.availableMemoryInMegaBytes {
+			merged.availableMemoryInMegaBytes = branch.availableMemoryInMegaBytes
+		}
+	}
+	*environment = *merged
+}
+
 func (environment *ValidatorEnvironment) AppendRequiredImagePull(containerImage string) {
 	environment.imagesToPull[containerImage] = nil
 }
@@ -136,6 +213,7 @@ func (environment *ValidatorEnvironment) FreeMemory(serviceName service.ServiceN
 		return
 	}
 	environment.availableMemoryInMegaBytes += memoryConsumedByService
+	delete(environment.minMemoryByServiceName, serviceName)
 }
 
 func (environment *ValidatorEnvironment) ConsumeMemory(memoryConsumed uint64, serviceName service.ServiceName) {
@@ -150,6 +228,7 @@ func (environment *ValidatorEnvironment) FreeCPU(serviceName service.ServiceName
 		return
 	}
 	environment.availableCpuInMilliCores += cpuConsumedByService
+	delete(environment.minCPUByServiceName, serviceName)
 }
 
 func (environment *ValidatorEnvironment) ConsumeCPU(cpuConsumed uint64, serviceName service.ServiceName) {
@@ -177,6 +256,145 @@ func (environment *ValidatorEnvironment) HasEnoughMemory(memoryToConsume uint64,
 	return startosis_errors.NewValidationError("service '%v' requires '%v' megabytes of memory but based on our calculation we will only have '%v' megabytes available at the time we start the service", serviceNameForLogging, memoryToConsume, environment.availableMemoryInMegaBytes)
 }
 
-func (environment *ValidatorEnvironment) AddPersistentKey(persistentKey service_directory.DirectoryPersistentKey) {
+// AddPersistentKey records the persistent key along with its size. Services sharing a persistent key share the same
+// volume, so only the biggest size requested for a key counts towards the storage quota
+func (environment *ValidatorEnvironment) AddPersistentKey(persistentKey service_directory.DirectoryPersistentKey, size service_directory.DirectoryPersistentSize) {
 	environment.persistentKeys[persistentKey] = ComponentCreatedOrUpdatedDuringPackageRun
+	if existingSize, found := environment.persistentKeySizes[persistentKey]; !found || size > existingSize {
+		environment.persistentKeySizes[persistentKey] = size
+	}
+}
+
+// AddExistingServiceResources records the minimum CPU, minimum memory and persistent storage of a service that existed
+// before the run so that they count towards the resource quota. The available CPU and memory aren't changed as they
+// already account for the running services
+func (environment *ValidatorEnvironment) AddExistingServiceResources(serviceName service.ServiceName, serviceConfig *service.ServiceConfig) {
+	environment.minCPUByServiceName[serviceName] = compute_resources.CpuMilliCores(serviceConfig.GetMinCPUAllocationMillicpus())
+	environment.minMemoryByServiceName[serviceName] = compute_resources.MemoryInMegaBytes(serviceConfig.GetMinMemoryAllocationMegabytes())
+	persistentDirectories := serviceConfig.GetPersistentDirectories()
+	if persistentDirectories == nil {
+		return
+	}
+	for _, directory := range persistentDirectories.ServiceDirpathToPersistentDirectory {
+		if _, found := environment.persistentKeys[directory.PersistentKey]; !found {
+			environment.persistentKeys[directory.PersistentKey] = ComponentExistedBeforePackageRun
+		}
+		if existingSize, found := environment.persistentKeySizes[directory.PersistentKey]; !found || directory.Size > existingSize {
+			environment.persistentKeySizes[directory.PersistentKey] = directory.Size
+		}
+	}
+}
+
+// GetResourceQuotaViolation checks the resources claimed by the plan against the enclave resource quota and returns a
+// single error listing every exceeded resource along with what each service or persistent key contributes to it.
+// It returns nil if the enclave has no quota or if the plan fits in it.
+// The services that existed before the run count towards every quota, unless the plan removes them
+func (environment *ValidatorEnvironment) GetResourceQuotaViolation() *startosis_errors.ValidationError {
+	quota := environment.resourceQuota
+	if quota == nil || quota.IsUnlimited() {
+		return nil
+	}
+	var violations []string
+
+	if maxCpu := uint64(quota.GetMaxCpuMilliCores()); maxCpu != enclave.NoResourceQuotaLimit {
+		cpuByServiceName := map[string]uint64{}
+		for serviceName, cpu := range environment.minCPUByServiceName {
+			cpuByServiceName[string(serviceName)] = uint64(cpu)
+		}
+		if violation, isViolated := getResourceQuotaViolationLine("cpu", "millicores", 1, maxCpu, cpuByServiceName); isViolated {
+			violations = append(violations, violation)
+		}
+	}
+
+	if maxMemory := uint64(quota.GetMaxMemoryMegaBytes()); maxMemory != enclave.NoResourceQuotaLimit {
+		memoryByServiceName := map[string]uint64{}
+		for serviceName, memory := range environment.minMemoryByServiceName {
+			memoryByServiceName[string(serviceName)] = uint64(memory)
+		}
+		if violation, isViolated := getResourceQuotaViolationLine("memory", "megabytes", 1, maxMemory, memoryByServiceName); isViolated {
+			violations = append(violations, violation)
+		}
+	}
+
+	if maxServices := uint64(quota.GetMaxServices()); maxServices != enclave.NoResourceQuotaLimit {
+		numServices := uint64(len(environment.serviceNames))
+		if numServices > maxServices {
+			violations = append(violations, fmt.Sprintf(resourceQuotaViolationLineFormat, "services", numServices, "services", maxServices, "services", ""))
+		}
+	}
+
+	if maxStorage := quota.GetMaxPersistentStorageMegaBytes(); maxStorage != enclave.NoResourceQuotaLimit {
+		storageByPersistentKey := map[string]uint64{}
+		for persistentKey, size := range environment.persistentKeySizes {
+			storageByPersistentKey[string(persistentKey)] = uint64(size)
+		}
+		// the sizes are compared in bytes as sizes which aren't a whole number of megabytes add up
+		if violation, isViolated := getResourceQuotaViolationLine("persistent storage", "megabytes", persistentStorageBytesPerMegaByte, maxStorage*persistentStorageBytesPerMegaByte, storageByPersistentKey); isViolated {
+			violations = append(violations, violation)
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return startosis_errors.NewValidationError("%s%s%s", resourceQuotaViolationHeader, resourceQuotaViolationLinesSeparator, strings.Join(violations, resourceQuotaViolationLinesSeparator))
+}
+
+func (environment *ValidatorEnvironment) GetKurtosisBackendType() args.KurtosisBackendType {
+	return environment.kurtosisBackendType
+}
+
+// getResourceQuotaViolationLine compares the amounts to the limit as they are, and reports them in the unit, each unit
+// being worth amountPerUnit; reported amounts are rounded up so that a contribution never shows as 0
+func getResourceQuotaViolationLine(resourceName string, unit string, amountPerUnit uint64, limit uint64, amountByContributor map[string]uint64) (string, bool) {
+	total := uint64(0)
+	var contributors []string
+	for contributor, amount := range amountByContributor {
+		total += amount
+		if amount > 0 {
+			contributors = append(contributors, contributor)
+		}
+	}
+	if total <= limit {
+		return "", false
+	}
+	sort.Strings(contributors)
+	var contributions []string
+	for _, contributor := range contributors {
+		contributions = append(contributions, fmt.Sprintf(resourceQuotaContributionFormat, contributor, roundUpToUnit(amountByContributor[contributor], amountPerUnit)))
+	}
+	return fmt.Sprintf(resourceQuotaViolationLineFormat, resourceName, roundUpToUnit(total, amountPerUnit), unit, limit/amountPerUnit, unit, fmt.Sprintf(resourceQuotaContributionsFormat, strings.Join(contributions, resourceQuotaContributionsSeparator))), true
+}
+
+func roundUpToUnit(amount uint64, amountPerUnit uint64) uint64 {
+	return (amount + amountPerUnit - 1) / amountPerUnit
+}
+
+func copyMap[K comparable, V any](original map[K]V) map[K]V {
+	copied := make(map[K]V, len(original))
+	for key, value := range original {
+		copied[key] = value
+	}
+	return copied
+}
+
+func mergeMap[K comparable, V any](destination map[K]V, source map[K]V, resolve func(destinationValue V, sourceValue V) V) {
+	for key, sourceValue := range source {
+		if destinationValue, found := destination[key]; found {
+			destination[key] = resolve(destinationValue, sourceValue)
+			continue
+		}
+		destination[key] = sourceValue
+	}
+}
+
+func keepLast[V any](_ V, sourceValue V) V {
+	return sourceValue
+}
+
+func keepMax[V ~uint8 | ~int64 | ~uint64](destinationValue V, sourceValue V) V {
+	if sourceValue > destinationValue {
+		return sourceValue
+	}
+	return destinationValue
 }
@@ -3,8 +3,11 @@ package startosis_validator
 import (
 	"testing"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,11 +21,14 @@ const (
 	isResourceInformationComplete = true
 	tooMuchMemory                 = 120000
 	tooMuchCpu                    = 5000
+	testFooService                = service.ServiceName("foo")
+	testPersistentKey             = service_directory.DirectoryPersistentKey("data")
+	oneMegaByteInBytes            = 1024 * 1024
 )
 
 func TestMultiplePortIdsForValidation(t *testing.T) {
 	emptyInitialMapping := map[service.ServiceName][]string{}
-	validatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing)
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, nil)
 	portIds := []string{
 		fooPortId,
 		fizzPortId,
@@ -37,3 +43,129 @@ func TestMultiplePortIdsForValidation(t *testing.T) {
 	require.Error(t, validatorEnvironment.HasEnoughCPU(tooMuchCpu, testBarService))
 	require.Error(t, validatorEnvironment.HasEnoughMemory(tooMuchMemory, testBarService))
 }
+
+func TestResourceQuotaViolation_NoQuota(t *testing.T) {
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, nil)
+	validatorEnvironment.AddServiceName(testBarService)
+	validatorEnvironment.ConsumeCPU(tooMuchCpu, testBarService)
+	require.Nil(t, validatorEnvironment.GetResourceQuotaViolation())
+}
+
+func TestResourceQuotaViolation_PlanFitsInQuota(t *testing.T) {
+	quota := enclave.NewEnclaveResourceQuota(1000, 512, 2, 100)
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, quota)
+	validatorEnvironment.AddServiceName(testBarService)
+	validatorEnvironment.ConsumeCPU(600, testBarService)
+	validatorEnvironment.ConsumeMemory(256, testBarService)
+	validatorEnvironment.AddServiceName(testFooService)
+	validatorEnvironment.ConsumeCPU(400, testFooService)
+	validatorEnvironment.ConsumeMemory(256, testFooService)
+	// both services share the same volume so it's only counted once
+	validatorEnvironment.AddPersistentKey(testPersistentKey, 60*oneMegaByteInBytes)
+	validatorEnvironment.AddPersistentKey(testPersistentKey, 100*oneMegaByteInBytes)
+	require.Nil(t, validatorEnvironment.GetResourceQuotaViolation())
+}
+
+func TestResourceQuotaViolation_ReportsEveryExceededResource(t *testing.T) {
+	quota := enclave.NewEnclaveResourceQuota(1000, enclave.NoResourceQuotaLimit, 1, 50)
+	existingServiceNames := map[service.ServiceName]bool{
+		testFooService: true,
+	}
+	validatorEnvironment := NewValidatorEnvironment(existingServiceNames, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, quota)
+	validatorEnvironment.AddServiceName(testBarService)
+	validatorEnvironment.ConsumeCPU(1500, testBarService)
+	validatorEnvironment.ConsumeMemory(tooMuchMemory, testBarService)
+	validatorEnvironment.AddPersistentKey(testPersistentKey, 100*oneMegaByteInBytes)
+
+	violation := validatorEnvironment.GetResourceQuotaViolation()
+	require.NotNil(t, violation)
+	require.Contains(t, violation.Error(), "cpu: the plan requires 1500 millicores but the quota allows 1000 millicores ('bar': 1500)")
+	require.Contains(t, violation.Error(), "services: the plan requires 2 services but the quota allows 1 services")
+	require.Contains(t, violation.Error(), "persistent storage: the plan requires 100 megabytes but the quota allows 50 megabytes ('data': 100)")
+	require.NotContains(t, violation.Error(), "memory")
+}
+
+func TestResourceQuotaViolation_RemovedServiceIsFreed(t *testing.T) {
+	quota := enclave.NewEnclaveResourceQuota(1000, 512, 1, enclave.NoResourceQuotaLimit)
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, quota)
+	validatorEnvironment.AddServiceName(testBarService)
+	validatorEnvironment.ConsumeCPU(1000, testBarService)
+	validatorEnvironment.ConsumeMemory(512, testBarService)
+	validatorEnvironment.RemoveServiceName(testBarService)
+	validatorEnvironment.FreeCPU(testBarService)
+	validatorEnvironment.FreeMemory(testBarService)
+	validatorEnvironment.AddServiceName(testFooService)
+	validatorEnvironment.ConsumeCPU(1000, testFooService)
+	validatorEnvironment.ConsumeMemory(512, testFooService)
+	require.Nil(t, validatorEnvironment.GetResourceQuotaViolation())
+}
+
+func TestResourceQuotaViolation_ExistingServicesCount(t *testing.T) {
+	quota := enclave.NewEnclaveResourceQuota(1000, 512, enclave.NoResourceQuotaLimit, 100)
+	existingServiceNames := map[service.ServiceName]bool{
+		testFooService: true,
+	}
+	validatorEnvironment := NewValidatorEnvironment(existingServiceNames, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, quota)
+	existingPersistentDirectories := service_directory.NewPersistentDirectories(map[string]service_directory.PersistentDirectory{
+		"/data": {PersistentKey: testPersistentKey, Size: 60 * oneMegaByteInBytes, StorageClass: service_directory.DefaultStorageClass, AccessMode: service_directory.DefaultAccessMode},
+	})
+	existingServiceConfig, err := service.CreateServiceConfig("image", nil, nil, nil, nil, nil, nil, nil, nil, nil, existingPersistentDirectories, 0, 0, "", 800, 0, nil, nil, nil, nil, image_download_mode.ImageDownloadMode_Missing, true)
+	require.NoError(t, err)
+	validatorEnvironment.AddExistingServiceResources(testFooService, existingServiceConfig)
+	validatorEnvironment.AddServiceName(testBarService)
+	validatorEnvironment.ConsumeCPU(400, testBarService)
+	validatorEnvironment.AddPersistentKey(testPersistentKey, 50*oneMegaByteInBytes)
+	validatorEnvironment.AddPersistentKey("logs", 50*oneMegaByteInBytes)
+
+	violation := validatorEnvironment.GetResourceQuotaViolation()
+	require.NotNil(t, violation)
+	require.Contains(t, violation.Error(), "cpu: the plan requires 1200 millicores but the quota allows 1000 millicores ('bar': 400, 'foo': 800)")
+	// the existing volume is bigger than the one the plan asks for under the same key
+	require.Contains(t, violation.Error(), "persistent storage: the plan requires 110 megabytes but the quota allows 100 megabytes ('data': 60, 'logs': 50)")
+
+	validatorEnvironment.RemoveServiceName(testFooService)
+	validatorEnvironment.FreeCPU(testFooService)
+	validatorEnvironment.FreeMemory(testFooService)
+	violation = validatorEnvironment.GetResourceQuotaViolation()
+	require.NotNil(t, violation)
+	// persistent volumes outlive the services using them
+	require.NotContains(t, violation.Error(), "cpu")
+	require.Contains(t, violation.Error(), "persistent storage")
+}
+
+func TestResourceQuotaViolation_StorageIsComparedInBytes(t *testing.T) {
+	quota := enclave.NewEnclaveResourceQuota(enclave.NoResourceQuotaLimit, enclave.NoResourceQuotaLimit, enclave.NoResourceQuotaLimit, 1)
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, quota)
+	// each key is under a megabyte, which integer division would have counted as nothing
+	validatorEnvironment.AddPersistentKey(testPersistentKey, oneMegaByteInBytes/2+1)
+	validatorEnvironment.AddPersistentKey("logs", oneMegaByteInBytes/2+1)
+
+	violation := validatorEnvironment.GetResourceQuotaViolation()
+	require.NotNil(t, violation)
+	require.Contains(t, violation.Error(), "persistent storage: the plan requires 2 megabytes but the quota allows 1 megabytes ('data': 1, 'logs': 1)")
+}
+
+func TestMergeBranches_KeepsComponentsOfEveryBranchAndTheMostDemandingResources(t *testing.T) {
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, nil)
+
+	thenEnvironment := validatorEnvironment.Clone()
+	thenEnvironment.AddServiceName(testFooService)
+	thenEnvironment.ConsumeCPU(1000, testFooService)
+	thenEnvironment.ConsumeMemory(100, testFooService)
+
+	elseEnvironment := validatorEnvironment.Clone()
+	elseEnvironment.AddServiceName(testBarService)
+	elseEnvironment.ConsumeCPU(10, testBarService)
+	elseEnvironment.ConsumeMemory(1000, testBarService)
+
+	// the branches don't affect the environment they were cloned from
+	require.Equal(t, ComponentNotFound, validatorEnvironment.DoesServiceNameExist(testFooService))
+
+	validatorEnvironment.MergeBranches(thenEnvironment, elseEnvironment)
+	require.Equal(t, ComponentCreatedOrUpdatedDuringPackageRun, validatorEnvironment.DoesServiceNameExist(testFooService))
+	require.Equal(t, ComponentCreatedOrUpdatedDuringPackageRun, validatorEnvironment.DoesServiceNameExist(testBarService))
+	require.Nil(t, validatorEnvironment.HasEnoughCPU(availableCpuInMilliCores-1000, testFooService))
+	require.NotNil(t, validatorEnvironment.HasEnoughCPU(availableCpuInMilliCores-999, testFooService))
+	require.Nil(t, validatorEnvironment.HasEnoughMemory(availableMemoryInBytes-1000, testBarService))
+	require.NotNil(t, validatorEnvironment.HasEnoughMemory(availableMemoryInBytes-999, testBarService))
+}
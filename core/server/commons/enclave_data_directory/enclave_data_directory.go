@@ -30,6 +30,9 @@ const (
 
 	// Name of directory INSIDE THE ENCLAVE DATA DIR containing the enclave database (currently the bolt dB is implemented)
 	enclaveDatabase = "enclave-database"
+
+	// Name of directory INSIDE THE ENCLAVE DATA DIR where package archives pulled from OCI registries are cached
+	ociArtifactsCacheDirname = "oci-artifacts-cache"
 )
 
 // A directory containing all the data associated with a certain enclave (i.e. a Docker subnetwork where services are spun up)
@@ -93,3 +96,11 @@ func (dir EnclaveDataDirectory) GetEnclaveDataDirectoryPaths() (string, string,
 
 	return repositoriesStoreDirpath, tempRepositoriesStoreDirpath, githubAuthStoreDirpath, enclaveDatabaseDirpath, nil
 }
+
+func (dir EnclaveDataDirectory) GetOciArtifactsCacheDirpath() (string, error) {
+	ociArtifactsCacheDirpath := path.Join(dir.absMountDirpath, ociArtifactsCacheDirname)
+	if err := ensureDirpathExists(ociArtifactsCacheDirpath); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred ensuring the OCI artifacts cache dirpath '%v' exists.", ociArtifactsCacheDirpath)
+	}
+	return ociArtifactsCacheDirpath, nil
+}
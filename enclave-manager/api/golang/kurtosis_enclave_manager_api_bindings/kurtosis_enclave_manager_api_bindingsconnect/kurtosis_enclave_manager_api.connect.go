@@ -101,6 +101,12 @@ const (
 	// KurtosisEnclaveManagerServerUpgradeKurtosisVersionProcedure is the fully-qualified name of the
 	// KurtosisEnclaveManagerServer's UpgradeKurtosisVersion RPC.
 	KurtosisEnclaveManagerServerUpgradeKurtosisVersionProcedure = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/UpgradeKurtosisVersion"
+	// KurtosisEnclaveManagerServerWatchEnclaveProcedure is the fully-qualified name of the
+	// KurtosisEnclaveManagerServer's WatchEnclave RPC.
+	KurtosisEnclaveManagerServerWatchEnclaveProcedure = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/WatchEnclave"
+	// KurtosisEnclaveManagerServerGetEnclaveTopologyProcedure is the fully-qualified name of the
+	// KurtosisEnclaveManagerServer's GetEnclaveTopology RPC.
+	KurtosisEnclaveManagerServerGetEnclaveTopologyProcedure = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/GetEnclaveTopology"
 )
 
 // KurtosisEnclaveManagerServerClient is a client for the
@@ -127,6 +133,10 @@ type KurtosisEnclaveManagerServerClient interface {
 	AddAlias(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.AddAliasRequest]) (*connect.Response[emptypb.Empty], error)
 	IsNewKurtosisVersionAvailable(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_backend_server_rpc_api_bindings.IsNewKurtosisVersionAvailableResponse], error)
 	UpgradeKurtosisVersion(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// Streams the changes to the services and files artifacts of an enclave, the progress of the Starlark runs executed in
+	// it, and the topology of the enclave whenever it changes
+	WatchEnclave(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.WatchEnclaveRequest]) (*connect.ServerStreamForClient[kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent], error)
+	GetEnclaveTopology(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.GetEnclaveTopologyRequest]) (*connect.Response[kurtosis_enclave_manager_api_bindings.EnclaveTopology], error)
 }
 
 // NewKurtosisEnclaveManagerServerClient constructs a client for the
@@ -245,6 +255,16 @@ func NewKurtosisEnclaveManagerServerClient(httpClient connect.HTTPClient, baseUR
 			baseURL+KurtosisEnclaveManagerServerUpgradeKurtosisVersionProcedure,
 			opts...,
 		),
+		watchEnclave: connect.NewClient[kurtosis_enclave_manager_api_bindings.WatchEnclaveRequest, kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent](
+			httpClient,
+			baseURL+KurtosisEnclaveManagerServerWatchEnclaveProcedure,
+			opts...,
+		),
+		getEnclaveTopology: connect.NewClient[kurtosis_enclave_manager_api_bindings.GetEnclaveTopologyRequest, kurtosis_enclave_manager_api_bindings.EnclaveTopology](
+			httpClient,
+			baseURL+KurtosisEnclaveManagerServerGetEnclaveTopologyProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -271,6 +291,8 @@ type kurtosisEnclaveManagerServerClient struct {
 	addAlias                       *connect.Client[kurtosis_enclave_manager_api_bindings.AddAliasRequest, emptypb.Empty]
 	isNewKurtosisVersionAvailable  *connect.Client[emptypb.Empty, kurtosis_backend_server_rpc_api_bindings.IsNewKurtosisVersionAvailableResponse]
 	upgradeKurtosisVersion         *connect.Client[emptypb.Empty, emptypb.Empty]
+	watchEnclave                   *connect.Client[kurtosis_enclave_manager_api_bindings.WatchEnclaveRequest, kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent]
+	getEnclaveTopology             *connect.Client[kurtosis_enclave_manager_api_bindings.GetEnclaveTopologyRequest, kurtosis_enclave_manager_api_bindings.EnclaveTopology]
 }
 
 // Check calls kurtosis_enclave_manager.KurtosisEnclaveManagerServer.Check.
@@ -388,6 +410,17 @@ func (c *kurtosisEnclaveManagerServerClient) UpgradeKurtosisVersion(ctx context.
 	return c.upgradeKurtosisVersion.CallUnary(ctx, req)
 }
 
+// WatchEnclave calls kurtosis_enclave_manager.KurtosisEnclaveManagerServer.WatchEnclave.
+func (c *kurtosisEnclaveManagerServerClient) WatchEnclave(ctx context.Context, req *connect.Request[kurtosis_enclave_manager_api_bindings.WatchEnclaveRequest]) (*connect.ServerStreamForClient[kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent], error) {
+	return c.watchEnclave.CallServerStream(ctx, req)
+}
+
+// GetEnclaveTopology calls
+// kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetEnclaveTopology.
+func (c *kurtosisEnclaveManagerServerClient) GetEnclaveTopology(ctx context.Context, req *connect.Request[kurtosis_enclave_manager_api_bindings.GetEnclaveTopologyRequest]) (*connect.Response[kurtosis_enclave_manager_api_bindings.EnclaveTopology], error) {
+	return c.getEnclaveTopology.CallUnary(ctx, req)
+}
+
 // KurtosisEnclaveManagerServerHandler is an implementation of the
 // kurtosis_enclave_manager.KurtosisEnclaveManagerServer service.
 type KurtosisEnclaveManagerServerHandler interface {
@@ -412,6 +445,10 @@ type KurtosisEnclaveManagerServerHandler interface {
 	AddAlias(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.AddAliasRequest]) (*connect.Response[emptypb.Empty], error)
 	IsNewKurtosisVersionAvailable(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_backend_server_rpc_api_bindings.IsNewKurtosisVersionAvailableResponse], error)
 	UpgradeKurtosisVersion(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// Streams the changes to the services and files artifacts of an enclave, the progress of the Starlark runs executed in
+	// it, and the topology of the enclave whenever it changes
+	WatchEnclave(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.WatchEnclaveRequest], *connect.ServerStream[kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent]) error
+	GetEnclaveTopology(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.GetEnclaveTopologyRequest]) (*connect.Response[kurtosis_enclave_manager_api_bindings.EnclaveTopology], error)
 }
 
 // NewKurtosisEnclaveManagerServerHandler builds an HTTP handler from the service implementation. It
@@ -525,6 +562,16 @@ func NewKurtosisEnclaveManagerServerHandler(svc KurtosisEnclaveManagerServerHand
 		svc.UpgradeKurtosisVersion,
 		opts...,
 	)
+	kurtosisEnclaveManagerServerWatchEnclaveHandler := connect.NewServerStreamHandler(
+		KurtosisEnclaveManagerServerWatchEnclaveProcedure,
+		svc.WatchEnclave,
+		opts...,
+	)
+	kurtosisEnclaveManagerServerGetEnclaveTopologyHandler := connect.NewUnaryHandler(
+		KurtosisEnclaveManagerServerGetEnclaveTopologyProcedure,
+		svc.GetEnclaveTopology,
+		opts...,
+	)
 	return "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case KurtosisEnclaveManagerServerCheckProcedure:
@@ -569,6 +616,10 @@ func NewKurtosisEnclaveManagerServerHandler(svc KurtosisEnclaveManagerServerHand
 			kurtosisEnclaveManagerServerIsNewKurtosisVersionAvailableHandler.ServeHTTP(w, r)
 		case KurtosisEnclaveManagerServerUpgradeKurtosisVersionProcedure:
 			kurtosisEnclaveManagerServerUpgradeKurtosisVersionHandler.ServeHTTP(w, r)
+		case KurtosisEnclaveManagerServerWatchEnclaveProcedure:
+			kurtosisEnclaveManagerServerWatchEnclaveHandler.ServeHTTP(w, r)
+		case KurtosisEnclaveManagerServerGetEnclaveTopologyProcedure:
+			kurtosisEnclaveManagerServerGetEnclaveTopologyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -661,3 +712,11 @@ func (UnimplementedKurtosisEnclaveManagerServerHandler) IsNewKurtosisVersionAvai
 func (UnimplementedKurtosisEnclaveManagerServerHandler) UpgradeKurtosisVersion(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kurtosis_enclave_manager.KurtosisEnclaveManagerServer.UpgradeKurtosisVersion is not implemented"))
 }
+
+func (UnimplementedKurtosisEnclaveManagerServerHandler) WatchEnclave(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.WatchEnclaveRequest], *connect.ServerStream[kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("kurtosis_enclave_manager.KurtosisEnclaveManagerServer.WatchEnclave is not implemented"))
+}
+
+func (UnimplementedKurtosisEnclaveManagerServerHandler) GetEnclaveTopology(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.GetEnclaveTopologyRequest]) (*connect.Response[kurtosis_enclave_manager_api_bindings.EnclaveTopology], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetEnclaveTopology is not implemented"))
+}
@@ -44,6 +44,8 @@ const (
 	KurtosisEnclaveManagerServer_AddAlias_FullMethodName                       = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/AddAlias"
 	KurtosisEnclaveManagerServer_IsNewKurtosisVersionAvailable_FullMethodName  = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/IsNewKurtosisVersionAvailable"
 	KurtosisEnclaveManagerServer_UpgradeKurtosisVersion_FullMethodName         = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/UpgradeKurtosisVersion"
+	KurtosisEnclaveManagerServer_WatchEnclave_FullMethodName                   = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/WatchEnclave"
+	KurtosisEnclaveManagerServer_GetEnclaveTopology_FullMethodName             = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/GetEnclaveTopology"
 )
 
 // KurtosisEnclaveManagerServerClient is the client API for KurtosisEnclaveManagerServer service.
@@ -71,6 +73,10 @@ type KurtosisEnclaveManagerServerClient interface {
 	AddAlias(ctx context.Context, in *AddAliasRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	IsNewKurtosisVersionAvailable(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*kurtosis_backend_server_rpc_api_bindings.IsNewKurtosisVersionAvailableResponse, error)
 	UpgradeKurtosisVersion(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Streams the changes to the services and files artifacts of an enclave, the progress of the Starlark runs executed in
+	// it, and the topology of the enclave whenever it changes
+	WatchEnclave(ctx context.Context, in *WatchEnclaveRequest, opts ...grpc.CallOption) (KurtosisEnclaveManagerServer_WatchEnclaveClient, error)
+	GetEnclaveTopology(ctx context.Context, in *GetEnclaveTopologyRequest, opts ...grpc.CallOption) (*EnclaveTopology, error)
 }
 
 type kurtosisEnclaveManagerServerClient struct {
@@ -362,6 +368,47 @@ func (c *kurtosisEnclaveManagerServerClient) UpgradeKurtosisVersion(ctx context.
 	return out, nil
 }
 
+func (c *kurtosisEnclaveManagerServerClient) WatchEnclave(ctx context.Context, in *WatchEnclaveRequest, opts ...grpc.CallOption) (KurtosisEnclaveManagerServer_WatchEnclaveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KurtosisEnclaveManagerServer_ServiceDesc.Streams[4], KurtosisEnclaveManagerServer_WatchEnclave_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kurtosisEnclaveManagerServerWatchEnclaveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KurtosisEnclaveManagerServer_WatchEnclaveClient interface {
+	Recv() (*WatchEnclaveEvent, error)
+	grpc.ClientStream
+}
+
+type kurtosisEnclaveManagerServerWatchEnclaveClient struct {
+	grpc.ClientStream
+}
+
+func (x *kurtosisEnclaveManagerServerWatchEnclaveClient) Recv() (*WatchEnclaveEvent, error) {
+	m := new(WatchEnclaveEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *kurtosisEnclaveManagerServerClient) GetEnclaveTopology(ctx context.Context, in *GetEnclaveTopologyRequest, opts ...grpc.CallOption) (*EnclaveTopology, error) {
+	out := new(EnclaveTopology)
+	err := c.cc.Invoke(ctx, KurtosisEnclaveManagerServer_GetEnclaveTopology_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KurtosisEnclaveManagerServerServer is the server API for KurtosisEnclaveManagerServer service.
 // All implementations should embed UnimplementedKurtosisEnclaveManagerServerServer
 // for forward compatibility
@@ -387,6 +434,10 @@ type KurtosisEnclaveManagerServerServer interface {
 	AddAlias(context.Context, *AddAliasRequest) (*emptypb.Empty, error)
 	IsNewKurtosisVersionAvailable(context.Context, *emptypb.Empty) (*kurtosis_backend_server_rpc_api_bindings.IsNewKurtosisVersionAvailableResponse, error)
 	UpgradeKurtosisVersion(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Streams the changes to the services and files artifacts of an enclave, the progress of the Starlark runs executed in
+	// it, and the topology of the enclave whenever it changes
+	WatchEnclave(*WatchEnclaveRequest, KurtosisEnclaveManagerServer_WatchEnclaveServer) error
+	GetEnclaveTopology(context.Context, *GetEnclaveTopologyRequest) (*EnclaveTopology, error)
 }
 
 // UnimplementedKurtosisEnclaveManagerServerServer should be embedded to have forward compatible implementations.
@@ -456,6 +507,12 @@ func (UnimplementedKurtosisEnclaveManagerServerServer) IsNewKurtosisVersionAvail
 func (UnimplementedKurtosisEnclaveManagerServerServer) UpgradeKurtosisVersion(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpgradeKurtosisVersion not implemented")
 }
+func (UnimplementedKurtosisEnclaveManagerServerServer) WatchEnclave(*WatchEnclaveRequest, KurtosisEnclaveManagerServer_WatchEnclaveServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEnclave not implemented")
+}
+func (UnimplementedKurtosisEnclaveManagerServerServer) GetEnclaveTopology(context.Context, *GetEnclaveTopologyRequest) (*EnclaveTopology, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaveTopology not implemented")
+}
 
 // UnsafeKurtosisEnclaveManagerServerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to KurtosisEnclaveManagerServerServer will
@@ -858,6 +915,45 @@ func _KurtosisEnclaveManagerServer_UpgradeKurtosisVersion_Handler(srv interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KurtosisEnclaveManagerServer_WatchEnclave_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEnclaveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KurtosisEnclaveManagerServerServer).WatchEnclave(m, &kurtosisEnclaveManagerServerWatchEnclaveServer{stream})
+}
+
+type KurtosisEnclaveManagerServer_WatchEnclaveServer interface {
+	Send(*WatchEnclaveEvent) error
+	grpc.ServerStream
+}
+
+type kurtosisEnclaveManagerServerWatchEnclaveServer struct {
+	grpc.ServerStream
+}
+
+func (x *kurtosisEnclaveManagerServerWatchEnclaveServer) Send(m *WatchEnclaveEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _KurtosisEnclaveManagerServer_GetEnclaveTopology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnclaveTopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KurtosisEnclaveManagerServerServer).GetEnclaveTopology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KurtosisEnclaveManagerServer_GetEnclaveTopology_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KurtosisEnclaveManagerServerServer).GetEnclaveTopology(ctx, req.(*GetEnclaveTopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KurtosisEnclaveManagerServer_ServiceDesc is the grpc.ServiceDesc for KurtosisEnclaveManagerServer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -933,6 +1029,10 @@ var KurtosisEnclaveManagerServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpgradeKurtosisVersion",
 			Handler:    _KurtosisEnclaveManagerServer_UpgradeKurtosisVersion_Handler,
 		},
+		{
+			MethodName: "GetEnclaveTopology",
+			Handler:    _KurtosisEnclaveManagerServer_GetEnclaveTopology_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -955,6 +1055,11 @@ var KurtosisEnclaveManagerServer_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _KurtosisEnclaveManagerServer_DownloadFilesArtifact_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchEnclave",
+			Handler:       _KurtosisEnclaveManagerServer_WatchEnclave_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "kurtosis_enclave_manager_api.proto",
 }
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AddAliasRequest, CreateRepositoryWebhookRequest, DownloadFilesArtifactRequest, GetCloudInstanceConfigRequest, GetListFilesArtifactNamesAndUuidsRequest, GetServicesRequest, GetStarlarkRunRequest, HealthCheckRequest, HealthCheckResponse, InspectFilesArtifactContentsRequest, LockUnlockPortRequest, RunStarlarkPackageRequest, RunStarlarkScriptRequest, StarlarkPackagePlanYamlArgs, StarlarkScriptPlanYamlArgs } from "./kurtosis_enclave_manager_api_pb.js";
+import { AddAliasRequest, CreateRepositoryWebhookRequest, DownloadFilesArtifactRequest, EnclaveTopology, GetCloudInstanceConfigRequest, GetEnclaveTopologyRequest, GetListFilesArtifactNamesAndUuidsRequest, GetServicesRequest, GetStarlarkRunRequest, HealthCheckRequest, HealthCheckResponse, InspectFilesArtifactContentsRequest, LockUnlockPortRequest, RunStarlarkPackageRequest, RunStarlarkScriptRequest, StarlarkPackagePlanYamlArgs, StarlarkScriptPlanYamlArgs, WatchEnclaveEvent, WatchEnclaveRequest } from "./kurtosis_enclave_manager_api_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 import { CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetServiceLogsArgs, GetServiceLogsResponse } from "./engine_service_pb.js";
 import { GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, StarlarkRunResponseLine, StreamedDataChunk } from "./api_container_service_pb.js";
@@ -204,6 +204,27 @@ export const KurtosisEnclaveManagerServer = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * Streams the changes to the services and files artifacts of an enclave, the progress of the Starlark runs executed in
+     * it, and the topology of the enclave whenever it changes
+     *
+     * @generated from rpc kurtosis_enclave_manager.KurtosisEnclaveManagerServer.WatchEnclave
+     */
+    watchEnclave: {
+      name: "WatchEnclave",
+      I: WatchEnclaveRequest,
+      O: WatchEnclaveEvent,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * @generated from rpc kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetEnclaveTopology
+     */
+    getEnclaveTopology: {
+      name: "GetEnclaveTopology",
+      I: GetEnclaveTopologyRequest,
+      O: EnclaveTopology,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -5,7 +5,31 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
-import { DownloadFilesArtifactArgs, FilesArtifactNameAndUuid, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkPackagePlanYamlArgs as StarlarkPackagePlanYamlArgs$1, StarlarkScriptPlanYamlArgs as StarlarkScriptPlanYamlArgs$1 } from "./api_container_service_pb.js";
+import { DownloadFilesArtifactArgs, FilesArtifactNameAndUuid, RunStarlarkPackageArgs, RunStarlarkScriptArgs, ServiceInfo, ServiceStatus, StarlarkPackagePlanYamlArgs as StarlarkPackagePlanYamlArgs$1, StarlarkRunFinishedEvent, StarlarkRunProgress, StarlarkScriptPlanYamlArgs as StarlarkScriptPlanYamlArgs$1 } from "./api_container_service_pb.js";
+
+/**
+ * @generated from enum kurtosis_enclave_manager.TopologyEdgeReference
+ */
+export enum TopologyEdgeReference {
+  /**
+   * The service references the IP address or hostname of the other service in an env var, command or entrypoint
+   *
+   * @generated from enum value: ADDRESS = 0;
+   */
+  ADDRESS = 0,
+
+  /**
+   * The service references the hostname of the other service along with one of its ports, e.g. "postgres:5432"
+   *
+   * @generated from enum value: PORT = 1;
+   */
+  PORT = 1,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TopologyEdgeReference)
+proto3.util.setEnumType(TopologyEdgeReference, "kurtosis_enclave_manager.TopologyEdgeReference", [
+  { no: 0, name: "ADDRESS" },
+  { no: 1, name: "PORT" },
+]);
 
 /**
  * @generated from message kurtosis_enclave_manager.GetCloudInstanceConfigRequest
@@ -728,3 +752,571 @@ export class StarlarkPackagePlanYamlArgs extends Message<StarlarkPackagePlanYaml
   }
 }
 
+/**
+ * ==============================================================================================
+ *                                        Watch Enclave
+ * ==============================================================================================
+ *
+ * @generated from message kurtosis_enclave_manager.WatchEnclaveRequest
+ */
+export class WatchEnclaveRequest extends Message<WatchEnclaveRequest> {
+  /**
+   * @generated from field: string apic_ip_address = 1;
+   */
+  apicIpAddress = "";
+
+  /**
+   * @generated from field: int32 apic_port = 2;
+   */
+  apicPort = 0;
+
+  /**
+   * How often the enclave is polled for changes, defaults to 2 seconds; values under 500 milliseconds are raised to it
+   *
+   * @generated from field: optional uint32 poll_interval_millis = 3;
+   */
+  pollIntervalMillis?: number;
+
+  constructor(data?: PartialMessage<WatchEnclaveRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kurtosis_enclave_manager.WatchEnclaveRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "apic_ip_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "apic_port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "poll_interval_millis", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchEnclaveRequest {
+    return new WatchEnclaveRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchEnclaveRequest {
+    return new WatchEnclaveRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchEnclaveRequest {
+    return new WatchEnclaveRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchEnclaveRequest | PlainMessage<WatchEnclaveRequest> | undefined, b: WatchEnclaveRequest | PlainMessage<WatchEnclaveRequest> | undefined): boolean {
+    return proto3.util.equals(WatchEnclaveRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message kurtosis_enclave_manager.WatchEnclaveEvent
+ */
+export class WatchEnclaveEvent extends Message<WatchEnclaveEvent> {
+  /**
+   * @generated from oneof kurtosis_enclave_manager.WatchEnclaveEvent.event
+   */
+  event: {
+    /**
+     * @generated from field: kurtosis_enclave_manager.ServiceAdded service_added = 1;
+     */
+    value: ServiceAdded;
+    case: "serviceAdded";
+  } | {
+    /**
+     * @generated from field: kurtosis_enclave_manager.ServiceRemoved service_removed = 2;
+     */
+    value: ServiceRemoved;
+    case: "serviceRemoved";
+  } | {
+    /**
+     * @generated from field: kurtosis_enclave_manager.ServiceStatusChanged service_status_changed = 3;
+     */
+    value: ServiceStatusChanged;
+    case: "serviceStatusChanged";
+  } | {
+    /**
+     * @generated from field: api_container_api.StarlarkRunProgress starlark_run_progress = 4;
+     */
+    value: StarlarkRunProgress;
+    case: "starlarkRunProgress";
+  } | {
+    /**
+     * @generated from field: api_container_api.StarlarkRunFinishedEvent starlark_run_finished = 5;
+     */
+    value: StarlarkRunFinishedEvent;
+    case: "starlarkRunFinished";
+  } | {
+    /**
+     * @generated from field: kurtosis_enclave_manager.FilesArtifactAdded files_artifact_added = 6;
+     */
+    value: FilesArtifactAdded;
+    case: "filesArtifactAdded";
+  } | {
+    /**
+     * @generated from field: kurtosis_enclave_manager.FilesArtifactRemoved files_artifact_removed = 7;
+     */
+    value: FilesArtifactRemoved;
+    case: "filesArtifactRemoved";
+  } | {
+    /**
+     * @generated from field: kurtosis_enclave_manager.EnclaveTopology topology = 8;
+     */
+    value: EnclaveTopology;
+    case: "topology";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<WatchEnclaveEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kurtosis_enclave_manager.WatchEnclaveEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "service_added", kind: "message", T: ServiceAdded, oneof: "event" },
+    { no: 2, name: "service_removed", kind: "message", T: ServiceRemoved, oneof: "event" },
+    { no: 3, name: "service_status_changed", kind: "message", T: ServiceStatusChanged, oneof: "event" },
+    { no: 4, name: "starlark_run_progress", kind: "message", T: StarlarkRunProgress, oneof: "event" },
+    { no: 5, name: "starlark_run_finished", kind: "message", T: StarlarkRunFinishedEvent, oneof: "event" },
+    { no: 6, name: "files_artifact_added", kind: "message", T: FilesArtifactAdded, oneof: "event" },
+    { no: 7, name: "files_artifact_removed", kind: "message", T: FilesArtifactRemoved, oneof: "event" },
+    { no: 8, name: "topology", kind: "message", T: EnclaveTopology, oneof: "event" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchEnclaveEvent {
+    return new WatchEnclaveEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchEnclaveEvent {
+    return new WatchEnclaveEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchEnclaveEvent {
+    return new WatchEnclaveEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchEnclaveEvent | PlainMessage<WatchEnclaveEvent> | undefined, b: WatchEnclaveEvent | PlainMessage<WatchEnclaveEvent> | undefined): boolean {
+    return proto3.util.equals(WatchEnclaveEvent, a, b);
+  }
+}
+
+/**
+ * Sent for every service of the enclave when the watch starts, then for every service added to it
+ *
+ * @generated from message kurtosis_enclave_manager.ServiceAdded
+ */
+export class ServiceAdded extends Message<ServiceAdded> {
+  /**
+   * @generated from field: api_container_api.ServiceInfo service_info = 1;
+   */
+  serviceInfo?: ServiceInfo;
+
+  constructor(data?: PartialMessage<ServiceAdded>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kurtosis_enclave_manager.ServiceAdded";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "service_info", kind: "message", T: ServiceInfo },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceAdded {
+    return new ServiceAdded().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceAdded {
+    return new ServiceAdded().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceAdded {
+    return new ServiceAdded().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ServiceAdded | PlainMessage<ServiceAdded> | undefined, b: ServiceAdded | PlainMessage<ServiceAdded> | undefined): boolean {
+    return proto3.util.equals(ServiceAdded, a, b);
+  }
+}
+
+/**
+ * @generated from message kurtosis_enclave_manager.ServiceRemoved
+ */
+export class ServiceRemoved extends Message<ServiceRemoved> {
+  /**
+   * @generated from field: string service_name = 1;
+   */
+  serviceName = "";
+
+  /**
+   * @generated from field: string service_uuid = 2;
+   */
+  serviceUuid = "";
+
+  constructor(data?: PartialMessage<ServiceRemoved>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kurtosis_enclave_manager.ServiceRemoved";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceRemoved {
+    return new ServiceRemoved().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceRemoved {
+    return new ServiceRemoved().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceRemoved {
+    return new ServiceRemoved().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ServiceRemoved | PlainMessage<ServiceRemoved> | undefined, b: ServiceRemoved | PlainMessage<ServiceRemoved> | undefined): boolean {
+    return proto3.util.equals(ServiceRemoved, a, b);
+  }
+}
+
+/**
+ * @generated from message kurtosis_enclave_manager.ServiceStatusChanged
+ */
+export class ServiceStatusChanged extends Message<ServiceStatusChanged> {
+  /**
+   * @generated from field: api_container_api.ServiceInfo service_info = 1;
+   */
+  serviceInfo?: ServiceInfo;
+
+  /**
+   * @generated from field: api_container_api.ServiceStatus previous_status = 2;
+   */
+  previousStatus = ServiceStatus.STOPPED;
+
+  constructor(data?: PartialMessage<ServiceStatusChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kurtosis_enclave_manager.ServiceStatusChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "service_info", kind: "message", T: ServiceInfo },
+    { no: 2, name: "previous_status", kind: "enum", T: proto3.getEnumType(ServiceStatus) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceStatusChanged {
+    return new ServiceStatusChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceStatusChanged {
+    return new ServiceStatusChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceStatusChanged {
+    return new ServiceStatusChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ServiceStatusChanged | PlainMessage<ServiceStatusChanged> | undefined, b: ServiceStatusChanged | PlainMessage<ServiceStatusChanged> | undefined): boolean {
+    return proto3.util.equals(ServiceStatusChanged, a, b);
+  }
+}
+
+/**
+ * Sent for every files artifact of the enclave when the watch starts, then for every files artifact added to it
+ *
+ * @generated from message kurtosis_enclave_manager.FilesArtifactAdded
+ */
+export class FilesArtifactAdded extends Message<FilesArtifactAdded> {
+  /**
+   * @generated from field: api_container_api.FilesArtifactNameAndUuid files_artifact = 1;
+   */
+  filesArtifact?: FilesArtifactNameAndUuid;
+
+  constructor(data?: PartialMessage<FilesArtifactAdded>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kurtosis_enclave_manager.FilesArtifactAdded";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "files_artifact", kind: "message", T: FilesArtifactNameAndUuid },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FilesArtifactAdded {
+    return new FilesArtifactAdded().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FilesArtifactAdded {
+    return new FilesArtifactAdded().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FilesArtifactAdded {
+    return new FilesArtifactAdded().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FilesArtifactAdded | PlainMessage<FilesArtifactAdded> | undefined, b: FilesArtifactAdded | PlainMessage<FilesArtifactAdded> | undefined): boolean {
+    return proto3.util.equals(FilesArtifactAdded, a, b);
+  }
+}
+
+/**
+ * @generated from message kurtosis_enclave_manager.FilesArtifactRemoved
+ */
+export class FilesArtifactRemoved extends Message<FilesArtifactRemoved> {
+  /**
+   * @generated from field: api_container_api.FilesArtifactNameAndUuid files_artifact = 1;
+   */
+  filesArtifact?: FilesArtifactNameAndUuid;
+
+  constructor(data?: PartialMessage<FilesArtifactRemoved>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kurtosis_enclave_manager.FilesArtifactRemoved";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "files_artifact", kind: "message", T: FilesArtifactNameAndUuid },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FilesArtifactRemoved {
+    return new FilesArtifactRemoved().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FilesArtifactRemoved {
+    return new FilesArtifactRemoved().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FilesArtifactRemoved {
+    return new FilesArtifactRemoved().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FilesArtifactRemoved | PlainMessage<FilesArtifactRemoved> | undefined, b: FilesArtifactRemoved | PlainMessage<FilesArtifactRemoved> | undefined): boolean {
+    return proto3.util.equals(FilesArtifactRemoved, a, b);
+  }
+}
+
+/**
+ * ==============================================================================================
+ *                                     Get Enclave Topology
+ * ==============================================================================================
+ *
+ * @generated from message kurtosis_enclave_manager.GetEnclaveTopologyRequest
+ */
+export class GetEnclaveTopologyRequest extends Message<GetEnclaveTopologyRequest> {
+  /**
+   * @generated from field: string apic_ip_address = 1;
+   */
+  apicIpAddress = "";
+
+  /**
+   * @generated from field: int32 apic_port = 2;
+   */
+  apicPort = 0;
+
+  constructor(data?: PartialMessage<GetEnclaveTopologyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kurtosis_enclave_manager.GetEnclaveTopologyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "apic_ip_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "apic_port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclaveTopologyRequest {
+    return new GetEnclaveTopologyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclaveTopologyRequest {
+    return new GetEnclaveTopologyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclaveTopologyRequest {
+    return new GetEnclaveTopologyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetEnclaveTopologyRequest | PlainMessage<GetEnclaveTopologyRequest> | undefined, b: GetEnclaveTopologyRequest | PlainMessage<GetEnclaveTopologyRequest> | undefined): boolean {
+    return proto3.util.equals(GetEnclaveTopologyRequest, a, b);
+  }
+}
+
+/**
+ * The services of the last Starlark run of the enclave, and the dependencies between them
+ *
+ * @generated from message kurtosis_enclave_manager.EnclaveTopology
+ */
+export class EnclaveTopology extends Message<EnclaveTopology> {
+  /**
+   * @generated from field: repeated kurtosis_enclave_manager.TopologyNode nodes = 1;
+   */
+  nodes: TopologyNode[] = [];
+
+  /**
+   * @generated from field: repeated kurtosis_enclave_manager.TopologyEdge edges = 2;
+   */
+  edges: TopologyEdge[] = [];
+
+  constructor(data?: PartialMessage<EnclaveTopology>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kurtosis_enclave_manager.EnclaveTopology";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "nodes", kind: "message", T: TopologyNode, repeated: true },
+    { no: 2, name: "edges", kind: "message", T: TopologyEdge, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclaveTopology {
+    return new EnclaveTopology().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclaveTopology {
+    return new EnclaveTopology().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclaveTopology {
+    return new EnclaveTopology().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EnclaveTopology | PlainMessage<EnclaveTopology> | undefined, b: EnclaveTopology | PlainMessage<EnclaveTopology> | undefined): boolean {
+    return proto3.util.equals(EnclaveTopology, a, b);
+  }
+}
+
+/**
+ * @generated from message kurtosis_enclave_manager.TopologyNode
+ */
+export class TopologyNode extends Message<TopologyNode> {
+  /**
+   * @generated from field: string service_name = 1;
+   */
+  serviceName = "";
+
+  /**
+   * Empty if the service is in the plan but not in the enclave, e.g. because it was removed
+   *
+   * @generated from field: string service_uuid = 2;
+   */
+  serviceUuid = "";
+
+  /**
+   * UNKNOWN if the service is in the plan but not in the enclave
+   *
+   * @generated from field: api_container_api.ServiceStatus service_status = 3;
+   */
+  serviceStatus = ServiceStatus.STOPPED;
+
+  /**
+   * The ids of the ports of the service
+   *
+   * @generated from field: repeated string port_ids = 4;
+   */
+  portIds: string[] = [];
+
+  constructor(data?: PartialMessage<TopologyNode>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kurtosis_enclave_manager.TopologyNode";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "service_status", kind: "enum", T: proto3.getEnumType(ServiceStatus) },
+    { no: 4, name: "port_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TopologyNode {
+    return new TopologyNode().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TopologyNode {
+    return new TopologyNode().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TopologyNode {
+    return new TopologyNode().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TopologyNode | PlainMessage<TopologyNode> | undefined, b: TopologyNode | PlainMessage<TopologyNode> | undefined): boolean {
+    return proto3.util.equals(TopologyNode, a, b);
+  }
+}
+
+/**
+ * The service_name service depends on the depends_on_service_name service
+ *
+ * @generated from message kurtosis_enclave_manager.TopologyEdge
+ */
+export class TopologyEdge extends Message<TopologyEdge> {
+  /**
+   * @generated from field: string service_name = 1;
+   */
+  serviceName = "";
+
+  /**
+   * @generated from field: string depends_on_service_name = 2;
+   */
+  dependsOnServiceName = "";
+
+  /**
+   * @generated from field: kurtosis_enclave_manager.TopologyEdgeReference reference = 3;
+   */
+  reference = TopologyEdgeReference.ADDRESS;
+
+  /**
+   * Where the reference was found, e.g. "env var DATABASE_URL"
+   *
+   * @generated from field: string location = 4;
+   */
+  location = "";
+
+  /**
+   * Set for port references, the id of the referenced port
+   *
+   * @generated from field: optional string port_id = 5;
+   */
+  portId?: string;
+
+  constructor(data?: PartialMessage<TopologyEdge>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "kurtosis_enclave_manager.TopologyEdge";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "depends_on_service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reference", kind: "enum", T: proto3.getEnumType(TopologyEdgeReference) },
+    { no: 4, name: "location", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "port_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TopologyEdge {
+    return new TopologyEdge().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TopologyEdge {
+    return new TopologyEdge().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TopologyEdge {
+    return new TopologyEdge().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TopologyEdge | PlainMessage<TopologyEdge> | undefined, b: TopologyEdge | PlainMessage<TopologyEdge> | undefined): boolean {
+    return proto3.util.equals(TopologyEdge, a, b);
+  }
+}
+
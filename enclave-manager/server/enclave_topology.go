@@ -0,0 +1,316 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings/kurtosis_core_rpc_api_bindingsconnect"
+	"github.com/kurtosis-tech/kurtosis/enclave-manager/api/golang/kurtosis_enclave_manager_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// The package id the APIC stores for Starlark runs of standalone scripts
+	packageIdPlaceholderForStandaloneScript = "DEFAULT_PACKAGE_ID_FOR_SCRIPT"
+
+	planYamlIpAddressReferenceType = "ip_address"
+	planYamlHostnameReferenceType  = "hostname"
+
+	envVarLocationFormat = "env var %s"
+	commandLocation      = "command"
+	entrypointLocation   = "entrypoint"
+)
+
+var (
+	// The plan yaml swaps the runtime values of a service for "{{ kurtosis.<plan uuid>.<reference type> }}", optionally
+	// followed by a port number
+	planYamlServiceReferenceRegex = regexp.MustCompile(`\{\{ kurtosis\.([^.\s]+)\.([a-z_]+) \}\}(?::(\d+))?`)
+
+	// Services reach each other by name inside an enclave, e.g. "postgres:5432"
+	hostnameAndPortReferenceRegex = regexp.MustCompile(`(?:^|[^-A-Za-z0-9_.])([a-z0-9][-a-z0-9]*):(\d+)`)
+)
+
+// planYaml holds the parts of the plan yaml generated by the APIC the topology is derived from
+type planYaml struct {
+	Services []*planYamlService `yaml:"services,omitempty"`
+}
+
+type planYamlService struct {
+	Uuid       string            `yaml:"uuid,omitempty"`
+	Name       string            `yaml:"name,omitempty"`
+	Cmd        []string          `yaml:"command,omitempty"`
+	Entrypoint []string          `yaml:"entrypoint,omitempty"`
+	EnvVars    []*planYamlEnvVar `yaml:"envVars,omitempty"`
+	Ports      []*planYamlPort   `yaml:"ports,omitempty"`
+}
+
+type planYamlEnvVar struct {
+	Key   string `yaml:"key,omitempty"`
+	Value string `yaml:"value,omitempty"`
+}
+
+type planYamlPort struct {
+	Name   string `yaml:"name,omitempty"`
+	Number uint16 `yaml:"number,omitempty"`
+}
+
+func (c *WebServer) GetEnclaveTopology(
+	ctx context.Context,
+	req *connect.Request[kurtosis_enclave_manager_api_bindings.GetEnclaveTopologyRequest],
+) (*connect.Response[kurtosis_enclave_manager_api_bindings.EnclaveTopology], error) {
+	isValidRequest, _, err := c.ValidateRequestAuthorization(ctx, c.enforceAuth, req.Header())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Authentication attempt failed")
+	}
+	if !isValidRequest {
+		return nil, stacktrace.Propagate(err, "User not authorized")
+	}
+	apiContainerServiceClient, err := c.createAPICClient(req.Msg.ApicIpAddress, req.Msg.ApicPort)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create the APIC client")
+	}
+
+	serviceInfos, err := getAllServiceInfos(ctx, apiContainerServiceClient)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services of the enclave")
+	}
+	serializedPlanYaml, err := getLastStarlarkRunPlanYaml(ctx, apiContainerServiceClient)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the plan yaml of the last Starlark run of the enclave")
+	}
+	topology, err := newEnclaveTopology(serializedPlanYaml, serviceInfos)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deriving the topology of the enclave")
+	}
+	return connect.NewResponse(topology), nil
+}
+
+func getAllServiceInfos(
+	ctx context.Context,
+	apiContainerServiceClient *kurtosis_core_rpc_api_bindingsconnect.ApiContainerServiceClient,
+) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
+	serviceRequest := connect.NewRequest(&kurtosis_core_rpc_api_bindings.GetServicesArgs{
+		ServiceIdentifiers: map[string]bool{},
+	})
+	response, err := (*apiContainerServiceClient).GetServices(ctx, serviceRequest)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services from the APIC")
+	}
+	return response.Msg.GetServiceInfo(), nil
+}
+
+// getLastStarlarkRunPlanYaml returns the plan yaml of the last Starlark run of the enclave, or an empty string if
+// nothing was run in it yet
+func getLastStarlarkRunPlanYaml(
+	ctx context.Context,
+	apiContainerServiceClient *kurtosis_core_rpc_api_bindingsconnect.ApiContainerServiceClient,
+) (string, error) {
+	starlarkRun, err := (*apiContainerServiceClient).GetStarlarkRun(ctx, connect.NewRequest(&emptypb.Empty{}))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the last Starlark run from the APIC")
+	}
+	serializedParams := starlarkRun.Msg.GetSerializedParams()
+	mainFunctionName := starlarkRun.Msg.GetMainFunctionName()
+
+	var planYamlResponse *connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml]
+	switch starlarkRun.Msg.GetPackageId() {
+	case "":
+		return "", nil
+	case packageIdPlaceholderForStandaloneScript:
+		planYamlResponse, err = (*apiContainerServiceClient).GetStarlarkScriptPlanYaml(ctx, connect.NewRequest(&kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs{
+			SerializedScript: starlarkRun.Msg.GetSerializedScript(),
+			SerializedParams: &serializedParams,
+			MainFunctionName: &mainFunctionName,
+		}))
+	default:
+		relativePathToMainFile := starlarkRun.Msg.GetRelativePathToMainFile()
+		planYamlResponse, err = (*apiContainerServiceClient).GetStarlarkPackagePlanYaml(ctx, connect.NewRequest(&kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs{
+			PackageId:              starlarkRun.Msg.GetPackageId(),
+			SerializedParams:       &serializedParams,
+			RelativePathToMainFile: &relativePathToMainFile,
+			MainFunctionName:       &mainFunctionName,
+		}))
+	}
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the plan yaml of Starlark run of '%s' from the APIC", starlarkRun.Msg.GetPackageId())
+	}
+	return planYamlResponse.Msg.GetPlanYaml(), nil
+}
+
+// newEnclaveTopology derives the dependencies between services from the references to each other's address found in
+// the env vars, commands and entrypoints of the plan yaml. Every service of the plan or of the enclave is a node
+func newEnclaveTopology(
+	serializedPlanYaml string,
+	serviceInfos map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo,
+) (*kurtosis_enclave_manager_api_bindings.EnclaveTopology, error) {
+	plan := &planYaml{Services: nil}
+	if err := yaml.Unmarshal([]byte(serializedPlanYaml), plan); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the plan yaml")
+	}
+
+	nodes := []*kurtosis_enclave_manager_api_bindings.TopologyNode{}
+	isNodeAdded := map[string]bool{}
+	for _, service := range plan.Services {
+		if service.Name == "" || isNodeAdded[service.Name] {
+			continue
+		}
+		nodes = append(nodes, newTopologyNode(service.Name, service, serviceInfos[service.Name]))
+		isNodeAdded[service.Name] = true
+	}
+	serviceNamesOutsidePlan := []string{}
+	for serviceName := range serviceInfos {
+		if !isNodeAdded[serviceName] {
+			serviceNamesOutsidePlan = append(serviceNamesOutsidePlan, serviceName)
+		}
+	}
+	sort.Strings(serviceNamesOutsidePlan)
+	for _, serviceName := range serviceNamesOutsidePlan {
+		nodes = append(nodes, newTopologyNode(serviceName, nil, serviceInfos[serviceName]))
+	}
+
+	return &kurtosis_enclave_manager_api_bindings.EnclaveTopology{
+		Nodes: nodes,
+		Edges: newTopologyEdges(plan.Services),
+	}, nil
+}
+
+func newTopologyNode(
+	serviceName string,
+	maybePlanService *planYamlService,
+	maybeServiceInfo *kurtosis_core_rpc_api_bindings.ServiceInfo,
+) *kurtosis_enclave_manager_api_bindings.TopologyNode {
+	node := &kurtosis_enclave_manager_api_bindings.TopologyNode{
+		ServiceName:   serviceName,
+		ServiceUuid:   "",
+		ServiceStatus: kurtosis_core_rpc_api_bindings.ServiceStatus_UNKNOWN,
+		PortIds:       []string{},
+	}
+	if maybeServiceInfo != nil {
+		node.ServiceUuid = maybeServiceInfo.GetServiceUuid()
+		node.ServiceStatus = maybeServiceInfo.GetServiceStatus()
+		for portId := range maybeServiceInfo.GetPrivatePorts() {
+			node.PortIds = append(node.PortIds, portId)
+		}
+	} else if maybePlanService != nil {
+		for _, port := range maybePlanService.Ports {
+			node.PortIds = append(node.PortIds, port.Name)
+		}
+	}
+	sort.Strings(node.PortIds)
+	return node
+}
+
+func newTopologyEdges(planServices []*planYamlService) []*kurtosis_enclave_manager_api_bindings.TopologyEdge {
+	servicesByPlanUuid := map[string]*planYamlService{}
+	servicesByName := map[string]*planYamlService{}
+	for _, service := range planServices {
+		servicesByPlanUuid[service.Uuid] = service
+		servicesByName[service.Name] = service
+	}
+
+	edges := []*kurtosis_enclave_manager_api_bindings.TopologyEdge{}
+	isEdgeAdded := map[string]bool{}
+	addEdges := func(service *planYamlService, value string, location string) {
+		for _, edge := range findServiceReferences(service, value, location, servicesByPlanUuid, servicesByName) {
+			edgeKey := fmt.Sprintf("%s|%s|%v|%s|%s", edge.ServiceName, edge.DependsOnServiceName, edge.Reference, edge.Location, edge.GetPortId())
+			if isEdgeAdded[edgeKey] {
+				continue
+			}
+			edges = append(edges, edge)
+			isEdgeAdded[edgeKey] = true
+		}
+	}
+	for _, service := range planServices {
+		for _, envVar := range service.EnvVars {
+			addEdges(service, envVar.Value, fmt.Sprintf(envVarLocationFormat, envVar.Key))
+		}
+		for _, cmdArg := range service.Cmd {
+			addEdges(service, cmdArg, commandLocation)
+		}
+		for _, entrypointArg := range service.Entrypoint {
+			addEdges(service, entrypointArg, entrypointLocation)
+		}
+	}
+	return edges
+}
+
+func findServiceReferences(
+	service *planYamlService,
+	value string,
+	location string,
+	servicesByPlanUuid map[string]*planYamlService,
+	servicesByName map[string]*planYamlService,
+) []*kurtosis_enclave_manager_api_bindings.TopologyEdge {
+	edges := []*kurtosis_enclave_manager_api_bindings.TopologyEdge{}
+	for _, match := range planYamlServiceReferenceRegex.FindAllStringSubmatch(value, -1) {
+		referencedService, found := servicesByPlanUuid[match[1]]
+		if !found || referencedService == service {
+			continue
+		}
+		if match[2] != planYamlIpAddressReferenceType && match[2] != planYamlHostnameReferenceType {
+			continue
+		}
+		edges = append(edges, newTopologyEdge(service, referencedService, location, match[3]))
+	}
+	for _, match := range hostnameAndPortReferenceRegex.FindAllStringSubmatch(value, -1) {
+		referencedService, found := servicesByName[match[1]]
+		if !found || referencedService == service {
+			continue
+		}
+		if findPortId(referencedService, match[2]) == nil {
+			continue
+		}
+		edges = append(edges, newTopologyEdge(service, referencedService, location, match[2]))
+	}
+	return edges
+}
+
+// newTopologyEdge creates a port reference edge if the port number is one of the ports of the referenced service, and
+// an address reference edge otherwise
+func newTopologyEdge(
+	service *planYamlService,
+	referencedService *planYamlService,
+	location string,
+	maybePortNumber string,
+) *kurtosis_enclave_manager_api_bindings.TopologyEdge {
+	edge := &kurtosis_enclave_manager_api_bindings.TopologyEdge{
+		ServiceName:          service.Name,
+		DependsOnServiceName: referencedService.Name,
+		Reference:            kurtosis_enclave_manager_api_bindings.TopologyEdgeReference_ADDRESS,
+		Location:             location,
+		PortId:               nil,
+	}
+	if portId := findPortId(referencedService, maybePortNumber); portId != nil {
+		edge.Reference = kurtosis_enclave_manager_api_bindings.TopologyEdgeReference_PORT
+		edge.PortId = portId
+	}
+	return edge
+}
+
+func findPortId(service *planYamlService, portNumberStr string) *string {
+	portNumber, err := strconv.ParseUint(portNumberStr, 10, 16)
+	if err != nil {
+		return nil
+	}
+	for _, port := range service.Ports {
+		if uint64(port.Number) == portNumber {
+			portId := port.Name
+			return &portId
+		}
+	}
+	return nil
+}
+
+// logTopologyPlanYamlError logs the error preventing the plan yaml from being retrieved; the topology then only holds
+// the services of the enclave, without dependencies
+func logTopologyPlanYamlError(err error) {
+	logrus.Warnf("The dependencies between the services of the enclave can't be derived as the plan yaml of its last Starlark run couldn't be retrieved:\n%v", err)
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/enclave-manager/api/golang/kurtosis_enclave_manager_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPlanYaml = `packageId: DEFAULT_PACKAGE_ID_FOR_SCRIPT
+services:
+- uuid: "1"
+  name: postgres
+  image:
+    name: postgres:alpine
+  envVars:
+  - key: POSTGRES_PASSWORD
+    value: password
+  ports:
+  - name: postgres
+    number: 5432
+    transportProtocol: TCP
+- uuid: "2"
+  name: api
+  image:
+    name: api:latest
+  command:
+  - --db-host={{ kurtosis.1.ip_address }}
+  envVars:
+  - key: DATABASE_URL
+    value: postgresql://{{ kurtosis.1.hostname }}:5432/db
+  - key: SELF
+    value: api:8080
+  ports:
+  - name: http
+    number: 8080
+- uuid: "3"
+  name: frontend
+  image:
+    name: frontend:latest
+  entrypoint:
+  - /start.sh
+  - http://api:8080
+  - http://api:9999
+`
+)
+
+func TestNewEnclaveTopology(t *testing.T) {
+	serviceInfos := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+		"postgres": newTestServiceInfo("postgres", "postgres-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING, "postgres"),
+		"api":      newTestServiceInfo("api", "api-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_STOPPED, "http"),
+		"added":    newTestServiceInfo("added", "added-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING),
+	}
+
+	topology, err := newEnclaveTopology(testPlanYaml, serviceInfos)
+	require.NoError(t, err)
+
+	require.Len(t, topology.Nodes, 4)
+	require.Equal(t, "postgres", topology.Nodes[0].ServiceName)
+	require.Equal(t, "postgres-uuid", topology.Nodes[0].ServiceUuid)
+	require.Equal(t, kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING, topology.Nodes[0].ServiceStatus)
+	require.Equal(t, []string{"postgres"}, topology.Nodes[0].PortIds)
+	require.Equal(t, "api", topology.Nodes[1].ServiceName)
+	require.Equal(t, kurtosis_core_rpc_api_bindings.ServiceStatus_STOPPED, topology.Nodes[1].ServiceStatus)
+	// In the plan but not in the enclave
+	require.Equal(t, "frontend", topology.Nodes[2].ServiceName)
+	require.Empty(t, topology.Nodes[2].ServiceUuid)
+	require.Equal(t, kurtosis_core_rpc_api_bindings.ServiceStatus_UNKNOWN, topology.Nodes[2].ServiceStatus)
+	// In the enclave but not in the plan
+	require.Equal(t, "added", topology.Nodes[3].ServiceName)
+
+	postgresPortId := "postgres"
+	httpPortId := "http"
+	expectedEdges := []*kurtosis_enclave_manager_api_bindings.TopologyEdge{
+		{
+			ServiceName:          "api",
+			DependsOnServiceName: "postgres",
+			Reference:            kurtosis_enclave_manager_api_bindings.TopologyEdgeReference_PORT,
+			Location:             "env var DATABASE_URL",
+			PortId:               &postgresPortId,
+		},
+		{
+			ServiceName:          "api",
+			DependsOnServiceName: "postgres",
+			Reference:            kurtosis_enclave_manager_api_bindings.TopologyEdgeReference_ADDRESS,
+			Location:             "command",
+			PortId:               nil,
+		},
+		{
+			ServiceName:          "frontend",
+			DependsOnServiceName: "api",
+			Reference:            kurtosis_enclave_manager_api_bindings.TopologyEdgeReference_PORT,
+			Location:             "entrypoint",
+			PortId:               &httpPortId,
+		},
+	}
+	require.Len(t, topology.Edges, len(expectedEdges))
+	for idx, expectedEdge := range expectedEdges {
+		require.Equal(t, expectedEdge.ServiceName, topology.Edges[idx].ServiceName)
+		require.Equal(t, expectedEdge.DependsOnServiceName, topology.Edges[idx].DependsOnServiceName)
+		require.Equal(t, expectedEdge.Reference, topology.Edges[idx].Reference)
+		require.Equal(t, expectedEdge.Location, topology.Edges[idx].Location)
+		require.Equal(t, expectedEdge.PortId, topology.Edges[idx].PortId)
+	}
+}
+
+func TestNewEnclaveTopology_NoPlan(t *testing.T) {
+	serviceInfos := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+		"b": newTestServiceInfo("b", "b-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING),
+		"a": newTestServiceInfo("a", "a-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING),
+	}
+
+	topology, err := newEnclaveTopology("", serviceInfos)
+	require.NoError(t, err)
+	require.Len(t, topology.Nodes, 2)
+	require.Equal(t, "a", topology.Nodes[0].ServiceName)
+	require.Equal(t, "b", topology.Nodes[1].ServiceName)
+	require.Empty(t, topology.Edges)
+}
+
+func TestNewEnclaveTopology_InvalidPlanYaml(t *testing.T) {
+	_, err := newEnclaveTopology("services: {", map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{})
+	require.Error(t, err)
+}
+
+func newTestServiceInfo(
+	name string,
+	uuid string,
+	status kurtosis_core_rpc_api_bindings.ServiceStatus,
+	portIds ...string,
+) *kurtosis_core_rpc_api_bindings.ServiceInfo {
+	privatePorts := map[string]*kurtosis_core_rpc_api_bindings.Port{}
+	for _, portId := range portIds {
+		privatePorts[portId] = &kurtosis_core_rpc_api_bindings.Port{} // nolint: exhaustruct
+	}
+	return &kurtosis_core_rpc_api_bindings.ServiceInfo{ // nolint: exhaustruct
+		ServiceUuid:   uuid,
+		Name:          name,
+		ServiceStatus: status,
+		PrivatePorts:  privatePorts,
+	}
+}
@@ -286,7 +286,8 @@ func (c *WebServer) GetEnclaves(ctx context.Context, req *connect.Request[emptyp
 	if !isValidRequest {
 		return nil, stacktrace.Propagate(err, "User not authorized")
 	}
-	enclaves, err := (*c.engineServiceClient).GetEnclaves(ctx, req)
+	getEnclavesReq := connect.NewRequest(&kurtosis_engine_rpc_api_bindings.GetEnclavesArgs{Labels: nil})
+	enclaves, err := (*c.engineServiceClient).GetEnclaves(ctx, getEnclavesReq)
 	if err != nil {
 		return nil, err
 	}
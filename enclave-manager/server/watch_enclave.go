@@ -0,0 +1,329 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings/kurtosis_core_rpc_api_bindingsconnect"
+	"github.com/kurtosis-tech/kurtosis/enclave-manager/api/golang/kurtosis_enclave_manager_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	defaultWatchEnclavePollInterval = 2 * time.Second
+	minWatchEnclavePollInterval     = 500 * time.Millisecond
+)
+
+// enclaveSnapshot is the state of an enclave at one poll, the APIC offering no way to be notified of changes
+type enclaveSnapshot struct {
+	servicesByUuid       map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo
+	filesArtifactsByUuid map[string]*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid
+}
+
+// enclaveWatcher polls an enclave and turns the differences between polls into events
+type enclaveWatcher struct {
+	apiContainerServiceClient *kurtosis_core_rpc_api_bindingsconnect.ApiContainerServiceClient
+
+	previousSnapshot *enclaveSnapshot
+
+	// The plan yaml is only retrieved again when the services of the enclave change, as it requires interpreting the
+	// last Starlark run
+	serializedPlanYaml string
+	previousTopology   *kurtosis_enclave_manager_api_bindings.EnclaveTopology
+}
+
+func (c *WebServer) WatchEnclave(
+	ctx context.Context,
+	req *connect.Request[kurtosis_enclave_manager_api_bindings.WatchEnclaveRequest],
+	responseStream *connect.ServerStream[kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent],
+) error {
+	isValidRequest, _, err := c.ValidateRequestAuthorization(ctx, c.enforceAuth, req.Header())
+	if err != nil {
+		return stacktrace.Propagate(err, "Authentication attempt failed")
+	}
+	if !isValidRequest {
+		return stacktrace.Propagate(err, "User not authorized")
+	}
+	apiContainerServiceClient, err := c.createAPICClient(req.Msg.ApicIpAddress, req.Msg.ApicPort)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to create the APIC client")
+	}
+
+	// Watching first so the progress of a run started while the enclave gets polled for the first time isn't missed
+	watchCtx, cancelWatchFunc := context.WithCancel(ctx)
+	defer cancelWatchFunc()
+	runProgressLines := watchStarlarkRunProgress(watchCtx, apiContainerServiceClient)
+
+	watcher := newEnclaveWatcher(apiContainerServiceClient)
+	sendRefreshEvents := func(shouldRefreshPlanYaml bool) error {
+		events, err := watcher.refresh(ctx, shouldRefreshPlanYaml)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred polling the enclave with APIC '%s'", newApicAddress(req.Msg.ApicIpAddress, req.Msg.ApicPort))
+		}
+		for _, event := range events {
+			if err := responseStream.Send(event); err != nil {
+				return stacktrace.Propagate(err, "An error occurred in the enclave manager server attempting to send an enclave event")
+			}
+		}
+		return nil
+	}
+
+	if err := sendRefreshEvents(true); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(getWatchEnclavePollInterval(req.Msg))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := sendRefreshEvents(false); err != nil {
+				return err
+			}
+		case line, isChanOpen := <-runProgressLines:
+			if !isChanOpen {
+				// The enclave keeps being polled, only the progress of the runs is missing
+				runProgressLines = nil
+				continue
+			}
+			event := newStarlarkRunWatchEnclaveEvent(line)
+			if event == nil {
+				continue
+			}
+			if err := responseStream.Send(event); err != nil {
+				return stacktrace.Propagate(err, "An error occurred in the enclave manager server attempting to send the progress of a Starlark run")
+			}
+			// The run may have changed the plan without adding or removing services
+			if line.GetRunFinishedEvent() != nil {
+				if err := sendRefreshEvents(true); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// watchStarlarkRunProgress returns the channel the APIC sends the progress and run finished lines of every run in the
+// enclave to, whoever started the run. The channel is closed once the APIC stops sending them
+func watchStarlarkRunProgress(
+	ctx context.Context,
+	apiContainerServiceClient *kurtosis_core_rpc_api_bindingsconnect.ApiContainerServiceClient,
+) chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	runProgressLines := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+	go func() {
+		defer close(runProgressLines)
+		runProgressStream, err := (*apiContainerServiceClient).WatchStarlarkRunProgress(ctx, connect.NewRequest(&emptypb.Empty{}))
+		if err != nil {
+			logrus.Warnf("Couldn't watch the progress of the Starlark runs of the enclave, it won't be reported. Error was:\n%v", err)
+			return
+		}
+		defer runProgressStream.Close()
+		for runProgressStream.Receive() {
+			select {
+			case runProgressLines <- runProgressStream.Msg():
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := runProgressStream.Err(); err != nil && ctx.Err() == nil {
+			logrus.Warnf("Stopped watching the progress of the Starlark runs of the enclave, it won't be reported anymore. Error was:\n%v", err)
+		}
+	}()
+	return runProgressLines
+}
+
+func newEnclaveWatcher(apiContainerServiceClient *kurtosis_core_rpc_api_bindingsconnect.ApiContainerServiceClient) *enclaveWatcher {
+	return &enclaveWatcher{
+		apiContainerServiceClient: apiContainerServiceClient,
+		previousSnapshot:          newEmptyEnclaveSnapshot(),
+		serializedPlanYaml:        "",
+		previousTopology:          nil,
+	}
+}
+
+// refresh polls the enclave, returning the events for what changed since the previous poll. The first poll reports
+// everything in the enclave as added, along with its topology
+func (watcher *enclaveWatcher) refresh(ctx context.Context, shouldRefreshPlanYaml bool) ([]*kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent, error) {
+	serviceInfos, err := getAllServiceInfos(ctx, watcher.apiContainerServiceClient)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services of the enclave")
+	}
+	filesArtifacts, err := (*watcher.apiContainerServiceClient).ListFilesArtifactNamesAndUuids(ctx, connect.NewRequest(&emptypb.Empty{}))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the files artifacts of the enclave")
+	}
+	snapshot := newEnclaveSnapshot(serviceInfos, filesArtifacts.Msg.GetFileNamesAndUuids())
+
+	events, haveServicesChanged := diffEnclaveSnapshots(watcher.previousSnapshot, snapshot)
+	watcher.previousSnapshot = snapshot
+
+	if shouldRefreshPlanYaml || haveServicesChanged {
+		serializedPlanYaml, err := getLastStarlarkRunPlanYaml(ctx, watcher.apiContainerServiceClient)
+		if err != nil {
+			logTopologyPlanYamlError(err)
+			serializedPlanYaml = ""
+		}
+		watcher.serializedPlanYaml = serializedPlanYaml
+	}
+	topology, err := newEnclaveTopology(watcher.serializedPlanYaml, serviceInfos)
+	if err != nil {
+		logTopologyPlanYamlError(err)
+		if topology, err = newEnclaveTopology("", serviceInfos); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deriving the topology of the enclave from its services")
+		}
+	}
+	if !proto.Equal(topology, watcher.previousTopology) {
+		events = append(events, &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent{
+			Event: &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent_Topology{Topology: topology},
+		})
+		watcher.previousTopology = topology
+	}
+	return events, nil
+}
+
+func newEmptyEnclaveSnapshot() *enclaveSnapshot {
+	return newEnclaveSnapshot(nil, nil)
+}
+
+func newEnclaveSnapshot(
+	serviceInfos map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo,
+	filesArtifacts []*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid,
+) *enclaveSnapshot {
+	snapshot := &enclaveSnapshot{
+		servicesByUuid:       map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{},
+		filesArtifactsByUuid: map[string]*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid{},
+	}
+	for _, serviceInfo := range serviceInfos {
+		snapshot.servicesByUuid[serviceInfo.GetServiceUuid()] = serviceInfo
+	}
+	for _, filesArtifact := range filesArtifacts {
+		snapshot.filesArtifactsByUuid[filesArtifact.GetFileUuid()] = filesArtifact
+	}
+	return snapshot
+}
+
+// diffEnclaveSnapshots returns the events turning the previous snapshot into the current one, ordered by name, and
+// whether services were added or removed
+func diffEnclaveSnapshots(previous *enclaveSnapshot, current *enclaveSnapshot) ([]*kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent, bool) {
+	events := []*kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent{}
+	haveServicesChanged := false
+
+	for _, serviceInfo := range sortServiceInfosByName(previous.servicesByUuid) {
+		if _, found := current.servicesByUuid[serviceInfo.GetServiceUuid()]; found {
+			continue
+		}
+		events = append(events, &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent{
+			Event: &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent_ServiceRemoved{
+				ServiceRemoved: &kurtosis_enclave_manager_api_bindings.ServiceRemoved{
+					ServiceName: serviceInfo.GetName(),
+					ServiceUuid: serviceInfo.GetServiceUuid(),
+				},
+			},
+		})
+		haveServicesChanged = true
+	}
+	for _, serviceInfo := range sortServiceInfosByName(current.servicesByUuid) {
+		previousServiceInfo, found := previous.servicesByUuid[serviceInfo.GetServiceUuid()]
+		if !found {
+			events = append(events, &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent{
+				Event: &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent_ServiceAdded{
+					ServiceAdded: &kurtosis_enclave_manager_api_bindings.ServiceAdded{ServiceInfo: serviceInfo},
+				},
+			})
+			haveServicesChanged = true
+			continue
+		}
+		if previousServiceInfo.GetServiceStatus() != serviceInfo.GetServiceStatus() {
+			events = append(events, &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent{
+				Event: &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent_ServiceStatusChanged{
+					ServiceStatusChanged: &kurtosis_enclave_manager_api_bindings.ServiceStatusChanged{
+						ServiceInfo:    serviceInfo,
+						PreviousStatus: previousServiceInfo.GetServiceStatus(),
+					},
+				},
+			})
+		}
+	}
+
+	for _, filesArtifact := range sortFilesArtifactsByName(previous.filesArtifactsByUuid) {
+		if _, found := current.filesArtifactsByUuid[filesArtifact.GetFileUuid()]; found {
+			continue
+		}
+		events = append(events, &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent{
+			Event: &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent_FilesArtifactRemoved{
+				FilesArtifactRemoved: &kurtosis_enclave_manager_api_bindings.FilesArtifactRemoved{FilesArtifact: filesArtifact},
+			},
+		})
+	}
+	for _, filesArtifact := range sortFilesArtifactsByName(current.filesArtifactsByUuid) {
+		if _, found := previous.filesArtifactsByUuid[filesArtifact.GetFileUuid()]; found {
+			continue
+		}
+		events = append(events, &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent{
+			Event: &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent_FilesArtifactAdded{
+				FilesArtifactAdded: &kurtosis_enclave_manager_api_bindings.FilesArtifactAdded{FilesArtifact: filesArtifact},
+			},
+		})
+	}
+	return events, haveServicesChanged
+}
+
+// newStarlarkRunWatchEnclaveEvent returns the event for a progress or run finished line, or nil for other lines
+func newStarlarkRunWatchEnclaveEvent(line *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) *kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent {
+	if progress := line.GetProgressInfo(); progress != nil {
+		return &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent{
+			Event: &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent_StarlarkRunProgress{StarlarkRunProgress: progress},
+		}
+	}
+	if runFinishedEvent := line.GetRunFinishedEvent(); runFinishedEvent != nil {
+		return &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent{
+			Event: &kurtosis_enclave_manager_api_bindings.WatchEnclaveEvent_StarlarkRunFinished{StarlarkRunFinished: runFinishedEvent},
+		}
+	}
+	return nil
+}
+
+func getWatchEnclavePollInterval(req *kurtosis_enclave_manager_api_bindings.WatchEnclaveRequest) time.Duration {
+	if req.PollIntervalMillis == nil {
+		return defaultWatchEnclavePollInterval
+	}
+	pollInterval := time.Duration(req.GetPollIntervalMillis()) * time.Millisecond
+	if pollInterval < minWatchEnclavePollInterval {
+		return minWatchEnclavePollInterval
+	}
+	return pollInterval
+}
+
+func newApicAddress(ip string, port int32) string {
+	return fmt.Sprintf("%s:%d", ip, port)
+}
+
+func sortServiceInfosByName(servicesByUuid map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo) []*kurtosis_core_rpc_api_bindings.ServiceInfo {
+	result := []*kurtosis_core_rpc_api_bindings.ServiceInfo{}
+	for _, serviceInfo := range servicesByUuid {
+		result = append(result, serviceInfo)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetName() < result[j].GetName()
+	})
+	return result
+}
+
+func sortFilesArtifactsByName(filesArtifactsByUuid map[string]*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid) []*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid {
+	result := []*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid{}
+	for _, filesArtifact := range filesArtifactsByUuid {
+		result = append(result, filesArtifact)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetFileName() < result[j].GetFileName()
+	})
+	return result
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/enclave-manager/api/golang/kurtosis_enclave_manager_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffEnclaveSnapshots_FirstPollReportsEverythingAsAdded(t *testing.T) {
+	current := newEnclaveSnapshot(
+		map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+			"b": newTestServiceInfo("b", "b-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING),
+			"a": newTestServiceInfo("a", "a-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING),
+		},
+		[]*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid{
+			{FileName: "config", FileUuid: "config-uuid"},
+		},
+	)
+
+	events, haveServicesChanged := diffEnclaveSnapshots(newEmptyEnclaveSnapshot(), current)
+	require.True(t, haveServicesChanged)
+	require.Len(t, events, 3)
+	require.Equal(t, "a", events[0].GetServiceAdded().GetServiceInfo().GetName())
+	require.Equal(t, "b", events[1].GetServiceAdded().GetServiceInfo().GetName())
+	require.Equal(t, "config", events[2].GetFilesArtifactAdded().GetFilesArtifact().GetFileName())
+}
+
+func TestDiffEnclaveSnapshots_Changes(t *testing.T) {
+	previous := newEnclaveSnapshot(
+		map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+			"kept":    newTestServiceInfo("kept", "kept-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING),
+			"stopped": newTestServiceInfo("stopped", "stopped-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING),
+			"removed": newTestServiceInfo("removed", "removed-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING),
+		},
+		[]*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid{
+			{FileName: "old", FileUuid: "old-uuid"},
+		},
+	)
+	current := newEnclaveSnapshot(
+		map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+			"kept":    newTestServiceInfo("kept", "kept-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING),
+			"stopped": newTestServiceInfo("stopped", "stopped-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_STOPPED),
+			"added":   newTestServiceInfo("added", "added-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING),
+		},
+		[]*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid{
+			{FileName: "new", FileUuid: "new-uuid"},
+		},
+	)
+
+	events, haveServicesChanged := diffEnclaveSnapshots(previous, current)
+	require.True(t, haveServicesChanged)
+	require.Len(t, events, 5)
+	require.Equal(t, &kurtosis_enclave_manager_api_bindings.ServiceRemoved{ServiceName: "removed", ServiceUuid: "removed-uuid"}, events[0].GetServiceRemoved())
+	require.Equal(t, "added", events[1].GetServiceAdded().GetServiceInfo().GetName())
+	require.Equal(t, "stopped", events[2].GetServiceStatusChanged().GetServiceInfo().GetName())
+	require.Equal(t, kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING, events[2].GetServiceStatusChanged().GetPreviousStatus())
+	require.Equal(t, "old", events[3].GetFilesArtifactRemoved().GetFilesArtifact().GetFileName())
+	require.Equal(t, "new", events[4].GetFilesArtifactAdded().GetFilesArtifact().GetFileName())
+}
+
+func TestDiffEnclaveSnapshots_StatusChangeOnlyDoesNotChangeServices(t *testing.T) {
+	previous := newEnclaveSnapshot(map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+		"a": newTestServiceInfo("a", "a-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING),
+	}, nil)
+	current := newEnclaveSnapshot(map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+		"a": newTestServiceInfo("a", "a-uuid", kurtosis_core_rpc_api_bindings.ServiceStatus_STOPPED),
+	}, nil)
+
+	events, haveServicesChanged := diffEnclaveSnapshots(previous, current)
+	require.False(t, haveServicesChanged)
+	require.Len(t, events, 1)
+
+	events, haveServicesChanged = diffEnclaveSnapshots(current, current)
+	require.False(t, haveServicesChanged)
+	require.Empty(t, events)
+}
+
+func TestNewStarlarkRunWatchEnclaveEvent(t *testing.T) {
+	progressLine := &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_ProgressInfo{
+			ProgressInfo: &kurtosis_core_rpc_api_bindings.StarlarkRunProgress{
+				CurrentStepInfo:   []string{"Adding service"},
+				TotalSteps:        2,
+				CurrentStepNumber: 1,
+			},
+		},
+	}
+	runFinishedLine := &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_RunFinishedEvent{
+			RunFinishedEvent: &kurtosis_core_rpc_api_bindings.StarlarkRunFinishedEvent{IsRunSuccessful: true},
+		},
+	}
+	infoLine := &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_Info{
+			Info: &kurtosis_core_rpc_api_bindings.StarlarkInfo{InfoMessage: "info"},
+		},
+	}
+
+	require.Equal(t, progressLine.GetProgressInfo(), newStarlarkRunWatchEnclaveEvent(progressLine).GetStarlarkRunProgress())
+	require.Equal(t, runFinishedLine.GetRunFinishedEvent(), newStarlarkRunWatchEnclaveEvent(runFinishedLine).GetStarlarkRunFinished())
+	require.Nil(t, newStarlarkRunWatchEnclaveEvent(infoLine))
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args/kurtosis_backend_config"
@@ -69,6 +70,13 @@ type EngineServerArgs struct {
 	Domain string `json:"domain"`
 
 	LogRetentionPeriod string `json:"logRetentionPeriod"`
+
+	// Resource quota applied to the enclaves created without one, and filling the limits left unset by the quota of
+	// the others. Nil if the enclaves have no default quota
+	DefaultEnclaveResourceQuota *enclave.EnclaveResourceQuota `json:"defaultEnclaveResourceQuota"`
+
+	// How the callers of the engine APIs are authenticated and authorized. Nil if the engine accepts any caller
+	AuthConfig *EngineAuthConfig `json:"authConfig"`
 }
 
 var skipValidation = map[string]bool{
@@ -128,6 +136,8 @@ func NewEngineServerArgs(
 	restartAPIContainers bool,
 	domain string,
 	logRetentionPeriod string,
+	defaultEnclaveResourceQuota *enclave.EnclaveResourceQuota,
+	authConfig *EngineAuthConfig,
 ) (*EngineServerArgs, error) {
 	if enclaveEnvVars == "" {
 		enclaveEnvVars = emptyJsonField
@@ -150,6 +160,8 @@ func NewEngineServerArgs(
 		RestartAPIContainers:        restartAPIContainers,
 		Domain:                      domain,
 		LogRetentionPeriod:          logRetentionPeriod,
+		DefaultEnclaveResourceQuota: defaultEnclaveResourceQuota,
+		AuthConfig:                  authConfig,
 	}
 	if err := result.validate(); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred validating engine server args")
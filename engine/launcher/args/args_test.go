@@ -24,3 +24,14 @@ func TestArgsUnmarshalDocker(t *testing.T) {
 	err := json.Unmarshal(paramsJsonBytes, &args)
 	require.NoError(t, err)
 }
+
+func TestArgsUnmarshalAuthConfig(t *testing.T) {
+	argsJson := `{"grpcListenPortNum":9710,"logLevelStr":"debug","imageVersionTag":"X.X.X","metricsUserId":"5e9d668ad9b004ba16def3ee14c271f5134e1df57a4d4996924e6544e6b0e9be","didUserAcceptSendingMetrics":true,"kurtosisBackendType":"docker","kurtosisBackendConfig":{},"authConfig":{"tokens":[{"principal":"alice","role":"enclave-owner","tokenSha256":"abc"}]}}`
+	var args EngineServerArgs
+	err := json.Unmarshal([]byte(argsJson), &args)
+	require.NoError(t, err)
+	require.NotNil(t, args.AuthConfig)
+	require.Len(t, args.AuthConfig.Tokens, 1)
+	require.Equal(t, "alice", args.AuthConfig.Tokens[0].Principal)
+	require.Equal(t, EngineAuthRole_EnclaveOwner, args.AuthConfig.Tokens[0].Role)
+}
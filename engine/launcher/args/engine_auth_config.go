@@ -0,0 +1,138 @@
+package args
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// EngineAuthRole is the set of engine API calls a principal is allowed to make
+type EngineAuthRole string
+
+const (
+	// Can only read engine and enclave information, and the service logs
+	EngineAuthRole_ReadOnly EngineAuthRole = "read-only"
+	// Can read everything, create enclaves and stop, destroy or extend the enclaves it created
+	EngineAuthRole_EnclaveOwner EngineAuthRole = "enclave-owner"
+	// Can make any call
+	EngineAuthRole_Admin EngineAuthRole = "admin"
+)
+
+var AllEngineAuthRoles = []EngineAuthRole{
+	EngineAuthRole_ReadOnly,
+	EngineAuthRole_EnclaveOwner,
+	EngineAuthRole_Admin,
+}
+
+func (role EngineAuthRole) IsValid() bool {
+	for _, validRole := range AllEngineAuthRoles {
+		if role == validRole {
+			return true
+		}
+	}
+	return false
+}
+
+// EngineAuthToken is a bearer token accepted by the engine; only its hash is handed to the engine
+// Fields are public for JSON de/serialization
+type EngineAuthToken struct {
+	// Name of the principal authenticated by the token, recorded as the owner of the enclaves it creates
+	Principal string `json:"principal"`
+
+	Role EngineAuthRole `json:"role"`
+
+	// Hex encoded SHA-256 of the token, see HashEngineAuthToken
+	TokenSha256 string `json:"tokenSha256"`
+}
+
+// EngineAuthConfig configures how the engine authenticates and authorizes the callers of its APIs
+// Fields are public for JSON de/serialization
+type EngineAuthConfig struct {
+	Tokens []EngineAuthToken `json:"tokens"`
+
+	// PEM encoded certificate and key the engine serves its APIs with; TLS is disabled when they're empty
+	ServerCertificate string `json:"serverCertificate"`
+	ServerKey         string `json:"serverKey"`
+
+	// PEM encoded CA the client certificates must be signed by; mTLS authentication is disabled when it's empty
+	ClientCertificateAuthority string `json:"clientCertificateAuthority"`
+
+	// Role of the principals authenticated with a client certificate, keyed by the certificate common name which is
+	// used as principal. Client certificates with a common name not in here are rejected
+	ClientCertificateRoles map[string]EngineAuthRole `json:"clientCertificateRoles"`
+}
+
+func NewEngineAuthConfig(
+	tokens []EngineAuthToken,
+	serverCertificate string,
+	serverKey string,
+	clientCertificateAuthority string,
+	clientCertificateRoles map[string]EngineAuthRole,
+) (*EngineAuthConfig, error) {
+	result := &EngineAuthConfig{
+		Tokens:                     tokens,
+		ServerCertificate:          serverCertificate,
+		ServerKey:                  serverKey,
+		ClientCertificateAuthority: clientCertificateAuthority,
+		ClientCertificateRoles:     clientCertificateRoles,
+	}
+	if err := result.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the engine auth config")
+	}
+	return result, nil
+}
+
+func (config *EngineAuthConfig) IsTlsEnabled() bool {
+	return config.ServerCertificate != ""
+}
+
+func (config *EngineAuthConfig) IsClientCertificateAuthEnabled() bool {
+	return config.ClientCertificateAuthority != ""
+}
+
+func (config *EngineAuthConfig) Validate() error {
+	if len(config.Tokens) == 0 && !config.IsClientCertificateAuthEnabled() {
+		return stacktrace.NewError("The engine auth config must declare at least one token or a client certificate authority, otherwise no caller can be authenticated")
+	}
+	if (config.ServerCertificate == "") != (config.ServerKey == "") {
+		return stacktrace.NewError("The server certificate and the server key must be set together")
+	}
+	if config.IsClientCertificateAuthEnabled() && !config.IsTlsEnabled() {
+		return stacktrace.NewError("Client certificate authentication requires the engine to serve TLS, but no server certificate was set")
+	}
+	if len(config.ClientCertificateRoles) > 0 && !config.IsClientCertificateAuthEnabled() {
+		return stacktrace.NewError("Client certificate roles were declared but no client certificate authority was set")
+	}
+
+	seenTokenHashes := map[string]bool{}
+	for _, token := range config.Tokens {
+		if strings.TrimSpace(token.Principal) == "" {
+			return stacktrace.NewError("Found a token with an empty principal")
+		}
+		if !token.Role.IsValid() {
+			return stacktrace.NewError("Invalid role '%v' for the token of principal '%v'; valid roles are '%v'", token.Role, token.Principal, AllEngineAuthRoles)
+		}
+		if token.TokenSha256 == "" {
+			return stacktrace.NewError("The token of principal '%v' has no hash", token.Principal)
+		}
+		if _, found := seenTokenHashes[token.TokenSha256]; found {
+			return stacktrace.NewError("The token of principal '%v' is used by another principal", token.Principal)
+		}
+		seenTokenHashes[token.TokenSha256] = true
+	}
+
+	for commonName, role := range config.ClientCertificateRoles {
+		if !role.IsValid() {
+			return stacktrace.NewError("Invalid role '%v' for the client certificate with common name '%v'; valid roles are '%v'", role, commonName, AllEngineAuthRoles)
+		}
+	}
+	return nil
+}
+
+// HashEngineAuthToken returns the hex encoded SHA-256 of the token, which is what the engine stores and compares
+func HashEngineAuthToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,57 @@
+package args
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPrincipal = "alice"
+	testToken     = "s3cr3t"
+)
+
+func TestNewEngineAuthConfig_Tokens(t *testing.T) {
+	tokens := []EngineAuthToken{
+		{Principal: testPrincipal, Role: EngineAuthRole_Admin, TokenSha256: HashEngineAuthToken(testToken)},
+	}
+	config, err := NewEngineAuthConfig(tokens, "", "", "", nil)
+	require.NoError(t, err)
+	require.False(t, config.IsTlsEnabled())
+	require.False(t, config.IsClientCertificateAuthEnabled())
+}
+
+func TestNewEngineAuthConfig_NoWayToAuthenticate(t *testing.T) {
+	_, err := NewEngineAuthConfig(nil, "", "", "", nil)
+	require.Error(t, err)
+}
+
+func TestNewEngineAuthConfig_InvalidRole(t *testing.T) {
+	tokens := []EngineAuthToken{
+		{Principal: testPrincipal, Role: "superuser", TokenSha256: HashEngineAuthToken(testToken)},
+	}
+	_, err := NewEngineAuthConfig(tokens, "", "", "", nil)
+	require.Error(t, err)
+}
+
+func TestNewEngineAuthConfig_DuplicatedToken(t *testing.T) {
+	tokens := []EngineAuthToken{
+		{Principal: testPrincipal, Role: EngineAuthRole_Admin, TokenSha256: HashEngineAuthToken(testToken)},
+		{Principal: "bob", Role: EngineAuthRole_ReadOnly, TokenSha256: HashEngineAuthToken(testToken)},
+	}
+	_, err := NewEngineAuthConfig(tokens, "", "", "", nil)
+	require.Error(t, err)
+}
+
+func TestNewEngineAuthConfig_ClientCertificatesRequireTls(t *testing.T) {
+	_, err := NewEngineAuthConfig(nil, "", "", "ca", map[string]EngineAuthRole{testPrincipal: EngineAuthRole_Admin})
+	require.Error(t, err)
+
+	_, err = NewEngineAuthConfig(nil, "cert", "key", "ca", map[string]EngineAuthRole{testPrincipal: EngineAuthRole_Admin})
+	require.NoError(t, err)
+}
+
+func TestHashEngineAuthToken(t *testing.T) {
+	require.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", HashEngineAuthToken(""))
+	require.NotEqual(t, HashEngineAuthToken(testToken), HashEngineAuthToken(testToken+"x"))
+}
@@ -7,4 +7,30 @@ package kurtosis_backend_config
 
 type KubernetesBackendConfig struct {
 	StorageClass string
+
+	// Nil if the HTTP ports of the user services aren't exposed outside the cluster
+	ServiceExposure *KubernetesServiceExposureConfig
+
+	// Whether the API container runs the user services as bare pods ("pod") or as StatefulSets ("stateful-set"), empty
+	// means pods
+	ServiceWorkload string
+
+	// The image the API container copies the helper wrapping the tasks from, empty means the public busybox image
+	TaskHelperImage string
+}
+
+// KubernetesServiceExposureConfig describes how the API container exposes the HTTP ports of the user services outside
+// the cluster, through Ingresses or through Gateway API HTTPRoutes
+type KubernetesServiceExposureConfig struct {
+	Type string
+
+	HostnameTemplate string
+
+	IsHttps bool
+
+	IngressClassName string
+
+	GatewayName string
+
+	GatewayNamespace string
 }
@@ -8,6 +8,7 @@ package engine_server_launcher
 import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
@@ -49,6 +50,10 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 	restartAPIContainers bool,
 	domain string,
 	logRetentionPeriod string,
+	// Nil if the enclaves have no default resource quota
+	defaultEnclaveResourceQuota *enclave.EnclaveResourceQuota,
+	// Nil if the engine accepts any caller
+	authConfig *args.EngineAuthConfig,
 ) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
@@ -73,7 +78,9 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 		githubAuthToken,
 		restartAPIContainers,
 		domain,
-		logRetentionPeriod)
+		logRetentionPeriod,
+		defaultEnclaveResourceQuota,
+		authConfig)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred launching the engine server container with default version tag '%v'", kurtosis_version.KurtosisVersion)
 	}
@@ -100,6 +107,10 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 	restartAPIContainers bool,
 	domain string,
 	logRetentionPeriod string,
+	// Nil if the enclaves have no default resource quota
+	defaultEnclaveResourceQuota *enclave.EnclaveResourceQuota,
+	// Nil if the engine accepts any caller
+	authConfig *args.EngineAuthConfig,
 ) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
@@ -125,6 +136,8 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 		restartAPIContainers,
 		domain,
 		logRetentionPeriod,
+		defaultEnclaveResourceQuota,
+		authConfig,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the engine server args")
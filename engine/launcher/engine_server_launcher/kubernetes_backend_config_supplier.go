@@ -13,15 +13,26 @@ import (
 type KubernetesBackendConfigSupplier struct {
 	storageClass           string
 	enclaveSizeInMegabytes uint
+	serviceExposure        *kurtosis_backend_config.KubernetesServiceExposureConfig
+	serviceWorkload        string
+	taskHelperImage        string
 }
 
-func NewKubernetesKurtosisBackendConfigSupplier(storageClass string, enclaveSizeInMegabytes uint) KubernetesBackendConfigSupplier {
+func NewKubernetesKurtosisBackendConfigSupplier(storageClass string, enclaveSizeInMegabytes uint, serviceExposure *kurtosis_backend_config.KubernetesServiceExposureConfig, serviceWorkload string, taskHelperImage string) KubernetesBackendConfigSupplier {
 	return KubernetesBackendConfigSupplier{
 		storageClass:           storageClass,
 		enclaveSizeInMegabytes: enclaveSizeInMegabytes,
+		serviceExposure:        serviceExposure,
+		serviceWorkload:        serviceWorkload,
+		taskHelperImage:        taskHelperImage,
 	}
 }
 
 func (backendConfigSupplier KubernetesBackendConfigSupplier) getKurtosisBackendConfig() (args.KurtosisBackendType, interface{}) {
-	return args.KurtosisBackendType_Kubernetes, kurtosis_backend_config.KubernetesBackendConfig{StorageClass: backendConfigSupplier.storageClass}
+	return args.KurtosisBackendType_Kubernetes, kurtosis_backend_config.KubernetesBackendConfig{
+		StorageClass:    backendConfigSupplier.storageClass,
+		ServiceExposure: backendConfigSupplier.serviceExposure,
+		ServiceWorkload: backendConfigSupplier.serviceWorkload,
+		TaskHelperImage: backendConfigSupplier.taskHelperImage,
+	}
 }
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/tls"
+	"time"
+
+	api_container_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+
+	// Long enough for the CLI commands, which get a credential when they start
+	apiContainerCredentialValidity = 24 * time.Hour
+)
+
+// ApiContainerCredentialIssuer lets the principals authenticated by the engine authenticate to the API containers too.
+// Token principals use their own token, which the API containers accept, while the principals authenticated with a
+// client certificate get a credential signed with the key of the engine
+type ApiContainerCredentialIssuer struct {
+	// Nil if the engine doesn't serve TLS, in which case the principals can only authenticate with a token
+	signer crypto.Signer
+}
+
+func NewApiContainerCredentialIssuer(config *args.EngineAuthConfig) (*ApiContainerCredentialIssuer, error) {
+	var signer crypto.Signer
+	if config.IsTlsEnabled() {
+		serverCertificate, err := tls.X509KeyPair([]byte(config.ServerCertificate), []byte(config.ServerKey))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred loading the engine server certificate and key")
+		}
+		var ok bool
+		signer, ok = serverCertificate.PrivateKey.(crypto.Signer)
+		if !ok {
+			return nil, stacktrace.NewError("The engine server key of type '%T' can't sign the API container credentials", serverCertificate.PrivateKey)
+		}
+	}
+	return &ApiContainerCredentialIssuer{
+		signer: signer,
+	}, nil
+}
+
+// GetApiContainerToken returns the bearer token the principal calls the API container of the enclave with
+func (issuer *ApiContainerCredentialIssuer) GetApiContainerToken(principal *Principal, enclaveUuid string) (string, error) {
+	if principal.GetToken() != "" {
+		return principal.GetToken(), nil
+	}
+	if issuer.signer == nil {
+		return "", stacktrace.NewError("Principal '%v' didn't authenticate with a token and the engine has no TLS key to issue it a credential", principal.GetId())
+	}
+	credential, err := api_container_args.SignApiContainerCredential(issuer.signer, api_container_args.ApiContainerCredentialClaims{
+		PrincipalId:   principal.GetId(),
+		Role:          api_container_args.ApiContainerAuthRole(principal.GetRole()),
+		EnclaveUUID:   enclaveUuid,
+		ExpiresAtUnix: time.Now().Add(apiContainerCredentialValidity).Unix(),
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred issuing a credential for principal '%v' to call the API container of enclave '%v'", principal.GetId(), enclaveUuid)
+	}
+	return credential, nil
+}
+
+// NewApiContainerPerRPCCredentials returns the gRPC credentials forwarding the principal found in the context of each
+// call to the API container of the enclave
+func (issuer *ApiContainerCredentialIssuer) NewApiContainerPerRPCCredentials(enclaveUuid string) credentials.PerRPCCredentials {
+	return &apiContainerPerRPCCredentials{
+		issuer:      issuer,
+		enclaveUuid: enclaveUuid,
+	}
+}
+
+type apiContainerPerRPCCredentials struct {
+	issuer      *ApiContainerCredentialIssuer
+	enclaveUuid string
+}
+
+func (creds *apiContainerPerRPCCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	principal := GetPrincipalFromContext(ctx)
+	if principal == nil {
+		return nil, stacktrace.NewError("The call to the API container of enclave '%v' has no authenticated principal to forward", creds.enclaveUuid)
+	}
+	token, err := creds.issuer.GetApiContainerToken(principal, creds.enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the token of principal '%v' for the API container of enclave '%v'", principal.GetId(), creds.enclaveUuid)
+	}
+	return map[string]string{
+		authorizationMetadataKey: bearerTokenPrefix + token,
+	}, nil
+}
+
+// RequireTransportSecurity is false as the API containers are called over plaintext
+func (creds *apiContainerPerRPCCredentials) RequireTransportSecurity() bool {
+	return false
+}
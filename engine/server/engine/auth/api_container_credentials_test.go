@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	api_container_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid = "enclave-uuid"
+)
+
+func TestGetApiContainerToken_TokenPrincipal(t *testing.T) {
+	issuer, err := NewApiContainerCredentialIssuer(&args.EngineAuthConfig{}) //nolint:exhaustruct
+	require.NoError(t, err)
+
+	token, err := issuer.GetApiContainerToken(NewTokenPrincipal(ownerPrincipalName, args.EngineAuthRole_EnclaveOwner, adminToken), testEnclaveUuid)
+	require.NoError(t, err)
+	require.Equal(t, adminToken, token)
+
+	// Without TLS key, the engine can't issue credentials to the principals that didn't use a token
+	_, err = issuer.GetApiContainerToken(NewClientCertificatePrincipal(ownerPrincipalName, args.EngineAuthRole_EnclaveOwner), testEnclaveUuid)
+	require.Error(t, err)
+}
+
+func TestGetApiContainerToken_ClientCertificatePrincipal(t *testing.T) {
+	serverKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	//nolint:exhaustruct
+	certificateTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "engine"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplate, serverKey.Public(), serverKey)
+	require.NoError(t, err)
+	serverKeyBytes, err := x509.MarshalECPrivateKey(serverKey)
+	require.NoError(t, err)
+	serverCertificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Headers: nil, Bytes: certificateBytes}))
+
+	//nolint:exhaustruct
+	issuer, err := NewApiContainerCredentialIssuer(&args.EngineAuthConfig{
+		ServerCertificate: serverCertificate,
+		ServerKey:         string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Headers: nil, Bytes: serverKeyBytes})),
+	})
+	require.NoError(t, err)
+
+	principal := NewClientCertificatePrincipal(ownerPrincipalName, args.EngineAuthRole_EnclaveOwner)
+	credential, err := issuer.GetApiContainerToken(principal, testEnclaveUuid)
+	require.NoError(t, err)
+
+	claims, err := api_container_args.VerifyApiContainerCredential(credential, serverCertificate, testEnclaveUuid, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, principal.GetId(), claims.PrincipalId)
+	require.Equal(t, api_container_args.ApiContainerAuthRole_EnclaveOwner, claims.Role)
+}
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	authorizationHeaderKey = "Authorization"
+	bearerTokenPrefix      = "Bearer "
+)
+
+// Authenticator identifies the callers of the engine APIs, either by the bearer token they send or by the client
+// certificate they present
+type Authenticator struct {
+	principalsByTokenHash map[string]*Principal
+
+	// Keyed by client certificate common name
+	principalsByCommonName map[string]*Principal
+
+	// Nil if the APIs are served in plaintext
+	tlsConfig *tls.Config
+}
+
+func NewAuthenticator(config *args.EngineAuthConfig) (*Authenticator, error) {
+	if err := config.Validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid engine auth config")
+	}
+
+	principalsByTokenHash := map[string]*Principal{}
+	for _, token := range config.Tokens {
+		principalsByTokenHash[token.TokenSha256] = NewTokenPrincipal(token.Principal, token.Role, "")
+	}
+
+	principalsByCommonName := map[string]*Principal{}
+	for commonName, role := range config.ClientCertificateRoles {
+		principalsByCommonName[commonName] = NewClientCertificatePrincipal(commonName, role)
+	}
+
+	var tlsConfig *tls.Config
+	if config.IsTlsEnabled() {
+		serverCertificate, err := tls.X509KeyPair([]byte(config.ServerCertificate), []byte(config.ServerKey))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred loading the engine server certificate and key")
+		}
+		// nolint: exhaustruct
+		tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{serverCertificate},
+			MinVersion:   tls.VersionTLS12,
+		}
+		if config.IsClientCertificateAuthEnabled() {
+			clientCertificateAuthorityPool := x509.NewCertPool()
+			if !clientCertificateAuthorityPool.AppendCertsFromPEM([]byte(config.ClientCertificateAuthority)) {
+				return nil, stacktrace.NewError("No certificate could be parsed from the client certificate authority PEM")
+			}
+			tlsConfig.ClientCAs = clientCertificateAuthorityPool
+			// Callers without a client certificate can still authenticate with a token
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+
+	return &Authenticator{
+		principalsByTokenHash:  principalsByTokenHash,
+		principalsByCommonName: principalsByCommonName,
+		tlsConfig:              tlsConfig,
+	}, nil
+}
+
+// GetTlsConfig returns nil if the engine APIs are served in plaintext
+func (authenticator *Authenticator) GetTlsConfig() *tls.Config {
+	return authenticator.tlsConfig
+}
+
+func (authenticator *Authenticator) Authenticate(request *http.Request) (*Principal, error) {
+	if request.TLS != nil && len(request.TLS.VerifiedChains) > 0 && len(request.TLS.VerifiedChains[0]) > 0 {
+		commonName := request.TLS.VerifiedChains[0][0].Subject.CommonName
+		principal, found := authenticator.principalsByCommonName[commonName]
+		if !found {
+			return nil, stacktrace.NewError("The client certificate with common name '%v' isn't allowed to call the engine", commonName)
+		}
+		return principal, nil
+	}
+
+	authorizationHeader := request.Header.Get(authorizationHeaderKey)
+	if authorizationHeader == "" {
+		return nil, stacktrace.NewError("No client certificate nor '%v' header was provided", authorizationHeaderKey)
+	}
+	if !strings.HasPrefix(authorizationHeader, bearerTokenPrefix) {
+		return nil, stacktrace.NewError("The '%v' header must hold a bearer token", authorizationHeaderKey)
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(authorizationHeader, bearerTokenPrefix))
+	principal, found := authenticator.principalsByTokenHash[args.HashEngineAuthToken(token)]
+	if !found {
+		return nil, stacktrace.NewError("The provided token isn't valid")
+	}
+	// The token is kept so that it can be forwarded to the API containers
+	return NewTokenPrincipal(principal.GetName(), principal.GetRole(), token), nil
+}
+
+// HttpMiddleware rejects the unauthenticated requests and adds the principal to the context of the others
+func (authenticator *Authenticator) HttpMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		principal, err := authenticator.Authenticate(request)
+		if err != nil {
+			logrus.Debugf("Rejected an unauthenticated request to '%v':\n%v", request.URL.Path, err)
+			http.Error(writer, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(writer, request.WithContext(NewContextWithPrincipal(request.Context(), principal)))
+	})
+}
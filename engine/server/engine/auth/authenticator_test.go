@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api_container_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	adminToken    = "admin-token"
+	readOnlyToken = "read-only-token"
+)
+
+func newTestAuthenticator(t *testing.T) *Authenticator {
+	config, err := args.NewEngineAuthConfig(
+		[]args.EngineAuthToken{
+			{Principal: ownerPrincipalName, Role: args.EngineAuthRole_Admin, TokenSha256: args.HashEngineAuthToken(adminToken)},
+			{Principal: otherPrincipalName, Role: args.EngineAuthRole_ReadOnly, TokenSha256: args.HashEngineAuthToken(readOnlyToken)},
+		},
+		"",
+		"",
+		"",
+		nil,
+	)
+	require.NoError(t, err)
+	authenticator, err := NewAuthenticator(config)
+	require.NoError(t, err)
+	return authenticator
+}
+
+func TestAuthenticate_Token(t *testing.T) {
+	authenticator := newTestAuthenticator(t)
+	require.Nil(t, authenticator.GetTlsConfig())
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set(authorizationHeaderKey, bearerTokenPrefix+readOnlyToken)
+	principal, err := authenticator.Authenticate(request)
+	require.NoError(t, err)
+	require.Equal(t, otherPrincipalName, principal.GetName())
+	require.Equal(t, api_container_args.GetAuthPrincipalId(api_container_args.AuthPrincipalKind_Token, otherPrincipalName), principal.GetId())
+	require.Equal(t, args.EngineAuthRole_ReadOnly, principal.GetRole())
+	require.Equal(t, readOnlyToken, principal.GetToken())
+}
+
+func TestAuthenticate_InvalidToken(t *testing.T) {
+	authenticator := newTestAuthenticator(t)
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set(authorizationHeaderKey, bearerTokenPrefix+"not-a-token")
+	_, err := authenticator.Authenticate(request)
+	require.Error(t, err)
+
+	request.Header.Set(authorizationHeaderKey, adminToken)
+	_, err = authenticator.Authenticate(request)
+	require.Error(t, err)
+
+	request.Header.Del(authorizationHeaderKey)
+	_, err = authenticator.Authenticate(request)
+	require.Error(t, err)
+}
+
+func TestHttpMiddleware(t *testing.T) {
+	authenticator := newTestAuthenticator(t)
+	var principalId string
+	handler := authenticator.HttpMiddleware(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		principalId = GetPrincipalIdFromContext(request.Context())
+	}))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", nil))
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	require.Empty(t, principalId)
+
+	recorder = httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/", nil)
+	request.Header.Set(authorizationHeaderKey, bearerTokenPrefix+adminToken)
+	handler.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, ownerPrincipalId, principalId)
+}
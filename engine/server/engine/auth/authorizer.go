@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// permission is what a call to the engine APIs requires from its caller
+type permission int
+
+const (
+	// Reading engine and enclave information, and the logs
+	permission_Read permission = iota
+	permission_CreateEnclave
+	// Mutating an existing enclave, which only its owner and the admins can do
+	permission_ManageEnclave
+	permission_Admin
+)
+
+// EnclaveOwnerGetter returns the principal that created an enclave, empty if it was created while the engine wasn't
+// requiring authentication
+type EnclaveOwnerGetter interface {
+	GetEnclaveOwner(ctx context.Context, enclaveIdentifier string) (string, error)
+}
+
+// Authorizer enforces the role of the authenticated principals
+type Authorizer struct {
+	enclaveOwnerGetter EnclaveOwnerGetter
+}
+
+func NewAuthorizer(enclaveOwnerGetter EnclaveOwnerGetter) *Authorizer {
+	return &Authorizer{
+		enclaveOwnerGetter: enclaveOwnerGetter,
+	}
+}
+
+// authorize returns an error if the principal isn't allowed the permission; the enclave identifier is only used for
+// permission_ManageEnclave
+func (authorizer *Authorizer) authorize(
+	ctx context.Context,
+	principal *Principal,
+	requiredPermission permission,
+	enclaveIdentifier string,
+) error {
+	switch principal.GetRole() {
+	case args.EngineAuthRole_Admin:
+		return nil
+	case args.EngineAuthRole_ReadOnly:
+		if requiredPermission == permission_Read {
+			return nil
+		}
+		return stacktrace.NewError("Principal '%v' has the '%v' role which only allows reading", principal.GetId(), principal.GetRole())
+	case args.EngineAuthRole_EnclaveOwner:
+		switch requiredPermission {
+		case permission_Read, permission_CreateEnclave:
+			return nil
+		case permission_ManageEnclave:
+			owner, err := authorizer.enclaveOwnerGetter.GetEnclaveOwner(ctx, enclaveIdentifier)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting the owner of enclave '%v'", enclaveIdentifier)
+			}
+			if owner != principal.GetId() {
+				return stacktrace.NewError("Principal '%v' has the '%v' role and can't manage enclave '%v' which it doesn't own", principal.GetId(), principal.GetRole(), enclaveIdentifier)
+			}
+			return nil
+		case permission_Admin:
+			return stacktrace.NewError("Principal '%v' has the '%v' role which doesn't allow administering the engine", principal.GetId(), principal.GetRole())
+		}
+	}
+	return stacktrace.NewError("Principal '%v' has the unknown role '%v'", principal.GetId(), principal.GetRole())
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	api_container_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ownedEnclave   = "owned-enclave"
+	othersEnclave  = "others-enclave"
+	unknownEnclave = "unknown-enclave"
+
+	ownerPrincipalName = "alice"
+	otherPrincipalName = "bob"
+)
+
+var (
+	ownerPrincipalId = api_container_args.GetAuthPrincipalId(api_container_args.AuthPrincipalKind_Token, ownerPrincipalName)
+	otherPrincipalId = api_container_args.GetAuthPrincipalId(api_container_args.AuthPrincipalKind_Token, otherPrincipalName)
+)
+
+type testEnclaveOwnerGetter struct{}
+
+func (getter testEnclaveOwnerGetter) GetEnclaveOwner(_ context.Context, enclaveIdentifier string) (string, error) {
+	switch enclaveIdentifier {
+	case ownedEnclave:
+		return ownerPrincipalId, nil
+	case othersEnclave:
+		return otherPrincipalId, nil
+	default:
+		return "", stacktrace.NewError("Enclave '%v' doesn't exist", enclaveIdentifier)
+	}
+}
+
+func TestAuthorize_Admin(t *testing.T) {
+	authorizer := NewAuthorizer(testEnclaveOwnerGetter{})
+	principal := NewTokenPrincipal(ownerPrincipalName, args.EngineAuthRole_Admin, "")
+	ctx := context.Background()
+
+	require.NoError(t, authorizer.authorize(ctx, principal, permission_Read, ""))
+	require.NoError(t, authorizer.authorize(ctx, principal, permission_CreateEnclave, ""))
+	require.NoError(t, authorizer.authorize(ctx, principal, permission_ManageEnclave, othersEnclave))
+	require.NoError(t, authorizer.authorize(ctx, principal, permission_ManageEnclave, unknownEnclave))
+	require.NoError(t, authorizer.authorize(ctx, principal, permission_Admin, ""))
+}
+
+func TestAuthorize_ReadOnly(t *testing.T) {
+	authorizer := NewAuthorizer(testEnclaveOwnerGetter{})
+	principal := NewTokenPrincipal(ownerPrincipalName, args.EngineAuthRole_ReadOnly, "")
+	ctx := context.Background()
+
+	require.NoError(t, authorizer.authorize(ctx, principal, permission_Read, ""))
+	require.Error(t, authorizer.authorize(ctx, principal, permission_CreateEnclave, ""))
+	require.Error(t, authorizer.authorize(ctx, principal, permission_ManageEnclave, ownedEnclave))
+	require.Error(t, authorizer.authorize(ctx, principal, permission_Admin, ""))
+}
+
+func TestAuthorize_EnclaveOwner(t *testing.T) {
+	authorizer := NewAuthorizer(testEnclaveOwnerGetter{})
+	principal := NewTokenPrincipal(ownerPrincipalName, args.EngineAuthRole_EnclaveOwner, "")
+	ctx := context.Background()
+
+	require.NoError(t, authorizer.authorize(ctx, principal, permission_Read, ""))
+	require.NoError(t, authorizer.authorize(ctx, principal, permission_CreateEnclave, ""))
+	require.NoError(t, authorizer.authorize(ctx, principal, permission_ManageEnclave, ownedEnclave))
+	require.Error(t, authorizer.authorize(ctx, principal, permission_ManageEnclave, othersEnclave))
+	require.Error(t, authorizer.authorize(ctx, principal, permission_ManageEnclave, unknownEnclave))
+	require.Error(t, authorizer.authorize(ctx, principal, permission_Admin, ""))
+
+	// A client certificate with the same common name as the owner token principal doesn't own its enclaves
+	certificateNamesake := NewClientCertificatePrincipal(ownerPrincipalName, args.EngineAuthRole_EnclaveOwner)
+	require.Error(t, authorizer.authorize(ctx, certificateNamesake, permission_ManageEnclave, ownedEnclave))
+}
+
+func TestGetRestRoutePermission(t *testing.T) {
+	require.Equal(t, permission_Read, getRestRoutePermission("GET", "/api/enclaves"))
+	require.Equal(t, permission_Read, getRestRoutePermission("GET", "/api/enclaves/:enclave_identifier/services"))
+	require.Equal(t, permission_ManageEnclave, getRestRoutePermission("GET", "/api/enclaves/:enclave_identifier/services/:service_identifier/exec"))
+	require.Equal(t, permission_CreateEnclave, getRestRoutePermission("POST", "/api/enclaves"))
+	require.Equal(t, permission_ManageEnclave, getRestRoutePermission("DELETE", "/api/enclaves/:enclave_identifier"))
+	require.Equal(t, permission_ManageEnclave, getRestRoutePermission("POST", "/api/enclaves/:enclave_identifier/starlark/scripts"))
+	require.Equal(t, permission_Admin, getRestRoutePermission("DELETE", "/api/enclaves"))
+}
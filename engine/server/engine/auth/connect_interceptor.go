@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings/kurtosis_engine_rpc_api_bindingsconnect"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Procedures not in here require the admin role
+var engineProcedurePermissions = map[string]permission{
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEngineInfoProcedure:                              permission_Read,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEnclavesProcedure:                                permission_Read,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetExistingAndHistoricalEnclaveIdentifiersProcedure: permission_Read,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetServiceLogsProcedure:                             permission_Read,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEnclaveApiContainerTokenProcedure:                permission_Read,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCreateEnclaveProcedure:                              permission_CreateEnclave,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceStopEnclaveProcedure:                                permission_ManageEnclave,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceDestroyEnclaveProcedure:                             permission_ManageEnclave,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceExtendEnclaveProcedure:                              permission_ManageEnclave,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCleanProcedure:                                      permission_Admin,
+}
+
+// Implemented by the args of the calls acting on a single enclave
+type enclaveIdentifierGetter interface {
+	GetEnclaveIdentifier() string
+}
+
+type connectInterceptor struct {
+	authorizer *Authorizer
+}
+
+// NewConnectInterceptor returns the interceptor authorizing the calls to the engine connect API; the callers must have
+// been authenticated by the Authenticator HTTP middleware beforehand
+func (authorizer *Authorizer) NewConnectInterceptor() connect.Interceptor {
+	return &connectInterceptor{
+		authorizer: authorizer,
+	}
+}
+
+func (interceptor *connectInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := interceptor.authorizeProcedure(ctx, request.Spec().Procedure, request.Any()); err != nil {
+			return nil, err
+		}
+		return next(ctx, request)
+	}
+}
+
+func (interceptor *connectInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (interceptor *connectInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		// The request message isn't received yet, which is fine as none of the streaming procedures act on a single enclave
+		if err := interceptor.authorizeProcedure(ctx, conn.Spec().Procedure, nil); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (interceptor *connectInterceptor) authorizeProcedure(ctx context.Context, procedure string, requestMsg any) error {
+	principal := GetPrincipalFromContext(ctx)
+	if principal == nil {
+		return connect.NewError(connect.CodeUnauthenticated, stacktrace.NewError("The call to '%v' wasn't authenticated", procedure))
+	}
+
+	requiredPermission := getEngineProcedurePermission(procedure)
+	enclaveIdentifier := ""
+	if requiredPermission == permission_ManageEnclave {
+		enclaveArgs, ok := requestMsg.(enclaveIdentifierGetter)
+		if !ok {
+			return connect.NewError(connect.CodePermissionDenied, stacktrace.NewError("Couldn't find the enclave targeted by the call to '%v'", procedure))
+		}
+		enclaveIdentifier = enclaveArgs.GetEnclaveIdentifier()
+	}
+
+	if err := interceptor.authorizer.authorize(ctx, principal, requiredPermission, enclaveIdentifier); err != nil {
+		return connect.NewError(connect.CodePermissionDenied, stacktrace.Propagate(err, "Principal '%v' isn't allowed to call '%v'", principal.GetId(), procedure))
+	}
+	return nil
+}
+
+func getEngineProcedurePermission(procedure string) permission {
+	requiredPermission, found := engineProcedurePermissions[procedure]
+	if !found {
+		return permission_Admin
+	}
+	return requiredPermission
+}
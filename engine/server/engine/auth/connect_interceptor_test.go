@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	engineServiceName = "EngineService"
+)
+
+// Every engine procedure must be explicitly classified, so a new procedure doesn't silently fall back to admin only
+func TestEveryEngineProcedureHasAPermission(t *testing.T) {
+	service := kurtosis_engine_rpc_api_bindings.File_engine_service_proto.Services().ByName(engineServiceName)
+	require.NotNil(t, service)
+
+	methods := service.Methods()
+	require.Len(t, engineProcedurePermissions, methods.Len())
+	for i := 0; i < methods.Len(); i++ {
+		procedure := fmt.Sprintf("/%v/%v", service.FullName(), methods.Get(i).Name())
+		_, found := engineProcedurePermissions[procedure]
+		require.True(t, found, "Procedure '%v' has no permission", procedure)
+	}
+}
+
+func TestAuthorizeProcedure_EnclaveOwner(t *testing.T) {
+	interceptor := &connectInterceptor{authorizer: NewAuthorizer(testEnclaveOwnerGetter{})}
+	ctx := NewContextWithPrincipal(context.Background(), NewTokenPrincipal(ownerPrincipalName, args.EngineAuthRole_EnclaveOwner, ""))
+
+	destroyProcedure := "/engine_api.EngineService/DestroyEnclave"
+	err := interceptor.authorizeProcedure(ctx, destroyProcedure, &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{EnclaveIdentifier: ownedEnclave})
+	require.NoError(t, err)
+
+	err = interceptor.authorizeProcedure(ctx, destroyProcedure, &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{EnclaveIdentifier: othersEnclave})
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	err = interceptor.authorizeProcedure(ctx, "/engine_api.EngineService/Clean", &kurtosis_engine_rpc_api_bindings.CleanArgs{})
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	err = interceptor.authorizeProcedure(ctx, "/engine_api.EngineService/SomeFutureProcedure", nil)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+}
+
+func TestAuthorizeProcedure_Unauthenticated(t *testing.T) {
+	interceptor := &connectInterceptor{authorizer: NewAuthorizer(testEnclaveOwnerGetter{})}
+
+	err := interceptor.authorizeProcedure(context.Background(), "/engine_api.EngineService/GetEnclaves", nil)
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
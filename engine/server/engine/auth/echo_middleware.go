@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierPathParam = "enclave_identifier"
+	enclavesRouteSuffix        = "/enclaves"
+	// Interactive exec is upgraded from a GET but runs commands in the service
+	execRouteSuffix = "/exec"
+)
+
+// NewEchoMiddleware returns the middleware authenticating and authorizing the calls to the engine REST API
+func (authorizer *Authorizer) NewEchoMiddleware(authenticator *Authenticator) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			request := c.Request()
+			principal, err := authenticator.Authenticate(request)
+			if err != nil {
+				logrus.Debugf("Rejected an unauthenticated request to '%v':\n%v", request.URL.Path, err)
+				return echo.NewHTTPError(http.StatusUnauthorized)
+			}
+
+			requiredPermission := getRestRoutePermission(request.Method, c.Path())
+			enclaveIdentifier := c.Param(enclaveIdentifierPathParam)
+			if err := authorizer.authorize(request.Context(), principal, requiredPermission, enclaveIdentifier); err != nil {
+				logrus.Debugf("Rejected the request of principal '%v' to '%v':\n%v", principal.GetId(), request.URL.Path, err)
+				return echo.NewHTTPError(http.StatusForbidden)
+			}
+
+			c.SetRequest(request.WithContext(NewContextWithPrincipal(request.Context(), principal)))
+			return next(c)
+		}
+	}
+}
+
+// getRestRoutePermission takes the route path with its parameters placeholders, e.g. /api/enclaves/:enclave_identifier
+func getRestRoutePermission(method string, routePath string) permission {
+	switch {
+	case method == http.MethodGet && strings.HasSuffix(routePath, execRouteSuffix):
+		return permission_ManageEnclave
+	case method == http.MethodGet:
+		return permission_Read
+	case method == http.MethodPost && strings.HasSuffix(routePath, enclavesRouteSuffix):
+		return permission_CreateEnclave
+	case strings.Contains(routePath, ":"+enclaveIdentifierPathParam):
+		return permission_ManageEnclave
+	default:
+		return permission_Admin
+	}
+}
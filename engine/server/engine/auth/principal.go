@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"context"
+
+	api_container_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+)
+
+type principalContextKey struct{}
+
+// Principal is an authenticated caller of the engine APIs
+type Principal struct {
+	kind api_container_args.AuthPrincipalKind
+	name string
+	role args.EngineAuthRole
+
+	// The token the principal authenticated with, forwarded to the API containers; empty if it didn't use a token
+	token string
+}
+
+func NewTokenPrincipal(name string, role args.EngineAuthRole, token string) *Principal {
+	return &Principal{
+		kind:  api_container_args.AuthPrincipalKind_Token,
+		name:  name,
+		role:  role,
+		token: token,
+	}
+}
+
+func NewClientCertificatePrincipal(commonName string, role args.EngineAuthRole) *Principal {
+	return &Principal{
+		kind:  api_container_args.AuthPrincipalKind_ClientCertificate,
+		name:  commonName,
+		role:  role,
+		token: "",
+	}
+}
+
+func (principal *Principal) GetKind() api_container_args.AuthPrincipalKind {
+	return principal.kind
+}
+
+func (principal *Principal) GetName() string {
+	return principal.name
+}
+
+// GetId returns the identifier recorded as the owner of the enclaves the principal creates, which tells apart the
+// principals of different kinds having the same name
+func (principal *Principal) GetId() string {
+	return api_container_args.GetAuthPrincipalId(principal.kind, principal.name)
+}
+
+func (principal *Principal) GetToken() string {
+	return principal.token
+}
+
+func (principal *Principal) GetRole() args.EngineAuthRole {
+	return principal.role
+}
+
+func NewContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// NewBackgroundContextWithPrincipalOf returns a background context carrying the principal of ctx, for the calls
+// to the API containers that outlive the request they're made for
+func NewBackgroundContextWithPrincipalOf(ctx context.Context) context.Context {
+	principal := GetPrincipalFromContext(ctx)
+	if principal == nil {
+		return context.Background()
+	}
+	return NewContextWithPrincipal(context.Background(), principal)
+}
+
+// GetPrincipalFromContext returns nil if the caller wasn't authenticated, which is the case when the engine runs
+// without authentication
+func GetPrincipalFromContext(ctx context.Context) *Principal {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	if !ok {
+		return nil
+	}
+	return principal
+}
+
+// GetPrincipalIdFromContext returns an empty string if the caller wasn't authenticated
+func GetPrincipalIdFromContext(ctx context.Context) string {
+	principal := GetPrincipalFromContext(ctx)
+	if principal == nil {
+		return ""
+	}
+	return principal.GetId()
+}
@@ -170,19 +170,19 @@ func getMockedKurtosisBackendWithEnclavesAndServices(ctx context.Context, t *tes
 	enclaveStatus := enclave.EnclaveStatus_Running
 	enclaveCreationTime := mockTime.Now() // time doesn't matter
 	enclaveMap := map[enclave.EnclaveUUID]*enclave.Enclave{
-		enclaveUuid: enclave.NewEnclave(enclaveUuid, testEnclaveUuid, enclaveStatus, &enclaveCreationTime, false),
+		enclaveUuid: enclave.NewEnclave(enclaveUuid, testEnclaveUuid, enclaveStatus, &enclaveCreationTime, false, map[string]string{}, nil, nil, ""),
 	}
 
 	mockKurtosisBackend.
 		EXPECT().
-		GetEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil}).
+		GetEnclaves(ctx, &enclave.EnclaveFilters{UUIDs: nil, Statuses: nil, Labels: nil}).
 		Return(enclaveMap, nil)
 
 	serviceUuid := service.ServiceUUID(testUserService1Uuid)
 	serviceRegistration := service.NewServiceRegistration(testUserService1Name, serviceUuid, enclaveUuid, net.IP{}, "")
-	serviceContainer := container.NewContainer(container.ContainerStatus_Running, "", []string{}, []string{}, map[string]string{})
+	serviceContainer := container.NewContainer(container.ContainerStatus_Running, "", []string{}, []string{}, map[string]string{}, 0)
 	servicesMap := map[service.ServiceUUID]*service.Service{
-		serviceUuid: service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, net.IP{}, map[string]*port_spec.PortSpec{}, serviceContainer),
+		serviceUuid: service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, net.IP{}, map[string]*port_spec.PortSpec{}, nil, serviceContainer),
 	}
 	mockKurtosisBackend.
 		EXPECT().
@@ -7,6 +7,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
+	api_container_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -22,16 +23,21 @@ const (
 type EnclaveCreator struct {
 	kurtosisBackend                           backend_interface.KurtosisBackend
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
+
+	// Nil if the engine doesn't require authentication, in which case neither do the API containers
+	engineAuthConfig *args.EngineAuthConfig
 }
 
 func newEnclaveCreator(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier,
+	engineAuthConfig *args.EngineAuthConfig,
 ) *EnclaveCreator {
 
 	return &EnclaveCreator{
 		kurtosisBackend: kurtosisBackend,
 		apiContainerKurtosisBackendConfigSupplier: apiContainerKurtosisBackendConfigSupplier,
+		engineAuthConfig: engineAuthConfig,
 	}
 }
 
@@ -51,6 +57,13 @@ func (creator *EnclaveCreator) CreateEnclave(
 	cloudInstanceID metrics_client.CloudInstanceID,
 	kurtosisBackendType args.KurtosisBackendType,
 	shouldAPICRunInDebugMode bool,
+	enclaveLabels map[string]string,
+	// Nil if the enclave should never expire
+	expiration *enclave.EnclaveExpiration,
+	// Nil if the enclave has no resource quota
+	resourceQuota *enclave.EnclaveResourceQuota,
+	// Principal creating the enclave, empty if the engine doesn't require authentication
+	owner string,
 ) (*types.EnclaveInfo, error) {
 
 	uuid, err := uuid_generator.GenerateUUIDString()
@@ -62,7 +75,7 @@ func (creator *EnclaveCreator) CreateEnclave(
 	teardownCtx := context.Background() // Separate context for tearing stuff down in case the input context is cancelled
 	// Create Enclave with kurtosisBackend
 
-	newEnclave, err := creator.kurtosisBackend.CreateEnclave(setupCtx, enclaveUuid, enclaveName)
+	newEnclave, err := creator.kurtosisBackend.CreateEnclave(setupCtx, enclaveUuid, enclaveName, enclaveLabels, expiration, resourceQuota, owner)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with name `%v` and uuid '%v'", enclaveName, enclaveUuid)
 	}
@@ -112,7 +125,9 @@ func (creator *EnclaveCreator) CreateEnclave(
 		isCI,
 		cloudUserID,
 		cloudInstanceID,
-		shouldAPICRunInDebugMode)
+		shouldAPICRunInDebugMode,
+		resourceQuota,
+		owner)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred launching the API container")
 	}
@@ -161,6 +176,8 @@ func (creator *EnclaveCreator) CreateEnclave(
 		mode = types.EnclaveMode_PRODUCTION
 	}
 
+	expirationTime, expiryAction := getEnclaveExpirationTimeAndAction(newEnclave.GetExpiration())
+
 	newEnclaveInfo := &types.EnclaveInfo{
 		EnclaveUuid:        newEnclaveUuidStr,
 		Name:               newEnclave.GetName(),
@@ -176,6 +193,11 @@ func (creator *EnclaveCreator) CreateEnclave(
 		ApiContainerHostMachineInfo: apiContainerHostMachineInfo,
 		CreationTime:                *creationTimestamp,
 		Mode:                        mode,
+		Labels:                      getEnclaveLabels(newEnclave),
+		ExpirationTime:              expirationTime,
+		ExpiryAction:                expiryAction,
+		ResourceQuota:               getEnclaveResourceQuota(newEnclave),
+		Owner:                       newEnclave.GetOwner(),
 	}
 
 	// Everything started successfully, so the responsibility of deleting the enclave is now transferred to the caller
@@ -199,6 +221,10 @@ func (creator *EnclaveCreator) LaunchApiContainer(
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
 	shouldStartInDebugMode bool,
+	// Nil if the enclave has no resource quota
+	enclaveResourceQuota *enclave.EnclaveResourceQuota,
+	// Principal owning the enclave, empty if it has no owner
+	enclaveOwner string,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -206,6 +232,7 @@ func (creator *EnclaveCreator) LaunchApiContainer(
 	apiContainerLauncher := api_container_launcher.NewApiContainerLauncher(
 		creator.kurtosisBackend,
 	)
+	apiContainerAuthConfig := creator.getApiContainerAuthConfig(enclaveOwner)
 	if apiContainerImageVersionTag != "" {
 		apiContainer, err := apiContainerLauncher.LaunchWithCustomVersion(
 			ctx,
@@ -221,7 +248,9 @@ func (creator *EnclaveCreator) LaunchApiContainer(
 			isCI,
 			cloudUserID,
 			cloudInstanceID,
-			shouldStartInDebugMode)
+			shouldStartInDebugMode,
+			enclaveResourceQuota,
+			apiContainerAuthConfig)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with custom version '%v', but an error occurred", enclaveUuid, apiContainerImageVersionTag)
 		}
@@ -241,9 +270,28 @@ func (creator *EnclaveCreator) LaunchApiContainer(
 		cloudUserID,
 		cloudInstanceID,
 		shouldStartInDebugMode,
+		enclaveResourceQuota,
+		apiContainerAuthConfig,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with the default version, but an error occurred", enclaveUuid)
 	}
 	return apiContainer, nil
 }
+
+// getApiContainerAuthConfig returns nil if the engine doesn't require authentication; otherwise the API container
+// accepts the same tokens as the engine and the credentials it issues to the other principals
+func (creator *EnclaveCreator) getApiContainerAuthConfig(enclaveOwner string) *api_container_args.ApiContainerAuthConfig {
+	if creator.engineAuthConfig == nil {
+		return nil
+	}
+	tokens := []api_container_args.ApiContainerAuthToken{}
+	for _, token := range creator.engineAuthConfig.Tokens {
+		tokens = append(tokens, api_container_args.ApiContainerAuthToken{
+			Principal:   token.Principal,
+			Role:        api_container_args.ApiContainerAuthRole(token.Role),
+			TokenSha256: token.TokenSha256,
+		})
+	}
+	return api_container_args.NewApiContainerAuthConfig(tokens, creator.engineAuthConfig.ServerCertificate, enclaveOwner)
+}
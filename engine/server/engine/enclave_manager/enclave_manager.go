@@ -35,6 +35,9 @@ const (
 	errorDelimiter = ", "
 
 	enclaveNameNotFound = "Name Not Found"
+
+	// How often the engine looks for expired enclaves
+	enclaveReaperInterval = 1 * time.Minute
 )
 
 // TODO Move this to the KurtosisBackend to calculate!!
@@ -75,6 +78,9 @@ type EnclaveManager struct {
 	isCI                        bool
 	cloudUserID                 metrics_client.CloudUserID
 	cloudInstanceID             metrics_client.CloudInstanceID
+
+	// Nil if the engine doesn't set a default enclave resource quota
+	defaultEnclaveResourceQuota *enclave.EnclaveResourceQuota
 }
 
 func CreateEnclaveManager(
@@ -90,8 +96,11 @@ func CreateEnclaveManager(
 	isCI bool,
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
+	defaultEnclaveResourceQuota *enclave.EnclaveResourceQuota,
+	// Nil if the engine doesn't require authentication
+	engineAuthConfig *args.EngineAuthConfig,
 ) (*EnclaveManager, error) {
-	enclaveCreator := newEnclaveCreator(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier)
+	enclaveCreator := newEnclaveCreator(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier, engineAuthConfig)
 
 	var (
 		err         error
@@ -100,7 +109,7 @@ func CreateEnclaveManager(
 
 	// The enclave pool feature is only available for Kubernetes so far
 	if kurtosisBackendType == args.KurtosisBackendType_Kubernetes {
-		enclavePool, err = CreateEnclavePool(kurtosisBackend, enclaveCreator, poolSize, engineVersion, enclaveEnvVars, metricsUserID, didUserAcceptSendingMetrics, isCI, cloudUserID, cloudInstanceID)
+		enclavePool, err = CreateEnclavePool(kurtosisBackend, enclaveCreator, poolSize, engineVersion, enclaveEnvVars, metricsUserID, didUserAcceptSendingMetrics, isCI, cloudUserID, cloudInstanceID, defaultEnclaveResourceQuota)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating enclave pool with pool-size '%v' and engine version '%v'", poolSize, engineVersion)
 		}
@@ -121,6 +130,7 @@ func CreateEnclaveManager(
 		isCI:                                      isCI,
 		cloudUserID:                               cloudUserID,
 		cloudInstanceID:                           cloudInstanceID,
+		defaultEnclaveResourceQuota:               defaultEnclaveResourceQuota,
 	}
 
 	return enclaveManager, nil
@@ -139,6 +149,14 @@ func (manager *EnclaveManager) CreateEnclave(
 	enclaveName string,
 	isProduction bool,
 	shouldAPICRunInDebugMode bool,
+	enclaveLabels map[string]string,
+	// If nil, the enclave will never expire
+	enclaveTtl *time.Duration,
+	expiryAction enclave.EnclaveExpiryAction,
+	// If nil, the engine default quota is used; otherwise the limits left to zero are taken from the default quota
+	resourceQuota *enclave.EnclaveResourceQuota,
+	// Authenticated principal creating the enclave, empty if the engine doesn't require authentication
+	owner string,
 ) (*types.EnclaveInfo, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
@@ -148,6 +166,25 @@ func (manager *EnclaveManager) CreateEnclave(
 		err         error
 	)
 
+	var expiration *enclave.EnclaveExpiration
+	if enclaveTtl != nil {
+		if *enclaveTtl <= 0 {
+			return nil, stacktrace.NewError("The enclave TTL must be positive but got '%v'", *enclaveTtl)
+		}
+		if !expiryAction.IsValid() {
+			return nil, stacktrace.NewError("Invalid enclave expiry action '%v'; valid actions are '%v'", expiryAction, enclave.AllEnclaveExpiryActions)
+		}
+		expiration = enclave.NewEnclaveExpiration(time.Now().Add(*enclaveTtl), expiryAction)
+	}
+
+	enclaveResourceQuota := manager.defaultEnclaveResourceQuota
+	if resourceQuota != nil {
+		enclaveResourceQuota = resourceQuota.WithDefaults(manager.defaultEnclaveResourceQuota)
+	}
+	if enclaveResourceQuota != nil && enclaveResourceQuota.IsUnlimited() {
+		enclaveResourceQuota = nil
+	}
+
 	allExistingAndHistoricalIdentifiers, err := manager.getExistingAndHistoricalEnclaveIdentifiersWithoutMutex()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting existing and historical enclave identifiers")
@@ -168,7 +205,9 @@ func (manager *EnclaveManager) CreateEnclave(
 	}
 
 	// TODO(victor.colombo): Extend enclave pool to have warm production enclaves
-	if !isProduction && manager.enclavePool != nil {
+	// Idle enclaves are created without labels, expiration nor owner and with the default resource quota, so they
+	// can't be used when these are requested
+	if !isProduction && manager.enclavePool != nil && len(enclaveLabels) == 0 && expiration == nil && resourceQuota == nil && owner == "" {
 		enclaveInfo, err = manager.enclavePool.GetEnclave(
 			setupCtx,
 			enclaveName,
@@ -197,6 +236,10 @@ func (manager *EnclaveManager) CreateEnclave(
 			manager.cloudInstanceID,
 			manager.kurtosisBackendType,
 			shouldAPICRunInDebugMode,
+			enclaveLabels,
+			expiration,
+			enclaveResourceQuota,
+			owner,
 		)
 		if err != nil {
 			return nil, stacktrace.Propagate(
@@ -222,13 +265,21 @@ func (manager *EnclaveManager) CreateEnclave(
 // It's a liiiitle weird that we return an EnclaveInfo object (which is a Protobuf object), but as of 2021-10-21 this class
 //
 //	is only used by the EngineServerService so we might as well return the object that EngineServerService wants
+//
+// If labels are provided, only the enclaves having all of them are returned
 func (manager *EnclaveManager) GetEnclaves(
 	ctx context.Context,
+	labels map[string]string,
 ) (map[string]*types.EnclaveInfo, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 
-	enclaves, err := manager.getEnclavesWithoutMutex(ctx)
+	filters := &enclave.EnclaveFilters{
+		UUIDs:    nil,
+		Statuses: nil,
+		Labels:   labels,
+	}
+	enclaves, err := manager.getEnclavesMatchingFiltersWithoutMutex(ctx, filters)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves without the mutex")
 	}
@@ -267,11 +318,111 @@ func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdenti
 		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
 	}
 
+	if err := manager.destroyEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying enclave with identifier '%v'", enclaveIdentifier)
+	}
+	return nil
+}
+
+// ExtendEnclave pushes out the expiration time of an enclave that was created with a TTL, counting from now if the
+// enclave has already expired, and returns the new expiration time
+func (manager *EnclaveManager) ExtendEnclave(ctx context.Context, enclaveIdentifier string, extension time.Duration) (time.Time, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if extension <= 0 {
+		return time.Time{}, stacktrace.NewError("The enclave TTL extension must be positive but got '%v'", extension)
+	}
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return time.Time{}, stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+
+	enclaves, err := manager.getEnclavesMatchingFiltersWithoutMutex(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return time.Time{}, stacktrace.Propagate(err, "An error occurred getting enclave '%v'", enclaveUuid)
+	}
+	enclaveInfo, found := enclaves[enclaveUuid]
+	if !found {
+		return time.Time{}, stacktrace.NewError("Couldn't find enclave '%v' for identifier '%v'", enclaveUuid, enclaveIdentifier)
+	}
+	if enclaveInfo.ExpirationTime == nil {
+		return time.Time{}, stacktrace.NewError("Enclave '%v' can't be extended because it was created without a TTL, so it never expires", enclaveIdentifier)
+	}
+
+	newExpirationTime := getExtendedExpirationTime(*enclaveInfo.ExpirationTime, time.Now(), extension)
+	// the new expiration is stored in the backend, so that the reaper still honors it after an engine restart
+	newExpiration := enclave.NewEnclaveExpiration(newExpirationTime, getBackendEnclaveExpiryAction(enclaveInfo.ExpiryAction))
+	if err := manager.kurtosisBackend.UpdateEnclaveExpiration(ctx, enclaveUuid, newExpiration); err != nil {
+		return time.Time{}, stacktrace.Propagate(err, "An error occurred storing the new expiration time '%v' of enclave '%v'", newExpirationTime, enclaveIdentifier)
+	}
+	logrus.Infof("Extended the expiration time of enclave '%v' to '%v'", enclaveInfo.Name, newExpirationTime)
+	return newExpirationTime, nil
+}
+
+// StartEnclaveReaper schedules a thread that periodically stops or destroys the enclaves whose TTL has expired,
+// depending on the expiry action they were created with
+func (manager *EnclaveManager) StartEnclaveReaper(ctx context.Context) {
+	go func() {
+		logrus.Debugf("Scheduling expired enclaves reaping every '%v'...", enclaveReaperInterval)
+		reaperTicker := time.NewTicker(enclaveReaperInterval)
+		defer reaperTicker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				logrus.Debug("Context was cancelled, stopping the expired enclaves reaper")
+				return
+			case <-reaperTicker.C:
+				if err := manager.ReapExpiredEnclaves(ctx); err != nil {
+					logrus.Errorf("An error occurred reaping expired enclaves:\n%v", err)
+				}
+			}
+		}
+	}()
+}
+
+// ReapExpiredEnclaves applies the expiry action to every enclave whose expiration time has passed
+func (manager *EnclaveManager) ReapExpiredEnclaves(ctx context.Context) error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaves, err := manager.getEnclavesWithoutMutex(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclaves to look for expired ones")
+	}
+
+	reapingErrStrs := []string{}
+	for enclaveUuid, enclaveInfo := range getEnclavesToReap(enclaves, time.Now()) {
+		switch enclaveInfo.ExpiryAction {
+		case types.EnclaveExpiryAction_STOP:
+			if err := manager.stopEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+				reapingErrStrs = append(reapingErrStrs, stacktrace.Propagate(err, "An error occurred stopping expired enclave '%v'", enclaveInfo.Name).Error())
+				continue
+			}
+			logrus.Infof("Stopped enclave '%v' with UUID '%v' because it expired at '%v'", enclaveInfo.Name, enclaveUuid, enclaveInfo.ExpirationTime)
+		case types.EnclaveExpiryAction_DESTROY:
+			if err := manager.destroyEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+				reapingErrStrs = append(reapingErrStrs, stacktrace.Propagate(err, "An error occurred destroying expired enclave '%v'", enclaveInfo.Name).Error())
+				continue
+			}
+			logrus.Infof("Destroyed enclave '%v' with UUID '%v' because it expired at '%v'", enclaveInfo.Name, enclaveUuid, enclaveInfo.ExpirationTime)
+		}
+	}
+
+	if len(reapingErrStrs) > 0 {
+		return stacktrace.NewError("One or more errors occurred reaping expired enclaves:\n%v", strings.Join(reapingErrStrs, "\n\n"))
+	}
+	return nil
+}
+
+func (manager *EnclaveManager) destroyEnclaveWithoutMutex(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
 	enclaveDestroyFilter := &enclave.EnclaveFilters{
 		UUIDs: map[enclave.EnclaveUUID]bool{
 			enclaveUuid: true,
 		},
 		Statuses: nil,
+		Labels:   nil,
 	}
 	successfullyDestroyedEnclaves, erroredEnclaves, err := manager.kurtosisBackend.DestroyEnclaves(ctx, enclaveDestroyFilter)
 	if err != nil {
@@ -279,13 +430,13 @@ func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdenti
 	}
 	if _, found := successfullyDestroyedEnclaves[enclaveUuid]; found {
 		if err = manager.logsDbClient.RemoveEnclaveLogs(string(enclaveUuid)); err != nil {
-			return stacktrace.Propagate(err, "An error occurred attempting to remove enclave '%v' logs after it was destroyed.", enclaveIdentifier)
+			return stacktrace.Propagate(err, "An error occurred attempting to remove enclave '%v' logs after it was destroyed.", enclaveUuid)
 		}
 		return nil
 	}
 	destructionErr, found := erroredEnclaves[enclaveUuid]
 	if !found {
-		return stacktrace.NewError("The requested enclave UUD '%v' for identifier '%v' wasn't found in the successfully-destroyed enclaves map, nor in the errors map; this is a bug in Kurtosis!", enclaveUuid, enclaveIdentifier)
+		return stacktrace.NewError("The requested enclave UUD '%v' wasn't found in the successfully-destroyed enclaves map, nor in the errors map; this is a bug in Kurtosis!", enclaveUuid)
 	}
 	return destructionErr
 }
@@ -353,6 +504,27 @@ func (manager *EnclaveManager) GetEnclaveUuidForEnclaveIdentifier(ctx context.Co
 	return manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
 }
 
+// GetEnclaveOwner returns the principal that created the enclave, empty if it was created while the engine wasn't
+// requiring authentication
+func (manager *EnclaveManager) GetEnclaveOwner(ctx context.Context, enclaveIdentifier string) (string, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while fetching uuid for enclave '%v'", enclaveIdentifier)
+	}
+	enclaves, err := manager.getEnclavesMatchingFiltersWithoutMutex(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting enclave '%v'", enclaveUuid)
+	}
+	enclaveInfo, found := enclaves[enclaveUuid]
+	if !found {
+		return "", stacktrace.NewError("Couldn't find enclave '%v'", enclaveUuid)
+	}
+	return enclaveInfo.Owner, nil
+}
+
 func (manager *EnclaveManager) GetExistingAndHistoricalEnclaveIdentifiers() ([]*types.EnclaveIdentifiers, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
@@ -409,6 +581,16 @@ func (manager *EnclaveManager) RestartAllEnclaveAPIContainers(ctx context.Contex
 		apiContainersToDestroyEnclaveUuids[enclaveUuid] = true
 	}
 
+	// the resource quota is persisted on the enclave, so the new API containers keep enforcing it
+	enclavesWithRunningAPIContainers, err := manager.kurtosisBackend.GetEnclaves(ctx, &enclave.EnclaveFilters{
+		UUIDs:    apiContainersToDestroyEnclaveUuids,
+		Statuses: nil,
+		Labels:   nil,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclaves with UUIDs '%+v'", apiContainersToDestroyEnclaveUuids)
+	}
+
 	if err := manager.destroyApiContainers(ctx, apiContainersToDestroyEnclaveUuids); err != nil {
 		return stacktrace.Propagate(err, "An error occurred destroying API containers on enclave with UUIDs '%+v'", apiContainersToDestroyEnclaveUuids)
 	}
@@ -423,6 +605,13 @@ func (manager *EnclaveManager) RestartAllEnclaveAPIContainers(ctx context.Contex
 
 	for enclaveUuid, currentAPIContainer := range allAPIContainersRunning {
 
+		var enclaveResourceQuota *enclave.EnclaveResourceQuota
+		enclaveOwner := ""
+		if enclaveObj, found := enclavesWithRunningAPIContainers[enclaveUuid]; found {
+			enclaveResourceQuota = enclaveObj.GetResourceQuota()
+			enclaveOwner = enclaveObj.GetOwner()
+		}
+
 		_, err := manager.enclaveCreator.LaunchApiContainer(
 			ctx,
 			useDefaultApiContainerVersionTag,
@@ -437,6 +626,8 @@ func (manager *EnclaveManager) RestartAllEnclaveAPIContainers(ctx context.Contex
 			manager.cloudUserID,
 			manager.cloudInstanceID,
 			noDebugMode,
+			enclaveResourceQuota,
+			enclaveOwner,
 		)
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred launching the API container")
@@ -587,6 +778,7 @@ func (manager *EnclaveManager) cleanEnclaves(
 	destroyEnclaveFilters := &enclave.EnclaveFilters{
 		UUIDs:    enclaveUUIDs,
 		Statuses: enclaveStatusFilters,
+		Labels:   nil,
 	}
 	successfullyDestroyedEnclaves, erroredEnclaves, err := manager.kurtosisBackend.DestroyEnclaves(ctx, destroyEnclaveFilters)
 	if err != nil {
@@ -614,7 +806,14 @@ func (manager *EnclaveManager) cleanEnclaves(
 func (manager *EnclaveManager) getEnclavesWithoutMutex(
 	ctx context.Context,
 ) (map[enclave.EnclaveUUID]*types.EnclaveInfo, error) {
-	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getAllEnclavesFilter())
+	return manager.getEnclavesMatchingFiltersWithoutMutex(ctx, getAllEnclavesFilter())
+}
+
+func (manager *EnclaveManager) getEnclavesMatchingFiltersWithoutMutex(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (map[enclave.EnclaveUUID]*types.EnclaveInfo, error) {
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, filters)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error thrown retrieving enclaves")
 	}
@@ -650,6 +849,7 @@ func getEnclaveByEnclaveIdFilter(enclaveUuid enclave.EnclaveUUID) *enclave.Encla
 			enclaveUuid: true,
 		},
 		Statuses: nil,
+		Labels:   nil,
 	}
 }
 
@@ -657,6 +857,7 @@ func getAllEnclavesFilter() *enclave.EnclaveFilters {
 	return &enclave.EnclaveFilters{
 		UUIDs:    map[enclave.EnclaveUUID]bool{},
 		Statuses: nil,
+		Labels:   nil,
 	}
 }
 
@@ -693,6 +894,8 @@ func getEnclaveInfoForEnclave(ctx context.Context, kurtosisBackend backend_inter
 		mode = types.EnclaveMode_PRODUCTION
 	}
 
+	expirationTime, expiryAction := getEnclaveExpirationTimeAndAction(enclave.GetExpiration())
+
 	return &types.EnclaveInfo{
 		EnclaveUuid:                 enclaveUuidStr,
 		ShortenedUuid:               uuid_generator.ShortenedUUIDString(enclaveUuidStr),
@@ -703,6 +906,11 @@ func getEnclaveInfoForEnclave(ctx context.Context, kurtosisBackend backend_inter
 		ApiContainerHostMachineInfo: apiContainerHostMachineInfo,
 		CreationTime:                *creationTimestamp,
 		Mode:                        mode,
+		Labels:                      getEnclaveLabels(enclave),
+		ExpirationTime:              expirationTime,
+		ExpiryAction:                expiryAction,
+		ResourceQuota:               getEnclaveResourceQuota(enclave),
+		Owner:                       enclave.GetOwner(),
 	}, nil
 }
 
@@ -788,3 +996,68 @@ func getEnclaveCreationTimestamp(enclave *enclave.Enclave) (*time.Time, error) {
 
 	return enclaveCreationTime, nil
 }
+
+// Never returns nil so the labels can be safely serialized
+func getEnclaveLabels(enclaveObj *enclave.Enclave) map[string]string {
+	if enclaveObj.GetLabels() == nil {
+		return map[string]string{}
+	}
+	return enclaveObj.GetLabels()
+}
+
+// Returns nil if the enclave has no resource quota
+func getEnclaveResourceQuota(enclaveObj *enclave.Enclave) *types.EnclaveResourceQuota {
+	resourceQuota := enclaveObj.GetResourceQuota()
+	if resourceQuota == nil {
+		return nil
+	}
+	return &types.EnclaveResourceQuota{
+		MaxCpuMilliCores:              uint64(resourceQuota.GetMaxCpuMilliCores()),
+		MaxMemoryMegaBytes:            uint64(resourceQuota.GetMaxMemoryMegaBytes()),
+		MaxServices:                   resourceQuota.GetMaxServices(),
+		MaxPersistentStorageMegaBytes: resourceQuota.GetMaxPersistentStorageMegaBytes(),
+	}
+}
+
+// Returns a nil expiration time if the enclave never expires
+func getEnclaveExpirationTimeAndAction(expiration *enclave.EnclaveExpiration) (*time.Time, types.EnclaveExpiryAction) {
+	if expiration == nil {
+		return nil, types.EnclaveExpiryAction_DESTROY
+	}
+	expirationTime := expiration.GetExpirationTime()
+	if expiration.GetAction() == enclave.EnclaveExpiryAction_Stop {
+		return &expirationTime, types.EnclaveExpiryAction_STOP
+	}
+	return &expirationTime, types.EnclaveExpiryAction_DESTROY
+}
+
+func getBackendEnclaveExpiryAction(expiryAction types.EnclaveExpiryAction) enclave.EnclaveExpiryAction {
+	if expiryAction == types.EnclaveExpiryAction_STOP {
+		return enclave.EnclaveExpiryAction_Stop
+	}
+	return enclave.EnclaveExpiryAction_Destroy
+}
+
+// The extension is added to the current expiration time, unless the enclave has already expired in which case it's added to now
+func getExtendedExpirationTime(currentExpirationTime time.Time, now time.Time, extension time.Duration) time.Time {
+	if currentExpirationTime.Before(now) {
+		return now.Add(extension)
+	}
+	return currentExpirationTime.Add(extension)
+}
+
+// Returns the expired enclaves that still need their expiry action to be applied; stopped enclaves that should be
+// stopped on expiry have nothing left to do
+func getEnclavesToReap(enclaves map[enclave.EnclaveUUID]*types.EnclaveInfo, now time.Time) map[enclave.EnclaveUUID]*types.EnclaveInfo {
+	result := map[enclave.EnclaveUUID]*types.EnclaveInfo{}
+	for enclaveUuid, enclaveInfo := range enclaves {
+		if enclaveInfo.ExpirationTime == nil || now.Before(*enclaveInfo.ExpirationTime) {
+			continue
+		}
+		if enclaveInfo.ExpiryAction == types.EnclaveExpiryAction_STOP && enclaveInfo.EnclaveStatus != types.EnclaveStatus_RUNNING {
+			continue
+		}
+		result[enclaveUuid] = enclaveInfo
+	}
+	return result
+}
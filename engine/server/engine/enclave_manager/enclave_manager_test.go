@@ -2,10 +2,12 @@ package enclave_manager
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	engine_types "github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,3 +31,73 @@ func TestGetApiContainerStatusFromContainerStatusCompleteness(t *testing.T) {
 		require.NoError(t, err, "No ApiContainerStatus provided for container status '%v'", containerStatus.String())
 	}
 }
+
+func TestGetExtendedExpirationTime(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	extension := 30 * time.Minute
+
+	notExpiredYet := now.Add(time.Hour)
+	require.Equal(t, notExpiredYet.Add(extension), getExtendedExpirationTime(notExpiredYet, now, extension))
+
+	alreadyExpired := now.Add(-time.Hour)
+	require.Equal(t, now.Add(extension), getExtendedExpirationTime(alreadyExpired, now, extension))
+}
+
+func TestGetEnclaveExpirationTimeAndAction(t *testing.T) {
+	expirationTime, expiryAction := getEnclaveExpirationTimeAndAction(nil)
+	require.Nil(t, expirationTime)
+	require.Equal(t, engine_types.EnclaveExpiryAction_DESTROY, expiryAction)
+
+	expectedExpirationTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	expirationTime, expiryAction = getEnclaveExpirationTimeAndAction(enclave.NewEnclaveExpiration(expectedExpirationTime, enclave.EnclaveExpiryAction_Stop))
+	require.NotNil(t, expirationTime)
+	require.Equal(t, expectedExpirationTime, *expirationTime)
+	require.Equal(t, engine_types.EnclaveExpiryAction_STOP, expiryAction)
+}
+
+func TestGetBackendEnclaveExpiryAction(t *testing.T) {
+	require.Equal(t, enclave.EnclaveExpiryAction_Stop, getBackendEnclaveExpiryAction(engine_types.EnclaveExpiryAction_STOP))
+	require.Equal(t, enclave.EnclaveExpiryAction_Destroy, getBackendEnclaveExpiryAction(engine_types.EnclaveExpiryAction_DESTROY))
+}
+
+func TestGetEnclavesToReap(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	enclaves := map[enclave.EnclaveUUID]*engine_types.EnclaveInfo{
+		"never-expires":        newEnclaveInfoForReaperTest(nil, engine_types.EnclaveExpiryAction_DESTROY, engine_types.EnclaveStatus_RUNNING),
+		"not-expired":          newEnclaveInfoForReaperTest(&future, engine_types.EnclaveExpiryAction_DESTROY, engine_types.EnclaveStatus_RUNNING),
+		"expired-destroy":      newEnclaveInfoForReaperTest(&past, engine_types.EnclaveExpiryAction_DESTROY, engine_types.EnclaveStatus_STOPPED),
+		"expired-stop-running": newEnclaveInfoForReaperTest(&past, engine_types.EnclaveExpiryAction_STOP, engine_types.EnclaveStatus_RUNNING),
+		"expired-stop-stopped": newEnclaveInfoForReaperTest(&past, engine_types.EnclaveExpiryAction_STOP, engine_types.EnclaveStatus_STOPPED),
+		"expires-exactly-now":  newEnclaveInfoForReaperTest(&now, engine_types.EnclaveExpiryAction_DESTROY, engine_types.EnclaveStatus_EMPTY),
+	}
+
+	enclavesToReap := getEnclavesToReap(enclaves, now)
+	require.Len(t, enclavesToReap, 3)
+	require.Contains(t, enclavesToReap, enclave.EnclaveUUID("expired-destroy"))
+	require.Contains(t, enclavesToReap, enclave.EnclaveUUID("expired-stop-running"))
+	require.Contains(t, enclavesToReap, enclave.EnclaveUUID("expires-exactly-now"))
+}
+
+func newEnclaveInfoForReaperTest(
+	expirationTime *time.Time,
+	expiryAction engine_types.EnclaveExpiryAction,
+	status engine_types.EnclaveStatus,
+) *engine_types.EnclaveInfo {
+	return &engine_types.EnclaveInfo{
+		ApiContainerHostMachineInfo: nil,
+		ApiContainerInfo:            nil,
+		ApiContainerStatus:          engine_types.ContainerStatus_NONEXISTENT,
+		EnclaveStatus:               status,
+		CreationTime:                time.Time{},
+		EnclaveUuid:                 "",
+		Mode:                        engine_types.EnclaveMode_TEST,
+		Name:                        "",
+		ShortenedUuid:               "",
+		Labels:                      map[string]string{},
+		ExpirationTime:              expirationTime,
+		ExpiryAction:                expiryAction,
+	}
+}
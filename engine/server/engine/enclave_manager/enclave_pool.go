@@ -39,6 +39,8 @@ type EnclavePool struct {
 	isCI                        bool
 	cloudUserID                 metrics_client.CloudUserID
 	cloudInstanceID             metrics_client.CloudInstanceID
+	// Nil if the engine doesn't set a default enclave resource quota
+	defaultEnclaveResourceQuota *enclave.EnclaveResourceQuota
 }
 
 // CreateEnclavePool will do the following:
@@ -58,6 +60,7 @@ func CreateEnclavePool(
 	isCI bool,
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
+	defaultEnclaveResourceQuota *enclave.EnclaveResourceQuota,
 ) (*EnclavePool, error) {
 
 	//TODO the current implementation only removes the previous idle enclave, it's pending to implement the reusable feature
@@ -105,6 +108,7 @@ func CreateEnclavePool(
 		isCI:                        isCI,
 		cloudUserID:                 cloudUserID,
 		cloudInstanceID:             cloudInstanceID,
+		defaultEnclaveResourceQuota: defaultEnclaveResourceQuota,
 	}
 
 	go enclavePool.run(ctxWithCancel)
@@ -295,6 +299,12 @@ func (pool *EnclavePool) createNewIdleEnclave(ctx context.Context) (*types.Encla
 		pool.cloudInstanceID,
 		args.KurtosisBackendType_Kubernetes, // enclave pool only available for k8s
 		defaultApicDebugModeForEnclavesInThePool,
+		// idle enclaves don't have labels nor owner, never expire and get the default resource quota, the pool is
+		// skipped when these are requested
+		map[string]string{},
+		nil,
+		pool.defaultEnclaveResourceQuota,
+		"",
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -318,6 +328,7 @@ func (pool *EnclavePool) getRunningEnclave(ctx context.Context, enclaveUUID encl
 		Statuses: map[enclave.EnclaveStatus]bool{
 			enclave.EnclaveStatus_Running: true,
 		},
+		Labels: nil,
 	}
 
 	enclaves, err := pool.kurtosisBackend.GetEnclaves(ctx, filters)
@@ -375,6 +386,7 @@ func destroyIdleEnclaves(kurtosisBackend backend_interface.KurtosisBackend) erro
 	filters := &enclave.EnclaveFilters{
 		UUIDs:    nil,
 		Statuses: nil,
+		Labels:   nil,
 	}
 
 	enclaves, err := kurtosisBackend.GetEnclaves(ctx, filters)
@@ -430,6 +442,7 @@ func destroyOldIdleEnclaves(kurtosisBackend backend_interface.KurtosisBackend, b
 	filters := &enclave.EnclaveFilters{
 		UUIDs:    nil,
 		Statuses: nil,
+		Labels:   nil,
 	}
 
 	idleEnclavesToRemove := map[enclave.EnclaveUUID]bool{}
@@ -468,6 +481,7 @@ func destroyEnclavesByUUID(
 	destroyEnclaveFilters := &enclave.EnclaveFilters{
 		UUIDs:    enclavesToRemove,
 		Statuses: map[enclave.EnclaveStatus]bool{},
+		Labels:   nil,
 	}
 
 	logrus.Debugf("Destroying enclaves '%+v'", enclavesToRemove)
@@ -6,6 +6,7 @@
 package main
 
 import (
+	"connectrpc.com/connect"
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/file_layout"
@@ -31,11 +32,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
+	api_container_backend_config "github.com/kurtosis-tech/kurtosis/core/launcher/args/kurtosis_backend_config"
 	em_api "github.com/kurtosis-tech/kurtosis/enclave-manager/server"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args/kurtosis_backend_config"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/kurtosis_backend"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume"
@@ -95,7 +99,7 @@ const (
 
 var (
 	defaultCORSOrigins []string = []string{"*"}
-	defaultCORSHeaders []string = []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept}
+	defaultCORSHeaders []string = []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization}
 )
 
 // Nil indicates that the KurtosisBackend should not operate in API container mode, which is appropriate here
@@ -195,63 +199,38 @@ func runMain() error {
 		serverArgs.IsCI,
 		serverArgs.CloudUserID,
 		serverArgs.CloudInstanceID,
-		serverArgs.KurtosisLocalBackendConfig)
+		serverArgs.KurtosisLocalBackendConfig,
+		serverArgs.DefaultEnclaveResourceQuota,
+		serverArgs.AuthConfig)
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to create an enclave manager for backend type '%v' and config '%+v'", serverArgs.KurtosisBackendType, backendConfig)
 	}
+	enclaveManager.StartEnclaveReaper(ctx)
 
-	go func() {
-		envJsFilePath := filepath.Join(pathToStaticFolder, envJsFilename)
-		envJsFilePathPerm := envJsFilePerm
-		envJsFileContent := "window.env = {};"
-		if serverArgs.Domain != "" {
-			envJsFileContent += fmt.Sprintf("window.env.domain = '%s';", serverArgs.Domain)
-		}
-		if err = os.WriteFile(envJsFilePath, []byte(envJsFileContent), fs.FileMode(envJsFilePathPerm)); err != nil {
-			logrus.Errorf("Failed to create the environment js file: '%v'", err)
-			return
-		}
-		logrus.Debugf("Created environment js file with content: \n%s", envJsFileContent)
-
-		fileServer := http.FileServer(http.Dir(pathToStaticFolder))
-		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			path, err := filepath.Abs(r.URL.Path)
-			if err != nil {
-				http.Error(w, err.Error(), http.StatusBadRequest)
-				return
-			}
-
-			path = filepath.Join(pathToStaticFolder, path)
-
-			_, err = os.Stat(path)
-			if os.IsNotExist(err) {
-				// file does not exist, serve index.html
-				http.ServeFile(w, r, filepath.Join(pathToStaticFolder, indexPath))
-				return
-			} else if err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-				return
-			}
-
-			w.Header().Add("Cache-Control", "no-store")
-			fileServer.ServeHTTP(w, r)
-		})
-
-		err := http.ListenAndServe(webappPortAddr, handler)
+	var (
+		authenticator                *auth.Authenticator
+		authorizer                   *auth.Authorizer
+		apiContainerCredentialIssuer *auth.ApiContainerCredentialIssuer
+	)
+	if serverArgs.AuthConfig != nil {
+		authenticator, err = auth.NewAuthenticator(serverArgs.AuthConfig)
 		if err != nil {
-			logrus.Debugf("error while starting the webapp: \n%v", err)
+			return stacktrace.Propagate(err, "An error occurred creating the engine API authenticator")
 		}
-	}()
-
-	go func() {
-		enforceAuth := serverArgs.OnBastionHost
-		err = em_api.RunEnclaveManagerApiServer(enforceAuth)
+		authorizer = auth.NewAuthorizer(enclaveManager)
+		apiContainerCredentialIssuer, err = auth.NewApiContainerCredentialIssuer(serverArgs.AuthConfig)
 		if err != nil {
-			logrus.Fatal("an error occurred while processing the auth settings, exiting!", err)
-			fmt.Fprintln(logrus.StandardLogger().Out, err)
-			os.Exit(failureExitCode)
+			return stacktrace.Propagate(err, "An error occurred creating the API container credential issuer")
 		}
-	}()
+	}
+
+	// The enclave manager UI calls the engine API on behalf of its users without their credentials, so it's not served
+	// when the engine requires authentication; the CLI refuses to start the engine with both, this is a safety net
+	if authenticator != nil {
+		logrus.Warn("The engine requires authentication, which the enclave manager UI doesn't support, so it won't be served")
+	} else {
+		startEnclaveManagerUI(serverArgs)
+	}
 
 	logger := logrus.StandardLogger()
 	metricsClient, closeClientFunc, err := metrics_client.CreateMetricsClient(
@@ -291,6 +270,9 @@ func runMain() error {
 			enclaveManager,
 			logsDatabaseClient,
 			metricsClient,
+			authenticator,
+			authorizer,
+			apiContainerCredentialIssuer,
 		)
 		if err != nil {
 			logrus.Fatal("The REST API server is down, exiting!", err)
@@ -305,8 +287,16 @@ func runMain() error {
 		serverArgs.MetricsUserID,
 		serverArgs.DidUserAcceptSendingMetrics,
 		logsDatabaseClient,
-		metricsClient)
-	apiPath, handler := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceHandler(engineConnectServer)
+		metricsClient,
+		apiContainerCredentialIssuer)
+	var handlerOptions []connect.HandlerOption
+	if authorizer != nil {
+		handlerOptions = append(handlerOptions, connect.WithInterceptors(authorizer.NewConnectInterceptor()))
+	}
+	apiPath, handler := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceHandler(engineConnectServer, handlerOptions...)
+	if authenticator != nil {
+		handler = authenticator.HttpMiddleware(handler)
+	}
 	defer func() {
 		if err := engineConnectServer.Close(); err != nil {
 			logrus.Errorf("We tried to close the engine connect server service but something fails. Err:\n%v", err)
@@ -314,6 +304,9 @@ func runMain() error {
 	}()
 
 	engineHttpServer := connect_server.NewConnectServer(serverArgs.GrpcListenPortNum, grpcServerStopGracePeriod, handler, apiPath)
+	if authenticator != nil && authenticator.GetTlsConfig() != nil {
+		engineHttpServer = connect_server.NewConnectServerWithTls(serverArgs.GrpcListenPortNum, grpcServerStopGracePeriod, handler, apiPath, authenticator.GetTlsConfig())
+	}
 	if err := engineHttpServer.RunServerUntilInterruptedWithCors(cors.AllowAll()); err != nil {
 		return stacktrace.Propagate(err, "An error occurred running the server.")
 	}
@@ -321,6 +314,62 @@ func runMain() error {
 	return nil
 }
 
+// startEnclaveManagerUI starts the webapp and the enclave manager API in the background
+func startEnclaveManagerUI(serverArgs *args.EngineServerArgs) {
+	go func() {
+		envJsFilePath := filepath.Join(pathToStaticFolder, envJsFilename)
+		envJsFilePathPerm := envJsFilePerm
+		envJsFileContent := "window.env = {};"
+		if serverArgs.Domain != "" {
+			envJsFileContent += fmt.Sprintf("window.env.domain = '%s';", serverArgs.Domain)
+		}
+		if err := os.WriteFile(envJsFilePath, []byte(envJsFileContent), fs.FileMode(envJsFilePathPerm)); err != nil {
+			logrus.Errorf("Failed to create the environment js file: '%v'", err)
+			return
+		}
+		logrus.Debugf("Created environment js file with content: \n%s", envJsFileContent)
+
+		fileServer := http.FileServer(http.Dir(pathToStaticFolder))
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path, err := filepath.Abs(r.URL.Path)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			path = filepath.Join(pathToStaticFolder, path)
+
+			_, err = os.Stat(path)
+			if os.IsNotExist(err) {
+				// file does not exist, serve index.html
+				http.ServeFile(w, r, filepath.Join(pathToStaticFolder, indexPath))
+				return
+			} else if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			w.Header().Add("Cache-Control", "no-store")
+			fileServer.ServeHTTP(w, r)
+		})
+
+		err := http.ListenAndServe(webappPortAddr, handler)
+		if err != nil {
+			logrus.Debugf("error while starting the webapp: \n%v", err)
+		}
+	}()
+
+	go func() {
+		enforceAuth := serverArgs.OnBastionHost
+		err := em_api.RunEnclaveManagerApiServer(enforceAuth)
+		if err != nil {
+			logrus.Fatal("an error occurred while processing the auth settings, exiting!", err)
+			fmt.Fprintln(logrus.StandardLogger().Out, err)
+			os.Exit(failureExitCode)
+		}
+	}()
+}
+
 func getEnclaveManager(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	kurtosisBackendType args.KurtosisBackendType,
@@ -334,6 +383,9 @@ func getEnclaveManager(
 	cloudUserId metrics_client.CloudUserID,
 	cloudInstanceId metrics_client.CloudInstanceID,
 	kurtosisLocalBackendConfig interface{},
+	defaultEnclaveResourceQuota *enclave.EnclaveResourceQuota,
+	// Nil if the engine doesn't require authentication
+	authConfig *args.EngineAuthConfig,
 ) (*enclave_manager.EnclaveManager, error) {
 	var apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
 	switch kurtosisBackendType {
@@ -344,7 +396,12 @@ func getEnclaveManager(
 		if !ok {
 			return nil, stacktrace.NewError("Failed to cast cluster configuration interface to the appropriate type, even though Kurtosis backend type is '%v'", args.KurtosisBackendType_Kubernetes.String())
 		}
-		apiContainerKurtosisBackendConfigSupplier = api_container_launcher.NewKubernetesKurtosisBackendConfigSupplier(kurtosisLocalBackendConfigKubernetesType.StorageClass)
+		apiContainerKurtosisBackendConfigSupplier = api_container_launcher.NewKubernetesKurtosisBackendConfigSupplier(
+			kurtosisLocalBackendConfigKubernetesType.StorageClass,
+			getApiContainerServiceExposureConfig(kurtosisLocalBackendConfigKubernetesType.ServiceExposure),
+			kurtosisLocalBackendConfigKubernetesType.ServiceWorkload,
+			kurtosisLocalBackendConfigKubernetesType.TaskHelperImage,
+		)
 	default:
 		return nil, stacktrace.NewError("Backend type '%v' was not recognized by engine server.", kurtosisBackendType.String())
 	}
@@ -362,6 +419,8 @@ func getEnclaveManager(
 		isCI,
 		cloudUserId,
 		cloudInstanceId,
+		defaultEnclaveResourceQuota,
+		authConfig,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave manager for backend type '%+v' using pool-size '%v' and engine version '%v'", kurtosisBackendType, poolSize, engineVersion)
@@ -444,6 +503,10 @@ func restApiServer(
 	enclave_manager *enclave_manager.EnclaveManager,
 	logsDatabaseClient centralized_logs.LogsDatabaseClient,
 	metricsClient metrics_client.MetricsClient,
+	// Both nil if the engine accepts any caller
+	authenticator *auth.Authenticator,
+	authorizer *auth.Authorizer,
+	apiContainerCredentialIssuer *auth.ApiContainerCredentialIssuer,
 ) error {
 
 	asyncStarlarkLogs := streaming.NewStreamerPool[*kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](streamerPoolSize, streamerExpirationTime)
@@ -466,6 +529,10 @@ func restApiServer(
 		AllowHeaders: defaultCORSHeaders,
 	}))
 
+	if authorizer != nil {
+		echoApiRouter.Use(authorizer.NewEchoMiddleware(authenticator))
+	}
+
 	// ============================== Engine Management API ======================================
 	engineRuntime := restApi.EngineRuntime{
 		ImageVersionTag: serverArgs.ImageVersionTag,
@@ -482,19 +549,20 @@ func restApiServer(
 		AllowedMethods: defaultCORSHeaders,
 	})
 	webSocketRuntime := restApi.WebSocketRuntime{
-		ImageVersionTag:             serverArgs.ImageVersionTag,
-		EnclaveManager:              enclave_manager,
-		MetricsUserID:               serverArgs.MetricsUserID,
-		DidUserAcceptSendingMetrics: serverArgs.DidUserAcceptSendingMetrics,
-		LogsDatabaseClient:          logsDatabaseClient,
-		MetricsClient:               metricsClient,
-		AsyncStarlarkLogs:           asyncStarlarkLogs,
-		CorsConfig:                  *corsConfig,
+		ImageVersionTag:              serverArgs.ImageVersionTag,
+		EnclaveManager:               enclave_manager,
+		MetricsUserID:                serverArgs.MetricsUserID,
+		DidUserAcceptSendingMetrics:  serverArgs.DidUserAcceptSendingMetrics,
+		LogsDatabaseClient:           logsDatabaseClient,
+		MetricsClient:                metricsClient,
+		AsyncStarlarkLogs:            asyncStarlarkLogs,
+		CorsConfig:                   *corsConfig,
+		ApiContainerCredentialIssuer: apiContainerCredentialIssuer,
 	}
 	loggingApi.RegisterHandlers(echoApiRouter, webSocketRuntime)
 
 	// ============================== Engine Management API ======================================
-	enclaveRuntime, err := restApi.NewEnclaveRuntime(ctx, *enclave_manager, asyncStarlarkLogs, false)
+	enclaveRuntime, err := restApi.NewEnclaveRuntime(ctx, *enclave_manager, asyncStarlarkLogs, false, apiContainerCredentialIssuer)
 	if err != nil {
 		newErr := stacktrace.Propagate(err, "Failed to initialize %T", enclaveRuntime)
 		return newErr
@@ -529,5 +597,29 @@ func restApiServer(
 	}
 
 	// ============================== Start Server ======================================
-	return echoRouter.Start(net.JoinHostPort(engine.RESTAPIHostIP, fmt.Sprint(engine.RESTAPIPortAddr)))
+	restApiAddr := net.JoinHostPort(engine.RESTAPIHostIP, fmt.Sprint(engine.RESTAPIPortAddr))
+	if authenticator != nil && authenticator.GetTlsConfig() != nil {
+		// nolint:exhaustruct
+		return echoRouter.StartServer(&http.Server{
+			Addr:      restApiAddr,
+			TLSConfig: authenticator.GetTlsConfig(),
+		})
+	}
+	return echoRouter.Start(restApiAddr)
+}
+
+// getApiContainerServiceExposureConfig hands the service exposure of the engine config down to the API containers, which
+// are the ones starting the user services
+func getApiContainerServiceExposureConfig(serviceExposure *kurtosis_backend_config.KubernetesServiceExposureConfig) *api_container_backend_config.KubernetesServiceExposureConfig {
+	if serviceExposure == nil {
+		return nil
+	}
+	return &api_container_backend_config.KubernetesServiceExposureConfig{
+		Type:             serviceExposure.Type,
+		HostnameTemplate: serviceExposure.HostnameTemplate,
+		IsHttps:          serviceExposure.IsHttps,
+		IngressClassName: serviceExposure.IngressClassName,
+		GatewayName:      serviceExposure.GatewayName,
+		GatewayNamespace: serviceExposure.GatewayNamespace,
+	}
 }
@@ -0,0 +1,48 @@
+package to_grpc
+
+import (
+	rpc_api "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	api_type "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/api_types"
+)
+
+// ToGrpcExecCommandStreamRequests maps a client input to the requests it stands for, as a single input can carry
+// several of them (e.g. the last STDIN bytes along with closing it)
+func ToGrpcExecCommandStreamRequests(input api_type.ExecCommandStreamInput) []*rpc_api.ExecCommandStreamRequest {
+	requests := []*rpc_api.ExecCommandStreamRequest{}
+	if input.Resize != nil {
+		requests = append(requests, &rpc_api.ExecCommandStreamRequest{
+			Request: &rpc_api.ExecCommandStreamRequest_Resize{
+				Resize: &rpc_api.TerminalSize{
+					Width:  uint32(input.Resize.Width),
+					Height: uint32(input.Resize.Height),
+				},
+			},
+		})
+	}
+	if input.Stdin != nil && len(*input.Stdin) > 0 {
+		requests = append(requests, &rpc_api.ExecCommandStreamRequest{
+			Request: &rpc_api.ExecCommandStreamRequest_Stdin{
+				Stdin: *input.Stdin,
+			},
+		})
+	}
+	if input.CloseStdin != nil && *input.CloseStdin {
+		requests = append(requests, &rpc_api.ExecCommandStreamRequest{
+			Request: &rpc_api.ExecCommandStreamRequest_CloseStdin{
+				CloseStdin: true,
+			},
+		})
+	}
+	return requests
+}
+
+// ToGrpcTerminalSize maps the initial size of the TTY of an exec command, which is only set if both its dimensions are
+func ToGrpcTerminalSize(width *api_type.TerminalWidth, height *api_type.TerminalHeight) *rpc_api.TerminalSize {
+	if width == nil || height == nil {
+		return nil
+	}
+	return &rpc_api.TerminalSize{
+		Width:  uint32(*width),
+		Height: uint32(*height),
+	}
+}
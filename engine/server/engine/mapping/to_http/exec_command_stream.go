@@ -0,0 +1,33 @@
+package to_http
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+
+	rpc_api "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	api_type "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/api_types"
+)
+
+func ToHttpExecCommandStreamOutput(rpc_value *rpc_api.ExecCommandStreamResponse) (*api_type.ExecCommandStreamOutput, error) {
+	switch response := rpc_value.GetResponse().(type) {
+	case *rpc_api.ExecCommandStreamResponse_Stdout:
+		return &api_type.ExecCommandStreamOutput{
+			ExitCode: nil,
+			Stderr:   nil,
+			Stdout:   &response.Stdout,
+		}, nil
+	case *rpc_api.ExecCommandStreamResponse_Stderr:
+		return &api_type.ExecCommandStreamOutput{
+			ExitCode: nil,
+			Stderr:   &response.Stderr,
+			Stdout:   nil,
+		}, nil
+	case *rpc_api.ExecCommandStreamResponse_ExitCode:
+		return &api_type.ExecCommandStreamOutput{
+			ExitCode: &response.ExitCode,
+			Stderr:   nil,
+			Stdout:   nil,
+		}, nil
+	default:
+		return nil, stacktrace.NewError("Unmatched exec command stream response of type '%T'", response)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_grpc"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
@@ -37,17 +38,21 @@ type enclaveRuntime struct {
 	ctx                      context.Context
 	lock                     sync.Mutex
 	asyncStarlarkLogs        streaming.StreamerPool[*rpc_api.StarlarkRunResponseLine]
+
+	// Nil if the engine doesn't require authentication
+	apiContainerCredentialIssuer *auth.ApiContainerCredentialIssuer
 }
 
-func NewEnclaveRuntime(ctx context.Context, manager enclave_manager.EnclaveManager, asyncStarlarkLogs streaming.StreamerPool[*rpc_api.StarlarkRunResponseLine], connectOnHostMachine bool) (*enclaveRuntime, error) {
+func NewEnclaveRuntime(ctx context.Context, manager enclave_manager.EnclaveManager, asyncStarlarkLogs streaming.StreamerPool[*rpc_api.StarlarkRunResponseLine], connectOnHostMachine bool, apiContainerCredentialIssuer *auth.ApiContainerCredentialIssuer) (*enclaveRuntime, error) {
 
 	runtime := enclaveRuntime{
-		enclaveManager:           manager,
-		remoteApiContainerClient: map[string]rpc_api.ApiContainerServiceClient{},
-		connectOnHostMachine:     connectOnHostMachine,
-		ctx:                      ctx,
-		asyncStarlarkLogs:        asyncStarlarkLogs,
-		lock:                     sync.Mutex{},
+		enclaveManager:               manager,
+		remoteApiContainerClient:     map[string]rpc_api.ApiContainerServiceClient{},
+		connectOnHostMachine:         connectOnHostMachine,
+		ctx:                          ctx,
+		asyncStarlarkLogs:            asyncStarlarkLogs,
+		lock:                         sync.Mutex{},
+		apiContainerCredentialIssuer: apiContainerCredentialIssuer,
 	}
 
 	err := runtime.refreshEnclaveConnections()
@@ -603,9 +608,13 @@ func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkPackagesPack
 		StarlarkPackageContent: nil,
 		NonBlockingMode:        request.Body.NonBlockingMode,
 		GithubAuthToken:        request.Body.GithubAuthToken,
+		// The REST API doesn't expose the git host configs yet
+		GitHostConfigs:      nil,
+		RegistryCredentials: nil,
 	}
 
-	ctxWithCancel, cancelCtxFunc := context.WithCancel(context.Background())
+	// The run outlives the request, but is still made on behalf of its principal
+	ctxWithCancel, cancelCtxFunc := context.WithCancel(auth.NewBackgroundContextWithPrincipalOf(ctx))
 	stream, err := (*apiContainerClient).RunStarlarkPackage(ctxWithCancel, &runStarlarkPackageArgs)
 	if err != nil {
 		cancelCtxFunc()
@@ -676,9 +685,13 @@ func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx
 		CloudUserId:          request.Body.CloudUserId,
 		ImageDownloadMode:    utils.MapPointer(request.Body.ImageDownloadMode, to_grpc.ToGrpcImageDownloadMode),
 		NonBlockingMode:      request.Body.NonBlockingMode,
+		// The REST API doesn't expose the git host configs yet
+		GitHostConfigs:      nil,
+		RegistryCredentials: nil,
 	}
 
-	ctxWithCancel, cancelCtxFunc := context.WithCancel(context.Background())
+	// The run outlives the request, but is still made on behalf of its principal
+	ctxWithCancel, cancelCtxFunc := context.WithCancel(auth.NewBackgroundContextWithPrincipalOf(ctx))
 	stream, err := (*apiContainerClient).RunStarlarkScript(ctxWithCancel, &runStarlarkScriptArgs)
 	if err != nil {
 		cancelCtxFunc()
@@ -716,7 +729,8 @@ func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx
 
 // GetGrpcClientConn returns a client conn dialed in to the local port
 // It is the caller's responsibility to call resultClientConn.close()
-func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool) (resultClientConn *grpc.ClientConn, resultErr error) {
+// The API container calls forward the principal found in their context if the credential issuer isn't nil
+func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool, apiContainerCredentialIssuer *auth.ApiContainerCredentialIssuer) (resultClientConn *grpc.ClientConn, resultErr error) {
 	enclaveAPIContainerInfo := enclaveInfo.ApiContainerInfo
 	if enclaveAPIContainerInfo == nil {
 		logrus.Infof("No API container info is available for enclave %s", enclaveInfo.EnclaveUuid)
@@ -736,7 +750,11 @@ func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool)
 	}
 
 	grpcServerAddress := fmt.Sprintf("%v:%v", apiContainerIP, apiContainerGrpcPort)
-	grpcConnection, err := grpc.Dial(grpcServerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if apiContainerCredentialIssuer != nil {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(apiContainerCredentialIssuer.NewApiContainerPerRPCCredentials(enclaveInfo.EnclaveUuid)))
+	}
+	grpcConnection, err := grpc.Dial(grpcServerAddress, dialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to create a GRPC client connection on address '%v', but a non-nil error was returned", grpcServerAddress)
 	}
@@ -781,7 +799,7 @@ func (runtime *enclaveRuntime) refreshEnclaveConnections() error {
 	runtime.lock.Lock()
 	defer runtime.lock.Unlock()
 
-	enclaves, err := runtime.enclaveManager.GetEnclaves(runtime.ctx)
+	enclaves, err := runtime.enclaveManager.GetEnclaves(runtime.ctx, nil)
 	if err != nil {
 		return stacktrace.Propagate(err, "failed to retrieve the list of enclaves")
 	}
@@ -798,7 +816,7 @@ func (runtime *enclaveRuntime) refreshEnclaveConnections() error {
 	for uuid, info := range enclaves {
 		_, found := runtime.remoteApiContainerClient[uuid]
 		if !found && info != nil {
-			conn, err := getGrpcClientConn(*info, runtime.connectOnHostMachine)
+			conn, err := getGrpcClientConn(*info, runtime.connectOnHostMachine, runtime.apiContainerCredentialIssuer)
 			if err != nil {
 				return stacktrace.Propagate(err, "Failed to establish gRPC connection with enclave manager service on enclave %s", uuid)
 			}
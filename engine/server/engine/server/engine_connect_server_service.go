@@ -7,8 +7,10 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	user_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
@@ -41,6 +43,9 @@ type EngineConnectServerService struct {
 	logsDatabaseClient centralized_logs.LogsDatabaseClient
 
 	metricsClient metrics_client.MetricsClient
+
+	// Nil if the engine doesn't require authentication
+	apiContainerCredentialIssuer *auth.ApiContainerCredentialIssuer
 }
 
 func NewEngineConnectServerService(
@@ -50,14 +55,16 @@ func NewEngineConnectServerService(
 	didUserAcceptSendingMetrics bool,
 	logsDatabaseClient centralized_logs.LogsDatabaseClient,
 	metricsClient metrics_client.MetricsClient,
+	apiContainerCredentialIssuer *auth.ApiContainerCredentialIssuer,
 ) *EngineConnectServerService {
 	service := &EngineConnectServerService{
-		imageVersionTag:             imageVersionTag,
-		enclaveManager:              enclaveManager,
-		metricsUserID:               metricsUserId,
-		didUserAcceptSendingMetrics: didUserAcceptSendingMetrics,
-		logsDatabaseClient:          logsDatabaseClient,
-		metricsClient:               metricsClient,
+		imageVersionTag:              imageVersionTag,
+		enclaveManager:               enclaveManager,
+		metricsUserID:                metricsUserId,
+		didUserAcceptSendingMetrics:  didUserAcceptSendingMetrics,
+		logsDatabaseClient:           logsDatabaseClient,
+		metricsClient:                metricsClient,
+		apiContainerCredentialIssuer: apiContainerCredentialIssuer,
 	}
 	return service
 }
@@ -119,9 +126,35 @@ func toGrpcEnclaveMode(mode types.EnclaveMode) kurtosis_engine_rpc_api_bindings.
 	}
 }
 
+func toGrpcEnclaveExpiryAction(action types.EnclaveExpiryAction) kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction {
+	switch action {
+	case types.EnclaveExpiryAction_DESTROY:
+		return kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY
+	case types.EnclaveExpiryAction_STOP:
+		return kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_STOP
+	default:
+		panic(fmt.Sprintf("Undefined mapping of value: %s", action))
+	}
+}
+
+func toEnclaveExpiryAction(action kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction) enclave.EnclaveExpiryAction {
+	switch action {
+	case kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY:
+		return enclave.EnclaveExpiryAction_Destroy
+	case kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_STOP:
+		return enclave.EnclaveExpiryAction_Stop
+	default:
+		panic(fmt.Sprintf("Undefined mapping of value: %s", action))
+	}
+}
+
 func toGrpcEnclaveInfo(info types.EnclaveInfo) kurtosis_engine_rpc_api_bindings.EnclaveInfo {
 	containerInfo := utils.MapPointer(info.ApiContainerInfo, toGrpcEnclaveAPIContainerInfo)
 	apiHostMachine := utils.MapPointer(info.ApiContainerHostMachineInfo, toGrpcApiContainerHostMachineInfo)
+	var expirationTime *timestamppb.Timestamp
+	if info.ExpirationTime != nil {
+		expirationTime = toGrpcTimestamp(*info.ExpirationTime)
+	}
 	return kurtosis_engine_rpc_api_bindings.EnclaveInfo{
 		EnclaveUuid:                 info.EnclaveUuid,
 		ShortenedUuid:               info.ShortenedUuid,
@@ -132,6 +165,33 @@ func toGrpcEnclaveInfo(info types.EnclaveInfo) kurtosis_engine_rpc_api_bindings.
 		ApiContainerHostMachineInfo: apiHostMachine,
 		CreationTime:                toGrpcTimestamp(info.CreationTime),
 		Mode:                        toGrpcEnclaveMode(info.Mode),
+		Labels:                      info.Labels,
+		ExpirationTime:              expirationTime,
+		ExpiryAction:                toGrpcEnclaveExpiryAction(info.ExpiryAction),
+		ResourceQuota:               utils.MapPointer(info.ResourceQuota, toGrpcEnclaveResourceQuota),
+		Owner:                       info.Owner,
+	}
+}
+
+// Returns nil if no resource quota was requested
+func toEnclaveResourceQuota(quota *kurtosis_engine_rpc_api_bindings.EnclaveResourceQuota) *enclave.EnclaveResourceQuota {
+	if quota == nil {
+		return nil
+	}
+	return enclave.NewEnclaveResourceQuota(
+		compute_resources.CpuMilliCores(quota.GetMaxCpuMillicores()),
+		compute_resources.MemoryInMegaBytes(quota.GetMaxMemoryMegabytes()),
+		quota.GetMaxServices(),
+		quota.GetMaxPersistentStorageMegabytes(),
+	)
+}
+
+func toGrpcEnclaveResourceQuota(quota types.EnclaveResourceQuota) kurtosis_engine_rpc_api_bindings.EnclaveResourceQuota {
+	return kurtosis_engine_rpc_api_bindings.EnclaveResourceQuota{
+		MaxCpuMillicores:              quota.MaxCpuMilliCores,
+		MaxMemoryMegabytes:            quota.MaxMemoryMegaBytes,
+		MaxServices:                   quota.MaxServices,
+		MaxPersistentStorageMegabytes: quota.MaxPersistentStorageMegaBytes,
 	}
 }
 
@@ -175,6 +235,15 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 		isProduction = true
 	}
 
+	var enclaveTtl *time.Duration
+	if args.Ttl != nil {
+		if err := args.GetTtl().CheckValid(); err != nil {
+			return nil, stacktrace.Propagate(err, "An invalid enclave TTL was provided")
+		}
+		ttl := args.GetTtl().AsDuration()
+		enclaveTtl = &ttl
+	}
+
 	enclaveInfo, err := service.enclaveManager.CreateEnclave(
 		ctx,
 		service.imageVersionTag,
@@ -183,6 +252,11 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 		args.GetEnclaveName(),
 		isProduction,
 		args.GetShouldApicRunInDebugMode(),
+		args.GetLabels(),
+		enclaveTtl,
+		toEnclaveExpiryAction(args.GetExpiryAction()),
+		toEnclaveResourceQuota(args.GetResourceQuota()),
+		auth.GetPrincipalIdFromContext(ctx),
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating new enclave with name '%v'", args.GetEnclaveName())
@@ -196,8 +270,8 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 	return connect.NewResponse(response), nil
 }
 
-func (service *EngineConnectServerService) GetEnclaves(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
-	infoForEnclaves, err := service.enclaveManager.GetEnclaves(ctx)
+func (service *EngineConnectServerService) GetEnclaves(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
+	infoForEnclaves, err := service.enclaveManager.GetEnclaves(ctx, connectArgs.Msg.GetLabels())
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting info for enclaves")
 	}
@@ -253,6 +327,43 @@ func (service *EngineConnectServerService) DestroyEnclave(ctx context.Context, c
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (service *EngineConnectServerService) ExtendEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error) {
+	args := connectArgs.Msg
+	if err := args.GetExtension().CheckValid(); err != nil {
+		return nil, stacktrace.Propagate(err, "An invalid TTL extension was provided for enclave '%v'", args.GetEnclaveIdentifier())
+	}
+
+	newExpirationTime, err := service.enclaveManager.ExtendEnclave(ctx, args.GetEnclaveIdentifier(), args.GetExtension().AsDuration())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred extending enclave with identifier '%v'", args.GetEnclaveIdentifier())
+	}
+	response := &kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse{
+		ExpirationTime: toGrpcTimestamp(newExpirationTime),
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (service *EngineConnectServerService) GetEnclaveApiContainerToken(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenResponse], error) {
+	args := connectArgs.Msg
+	principal := auth.GetPrincipalFromContext(ctx)
+	if service.apiContainerCredentialIssuer == nil || principal == nil {
+		return nil, stacktrace.NewError("The engine doesn't require authentication, so neither do the API containers")
+	}
+
+	enclaveUuid, err := service.enclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx, args.GetEnclaveIdentifier())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the UUID of enclave '%v'", args.GetEnclaveIdentifier())
+	}
+	token, err := service.apiContainerCredentialIssuer.GetApiContainerToken(principal, string(enclaveUuid))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the API container token of enclave '%v'", args.GetEnclaveIdentifier())
+	}
+	response := &kurtosis_engine_rpc_api_bindings.GetEnclaveApiContainerTokenResponse{
+		Token: token,
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (service *EngineConnectServerService) Clean(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error) {
 	args := connectArgs.Msg
 	removedEnclaveUuidsAndNames, err := service.enclaveManager.Clean(ctx, args.GetShouldCleanAll())
@@ -3,9 +3,11 @@ package server
 import (
 	"context"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"net/http"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
@@ -56,7 +58,7 @@ func (engine EngineRuntime) DeleteEnclaves(ctx context.Context, request api.Dele
 // Get Enclaves
 // (GET /enclaves)
 func (engine EngineRuntime) GetEnclaves(ctx context.Context, request api.GetEnclavesRequestObject) (api.GetEnclavesResponseObject, error) {
-	infoForEnclaves, err := engine.EnclaveManager.GetEnclaves(ctx)
+	infoForEnclaves, err := engine.EnclaveManager.GetEnclaves(ctx, nil)
 	if err != nil {
 		response := internalErrorResponseInfof(err, "An error occurred getting info for enclaves")
 		return api.GetEnclavesdefaultJSONResponse{
@@ -108,6 +110,11 @@ func (engine EngineRuntime) PostEnclaves(ctx context.Context, request api.PostEn
 		enclaveName,
 		isProduction,
 		bool(shouldApicRunInDebugMode),
+		map[string]string{},
+		nil,
+		enclave.EnclaveExpiryAction_Destroy,
+		nil,
+		auth.GetPrincipalIdFromContext(ctx),
 	)
 	if err != nil {
 		response := internalErrorResponseInfof(err, "An error occurred creating new enclave with name '%v'", request.Body.EnclaveName)
@@ -160,7 +167,7 @@ func (engine EngineRuntime) DeleteEnclavesEnclaveIdentifier(ctx context.Context,
 // Get Enclave Info
 // (GET /enclaves/{enclave_identifier})
 func (engine EngineRuntime) GetEnclavesEnclaveIdentifier(ctx context.Context, request api.GetEnclavesEnclaveIdentifierRequestObject) (api.GetEnclavesEnclaveIdentifierResponseObject, error) {
-	infoForEnclaves, err := engine.EnclaveManager.GetEnclaves(ctx)
+	infoForEnclaves, err := engine.EnclaveManager.GetEnclaves(ctx, nil)
 	if err != nil {
 		response := internalErrorResponseInfof(err, "An error occurred getting info for enclaves")
 		return api.GetEnclavesEnclaveIdentifierdefaultJSONResponse{
@@ -186,7 +193,7 @@ func (engine EngineRuntime) GetEnclavesEnclaveIdentifier(ctx context.Context, re
 // (GET /enclaves/{enclave_identifier}/status)
 func (engine EngineRuntime) GetEnclavesEnclaveIdentifierStatus(ctx context.Context, request api.GetEnclavesEnclaveIdentifierStatusRequestObject) (api.GetEnclavesEnclaveIdentifierStatusResponseObject, error) {
 	enclaveIdentifier := request.EnclaveIdentifier
-	enclaveList, err := engine.EnclaveManager.GetEnclaves(ctx)
+	enclaveList, err := engine.EnclaveManager.GetEnclaves(ctx, nil)
 	if err != nil {
 		response := internalErrorResponseInfof(err, "An error occurred stopping enclave '%v'", enclaveIdentifier)
 		return api.GetEnclavesEnclaveIdentifierStatusdefaultJSONResponse{
@@ -1,13 +1,17 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	user_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_grpc"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/streaming"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
@@ -43,6 +47,9 @@ type WebSocketRuntime struct {
 
 	// Allow CORS origins for websocket
 	CorsConfig cors.Cors
+
+	// Nil if the engine doesn't require authentication
+	ApiContainerCredentialIssuer *auth.ApiContainerCredentialIssuer
 }
 
 func (engine WebSocketRuntime) GetEnclavesEnclaveIdentifierLogs(ctx echo.Context, enclaveIdentifier api_type.EnclaveIdentifier, params api_type.GetEnclavesEnclaveIdentifierLogsParams) error {
@@ -122,6 +129,94 @@ func (engine WebSocketRuntime) GetEnclavesEnclaveIdentifierServicesServiceIdenti
 	return nil
 }
 
+// (GET /enclaves/{enclave_identifier}/services/{service_identifier}/exec)
+func (engine WebSocketRuntime) GetEnclavesEnclaveIdentifierServicesServiceIdentifierExec(ctx echo.Context, enclaveIdentifier api_type.EnclaveIdentifier, serviceIdentifier api_type.ServiceIdentifier, params api_type.GetEnclavesEnclaveIdentifierServicesServiceIdentifierExecParams) error {
+	if !ctx.IsWebSocket() {
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Type:    api_type.ERROR,
+			Message: "Running an interactive command requires a Websocket connection",
+			Code:    http.StatusBadRequest,
+		})
+		return nil
+	}
+
+	enclaveUuid, err := engine.EnclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx.Request().Context(), enclaveIdentifier)
+	if err != nil {
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Type:    api_type.INFO,
+			Message: fmt.Sprintf("enclave '%s' not found", enclaveIdentifier),
+			Code:    http.StatusNotFound,
+		})
+		return nil
+	}
+	enclaves, err := engine.EnclaveManager.GetEnclaves(ctx.Request().Context(), nil)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get the enclaves")
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Type:    api_type.ERROR,
+			Message: "Couldn't retrieve connection with enclave",
+			Code:    http.StatusInternalServerError,
+		})
+		return nil
+	}
+	enclaveInfo, found := enclaves[string(enclaveUuid)]
+	if !found || enclaveInfo == nil {
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Type:    api_type.INFO,
+			Message: fmt.Sprintf("enclave '%s' not found", enclaveIdentifier),
+			Code:    http.StatusNotFound,
+		})
+		return nil
+	}
+	// The engine runs in the same network as the API containers, same as the enclave REST API
+	conn, err := getGrpcClientConn(*enclaveInfo, false, engine.ApiContainerCredentialIssuer)
+	if err != nil || conn == nil {
+		logrus.WithError(err).Errorf("Failed to connect to the API container of enclave '%s'", enclaveUuid)
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Type:    api_type.ERROR,
+			Message: "Couldn't retrieve connection with enclave",
+			Code:    http.StatusInternalServerError,
+		})
+		return nil
+	}
+	defer conn.Close()
+
+	streamCtx, cancelStream := context.WithCancel(auth.NewBackgroundContextWithPrincipalOf(ctx.Request().Context()))
+	defer cancelStream()
+	stream, err := rpc_api.NewApiContainerServiceClient(conn).ExecCommandStream(streamCtx)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to open exec command stream")
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Type:    api_type.ERROR,
+			Message: "Failed to start the command",
+			Code:    http.StatusInternalServerError,
+		})
+		return nil
+	}
+	startRequest := &rpc_api.ExecCommandStreamRequest{
+		Request: &rpc_api.ExecCommandStreamRequest_Start{
+			Start: &rpc_api.ExecCommandStreamStart{
+				ServiceIdentifier: serviceIdentifier,
+				CommandArgs:       params.CommandArgs,
+				Tty:               utils.DerefWith(params.Tty, false),
+				TerminalSize:      to_grpc.ToGrpcTerminalSize(params.TerminalWidth, params.TerminalHeight),
+			},
+		},
+	}
+	if err = stream.Send(startRequest); err != nil {
+		logrus.WithError(err).Error("Failed to start exec command")
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Type:    api_type.ERROR,
+			Message: "Failed to start the command",
+			Code:    http.StatusInternalServerError,
+		})
+		return nil
+	}
+
+	streamExecCommandWithWebsocket(ctx, engine.CorsConfig, stream, cancelStream)
+	return nil
+}
+
 // (GET /starlark/executions/{starlark_execution_uuid}/logs)
 func (engine WebSocketRuntime) GetStarlarkExecutionsStarlarkExecutionUuidLogs(ctx echo.Context, starlarkExecutionUuid api_type.StarlarkExecutionUuid) error {
 	asyncLogUuid := streaming.StreamerUUID(starlarkExecutionUuid)
@@ -273,6 +368,67 @@ func streamServiceLogsWithWebsocket(ctx echo.Context, cors cors.Cors, streamer s
 	}
 }
 
+// streamExecCommandWithWebsocket forwards the client inputs to the running command and its output back to the client,
+// until the command exits or the client goes away
+func streamExecCommandWithWebsocket(ctx echo.Context, cors cors.Cors, stream rpc_api.ApiContainerService_ExecCommandStreamClient, cancelStream context.CancelFunc) {
+	// client messages are all handled by the pump read goroutine, so this is the only sender after the start request
+	onMessage := func(data []byte) {
+		var input api_type.ExecCommandStreamInput
+		if err := json.Unmarshal(data, &input); err != nil {
+			logrus.WithError(err).Warn("Ignoring invalid exec command input")
+			return
+		}
+		for _, request := range to_grpc.ToGrpcExecCommandStreamRequests(input) {
+			if err := stream.Send(request); err != nil {
+				logrus.WithError(err).Debug("Failed to forward exec command input")
+				return
+			}
+		}
+	}
+	wsPump, err := streaming.NewBidirectionalWebsocketPump[api_type.ExecCommandStreamOutput](ctx, cors, onMessage)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to start websocket connection")
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Type:    api_type.ERROR,
+			Message: "Failed to start websocket connection",
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+	defer wsPump.Close()
+	wsPump.OnClose(cancelStream)
+
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			logrus.WithError(err).Warn("Exec command stream failed")
+			if err := wsPump.PumpResponseInfo(&api_type.ResponseInfo{
+				Type:    api_type.ERROR,
+				Message: "The command stream failed before the command exited",
+				Code:    http.StatusInternalServerError,
+			}); err != nil {
+				logrus.WithError(err).Warn("Failed to send response.")
+			}
+			return
+		}
+		output, err := to_http.ToHttpExecCommandStreamOutput(response)
+		if err != nil {
+			logrus.WithError(err).Warn("Skipping exec command output")
+			continue
+		}
+		if err := wsPump.PumpMessage(output); err != nil {
+			logrus.WithError(err).Warn("Failed to stream all the command output")
+			return
+		}
+		if output.ExitCode != nil {
+			return
+		}
+	}
+}
+
 func streamServiceLogsWithHTTP(ctx echo.Context, streamer streaming.ServiceLogStreamer) {
 	ctx.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	enc := json.NewEncoder(ctx.Response())
@@ -17,6 +17,8 @@ const (
 	wsReadBufferSize  = 1024
 	wsWriteBufferSize = 1024
 	maxMessageSize    = 512
+	// Messages sent by clients of bidirectional pumps carry data (e.g. STDIN), so they get a bigger limit
+	maxBidirectionalMessageSize = 64 * 1024
 	// Time allowed to write a message to the peer.
 	writeWait = 10 * time.Second
 	// Time allowed to read the next pong message from the peer.
@@ -35,9 +37,22 @@ type WebsocketPump[T interface{}] struct {
 	closed          bool
 	connectionError *error
 	onCloseCallback func()
+	// Called with each data message sent by the client; nil if the client messages are discarded
+	onMessageCallback func(data []byte)
+	readLimit         int64
 }
 
 func NewWebsocketPump[T interface{}](ctx echo.Context, cors cors.Cors) (*WebsocketPump[T], error) {
+	return newWebsocketPump[T](ctx, cors, nil, maxMessageSize)
+}
+
+// NewBidirectionalWebsocketPump creates a pump that also hands every message sent by the client to the callback, from a
+// single goroutine. The connection is closed when reading from the client fails.
+func NewBidirectionalWebsocketPump[T interface{}](ctx echo.Context, cors cors.Cors, onMessage func(data []byte)) (*WebsocketPump[T], error) {
+	return newWebsocketPump[T](ctx, cors, onMessage, maxBidirectionalMessageSize)
+}
+
+func newWebsocketPump[T interface{}](ctx echo.Context, cors cors.Cors, onMessage func(data []byte), readLimit int64) (*WebsocketPump[T], error) {
 	// nolint: exhaustruct
 	upgrader := websocket.Upgrader{
 		ReadBufferSize:  wsReadBufferSize,
@@ -54,13 +69,15 @@ func NewWebsocketPump[T interface{}](ctx echo.Context, cors cors.Cors) (*Websock
 
 	// nolint: exhaustruct
 	pump := &WebsocketPump[T]{
-		websocket:       conn,
-		inputChan:       make(chan *T),
-		infoChan:        make(chan *api_type.ResponseInfo),
-		ctx:             ctxWithCancel,
-		cancelFunc:      cancelFunc,
-		closed:          false,
-		onCloseCallback: func() {},
+		websocket:         conn,
+		inputChan:         make(chan *T),
+		infoChan:          make(chan *api_type.ResponseInfo),
+		ctx:               ctxWithCancel,
+		cancelFunc:        cancelFunc,
+		closed:            false,
+		onCloseCallback:   func() {},
+		onMessageCallback: onMessage,
+		readLimit:         readLimit,
 	}
 
 	go pump.startPumping()
@@ -70,10 +87,17 @@ func NewWebsocketPump[T interface{}](ctx echo.Context, cors cors.Cors) (*Websock
 
 func (pump *WebsocketPump[T]) readLoop() {
 	for {
-		_, _, err := pump.websocket.ReadMessage()
+		messageType, data, err := pump.websocket.ReadMessage()
 		if err != nil {
+			if pump.onMessageCallback != nil {
+				// the client isn't there anymore to send input, so there's no point in keeping the pump open
+				pump.cancelFunc()
+			}
 			break
 		}
+		if pump.onMessageCallback != nil && (messageType == websocket.TextMessage || messageType == websocket.BinaryMessage) {
+			pump.onMessageCallback(data)
+		}
 	}
 }
 
@@ -91,10 +115,10 @@ func (pump *WebsocketPump[T]) startPumping() {
 	logrus.WithFields(logrus.Fields{
 		"pongWait":       pongWait,
 		"pingPeriod":     pingPeriod,
-		"maxMessageSize": maxMessageSize,
+		"maxMessageSize": pump.readLimit,
 	}).Debug("Started keep alive process for websocket connection.")
 
-	pump.websocket.SetReadLimit(maxMessageSize)
+	pump.websocket.SetReadLimit(pump.readLimit)
 	if err := pump.websocket.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
 		logrus.WithError(err).Error("Failed to set Pong wait time")
 		pump.connectionError = &err
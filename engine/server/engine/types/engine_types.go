@@ -30,6 +30,14 @@ const (
 	EnclaveMode_TEST       EnclaveMode = "TEST"
 )
 
+// Defines values for EnclaveExpiryAction.
+type EnclaveExpiryAction string
+
+const (
+	EnclaveExpiryAction_DESTROY EnclaveExpiryAction = "DESTROY"
+	EnclaveExpiryAction_STOP    EnclaveExpiryAction = "STOP"
+)
+
 // CreateEnclaveArgs defines model for CreateEnclaveArgs.
 type CreateEnclaveArgs struct {
 	ApiContainerLogLevel   string
@@ -70,6 +78,22 @@ type EnclaveInfo struct {
 	Mode                        EnclaveMode
 	Name                        string
 	ShortenedUuid               string
+	Labels                      map[string]string
+	// Nil if the enclave never expires
+	ExpirationTime *Timestamp
+	ExpiryAction   EnclaveExpiryAction
+	// Nil if the enclave has no resource quota
+	ResourceQuota *EnclaveResourceQuota
+	// Empty if the enclave was created while the engine wasn't requiring authentication
+	Owner string
+}
+
+// EnclaveResourceQuota defines model for EnclaveResourceQuota, a zero limit means that the resource isn't capped
+type EnclaveResourceQuota struct {
+	MaxCpuMilliCores              uint64
+	MaxMemoryMegaBytes            uint64
+	MaxServices                   uint32
+	MaxPersistentStorageMegaBytes uint64
 }
 
 // EnclaveNameAndUuid defines model for EnclaveNameAndUuid.
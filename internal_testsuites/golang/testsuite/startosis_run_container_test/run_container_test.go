@@ -0,0 +1,43 @@
+package startosis_run_container_test
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis-cli/golang_internal_testsuite/test_helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	runContainerTest = "run-container-test"
+
+	runContainerStarlarkStreams = `
+def run(plan):
+  result = plan.run_container(image="busybox:1.36.1", entrypoint=["sh", "-c", "echo to-stdout; echo to-stderr >&2"])
+  plan.verify(result.stdout, "==", "to-stdout\n")
+  plan.verify(result.stderr, "==", "to-stderr\n")
+`
+	runContainerStarlarkStore = `
+def run(plan):
+  result = plan.run_container(image="busybox:1.36.1", entrypoint=["sh", "-c", "mkdir -p /out && echo kurtosis > /out/result.txt"], store=[StoreSpec(src="/out/result.txt", name="result")])
+  result2 = plan.run_sh(run="cat /task/result.txt", files={"/task": result.files_artifacts[0]})
+  plan.verify(result2.output, "==", "kurtosis\n")
+`
+)
+
+func TestStarlark_RunContainerSplitsStreams(t *testing.T) {
+	ctx := context.Background()
+	runResult, err := test_helpers.SetupSimpleEnclaveAndRunScript(t, ctx, runContainerTest, runContainerStarlarkStreams)
+	require.Nil(t, err)
+	require.Nil(t, runResult.ExecutionError)
+	require.Empty(t, runResult.ValidationErrors)
+	require.Contains(t, string(runResult.RunOutput), "Verification succeeded. Value is '\"to-stdout\\n\"'.")
+	require.Contains(t, string(runResult.RunOutput), "Verification succeeded. Value is '\"to-stderr\\n\"'.")
+}
+
+func TestStarlark_RunContainerStoresFiles(t *testing.T) {
+	ctx := context.Background()
+	runResult, err := test_helpers.SetupSimpleEnclaveAndRunScript(t, ctx, runContainerTest, runContainerStarlarkStore)
+	require.Nil(t, err)
+	require.Nil(t, runResult.ExecutionError)
+	require.Contains(t, string(runResult.RunOutput), "Verification succeeded. Value is '\"kurtosis\\n\"'.")
+}